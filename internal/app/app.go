@@ -1,31 +1,64 @@
 package app
 
 import (
+	"CodeWithAzri/internal/app/module/audit"
 	"CodeWithAzri/internal/app/module/course"
+	"CodeWithAzri/internal/app/module/discussion"
+	"CodeWithAzri/internal/app/module/event"
 	firebaseModule "CodeWithAzri/internal/app/module/firebase"
+	"CodeWithAzri/internal/app/module/job"
+	"CodeWithAzri/internal/app/module/media"
+	"CodeWithAzri/internal/app/module/moderation"
+	"CodeWithAzri/internal/app/module/notification"
+	"CodeWithAzri/internal/app/module/realtime"
+	"CodeWithAzri/internal/app/module/serviceaccount"
 	"CodeWithAzri/internal/app/module/user"
+	"CodeWithAzri/internal/app/module/webhook"
 	"CodeWithAzri/internal/pkg/constant"
 	"CodeWithAzri/internal/pkg/middleware"
 	"CodeWithAzri/internal/pkg/router"
+	"CodeWithAzri/pkg/cache"
+	"CodeWithAzri/pkg/config"
+	"CodeWithAzri/pkg/mailer"
+	"CodeWithAzri/pkg/ratelimit"
 	"CodeWithAzri/pkg/sqlPkg"
+	"CodeWithAzri/pkg/storage"
+	"context"
 	"database/sql"
+	"errors"
 	"log"
 	"net/http"
+	"time"
 
 	_ "CodeWithAzri/docs"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 )
 
 type App struct {
-	SqlDB          *sql.DB
-	Router         *router.Router
-	Middlewares    []any
-	Validate       *validator.Validate
-	UserModule     *user.Module
-	FirebaseModule *firebaseModule.Module
-	CourseModule   *course.Module
+	SqlDB                *sql.DB
+	Router               *router.Router
+	Middlewares          []any
+	Validate             *validator.Validate
+	UserModule           *user.Module
+	FirebaseModule       *firebaseModule.Module
+	CourseModule         *course.Module
+	MediaModule          *media.Module
+	JobModule            *job.Module
+	EventModule          *event.Module
+	WebhookModule        *webhook.Module
+	NotificationModule   *notification.Module
+	RealtimeModule       *realtime.Module
+	DiscussionModule     *discussion.Module
+	ModerationModule     *moderation.Module
+	AuditModule          *audit.Module
+	ServiceAccountModule *serviceaccount.Module
+	Storage              storage.Storage
+	Mailer               mailer.Sender
+	RateLimitStore       ratelimit.Store
+	Cache                cache.Cache
 }
 
 func NewApp() *App {
@@ -42,10 +75,126 @@ func (a *App) initDB() {
 	}
 }
 
+func (a *App) initStorage() {
+	var err error
+	a.Storage, err = storage.NewFromEnv()
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (a *App) initMailer() {
+	var err error
+	a.Mailer, err = mailer.NewFromEnv()
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (a *App) initRateLimitStore() {
+	var err error
+	a.RateLimitStore, err = ratelimit.NewFromEnv()
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (a *App) initCache() {
+	var err error
+	a.Cache, err = cache.NewFromEnv()
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (a *App) initModules() {
-	a.UserModule = user.NewModule(a.SqlDB, a.Validate)
+	a.JobModule = job.NewModule(a.SqlDB, a.Validate)
+	a.EventModule = event.NewModule(a.SqlDB, a.JobModule.Service)
+	a.WebhookModule = webhook.NewModule(a.SqlDB, a.Validate, a.JobModule.Service, a.EventModule.Service)
+	a.MediaModule = media.NewModule(a.SqlDB, a.Validate, a.Storage)
 	a.FirebaseModule = firebaseModule.NewModule()
-	a.CourseModule = course.NewModule(a.SqlDB, a.Validate)
+	a.UserModule = user.NewModule(a.SqlDB, a.Validate, a.MediaModule.Service, a.FirebaseModule, a.Cache)
+	a.CourseModule = course.NewModule(a.SqlDB, a.Validate, a.MediaModule.Service, a.Cache)
+	a.MediaModule.Service.OnVideoProcessed(a.CourseModule.Service.RecordVideoDuration)
+	a.RealtimeModule = realtime.NewModule(a.SqlDB, a.EventModule.Service, a.CourseModule.Service)
+	a.ModerationModule = moderation.NewModule(a.SqlDB, a.Validate)
+	a.ModerationModule.Service.OnContentModerated(a.CourseModule.Service.RecordModeration)
+	a.DiscussionModule = discussion.NewModule(a.SqlDB, a.Validate, a.CourseModule.Service, a.ModerationModule.Service, a.RealtimeModule.Service)
+	a.NotificationModule = notification.NewModule(a.SqlDB, a.Validate, a.JobModule.Service, a.EventModule.Service,
+		a.UserModule.Service, a.CourseModule.Service, a.Mailer, a.RealtimeModule.Service)
+	a.UserModule.Service.AddPersonalDataHolder(a.CourseModule.Service)
+	a.UserModule.Service.AddPersonalDataHolder(a.DiscussionModule.Service)
+	a.UserModule.Service.AddPersonalDataHolder(a.NotificationModule.Service)
+	a.UserModule.Service.AddPersonalDataHolder(a.EventModule.Service)
+	a.UserModule.Service.AddPersonalDataHolder(a.WebhookModule.Service)
+	a.AuditModule = audit.NewModule(a.SqlDB, a.Validate)
+	a.ServiceAccountModule = serviceaccount.NewModule(a.SqlDB, a.Validate)
+	a.initAuditSnapshots()
+}
+
+// initAuditSnapshots lets the audit log diff courses, tags, webhooks, service
+// accounts and users before and after each change. Changes to other entities
+// are recorded without their content.
+func (a *App) initAuditSnapshots() {
+	a.AuditModule.Service.RegisterSnapshot("courses", func(entityID string) (any, error) {
+		id, err := uuid.Parse(entityID)
+		if err != nil {
+			return nil, nil
+		}
+		course, err := a.CourseModule.Repository.ReadOne(id)
+		if err != nil || course.ID == uuid.Nil {
+			return nil, err
+		}
+		return course, nil
+	})
+	a.AuditModule.Service.RegisterSnapshot("tags", func(entityID string) (any, error) {
+		id, err := uuid.Parse(entityID)
+		if err != nil {
+			return nil, nil
+		}
+		tag, err := a.CourseModule.Repository.ReadTag(id)
+		if err != nil || tag.ID == uuid.Nil {
+			return nil, err
+		}
+		return tag, nil
+	})
+	a.AuditModule.Service.RegisterSnapshot("webhooks", func(entityID string) (any, error) {
+		id, err := uuid.Parse(entityID)
+		if err != nil {
+			return nil, nil
+		}
+		subscription, err := a.WebhookModule.Repository.ReadOne(id)
+		if err != nil || subscription.ID == uuid.Nil {
+			return nil, err
+		}
+		return subscription, nil
+	})
+	a.AuditModule.Service.RegisterSnapshot("service-accounts", func(entityID string) (any, error) {
+		id, err := uuid.Parse(entityID)
+		if err != nil {
+			return nil, nil
+		}
+		account, err := a.ServiceAccountModule.Repository.ReadOne(id)
+		if err != nil || account.ID == uuid.Nil {
+			return nil, err
+		}
+		return account, nil
+	})
+	// The audit log cannot be erased, so it never sees the personal fields
+	// of users.
+	a.AuditModule.Service.RegisterSnapshot("users", func(entityID string) (any, error) {
+		user, err := a.UserModule.Repository.ReadOne(entityID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		user.Name = ""
+		user.Email = ""
+		user.ProfilePicture = ""
+		return user, nil
+	})
 }
 
 func (a *App) initMigrations() {
@@ -59,11 +208,58 @@ func (a *App) initMigrations() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	err = a.MediaModule.Migration.CreateMediaTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.JobModule.Migration.CreateJobTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.EventModule.Migration.CreateEventTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.WebhookModule.Migration.CreateWebhookTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.NotificationModule.Migration.CreateNotificationTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.DiscussionModule.Migration.CreateDiscussionTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.ModerationModule.Migration.CreateModerationTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.AuditModule.Migration.CreateAuditTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = a.ServiceAccountModule.Migration.CreateServiceAccountTables(a.SqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
 
 func (a *App) initMiddlewares() {
 	firebaseMiddleware := middleware.NewFirebaseMiddleware(a.FirebaseModule.FirebaseApp)
+	firebaseMiddleware.APIKeys = a.ServiceAccountModule.Service
 	a.Middlewares = append(a.Middlewares, firebaseMiddleware)
+	a.Router.AuditMiddleware = middleware.AuditMiddleware(a.AuditModule.Service)
+	a.Router.UserSyncMiddleware = middleware.UserSyncMiddleware(a.UserModule.Service, a.Cache)
+	proxies, err := middleware.ParseTrustedProxies(config.GetEnvValue("TRUSTED_PROXIES"))
+	if err != nil {
+		panic(err)
+	}
+	a.Router.RateLimitMiddleware = middleware.RateLimitMiddleware(a.RateLimitStore, proxies)
+	// Generous enough for several users behind one NAT, but it stops a
+	// single address from guessing tokens or API keys at full speed.
+	a.Router.IPRateLimitMiddleware = middleware.IPRateLimitMiddleware(a.RateLimitStore, proxies, ratelimit.Limit{Requests: 1200, Window: time.Minute})
 }
 
 func (a *App) initModuleRouters() {
@@ -75,6 +271,15 @@ func (a *App) initModuleRouters() {
 
 	router.RegisterUserRoutes(a.Router, constant.V1, a.UserModule, m)
 	router.RegisterCourseRoutes(a.Router, constant.V1, a.CourseModule, m)
+	router.RegisterMediaRoutes(a.Router, constant.V1, a.MediaModule, m)
+	router.RegisterJobRoutes(a.Router, constant.V1, a.JobModule, m)
+	router.RegisterWebhookRoutes(a.Router, constant.V1, a.WebhookModule, m)
+	router.RegisterNotificationRoutes(a.Router, constant.V1, a.NotificationModule, m)
+	router.RegisterRealtimeRoutes(a.Router, constant.V1, a.RealtimeModule, m)
+	router.RegisterDiscussionRoutes(a.Router, constant.V1, a.DiscussionModule, m)
+	router.RegisterModerationRoutes(a.Router, constant.V1, a.ModerationModule, m)
+	router.RegisterAuditRoutes(a.Router, constant.V1, a.AuditModule, m)
+	router.RegisterServiceAccountRoutes(a.Router, constant.V1, a.ServiceAccountModule, m)
 	a.Router.Mux.Get("/swagger/*", httpSwagger.Handler(
 		httpSwagger.URL("/swagger/doc.json"),
 	))
@@ -83,6 +288,10 @@ func (a *App) initModuleRouters() {
 
 func (a *App) initComponents() {
 	a.initDB()
+	a.initStorage()
+	a.initMailer()
+	a.initRateLimitStore()
+	a.initCache()
 	a.Router = router.NewRouter()
 	a.Validate = validator.New()
 	a.initModules()
@@ -92,6 +301,13 @@ func (a *App) initComponents() {
 }
 
 func (a *App) Run() {
+	go a.CourseModule.Worker.Start(context.Background())
+	go a.MediaModule.Worker.Start(context.Background())
+	go a.MediaModule.VideoWorker.Start(context.Background())
+	go a.JobModule.Worker.Start(context.Background())
+	go a.EventModule.Worker.Start(context.Background())
+	go a.RealtimeModule.Worker.Start(context.Background())
+
 	err := http.ListenAndServe(
 		":8080",
 		a.Router.Mux,
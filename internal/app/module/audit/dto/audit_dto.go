@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogDTO struct {
+	ID         uuid.UUID       `json:"id"`
+	ActorID    string          `json:"actor_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Changes    json.RawMessage `json:"changes" swaggertype:"object"`
+	CreatedAt  int64           `json:"created_at"`
+}
+
+// AuditLogFilterDTO narrows the audit logs listed on the admin endpoint.
+// Empty fields match every log. From and To are Unix milliseconds, From
+// inclusive and To exclusive.
+type AuditLogFilterDTO struct {
+	ActorID    string
+	EntityType string
+	EntityID   string
+	From       int64
+	To         int64
+}
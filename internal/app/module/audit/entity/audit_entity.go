@@ -0,0 +1,17 @@
+package entity
+
+import "github.com/google/uuid"
+
+// AuditLog records one data-changing request. Action is the method and route
+// pattern, like "PUT /api/v1/courses/{id}", and Changes maps the JSON path of
+// every changed field to its old and new value. Rows are never updated or
+// deleted.
+type AuditLog struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+	ActorID    string    `json:"actor_id" gorm:"type:varchar(255);not null;index"`
+	Action     string    `json:"action" gorm:"type:varchar(255);not null"`
+	EntityType string    `json:"entity_type" gorm:"type:varchar(50);not null;index:idx_audit_logs_entity,priority:1"`
+	EntityID   string    `json:"entity_id" gorm:"type:varchar(255);not null;default:'';index:idx_audit_logs_entity,priority:2"`
+	Changes    string    `json:"changes" gorm:"type:jsonb;not null;default:'{}'"`
+	CreatedAt  int64     `json:"created_at" gorm:"not null;index"`
+}
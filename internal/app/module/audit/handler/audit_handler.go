@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/audit/dto"
+	"CodeWithAzri/internal/app/module/audit/service"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var errInvalidTimeRange = errors.New("from and to must be Unix milliseconds with from before to")
+
+type Handler struct {
+	service  service.AuditService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.AuditService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// GetAuditLogs godoc
+//
+//	@Summary		List the audit log
+//	@Tags			Admin
+//	@Description	Page through the data-changing requests, newest first, optionally filtered by actor, entity and time range. Each log holds the changed fields of the entity with their old and new values; secrets are redacted. Requires the admin claim.
+//	@ID				get-audit-logs
+//	@Accept			json
+//	@Produce		json
+//	@Param			actor_id		query	string	false	"User ID of the actor"
+//	@Param			entity_type		query	string	false	"Entity type as named in the routes, e.g. courses"
+//	@Param			entity_id		query	string	false	"Entity ID"
+//	@Param			from			query	int		false	"Start of the time range in Unix milliseconds, inclusive"
+//	@Param			to				query	int		false	"End of the time range in Unix milliseconds, exclusive"
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of logs per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.AuditLogDTO}	"Successful response with audit logs"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/admin/audit-logs [get]
+func (h *Handler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	from, fromErr := parseTime(requestPkg.GetQueryParam(r, "from"))
+	to, toErr := parseTime(requestPkg.GetQueryParam(r, "to"))
+	if fromErr != nil || toErr != nil || (from > 0 && to > 0 && from >= to) {
+		response.RespondError(http.StatusBadRequest, errInvalidTimeRange, w)
+		return
+	}
+
+	filter := dto.AuditLogFilterDTO{
+		ActorID:    requestPkg.GetQueryParam(r, "actor_id"),
+		EntityType: requestPkg.GetQueryParam(r, "entity_type"),
+		EntityID:   requestPkg.GetQueryParam(r, "entity_id"),
+		From:       from,
+		To:         to,
+	}
+
+	limit, page := requestPkg.ParsePagination(r)
+
+	logs, err := h.service.GetLogs(filter, limit, page)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Audit Logs Fetched Successfully", "Success", logs, w)
+}
+
+// parseTime reads an optional Unix millisecond timestamp; empty is 0.
+func parseTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	t, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || t <= 0 {
+		return 0, errInvalidTimeRange
+	}
+
+	return t, nil
+}
@@ -0,0 +1,15 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/audit/handler"
+	"CodeWithAzri/internal/app/module/audit/service/mocks"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.AuditService) {
+	mockService := mocks.NewAuditService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
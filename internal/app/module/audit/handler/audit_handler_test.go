@@ -0,0 +1,60 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/audit/dto"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_GetAuditLogs(t *testing.T) {
+	auditHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Filtered Logs Successfully", func(t *testing.T) {
+		filter := dto.AuditLogFilterDTO{ActorID: "admin-uid", EntityType: "courses", EntityID: "c1", From: 100000, To: 200000}
+		log := dto.AuditLogDTO{ID: uuid.New(), ActorID: "admin-uid", Action: "DELETE /api/v1/courses/{id}", Changes: json.RawMessage(`{}`)}
+		mockService.On("GetLogs", filter, 20, 2).Return([]dto.AuditLogDTO{log}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/audit-logs?actor_id=admin-uid&entity_type=courses&entity_id=c1&from=100000&to=200000&limit=20&page=2", nil)
+		recorder := httptest.NewRecorder()
+		auditHandler.GetAuditLogs(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"action":"DELETE /api/v1/courses/{id}"`)
+	})
+
+	t.Run("Get All Logs Successfully", func(t *testing.T) {
+		mockService.On("GetLogs", dto.AuditLogFilterDTO{}, 10, 1).Return([]dto.AuditLogDTO{}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/audit-logs", nil)
+		recorder := httptest.NewRecorder()
+		auditHandler.GetAuditLogs(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Invalid Time Range", func(t *testing.T) {
+		for _, query := range []string{"from=yesterday", "to=-1", "from=200000&to=100000"} {
+			req, _ := http.NewRequest("GET", "/api/v1/admin/audit-logs?"+query, nil)
+			recorder := httptest.NewRecorder()
+			auditHandler.GetAuditLogs(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, query)
+		}
+	})
+
+	t.Run("Get Logs Error", func(t *testing.T) {
+		mockService.On("GetLogs", dto.AuditLogFilterDTO{}, 10, 1).Return(nil, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/audit-logs", nil)
+		recorder := httptest.NewRecorder()
+		auditHandler.GetAuditLogs(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
@@ -0,0 +1,46 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type AuditMigration struct{}
+
+// appendOnlyQueries make the database reject updates and deletes of audit
+// logs, so the log cannot be rewritten even by a bug in the application.
+var appendOnlyQueries = []string{
+	`
+		CREATE OR REPLACE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_logs is append-only';
+		END;
+		$$ LANGUAGE plpgsql
+	`,
+	`DROP TRIGGER IF EXISTS audit_logs_append_only ON audit_logs`,
+	`
+		CREATE TRIGGER audit_logs_append_only BEFORE UPDATE OR DELETE ON audit_logs
+		FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only()
+	`,
+}
+
+func (m AuditMigration) CreateAuditTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	err := migrationDB.AutoMigrate(
+		entity.AuditLog{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range appendOnlyQueries {
+		err = migrationDB.Exec(query).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
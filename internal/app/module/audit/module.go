@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"CodeWithAzri/internal/app/module/audit/handler"
+	"CodeWithAzri/internal/app/module/audit/migration"
+	"CodeWithAzri/internal/app/module/audit/repository"
+	"CodeWithAzri/internal/app/module/audit/service"
+	"database/sql"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.AuditService
+	Repository repository.AuditRepository
+	Migration  *migration.AuditMigration
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewAuditService(m.Repository)
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.AuditMigration{}
+	return m
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"database/sql"
+	"fmt"
+)
+
+// AuditRepository only appends and reads; audit logs are never changed.
+type AuditRepository interface {
+	Append(log entity.AuditLog) error
+	ReadMany(actorID string, entityType string, entityID string, from int64, to int64, limit int, offset int) ([]entity.AuditLog, error)
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) AuditRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+const auditLogColumns = `id, actor_id, action, entity_type, entity_id, changes, created_at`
+
+func (r *Repository) Append(log entity.AuditLog) error {
+	query := `
+		INSERT INTO audit_logs (` + auditLogColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(query, log.ID, log.ActorID, log.Action, log.EntityType, log.EntityID, log.Changes, log.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to append audit log: %v", err)
+	}
+
+	return nil
+}
+
+// ReadMany lists audit logs from newest to oldest, created in [from, to). An
+// empty actorID, entityType or entityID matches every log, and a zero from or
+// to leaves that end of the range open.
+func (r *Repository) ReadMany(actorID string, entityType string, entityID string, from int64, to int64, limit int, offset int) ([]entity.AuditLog, error) {
+	query := `
+		SELECT ` + auditLogColumns + ` FROM audit_logs
+		WHERE ($1 = '' OR actor_id = $1) AND ($2 = '' OR entity_type = $2) AND ($3 = '' OR entity_id = $3)
+		AND ($4 = 0 OR created_at >= $4) AND ($5 = 0 OR created_at < $5)
+		ORDER BY created_at DESC
+		LIMIT $6 OFFSET $7
+	`
+
+	rows, err := r.db.Query(query, actorID, entityType, entityID, from, to, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit logs: %v", err)
+	}
+	defer rows.Close()
+
+	logs := make([]entity.AuditLog, 0)
+	for rows.Next() {
+		var log entity.AuditLog
+		err = rows.Scan(&log.ID, &log.ActorID, &log.Action, &log.EntityType, &log.EntityID, &log.Changes, &log.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit log: %v", err)
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
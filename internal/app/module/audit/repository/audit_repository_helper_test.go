@@ -0,0 +1,45 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"CodeWithAzri/internal/app/module/audit/repository"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	appendLogQuery = "INSERT INTO audit_logs (id, actor_id, action, entity_type, entity_id, changes, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	readLogsQuery  = "SELECT id, actor_id, action, entity_type, entity_id, changes, created_at FROM audit_logs WHERE ($1 = '' OR actor_id = $1) AND ($2 = '' OR entity_type = $2) AND ($3 = '' OR entity_id = $3) AND ($4 = 0 OR created_at >= $4) AND ($5 = 0 OR created_at < $5) ORDER BY created_at DESC LIMIT $6 OFFSET $7"
+)
+
+var MockLog entity.AuditLog = entity.AuditLog{
+	ID:         uuid.MustParse("7d1e4b2a-9c3f-4e5d-8a6b-2f1c3d4e5f61"),
+	ActorID:    "admin-uid",
+	Action:     "PUT /api/v1/courses/{id}",
+	EntityType: "courses",
+	EntityID:   "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61",
+	Changes:    `{"name":{"from":"Go","to":"Go 101"}}`,
+	CreatedAt:  121212,
+}
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.AuditRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func prepareLogRows(logs ...entity.AuditLog) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "actor_id", "action", "entity_type", "entity_id", "changes", "created_at"})
+	for _, l := range logs {
+		rows.AddRow(l.ID, l.ActorID, l.Action, l.EntityType, l.EntityID, l.Changes, l.CreatedAt)
+	}
+	return rows
+}
@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_Append(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Append Log", func(t *testing.T) {
+		l := MockLog
+		mock.ExpectExec(appendLogQuery).
+			WithArgs(l.ID, l.ActorID, l.Action, l.EntityType, l.EntityID, l.Changes, l.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Append(MockLog)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Append Log Error", func(t *testing.T) {
+		mock.ExpectExec(appendLogQuery).WillReturnError(errors.New("insert failed"))
+
+		err := repo.Append(MockLog)
+
+		assert.EqualError(t, err, "failed to append audit log: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadMany(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Filtered Logs", func(t *testing.T) {
+		mock.ExpectQuery(readLogsQuery).
+			WithArgs("admin-uid", "courses", MockLog.EntityID, int64(100000), int64(200000), 10, 0).
+			WillReturnRows(prepareLogRows(MockLog))
+
+		logs, err := repo.ReadMany("admin-uid", "courses", MockLog.EntityID, 100000, 200000, 10, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.AuditLog{MockLog}, logs)
+	})
+
+	t.Run("Read All Logs", func(t *testing.T) {
+		mock.ExpectQuery(readLogsQuery).WithArgs("", "", "", int64(0), int64(0), 10, 10).WillReturnRows(prepareLogRows())
+
+		logs, err := repo.ReadMany("", "", "", 0, 0, 10, 10)
+
+		assert.NoError(t, err)
+		assert.Empty(t, logs)
+	})
+
+	t.Run("Read Logs Error", func(t *testing.T) {
+		mock.ExpectQuery(readLogsQuery).WillReturnError(errors.New("select failed"))
+
+		_, err := repo.ReadMany("", "", "", 0, 0, 10, 0)
+
+		assert.EqualError(t, err, "failed to read audit logs: select failed")
+	})
+
+	t.Run("Scan Log Error", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id"}).AddRow(MockLog.ID)
+		mock.ExpectQuery(readLogsQuery).WillReturnRows(rows)
+
+		_, err := repo.ReadMany("", "", "", 0, 0, 10, 0)
+
+		assert.ErrorContains(t, err, "failed to scan audit log")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/audit/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepository is an autogenerated mock type for the AuditRepository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+type AuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditRepository) EXPECT() *AuditRepository_Expecter {
+	return &AuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// Append provides a mock function with given fields: log
+func (_m *AuditRepository) Append(log entity.AuditLog) error {
+	ret := _m.Called(log)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.AuditLog) error); ok {
+		r0 = rf(log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditRepository_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type AuditRepository_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - log entity.AuditLog
+func (_e *AuditRepository_Expecter) Append(log interface{}) *AuditRepository_Append_Call {
+	return &AuditRepository_Append_Call{Call: _e.mock.On("Append", log)}
+}
+
+func (_c *AuditRepository_Append_Call) Run(run func(log entity.AuditLog)) *AuditRepository_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.AuditLog))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_Append_Call) Return(_a0 error) *AuditRepository_Append_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditRepository_Append_Call) RunAndReturn(run func(entity.AuditLog) error) *AuditRepository_Append_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMany provides a mock function with given fields: actorID, entityType, entityID, from, to, limit, offset
+func (_m *AuditRepository) ReadMany(actorID string, entityType string, entityID string, from int64, to int64, limit int, offset int) ([]entity.AuditLog, error) {
+	ret := _m.Called(actorID, entityType, entityID, from, to, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMany")
+	}
+
+	var r0 []entity.AuditLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int64, int64, int, int) ([]entity.AuditLog, error)); ok {
+		return rf(actorID, entityType, entityID, from, to, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int64, int64, int, int) []entity.AuditLog); ok {
+		r0 = rf(actorID, entityType, entityID, from, to, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int64, int64, int, int) error); ok {
+		r1 = rf(actorID, entityType, entityID, from, to, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
+type AuditRepository_ReadMany_Call struct {
+	*mock.Call
+}
+
+// ReadMany is a helper method to define mock.On call
+//   - actorID string
+//   - entityType string
+//   - entityID string
+//   - from int64
+//   - to int64
+//   - limit int
+//   - offset int
+func (_e *AuditRepository_Expecter) ReadMany(actorID interface{}, entityType interface{}, entityID interface{}, from interface{}, to interface{}, limit interface{}, offset interface{}) *AuditRepository_ReadMany_Call {
+	return &AuditRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", actorID, entityType, entityID, from, to, limit, offset)}
+}
+
+func (_c *AuditRepository_ReadMany_Call) Run(run func(actorID string, entityType string, entityID string, from int64, to int64, limit int, offset int)) *AuditRepository_ReadMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(int64), args[4].(int64), args[5].(int), args[6].(int))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_ReadMany_Call) Return(_a0 []entity.AuditLog, _a1 error) *AuditRepository_ReadMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRepository_ReadMany_Call) RunAndReturn(run func(string, string, string, int64, int64, int, int) ([]entity.AuditLog, error)) *AuditRepository_ReadMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are parts of field names whose values are never written to
+// the audit log. Changes to them are still recorded.
+var sensitiveKeys = []string{"secret", "password", "token"}
+
+// Change is the old and new value of a field.
+type Change struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// diff compares the JSON forms of before and after. Objects are compared
+// field by field and the changes are keyed by their dot separated path;
+// anything else, arrays included, is compared whole. Either side may be nil
+// for entities that were created or deleted.
+func diff(before any, after any) (map[string]Change, error) {
+	from, err := normalize(before)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := normalize(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]Change)
+	compare("", from, to, changes)
+	return changes, nil
+}
+
+// normalize turns v into the maps, slices and scalars it encodes to.
+// json.RawMessage and []byte holding JSON are decoded as is.
+func normalize(v any) (any, error) {
+	var data []byte
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	default:
+		var err error
+		data, err = json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var normalized any
+	err := json.Unmarshal(data, &normalized)
+	return normalized, err
+}
+
+func compare(path string, from any, to any, changes map[string]Change) {
+	if isSensitive(path) {
+		if !reflect.DeepEqual(from, to) {
+			changes[path] = Change{From: redact(from), To: redact(to)}
+		}
+		return
+	}
+
+	fromObject, fromIsObject := from.(map[string]any)
+	toObject, toIsObject := to.(map[string]any)
+	if (fromIsObject || from == nil) && (toIsObject || to == nil) && (fromIsObject || toIsObject) {
+		for key, value := range fromObject {
+			compare(join(path, key), value, toObject[key], changes)
+		}
+		for key, value := range toObject {
+			if _, ok := fromObject[key]; !ok {
+				compare(join(path, key), nil, value, changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		changes[path] = Change{From: from, To: to}
+	}
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func isSensitive(path string) bool {
+	key := strings.ToLower(path[strings.LastIndex(path, ".")+1:])
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(v any) any {
+	if v == nil {
+		return nil
+	}
+	return redacted
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/audit/dto"
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"CodeWithAzri/internal/app/module/audit/repository"
+	"CodeWithAzri/internal/pkg/middleware"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Snapshotter reads the current state of an entity for the audit log. It
+// returns nil when the entity does not exist.
+type Snapshotter func(entityID string) (any, error)
+
+type AuditService interface {
+	middleware.AuditRecorder
+	RegisterSnapshot(entityType string, snapshot Snapshotter)
+	GetLogs(filter dto.AuditLogFilterDTO, limit int, page int) ([]dto.AuditLogDTO, error)
+}
+
+type Service struct {
+	repository repository.AuditRepository
+	mu         sync.RWMutex
+	snapshots  map[string]Snapshotter
+}
+
+// NewAuditService creates the service behind the audit log. Entities whose
+// type has a Snapshotter are diffed before and after each change; for the
+// others only the action is recorded, since request bodies may hold
+// personal data the log can never erase.
+func NewAuditService(r repository.AuditRepository) AuditService {
+	s := new(Service)
+	s.repository = r
+	s.snapshots = make(map[string]Snapshotter)
+	return s
+}
+
+// RegisterSnapshot tracks the state of entities of entityType, as named in
+// their routes, like "courses".
+func (s *Service) RegisterSnapshot(entityType string, snapshot Snapshotter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[entityType] = snapshot
+}
+
+func (s *Service) Snapshot(entityType string, entityID string) any {
+	s.mu.RLock()
+	snapshot, ok := s.snapshots[entityType]
+	s.mu.RUnlock()
+	if !ok || entityID == "" {
+		return nil
+	}
+
+	state, err := snapshot(entityID)
+	if err != nil {
+		log.Printf("failed to snapshot %s %s for the audit log: %v", entityType, entityID, err)
+		return nil
+	}
+
+	return state
+}
+
+// Record appends entry to the audit log. Failing to write the log does not
+// undo the change, so errors are only logged.
+func (s *Service) Record(entry middleware.AuditEntry) {
+	s.mu.RLock()
+	_, tracked := s.snapshots[entry.EntityType]
+	s.mu.RUnlock()
+
+	var after any
+	if tracked {
+		after = s.Snapshot(entry.EntityType, entry.EntityID)
+	}
+
+	changes, err := diff(entry.Before, after)
+	if err != nil {
+		log.Printf("failed to diff %s %s for the audit log: %v", entry.EntityType, entry.EntityID, err)
+		changes = map[string]Change{}
+	}
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		log.Printf("failed to encode audit log changes: %v", err)
+		return
+	}
+
+	err = s.repository.Append(entity.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    entry.ActorID,
+		Action:     entry.Action,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Changes:    string(changesJSON),
+		CreatedAt:  timepkg.NowUnixMilli(),
+	})
+	if err != nil {
+		log.Printf("failed to record %s by %s: %v", entry.Action, entry.ActorID, err)
+	}
+}
+
+func (s *Service) GetLogs(filter dto.AuditLogFilterDTO, limit int, page int) ([]dto.AuditLogDTO, error) {
+	offset := (page - 1) * limit
+
+	logs, err := s.repository.ReadMany(filter.ActorID, filter.EntityType, filter.EntityID, filter.From, filter.To, limit, offset)
+	if err != nil {
+		return []dto.AuditLogDTO{}, err
+	}
+
+	logDTOs := make([]dto.AuditLogDTO, 0, len(logs))
+	for _, auditLog := range logs {
+		logDTOs = append(logDTOs, dto.AuditLogDTO{
+			ID:         auditLog.ID,
+			ActorID:    auditLog.ActorID,
+			Action:     auditLog.Action,
+			EntityType: auditLog.EntityType,
+			EntityID:   auditLog.EntityID,
+			Changes:    json.RawMessage(auditLog.Changes),
+			CreatedAt:  auditLog.CreatedAt,
+		})
+	}
+
+	return logDTOs, nil
+}
@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"CodeWithAzri/internal/app/module/audit/repository/mocks"
+	"CodeWithAzri/internal/app/module/audit/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+var MockLog entity.AuditLog = entity.AuditLog{
+	ID:         uuid.MustParse("7d1e4b2a-9c3f-4e5d-8a6b-2f1c3d4e5f61"),
+	ActorID:    "admin-uid",
+	Action:     "PUT /api/v1/courses/{id}",
+	EntityType: "courses",
+	EntityID:   "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61",
+	Changes:    `{"name":{"from":"Go","to":"Go 101"}}`,
+	CreatedAt:  121212,
+}
+
+type mockCourse struct {
+	Name   string   `json:"name"`
+	Status string   `json:"status"`
+	Tags   []string `json:"tags"`
+}
+
+func initializeService(t *testing.T) (service.AuditService, *mocks.AuditRepository) {
+	mockRepo := mocks.NewAuditRepository(t)
+	s := service.NewAuditService(mockRepo)
+	return s, mockRepo
+}
+
+// expectAppend accepts one log and returns where it is stored once appended.
+func expectAppend(mockRepo *mocks.AuditRepository) *entity.AuditLog {
+	var log entity.AuditLog
+	mockRepo.On("Append", mock.AnythingOfType("entity.AuditLog")).
+		Run(func(args mock.Arguments) { log = args.Get(0).(entity.AuditLog) }).
+		Return(nil)
+	return &log
+}
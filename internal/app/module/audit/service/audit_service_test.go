@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/audit/dto"
+	"CodeWithAzri/internal/app/module/audit/entity"
+	"CodeWithAzri/internal/pkg/middleware"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Snapshot(t *testing.T) {
+	t.Run("Tracked Entity", func(t *testing.T) {
+		s, _ := initializeService(t)
+		s.RegisterSnapshot("courses", func(entityID string) (any, error) {
+			return mockCourse{Name: entityID}, nil
+		})
+
+		assert.Equal(t, mockCourse{Name: "c1"}, s.Snapshot("courses", "c1"))
+	})
+
+	t.Run("Untracked Entity", func(t *testing.T) {
+		s, _ := initializeService(t)
+
+		assert.Nil(t, s.Snapshot("comments", "c1"))
+	})
+
+	t.Run("Snapshot Error", func(t *testing.T) {
+		s, _ := initializeService(t)
+		s.RegisterSnapshot("courses", func(entityID string) (any, error) {
+			return nil, errors.New("select failed")
+		})
+
+		assert.Nil(t, s.Snapshot("courses", "c1"))
+	})
+}
+
+func TestService_Record(t *testing.T) {
+	entry := middleware.AuditEntry{
+		ActorID:    "admin-uid",
+		Action:     "PUT /api/v1/courses/{id}",
+		EntityType: "courses",
+		EntityID:   "c1",
+	}
+
+	t.Run("Diff Tracked Entity", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		s.RegisterSnapshot("courses", func(entityID string) (any, error) {
+			return mockCourse{Name: "Go 101", Status: "draft", Tags: []string{"go"}}, nil
+		})
+		log := expectAppend(mockRepo)
+		e := entry
+		e.Before = mockCourse{Name: "Go", Status: "draft", Tags: []string{"go"}}
+
+		s.Record(e)
+
+		assert.NotEqual(t, MockLog.ID, log.ID)
+		assert.Equal(t, "admin-uid", log.ActorID)
+		assert.Equal(t, "PUT /api/v1/courses/{id}", log.Action)
+		assert.Equal(t, "courses", log.EntityType)
+		assert.Equal(t, "c1", log.EntityID)
+		assert.JSONEq(t, `{"name":{"from":"Go","to":"Go 101"}}`, log.Changes)
+		assert.NotZero(t, log.CreatedAt)
+	})
+
+	t.Run("Deleted Tracked Entity", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		s.RegisterSnapshot("courses", func(entityID string) (any, error) {
+			return nil, nil
+		})
+		log := expectAppend(mockRepo)
+		e := entry
+		e.Action = "DELETE /api/v1/courses/{id}"
+		e.Before = mockCourse{Name: "Go", Status: "draft"}
+
+		s.Record(e)
+
+		assert.JSONEq(t, `{"name":{"from":"Go","to":null},"status":{"from":"draft","to":null}}`, log.Changes)
+	})
+
+	t.Run("Diff Nested Fields", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		s.RegisterSnapshot("webhooks", func(entityID string) (any, error) {
+			return json.RawMessage(`{"config":{"url":"https://example.com/v2","api_token":"new"},"events":["course.created"]}`), nil
+		})
+		log := expectAppend(mockRepo)
+		e := entry
+		e.EntityType = "webhooks"
+		e.Before = json.RawMessage(`{"config":{"url":"https://example.com/v1","api_token":"old"},"events":[]}`)
+
+		s.Record(e)
+
+		assert.JSONEq(t, `{
+			"config.url":{"from":"https://example.com/v1","to":"https://example.com/v2"},
+			"config.api_token":{"from":"[REDACTED]","to":"[REDACTED]"},
+			"events":{"from":[],"to":["course.created"]}
+		}`, log.Changes)
+	})
+
+	t.Run("Untracked Entity Records Action Only", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		log := expectAppend(mockRepo)
+		e := entry
+		e.Action = "POST /api/v1/discussions/{id}/comments"
+		e.EntityType = "discussions"
+
+		s.Record(e)
+
+		assert.Equal(t, "POST /api/v1/discussions/{id}/comments", log.Action)
+		assert.Equal(t, "discussions", log.EntityType)
+		assert.Equal(t, "c1", log.EntityID)
+		assert.JSONEq(t, `{}`, log.Changes)
+	})
+
+	t.Run("Append Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("Append", mock.AnythingOfType("entity.AuditLog")).Return(errors.New("insert failed"))
+
+		assert.NotPanics(t, func() { s.Record(entry) })
+	})
+}
+
+func TestService_GetLogs(t *testing.T) {
+	filter := dto.AuditLogFilterDTO{ActorID: "admin-uid", EntityType: "courses", From: 100000, To: 200000}
+
+	t.Run("Get Logs", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadMany", "admin-uid", "courses", "", int64(100000), int64(200000), 10, 10).
+			Return([]entity.AuditLog{MockLog}, nil)
+
+		logs, err := s.GetLogs(filter, 10, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.AuditLogDTO{{
+			ID:         MockLog.ID,
+			ActorID:    MockLog.ActorID,
+			Action:     MockLog.Action,
+			EntityType: MockLog.EntityType,
+			EntityID:   MockLog.EntityID,
+			Changes:    json.RawMessage(MockLog.Changes),
+			CreatedAt:  MockLog.CreatedAt,
+		}}, logs)
+	})
+
+	t.Run("Get Logs Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadMany", "admin-uid", "courses", "", int64(100000), int64(200000), 10, 0).
+			Return(nil, errors.New("select failed"))
+
+		logs, err := s.GetLogs(filter, 10, 1)
+
+		assert.EqualError(t, err, "select failed")
+		assert.Empty(t, logs)
+	})
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/audit/dto"
+	middleware "CodeWithAzri/internal/pkg/middleware"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "CodeWithAzri/internal/app/module/audit/service"
+)
+
+// AuditService is an autogenerated mock type for the AuditService type
+type AuditService struct {
+	mock.Mock
+}
+
+type AuditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditService) EXPECT() *AuditService_Expecter {
+	return &AuditService_Expecter{mock: &_m.Mock}
+}
+
+// GetLogs provides a mock function with given fields: filter, limit, page
+func (_m *AuditService) GetLogs(filter dto.AuditLogFilterDTO, limit int, page int) ([]dto.AuditLogDTO, error) {
+	ret := _m.Called(filter, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogs")
+	}
+
+	var r0 []dto.AuditLogDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.AuditLogFilterDTO, int, int) ([]dto.AuditLogDTO, error)); ok {
+		return rf(filter, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(dto.AuditLogFilterDTO, int, int) []dto.AuditLogDTO); ok {
+		r0 = rf(filter, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.AuditLogDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.AuditLogFilterDTO, int, int) error); ok {
+		r1 = rf(filter, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditService_GetLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogs'
+type AuditService_GetLogs_Call struct {
+	*mock.Call
+}
+
+// GetLogs is a helper method to define mock.On call
+//   - filter dto.AuditLogFilterDTO
+//   - limit int
+//   - page int
+func (_e *AuditService_Expecter) GetLogs(filter interface{}, limit interface{}, page interface{}) *AuditService_GetLogs_Call {
+	return &AuditService_GetLogs_Call{Call: _e.mock.On("GetLogs", filter, limit, page)}
+}
+
+func (_c *AuditService_GetLogs_Call) Run(run func(filter dto.AuditLogFilterDTO, limit int, page int)) *AuditService_GetLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(dto.AuditLogFilterDTO), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *AuditService_GetLogs_Call) Return(_a0 []dto.AuditLogDTO, _a1 error) *AuditService_GetLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditService_GetLogs_Call) RunAndReturn(run func(dto.AuditLogFilterDTO, int, int) ([]dto.AuditLogDTO, error)) *AuditService_GetLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: entry
+func (_m *AuditService) Record(entry middleware.AuditEntry) {
+	_m.Called(entry)
+}
+
+// AuditService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type AuditService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - entry middleware.AuditEntry
+func (_e *AuditService_Expecter) Record(entry interface{}) *AuditService_Record_Call {
+	return &AuditService_Record_Call{Call: _e.mock.On("Record", entry)}
+}
+
+func (_c *AuditService_Record_Call) Run(run func(entry middleware.AuditEntry)) *AuditService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(middleware.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *AuditService_Record_Call) Return() *AuditService_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuditService_Record_Call) RunAndReturn(run func(middleware.AuditEntry)) *AuditService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterSnapshot provides a mock function with given fields: entityType, snapshot
+func (_m *AuditService) RegisterSnapshot(entityType string, snapshot service.Snapshotter) {
+	_m.Called(entityType, snapshot)
+}
+
+// AuditService_RegisterSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterSnapshot'
+type AuditService_RegisterSnapshot_Call struct {
+	*mock.Call
+}
+
+// RegisterSnapshot is a helper method to define mock.On call
+//   - entityType string
+//   - snapshot service.Snapshotter
+func (_e *AuditService_Expecter) RegisterSnapshot(entityType interface{}, snapshot interface{}) *AuditService_RegisterSnapshot_Call {
+	return &AuditService_RegisterSnapshot_Call{Call: _e.mock.On("RegisterSnapshot", entityType, snapshot)}
+}
+
+func (_c *AuditService_RegisterSnapshot_Call) Run(run func(entityType string, snapshot service.Snapshotter)) *AuditService_RegisterSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(service.Snapshotter))
+	})
+	return _c
+}
+
+func (_c *AuditService_RegisterSnapshot_Call) Return() *AuditService_RegisterSnapshot_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuditService_RegisterSnapshot_Call) RunAndReturn(run func(string, service.Snapshotter)) *AuditService_RegisterSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function with given fields: entityType, entityID
+func (_m *AuditService) Snapshot(entityType string, entityID string) any {
+	ret := _m.Called(entityType, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 any
+	if rf, ok := ret.Get(0).(func(string, string) any); ok {
+		r0 = rf(entityType, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	return r0
+}
+
+// AuditService_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type AuditService_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - entityType string
+//   - entityID string
+func (_e *AuditService_Expecter) Snapshot(entityType interface{}, entityID interface{}) *AuditService_Snapshot_Call {
+	return &AuditService_Snapshot_Call{Call: _e.mock.On("Snapshot", entityType, entityID)}
+}
+
+func (_c *AuditService_Snapshot_Call) Run(run func(entityType string, entityID string)) *AuditService_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuditService_Snapshot_Call) Return(_a0 any) *AuditService_Snapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditService_Snapshot_Call) RunAndReturn(run func(string, string) any) *AuditService_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditService creates a new instance of AuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditService {
+	mock := &AuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,30 +1,72 @@
 package dto
 
 import (
+	// Not aliased: swag only resolves aliases that match the package name.
+	"CodeWithAzri/internal/app/module/media/dto"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
 	language_enum "CodeWithAzri/pkg/enums/language"
 
 	"github.com/google/uuid"
 )
 
 type CourseDTO struct {
-	ID            uuid.UUID              `json:"id,omitempty"`
-	Name          string                 `json:"name,omitempty"`
-	Description   string                 `json:"description,omitempty"`
-	Language      language_enum.Language `json:"language,omitempty"`
-	CourseTags    []CourseTagsDTO        `json:"tags,omitempty"`
-	CourseReviews []CourseReviewsDTO     `json:"reviews,omitempty"`
-	Gallery       []CourseGalleryDTO     `json:"gallery,omitempty"`
-	Sections      []CourseSectionDTO     `json:"sections,omitempty"`
-	CreatedAt     int64                  `json:"created_at,omitempty"`
-	UpdatedAt     int64                  `json:"updated_at,omitempty"`
+	ID            uuid.UUID                       `json:"id,omitempty"`
+	Name          string                          `json:"name,omitempty"`
+	Description   string                          `json:"description,omitempty"`
+	Language      language_enum.Language          `json:"language,omitempty"`
+	Status        course_status_enum.CourseStatus `json:"status,omitempty"`
+	PublishAt     *int64                          `json:"publish_at,omitempty"`
+	OwnerID       string                          `json:"owner_id,omitempty"`
+	CourseTags    []CourseTagsDTO                 `json:"tags,omitempty"`
+	CourseReviews []CourseReviewsDTO              `json:"reviews,omitempty"`
+	Gallery       []CourseGalleryDTO              `json:"gallery,omitempty"`
+	Sections      []CourseSectionDTO              `json:"sections,omitempty"`
+	Instructors   []CourseInstructorDTO           `json:"instructors,omitempty"`
+	CreatedAt     int64                           `json:"created_at,omitempty"`
+	UpdatedAt     int64                           `json:"updated_at,omitempty"`
+}
+
+// CourseSummaryDTO is a course as listed, without its sections and reviews.
+type CourseSummaryDTO struct {
+	ID          uuid.UUID                       `json:"id,omitempty"`
+	Name        string                          `json:"name,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Language    language_enum.Language          `json:"language,omitempty"`
+	Status      course_status_enum.CourseStatus `json:"status,omitempty"`
+	PublishAt   *int64                          `json:"publish_at,omitempty"`
+	OwnerID     string                          `json:"owner_id,omitempty"`
+	CourseTags  []CourseTagsDTO                 `json:"tags,omitempty"`
+	Gallery     []CourseGalleryDTO              `json:"gallery,omitempty"`
+	Instructors []CourseInstructorDTO           `json:"instructors,omitempty"`
+	CreatedAt   int64                           `json:"created_at,omitempty"`
+	UpdatedAt   int64                           `json:"updated_at,omitempty"`
+}
+
+// ExpandedCourseSummaryDTO is a listed course with the sections and reviews
+// the listing expanded, which are left out otherwise.
+type ExpandedCourseSummaryDTO struct {
+	CourseSummaryDTO
+	CourseReviews []CourseReviewsDTO `json:"reviews,omitempty"`
+	Sections      []CourseSectionDTO `json:"sections,omitempty"`
+}
+
+// CourseViewDTO shapes a course read. Fields keeps only the named top level
+// fields, all of them when empty. Expand adds sections, lessons, reviews,
+// tags or gallery to what the read includes by default.
+type CourseViewDTO struct {
+	Fields []string
+	Expand []string
 }
 
 type CourseGalleryDTO struct {
-	ID        uuid.UUID `json:"id,omitempty"`
-	CourseID  uuid.UUID `json:"course_id,omitempty"`
-	URL       string    `json:"url,omitempty"`
-	CreatedAt int64     `json:"created_at,omitempty"`
-	UpdatedAt int64     `json:"updated_at,omitempty"`
+	ID        uuid.UUID             `json:"id,omitempty"`
+	CourseID  uuid.UUID             `json:"course_id,omitempty"`
+	URL       string                `json:"url,omitempty"`
+	MediaID   *uuid.UUID            `json:"media_id,omitempty"`
+	Variants  []dto.ImageVariantDTO `json:"variants,omitempty"`
+	CreatedAt int64                 `json:"created_at,omitempty"`
+	UpdatedAt int64                 `json:"updated_at,omitempty"`
 }
 
 type CourseSectionDTO struct {
@@ -37,13 +79,15 @@ type CourseSectionDTO struct {
 }
 
 type CourseLessonDTO struct {
-	ID              uuid.UUID `json:"id,omitempty"`
-	CourseID        uuid.UUID `json:"course_id,omitempty"`
-	CourseSectionID uuid.UUID `json:"course_section_id,omitempty"`
-	Title           string    `json:"title,omitempty"`
-	VideoURL        string    `json:"video_url,omitempty"`
-	CreatedAt       int64     `json:"created_at,omitempty"`
-	UpdatedAt       int64     `json:"updated_at,omitempty"`
+	ID              uuid.UUID  `json:"id,omitempty"`
+	CourseID        uuid.UUID  `json:"course_id,omitempty"`
+	CourseSectionID uuid.UUID  `json:"course_section_id,omitempty"`
+	Title           string     `json:"title,omitempty"`
+	VideoURL        string     `json:"video_url,omitempty"`
+	MediaID         *uuid.UUID `json:"media_id,omitempty"`
+	Duration        int        `json:"duration,omitempty"`
+	CreatedAt       int64      `json:"created_at,omitempty"`
+	UpdatedAt       int64      `json:"updated_at,omitempty"`
 }
 
 type CourseReviewsDTO struct {
@@ -57,12 +101,133 @@ type CourseReviewsDTO struct {
 }
 
 type CourseTagsDTO struct {
-	ID        uuid.UUID `json:"id,omitempty"`
-	Name      string    `json:"name,omitempty"`
-	CreatedAt int64     `json:"created_at,omitempty"`
-	UpdatedAt int64     `json:"updated_at,omitempty"`
+	ID        uuid.UUID  `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Slug      string     `json:"slug,omitempty"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	CreatedAt int64      `json:"created_at,omitempty"`
+	UpdatedAt int64      `json:"updated_at,omitempty"`
+}
+
+// CourseTagCountDTO is a tag as listed, with the number of published courses
+// tagged with it.
+type CourseTagCountDTO struct {
+	CourseTagsDTO
+	CourseCount int64 `json:"course_count"`
+}
+
+// UpsertCourseTagDTO creates or replaces a tag. The slug is derived from the
+// name when left out, and a tag without a parent is a top level category.
+type UpsertCourseTagDTO struct {
+	Name     string     `json:"name" validate:"required,max=255"`
+	Slug     string     `json:"slug,omitempty" validate:"omitempty,max=255"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+}
+
+// MergeCourseTagDTO names the tag another one is merged into.
+type MergeCourseTagDTO struct {
+	TargetID uuid.UUID `json:"target_id" validate:"required"`
 }
 
 type CourseIDDTO struct {
 	ID uuid.UUID `uri:"id" binding:"required"`
 }
+
+type UpdateCourseStatusDTO struct {
+	Status    course_status_enum.CourseStatus `json:"status" validate:"required,oneof=draft review published archived"`
+	PublishAt *int64                          `json:"publish_at,omitempty" validate:"omitempty,gt=0"`
+}
+
+type UpdateCourseDTO struct {
+	Name        string                   `json:"name" validate:"required"`
+	Description string                   `json:"description" validate:"required"`
+	Language    language_enum.Language   `json:"language" validate:"required,oneof=id en"`
+	TagIDs      []uuid.UUID              `json:"tag_ids"`
+	Gallery     []UpsertCourseGalleryDTO `json:"gallery" validate:"dive"`
+	Sections    []UpsertCourseSectionDTO `json:"sections" validate:"dive"`
+}
+
+type UpsertCourseGalleryDTO struct {
+	ID      uuid.UUID  `json:"id,omitempty"`
+	URL     string     `json:"url,omitempty" validate:"required_without=MediaID,omitempty,url"`
+	MediaID *uuid.UUID `json:"media_id,omitempty"`
+}
+
+type UpsertCourseSectionDTO struct {
+	ID      uuid.UUID               `json:"id,omitempty"`
+	Name    string                  `json:"name" validate:"required"`
+	Lessons []UpsertCourseLessonDTO `json:"lessons" validate:"dive"`
+}
+
+type UpsertCourseLessonDTO struct {
+	ID       uuid.UUID  `json:"id,omitempty"`
+	Title    string     `json:"title" validate:"required"`
+	VideoURL string     `json:"video_url,omitempty" validate:"required_without=MediaID,omitempty,url"`
+	MediaID  *uuid.UUID `json:"media_id,omitempty"`
+}
+
+// LessonStreamDTO points a player at the HLS manifest of an uploaded lesson
+// video. The manifest URL is short-lived and must be fetched again once it
+// expires.
+type LessonStreamDTO struct {
+	LessonID    uuid.UUID `json:"lesson_id"`
+	ManifestURL string    `json:"manifest_url"`
+	ExpiresAt   int64     `json:"expires_at"`
+	Duration    int       `json:"duration"`
+}
+
+// LessonAccessDTO describes a lesson a user may see. Instructor is true when
+// the user teaches its course.
+type LessonAccessDTO struct {
+	CourseID    uuid.UUID              `json:"course_id"`
+	CourseName  string                 `json:"course_name"`
+	Language    language_enum.Language `json:"language"`
+	LessonID    uuid.UUID              `json:"lesson_id"`
+	LessonTitle string                 `json:"lesson_title"`
+	Instructor  bool                   `json:"instructor"`
+}
+
+type LessonProgressDTO struct {
+	CourseID    uuid.UUID `json:"course_id"`
+	LessonID    uuid.UUID `json:"lesson_id"`
+	UserID      string    `json:"user_id"`
+	CompletedAt int64     `json:"completed_at"`
+}
+
+// CourseProgressDTO counts the lessons of a course a user completed. Lessons
+// that were removed from the course no longer count.
+type CourseProgressDTO struct {
+	CourseID         uuid.UUID              `json:"course_id"`
+	Name             string                 `json:"name"`
+	Language         language_enum.Language `json:"language"`
+	UserID           string                 `json:"user_id"`
+	CompletedLessons int                    `json:"completed_lessons"`
+	TotalLessons     int                    `json:"total_lessons"`
+	Completed        bool                   `json:"completed"`
+}
+
+type CourseEnrollmentDTO struct {
+	CourseID  uuid.UUID `json:"course_id"`
+	UserID    string    `json:"user_id"`
+	CreatedAt int64     `json:"created_at"`
+}
+
+type CourseInstructorDTO struct {
+	CourseID  uuid.UUID                           `json:"course_id,omitempty"`
+	UserID    string                              `json:"user_id,omitempty"`
+	Role      instructor_role_enum.InstructorRole `json:"role,omitempty"`
+	Profile   InstructorProfileDTO                `json:"profile"`
+	CreatedAt int64                               `json:"created_at,omitempty"`
+	UpdatedAt int64                               `json:"updated_at,omitempty"`
+}
+
+type InstructorProfileDTO struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ProfilePicture string `json:"profilePicture,omitempty"`
+}
+
+type AddCourseInstructorDTO struct {
+	UserID string                              `json:"user_id" validate:"required"`
+	Role   instructor_role_enum.InstructorRole `json:"role" validate:"required,oneof=editor"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "github.com/google/uuid"
+
+type CourseRevisionDTO struct {
+	ID        uuid.UUID  `json:"id,omitempty"`
+	CourseID  uuid.UUID  `json:"course_id,omitempty"`
+	Revision  int        `json:"revision,omitempty"`
+	Message   string     `json:"message,omitempty"`
+	Snapshot  *CourseDTO `json:"snapshot,omitempty"`
+	CreatedBy string     `json:"created_by,omitempty"`
+	CreatedAt int64      `json:"created_at,omitempty"`
+}
+
+type FieldChangeDTO struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type ChildChangeDTO struct {
+	ID      uuid.UUID        `json:"id"`
+	Changes []FieldChangeDTO `json:"changes"`
+}
+
+type ChildrenDiffDTO struct {
+	Added    []uuid.UUID      `json:"added,omitempty"`
+	Removed  []uuid.UUID      `json:"removed,omitempty"`
+	Modified []ChildChangeDTO `json:"modified,omitempty"`
+}
+
+type CourseRevisionDiffDTO struct {
+	CourseID uuid.UUID        `json:"course_id"`
+	From     int              `json:"from"`
+	To       int              `json:"to"`
+	Changes  []FieldChangeDTO `json:"changes,omitempty"`
+	Tags     ChildrenDiffDTO  `json:"tags"`
+	Gallery  ChildrenDiffDTO  `json:"gallery"`
+	Sections ChildrenDiffDTO  `json:"sections"`
+	Lessons  ChildrenDiffDTO  `json:"lessons"`
+}
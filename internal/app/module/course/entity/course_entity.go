@@ -1,64 +1,133 @@
 package entity
 
 import (
+	userEntity "CodeWithAzri/internal/app/module/user/entity"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
 	language_enum "CodeWithAzri/pkg/enums/language"
 
 	"github.com/google/uuid"
 )
 
 type Course struct {
-	ID            uuid.UUID              `json:"id" gorm:"type:uuid;primaryKey"`
-	Name          string                 `json:"name" gorm:"type:varchar(255)"`
-	Description   string                 `json:"description" gorm:"type:text"`
-	Language      language_enum.Language `json:"language" gorm:"type:varchar(2)"`
-	CourseTags    []CourseTags           `json:"tags" gorm:"many2many:course_tags_courses;"`
-	CourseReviews []CourseReviews        `json:"reviews" gorm:"many2many:course_reviews_courses"`
-	Gallery       []CourseGallery        `json:"gallery"`
-	Sections      []CourseSection        `json:"sections"`
-	CreatedAt     int64                  `json:"created_at"`
-	UpdatedAt     int64                  `json:"updated_at"`
+	ID            uuid.UUID                       `json:"id" gorm:"type:uuid;primaryKey"`
+	Name          string                          `json:"name" gorm:"type:varchar(255)"`
+	Description   string                          `json:"description" gorm:"type:text"`
+	Language      language_enum.Language          `json:"language" gorm:"type:varchar(2)"`
+	Status        course_status_enum.CourseStatus `json:"status" gorm:"type:varchar(20);not null;default:published;index"`
+	PublishAt     *int64                          `json:"publish_at" gorm:"index"`
+	OwnerID       string                          `json:"owner_id" gorm:"type:varchar(255);not null;default:'';index"`
+	CourseTags    []CourseTags                    `json:"tags" gorm:"many2many:course_tags_courses;"`
+	CourseReviews []CourseReviews                 `json:"reviews" gorm:"many2many:course_reviews_courses"`
+	Gallery       []CourseGallery                 `json:"gallery"`
+	Sections      []CourseSection                 `json:"sections"`
+	Instructors   []CourseInstructor              `json:"instructors,omitempty"`
+	CreatedAt     int64                           `json:"created_at"`
+	UpdatedAt     int64                           `json:"updated_at"`
 }
 
+// CourseGallery either links an external image by URL or references an
+// uploaded image through MediaID, in which case URL is left empty.
 type CourseGallery struct {
-	ID        uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
-	CourseID  uuid.UUID `json:"course_id" gorm:"type:uuid;index"`
-	URL       string    `json:"url" gorm:"type:text"`
-	CreatedAt int64     `json:"created_at,omitempty"`
-	UpdatedAt int64     `json:"updated_at,omitempty"`
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+	CourseID  uuid.UUID  `json:"course_id" gorm:"type:uuid;index"`
+	URL       string     `json:"url" gorm:"type:text"`
+	MediaID   *uuid.UUID `json:"media_id,omitempty" gorm:"type:uuid;index"`
+	CreatedAt int64      `json:"created_at,omitempty"`
+	UpdatedAt int64      `json:"updated_at,omitempty"`
 }
 
 type CourseSection struct {
 	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey"`
 	CourseID  uuid.UUID      `json:"course_id" gorm:"type:uuid;index"`
 	Name      string         `json:"name" gorm:"type:varchar(255)"`
+	Position  int            `json:"position" gorm:"not null;default:0"`
 	Lessons   []CourseLesson `json:"lessons"`
 	CreatedAt int64          `json:"created_at,omitempty"`
 	UpdatedAt int64          `json:"updated_at,omitempty"`
 }
 
+// CourseLesson either links an external video by VideoURL or references an
+// uploaded lesson video through MediaID, which is streamed over HLS. Duration
+// is in seconds and only known once an uploaded video has been packaged.
 type CourseLesson struct {
-	ID              uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
-	CourseID        uuid.UUID `json:"course_id" gorm:"type:uuid;index"`
-	CourseSectionID uuid.UUID `json:"course_section_id" gorm:"type:uuid;index"`
-	Title           string    `json:"title" gorm:"type:varchar(255)"`
-	VideoURL        string    `json:"video_url" gorm:"type:text"`
-	CreatedAt       int64     `json:"created_at,omitempty"`
-	UpdatedAt       int64     `json:"updated_at,omitempty"`
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+	CourseID        uuid.UUID  `json:"course_id" gorm:"type:uuid;index"`
+	CourseSectionID uuid.UUID  `json:"course_section_id" gorm:"type:uuid;index"`
+	Title           string     `json:"title" gorm:"type:varchar(255)"`
+	VideoURL        string     `json:"video_url" gorm:"type:text"`
+	MediaID         *uuid.UUID `json:"media_id,omitempty" gorm:"type:uuid;index"`
+	Duration        int        `json:"duration" gorm:"not null;default:0"`
+	Position        int        `json:"position" gorm:"not null;default:0"`
+	CreatedAt       int64      `json:"created_at,omitempty"`
+	UpdatedAt       int64      `json:"updated_at,omitempty"`
 }
 
+// CourseReviews.HiddenAt is set while moderation keeps the review out of
+// sight.
 type CourseReviews struct {
 	ID        uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
 	CourseID  uuid.UUID `json:"course_id" gorm:"type:uuid;index"`
 	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;index"`
 	Value     int       `json:"value"`
 	Comment   string    `json:"comment" gorm:"type:text"`
+	HiddenAt  *int64    `json:"hidden_at,omitempty"`
 	CreatedAt int64     `json:"created_at,omitempty"`
 	UpdatedAt int64     `json:"updated_at,omitempty"`
 }
 
+// CourseTags are addressed by their Slug in URLs, and grouped into
+// categories by pointing ParentID at the tag they belong under.
 type CourseTags struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+	Name      string     `json:"name" gorm:"type:varchar(255);uniqueIndex"`
+	Slug      string     `json:"slug" gorm:"type:varchar(255);uniqueIndex"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty" gorm:"type:uuid;index"`
+	CreatedAt int64      `json:"created_at,omitempty"`
+	UpdatedAt int64      `json:"updated_at,omitempty"`
+}
+
+// CourseTagCount is a tag with the number of published courses tagged with
+// it.
+type CourseTagCount struct {
+	CourseTags
+	CourseCount int64 `json:"course_count"`
+}
+
+type CourseRevision struct {
 	ID        uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
-	Name      string    `json:"name" gorm:"type:varchar(255);uniqueIndex"`
-	CreatedAt int64     `json:"created_at,omitempty"`
-	UpdatedAt int64     `json:"updated_at,omitempty"`
+	CourseID  uuid.UUID `json:"course_id" gorm:"type:uuid;uniqueIndex:idx_course_revisions_course_revision"`
+	Revision  int       `json:"revision" gorm:"uniqueIndex:idx_course_revisions_course_revision"`
+	Message   string    `json:"message" gorm:"type:varchar(255)"`
+	Snapshot  string    `json:"snapshot" gorm:"type:jsonb"`
+	CreatedBy string    `json:"created_by" gorm:"type:varchar(255)"`
+	CreatedAt int64     `json:"created_at"`
+}
+
+type CourseInstructor struct {
+	CourseID  uuid.UUID                           `json:"course_id" gorm:"type:uuid;primaryKey"`
+	UserID    string                              `json:"user_id" gorm:"type:varchar(255);primaryKey;index"`
+	Role      instructor_role_enum.InstructorRole `json:"role" gorm:"type:varchar(20);not null"`
+	Profile   userEntity.User                     `json:"profile" gorm:"-"`
+	CreatedAt int64                               `json:"created_at,omitempty"`
+	UpdatedAt int64                               `json:"updated_at,omitempty"`
+}
+
+// CourseEnrollment grants a user access to the lessons of a course.
+type CourseEnrollment struct {
+	CourseID  uuid.UUID `json:"course_id" gorm:"type:uuid;primaryKey"`
+	UserID    string    `json:"user_id" gorm:"type:varchar(255);primaryKey;index"`
+	CreatedAt int64     `json:"created_at"`
+}
+
+// CourseLessonProgress records that a user completed a lesson.
+type CourseLessonProgress struct {
+	LessonID    uuid.UUID `json:"lesson_id" gorm:"type:uuid;primaryKey"`
+	UserID      string    `json:"user_id" gorm:"type:varchar(255);primaryKey;index:idx_course_lesson_progress_user_course,priority:1"`
+	CourseID    uuid.UUID `json:"course_id" gorm:"type:uuid;not null;index:idx_course_lesson_progress_user_course,priority:2"`
+	CompletedAt int64     `json:"completed_at"`
+}
+
+func (CourseLessonProgress) TableName() string {
+	return "course_lesson_progress"
 }
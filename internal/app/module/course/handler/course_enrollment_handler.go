@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// EnrollCourse godoc
+//
+//	@Summary		Enroll in a course
+//	@Tags			Course
+//	@Description	Enroll the signed in user in a published course, which lets them stream its lessons. Enrolling again keeps the original enrollment.
+//	@ID				enroll-course
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.CourseEnrollmentDTO}	"Successful response with the enrollment"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		404	{object}	response.ResponseError							"Course not found or not published"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/courses/{id}/enrollments [post]
+func (h *Handler) EnrollCourse(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	enrollment, err := h.service.Enroll(courseID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Course Enrolled Successfully", "Success", enrollment, w)
+}
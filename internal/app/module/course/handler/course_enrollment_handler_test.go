@@ -0,0 +1,60 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/service"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_EnrollCourse(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchInstructorRequest()
+
+	courseID := uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2")
+
+	t.Run("Enroll Course Successfully", func(t *testing.T) {
+		mockService.On("Enroll", courseID, "user123").Return(dto.CourseEnrollmentDTO{CourseID: courseID, UserID: "user123", CreatedAt: 121212}, nil).Once()
+
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/enrollments", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.EnrollCourse(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Enroll Course Not Found", service.ErrCourseNotFound, http.StatusNotFound},
+		{"Enroll Course Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("Enroll", courseID, "user123").Return(dto.CourseEnrollmentDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/enrollments", nil)
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.EnrollCourse(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"CodeWithAzri/internal/app/module/course/dto"
 	"CodeWithAzri/internal/app/module/course/service"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
 	"CodeWithAzri/pkg/requestPkg"
 	"CodeWithAzri/pkg/response"
 	"errors"
 	"net/http"
-	"strconv"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -24,6 +26,47 @@ func NewHandler(s service.CourseService, v *validator.Validate) *Handler {
 	return h
 }
 
+// CreateCourse godoc
+//
+//	@Summary		Create a course
+//	@Tags			Course
+//	@Description	Create a draft course owned by the signed in user. The content has the same shape as an update, except that gallery items, sections and lessons must not have IDs yet.
+//	@ID				create-course
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.UpdateCourseDTO	true	"Course content"
+//	@Param			Authorization	header	string				true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.CourseDTO}	"Successful response with the created course"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		422	{object}	response.ResponseError					"Content with IDs, unknown tags, or invalid gallery or lesson media"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/courses [post]
+func (h *Handler) CreateCourse(w http.ResponseWriter, r *http.Request) {
+	var d dto.UpdateCourseDTO
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	course, err := h.service.CreateCourse(userID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Course Created Successfully", "Success", course, w)
+}
+
 // GetCourseDetail godoc
 //
 //	@Summary		Create or fetch course details
@@ -33,13 +76,18 @@ func NewHandler(s service.CourseService, v *validator.Validate) *Handler {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id				path	string	true	"Course ID for creation or fetching"
+//	@Param			fields			query	string	false	"Comma separated fields to return, all of them by default"
+//	@Param			expand			query	string	false	"Comma separated content to include besides tags, gallery, sections and lessons: reviews"
 //	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Param			If-None-Match	header	string	false	"ETag of a cached copy"
 //	@Security		Bearer
 //	@Success		200	{object}	response.Response{data=dto.CourseDTO}	"Successful response with course details"
-//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
-//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
-//	@Failure		404	{object}	response.ResponseError					"Course not found"
-//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Header			200	{string}	ETag									"Version of the course details"
+//	@Success		304	"Cached copy is still current"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input, fields or expansions"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		404	{object}	response.ResponseError	"Course not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
 //	@Router			/api/v1/courses/{id} [get]
 func (h *Handler) GetCourseDetail(w http.ResponseWriter, r *http.Request) {
 	id := requestPkg.GetURLParam(r, "id")
@@ -49,9 +97,15 @@ func (h *Handler) GetCourseDetail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	courseDetail, err := h.service.GetDetailCourse(courseID)
+	userID := requestPkg.GetUserID(r)
+	view := parseCourseView(r)
+
+	courseDetail, etag, err := h.service.GetDetailCourse(courseID, userID, view, r.Header.Get("If-None-Match"))
+	if notModified(w, etag, err) {
+		return
+	}
 	if err != nil {
-		response.RespondError(http.StatusInternalServerError, err, w)
+		response.RespondError(statusCodeFromError(err), err, w)
 		return
 	}
 
@@ -61,7 +115,13 @@ func (h *Handler) GetCourseDetail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response.BuildResponse(http.StatusOK, "Course Detail Fetched Successfully", "Success", courseDetail, w)
+	data, err := selectFields(courseDetail, view.Fields)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Detail Fetched Successfully", "Success", data, w)
 }
 
 // GetPaginatedCourses godoc
@@ -74,40 +134,247 @@ func (h *Handler) GetCourseDetail(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			page			query	int		false	"Page number for pagination (default: 1)"
 //	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			fields			query	string	false	"Comma separated fields to return, all of them by default"
+//	@Param			expand			query	string	false	"Comma separated content to include besides tags and gallery: sections, lessons or reviews"
 //	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Param			If-None-Match	header	string	false	"ETag of a cached copy"
 //	@Security		Bearer
-//	@Success		200	{object}	response.Response{data=[]dto.CourseDTO}	"Successful response with paginated courses"
+//	@Success		200	{object}	response.Response{data=[]dto.ExpandedCourseSummaryDTO}	"Successful response with paginated courses"
+//	@Header			200	{string}	ETag											"Version of the page"
+//	@Success		304	"Cached copy is still current"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input, fields or expansions"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/courses [get]
+func (h *Handler) GetPaginatedCourses(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+
+	userID := requestPkg.GetUserID(r)
+	view := parseCourseView(r)
+
+	courses, etag, err := h.service.GetPaginatedCourses(limit, page, userID, view, r.Header.Get("If-None-Match"))
+	if notModified(w, etag, err) {
+		return
+	}
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	data, err := selectFields(courses, view.Fields)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Courses Fetched Successfully", "Success", data, w)
+}
+
+// ChangeCourseStatus godoc
+//
+//	@Summary		Change course status
+//	@Tags			Course
+//	@Description	Move a course through its draft, review, published and archived lifecycle. Sending status "published" with a future publish_at schedules the course for publishing.
+//	@ID				change-course-status
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string						true	"Course ID"
+//	@Param			input			body	dto.UpdateCourseStatusDTO	true	"Target status and optional publish time"
+//	@Param			Authorization	header	string						true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseDTO}	"Successful response with updated course"
 //	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
 //	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not the course owner"
+//	@Failure		404	{object}	response.ResponseError					"Course not found"
+//	@Failure		422	{object}	response.ResponseError					"Status transition not allowed"
 //	@Failure		500	{object}	response.ResponseError					"Internal server error"
-//	@Router			/api/v1/courses [get]
-func (h *Handler) GetPaginatedCourses(w http.ResponseWriter, r *http.Request) {
-	pageStr := requestPkg.GetQueryParam(r, "page")
-	limitStr := requestPkg.GetQueryParam(r, "limit")
+//	@Router			/api/v1/courses/{id}/status [patch]
+func (h *Handler) ChangeCourseStatus(w http.ResponseWriter, r *http.Request) {
+	id := requestPkg.GetURLParam(r, "id")
+	courseID, err := uuid.Parse(id)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.UpdateCourseStatusDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
 
-	page := 1
-	limit := 10
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
 
-	if pageStr != "" {
-		pageInt, err := strconv.Atoi(pageStr)
-		if err == nil {
-			page = pageInt
-		}
+	userID := requestPkg.GetUserID(r)
+
+	course, err := h.service.ChangeStatus(courseID, userID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
 	}
 
-	if limitStr != "" {
-		limitInt, err := strconv.Atoi(limitStr)
-		if err == nil {
-			limit = limitInt
-		}
+	response.BuildResponse(http.StatusOK, "Course Status Updated Successfully", "Success", course, w)
+}
+
+// UpdateCourse godoc
+//
+//	@Summary		Update a course
+//	@Tags			Course
+//	@Description	Update course details, tags, gallery, sections and lessons. Children are matched by ID, entries without an ID are created and entries that are left out are removed. Every update is recorded as a new course revision.
+//	@ID				update-course
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string				true	"Course ID"
+//	@Param			input			body	dto.UpdateCourseDTO	true	"New course content"
+//	@Param			Authorization	header	string				true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseDTO}	"Successful response with updated course"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an instructor of the course"
+//	@Failure		404	{object}	response.ResponseError					"Course not found"
+//	@Failure		409	{object}	response.ResponseError					"The course was changed by another edit in the meantime"
+//	@Failure		422	{object}	response.ResponseError					"Unknown gallery, section, lesson or tag ID, or invalid gallery or lesson media"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/courses/{id} [put]
+func (h *Handler) UpdateCourse(w http.ResponseWriter, r *http.Request) {
+	id := requestPkg.GetURLParam(r, "id")
+	courseID, err := uuid.Parse(id)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.UpdateCourseDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
 	}
 
-	courses, err := h.service.GetPaginatedCourses(limit, page)
+	userID := requestPkg.GetUserID(r)
 
+	course, err := h.service.UpdateCourse(courseID, userID, d)
 	if err != nil {
-		response.RespondError(http.StatusInternalServerError, err, w)
+		response.RespondError(statusCodeFromError(err), err, w)
 		return
 	}
 
-	response.BuildResponse(http.StatusOK, "Courses Fetched Successfully", "Success", courses, w)
+	response.BuildResponse(http.StatusOK, "Course Updated Successfully", "Success", course, w)
+}
+
+// notModified sets the caching headers of a catalogue read and answers 304
+// when the service found the client already has this version. Responses
+// depend on the viewer, so shared caches must not keep them.
+func notModified(w http.ResponseWriter, etag string, err error) bool {
+	if etag == "" {
+		return false
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if !errors.Is(err, service.ErrNotModified) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// parseCourseView reads the comma separated fields and expand query
+// parameters.
+func parseCourseView(r *http.Request) dto.CourseViewDTO {
+	return dto.CourseViewDTO{
+		Fields: splitList(requestPkg.GetQueryParam(r, "fields")),
+		Expand: splitList(requestPkg.GetQueryParam(r, "expand")),
+	}
+}
+
+func splitList(s string) []string {
+	var values []string
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// selectFields keeps only fields of data, a course or a list of them, going
+// through its JSON form so fields are named as clients see them. Data is
+// returned as is when no fields are given.
+func selectFields(data any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return data, nil
+	}
+
+	b, err := jsonpkg.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	err = jsonpkg.Unmarshal(b, &decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := decoded.(type) {
+	case map[string]any:
+		return pickFields(value, fields), nil
+	case []any:
+		for i, item := range value {
+			if object, ok := item.(map[string]any); ok {
+				value[i] = pickFields(object, fields)
+			}
+		}
+		return value, nil
+	default:
+		return decoded, nil
+	}
+}
+
+func pickFields(object map[string]any, fields []string) map[string]any {
+	picked := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if value, ok := object[field]; ok {
+			picked[field] = value
+		}
+	}
+	return picked
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidCourseView):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrCourseNotFound), errors.Is(err, service.ErrRevisionNotFound),
+		errors.Is(err, service.ErrUserNotFound), errors.Is(err, service.ErrInstructorNotFound),
+		errors.Is(err, service.ErrLessonNotFound), errors.Is(err, service.ErrLessonNotStreamable),
+		errors.Is(err, service.ErrTagNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrForbidden), errors.Is(err, service.ErrNotEnrolled):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrLessonVideoNotReady), errors.Is(err, service.ErrTagConflict), errors.Is(err, service.ErrCourseConflict):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrInvalidStatusTransition), errors.Is(err, service.ErrInvalidPublishAt), errors.Is(err, service.ErrUnknownCourseContent),
+		errors.Is(err, service.ErrOwnerChange), errors.Is(err, service.ErrInvalidGalleryMedia), errors.Is(err, service.ErrInvalidLessonMedia),
+		errors.Is(err, service.ErrInvalidTagSlug), errors.Is(err, service.ErrInvalidTagParent), errors.Is(err, service.ErrInvalidTagMerge),
+		errors.Is(err, service.ErrUnknownTag):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
 }
@@ -21,6 +21,8 @@ var mockTags []dto.CourseTagsDTO = []dto.CourseTagsDTO{
 	},
 }
 
+const mockETag = `W/"5d41402abc4b2a76b9719d911017c592"`
+
 var MockCourseDTO dto.CourseDTO = dto.CourseDTO{
 	ID:          uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
 	Name:        "Mock Course",
@@ -97,7 +99,7 @@ var MockCourseDTO dto.CourseDTO = dto.CourseDTO{
 	UpdatedAt: 121212,
 }
 
-var MockArrayCourseDTO []dto.CourseDTO = []dto.CourseDTO{
+var MockArrayCourseSummaryDTO []dto.CourseSummaryDTO = []dto.CourseSummaryDTO{
 	{
 		ID:          uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
 		Name:        "Mock Course",
@@ -135,3 +137,8 @@ var MockArrayCourseDTO []dto.CourseDTO = []dto.CourseDTO{
 		UpdatedAt: 121212,
 	},
 }
+
+var MockArrayExpandedCourseSummaryDTO []dto.ExpandedCourseSummaryDTO = []dto.ExpandedCourseSummaryDTO{
+	{CourseSummaryDTO: MockArrayCourseSummaryDTO[0]},
+	{CourseSummaryDTO: MockArrayCourseSummaryDTO[1]},
+}
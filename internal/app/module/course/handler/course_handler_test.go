@@ -3,7 +3,10 @@ package handler_test
 import (
 	"CodeWithAzri/internal/app/module/course/dto"
 	"CodeWithAzri/internal/app/module/course/handler"
+	"CodeWithAzri/internal/app/module/course/service"
 	"CodeWithAzri/internal/app/module/course/service/mocks"
+	"CodeWithAzri/pkg/requestPkg"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,8 +37,11 @@ func TestHandler_GetCourseDetail(t *testing.T) {
 		monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
 			return "18a95d2f-a941-4a64-bbe5-256be7626db2"
 		})
+		monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+			return "user123"
+		})
 
-		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID")).Return(MockCourseDTO, nil)
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("string"), dto.CourseViewDTO{}, mock.AnythingOfType("string")).Return(MockCourseDTO, mockETag, nil)
 
 		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", nil)
 		assert.NoError(t, err)
@@ -45,6 +51,99 @@ func TestHandler_GetCourseDetail(t *testing.T) {
 		courseHandler.GetCourseDetail(recorder, req)
 
 		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, mockETag, recorder.Header().Get("ETag"))
+		assert.Equal(t, "private, no-cache", recorder.Header().Get("Cache-Control"))
+	})
+}
+
+func TestHandler_GetCourseDetail_View(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	t.Run("Get Course Detail With Fields", func(t *testing.T) {
+		view := dto.CourseViewDTO{Fields: []string{"id", "name", "tags"}, Expand: []string{"reviews"}}
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), "user123", view, mock.AnythingOfType("string")).Return(MockCourseDTO, mockETag, nil).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2?fields=id,%20name,tags&expand=reviews", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseDetail(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var response struct {
+			Data map[string]any `json:"data"`
+		}
+		err = json.Unmarshal(recorder.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 3)
+		assert.Equal(t, MockCourseDTO.Name, response.Data["name"])
+		assert.Contains(t, response.Data, "tags")
+	})
+
+	t.Run("Get Course Detail Invalid View", func(t *testing.T) {
+		view := dto.CourseViewDTO{Expand: []string{"students"}}
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), "user123", view, mock.AnythingOfType("string")).
+			Return(dto.CourseDTO{}, "", fmt.Errorf("%w: unknown expansion \"students\"", service.ErrInvalidCourseView)).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2?expand=students", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseDetail(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_GetCourseDetail_NotModified(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	t.Run("Cached Copy Is Current", func(t *testing.T) {
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), "user123", dto.CourseViewDTO{}, mockETag).Return(dto.CourseDTO{}, mockETag, service.ErrNotModified).Once()
+
+		req, _ := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", nil)
+		req.Header.Set("If-None-Match", mockETag)
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseDetail(recorder, req)
+
+		assert.Equal(t, http.StatusNotModified, recorder.Code)
+		assert.Equal(t, mockETag, recorder.Header().Get("ETag"))
+		assert.Empty(t, recorder.Body.String())
+	})
+
+	t.Run("Cached Copy Is Stale", func(t *testing.T) {
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), "user123", dto.CourseViewDTO{}, `W/"stale"`).Return(MockCourseDTO, mockETag, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", nil)
+		req.Header.Set("If-None-Match", `W/"stale"`)
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseDetail(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, mockETag, recorder.Header().Get("ETag"))
 	})
 }
 
@@ -80,8 +179,11 @@ func TestHandler_GetCourseDetail_InternalServerError(t *testing.T) {
 		monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
 			return "18a95d2f-a941-4a64-bbe5-256be7626db2"
 		})
+		monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+			return "user123"
+		})
 
-		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID")).Return(dto.CourseDTO{}, errors.New("Internal Server Error"))
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("string"), dto.CourseViewDTO{}, mock.AnythingOfType("string")).Return(dto.CourseDTO{}, "", errors.New("Internal Server Error"))
 
 		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", nil)
 		assert.NoError(t, err)
@@ -111,8 +213,11 @@ func TestHandler_GetCourseDetail_NotFound(t *testing.T) {
 		monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
 			return "18a95d2f-a941-4a64-bbe5-256be7626db2"
 		})
+		monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+			return "user123"
+		})
 
-		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID")).Return(dto.CourseDTO{}, nil)
+		mockService.On("GetDetailCourse", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("string"), dto.CourseViewDTO{}, mock.AnythingOfType("string")).Return(dto.CourseDTO{}, "", nil)
 
 		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", nil)
 		assert.NoError(t, err)
@@ -136,7 +241,13 @@ func TestHandler_GetPaginatedCourses(t *testing.T) {
 	courseHandler, mockService := initializeHandler(t)
 
 	t.Run("Get Paginated Courses Successfully", func(t *testing.T) {
-		mockService.On("GetPaginatedCourses", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(MockArrayCourseDTO, nil)
+		defer monkey.UnpatchAll()
+
+		monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+			return "user123"
+		})
+
+		mockService.On("GetPaginatedCourses", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), dto.CourseViewDTO{}, "").Return(MockArrayExpandedCourseSummaryDTO, mockETag, nil).Once()
 
 		req, err := http.NewRequest("GET", "/courses?page=1&limit=10", nil)
 		assert.NoError(t, err)
@@ -146,16 +257,97 @@ func TestHandler_GetPaginatedCourses(t *testing.T) {
 		courseHandler.GetPaginatedCourses(recorder, req)
 
 		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, mockETag, recorder.Header().Get("ETag"))
 
 	})
 
+	t.Run("Get Paginated Courses Not Modified", func(t *testing.T) {
+		defer monkey.UnpatchAll()
+
+		monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+			return "user123"
+		})
+
+		mockService.On("GetPaginatedCourses", 10, 1, "user123", dto.CourseViewDTO{}, mockETag).Return([]dto.ExpandedCourseSummaryDTO{}, mockETag, service.ErrNotModified).Once()
+
+		req, _ := http.NewRequest("GET", "/courses?page=1&limit=10", nil)
+		req.Header.Set("If-None-Match", mockETag)
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetPaginatedCourses(recorder, req)
+
+		assert.Equal(t, http.StatusNotModified, recorder.Code)
+		assert.Equal(t, mockETag, recorder.Header().Get("ETag"))
+		assert.Empty(t, recorder.Body.String())
+	})
+
+}
+
+func TestHandler_GetPaginatedCourses_NonPositivePagination(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	mockService.On("GetPaginatedCourses", 10, 1, "user123", dto.CourseViewDTO{}, mock.AnythingOfType("string")).Return(MockArrayExpandedCourseSummaryDTO, mockETag, nil).Once()
+
+	req, err := http.NewRequest("GET", "/courses?page=0&limit=-5", nil)
+	assert.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+
+	courseHandler.GetPaginatedCourses(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_GetPaginatedCourses_View(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	view := dto.CourseViewDTO{Fields: []string{"id", "name"}}
+	mockService.On("GetPaginatedCourses", 10, 1, "user123", view, mock.AnythingOfType("string")).Return(MockArrayExpandedCourseSummaryDTO, mockETag, nil).Once()
+
+	req, err := http.NewRequest("GET", "/courses?fields=id,name", nil)
+	assert.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+
+	courseHandler.GetPaginatedCourses(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data []map[string]any `json:"data"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Data, len(MockArrayExpandedCourseSummaryDTO))
+	for i, course := range response.Data {
+		assert.Equal(t, map[string]any{"id": MockArrayExpandedCourseSummaryDTO[i].ID.String(), "name": MockArrayExpandedCourseSummaryDTO[i].Name}, course)
+	}
 }
 
 func TestHandler_GetPaginatedCourses_ServiceError(t *testing.T) {
 	courseHandler, mockService := initializeHandler(t)
 
 	t.Run("Get Paginated Courses Successfully", func(t *testing.T) {
-		mockService.On("GetPaginatedCourses", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(MockArrayCourseDTO, fmt.Errorf("Internal Server Error"))
+		defer monkey.UnpatchAll()
+
+		monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+			return "user123"
+		})
+
+		mockService.On("GetPaginatedCourses", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), dto.CourseViewDTO{}, mock.AnythingOfType("string")).Return(MockArrayExpandedCourseSummaryDTO, "", fmt.Errorf("Internal Server Error"))
 
 		req, err := http.NewRequest("GET", "/courses?page=1&limit=10", nil)
 		assert.NoError(t, err)
@@ -169,3 +361,203 @@ func TestHandler_GetPaginatedCourses_ServiceError(t *testing.T) {
 	})
 
 }
+
+func TestHandler_ChangeCourseStatus(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	t.Run("Change Course Status Successfully", func(t *testing.T) {
+		mockService.On("ChangeStatus", mock.AnythingOfType("uuid.UUID"), "user123", mock.AnythingOfType("dto.UpdateCourseStatusDTO")).Return(MockCourseDTO, nil).Once()
+
+		req, err := http.NewRequest("PATCH", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/status", bytes.NewBufferString(`{"status": "published"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.ChangeCourseStatus(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Change Course Status Invalid Status", func(t *testing.T) {
+		req, err := http.NewRequest("PATCH", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/status", bytes.NewBufferString(`{"status": "deleted"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.ChangeCourseStatus(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Change Course Status Invalid Body", func(t *testing.T) {
+		req, err := http.NewRequest("PATCH", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/status", bytes.NewBufferString(`<invalid json>`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.ChangeCourseStatus(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Change Course Status Not Found", service.ErrCourseNotFound, http.StatusNotFound},
+		{"Change Course Status Forbidden", service.ErrForbidden, http.StatusForbidden},
+		{"Change Course Status Invalid Transition", service.ErrInvalidStatusTransition, http.StatusUnprocessableEntity},
+		{"Change Course Status Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("ChangeStatus", mock.AnythingOfType("uuid.UUID"), "user123", mock.AnythingOfType("dto.UpdateCourseStatusDTO")).Return(dto.CourseDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("PATCH", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/status", bytes.NewBufferString(`{"status": "archived"}`))
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.ChangeCourseStatus(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_CreateCourse(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	validBody := `{"name": "New Course", "description": "New", "language": "en", "sections": [{"name": "Section", "lessons": [{"title": "Lesson", "video_url": "https://example.com/video"}]}]}`
+
+	t.Run("Create Course Successfully", func(t *testing.T) {
+		mockService.On("CreateCourse", "user123", mock.AnythingOfType("dto.UpdateCourseDTO")).Return(MockCourseDTO, nil).Once()
+
+		req, err := http.NewRequest("POST", "/courses", bytes.NewBufferString(validBody))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.CreateCourse(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	t.Run("Create Course Missing Name", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/courses", bytes.NewBufferString(`{"description": "New", "language": "en"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.CreateCourse(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Create Course Unknown Content", service.ErrUnknownCourseContent, http.StatusUnprocessableEntity},
+		{"Create Course Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("CreateCourse", "user123", mock.AnythingOfType("dto.UpdateCourseDTO")).Return(dto.CourseDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("POST", "/courses", bytes.NewBufferString(validBody))
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.CreateCourse(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_UpdateCourse(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	validBody := `{"name": "Updated Course", "description": "Updated", "language": "en", "sections": [{"name": "Section", "lessons": [{"title": "Lesson", "video_url": "https://example.com/video"}]}]}`
+
+	t.Run("Update Course Successfully", func(t *testing.T) {
+		mockService.On("UpdateCourse", mock.AnythingOfType("uuid.UUID"), "user123", mock.AnythingOfType("dto.UpdateCourseDTO")).Return(MockCourseDTO, nil).Once()
+
+		req, err := http.NewRequest("PUT", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", bytes.NewBufferString(validBody))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.UpdateCourse(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Update Course Invalid Lesson", func(t *testing.T) {
+		req, err := http.NewRequest("PUT", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", bytes.NewBufferString(`{"name": "Updated Course", "description": "Updated", "language": "en", "sections": [{"name": "Section", "lessons": [{"title": "Lesson", "video_url": "not a url"}]}]}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.UpdateCourse(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Update Course Forbidden", service.ErrForbidden, http.StatusForbidden},
+		{"Update Course Unknown Content", service.ErrUnknownCourseContent, http.StatusUnprocessableEntity},
+		{"Update Course Unknown Tag", service.ErrUnknownTag, http.StatusUnprocessableEntity},
+		{"Update Course Changed Meanwhile", service.ErrCourseConflict, http.StatusConflict},
+		{"Update Course Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("UpdateCourse", mock.AnythingOfType("uuid.UUID"), "user123", mock.AnythingOfType("dto.UpdateCourseDTO")).Return(dto.CourseDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("PUT", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2", bytes.NewBufferString(validBody))
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.UpdateCourse(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// AddCourseInstructor godoc
+//
+//	@Summary		Add a course co-author
+//	@Tags			Course
+//	@Description	Add a registered user as an editor of the course, or change the role of an existing co-author. Only the course owner may manage instructors.
+//	@ID				add-course-instructor
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string						true	"Course ID"
+//	@Param			input			body	dto.AddCourseInstructorDTO	true	"User to add and their role"
+//	@Param			Authorization	header	string						true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.CourseInstructorDTO}	"Successful response with the course instructors"
+//	@Failure		400	{object}	response.ResponseError								"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError								"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError								"Forbidden, not the course owner"
+//	@Failure		404	{object}	response.ResponseError								"Course or user not found"
+//	@Failure		422	{object}	response.ResponseError								"The course owner cannot be demoted"
+//	@Failure		500	{object}	response.ResponseError								"Internal server error"
+//	@Router			/api/v1/courses/{id}/instructors [post]
+func (h *Handler) AddCourseInstructor(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.AddCourseInstructorDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	instructors, err := h.service.AddInstructor(courseID, userID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Instructor Added Successfully", "Success", instructors, w)
+}
+
+// RemoveCourseInstructor godoc
+//
+//	@Summary		Remove a course co-author
+//	@Tags			Course
+//	@Description	Remove an editor from the course. The course owner cannot be removed.
+//	@ID				remove-course-instructor
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			userId			path	string	true	"User ID of the instructor to remove"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not the course owner"
+//	@Failure		404	{object}	response.ResponseError	"Course or instructor not found"
+//	@Failure		422	{object}	response.ResponseError	"The course owner cannot be removed"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/courses/{id}/instructors/{userId} [delete]
+func (h *Handler) RemoveCourseInstructor(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	instructorID := requestPkg.GetURLParam(r, "userId")
+	userID := requestPkg.GetUserID(r)
+
+	err = h.service.RemoveInstructor(courseID, userID, instructorID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Instructor Removed Successfully", "Success", nil, w)
+}
+
+// GetInstructorCourses godoc
+//
+//	@Summary		Get an instructor's courses
+//	@Tags			Course
+//	@Description	Retrieve a paginated list of the courses a user teaches. Unpublished courses are only included for their own instructors.
+//	@ID				get-instructor-courses
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"User ID of the instructor"
+//	@Param			page			query	int		false	"Page number for pagination (default: 1)"
+//	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.CourseSummaryDTO}	"Successful response with the instructor's courses"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/users/{id}/courses [get]
+func (h *Handler) GetInstructorCourses(w http.ResponseWriter, r *http.Request) {
+	instructorID := requestPkg.GetURLParam(r, "id")
+	limit, page := requestPkg.ParsePagination(r)
+	userID := requestPkg.GetUserID(r)
+
+	courses, err := h.service.GetInstructorCourses(instructorID, limit, page, userID)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Instructor Courses Fetched Successfully", "Success", courses, w)
+}
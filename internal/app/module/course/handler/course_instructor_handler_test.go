@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/pkg/requestPkg"
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func patchInstructorRequest() {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		if key == "userId" {
+			return "editor-uid"
+		}
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+}
+
+func TestHandler_AddCourseInstructor(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchInstructorRequest()
+
+	t.Run("Add Course Instructor Successfully", func(t *testing.T) {
+		mockService.On("AddInstructor", mock.AnythingOfType("uuid.UUID"), "user123", dto.AddCourseInstructorDTO{UserID: "editor-uid", Role: "editor"}).Return([]dto.CourseInstructorDTO{{UserID: "editor-uid", Role: "editor"}}, nil).Once()
+
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/instructors", bytes.NewBufferString(`{"user_id": "editor-uid", "role": "editor"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.AddCourseInstructor(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Add Course Instructor Owner Role", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/instructors", bytes.NewBufferString(`{"user_id": "editor-uid", "role": "owner"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.AddCourseInstructor(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Add Course Instructor Unknown User", service.ErrUserNotFound, http.StatusNotFound},
+		{"Add Course Instructor Forbidden", service.ErrForbidden, http.StatusForbidden},
+		{"Add Course Instructor Owner Change", service.ErrOwnerChange, http.StatusUnprocessableEntity},
+		{"Add Course Instructor Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("AddInstructor", mock.AnythingOfType("uuid.UUID"), "user123", mock.AnythingOfType("dto.AddCourseInstructorDTO")).Return(nil, tc.err).Once()
+
+			req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/instructors", bytes.NewBufferString(`{"user_id": "editor-uid", "role": "editor"}`))
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.AddCourseInstructor(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_RemoveCourseInstructor(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchInstructorRequest()
+
+	t.Run("Remove Course Instructor Successfully", func(t *testing.T) {
+		mockService.On("RemoveInstructor", mock.AnythingOfType("uuid.UUID"), "user123", "editor-uid").Return(nil).Once()
+
+		req, err := http.NewRequest("DELETE", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/instructors/editor-uid", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.RemoveCourseInstructor(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Remove Course Instructor Not Found", func(t *testing.T) {
+		mockService.On("RemoveInstructor", mock.AnythingOfType("uuid.UUID"), "user123", "editor-uid").Return(service.ErrInstructorNotFound).Once()
+
+		req, err := http.NewRequest("DELETE", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/instructors/editor-uid", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.RemoveCourseInstructor(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_GetInstructorCourses(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return "instructor-uid"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+
+	t.Run("Get Instructor Courses Successfully", func(t *testing.T) {
+		mockService.On("GetInstructorCourses", "instructor-uid", 5, 2, "user123").Return(MockArrayCourseSummaryDTO, nil).Once()
+
+		req, err := http.NewRequest("GET", "/users/instructor-uid/courses?page=2&limit=5", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetInstructorCourses(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Instructor Courses Service Error", func(t *testing.T) {
+		mockService.On("GetInstructorCourses", "instructor-uid", 10, 1, "user123").Return(nil, errors.New("Internal Server Error")).Once()
+
+		req, err := http.NewRequest("GET", "/users/instructor-uid/courses", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetInstructorCourses(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
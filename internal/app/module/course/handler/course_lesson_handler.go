@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// GetLessonStream godoc
+//
+//	@Summary		Stream a lesson video
+//	@Tags			Course
+//	@Description	Get a short-lived, signed HLS manifest URL for the uploaded video of a lesson. Only instructors and users enrolled in the published course may stream it. Request a new URL once it expires.
+//	@ID				get-lesson-stream
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			lessonId		path	string	true	"Lesson ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.LessonStreamDTO}	"Successful response with the manifest URL"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not enrolled in the course"
+//	@Failure		404	{object}	response.ResponseError						"Course or lesson not found, or the lesson has no uploaded video"
+//	@Failure		409	{object}	response.ResponseError						"The video is still being processed"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/courses/{id}/lessons/{lessonId}/stream [get]
+func (h *Handler) GetLessonStream(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	lessonID, err := uuid.Parse(requestPkg.GetURLParam(r, "lessonId"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	stream, err := h.service.GetLessonStream(courseID, lessonID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Lesson Stream Fetched Successfully", "Success", stream, w)
+}
+
+// CompleteLesson godoc
+//
+//	@Summary		Complete a lesson
+//	@Tags			Course
+//	@Description	Mark a lesson as completed by the signed in user. Only instructors and users enrolled in the published course may complete it. Completing it again keeps the original date.
+//	@ID				complete-lesson
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			lessonId		path	string	true	"Lesson ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.LessonProgressDTO}	"Successful response with the lesson progress"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not enrolled in the course"
+//	@Failure		404	{object}	response.ResponseError							"Course or lesson not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/courses/{id}/lessons/{lessonId}/complete [post]
+func (h *Handler) CompleteLesson(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	lessonID, err := uuid.Parse(requestPkg.GetURLParam(r, "lessonId"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	progress, err := h.service.CompleteLesson(courseID, lessonID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Lesson Completed Successfully", "Success", progress, w)
+}
+
+// GetCourseProgress godoc
+//
+//	@Summary		Get course progress
+//	@Tags			Course
+//	@Description	Count the lessons of a course the signed in user completed. Only instructors and users enrolled in the published course have progress.
+//	@ID				get-course-progress
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseProgressDTO}	"Successful response with the course progress"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not enrolled in the course"
+//	@Failure		404	{object}	response.ResponseError							"Course not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/courses/{id}/progress [get]
+func (h *Handler) GetCourseProgress(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	progress, err := h.service.GetCourseProgress(courseID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Progress Fetched Successfully", "Success", progress, w)
+}
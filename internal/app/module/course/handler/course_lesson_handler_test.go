@@ -0,0 +1,193 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/pkg/requestPkg"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func patchLessonRequest(lessonID string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		if key == "lessonId" {
+			return lessonID
+		}
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+}
+
+func TestHandler_GetLessonStream(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchLessonRequest("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+	courseID := uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2")
+	lessonID := uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+	t.Run("Get Lesson Stream Successfully", func(t *testing.T) {
+		mockService.On("GetLessonStream", courseID, lessonID, "user123").Return(dto.LessonStreamDTO{LessonID: lessonID, ManifestURL: "http://localhost/master.m3u8", ExpiresAt: 1000, Duration: 94}, nil).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/lessons/d60619ae-cee9-4877-8f5d-8b294fe9cd80/stream", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetLessonStream(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "http://localhost/master.m3u8")
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Get Lesson Stream Not Enrolled", service.ErrNotEnrolled, http.StatusForbidden},
+		{"Get Lesson Stream Lesson Not Found", service.ErrLessonNotFound, http.StatusNotFound},
+		{"Get Lesson Stream Not Streamable", service.ErrLessonNotStreamable, http.StatusNotFound},
+		{"Get Lesson Stream Not Ready", service.ErrLessonVideoNotReady, http.StatusConflict},
+		{"Get Lesson Stream Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("GetLessonStream", courseID, lessonID, "user123").Return(dto.LessonStreamDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/lessons/d60619ae-cee9-4877-8f5d-8b294fe9cd80/stream", nil)
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.GetLessonStream(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+
+	t.Run("Get Lesson Stream Invalid Lesson ID", func(t *testing.T) {
+		patchLessonRequest("invalid")
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/lessons/invalid/stream", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetLessonStream(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_CompleteLesson(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchLessonRequest("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+	courseID := uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2")
+	lessonID := uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+	t.Run("Complete Lesson Successfully", func(t *testing.T) {
+		mockService.On("CompleteLesson", courseID, lessonID, "user123").Return(dto.LessonProgressDTO{CourseID: courseID, LessonID: lessonID, UserID: "user123", CompletedAt: 121212}, nil).Once()
+
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/lessons/d60619ae-cee9-4877-8f5d-8b294fe9cd80/complete", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.CompleteLesson(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"completed_at":121212`)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Complete Lesson Not Enrolled", service.ErrNotEnrolled, http.StatusForbidden},
+		{"Complete Lesson Lesson Not Found", service.ErrLessonNotFound, http.StatusNotFound},
+		{"Complete Lesson Internal Server Error", errors.New("Internal Server Error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("CompleteLesson", courseID, lessonID, "user123").Return(dto.LessonProgressDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/lessons/d60619ae-cee9-4877-8f5d-8b294fe9cd80/complete", nil)
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.CompleteLesson(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+
+	t.Run("Complete Lesson Invalid Lesson ID", func(t *testing.T) {
+		patchLessonRequest("invalid")
+
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/lessons/invalid/complete", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.CompleteLesson(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_GetCourseProgress(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchLessonRequest("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+	courseID := uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2")
+
+	t.Run("Get Course Progress Successfully", func(t *testing.T) {
+		mockService.On("GetCourseProgress", courseID, "user123").Return(dto.CourseProgressDTO{
+			CourseID: courseID, Name: "Mock Course", UserID: "user123", CompletedLessons: 2, TotalLessons: 4,
+		}, nil).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/progress", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseProgress(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"completed_lessons":2`)
+		assert.Contains(t, recorder.Body.String(), `"total_lessons":4`)
+	})
+
+	t.Run("Get Course Progress Not Enrolled", func(t *testing.T) {
+		mockService.On("GetCourseProgress", courseID, "user123").Return(dto.CourseProgressDTO{}, service.ErrNotEnrolled).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/progress", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseProgress(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// GetCourseRevisions godoc
+//
+//	@Summary		List course revisions
+//	@Tags			Course
+//	@Description	List the revision history of a course, newest first.
+//	@ID				get-course-revisions
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.CourseRevisionDTO}	"Successful response with course revisions"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an instructor of the course"
+//	@Failure		404	{object}	response.ResponseError							"Course not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/courses/{id}/revisions [get]
+func (h *Handler) GetCourseRevisions(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	revisions, err := h.service.GetRevisions(courseID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Revisions Fetched Successfully", "Success", revisions, w)
+}
+
+// GetCourseRevision godoc
+//
+//	@Summary		Get a course revision
+//	@Tags			Course
+//	@Description	Fetch a single course revision including its content snapshot.
+//	@ID				get-course-revision
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			revision		path	int		true	"Revision number"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseRevisionDTO}	"Successful response with the course revision"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an instructor of the course"
+//	@Failure		404	{object}	response.ResponseError							"Course or revision not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/courses/{id}/revisions/{revision} [get]
+func (h *Handler) GetCourseRevision(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	revision, err := parseRevision(requestPkg.GetURLParam(r, "revision"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	courseRevision, err := h.service.GetRevision(courseID, userID, revision)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Revision Fetched Successfully", "Success", courseRevision, w)
+}
+
+// GetCourseRevisionDiff godoc
+//
+//	@Summary		Diff two course revisions
+//	@Tags			Course
+//	@Description	Compare two revisions of a course and list changed fields as well as added, removed and modified tags, gallery items, sections and lessons.
+//	@ID				get-course-revision-diff
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			from			query	int		true	"Base revision number"
+//	@Param			to				query	int		true	"Target revision number"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseRevisionDiffDTO}	"Successful response with the revision diff"
+//	@Failure		400	{object}	response.ResponseError								"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError								"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError								"Forbidden, not an instructor of the course"
+//	@Failure		404	{object}	response.ResponseError								"Course or revision not found"
+//	@Failure		500	{object}	response.ResponseError								"Internal server error"
+//	@Router			/api/v1/courses/{id}/revisions/diff [get]
+func (h *Handler) GetCourseRevisionDiff(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	from, err := parseRevision(requestPkg.GetQueryParam(r, "from"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	to, err := parseRevision(requestPkg.GetQueryParam(r, "to"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	diff, err := h.service.DiffRevisions(courseID, userID, from, to)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Revision Diff Fetched Successfully", "Success", diff, w)
+}
+
+// RollbackCourse godoc
+//
+//	@Summary		Roll a course back to a revision
+//	@Tags			Course
+//	@Description	Restore the content of a course from a previous revision. The rollback itself is recorded as a new revision and the course status is left unchanged. Tags deleted or merged away since the revision are left out.
+//	@ID				rollback-course
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Course ID"
+//	@Param			revision		path	int		true	"Revision number to restore"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseDTO}	"Successful response with the restored course"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an instructor of the course"
+//	@Failure		404	{object}	response.ResponseError					"Course or revision not found"
+//	@Failure		409	{object}	response.ResponseError					"The course was changed by another edit in the meantime"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/courses/{id}/revisions/{revision}/rollback [post]
+func (h *Handler) RollbackCourse(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	revision, err := parseRevision(requestPkg.GetURLParam(r, "revision"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	course, err := h.service.RollbackCourse(courseID, userID, revision)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Course Rolled Back Successfully", "Success", course, w)
+}
+
+func parseRevision(value string) (int, error) {
+	revision, err := strconv.Atoi(value)
+	if err != nil || revision < 1 {
+		return 0, errors.New("revision must be a positive number")
+	}
+	return revision, nil
+}
@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/pkg/requestPkg"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func patchRevisionRequest(revision string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		if key == "revision" {
+			return revision
+		}
+		return "18a95d2f-a941-4a64-bbe5-256be7626db2"
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+}
+
+func TestHandler_GetCourseRevisions(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchRevisionRequest("")
+
+	t.Run("Get Course Revisions Successfully", func(t *testing.T) {
+		mockService.On("GetRevisions", mock.AnythingOfType("uuid.UUID"), "user123").Return([]dto.CourseRevisionDTO{{Revision: 1}}, nil).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevisions(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Course Revisions Forbidden", func(t *testing.T) {
+		mockService.On("GetRevisions", mock.AnythingOfType("uuid.UUID"), "user123").Return(nil, service.ErrForbidden).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevisions(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestHandler_GetCourseRevision(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Course Revision Successfully", func(t *testing.T) {
+		patchRevisionRequest("2")
+		mockService.On("GetRevision", mock.AnythingOfType("uuid.UUID"), "user123", 2).Return(dto.CourseRevisionDTO{Revision: 2}, nil).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/2", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevision(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Course Revision Not Found", func(t *testing.T) {
+		patchRevisionRequest("9")
+		mockService.On("GetRevision", mock.AnythingOfType("uuid.UUID"), "user123", 9).Return(dto.CourseRevisionDTO{}, service.ErrRevisionNotFound).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/9", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevision(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Course Revision Invalid Number", func(t *testing.T) {
+		patchRevisionRequest("0")
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/0", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevision(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_GetCourseRevisionDiff(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchRevisionRequest("")
+
+	t.Run("Get Course Revision Diff Successfully", func(t *testing.T) {
+		mockService.On("DiffRevisions", mock.AnythingOfType("uuid.UUID"), "user123", 1, 2).Return(dto.CourseRevisionDiffDTO{From: 1, To: 2}, nil).Once()
+
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/diff?from=1&to=2", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevisionDiff(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Course Revision Diff Missing Query", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/diff?from=1", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetCourseRevisionDiff(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_RollbackCourse(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchRevisionRequest("1")
+
+	t.Run("Rollback Course Successfully", func(t *testing.T) {
+		mockService.On("RollbackCourse", mock.AnythingOfType("uuid.UUID"), "user123", 1).Return(MockCourseDTO, nil).Once()
+
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/1/rollback", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.RollbackCourse(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Rollback Course Internal Server Error", func(t *testing.T) {
+		mockService.On("RollbackCourse", mock.AnythingOfType("uuid.UUID"), "user123", 1).Return(dto.CourseDTO{}, errors.New("Internal Server Error")).Once()
+
+		req, err := http.NewRequest("POST", "/courses/18a95d2f-a941-4a64-bbe5-256be7626db2/revisions/1/rollback", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.RollbackCourse(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// GetTags godoc
+//
+//	@Summary		List tags
+//	@Tags			Course
+//	@Description	List every tag by name, with the number of published courses tagged with it. Tags with a parent_id are categorised under that tag.
+//	@ID				get-tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.CourseTagCountDTO}	"Successful response with the tags"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/tags [get]
+func (h *Handler) GetTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.service.GetTags()
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Tags Fetched Successfully", "Success", tags, w)
+}
+
+// GetTag godoc
+//
+//	@Summary		Get a tag
+//	@Tags			Course
+//	@Description	Retrieve a tag by its ID.
+//	@ID				get-tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Tag ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseTagsDTO}	"Successful response with the tag"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		404	{object}	response.ResponseError						"Tag not found"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/tags/{id} [get]
+func (h *Handler) GetTag(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	tag, err := h.service.GetTag(id)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Tag Fetched Successfully", "Success", tag, w)
+}
+
+// CreateTag godoc
+//
+//	@Summary		Create a tag
+//	@Tags			Admin
+//	@Description	Create a tag. The slug is derived from the name when left out, and parent_id puts the tag under another one. Requires the admin claim.
+//	@ID				create-tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.UpsertCourseTagDTO	true	"Name, slug and parent of the tag"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.CourseTagsDTO}	"Successful response with the created tag"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError						"Parent tag not found"
+//	@Failure		409	{object}	response.ResponseError						"Name or slug already taken"
+//	@Failure		422	{object}	response.ResponseError						"Invalid slug"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/admin/tags [post]
+func (h *Handler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var d dto.UpsertCourseTagDTO
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	tag, err := h.service.CreateTag(d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Tag Created Successfully", "Success", tag, w)
+}
+
+// UpdateTag godoc
+//
+//	@Summary		Update a tag
+//	@Tags			Admin
+//	@Description	Replace the name, slug and parent of a tag. A tag cannot be moved under itself or one of its descendants. Requires the admin claim.
+//	@ID				update-tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string					true	"Tag ID"
+//	@Param			input			body	dto.UpsertCourseTagDTO	true	"Name, slug and parent of the tag"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseTagsDTO}	"Successful response with the updated tag"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError						"Tag or parent tag not found"
+//	@Failure		409	{object}	response.ResponseError						"Name or slug already taken"
+//	@Failure		422	{object}	response.ResponseError						"Invalid slug or parent"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/admin/tags/{id} [put]
+func (h *Handler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.UpsertCourseTagDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	tag, err := h.service.UpdateTag(id, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Tag Updated Successfully", "Success", tag, w)
+}
+
+// DeleteTag godoc
+//
+//	@Summary		Delete a tag
+//	@Tags			Admin
+//	@Description	Remove a tag from its courses and delete it. Tags under it move up to its parent. Requires the admin claim.
+//	@ID				delete-tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Tag ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError	"Tag not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/admin/tags/{id} [delete]
+func (h *Handler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.DeleteTag(id)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Tag Deleted Successfully", "Success", nil, w)
+}
+
+// MergeTag godoc
+//
+//	@Summary		Merge a tag into another
+//	@Tags			Admin
+//	@Description	Move the courses and the tags under a tag over to the target tag, then delete it. A tag cannot be merged into itself or one of its descendants. Requires the admin claim.
+//	@ID				merge-tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string					true	"ID of the tag to merge away"
+//	@Param			input			body	dto.MergeCourseTagDTO	true	"Tag to merge into"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CourseTagsDTO}	"Successful response with the target tag"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError						"Tag or target tag not found"
+//	@Failure		422	{object}	response.ResponseError						"Target is the tag or one of its descendants"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/admin/tags/{id}/merge [post]
+func (h *Handler) MergeTag(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.MergeCourseTagDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	tag, err := h.service.MergeTags(id, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Tag Merged Successfully", "Success", tag, w)
+}
@@ -0,0 +1,279 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/service"
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func patchTagRequest(id string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return id
+	})
+}
+
+func TestHandler_GetTags(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Tags Successfully", func(t *testing.T) {
+		mockService.On("GetTags").Return([]dto.CourseTagCountDTO{{CourseTagsDTO: mockTags[0], CourseCount: 2}}, nil).Once()
+
+		req, err := http.NewRequest("GET", "/tags", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetTags(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"course_count":2`)
+	})
+
+	t.Run("Get Tags Service Error", func(t *testing.T) {
+		mockService.On("GetTags").Return(nil, errors.New("Internal Server Error")).Once()
+
+		req, err := http.NewRequest("GET", "/tags", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetTags(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_GetTag(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchTagRequest(mockTags[0].ID.String())
+
+	t.Run("Get Tag Successfully", func(t *testing.T) {
+		mockService.On("GetTag", mockTags[0].ID).Return(mockTags[0], nil).Once()
+
+		req, err := http.NewRequest("GET", "/tags/"+mockTags[0].ID.String(), nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetTag(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Tag Not Found", func(t *testing.T) {
+		mockService.On("GetTag", mockTags[0].ID).Return(dto.CourseTagsDTO{}, service.ErrTagNotFound).Once()
+
+		req, err := http.NewRequest("GET", "/tags/"+mockTags[0].ID.String(), nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetTag(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Tag Invalid ID", func(t *testing.T) {
+		patchTagRequest("invalid")
+
+		req, err := http.NewRequest("GET", "/tags/invalid", nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.GetTag(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_CreateTag(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	t.Run("Create Tag Successfully", func(t *testing.T) {
+		mockService.On("CreateTag", dto.UpsertCourseTagDTO{Name: "Mock Tag"}).Return(mockTags[0], nil).Once()
+
+		req, err := http.NewRequest("POST", "/admin/tags", bytes.NewBufferString(`{"name": "Mock Tag"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.CreateTag(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	t.Run("Create Tag Missing Name", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/admin/tags", bytes.NewBufferString(`{"slug": "mock-tag"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.CreateTag(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Create Tag Conflict", service.ErrTagConflict, http.StatusConflict},
+		{"Create Tag Invalid Slug", service.ErrInvalidTagSlug, http.StatusUnprocessableEntity},
+		{"Create Tag Unknown Parent", service.ErrTagNotFound, http.StatusNotFound},
+	}
+
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService.On("CreateTag", mock.AnythingOfType("dto.UpsertCourseTagDTO")).Return(dto.CourseTagsDTO{}, tc.err).Once()
+
+			req, err := http.NewRequest("POST", "/admin/tags", bytes.NewBufferString(`{"name": "Mock Tag"}`))
+			assert.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			courseHandler.CreateTag(recorder, req)
+
+			assert.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_UpdateTag(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchTagRequest(mockTags[1].ID.String())
+
+	t.Run("Update Tag Successfully", func(t *testing.T) {
+		parentID := mockTags[0].ID
+		input := dto.UpsertCourseTagDTO{Name: "Mock Tag 2", Slug: "mock-tag-2", ParentID: &parentID}
+		mockService.On("UpdateTag", mockTags[1].ID, input).Return(mockTags[1], nil).Once()
+
+		body := `{"name": "Mock Tag 2", "slug": "mock-tag-2", "parent_id": "` + parentID.String() + `"}`
+		req, err := http.NewRequest("PUT", "/admin/tags/"+mockTags[1].ID.String(), bytes.NewBufferString(body))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.UpdateTag(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Update Tag Invalid Parent", func(t *testing.T) {
+		mockService.On("UpdateTag", mockTags[1].ID, mock.AnythingOfType("dto.UpsertCourseTagDTO")).Return(dto.CourseTagsDTO{}, service.ErrInvalidTagParent).Once()
+
+		req, err := http.NewRequest("PUT", "/admin/tags/"+mockTags[1].ID.String(), bytes.NewBufferString(`{"name": "Mock Tag 2"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.UpdateTag(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+
+	t.Run("Update Tag Invalid Body", func(t *testing.T) {
+		req, err := http.NewRequest("PUT", "/admin/tags/"+mockTags[1].ID.String(), bytes.NewBufferString(`{"name": 1}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.UpdateTag(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_DeleteTag(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchTagRequest(mockTags[0].ID.String())
+
+	t.Run("Delete Tag Successfully", func(t *testing.T) {
+		mockService.On("DeleteTag", mockTags[0].ID).Return(nil).Once()
+
+		req, err := http.NewRequest("DELETE", "/admin/tags/"+mockTags[0].ID.String(), nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.DeleteTag(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Delete Tag Not Found", func(t *testing.T) {
+		mockService.On("DeleteTag", mockTags[0].ID).Return(service.ErrTagNotFound).Once()
+
+		req, err := http.NewRequest("DELETE", "/admin/tags/"+mockTags[0].ID.String(), nil)
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.DeleteTag(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_MergeTag(t *testing.T) {
+	courseHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchTagRequest(mockTags[1].ID.String())
+
+	body := `{"target_id": "` + mockTags[0].ID.String() + `"}`
+
+	t.Run("Merge Tag Successfully", func(t *testing.T) {
+		mockService.On("MergeTags", mockTags[1].ID, dto.MergeCourseTagDTO{TargetID: mockTags[0].ID}).Return(mockTags[0], nil).Once()
+
+		req, err := http.NewRequest("POST", "/admin/tags/"+mockTags[1].ID.String()+"/merge", bytes.NewBufferString(body))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.MergeTag(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Merge Tag Into Descendant", func(t *testing.T) {
+		mockService.On("MergeTags", mockTags[1].ID, mock.AnythingOfType("dto.MergeCourseTagDTO")).Return(dto.CourseTagsDTO{}, service.ErrInvalidTagMerge).Once()
+
+		req, err := http.NewRequest("POST", "/admin/tags/"+mockTags[1].ID.String()+"/merge", bytes.NewBufferString(body))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.MergeTag(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+
+	t.Run("Merge Tag Missing Target", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/admin/tags/"+mockTags[1].ID.String()+"/merge", bytes.NewBufferString(`{"target_id": "`+uuid.Nil.String()+`"}`))
+		assert.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+
+		courseHandler.MergeTag(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -18,7 +18,40 @@ func (m CourseMigration) CreateCourseTables(db *sql.DB) error {
 		entity.CourseLesson{},
 		entity.CourseReviews{},
 		entity.CourseTags{},
+		entity.CourseRevision{},
+		entity.CourseInstructor{},
+		entity.CourseEnrollment{},
+		entity.CourseLessonProgress{},
 	)
 
-	return nil
+	// Courses created before course_instructors existed only carry owner_id.
+	backfillOwnersQuery := `
+		INSERT INTO course_instructors (course_id, user_id, role, created_at, updated_at)
+		SELECT id, owner_id, 'owner', created_at, updated_at FROM courses WHERE owner_id <> ''
+		ON CONFLICT DO NOTHING
+	`
+
+	err := migrationDB.Exec(backfillOwnersQuery).Error
+	if err != nil {
+		return err
+	}
+
+	// Tags created before slugs existed get one from their name. Names that
+	// only differ in punctuation or case would share it, so all but the
+	// oldest of them get the start of their ID appended. Names without any
+	// letters or digits fall back to "tag".
+	backfillTagSlugsQuery := `
+		UPDATE course_tags t SET slug = s.slug
+		FROM (
+			SELECT id, base || CASE WHEN ROW_NUMBER() OVER (PARTITION BY base ORDER BY created_at, id) > 1 THEN '-' || LEFT(id::text, 8) ELSE '' END AS slug
+			FROM (
+				SELECT id, created_at, COALESCE(NULLIF(TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')), ''), 'tag') AS base
+				FROM course_tags
+				WHERE slug IS NULL
+			) b
+		) s
+		WHERE t.id = s.id
+	`
+
+	return migrationDB.Exec(backfillTagSlugsQuery).Error
 }
@@ -5,7 +5,10 @@ import (
 	"CodeWithAzri/internal/app/module/course/migration"
 	"CodeWithAzri/internal/app/module/course/repository"
 	"CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/internal/app/module/course/worker"
+	"CodeWithAzri/pkg/cache"
 	"database/sql"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -15,14 +18,16 @@ type Module struct {
 	Service    service.CourseService
 	Repository repository.CourseRepository
 	Migration  *migration.CourseMigration
+	Worker     *worker.PublishWorker
 }
 
-func NewModule(db *sql.DB, validate *validator.Validate) *Module {
+func NewModule(db *sql.DB, validate *validator.Validate, media service.MediaReader, c cache.Cache) *Module {
 	m := new(Module)
 	m.Repository = repository.NewRepository(db)
-	m.Service = service.NewCourseService(m.Repository)
+	m.Service = service.NewCourseService(m.Repository, media, c)
 	m.Handler = handler.NewHandler(m.Service, validate)
 	m.Migration = &migration.CourseMigration{}
+	m.Worker = worker.NewPublishWorker(m.Service, time.Minute)
 
 	return m
 }
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	eventRepository "CodeWithAzri/internal/app/module/event/repository"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Enroll adds userID to a course and returns when they enrolled. Enrolling
+// again keeps the original date, and events are only appended to the outbox
+// for the first enrollment.
+func (r *Repository) Enroll(enrollment entity.CourseEnrollment, events ...eventEntity.Event) (int64, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		INSERT INTO course_enrollments (course_id, user_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (course_id, user_id) DO UPDATE SET created_at = course_enrollments.created_at
+		RETURNING created_at, xmax = 0
+	`
+
+	var createdAt int64
+	var inserted bool
+	err = tx.QueryRow(query, enrollment.CourseID, enrollment.UserID, enrollment.CreatedAt).Scan(&createdAt, &inserted)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enroll in course: %v", err)
+	}
+
+	if inserted {
+		err = eventRepository.Append(tx, events...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return createdAt, nil
+}
+
+func (r *Repository) IsEnrolled(courseID uuid.UUID, userID string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM course_enrollments WHERE course_id = $1 AND user_id = $2)
+	`
+
+	var enrolled bool
+	err := r.db.QueryRow(query, courseID, userID).Scan(&enrolled)
+	if err != nil {
+		return false, fmt.Errorf("failed to read course enrollment: %v", err)
+	}
+
+	return enrolled, nil
+}
+
+func (r *Repository) ReadEnrolledUserIDs(courseID uuid.UUID) ([]string, error) {
+	query := "SELECT user_id FROM course_enrollments WHERE course_id = $1 ORDER BY created_at"
+
+	rows, err := r.db.Query(query, courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read enrolled users: %v", err)
+	}
+	defer rows.Close()
+
+	userIDs := make([]string, 0)
+	for rows.Next() {
+		var userID string
+		err = rows.Scan(&userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan enrolled user: %v", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
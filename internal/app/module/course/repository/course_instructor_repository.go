@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	"fmt"
+
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+
+	"github.com/google/uuid"
+)
+
+func (r *Repository) ReadInstructors(courseIDs []uuid.UUID) ([]entity.CourseInstructor, error) {
+	query := `
+		SELECT ci.course_id, ci.user_id, ci.role, ci.created_at, ci.updated_at,
+			COALESCE(u.name, ''), COALESCE(u.profile_picture, '')
+		FROM course_instructors ci
+			LEFT JOIN users u ON u.id = ci.user_id
+		WHERE ci.course_id = ANY($1::uuid[])
+		ORDER BY ci.created_at, ci.user_id
+	`
+
+	rows, err := r.db.Query(query, uuidArray(courseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course instructors: %v", err)
+	}
+	defer rows.Close()
+
+	instructors := make([]entity.CourseInstructor, 0)
+	for rows.Next() {
+		var instructor entity.CourseInstructor
+		err := rows.Scan(&instructor.CourseID, &instructor.UserID, &instructor.Role, &instructor.CreatedAt, &instructor.UpdatedAt,
+			&instructor.Profile.Name, &instructor.Profile.ProfilePicture,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course instructor: %v", err)
+		}
+		instructor.Profile.ID = instructor.UserID
+		instructors = append(instructors, instructor)
+	}
+
+	return instructors, nil
+}
+
+// AddInstructor links an existing user to a course, or changes their role if
+// they are already linked. It reports how many rows were written so callers
+// can tell an unknown user apart from a successful insert.
+func (r *Repository) AddInstructor(instructor entity.CourseInstructor) (int64, error) {
+	query := `
+		INSERT INTO course_instructors (course_id, user_id, role, created_at, updated_at)
+		SELECT $1, u.id, $3, $4, $5 FROM users u WHERE u.id = $2
+		ON CONFLICT (course_id, user_id) DO UPDATE SET role = $3, updated_at = $5
+	`
+	result, err := r.db.Exec(query, instructor.CourseID, instructor.UserID, instructor.Role, instructor.CreatedAt, instructor.UpdatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add course instructor: %v", err)
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *Repository) RemoveInstructor(courseID uuid.UUID, userID string) error {
+	query := `
+		DELETE FROM course_instructors
+		WHERE course_id = $1 AND user_id = $2
+	`
+	_, err := r.db.Exec(query, courseID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove course instructor: %v", err)
+	}
+
+	return nil
+}
+
+// ReadManyByInstructor pages over the courses instructorID teaches that
+// viewerID may see, newest first, with the content selected by relations.
+func (r *Repository) ReadManyByInstructor(instructorID string, limit, offset int, viewerID string, relations Relations) ([]entity.Course, error) {
+	coursesQuery := `
+		SELECT c.id, c.name, c.description, c.language, c.status, c.publish_at, c.owner_id, c.created_at, c.updated_at
+		FROM courses c
+		WHERE EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.user_id = $1)
+			AND (c.status = $2 OR EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.user_id = $3))
+		ORDER BY c.created_at DESC, c.id
+		LIMIT $4 OFFSET $5
+	`
+
+	return r.readCoursePage(relations, coursesQuery, instructorID, course_status_enum.Published, viewerID, limit, offset)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	eventRepository "CodeWithAzri/internal/app/module/event/repository"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// UpdateLessonDuration records the duration in seconds of an uploaded video
+// on every lesson that uses it.
+func (r *Repository) UpdateLessonDuration(mediaID uuid.UUID, duration int, updatedAt int64) error {
+	query := `
+		UPDATE course_lessons
+		SET duration = $1, updated_at = $2
+		WHERE media_id = $3
+	`
+	_, err := r.db.Exec(query, duration, updatedAt, mediaID)
+	if err != nil {
+		return fmt.Errorf("failed to update lesson duration: %v", err)
+	}
+
+	return nil
+}
+
+// CompleteLesson records that a user completed a lesson and returns when they
+// first did. Events are only appended to the outbox for the first completion.
+func (r *Repository) CompleteLesson(progress entity.CourseLessonProgress, events ...eventEntity.Event) (int64, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		INSERT INTO course_lesson_progress (lesson_id, user_id, course_id, completed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (lesson_id, user_id) DO UPDATE SET completed_at = course_lesson_progress.completed_at
+		RETURNING completed_at, xmax = 0
+	`
+
+	var completedAt int64
+	var inserted bool
+	err = tx.QueryRow(query, progress.LessonID, progress.UserID, progress.CourseID, progress.CompletedAt).Scan(&completedAt, &inserted)
+	if err != nil {
+		return 0, fmt.Errorf("failed to complete lesson: %v", err)
+	}
+
+	if inserted {
+		err = eventRepository.Append(tx, events...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return completedAt, nil
+}
+
+func (r *Repository) ReadCompletedLessons(courseID uuid.UUID, userID string) ([]uuid.UUID, error) {
+	query := "SELECT lesson_id FROM course_lesson_progress WHERE course_id = $1 AND user_id = $2"
+
+	rows, err := r.db.Query(query, courseID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read completed lessons: %v", err)
+	}
+	defer rows.Close()
+
+	lessonIDs := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var lessonID uuid.UUID
+		err = rows.Scan(&lessonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan completed lesson: %v", err)
+		}
+		lessonIDs = append(lessonIDs, lessonID)
+	}
+
+	return lessonIDs, nil
+}
+
+// ReadLessonCourseID returns the course a lesson belongs to, or uuid.Nil when
+// there is no such lesson.
+func (r *Repository) ReadLessonCourseID(lessonID uuid.UUID) (uuid.UUID, error) {
+	query := "SELECT course_id FROM course_lessons WHERE id = $1"
+
+	var courseID uuid.UUID
+	err := r.db.QueryRow(query, lessonID).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to read lesson course: %v", err)
+	}
+
+	return courseID, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	"fmt"
+)
+
+func (r *Repository) ReadUserEnrollments(userID string) ([]entity.CourseEnrollment, error) {
+	query := "SELECT course_id, user_id, created_at FROM course_enrollments WHERE user_id = $1 ORDER BY created_at"
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read enrollments: %v", err)
+	}
+	defer rows.Close()
+
+	enrollments := make([]entity.CourseEnrollment, 0)
+	for rows.Next() {
+		var enrollment entity.CourseEnrollment
+		err = rows.Scan(&enrollment.CourseID, &enrollment.UserID, &enrollment.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan enrollment: %v", err)
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+
+	return enrollments, nil
+}
+
+func (r *Repository) ReadUserProgress(userID string) ([]entity.CourseLessonProgress, error) {
+	query := "SELECT lesson_id, user_id, course_id, completed_at FROM course_lesson_progress WHERE user_id = $1 ORDER BY completed_at"
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lesson progress: %v", err)
+	}
+	defer rows.Close()
+
+	progress := make([]entity.CourseLessonProgress, 0)
+	for rows.Next() {
+		var p entity.CourseLessonProgress
+		err = rows.Scan(&p.LessonID, &p.UserID, &p.CourseID, &p.CompletedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lesson progress: %v", err)
+		}
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+func (r *Repository) ReadUserReviews(userID string) ([]entity.CourseReviews, error) {
+	query := `
+		SELECT id, course_id, user_id, value, comment, hidden_at, created_at, updated_at
+		FROM course_reviews WHERE user_id::text = $1 ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reviews: %v", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]entity.CourseReviews, 0)
+	for rows.Next() {
+		var review entity.CourseReviews
+		err = rows.Scan(&review.ID, &review.CourseID, &review.UserID, &review.Value, &review.Comment,
+			&review.HiddenAt, &review.CreatedAt, &review.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review: %v", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, nil
+}
+
+// EraseUserData deletes the enrollments and progress of a user and detaches
+// their reviews from them. Courses they teach are left to their co-instructors.
+func (r *Repository) EraseUserData(userID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM course_lesson_progress WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to erase lesson progress: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM course_enrollments WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to erase enrollments: %v", err)
+	}
+
+	_, err = tx.Exec("UPDATE course_reviews SET user_id = NULL WHERE user_id::text = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to anonymise reviews: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
@@ -2,32 +2,86 @@ package repository
 
 import (
 	"CodeWithAzri/internal/app/module/course/entity"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	eventRepository "CodeWithAzri/internal/app/module/event/repository"
+	"context"
 	"database/sql"
 	"fmt"
 
-	language_enum "CodeWithAzri/pkg/enums/language"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type CourseRepository interface {
-	Create(e entity.Course) error
-	ReadMany(limit, offset int) ([]entity.Course, error)
+	Create(e entity.Course, events ...eventEntity.Event) error
+	ReadMany(limit, offset int, viewerID string, relations Relations) ([]entity.Course, error)
 	ReadOne(id uuid.UUID) (entity.Course, error)
-	Update(id uuid.UUID, e entity.Course) error
+	ReadOneWith(id uuid.UUID, relations Relations) (entity.Course, error)
+	Update(id uuid.UUID, readAt int64, e entity.Course, baseline entity.CourseRevision, revision entity.CourseRevision, events ...eventEntity.Event) (int64, error)
+	UpdateStatus(id uuid.UUID, status course_status_enum.CourseStatus, publishAt *int64, updatedAt int64) error
+	PublishDue(now int64) (int64, error)
 	Delete(id uuid.UUID) error
+	ReadRevisions(courseID uuid.UUID) ([]entity.CourseRevision, error)
+	ReadRevision(courseID uuid.UUID, revision int) (entity.CourseRevision, error)
+	ReadInstructors(courseIDs []uuid.UUID) ([]entity.CourseInstructor, error)
+	AddInstructor(instructor entity.CourseInstructor) (int64, error)
+	RemoveInstructor(courseID uuid.UUID, userID string) error
+	ReadManyByInstructor(instructorID string, limit, offset int, viewerID string, relations Relations) ([]entity.Course, error)
+	Enroll(enrollment entity.CourseEnrollment, events ...eventEntity.Event) (int64, error)
+	IsEnrolled(courseID uuid.UUID, userID string) (bool, error)
+	ReadEnrolledUserIDs(courseID uuid.UUID) ([]string, error)
+	UpdateLessonDuration(mediaID uuid.UUID, duration int, updatedAt int64) error
+	CompleteLesson(progress entity.CourseLessonProgress, events ...eventEntity.Event) (int64, error)
+	ReadCompletedLessons(courseID uuid.UUID, userID string) ([]uuid.UUID, error)
+	ReadLessonCourseID(lessonID uuid.UUID) (uuid.UUID, error)
+	ReadUserEnrollments(userID string) ([]entity.CourseEnrollment, error)
+	ReadUserProgress(userID string) ([]entity.CourseLessonProgress, error)
+	ReadUserReviews(userID string) ([]entity.CourseReviews, error)
+	EraseUserData(userID string) error
+	ReadTags() ([]entity.CourseTagCount, error)
+	ReadTag(id uuid.UUID) (entity.CourseTags, error)
+	ReadTagsByIDs(ids []uuid.UUID) ([]entity.CourseTags, error)
+	ReadTagAncestorIDs(id uuid.UUID) ([]uuid.UUID, error)
+	CreateTag(tag entity.CourseTags) (int64, error)
+	UpdateTag(tag entity.CourseTags) (int64, error)
+	DeleteTag(id uuid.UUID) error
+	MergeTags(sourceID uuid.UUID, targetID uuid.UUID) error
 }
 
 type Repository struct {
 	db *sql.DB
 }
 
+// Relations selects the content read along with courses. Lessons are read
+// into their sections, so they are only read together with Sections.
+type Relations struct {
+	Tags     bool
+	Gallery  bool
+	Sections bool
+	Lessons  bool
+	Reviews  bool
+}
+
+// ContentRelations is the content a course is edited with.
+var ContentRelations = Relations{Tags: true, Gallery: true, Sections: true, Lessons: true}
+
+// querier is what the batched loaders need, so they run on the database or
+// inside a transaction alike.
+type querier interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
 func NewRepository(db *sql.DB) *Repository {
 	r := &Repository{db: db}
 	return r
 }
 
-func (r *Repository) Create(course entity.Course) error {
+// Create stores a course with its content and appends events to the outbox
+// in the same transaction.
+func (r *Repository) Create(course entity.Course, events ...eventEntity.Event) error {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %v", err)
@@ -39,14 +93,25 @@ func (r *Repository) Create(course entity.Course) error {
 	}()
 
 	courseQuery := `
-		INSERT INTO courses (id, name, description, language, created_at, updated_at)  
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at)  
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
-	_, err = tx.Exec(courseQuery, course.ID, course.Name, course.Description, course.Language, course.CreatedAt, course.UpdatedAt)
+	_, err = tx.Exec(courseQuery, course.ID, course.Name, course.Description, course.Language, course.Status, course.PublishAt, course.OwnerID, course.CreatedAt, course.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create course: %v", err)
 	}
 
+	if course.OwnerID != "" {
+		ownerQuery := `
+			INSERT INTO course_instructors (course_id, user_id, role, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`
+		_, err = tx.Exec(ownerQuery, course.ID, course.OwnerID, instructor_role_enum.Owner, course.CreatedAt, course.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to add course owner: %v", err)
+		}
+	}
+
 	for _, tag := range course.CourseTags {
 		linkQuery := `
 			INSERT INTO course_tags_courses (course_id, course_tags_id) 
@@ -60,10 +125,10 @@ func (r *Repository) Create(course entity.Course) error {
 
 	for _, galleryItem := range course.Gallery {
 		galleryQuery := `
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at)  
-			VALUES ($1, $2, $3, $4, $5)
+			INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at)  
+			VALUES ($1, $2, $3, $4, $5, $6)
 		`
-		_, err = tx.Exec(galleryQuery, galleryItem.ID, course.ID, galleryItem.URL, galleryItem.CreatedAt, galleryItem.UpdatedAt)
+		_, err = tx.Exec(galleryQuery, galleryItem.ID, course.ID, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt)
 		if err != nil {
 			return fmt.Errorf("failed to create gallery item: %v", err)
 		}
@@ -71,26 +136,31 @@ func (r *Repository) Create(course entity.Course) error {
 
 	for _, section := range course.Sections {
 		sectionQuery := `
-			INSERT INTO course_sections (id, course_id, name, created_at, updated_at)   
-			VALUES ($1, $2, $3, $4, $5)
+			INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
 		`
-		_, err = tx.Exec(sectionQuery, section.ID, course.ID, section.Name, section.CreatedAt, section.UpdatedAt)
+		_, err = tx.Exec(sectionQuery, section.ID, course.ID, section.Name, section.Position, section.CreatedAt, section.UpdatedAt)
 		if err != nil {
 			return fmt.Errorf("failed to create section: %v", err)
 		}
 
 		for _, lesson := range section.Lessons {
 			lessonQuery := `
-				INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) 
-				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 			`
-			_, err = tx.Exec(lessonQuery, lesson.ID, course.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.CreatedAt, lesson.UpdatedAt)
+			_, err = tx.Exec(lessonQuery, lesson.ID, course.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.MediaID, lesson.Duration, lesson.Position, lesson.CreatedAt, lesson.UpdatedAt)
 			if err != nil {
 				return fmt.Errorf("failed to create lesson: %v", err)
 			}
 		}
 	}
 
+	err = eventRepository.Append(tx, events...)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return fmt.Errorf("failed to commit transaction: %v", err)
@@ -99,72 +169,68 @@ func (r *Repository) Create(course entity.Course) error {
 	return nil
 }
 
+// ReadOne loads a course with its tags, gallery and sections.
 func (r *Repository) ReadOne(id uuid.UUID) (entity.Course, error) {
+	return r.ReadOneWith(id, ContentRelations)
+}
+
+// ReadOneWith loads a course with the content selected by relations. Each
+// relation has its own query, so the rows read grow with the content of the
+// course instead of with the product of its tags, gallery items and lessons.
+// The queries share a snapshot, like the single query they replace.
+func (r *Repository) ReadOneWith(id uuid.UUID, relations Relations) (entity.Course, error) {
+	tx, err := r.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return entity.Course{}, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
 	courseQuery := `
-    SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at,
-           t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at,
-           g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at,
-           s.id AS section_id, s.name AS section_name, s.course_id AS section_course_id, s.created_at, s.updated_at,
-           l.id AS lesson_id, l.title AS lesson_title, l.video_url AS lesson_video_url, l.course_id AS lesson_course_id, l.course_section_id AS lesson_section_id, l.created_at, l.updated_at
-    FROM courses c
-		LEFT JOIN course_tags_courses tc ON c.id = tc.course_id
-		LEFT JOIN course_tags t ON tc.course_tags_id = t.id
-		LEFT JOIN course_galleries g ON c.id = g.course_id
-		LEFT JOIN course_sections s ON c.id = s.course_id
-		LEFT JOIN course_lessons l ON s.id = l.course_section_id
-    WHERE c.id = $1
-`
-
-	rows, err := r.db.Query(courseQuery, id)
+		SELECT id, name, description, language, status, publish_at, owner_id, created_at, updated_at
+		FROM courses
+		WHERE id = $1
+	`
+
+	course, err := scanCourse(tx.QueryRow(courseQuery, id))
+	if err == sql.ErrNoRows {
+		return entity.Course{}, nil
+	}
 	if err != nil {
 		return entity.Course{}, fmt.Errorf("failed to read course: %v", err)
 	}
-	defer rows.Close()
 
-	course, err := scanReadOne(rows)
+	courses := []entity.Course{course}
+	err = readRelations(tx, courses, relations)
 	if err != nil {
-		return entity.Course{}, fmt.Errorf("failed to scan course: %v", err)
+		return entity.Course{}, err
 	}
 
-	return course, nil
+	return courses[0], nil
 }
 
-func (r *Repository) ReadMany(limit, offset int) ([]entity.Course, error) {
-
+// ReadMany pages over the courses viewerID may see, newest first, with the
+// content selected by relations.
+func (r *Repository) ReadMany(limit, offset int, viewerID string, relations Relations) ([]entity.Course, error) {
 	coursesQuery := `
-		SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at,
-			t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at,
-			g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at
+		SELECT c.id, c.name, c.description, c.language, c.status, c.publish_at, c.owner_id, c.created_at, c.updated_at
 		FROM courses c
-			LEFT JOIN course_tags_courses tc ON c.id = tc.course_id
-			LEFT JOIN course_tags t ON tc.course_tags_id = t.id
-			LEFT JOIN course_galleries g ON c.id = g.course_id
-		LIMIT $1 OFFSET $2
+		WHERE c.status = $1 OR EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.user_id = $2)
+		ORDER BY c.created_at DESC, c.id
+		LIMIT $3 OFFSET $4
 	`
 
-	rows, err := r.db.Query(coursesQuery, limit, offset)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read courses: %v", err)
-	}
-	defer rows.Close()
-
-	coursesMap, err := scanReadMany(rows)
-	if err != nil {
-		return nil, fmt.Errorf("failed to scan courses: %v", err)
-	}
-
-	var courses []entity.Course
-	for _, course := range coursesMap {
-		courses = append(courses, *course)
-	}
-
-	return courses, nil
+	return r.readCoursePage(relations, coursesQuery, course_status_enum.Published, viewerID, limit, offset)
 }
 
-func (r *Repository) Update(id uuid.UUID, updatedCourse entity.Course) error {
+// Update replaces the content of a course, records its revision and appends
+// events to the outbox in the same transaction. The course row stays locked
+// until then, and nothing is written when it was changed since it was read
+// at readAt: no rows are affected and the caller has to read it again.
+// baseline is only recorded when the course has no revisions yet.
+func (r *Repository) Update(id uuid.UUID, readAt int64, updatedCourse entity.Course, baseline entity.CourseRevision, revision entity.CourseRevision, events ...eventEntity.Event) (int64, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %v", err)
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
 	}
 	defer func() {
 		if err != nil {
@@ -172,80 +238,183 @@ func (r *Repository) Update(id uuid.UUID, updatedCourse entity.Course) error {
 		}
 	}()
 
+	var updatedAt int64
+	err = tx.QueryRow("SELECT updated_at FROM courses WHERE id = $1 FOR UPDATE", id).Scan(&updatedAt)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock course: %v", err)
+	}
+	if updatedAt != readAt {
+		tx.Rollback()
+		return 0, nil
+	}
+
 	courseUpdateQuery := `
 		UPDATE courses 
-		SET name = $1, description = $2 , language = $3, updated_at = $4
+		SET name = $1, description = $2, language = $3, updated_at = $4
 		WHERE id = $5
 	`
-	_, err = tx.Exec(courseUpdateQuery, updatedCourse.Name, updatedCourse.Description, updatedCourse.UpdatedAt, updatedCourse.Language, id)
+	_, err = tx.Exec(courseUpdateQuery, updatedCourse.Name, updatedCourse.Description, updatedCourse.Language, updatedCourse.UpdatedAt, id)
 	if err != nil {
-		return fmt.Errorf("failed to update course details: %v", err)
+		return 0, fmt.Errorf("failed to update course details: %v", err)
+	}
+
+	tagIDs := make([]uuid.UUID, 0, len(updatedCourse.CourseTags))
+	for _, tag := range updatedCourse.CourseTags {
+		tagIDs = append(tagIDs, tag.ID)
 	}
 
 	deleteTagsQuery := `
 		DELETE FROM course_tags_courses
-		WHERE course_id = $1
+		WHERE course_id = $1 AND NOT (course_tags_id = ANY($2::uuid[]))
 	`
-	_, err = tx.Exec(deleteTagsQuery, id)
+	_, err = tx.Exec(deleteTagsQuery, id, uuidArray(tagIDs))
 	if err != nil {
-		return fmt.Errorf("failed to delete existing tags for the course: %v", err)
+		return 0, fmt.Errorf("failed to unlink removed tags from the course: %v", err)
 	}
 
 	for _, tag := range updatedCourse.CourseTags {
 		linkQuery := `
 			INSERT INTO course_tags_courses (course_id, course_tags_id) 
 			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
 		`
 		_, err = tx.Exec(linkQuery, id, tag.ID)
 		if err != nil {
-			return fmt.Errorf("failed to link course to tag: %v", err)
+			return 0, fmt.Errorf("failed to link course to tag: %v", err)
 		}
 	}
 
+	galleryIDs := make([]uuid.UUID, 0, len(updatedCourse.Gallery))
+	for _, galleryItem := range updatedCourse.Gallery {
+		galleryIDs = append(galleryIDs, galleryItem.ID)
+	}
+
+	deleteGalleryQuery := `
+		DELETE FROM course_galleries
+		WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))
+	`
+	_, err = tx.Exec(deleteGalleryQuery, id, uuidArray(galleryIDs))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete removed gallery items: %v", err)
+	}
+
 	for _, galleryItem := range updatedCourse.Gallery {
 		galleryQuery := `
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) 
-			VALUES ($1, $2, $3, $4, $5)
-			ON CONFLICT (id) DO UPDATE SET url = $3, updated_at = $5
+			INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) 
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO UPDATE SET url = $3, media_id = $4, updated_at = $6
 		`
-		_, err = tx.Exec(galleryQuery, galleryItem.ID, id, galleryItem.URL, galleryItem.CreatedAt, galleryItem.UpdatedAt)
+		_, err = tx.Exec(galleryQuery, galleryItem.ID, id, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt)
 		if err != nil {
-			return fmt.Errorf("failed to update or insert gallery item: %v", err)
+			return 0, fmt.Errorf("failed to update or insert gallery item: %v", err)
 		}
 	}
 
+	sectionIDs := make([]uuid.UUID, 0, len(updatedCourse.Sections))
+	lessonIDs := make([]uuid.UUID, 0)
+	for _, section := range updatedCourse.Sections {
+		sectionIDs = append(sectionIDs, section.ID)
+		for _, lesson := range section.Lessons {
+			lessonIDs = append(lessonIDs, lesson.ID)
+		}
+	}
+
+	deleteLessonsQuery := `
+		DELETE FROM course_lessons
+		WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))
+	`
+	_, err = tx.Exec(deleteLessonsQuery, id, uuidArray(lessonIDs))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete removed lessons: %v", err)
+	}
+
+	deleteSectionsQuery := `
+		DELETE FROM course_sections
+		WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))
+	`
+	_, err = tx.Exec(deleteSectionsQuery, id, uuidArray(sectionIDs))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete removed sections: %v", err)
+	}
+
 	for _, section := range updatedCourse.Sections {
 		sectionQuery := `
-			INSERT INTO course_sections (id, course_id, name, created_at, updated_at) 
-			VALUES ($1, $2, $3, $4, $5)
-			ON CONFLICT (id) DO UPDATE SET name = $3, updated_at = $5
+			INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO UPDATE SET name = $3, position = $4, updated_at = $6
 		`
-		_, err = tx.Exec(sectionQuery, section.ID, id, section.Name, section.CreatedAt, section.UpdatedAt)
+		_, err = tx.Exec(sectionQuery, section.ID, id, section.Name, section.Position, section.CreatedAt, section.UpdatedAt)
 		if err != nil {
-			return fmt.Errorf("failed to update or insert section: %v", err)
+			return 0, fmt.Errorf("failed to update or insert section: %v", err)
 		}
 
 		for _, lesson := range section.Lessons {
 			lessonQuery := `
-				INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) 
-				VALUES ($1, $2, $3, $4, $5, $6, $7)
-				ON CONFLICT (id) DO UPDATE SET title = $4, video_url = $5, updated_at = $7
+				INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+				ON CONFLICT (id) DO UPDATE SET course_section_id = $3, title = $4, video_url = $5, media_id = $6, duration = $7, position = $8, updated_at = $10
 			`
-			_, err = tx.Exec(lessonQuery, lesson.ID, id, section.ID, lesson.Title, lesson.VideoURL, lesson.CreatedAt, lesson.UpdatedAt)
+			_, err = tx.Exec(lessonQuery, lesson.ID, id, section.ID, lesson.Title, lesson.VideoURL, lesson.MediaID, lesson.Duration, lesson.Position, lesson.CreatedAt, lesson.UpdatedAt)
 			if err != nil {
-				return fmt.Errorf("failed to update or insert lesson: %v", err)
+				return 0, fmt.Errorf("failed to update or insert lesson: %v", err)
 			}
 		}
 	}
 
+	_, err = tx.Exec(createBaselineRevisionQuery, baseline.ID, id, baseline.Message, baseline.Snapshot, baseline.CreatedBy, baseline.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create baseline course revision: %v", err)
+	}
+
+	_, err = tx.Exec(createRevisionQuery, revision.ID, id, revision.Message, revision.Snapshot, revision.CreatedBy, revision.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create course revision: %v", err)
+	}
+
+	err = eventRepository.Append(tx, events...)
+	if err != nil {
+		return 0, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
-		return fmt.Errorf("failed to commit transaction: %v", err)
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return 1, nil
+}
+
+func (r *Repository) UpdateStatus(id uuid.UUID, status course_status_enum.CourseStatus, publishAt *int64, updatedAt int64) error {
+	query := `
+		UPDATE courses
+		SET status = $1, publish_at = $2, updated_at = $3
+		WHERE id = $4
+	`
+	_, err := r.db.Exec(query, status, publishAt, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update course status: %v", err)
 	}
 
 	return nil
 }
 
+func (r *Repository) PublishDue(now int64) (int64, error) {
+	query := `
+		UPDATE courses
+		SET status = $1, publish_at = NULL, updated_at = $2
+		WHERE status = $3 AND publish_at IS NOT NULL AND publish_at <= $2
+	`
+	result, err := r.db.Exec(query, course_status_enum.Published, now, course_status_enum.Review)
+	if err != nil {
+		return 0, fmt.Errorf("failed to publish scheduled courses: %v", err)
+	}
+
+	return result.RowsAffected()
+}
+
 func (r *Repository) Delete(id uuid.UUID) error {
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -302,6 +471,24 @@ func (r *Repository) Delete(id uuid.UUID) error {
 		return fmt.Errorf("failed to delete CourseSections: %v", err)
 	}
 
+	deleteEnrollmentsQuery := `
+		DELETE FROM course_enrollments
+		WHERE course_id = $1
+	`
+	_, err = tx.Exec(deleteEnrollmentsQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete CourseEnrollments: %v", err)
+	}
+
+	deleteInstructorsQuery := `
+		DELETE FROM course_instructors
+		WHERE course_id = $1
+	`
+	_, err = tx.Exec(deleteInstructorsQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete CourseInstructors: %v", err)
+	}
+
 	deleteCourseQuery := `
 		DELETE FROM courses
 		WHERE id = $1
@@ -319,157 +506,290 @@ func (r *Repository) Delete(id uuid.UUID) error {
 	return nil
 }
 
-func scanReadOne(rows *sql.Rows) (entity.Course, error) {
-	var course entity.Course
+// readCourseTags loads the tags of every course in one query, keyed by
+// course.
+func readCourseTags(q querier, courseIDs []uuid.UUID) (map[uuid.UUID][]entity.CourseTags, error) {
+	query := `
+		SELECT tc.course_id, t.id, t.name, t.slug, t.parent_id, t.created_at, t.updated_at
+		FROM course_tags_courses tc
+			JOIN course_tags t ON t.id = tc.course_tags_id
+		WHERE tc.course_id = ANY($1::uuid[])
+		ORDER BY t.name
+	`
 
-	var currentCourseID uuid.UUID
-	var (
-		tagMap     = make(map[uuid.UUID]struct{})
-		galleryMap = make(map[uuid.UUID]struct{})
-		sectionMap = make(map[uuid.UUID]struct{})
-	)
+	rows, err := q.Query(query, uuidArray(courseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course tags: %v", err)
+	}
+	defer rows.Close()
 
+	tags := make(map[uuid.UUID][]entity.CourseTags)
 	for rows.Next() {
+		var courseID uuid.UUID
 		var tag entity.CourseTags
-		var gallery entity.CourseGallery
-		var section entity.CourseSection
-		var lesson entity.CourseLesson
-
-		err := rows.Scan(&course.ID, &course.Name, &course.Description, &course.Language, &course.CreatedAt, &course.UpdatedAt,
-			&tag.ID, &tag.Name, &tag.CreatedAt, &tag.UpdatedAt,
-			&gallery.ID, &gallery.URL, &gallery.CourseID, &gallery.CreatedAt, &gallery.UpdatedAt,
-			&section.ID, &section.Name, &section.CourseID, &section.CreatedAt, &section.UpdatedAt,
-			&lesson.ID, &lesson.Title, &lesson.VideoURL, &lesson.CourseID, &lesson.CourseSectionID, &lesson.CreatedAt, &lesson.UpdatedAt,
-		)
+		err := rows.Scan(&courseID, &tag.ID, &tag.Name, &tag.Slug, &tag.ParentID, &tag.CreatedAt, &tag.UpdatedAt)
 		if err != nil {
-			return entity.Course{}, err
+			return nil, fmt.Errorf("failed to scan course tag: %v", err)
 		}
+		tags[courseID] = append(tags[courseID], tag)
+	}
 
-		if currentCourseID != course.ID {
-			currentCourseID = course.ID
-			course.CourseTags = nil
-			course.Gallery = nil
-			course.Sections = nil
-			tagMap = make(map[uuid.UUID]struct{})
-			galleryMap = make(map[uuid.UUID]struct{})
-			sectionMap = make(map[uuid.UUID]struct{})
-		}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read course tags: %v", err)
+	}
 
-		if tag.ID != uuid.Nil {
-			if _, ok := tagMap[tag.ID]; !ok {
-				tagMap[tag.ID] = struct{}{}
-				course.CourseTags = append(course.CourseTags, tag)
-			}
+	return tags, nil
+}
+
+// readCourseGalleries loads the gallery of every course in one query, keyed
+// by course.
+func readCourseGalleries(q querier, courseIDs []uuid.UUID) (map[uuid.UUID][]entity.CourseGallery, error) {
+	query := `
+		SELECT id, course_id, url, media_id, created_at, updated_at
+		FROM course_galleries
+		WHERE course_id = ANY($1::uuid[])
+		ORDER BY created_at, id
+	`
+
+	rows, err := q.Query(query, uuidArray(courseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course galleries: %v", err)
+	}
+	defer rows.Close()
+
+	galleries := make(map[uuid.UUID][]entity.CourseGallery)
+	for rows.Next() {
+		var gallery entity.CourseGallery
+		err := rows.Scan(&gallery.ID, &gallery.CourseID, &gallery.URL, &gallery.MediaID, &gallery.CreatedAt, &gallery.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course gallery: %v", err)
 		}
+		galleries[gallery.CourseID] = append(galleries[gallery.CourseID], gallery)
+	}
 
-		if gallery.ID != uuid.Nil {
-			if _, ok := galleryMap[gallery.ID]; !ok {
-				galleryMap[gallery.ID] = struct{}{}
-				course.Gallery = append(course.Gallery, gallery)
-			}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read course galleries: %v", err)
+	}
+
+	return galleries, nil
+}
+
+// readCourseSections loads the sections of every course in one query, keyed
+// by course, without their lessons. Sections come back in the order the
+// instructor arranged them; rows written before positions existed all share
+// position zero and fall back to their creation order.
+func readCourseSections(q querier, courseIDs []uuid.UUID) (map[uuid.UUID][]entity.CourseSection, error) {
+	query := `
+		SELECT id, course_id, name, position, created_at, updated_at
+		FROM course_sections
+		WHERE course_id = ANY($1::uuid[])
+		ORDER BY position, created_at, id
+	`
+
+	rows, err := q.Query(query, uuidArray(courseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course sections: %v", err)
+	}
+	defer rows.Close()
+
+	sections := make(map[uuid.UUID][]entity.CourseSection)
+	for rows.Next() {
+		var section entity.CourseSection
+		err := rows.Scan(&section.ID, &section.CourseID, &section.Name, &section.Position, &section.CreatedAt, &section.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course section: %v", err)
 		}
+		sections[section.CourseID] = append(sections[section.CourseID], section)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read course sections: %v", err)
+	}
 
-		if section.ID != uuid.Nil {
-			if _, ok := sectionMap[section.ID]; !ok {
-				sectionMap[section.ID] = struct{}{}
-				currentSection := entity.CourseSection{
-					ID:        section.ID,
-					Name:      section.Name,
-					CourseID:  section.CourseID,
-					Lessons:   []entity.CourseLesson{lesson},
-					CreatedAt: section.CreatedAt,
-					UpdatedAt: section.UpdatedAt,
-				}
-
-				course.Sections = append(course.Sections, currentSection)
-			} else {
-				lessonExists := false
-				for i, existingSection := range course.Sections {
-					if existingSection.ID == section.ID {
-						for _, existingLesson := range existingSection.Lessons {
-							if existingLesson.ID == lesson.ID {
-								lessonExists = true
-								break
-							}
-						}
-						if !lessonExists {
-							course.Sections[i].Lessons = append(course.Sections[i].Lessons, lesson)
-						}
-						break
-					}
-				}
-			}
+	return sections, nil
+}
+
+// readCourseLessons loads the lessons of every course in one query, keyed by
+// section. Lessons carry their course, so they are read on their own rather
+// than joined to their sections, in the same order as readCourseSections.
+func readCourseLessons(q querier, courseIDs []uuid.UUID) (map[uuid.UUID][]entity.CourseLesson, error) {
+	query := `
+		SELECT id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at
+		FROM course_lessons
+		WHERE course_id = ANY($1::uuid[])
+		ORDER BY position, created_at, id
+	`
+
+	rows, err := q.Query(query, uuidArray(courseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course lessons: %v", err)
+	}
+	defer rows.Close()
+
+	lessons := make(map[uuid.UUID][]entity.CourseLesson)
+	for rows.Next() {
+		var lesson entity.CourseLesson
+		err := rows.Scan(&lesson.ID, &lesson.CourseID, &lesson.CourseSectionID, &lesson.Title, &lesson.VideoURL,
+			&lesson.MediaID, &lesson.Duration, &lesson.Position, &lesson.CreatedAt, &lesson.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course lesson: %v", err)
 		}
+		lessons[lesson.CourseSectionID] = append(lessons[lesson.CourseSectionID], lesson)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read course lessons: %v", err)
 	}
 
-	return course, nil
+	return lessons, nil
 }
 
-func scanReadMany(rows *sql.Rows) (map[uuid.UUID]*entity.Course, error) {
-	var coursesMap = make(map[uuid.UUID]*entity.Course)
+// readCourseReviews loads the visible reviews of every course in one query,
+// keyed by course.
+func readCourseReviews(q querier, courseIDs []uuid.UUID) (map[uuid.UUID][]entity.CourseReviews, error) {
+	query := `
+		SELECT id, course_id, user_id, value, comment, hidden_at, created_at, updated_at
+		FROM course_reviews
+		WHERE course_id = ANY($1::uuid[]) AND hidden_at IS NULL
+		ORDER BY created_at, id
+	`
 
+	rows, err := q.Query(query, uuidArray(courseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course reviews: %v", err)
+	}
+	defer rows.Close()
+
+	reviews := make(map[uuid.UUID][]entity.CourseReviews)
 	for rows.Next() {
-		var courseID, tagID, galleryID, galleryCourseID uuid.UUID
-		var courseName, courseDescription, courseLanguage, tagName, galleryURL sql.NullString
-		var courseCreatedAt, courseUpdatedAt, tagCreatedAt, tagUpdatedAt, galleryCreatedAt, galleryUpdatedAt sql.NullInt64
-
-		err := rows.Scan(
-			&courseID, &courseName, &courseDescription, &courseLanguage, &courseCreatedAt, &courseUpdatedAt,
-			&tagID, &tagName, &tagCreatedAt, &tagUpdatedAt,
-			&galleryID, &galleryURL, &galleryCourseID, &galleryCreatedAt, &galleryUpdatedAt,
-		)
+		var review entity.CourseReviews
+		err := rows.Scan(&review.ID, &review.CourseID, &review.UserID, &review.Value, &review.Comment,
+			&review.HiddenAt, &review.CreatedAt, &review.UpdatedAt)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to scan course review: %v", err)
 		}
+		reviews[review.CourseID] = append(reviews[review.CourseID], review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read course reviews: %v", err)
+	}
+
+	return reviews, nil
+}
 
-		if _, ok := coursesMap[courseID]; !ok {
-			coursesMap[courseID] = &entity.Course{
-				ID:          courseID,
-				Name:        courseName.String,
-				Description: courseDescription.String,
-				Language:    language_enum.Language(courseLanguage.String),
-				CreatedAt:   courseCreatedAt.Int64,
-				UpdatedAt:   courseUpdatedAt.Int64,
+// readRelations fills in the content of courses selected by relations, with
+// one query per relation whatever the number of courses.
+func readRelations(q querier, courses []entity.Course, relations Relations) error {
+	ids := make([]uuid.UUID, 0, len(courses))
+	for _, course := range courses {
+		ids = append(ids, course.ID)
+	}
+
+	if relations.Tags {
+		tags, err := readCourseTags(q, ids)
+		if err != nil {
+			return err
+		}
+		for i := range courses {
+			courses[i].CourseTags = tags[courses[i].ID]
+		}
+	}
+
+	if relations.Gallery {
+		galleries, err := readCourseGalleries(q, ids)
+		if err != nil {
+			return err
+		}
+		for i := range courses {
+			courses[i].Gallery = galleries[courses[i].ID]
+		}
+	}
+
+	if relations.Sections {
+		sections, err := readCourseSections(q, ids)
+		if err != nil {
+			return err
+		}
+
+		lessons := make(map[uuid.UUID][]entity.CourseLesson)
+		if relations.Lessons {
+			lessons, err = readCourseLessons(q, ids)
+			if err != nil {
+				return err
 			}
 		}
 
-		if tagID != uuid.Nil && tagName.Valid && !tagExists(coursesMap[courseID].CourseTags, tagID) {
-			coursesMap[courseID].CourseTags = append(coursesMap[courseID].CourseTags, entity.CourseTags{
-				ID:        tagID,
-				Name:      tagName.String,
-				CreatedAt: tagCreatedAt.Int64,
-				UpdatedAt: tagUpdatedAt.Int64,
-			})
+		for i := range courses {
+			courses[i].Sections = sections[courses[i].ID]
+			for j := range courses[i].Sections {
+				courses[i].Sections[j].Lessons = lessons[courses[i].Sections[j].ID]
+			}
 		}
+	}
 
-		if galleryID != uuid.Nil && galleryURL.Valid && galleryCourseID != uuid.Nil && !galleryExists(coursesMap[courseID].Gallery, galleryID) {
-			coursesMap[courseID].Gallery = append(coursesMap[courseID].Gallery, entity.CourseGallery{
-				ID:        galleryID,
-				CourseID:  galleryCourseID,
-				URL:       galleryURL.String,
-				CreatedAt: galleryCreatedAt.Int64,
-				UpdatedAt: galleryUpdatedAt.Int64,
-			})
+	if relations.Reviews {
+		reviews, err := readCourseReviews(q, ids)
+		if err != nil {
+			return err
+		}
+		for i := range courses {
+			courses[i].CourseReviews = reviews[courses[i].ID]
 		}
 	}
 
-	return coursesMap, nil
+	return nil
 }
 
-func tagExists(tags []entity.CourseTags, tagID uuid.UUID) bool {
-	for _, tag := range tags {
-		if tag.ID == tagID {
-			return true
+// readCoursePage reads the page of courses selected by query, then their
+// content selected by relations. Paging the courses on their own keeps
+// LIMIT and OFFSET counting courses rather than joined rows.
+func (r *Repository) readCoursePage(relations Relations, query string, args ...any) ([]entity.Course, error) {
+	tx, err := r.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read courses: %v", err)
+	}
+	defer rows.Close()
+
+	courses := make([]entity.Course, 0)
+	for rows.Next() {
+		course, err := scanCourse(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course: %v", err)
 		}
+		courses = append(courses, course)
 	}
-	return false
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read courses: %v", err)
+	}
+
+	if len(courses) == 0 {
+		return courses, nil
+	}
+
+	err = readRelations(tx, courses, relations)
+	if err != nil {
+		return nil, err
+	}
+
+	return courses, nil
 }
 
-func galleryExists(galleries []entity.CourseGallery, galleryID uuid.UUID) bool {
-	for _, gallery := range galleries {
-		if gallery.ID == galleryID {
-			return true
-		}
+// scanCourse scans the columns of a course, without its content.
+func scanCourse(row interface{ Scan(dest ...any) error }) (entity.Course, error) {
+	var course entity.Course
+	err := row.Scan(&course.ID, &course.Name, &course.Description, &course.Language,
+		&course.Status, &course.PublishAt, &course.OwnerID, &course.CreatedAt, &course.UpdatedAt)
+	return course, err
+}
+
+func uuidArray(ids []uuid.UUID) interface{} {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
 	}
-	return false
+	return pq.Array(values)
 }
@@ -2,31 +2,46 @@ package repository_test
 
 import (
 	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/repository"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	userEntity "CodeWithAzri/internal/app/module/user/entity"
+	"database/sql/driver"
+	"fmt"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+var mockParentTagID = uuid.MustParse("345c2c39-5a19-4842-bab8-072a53cd020b")
+
 var mockTags []entity.CourseTags = []entity.CourseTags{
 	{
 		ID:        uuid.MustParse("345c2c39-5a19-4842-bab8-072a53cd020b"),
 		Name:      "Mock Tag",
+		Slug:      "mock-tag",
 		CreatedAt: 121212,
 		UpdatedAt: 121212,
 	},
 	{
 		ID:        uuid.MustParse("7ccb15a4-483d-4b65-88f8-f2c6d2de3460"),
 		Name:      "Mock Tag 2",
+		Slug:      "mock-tag-2",
+		ParentID:  &mockParentTagID,
 		CreatedAt: 121212,
 		UpdatedAt: 121212,
 	},
 }
 
+var mockGalleryMediaID = uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+
 var MockEntity entity.Course = entity.Course{
 	ID:          uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
 	Name:        "Mock Course",
 	Description: "Mock Course Description",
 	Language:    "en",
+	Status:      "published",
+	OwnerID:     "instructor-uid",
 	CourseTags:  mockTags,
 	Gallery: []entity.CourseGallery{
 		{
@@ -36,6 +51,13 @@ var MockEntity entity.Course = entity.Course{
 			CreatedAt: 121212,
 			UpdatedAt: 121212,
 		},
+		{
+			ID:        uuid.MustParse("b2b71fda-f0f2-4358-9722-b3f13c4564a6"),
+			CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+			MediaID:   &mockGalleryMediaID,
+			CreatedAt: 121212,
+			UpdatedAt: 121212,
+		},
 	},
 	Sections: []entity.CourseSection{
 		{
@@ -104,6 +126,7 @@ var MockArrayEntity []entity.Course = []entity.Course{
 		Name:        "Mock Course",
 		Description: "Mock Course Description",
 		Language:    "en",
+		Status:      "published",
 		CourseTags:  mockTags,
 		Gallery: []entity.CourseGallery{
 			{
@@ -122,6 +145,7 @@ var MockArrayEntity []entity.Course = []entity.Course{
 		Name:        "Mock Course 2",
 		Description: "Mock Course Description 2",
 		Language:    "id",
+		Status:      "published",
 		CourseTags:  mockTags,
 		Gallery: []entity.CourseGallery{
 			{
@@ -137,85 +161,232 @@ var MockArrayEntity []entity.Course = []entity.Course{
 	},
 }
 
-func prepareRows(courseEntity entity.Course) *sqlmock.Rows {
-	rows := sqlmock.NewRows([]string{
-		"course_id", "name", "description", "language", "created_at", "updated_at",
-		"tag_id", "tag_name", "tag_created_at", "tag_updated_at",
-		"gallery_id", "gallery_url", "gallery_course_id", "gallery_created_at", "gallery_updated_at",
-		"section_id", "section_name", "section_course_id", "section_created_at", "section_updated_at",
-		"lesson_id", "lesson_title", "lesson_video_url", "lesson_course_id", "lesson_section_id", "lesson_created_at", "lesson_updated_at",
-	})
+const createRevisionQuery = "INSERT INTO course_revisions (id, course_id, revision, message, snapshot, created_by, created_at) SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5, $6 FROM course_revisions WHERE course_id = $2"
+
+const createBaselineRevisionQuery = "INSERT INTO course_revisions (id, course_id, revision, message, snapshot, created_by, created_at) SELECT $1, $2, 1, $3, $4, $5, $6 WHERE NOT EXISTS (SELECT 1 FROM course_revisions WHERE course_id = $2)"
+
+const lockCourseQuery = "SELECT updated_at FROM courses WHERE id = $1 FOR UPDATE"
+
+const appendEventQuery = "INSERT INTO outbox_events (id, type, aggregate_id, payload, created_at) VALUES ($1, $2, $3, $4, $5)"
+
+var MockEvent eventEntity.Event = eventEntity.Event{
+	ID:          uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+	Type:        "course.created",
+	AggregateID: "18a95d2f-a941-4a64-bbe5-256be7626db2",
+	Payload:     `{"course_id":"18a95d2f-a941-4a64-bbe5-256be7626db2"}`,
+	CreatedAt:   121212,
+}
+
+func expectAppendEvent(mock sqlmock.Sqlmock, event eventEntity.Event) *sqlmock.ExpectedExec {
+	return mock.ExpectExec(appendEventQuery).
+		WithArgs(event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+var MockRevision entity.CourseRevision = entity.CourseRevision{
+	ID:        uuid.MustParse("0f4b51c6-4bd4-4c4e-9d0b-2b7f0c4f2a11"),
+	CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+	Revision:  2,
+	Message:   "update",
+	Snapshot:  `{"id":"18a95d2f-a941-4a64-bbe5-256be7626db2","name":"Mock Course"}`,
+	CreatedBy: "instructor-uid",
+	CreatedAt: 121212,
+}
+
+var MockInstructors []entity.CourseInstructor = []entity.CourseInstructor{
+	{
+		CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+		UserID:    "instructor-uid",
+		Role:      "owner",
+		Profile:   userEntity.User{ID: "instructor-uid", Name: "Mock Instructor", ProfilePicture: "https://example.com/instructor.png"},
+		CreatedAt: 121212,
+		UpdatedAt: 121212,
+	},
+	{
+		CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+		UserID:    "editor-uid",
+		Role:      "editor",
+		Profile:   userEntity.User{ID: "editor-uid", Name: "Mock Editor"},
+		CreatedAt: 131313,
+		UpdatedAt: 131313,
+	},
+}
+
+const (
+	readCourseQuery           = "SELECT id, name, description, language, status, publish_at, owner_id, created_at, updated_at FROM courses WHERE id = $1"
+	readCourseTagsQuery       = "SELECT tc.course_id, t.id, t.name, t.slug, t.parent_id, t.created_at, t.updated_at FROM course_tags_courses tc JOIN course_tags t ON t.id = tc.course_tags_id WHERE tc.course_id = ANY($1::uuid[]) ORDER BY t.name"
+	readCourseGalleriesQuery  = "SELECT id, course_id, url, media_id, created_at, updated_at FROM course_galleries WHERE course_id = ANY($1::uuid[]) ORDER BY created_at, id"
+	readCourseSectionsQuery   = "SELECT id, course_id, name, position, created_at, updated_at FROM course_sections WHERE course_id = ANY($1::uuid[]) ORDER BY position, created_at, id"
+	readCourseLessonsQuery    = "SELECT id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at FROM course_lessons WHERE course_id = ANY($1::uuid[]) ORDER BY position, created_at, id"
+	readCourseReviewsQuery    = "SELECT id, course_id, user_id, value, comment, hidden_at, created_at, updated_at FROM course_reviews WHERE course_id = ANY($1::uuid[]) AND hidden_at IS NULL ORDER BY created_at, id"
+	readManyQuery             = "SELECT c.id, c.name, c.description, c.language, c.status, c.publish_at, c.owner_id, c.created_at, c.updated_at FROM courses c WHERE c.status = $1 OR EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.user_id = $2) ORDER BY c.created_at DESC, c.id LIMIT $3 OFFSET $4"
+	readManyByInstructorQuery = "SELECT c.id, c.name, c.description, c.language, c.status, c.publish_at, c.owner_id, c.created_at, c.updated_at FROM courses c WHERE EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.user_id = $1) AND (c.status = $2 OR EXISTS (SELECT 1 FROM course_instructors ci WHERE ci.course_id = c.id AND ci.user_id = $3)) ORDER BY c.created_at DESC, c.id LIMIT $4 OFFSET $5"
+)
+
+// summaryRelations is the content listed courses are read with.
+var summaryRelations = repository.Relations{Tags: true, Gallery: true}
+
+// expectReadOne expects ReadOne to load courseEntity.
+func expectReadOne(mock sqlmock.Sqlmock, courseEntity entity.Course) {
+	ids := pq.Array([]string{courseEntity.ID.String()})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+	mock.ExpectQuery(readCourseTagsQuery).WithArgs(ids).WillReturnRows(prepareTagRows(courseEntity))
+	mock.ExpectQuery(readCourseGalleriesQuery).WithArgs(ids).WillReturnRows(prepareGalleryRows(courseEntity))
+	mock.ExpectQuery(readCourseSectionsQuery).WithArgs(ids).WillReturnRows(prepareSectionRows(courseEntity))
+	mock.ExpectQuery(readCourseLessonsQuery).WithArgs(ids).WillReturnRows(prepareLessonRows(courseEntity))
+	mock.ExpectRollback()
+}
+
+func prepareCourseRow(courseEntity entity.Course) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name", "description", "language", "status", "publish_at", "owner_id", "created_at", "updated_at"}).
+		AddRow(courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language,
+			courseEntity.Status, courseEntity.PublishAt, courseEntity.OwnerID, courseEntity.CreatedAt, courseEntity.UpdatedAt)
+}
 
-	// Adding rows based on the MockEntity
+func prepareTagRows(courseEntity entity.Course) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"course_id", "id", "name", "slug", "parent_id", "created_at", "updated_at"})
 	for _, tag := range courseEntity.CourseTags {
-		rows.AddRow(
-			courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-			tag.ID, tag.Name, 121212, 121212,
-			uuid.Nil, "", uuid.Nil, 0, 0,
-			uuid.Nil, "", uuid.Nil, 0, 0,
-			uuid.Nil, "", "", uuid.Nil, uuid.Nil, 0, 0,
-		)
+		rows.AddRow(courseEntity.ID, tag.ID, tag.Name, tag.Slug, nullableUUID(tag.ParentID), tag.CreatedAt, tag.UpdatedAt)
 	}
+	return rows
+}
 
+func prepareGalleryRows(courseEntity entity.Course) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "course_id", "url", "media_id", "created_at", "updated_at"})
 	for _, gallery := range courseEntity.Gallery {
-		rows.AddRow(
-			courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-			uuid.Nil, "", 0, 0,
-			gallery.ID, gallery.URL, gallery.CourseID, 121212, 121212,
-			uuid.Nil, "", uuid.Nil, 0, 0,
-			uuid.Nil, "", "", uuid.Nil, uuid.Nil, 0, 0,
-		)
+		rows.AddRow(gallery.ID, gallery.CourseID, gallery.URL, nullableUUID(gallery.MediaID), gallery.CreatedAt, gallery.UpdatedAt)
 	}
+	return rows
+}
+
+func prepareSectionRows(courseEntity entity.Course) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "course_id", "name", "position", "created_at", "updated_at"})
+	for _, section := range courseEntity.Sections {
+		rows.AddRow(section.ID, section.CourseID, section.Name, section.Position, section.CreatedAt, section.UpdatedAt)
+	}
+	return rows
+}
 
+func prepareLessonRows(courseEntity entity.Course) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "course_id", "course_section_id", "title", "video_url", "media_id", "duration", "position", "created_at", "updated_at"})
 	for _, section := range courseEntity.Sections {
 		for _, lesson := range section.Lessons {
-			rows.AddRow(
-				courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-				uuid.Nil, "", 0, 0,
-				uuid.Nil, "", uuid.Nil, 0, 0,
-				section.ID, section.Name, section.CourseID, 121212, 121212,
-				lesson.ID, lesson.Title, lesson.VideoURL, lesson.CourseID, lesson.CourseSectionID, 121212, 121212,
-			)
+			rows.AddRow(lesson.ID, lesson.CourseID, lesson.CourseSectionID, lesson.Title, lesson.VideoURL,
+				nullableUUID(lesson.MediaID), lesson.Duration, lesson.Position, lesson.CreatedAt, lesson.UpdatedAt)
 		}
 	}
+	return rows
+}
 
-	// //This Additional Row is used to test the case on readOneScan when the lesson is same
-	rows.AddRow(
-		courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-		uuid.Nil, "", 0, 0,
-		uuid.Nil, "", uuid.Nil, 0, 0,
-		"b2b71fda-f0f2-4358-9722-b3f13c4564a7", "Mock Section", "18a95d2f-a941-4a64-bbe5-256be7626db2", 121212, 121212,
-		"d60619ae-cee9-4877-8f5d-8b294fe9cd80", "Mock Lesson", "https://www.youtube.com", "18a95d2f-a941-4a64-bbe5-256be7626db2", "b2b71fda-f0f2-4358-9722-b3f13c4564a7", 121212, 121212,
-	)
-
+func prepareReviewRows(reviews []entity.CourseReviews) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "course_id", "user_id", "value", "comment", "hidden_at", "created_at", "updated_at"})
+	for _, review := range reviews {
+		rows.AddRow(review.ID, review.CourseID, review.UserID, review.Value, review.Comment, review.HiddenAt, review.CreatedAt, review.UpdatedAt)
+	}
 	return rows
 }
 
-func prepareManyRows(courseArray []entity.Course) *sqlmock.Rows {
+// largeCourse builds a course with a few tags and gallery items and
+// sections×lessons lessons, the shape that made the old joined query read
+// one row per tag, gallery item and lesson combination.
+func largeCourse(sections int, lessons int) entity.Course {
+	course := MockEntity
+	course.CourseTags = nil
+	for i := 0; i < 5; i++ {
+		course.CourseTags = append(course.CourseTags, entity.CourseTags{ID: uuid.New(), Name: fmt.Sprintf("Tag %d", i), CreatedAt: 121212, UpdatedAt: 121212})
+	}
+
+	course.Gallery = nil
+	for i := 0; i < 4; i++ {
+		course.Gallery = append(course.Gallery, entity.CourseGallery{ID: uuid.New(), CourseID: course.ID, URL: "https://www.google.com", CreatedAt: 121212, UpdatedAt: 121212})
+	}
+
+	course.Sections = nil
+	for i := 0; i < sections; i++ {
+		section := entity.CourseSection{ID: uuid.New(), CourseID: course.ID, Name: fmt.Sprintf("Section %d", i), CreatedAt: 121212, UpdatedAt: 121212}
+		for j := 0; j < lessons; j++ {
+			section.Lessons = append(section.Lessons, entity.CourseLesson{
+				ID:              uuid.New(),
+				CourseID:        course.ID,
+				CourseSectionID: section.ID,
+				Title:           fmt.Sprintf("Lesson %d.%d", i, j),
+				VideoURL:        "https://www.youtube.com",
+				CreatedAt:       121212,
+				UpdatedAt:       121212,
+			})
+		}
+		course.Sections = append(course.Sections, section)
+	}
+
+	return course
+}
+
+// joinedReadOneQuery is the single query ReadOne used to join every kind of
+// content of a course with, before it read each kind separately.
+const joinedReadOneQuery = "SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, c.status, c.publish_at, c.owner_id, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.media_id AS gallery_media_id, g.course_id AS gallery_course_id, g.created_at, g.updated_at, s.id AS section_id, s.name AS section_name, s.course_id AS section_course_id, s.created_at, s.updated_at, l.id AS lesson_id, l.title AS lesson_title, l.video_url AS lesson_video_url, l.media_id AS lesson_media_id, l.duration AS lesson_duration, l.course_id AS lesson_course_id, l.course_section_id AS lesson_section_id, l.created_at, l.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LEFT JOIN course_sections s ON c.id = s.course_id LEFT JOIN course_lessons l ON s.id = l.course_section_id WHERE c.id = $1"
+
+// joinedCourseRows returns the rows joinedReadOneQuery returned for course:
+// one for every combination of tag, gallery item and lesson.
+func joinedCourseRows(course entity.Course) *sqlmock.Rows {
 	rows := sqlmock.NewRows([]string{
-		"course_id", "name", "description", "language", "created_at", "updated_at",
-		"tag_id", "tag_name", "tag_created_at", "tag_updated_at",
-		"gallery_id", "gallery_url", "gallery_course_id", "gallery_created_at", "gallery_updated_at",
+		"course_id", "name", "description", "language", "created_at", "updated_at", "status", "publish_at", "owner_id",
+		"tag_id", "tag_name", "created_at", "updated_at",
+		"gallery_id", "gallery_url", "gallery_media_id", "gallery_course_id", "created_at", "updated_at",
+		"section_id", "section_name", "section_course_id", "created_at", "updated_at",
+		"lesson_id", "lesson_title", "lesson_video_url", "lesson_media_id", "lesson_duration", "lesson_course_id", "lesson_section_id", "created_at", "updated_at",
 	})
 
-	for _, courseEntity := range courseArray {
-		for _, tag := range courseEntity.CourseTags {
-			rows.AddRow(
-				courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-				tag.ID, tag.Name, 121212, 121212,
-				uuid.Nil, "", uuid.Nil, 0, 0,
-			)
+	for _, tag := range course.CourseTags {
+		for _, gallery := range course.Gallery {
+			for _, section := range course.Sections {
+				for _, lesson := range section.Lessons {
+					rows.AddRow(course.ID, course.Name, course.Description, course.Language, course.CreatedAt, course.UpdatedAt, course.Status, course.PublishAt, course.OwnerID,
+						tag.ID, tag.Name, tag.CreatedAt, tag.UpdatedAt,
+						gallery.ID, gallery.URL, gallery.MediaID, gallery.CourseID, gallery.CreatedAt, gallery.UpdatedAt,
+						section.ID, section.Name, section.CourseID, section.CreatedAt, section.UpdatedAt,
+						lesson.ID, lesson.Title, lesson.VideoURL, lesson.MediaID, lesson.Duration, lesson.CourseID, lesson.CourseSectionID, lesson.CreatedAt, lesson.UpdatedAt)
+				}
+			}
 		}
+	}
 
+	return rows
+}
+
+// expectReadPage expects a page of courses to be read with query and args,
+// followed by their tags and galleries.
+func expectReadPage(mock sqlmock.Sqlmock, query string, args []driver.Value, courses []entity.Course) {
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "language", "status", "publish_at", "owner_id", "created_at", "updated_at"})
+	tagRows := sqlmock.NewRows([]string{"course_id", "id", "name", "slug", "parent_id", "created_at", "updated_at"})
+	galleryRows := sqlmock.NewRows([]string{"id", "course_id", "url", "media_id", "created_at", "updated_at"})
+	ids := make([]string, 0, len(courses))
+
+	for _, courseEntity := range courses {
+		rows.AddRow(courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language,
+			courseEntity.Status, courseEntity.PublishAt, courseEntity.OwnerID, courseEntity.CreatedAt, courseEntity.UpdatedAt)
+		for _, tag := range courseEntity.CourseTags {
+			tagRows.AddRow(courseEntity.ID, tag.ID, tag.Name, tag.Slug, nullableUUID(tag.ParentID), tag.CreatedAt, tag.UpdatedAt)
+		}
 		for _, gallery := range courseEntity.Gallery {
-			rows.AddRow(
-				courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-				uuid.Nil, "", 0, 0,
-				gallery.ID, gallery.URL, gallery.CourseID, 121212, 121212,
-			)
+			galleryRows.AddRow(gallery.ID, gallery.CourseID, gallery.URL, nullableUUID(gallery.MediaID), gallery.CreatedAt, gallery.UpdatedAt)
 		}
+		ids = append(ids, courseEntity.ID.String())
+	}
 
+	mock.ExpectBegin()
+	mock.ExpectQuery(query).WithArgs(args...).WillReturnRows(rows)
+	if len(courses) > 0 {
+		mock.ExpectQuery(readCourseTagsQuery).WithArgs(pq.Array(ids)).WillReturnRows(tagRows)
+		mock.ExpectQuery(readCourseGalleriesQuery).WithArgs(pq.Array(ids)).WillReturnRows(galleryRows)
 	}
+	mock.ExpectRollback()
+}
 
-	return rows
+func nullableUUID(id *uuid.UUID) driver.Value {
+	if id == nil {
+		return nil
+	}
+	return id.String()
 }
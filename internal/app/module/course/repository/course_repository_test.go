@@ -3,13 +3,18 @@ package repository_test
 import (
 	"CodeWithAzri/internal/app/module/course/entity"
 	"CodeWithAzri/internal/app/module/course/repository"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,6 +47,9 @@ func TestRepository_Create(t *testing.T) {
 	//Failed Insert Course
 	testCourseInsertErrorHandling(t, mock, repo, courseEntity)
 
+	//Failed Insert Course Owner
+	testCreateOwnerErrorHandling(t, mock, repo, courseEntity)
+
 	//Failed to link Course Tag
 	testLinkCourseToTagErrorHandling(t, mock, repo, courseEntity)
 
@@ -59,20 +67,31 @@ func TestRepository_Create(t *testing.T) {
 
 }
 
+func expectCreateOwner(mock sqlmock.Sqlmock, courseEntity entity.Course) *sqlmock.ExpectedExec {
+	return mock.ExpectExec("INSERT INTO course_instructors (course_id, user_id, role, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
+		WithArgs(courseEntity.ID, courseEntity.OwnerID, instructor_role_enum.Owner, courseEntity.CreatedAt, courseEntity.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
 func testCreateSuccess(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	for _, tag := range courseEntity.CourseTags {
 		mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 			WithArgs(courseEntity.ID, tag.ID).
@@ -80,26 +99,28 @@ func testCreateSuccess(t *testing.T, mock sqlmock.Sqlmock, repo repository.Cours
 	}
 
 	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.CreatedAt, galleryItem.UpdatedAt).
+		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
 	for _, section := range courseEntity.Sections {
-		mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(section.ID, courseEntity.ID, section.Name, section.CreatedAt, section.UpdatedAt).
+		mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+			WithArgs(section.ID, courseEntity.ID, section.Name, section.Position, section.CreatedAt, section.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		for _, lesson := range section.Lessons {
-			mock.ExpectExec("INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)").
-				WithArgs(lesson.ID, courseEntity.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.CreatedAt, lesson.UpdatedAt).
+			mock.ExpectExec("INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)").
+				WithArgs(lesson.ID, courseEntity.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.MediaID, lesson.Duration, lesson.Position, lesson.CreatedAt, lesson.UpdatedAt).
 				WillReturnResult(sqlmock.NewResult(0, 1))
 		}
 	}
 
+	expectAppendEvent(mock, MockEvent)
+
 	mock.ExpectCommit()
 
-	err := repo.Create(courseEntity)
+	err := repo.Create(courseEntity, MockEvent)
 
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -119,17 +140,22 @@ func testCreateTransactionErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo
 func testCreateRollbackHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 		WithArgs(courseEntity.ID, courseEntity.CourseTags[0].ID).
 		WillReturnError(errors.New("some error"))
@@ -145,12 +171,15 @@ func testCreateRollbackHandling(t *testing.T, mock sqlmock.Sqlmock, repo reposit
 func testCourseInsertErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
@@ -164,20 +193,42 @@ func testCourseInsertErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repo
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func testCreateOwnerErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
+	mock.ExpectBegin()
+
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	expectCreateOwner(mock, courseEntity).WillReturnError(errors.New("some error"))
+
+	mock.ExpectRollback()
+
+	err := repo.Create(courseEntity)
+
+	assert.EqualError(t, err, "failed to add course owner: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func testLinkCourseToTagErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 		WithArgs(courseEntity.ID, courseEntity.CourseTags[0].ID).
 		WillReturnError(errors.New("some error"))
@@ -193,28 +244,34 @@ func testLinkCourseToTagErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo r
 func testCreateGalleryItemErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	for _, tag := range courseEntity.CourseTags {
 		mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 			WithArgs(courseEntity.ID, tag.ID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
-	mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
+	mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
 		WithArgs(
 			courseEntity.Gallery[0].ID,
 			courseEntity.ID,
 			courseEntity.Gallery[0].URL,
+			courseEntity.Gallery[0].MediaID,
 			courseEntity.Gallery[0].CreatedAt,
 			courseEntity.Gallery[0].UpdatedAt,
 		).
@@ -232,17 +289,22 @@ func testCreateSectionErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo rep
 	// Expectations for the transaction
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	for _, tag := range courseEntity.CourseTags {
 		mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 			WithArgs(courseEntity.ID, tag.ID).
@@ -250,17 +312,18 @@ func testCreateSectionErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo rep
 	}
 
 	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.CreatedAt, galleryItem.UpdatedAt).
+		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
 	// Simulate an error during creating section
-	mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
+	mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
 		WithArgs(
 			courseEntity.Sections[0].ID,
 			courseEntity.ID,
 			courseEntity.Sections[0].Name,
+			courseEntity.Sections[0].Position,
 			courseEntity.Sections[0].CreatedAt,
 			courseEntity.Sections[0].UpdatedAt,
 		).
@@ -280,17 +343,22 @@ func testCreateSectionErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo rep
 func testCreateLessonErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	for _, tag := range courseEntity.CourseTags {
 		mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 			WithArgs(courseEntity.ID, tag.ID).
@@ -298,28 +366,32 @@ func testCreateLessonErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repo
 	}
 
 	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.CreatedAt, galleryItem.UpdatedAt).
+		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
-	mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
+	mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
 		WithArgs(
 			courseEntity.Sections[0].ID,
 			courseEntity.ID,
 			courseEntity.Sections[0].Name,
+			courseEntity.Sections[0].Position,
 			courseEntity.Sections[0].CreatedAt,
 			courseEntity.Sections[0].UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
-	mock.ExpectExec("INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)").
+	mock.ExpectExec("INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)").
 		WithArgs(
 			courseEntity.Sections[0].Lessons[0].ID,
 			courseEntity.ID,
 			courseEntity.Sections[0].ID,
 			courseEntity.Sections[0].Lessons[0].Title,
 			courseEntity.Sections[0].Lessons[0].VideoURL,
+			courseEntity.Sections[0].Lessons[0].MediaID,
+			courseEntity.Sections[0].Lessons[0].Duration,
+			courseEntity.Sections[0].Lessons[0].Position,
 			courseEntity.Sections[0].Lessons[0].CreatedAt,
 			courseEntity.Sections[0].Lessons[0].UpdatedAt,
 		).
@@ -335,17 +407,22 @@ func testCreateLessonErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repo
 func testCreateCommitErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 
-	mock.ExpectExec("INSERT INTO courses (id, name, description, language, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+	mock.ExpectExec("INSERT INTO courses (id, name, description, language, status, publish_at, owner_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)").
 		WithArgs(
 			courseEntity.ID,
 			courseEntity.Name,
 			courseEntity.Description,
 			courseEntity.Language,
+			courseEntity.Status,
+			courseEntity.PublishAt,
+			courseEntity.OwnerID,
 			courseEntity.CreatedAt,
 			courseEntity.UpdatedAt,
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectCreateOwner(mock, courseEntity)
+
 	for _, tag := range courseEntity.CourseTags {
 		mock.ExpectExec("INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)").
 			WithArgs(courseEntity.ID, tag.ID).
@@ -353,19 +430,19 @@ func testCreateCommitErrorHandling(t *testing.T, mock sqlmock.Sqlmock, repo repo
 	}
 
 	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.CreatedAt, galleryItem.UpdatedAt).
+		mock.ExpectExec("INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+			WithArgs(galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
 	for _, section := range courseEntity.Sections {
-		mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(section.ID, courseEntity.ID, section.Name, section.CreatedAt, section.UpdatedAt).
+		mock.ExpectExec("INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
+			WithArgs(section.ID, courseEntity.ID, section.Name, section.Position, section.CreatedAt, section.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		for _, lesson := range section.Lessons {
-			mock.ExpectExec("INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)").
-				WithArgs(lesson.ID, courseEntity.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.CreatedAt, lesson.UpdatedAt).
+			mock.ExpectExec("INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)").
+				WithArgs(lesson.ID, courseEntity.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.MediaID, lesson.Duration, lesson.Position, lesson.CreatedAt, lesson.UpdatedAt).
 				WillReturnResult(sqlmock.NewResult(0, 1))
 		}
 	}
@@ -385,683 +462,661 @@ func TestRepository_ReadOne(t *testing.T) {
 	defer db.Close()
 
 	courseEntity := MockEntity
+	ids := pq.Array([]string{courseEntity.ID.String()})
 
-	// Test Read One Success
-	testReadOneSuccess(t, mock, repo, courseEntity)
-
-	//Test Read One Scan Failure
-	testReadOneScanError(t, mock, repo, courseEntity)
+	t.Run("Read One Success", func(t *testing.T) {
+		expectReadOne(mock, courseEntity)
 
-	//Test Read One Query Error
-	testReadOneQuerryError(t, mock, repo, courseEntity)
-}
+		result, err := repo.ReadOne(courseEntity.ID)
 
-func testReadOneSuccess(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
+		assert.NoError(t, err)
+		assert.Equal(t, courseEntity, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	// Mocking the database query
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at, s.id AS section_id, s.name AS section_name, s.course_id AS section_course_id, s.created_at, s.updated_at, l.id AS lesson_id, l.title AS lesson_title, l.video_url AS lesson_video_url, l.course_id AS lesson_course_id, l.course_section_id AS lesson_section_id, l.created_at, l.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LEFT JOIN course_sections s ON c.id = s.course_id LEFT JOIN course_lessons l ON s.id = l.course_section_id WHERE c.id = $1").
-		WithArgs(courseEntity.ID).
-		WillReturnRows(prepareRows(courseEntity))
+	t.Run("Read One Large Course", func(t *testing.T) {
+		large := largeCourse(20, 25)
+		expectReadOne(mock, large)
 
-	// Calling the ReadOne method
-	result, err := repo.ReadOne(courseEntity.ID)
-	if err != nil {
-		t.Fatalf("Error while calling ReadOne: %v", err)
-	}
+		result, err := repo.ReadOne(large.ID)
 
-	// Asserting the result
-	assert.Equal(t, courseEntity, result)
+		assert.NoError(t, err)
+		assert.Equal(t, large, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	// Checking if all expectations were met
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
-	}
-}
+	t.Run("Read One Not Found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
 
-func testReadOneScanError(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-
-	rows := sqlmock.NewRows([]string{
-		"course_id", "name", "description", "language", "created_at", "updated_at",
-		"tag_id", "tag_name", "tag_created_at", "tag_updated_at",
-		"gallery_id", "gallery_url", "gallery_course_id", "gallery_created_at", "gallery_updated_at",
-		"section_id", "section_name", "section_course_id", "section_created_at", "section_updated_at",
-		"lesson_id", "lesson_title", "lesson_video_url", "lesson_course_id", "lesson_section_id", "lesson_created_at", "lesson_updated_at",
-	}).AddRow(
-		courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-		"invalid id", "", 121212, 121212,
-		uuid.Nil, "", uuid.Nil, 0, 0,
-		uuid.Nil, "", uuid.Nil, 0, 0,
-		uuid.Nil, "", "", uuid.Nil, uuid.Nil, 0, 0,
-	)
+		result, err := repo.ReadOne(courseEntity.ID)
 
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at, s.id AS section_id, s.name AS section_name, s.course_id AS section_course_id, s.created_at, s.updated_at, l.id AS lesson_id, l.title AS lesson_title, l.video_url AS lesson_video_url, l.course_id AS lesson_course_id, l.course_section_id AS lesson_section_id, l.created_at, l.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LEFT JOIN course_sections s ON c.id = s.course_id LEFT JOIN course_lessons l ON s.id = l.course_section_id WHERE c.id = $1").
-		WithArgs(courseEntity.ID).
-		WillReturnRows(rows)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.Course{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	_, err := repo.ReadOne(courseEntity.ID)
+	t.Run("Read One Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("begin error"))
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "sql: Scan error on column index 6, name \"tag_id\"")
+		_, err := repo.ReadOne(courseEntity.ID)
 
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
-	}
-}
+		assert.EqualError(t, err, "failed to begin transaction: begin error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-func testReadOneQuerryError(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
+	t.Run("Read One Query Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnError(fmt.Errorf("Querry Error"))
+		mock.ExpectRollback()
 
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at, s.id AS section_id, s.name AS section_name, s.course_id AS section_course_id, s.created_at, s.updated_at, l.id AS lesson_id, l.title AS lesson_title, l.video_url AS lesson_video_url, l.course_id AS lesson_course_id, l.course_section_id AS lesson_section_id, l.created_at, l.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LEFT JOIN course_sections s ON c.id = s.course_id LEFT JOIN course_lessons l ON s.id = l.course_section_id WHERE c.id = $1").
-		WithArgs(courseEntity.ID).
-		WillReturnError(fmt.Errorf("Querry Error"))
+		_, err := repo.ReadOne(courseEntity.ID)
 
-	_, err := repo.ReadOne(courseEntity.ID)
+		assert.EqualError(t, err, "failed to read course: Querry Error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Querry Error")
+	tests := []struct {
+		name  string
+		query string
+		err   string
+	}{
+		{"Read One Tags Error", readCourseTagsQuery, "failed to read course tags: some error"},
+		{"Read One Galleries Error", readCourseGalleriesQuery, "failed to read course galleries: some error"},
+		{"Read One Sections Error", readCourseSectionsQuery, "failed to read course sections: some error"},
+		{"Read One Lessons Error", readCourseLessonsQuery, "failed to read course lessons: some error"},
+	}
 
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+			for _, query := range []string{readCourseTagsQuery, readCourseGalleriesQuery, readCourseSectionsQuery, readCourseLessonsQuery} {
+				if query == test.query {
+					mock.ExpectQuery(query).WithArgs(ids).WillReturnError(errors.New("some error"))
+					break
+				}
+				mock.ExpectQuery(query).WithArgs(ids).WillReturnRows(sqlmock.NewRows(nil))
+			}
+			mock.ExpectRollback()
+
+			_, err := repo.ReadOne(courseEntity.ID)
+
+			assert.EqualError(t, err, test.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
 	}
+
+	t.Run("Read One Scan Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+		mock.ExpectQuery(readCourseTagsQuery).WithArgs(ids).
+			WillReturnRows(sqlmock.NewRows([]string{"course_id", "id", "name", "slug", "parent_id", "created_at", "updated_at"}).AddRow(courseEntity.ID, "invalid id", "", "", nil, 0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.ReadOne(courseEntity.ID)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to scan course tag")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
-func TestRepository_ReadMany(t *testing.T) {
+func TestRepository_ReadOneWith(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
-	courseArray := MockArrayEntity
-
-	rows := sqlmock.NewRows([]string{
-		"course_id", "name", "description", "language", "created_at", "updated_at",
-		"tag_id", "tag_name", "tag_created_at", "tag_updated_at",
-		"gallery_id", "gallery_url", "gallery_course_id", "gallery_created_at", "gallery_updated_at",
-	})
+	courseEntity := MockEntity
+	ids := pq.Array([]string{courseEntity.ID.String()})
 
-	for _, courseEntity := range courseArray {
-		for _, tag := range courseEntity.CourseTags {
-			rows.AddRow(
-				courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-				tag.ID, tag.Name, 121212, 121212,
-				uuid.Nil, "", uuid.Nil, 0, 0,
-			)
-		}
+	t.Run("Read One Without Relations", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+		mock.ExpectRollback()
 
-		for _, gallery := range courseEntity.Gallery {
-			rows.AddRow(
-				courseEntity.ID, courseEntity.Name, courseEntity.Description, courseEntity.Language, 121212, 121212,
-				uuid.Nil, "", 0, 0,
-				gallery.ID, gallery.URL, gallery.CourseID, 121212, 121212,
-			)
-		}
+		result, err := repo.ReadOneWith(courseEntity.ID, repository.Relations{})
 
-	}
+		assert.NoError(t, err)
+		assert.Equal(t, courseEntity.Name, result.Name)
+		assert.Empty(t, result.CourseTags)
+		assert.Empty(t, result.Gallery)
+		assert.Empty(t, result.Sections)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
-		WillReturnRows(rows)
+	t.Run("Read One With Sections Without Lessons", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+		mock.ExpectQuery(readCourseSectionsQuery).WithArgs(ids).WillReturnRows(prepareSectionRows(courseEntity))
+		mock.ExpectRollback()
 
-	result, err := repo.ReadMany(10, 0)
-	if err != nil {
-		t.Fatalf("Error while calling ReadOne: %v", err)
-	}
+		result, err := repo.ReadOneWith(courseEntity.ID, repository.Relations{Sections: true})
 
-	assert.Equal(t, courseArray, result)
+		assert.NoError(t, err)
+		assert.Len(t, result.Sections, len(courseEntity.Sections))
+		assert.Equal(t, courseEntity.Sections[0].Name, result.Sections[0].Name)
+		assert.Empty(t, result.Sections[0].Lessons)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
+	reviews := []entity.CourseReviews{
+		{ID: uuid.New(), CourseID: courseEntity.ID, UserID: uuid.New(), Value: 5, Comment: "Great", CreatedAt: 121212, UpdatedAt: 121212},
+		{ID: uuid.New(), CourseID: courseEntity.ID, UserID: uuid.New(), Value: 3, Comment: "Fine", CreatedAt: 131313, UpdatedAt: 131313},
 	}
-}
 
-func TestRepository_ReadMany_WithDuplicate(t *testing.T) {
-	db, mock, repo := initializeMockDB(t)
-	defer db.Close()
+	t.Run("Read One With Reviews", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+		mock.ExpectQuery(readCourseReviewsQuery).WithArgs(ids).WillReturnRows(prepareReviewRows(reviews))
+		mock.ExpectRollback()
 
-	courseEntity := []entity.Course{
-		{
-			ID:          uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
-			Name:        "Mock Course",
-			Description: "Mock Course Description",
-			Language:    "en",
-			CourseTags:  mockTags,
-			Gallery: []entity.CourseGallery{
-				{
-					ID:        uuid.MustParse("b2b71fda-f0f2-4358-9722-b3f13c4564a5"),
-					CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
-					URL:       "https://www.google.com",
-					CreatedAt: 121212,
-					UpdatedAt: 121212,
-				},
-			},
-			CreatedAt: 121212,
-			UpdatedAt: 121212,
-		},
-		{
-			ID:          uuid.MustParse("a66280a6-61e4-4806-9fc1-8f5457f413a1"),
-			Name:        "Mock Course 2",
-			Description: "Mock Course Description 2",
-			Language:    "id",
-			CourseTags:  mockTags,
-			Gallery: []entity.CourseGallery{
-				{
-					ID:        uuid.MustParse("d7899f00-3314-487f-a284-75c3916f5605"),
-					CourseID:  uuid.MustParse("a66280a6-61e4-4806-9fc1-8f5457f413a1"),
-					URL:       "https://www.google.com",
-					CreatedAt: 121212,
-					UpdatedAt: 121212,
-				},
-			},
-			CreatedAt: 121212,
-			UpdatedAt: 121212,
-		},
-	}
+		result, err := repo.ReadOneWith(courseEntity.ID, repository.Relations{Reviews: true})
 
-	rows := prepareManyRows(courseEntity).AddRow(
-		courseEntity[1].ID, courseEntity[1].Name, courseEntity[1].Description, courseEntity[1].Language, 121212, 121212,
-		"345c2c39-5a19-4842-bab8-072a53cd020b", "Mock Tag", 121212, 121212,
-		"d7899f00-3314-487f-a284-75c3916f5605", "https://www.google.com", courseEntity[1].ID, 121212, 121212,
-	)
+		assert.NoError(t, err)
+		assert.Equal(t, reviews, result.CourseReviews)
+		assert.Empty(t, result.CourseTags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
-		WillReturnRows(rows)
+	t.Run("Read One With Reviews Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+		mock.ExpectQuery(readCourseReviewsQuery).WithArgs(ids).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
 
-	_, err := repo.ReadMany(10, 0)
-	if err != nil {
-		t.Fatalf("Error while calling ReadOne: %v", err)
-	}
+		_, err := repo.ReadOneWith(courseEntity.ID, repository.Relations{Reviews: true})
 
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
-	}
-}
+		assert.EqualError(t, err, "failed to read course reviews: some error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-func TestRepository_Error(t *testing.T) {
-	db, mock, repo := initializeMockDB(t)
-	defer db.Close()
+	t.Run("Read One With Reviews Scan Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readCourseQuery).WithArgs(courseEntity.ID).WillReturnRows(prepareCourseRow(courseEntity))
+		mock.ExpectQuery(readCourseReviewsQuery).WithArgs(ids).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("invalid id"))
+		mock.ExpectRollback()
 
-	// Mocking the database query error
-	testReadManyErrorQeury(t, mock, repo)
+		_, err := repo.ReadOneWith(courseEntity.ID, repository.Relations{Reviews: true})
 
-	// Mocking the database scan error
-	testReadManyScanError(t, mock, repo)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to scan course review")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
-func testReadManyErrorQeury(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository) {
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
-		WillReturnError(fmt.Errorf("Querry Error"))
-
-	_, err := repo.ReadMany(10, 0)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Querry Error")
+// BenchmarkRepository_ReadOne reads courses with hundreds of lessons. Compare
+// it with BenchmarkRepository_ReadOneJoined, the single joined query it
+// replaced.
+func BenchmarkRepository_ReadOne(b *testing.B) {
+	for _, size := range []struct{ sections, lessons int }{{4, 25}, {20, 25}, {40, 25}} {
+		course := largeCourse(size.sections, size.lessons)
+		b.Run(fmt.Sprintf("%d lessons", size.sections*size.lessons), func(b *testing.B) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				b.Fatalf("Error creating mock database: %v", err)
+			}
+			defer db.Close()
+			repo := repository.NewRepository(db)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				expectReadOne(mock, course)
+				b.StartTimer()
+
+				if _, err := repo.ReadOne(course.ID); err != nil {
+					b.Fatalf("Error while calling ReadOne: %v", err)
+				}
+			}
+
+			rows := 1 + len(course.CourseTags) + len(course.Gallery) + len(course.Sections) + size.sections*size.lessons
+			b.ReportMetric(float64(rows), "rows/op")
+		})
+	}
+}
 
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
+// BenchmarkRepository_ReadOneJoined scans the same courses the way ReadOne
+// did with a single joined query, which returned tags×gallery items×lessons
+// rows, 10000 for the 500 lesson course.
+func BenchmarkRepository_ReadOneJoined(b *testing.B) {
+	for _, size := range []struct{ sections, lessons int }{{4, 25}, {20, 25}, {40, 25}} {
+		course := largeCourse(size.sections, size.lessons)
+		b.Run(fmt.Sprintf("%d lessons", size.sections*size.lessons), func(b *testing.B) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				b.Fatalf("Error creating mock database: %v", err)
+			}
+			defer db.Close()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				mock.ExpectQuery(joinedReadOneQuery).WithArgs(course.ID).WillReturnRows(joinedCourseRows(course))
+				b.StartTimer()
+
+				rows, err := db.Query(joinedReadOneQuery, course.ID)
+				if err != nil {
+					b.Fatalf("Error while querying: %v", err)
+				}
+				_, err = scanJoinedCourse(rows)
+				rows.Close()
+				if err != nil {
+					b.Fatalf("Error while scanning: %v", err)
+				}
+			}
+
+			rows := len(course.CourseTags) * len(course.Gallery) * size.sections * size.lessons
+			b.ReportMetric(float64(rows), "rows/op")
+		})
 	}
 }
 
-func testReadManyScanError(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository) {
-	rows := sqlmock.NewRows([]string{
-		"course_id", "name", "description", "language", "created_at", "updated_at",
-		"tag_id", "tag_name", "tag_created_at", "tag_updated_at",
-		"gallery_id", "gallery_url", "gallery_course_id", "gallery_created_at", "gallery_updated_at",
-	}).AddRow(
-		"18a95d2f-a941-4a64-bbe5-256be7626db2", "mock Name", "mock desc", "en", 121212, 121212,
-		"invalid id", "", 121212, 121212,
-		uuid.Nil, "", uuid.Nil, 0, 0,
-	)
+// scanJoinedCourse is how ReadOne de-duplicated the rows of the joined query,
+// kept as the baseline of BenchmarkRepository_ReadOneJoined.
+func scanJoinedCourse(rows *sql.Rows) (entity.Course, error) {
+	var course entity.Course
+	tagMap := make(map[uuid.UUID]struct{})
+	galleryMap := make(map[uuid.UUID]struct{})
+	sectionMap := make(map[uuid.UUID]struct{})
+
+	for rows.Next() {
+		var tag entity.CourseTags
+		var gallery entity.CourseGallery
+		var section entity.CourseSection
+		var lesson entity.CourseLesson
+
+		err := rows.Scan(&course.ID, &course.Name, &course.Description, &course.Language, &course.CreatedAt, &course.UpdatedAt,
+			&course.Status, &course.PublishAt, &course.OwnerID,
+			&tag.ID, &tag.Name, &tag.CreatedAt, &tag.UpdatedAt,
+			&gallery.ID, &gallery.URL, &gallery.MediaID, &gallery.CourseID, &gallery.CreatedAt, &gallery.UpdatedAt,
+			&section.ID, &section.Name, &section.CourseID, &section.CreatedAt, &section.UpdatedAt,
+			&lesson.ID, &lesson.Title, &lesson.VideoURL, &lesson.MediaID, &lesson.Duration, &lesson.CourseID, &lesson.CourseSectionID, &lesson.CreatedAt, &lesson.UpdatedAt,
+		)
+		if err != nil {
+			return entity.Course{}, err
+		}
 
-	mock.ExpectQuery("SELECT c.id AS course_id, c.name, c.description, c.language, c.created_at, c.updated_at, t.id AS tag_id, t.name AS tag_name, t.created_at, t.updated_at, g.id AS gallery_id, g.url AS gallery_url, g.course_id AS gallery_course_id, g.created_at, g.updated_at FROM courses c LEFT JOIN course_tags_courses tc ON c.id = tc.course_id LEFT JOIN course_tags t ON tc.course_tags_id = t.id LEFT JOIN course_galleries g ON c.id = g.course_id LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
-		WillReturnRows(rows)
+		if _, ok := tagMap[tag.ID]; !ok {
+			tagMap[tag.ID] = struct{}{}
+			course.CourseTags = append(course.CourseTags, tag)
+		}
 
-	_, err := repo.ReadMany(10, 0)
+		if _, ok := galleryMap[gallery.ID]; !ok {
+			galleryMap[gallery.ID] = struct{}{}
+			course.Gallery = append(course.Gallery, gallery)
+		}
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Scan error")
+		if _, ok := sectionMap[section.ID]; !ok {
+			sectionMap[section.ID] = struct{}{}
+			section.Lessons = []entity.CourseLesson{lesson}
+			course.Sections = append(course.Sections, section)
+			continue
+		}
 
-	err = mock.ExpectationsWereMet()
-	if err != nil {
-		t.Fatalf("Expectations not met: %v", err)
+		// Every lesson comes back once per tag and gallery item, so each
+		// row searches the lessons read so far.
+		for i := range course.Sections {
+			if course.Sections[i].ID != section.ID {
+				continue
+			}
+
+			exists := false
+			for _, existing := range course.Sections[i].Lessons {
+				if existing.ID == lesson.ID {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				course.Sections[i].Lessons = append(course.Sections[i].Lessons, lesson)
+			}
+			break
+		}
 	}
+
+	return course, rows.Err()
 }
 
-func TestRepository_Update(t *testing.T) {
+func TestRepository_ReadMany(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
-	courseEntity := MockEntity
+	args := []driver.Value{course_status_enum.Published, "", 10, 0}
 
-	// Test Update Success
-	testUpdateSuccess(t, mock, repo, courseEntity)
+	t.Run("Read Many Success", func(t *testing.T) {
+		expectReadPage(mock, readManyQuery, args, MockArrayEntity)
 
-	// Test Update Begin Transaction Error
-	testUpdateBeginTransactionError(t, mock, repo, courseEntity)
+		result, err := repo.ReadMany(10, 0, "", summaryRelations)
 
-	// Test Update Commit Transaction Error
-	testUpdateBeginTransactionErrorRollback(t, mock, repo, courseEntity)
+		assert.NoError(t, err)
+		assert.Equal(t, MockArrayEntity, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	//Test Update Delete Tags Link Error
-	testUpdateDeleteTagsFailure(t, mock, repo, courseEntity)
+	t.Run("Read Many Keeps Every Tag Of A Course", func(t *testing.T) {
+		courses := []entity.Course{largeCourse(0, 0), MockArrayEntity[1]}
+		expectReadPage(mock, readManyQuery, []driver.Value{course_status_enum.Published, "", 2, 0}, courses)
 
-	//Test Update Link Tags Error
-	testUpdateCourseTagsLinkFailure(t, mock, repo, courseEntity)
+		result, err := repo.ReadMany(2, 0, "", summaryRelations)
 
-	//Test Update Insert Gallery Error
-	testUpdateCourseInsertGallery(t, mock, repo, courseEntity)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Len(t, result[0].CourseTags, 5)
+		assert.Len(t, result[0].Gallery, 4)
+		assert.Equal(t, MockArrayEntity[1], result[1])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	//Test Update Insert Section Error
-	testUpdateCourseInsertSection(t, mock, repo, courseEntity)
+	t.Run("Read Many Empty Page", func(t *testing.T) {
+		expectReadPage(mock, readManyQuery, []driver.Value{course_status_enum.Published, "", 10, 20}, nil)
 
-	//Test Update Insert Lesson Error
-	testUpdateCourseInsertLesson(t, mock, repo, courseEntity)
+		result, err := repo.ReadMany(10, 20, "", summaryRelations)
 
-	//Test Update Commit Transaction Error
-	testUpdateCourseCommitError(t, mock, repo, courseEntity)
-}
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-func testUpdateSuccess(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+	t.Run("Read Many Without Relations", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readManyQuery).WithArgs(args...).WillReturnRows(prepareCourseRow(MockEntity))
+		mock.ExpectRollback()
 
-	// Expect the course details update query
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	// Expect the deletion of existing tags query
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	// Expect linking course to tags queries
-	for _, tag := range courseEntity.CourseTags {
-		mock.ExpectExec(`
-		INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)
-		`).WithArgs(courseEntity.ID, tag.ID).WillReturnResult(sqlmock.NewResult(0, 1))
-	}
+		result, err := repo.ReadMany(10, 0, "", repository.Relations{})
 
-	// Expect gallery update/insert queries
-	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec(`
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET url = $3, updated_at = $5
-		`).WithArgs(
-			galleryItem.ID,
-			courseEntity.ID,
-			galleryItem.URL,
-			galleryItem.CreatedAt,
-			galleryItem.UpdatedAt,
-		).WillReturnResult(sqlmock.NewResult(0, 1))
-	}
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Empty(t, result[0].CourseTags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	// Expect section update/insert queries
-	for _, section := range courseEntity.Sections {
-		mock.ExpectExec(`
-			INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET name = $3, updated_at = $5
-		`).WithArgs(
-			section.ID,
-			courseEntity.ID,
-			section.Name,
-			section.CreatedAt,
-			section.UpdatedAt,
-		).WillReturnResult(sqlmock.NewResult(0, 1))
+	t.Run("Read Many With Lessons", func(t *testing.T) {
+		ids := pq.Array([]string{MockEntity.ID.String()})
+		mock.ExpectBegin()
+		mock.ExpectQuery(readManyQuery).WithArgs(args...).WillReturnRows(prepareCourseRow(MockEntity))
+		mock.ExpectQuery(readCourseSectionsQuery).WithArgs(ids).WillReturnRows(prepareSectionRows(MockEntity))
+		mock.ExpectQuery(readCourseLessonsQuery).WithArgs(ids).WillReturnRows(prepareLessonRows(MockEntity))
+		mock.ExpectRollback()
 
-		// Expect lesson update/insert queries within each section
-		for _, lesson := range section.Lessons {
-			mock.ExpectExec(`
-			INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO UPDATE SET title = $4, video_url = $5, updated_at = $7
-			`).WithArgs(
-				lesson.ID,
-				courseEntity.ID,
-				section.ID,
-				lesson.Title,
-				lesson.VideoURL,
-				lesson.CreatedAt,
-				lesson.UpdatedAt,
-			).WillReturnResult(sqlmock.NewResult(0, 1))
-		}
-	}
+		result, err := repo.ReadMany(10, 0, "", repository.Relations{Sections: true, Lessons: true})
 
-	mock.ExpectCommit()
+		assert.NoError(t, err)
+		assert.Equal(t, MockEntity.Sections, result[0].Sections)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	// Call the method you are testing
-	err := repo.Update(courseEntity.ID, courseEntity)
-	if err != nil {
-		t.Errorf("Unexpected error: %v", err)
-	}
+	t.Run("Read Many Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("begin error"))
 
-	// Check if all expectations were met
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("Unfulfilled expectations: %s", err)
-	}
-}
+		_, err := repo.ReadMany(10, 0, "", summaryRelations)
 
-func testUpdateBeginTransactionError(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin().WillReturnError(errors.New("some error"))
+		assert.EqualError(t, err, "failed to begin transaction: begin error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+	t.Run("Read Many Query Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readManyQuery).WithArgs(args...).WillReturnError(fmt.Errorf("Querry Error"))
+		mock.ExpectRollback()
 
-	assert.Error(t, err)
-	assert.EqualError(t, err, "failed to begin transaction: some error")
+		_, err := repo.ReadMany(10, 0, "", summaryRelations)
 
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
+		assert.EqualError(t, err, "failed to read courses: Querry Error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-func testUpdateBeginTransactionErrorRollback(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+	t.Run("Read Many Scan Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readManyQuery).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("invalid id"))
+		mock.ExpectRollback()
 
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnError(errors.New("some error"))
+		_, err := repo.ReadMany(10, 0, "", summaryRelations)
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to scan course")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+	t.Run("Read Many Tags Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readManyQuery).WithArgs(args...).WillReturnRows(prepareCourseRow(MockEntity))
+		mock.ExpectQuery(readCourseTagsQuery).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
 
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
+		_, err := repo.ReadMany(10, 0, "", summaryRelations)
 
-func testUpdateDeleteTagsFailure(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+		assert.EqualError(t, err, "failed to read course tags: some error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
+	t.Run("Read Many Galleries Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readManyQuery).WithArgs(args...).WillReturnRows(prepareCourseRow(MockEntity))
+		mock.ExpectQuery(readCourseTagsQuery).WillReturnRows(prepareTagRows(MockEntity))
+		mock.ExpectQuery(readCourseGalleriesQuery).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
 
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnError(errors.New("some error"))
+		_, err := repo.ReadMany(10, 0, "", summaryRelations)
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+		assert.EqualError(t, err, "failed to read course galleries: some error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+func TestRepository_Update(t *testing.T) {
+	courseEntity := MockEntity
+	baseline := MockRevision
+	baseline.Message = "initial snapshot"
+	revision := MockRevision
+	readAt := courseEntity.UpdatedAt
 
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
+	// Test Update Success
+	t.Run("Update Success", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
 
-func testUpdateCourseTagsLinkFailure(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+		expectUpdate(mock, courseEntity, baseline, revision, "")
 
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
+		updated, err := repo.Update(courseEntity.ID, readAt, courseEntity, baseline, revision)
 
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	mock.ExpectExec(`INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)`).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
-		WillReturnError(fmt.Errorf("some error"))
+	t.Run("Update Appends Events", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+		expectUpdate(mock, courseEntity, baseline, revision, "", MockEvent)
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+		_, err := repo.Update(courseEntity.ID, readAt, courseEntity, baseline, revision, MockEvent)
 
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-func testUpdateCourseInsertGallery(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+	t.Run("Update Stale Course", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
 
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockCourseQuery).WithArgs(courseEntity.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(readAt + 1))
+		mock.ExpectRollback()
 
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		updated, err := repo.Update(courseEntity.ID, readAt, courseEntity, baseline, revision)
 
-	for _, tag := range courseEntity.CourseTags {
-		mock.ExpectExec(`
-		INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)
-		`).WithArgs(courseEntity.ID, tag.ID).WillReturnResult(sqlmock.NewResult(0, 1))
-	}
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	mock.ExpectExec(`
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET url = $3, updated_at = $5
-		`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnError(fmt.Errorf("some error"))
+	t.Run("Update Deleted Course", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockCourseQuery).WithArgs(courseEntity.ID).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+		updated, err := repo.Update(courseEntity.ID, readAt, courseEntity, baseline, revision)
 
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-func testUpdateCourseInsertSection(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+	//Test Update Begin Transaction Error
+	t.Run("Update Begin Transaction Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
 
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin().WillReturnError(errors.New("some error"))
 
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		_, err := repo.Update(courseEntity.ID, readAt, courseEntity, baseline, revision)
 
-	for _, tag := range courseEntity.CourseTags {
-		mock.ExpectExec(`
-		INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)
-		`).WithArgs(courseEntity.ID, tag.ID).WillReturnResult(sqlmock.NewResult(0, 1))
-	}
+		assert.EqualError(t, err, "failed to begin transaction: some error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 
-	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec(`
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET url = $3, updated_at = $5
-		`).WithArgs(
-			galleryItem.ID,
-			courseEntity.ID,
-			galleryItem.URL,
-			galleryItem.CreatedAt,
-			galleryItem.UpdatedAt,
-		).WillReturnResult(sqlmock.NewResult(0, 1))
+	failures := []struct {
+		step    string
+		message string
+	}{
+		{"lock", "failed to lock course: some error"},
+		{"course", "failed to update course details: some error"},
+		{"deleteTags", "failed to unlink removed tags from the course: some error"},
+		{"linkTag", "failed to link course to tag: some error"},
+		{"deleteGallery", "failed to delete removed gallery items: some error"},
+		{"gallery", "failed to update or insert gallery item: some error"},
+		{"deleteLessons", "failed to delete removed lessons: some error"},
+		{"deleteSections", "failed to delete removed sections: some error"},
+		{"section", "failed to update or insert section: some error"},
+		{"lesson", "failed to update or insert lesson: some error"},
+		{"baseline", "failed to create baseline course revision: some error"},
+		{"revision", "failed to create course revision: some error"},
+		{"commit", "failed to commit transaction: some error"},
 	}
 
-	mock.ExpectExec(`
-			INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET name = $3, updated_at = $5
-		`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnError(fmt.Errorf("some error"))
+	for _, failure := range failures {
+		t.Run("Update Failure "+failure.step, func(t *testing.T) {
+			db, mock, repo := initializeMockDB(t)
+			defer db.Close()
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+			expectUpdate(mock, courseEntity, baseline, revision, failure.step)
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+			_, err := repo.Update(courseEntity.ID, readAt, courseEntity, baseline, revision)
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+			assert.EqualError(t, err, failure.message)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
 }
 
-func testUpdateCourseInsertLesson(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
+// expectUpdate registers the statements Update runs for courseEntity and
+// events, with the course unchanged since it was read. When failAt names a
+// step, that statement returns an error and nothing after it is expected
+// but the rollback.
+func expectUpdate(mock sqlmock.Sqlmock, courseEntity entity.Course, baseline entity.CourseRevision, revision entity.CourseRevision, failAt string, events ...eventEntity.Event) {
+	someError := errors.New("some error")
+
 	mock.ExpectBegin()
 
-	// Expect the course details update query
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	// Expect the deletion of existing tags query
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	// Expect linking course to tags queries
-	for _, tag := range courseEntity.CourseTags {
-		mock.ExpectExec(`
-		INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)
-		`).WithArgs(courseEntity.ID, tag.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	lock := mock.ExpectQuery(lockCourseQuery).WithArgs(courseEntity.ID)
+	if failAt == "lock" {
+		lock.WillReturnError(someError)
+		mock.ExpectRollback()
+		return
+	}
+	lock.WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(courseEntity.UpdatedAt))
+
+	exec := func(step string, query string, args ...driver.Value) bool {
+		expectation := mock.ExpectExec(query).WithArgs(args...)
+		if step == failAt {
+			expectation.WillReturnError(someError)
+			mock.ExpectRollback()
+			return false
+		}
+		expectation.WillReturnResult(sqlmock.NewResult(0, 1))
+		return true
 	}
 
-	// Expect gallery update/insert queries
-	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec(`
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET url = $3, updated_at = $5
-		`).WithArgs(
-			galleryItem.ID,
-			courseEntity.ID,
-			galleryItem.URL,
-			galleryItem.CreatedAt,
-			galleryItem.UpdatedAt,
-		).WillReturnResult(sqlmock.NewResult(0, 1))
+	if !exec("course", "UPDATE courses SET name = $1, description = $2, language = $3, updated_at = $4 WHERE id = $5",
+		courseEntity.Name, courseEntity.Description, courseEntity.Language, courseEntity.UpdatedAt, courseEntity.ID) {
+		return
 	}
 
-	mock.ExpectExec(`
-			INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET name = $3, updated_at = $5
-		`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	mock.ExpectExec(`
-			INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO UPDATE SET title = $4, video_url = $5, updated_at = $7
-			`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnError(fmt.Errorf("some error"))
-
-	// Call the method you are testing
-	err := repo.Update(courseEntity.ID, courseEntity)
+	if !exec("deleteTags", "DELETE FROM course_tags_courses WHERE course_id = $1 AND NOT (course_tags_id = ANY($2::uuid[]))",
+		courseEntity.ID, sqlmock.AnyArg()) {
+		return
+	}
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+	for _, tag := range courseEntity.CourseTags {
+		if !exec("linkTag", "INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			courseEntity.ID, tag.ID) {
+			return
+		}
+	}
 
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
+	if !exec("deleteGallery", "DELETE FROM course_galleries WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))",
+		courseEntity.ID, sqlmock.AnyArg()) {
+		return
+	}
 
-func testUpdateCourseCommitError(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
-	mock.ExpectBegin()
+	for _, galleryItem := range courseEntity.Gallery {
+		if !exec("gallery", "INSERT INTO course_galleries (id, course_id, url, media_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (id) DO UPDATE SET url = $3, media_id = $4, updated_at = $6",
+			galleryItem.ID, courseEntity.ID, galleryItem.URL, galleryItem.MediaID, galleryItem.CreatedAt, galleryItem.UpdatedAt) {
+			return
+		}
+	}
 
-	// Expect the course details update query
-	mock.ExpectExec(`UPDATE courses SET name = $1, description = $2 , language = $3, updated_at = $4 WHERE id = $5`).WithArgs(
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-		sqlmock.AnyArg(),
-	).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	// Expect the deletion of existing tags query
-	mock.ExpectExec(`
-		DELETE FROM course_tags_courses
-		WHERE course_id = $1
-	`).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
-
-	// Expect linking course to tags queries
-	for _, tag := range courseEntity.CourseTags {
-		mock.ExpectExec(`
-		INSERT INTO course_tags_courses (course_id, course_tags_id) VALUES ($1, $2)
-		`).WithArgs(courseEntity.ID, tag.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	if !exec("deleteLessons", "DELETE FROM course_lessons WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))",
+		courseEntity.ID, sqlmock.AnyArg()) {
+		return
 	}
 
-	// Expect gallery update/insert queries
-	for _, galleryItem := range courseEntity.Gallery {
-		mock.ExpectExec(`
-			INSERT INTO course_galleries (id, course_id, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET url = $3, updated_at = $5
-		`).WithArgs(
-			galleryItem.ID,
-			courseEntity.ID,
-			galleryItem.URL,
-			galleryItem.CreatedAt,
-			galleryItem.UpdatedAt,
-		).WillReturnResult(sqlmock.NewResult(0, 1))
+	if !exec("deleteSections", "DELETE FROM course_sections WHERE course_id = $1 AND NOT (id = ANY($2::uuid[]))",
+		courseEntity.ID, sqlmock.AnyArg()) {
+		return
 	}
 
-	// Expect section update/insert queries
 	for _, section := range courseEntity.Sections {
-		mock.ExpectExec(`
-			INSERT INTO course_sections (id, course_id, name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE SET name = $3, updated_at = $5
-		`).WithArgs(
-			section.ID,
-			courseEntity.ID,
-			section.Name,
-			section.CreatedAt,
-			section.UpdatedAt,
-		).WillReturnResult(sqlmock.NewResult(0, 1))
+		if !exec("section", "INSERT INTO course_sections (id, course_id, name, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (id) DO UPDATE SET name = $3, position = $4, updated_at = $6",
+			section.ID, courseEntity.ID, section.Name, section.Position, section.CreatedAt, section.UpdatedAt) {
+			return
+		}
 
-		// Expect lesson update/insert queries within each section
 		for _, lesson := range section.Lessons {
-			mock.ExpectExec(`
-			INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO UPDATE SET title = $4, video_url = $5, updated_at = $7
-			`).WithArgs(
-				lesson.ID,
-				courseEntity.ID,
-				section.ID,
-				lesson.Title,
-				lesson.VideoURL,
-				lesson.CreatedAt,
-				lesson.UpdatedAt,
-			).WillReturnResult(sqlmock.NewResult(0, 1))
+			if !exec("lesson", "INSERT INTO course_lessons (id, course_id, course_section_id, title, video_url, media_id, duration, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (id) DO UPDATE SET course_section_id = $3, title = $4, video_url = $5, media_id = $6, duration = $7, position = $8, updated_at = $10",
+				lesson.ID, courseEntity.ID, section.ID, lesson.Title, lesson.VideoURL, lesson.MediaID, lesson.Duration, lesson.Position, lesson.CreatedAt, lesson.UpdatedAt) {
+				return
+			}
 		}
 	}
 
-	mock.ExpectCommit().WillReturnError(fmt.Errorf("some error"))
+	if !exec("baseline", createBaselineRevisionQuery,
+		baseline.ID, courseEntity.ID, baseline.Message, baseline.Snapshot, baseline.CreatedBy, baseline.CreatedAt) {
+		return
+	}
 
-	err := repo.Update(courseEntity.ID, courseEntity)
+	if !exec("revision", createRevisionQuery,
+		revision.ID, courseEntity.ID, revision.Message, revision.Snapshot, revision.CreatedBy, revision.CreatedAt) {
+		return
+	}
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "some error")
+	for _, event := range events {
+		expectAppendEvent(mock, event)
+	}
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+	if failAt == "commit" {
+		mock.ExpectCommit().WillReturnError(someError)
+		return
+	}
+	mock.ExpectCommit()
 }
 
 func TestRepository_Delete(t *testing.T) {
@@ -1091,6 +1146,12 @@ func TestRepository_Delete(t *testing.T) {
 	//Test Course Section Delete Error
 	testCourseDeleteSectionErrors(t, mock, repo, courseEntity)
 
+	//Test Course Enrollment Delete Error
+	testCourseDeleteEnrollmentErrors(t, mock, repo, courseEntity)
+
+	//Test Course Instructor Delete Error
+	testCourseDeleteInstructorErrors(t, mock, repo, courseEntity)
+
 	//Test Delete Course Errors
 	testDeleteCourseErrors(t, mock, repo, courseEntity)
 
@@ -1106,6 +1167,8 @@ func testCourseDeleteSuccess(t *testing.T, mock sqlmock.Sqlmock, repo repository
 	mock.ExpectExec(`DELETE FROM course_galleries WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM course_lessons WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM course_sections WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_enrollments WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_instructors WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM courses WHERE id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -1206,6 +1269,41 @@ func testCourseDeleteSectionErrors(t *testing.T, mock sqlmock.Sqlmock, repo repo
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func testCourseDeleteEnrollmentErrors(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM course_tags_courses WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_reviews_courses WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_galleries WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_lessons WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_sections WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_enrollments WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnError(fmt.Errorf("some error"))
+
+	err := repo.Delete(courseEntity.ID)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func testCourseDeleteInstructorErrors(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM course_tags_courses WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_reviews_courses WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_galleries WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_lessons WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_sections WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_enrollments WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_instructors WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnError(fmt.Errorf("some error"))
+
+	err := repo.Delete(courseEntity.ID)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func testDeleteCourseErrors(t *testing.T, mock sqlmock.Sqlmock, repo repository.CourseRepository, courseEntity entity.Course) {
 	mock.ExpectBegin()
 	mock.ExpectExec(`DELETE FROM course_tags_courses WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -1213,6 +1311,8 @@ func testDeleteCourseErrors(t *testing.T, mock sqlmock.Sqlmock, repo repository.
 	mock.ExpectExec(`DELETE FROM course_galleries WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM course_lessons WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM course_sections WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_enrollments WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_instructors WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM courses WHERE id = $1`).WithArgs(courseEntity.ID).WillReturnError(fmt.Errorf("some error"))
 
 	err := repo.Delete(courseEntity.ID)
@@ -1230,6 +1330,8 @@ func testDeleteCourseCommitErrors(t *testing.T, mock sqlmock.Sqlmock, repo repos
 	mock.ExpectExec(`DELETE FROM course_galleries WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM course_lessons WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM course_sections WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_enrollments WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM course_instructors WHERE course_id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`DELETE FROM courses WHERE id = $1`).WithArgs(courseEntity.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit().WillReturnError(fmt.Errorf("some error"))
 
@@ -1240,3 +1342,808 @@ func testDeleteCourseCommitErrors(t *testing.T, mock sqlmock.Sqlmock, repo repos
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestRepository_UpdateStatus(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	courseEntity := MockEntity
+	publishAt := int64(131313)
+
+	mock.ExpectExec("UPDATE courses SET status = $1, publish_at = $2, updated_at = $3 WHERE id = $4").
+		WithArgs(course_status_enum.Review, &publishAt, int64(121212), courseEntity.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdateStatus(courseEntity.ID, course_status_enum.Review, &publishAt, 121212)
+	assert.NoError(t, err)
+
+	mock.ExpectExec("UPDATE courses SET status = $1, publish_at = $2, updated_at = $3 WHERE id = $4").
+		WithArgs(course_status_enum.Archived, nil, int64(121212), courseEntity.ID).
+		WillReturnError(errors.New("some error"))
+
+	err = repo.UpdateStatus(courseEntity.ID, course_status_enum.Archived, nil, 121212)
+	assert.EqualError(t, err, "failed to update course status: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_PublishDue(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE courses SET status = $1, publish_at = NULL, updated_at = $2 WHERE status = $3 AND publish_at IS NOT NULL AND publish_at <= $2").
+		WithArgs(course_status_enum.Published, int64(121212), course_status_enum.Review).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	published, err := repo.PublishDue(121212)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), published)
+
+	mock.ExpectExec("UPDATE courses SET status = $1, publish_at = NULL, updated_at = $2 WHERE status = $3 AND publish_at IS NOT NULL AND publish_at <= $2").
+		WithArgs(course_status_enum.Published, int64(121212), course_status_enum.Review).
+		WillReturnError(errors.New("some error"))
+
+	_, err = repo.PublishDue(121212)
+	assert.EqualError(t, err, "failed to publish scheduled courses: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadRevisions(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	revision := MockRevision
+	query := "SELECT id, course_id, revision, message, created_by, created_at FROM course_revisions WHERE course_id = $1 ORDER BY revision DESC"
+
+	rows := sqlmock.NewRows([]string{"id", "course_id", "revision", "message", "created_by", "created_at"}).
+		AddRow(revision.ID, revision.CourseID, 2, revision.Message, revision.CreatedBy, revision.CreatedAt).
+		AddRow(uuid.New(), revision.CourseID, 1, "initial snapshot", revision.CreatedBy, revision.CreatedAt)
+
+	mock.ExpectQuery(query).WithArgs(revision.CourseID).WillReturnRows(rows)
+
+	revisions, err := repo.ReadRevisions(revision.CourseID)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, 2, revisions[0].Revision)
+	assert.Empty(t, revisions[0].Snapshot)
+
+	mock.ExpectQuery(query).WithArgs(revision.CourseID).WillReturnError(errors.New("some error"))
+
+	_, err = repo.ReadRevisions(revision.CourseID)
+	assert.EqualError(t, err, "failed to read course revisions: some error")
+
+	mock.ExpectQuery(query).WithArgs(revision.CourseID).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "course_id", "revision", "message", "created_by", "created_at"}).
+			AddRow("invalid id", revision.CourseID, 1, revision.Message, revision.CreatedBy, revision.CreatedAt),
+	)
+
+	_, err = repo.ReadRevisions(revision.CourseID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to scan course revision")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadRevision(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	revision := MockRevision
+	query := "SELECT id, course_id, revision, message, snapshot, created_by, created_at FROM course_revisions WHERE course_id = $1 AND revision = $2"
+
+	mock.ExpectQuery(query).WithArgs(revision.CourseID, revision.Revision).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "course_id", "revision", "message", "snapshot", "created_by", "created_at"}).
+			AddRow(revision.ID, revision.CourseID, revision.Revision, revision.Message, revision.Snapshot, revision.CreatedBy, revision.CreatedAt),
+	)
+
+	result, err := repo.ReadRevision(revision.CourseID, revision.Revision)
+	assert.NoError(t, err)
+	assert.Equal(t, revision, result)
+
+	mock.ExpectQuery(query).WithArgs(revision.CourseID, 99).WillReturnError(sql.ErrNoRows)
+
+	result, err = repo.ReadRevision(revision.CourseID, 99)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.Nil, result.ID)
+
+	mock.ExpectQuery(query).WithArgs(revision.CourseID, revision.Revision).WillReturnError(errors.New("some error"))
+
+	_, err = repo.ReadRevision(revision.CourseID, revision.Revision)
+	assert.EqualError(t, err, "failed to read course revision: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadInstructors(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT ci.course_id, ci.user_id, ci.role, ci.created_at, ci.updated_at, COALESCE(u.name, ''), COALESCE(u.profile_picture, '') FROM course_instructors ci LEFT JOIN users u ON u.id = ci.user_id WHERE ci.course_id = ANY($1::uuid[]) ORDER BY ci.created_at, ci.user_id"
+	columns := []string{"course_id", "user_id", "role", "created_at", "updated_at", "name", "profile_picture"}
+
+	rows := sqlmock.NewRows(columns)
+	for _, instructor := range MockInstructors {
+		rows.AddRow(instructor.CourseID, instructor.UserID, instructor.Role, instructor.CreatedAt, instructor.UpdatedAt, instructor.Profile.Name, instructor.Profile.ProfilePicture)
+	}
+	mock.ExpectQuery(query).WithArgs(pq.Array([]string{MockEntity.ID.String()})).WillReturnRows(rows)
+
+	instructors, err := repo.ReadInstructors([]uuid.UUID{MockEntity.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, MockInstructors, instructors)
+
+	mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+	_, err = repo.ReadInstructors([]uuid.UUID{MockEntity.ID})
+	assert.EqualError(t, err, "failed to read course instructors: some error")
+
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns).AddRow("invalid-uuid", "user", "owner", 0, 0, "", ""))
+
+	_, err = repo.ReadInstructors([]uuid.UUID{MockEntity.ID})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to scan course instructor")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddInstructor(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	instructor := MockInstructors[1]
+	query := "INSERT INTO course_instructors (course_id, user_id, role, created_at, updated_at) SELECT $1, u.id, $3, $4, $5 FROM users u WHERE u.id = $2 ON CONFLICT (course_id, user_id) DO UPDATE SET role = $3, updated_at = $5"
+
+	mock.ExpectExec(query).
+		WithArgs(instructor.CourseID, instructor.UserID, instructor.Role, instructor.CreatedAt, instructor.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	affected, err := repo.AddInstructor(instructor)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), affected)
+
+	mock.ExpectExec(query).WillReturnError(errors.New("some error"))
+
+	_, err = repo.AddInstructor(instructor)
+	assert.EqualError(t, err, "failed to add course instructor: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RemoveInstructor(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "DELETE FROM course_instructors WHERE course_id = $1 AND user_id = $2"
+
+	mock.ExpectExec(query).WithArgs(MockEntity.ID, "editor-uid").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.RemoveInstructor(MockEntity.ID, "editor-uid")
+	assert.NoError(t, err)
+
+	mock.ExpectExec(query).WithArgs(MockEntity.ID, "editor-uid").WillReturnError(errors.New("some error"))
+
+	err = repo.RemoveInstructor(MockEntity.ID, "editor-uid")
+	assert.EqualError(t, err, "failed to remove course instructor: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadManyByInstructor(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	expectReadPage(mock, readManyByInstructorQuery, []driver.Value{"instructor-uid", course_status_enum.Published, "viewer-uid", 10, 0}, MockArrayEntity[:1])
+
+	courses, err := repo.ReadManyByInstructor("instructor-uid", 10, 0, "viewer-uid", summaryRelations)
+	assert.NoError(t, err)
+	assert.Equal(t, MockArrayEntity[:1], courses)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(readManyByInstructorQuery).WillReturnError(errors.New("some error"))
+	mock.ExpectRollback()
+
+	_, err = repo.ReadManyByInstructor("instructor-uid", 10, 0, "viewer-uid", summaryRelations)
+	assert.EqualError(t, err, "failed to read courses: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Enroll(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	enrollment := entity.CourseEnrollment{CourseID: MockEntity.ID, UserID: "student-uid", CreatedAt: 131313}
+	query := "INSERT INTO course_enrollments (course_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT (course_id, user_id) DO UPDATE SET created_at = course_enrollments.created_at RETURNING created_at, xmax = 0"
+
+	t.Run("Enroll Appends Event", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).
+			WithArgs(enrollment.CourseID, enrollment.UserID, enrollment.CreatedAt).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "inserted"}).AddRow(131313, true))
+		expectAppendEvent(mock, MockEvent)
+		mock.ExpectCommit()
+
+		createdAt, err := repo.Enroll(enrollment, MockEvent)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(131313), createdAt)
+	})
+
+	t.Run("Enroll Again Keeps Date Without Event", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).
+			WithArgs(enrollment.CourseID, enrollment.UserID, enrollment.CreatedAt).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "inserted"}).AddRow(121212, false))
+		mock.ExpectCommit()
+
+		createdAt, err := repo.Enroll(enrollment, MockEvent)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(121212), createdAt)
+	})
+
+	t.Run("Enroll Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
+
+		_, err := repo.Enroll(enrollment, MockEvent)
+		assert.EqualError(t, err, "failed to enroll in course: some error")
+	})
+
+	t.Run("Enroll Append Event Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "inserted"}).AddRow(131313, true))
+		mock.ExpectExec(appendEventQuery).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
+
+		_, err := repo.Enroll(enrollment, MockEvent)
+		assert.EqualError(t, err, "failed to append event: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_IsEnrolled(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT EXISTS (SELECT 1 FROM course_enrollments WHERE course_id = $1 AND user_id = $2)"
+
+	mock.ExpectQuery(query).WithArgs(MockEntity.ID, "student-uid").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	enrolled, err := repo.IsEnrolled(MockEntity.ID, "student-uid")
+	assert.NoError(t, err)
+	assert.True(t, enrolled)
+
+	mock.ExpectQuery(query).WithArgs(MockEntity.ID, "student-uid").WillReturnError(errors.New("some error"))
+
+	_, err = repo.IsEnrolled(MockEntity.ID, "student-uid")
+	assert.EqualError(t, err, "failed to read course enrollment: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadEnrolledUserIDs(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT user_id FROM course_enrollments WHERE course_id = $1 ORDER BY created_at"
+
+	t.Run("Read Enrolled User IDs Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(MockEntity.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow("student-uid").AddRow("other-uid"))
+
+		userIDs, err := repo.ReadEnrolledUserIDs(MockEntity.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"student-uid", "other-uid"}, userIDs)
+	})
+
+	t.Run("Read Enrolled User IDs Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadEnrolledUserIDs(MockEntity.ID)
+		assert.EqualError(t, err, "failed to read enrolled users: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateLessonDuration(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mediaID := uuid.MustParse("9d4b2c6e-7a1f-4e3b-8c5d-2f6a9b0e1c33")
+	query := "UPDATE course_lessons SET duration = $1, updated_at = $2 WHERE media_id = $3"
+
+	mock.ExpectExec(query).WithArgs(94, int64(131313), mediaID).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.UpdateLessonDuration(mediaID, 94, 131313)
+	assert.NoError(t, err)
+
+	mock.ExpectExec(query).WithArgs(94, int64(131313), mediaID).WillReturnError(errors.New("some error"))
+
+	err = repo.UpdateLessonDuration(mediaID, 94, 131313)
+	assert.EqualError(t, err, "failed to update lesson duration: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CompleteLesson(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	lessonID := MockEntity.Sections[0].Lessons[0].ID
+	progress := entity.CourseLessonProgress{LessonID: lessonID, UserID: "student-uid", CourseID: MockEntity.ID, CompletedAt: 131313}
+	query := "INSERT INTO course_lesson_progress (lesson_id, user_id, course_id, completed_at) VALUES ($1, $2, $3, $4) ON CONFLICT (lesson_id, user_id) DO UPDATE SET completed_at = course_lesson_progress.completed_at RETURNING completed_at, xmax = 0"
+
+	t.Run("Complete Lesson Appends Event", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).
+			WithArgs(lessonID, "student-uid", MockEntity.ID, int64(131313)).
+			WillReturnRows(sqlmock.NewRows([]string{"completed_at", "inserted"}).AddRow(131313, true))
+		expectAppendEvent(mock, MockEvent)
+		mock.ExpectCommit()
+
+		completedAt, err := repo.CompleteLesson(progress, MockEvent)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(131313), completedAt)
+	})
+
+	t.Run("Complete Lesson Again Keeps Date Without Event", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).
+			WithArgs(lessonID, "student-uid", MockEntity.ID, int64(131313)).
+			WillReturnRows(sqlmock.NewRows([]string{"completed_at", "inserted"}).AddRow(121212, false))
+		mock.ExpectCommit()
+
+		completedAt, err := repo.CompleteLesson(progress, MockEvent)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(121212), completedAt)
+	})
+
+	t.Run("Complete Lesson Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
+
+		_, err := repo.CompleteLesson(progress, MockEvent)
+		assert.EqualError(t, err, "failed to complete lesson: some error")
+	})
+
+	t.Run("Complete Lesson Commit Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"completed_at", "inserted"}).AddRow(131313, false))
+		mock.ExpectCommit().WillReturnError(errors.New("some error"))
+
+		_, err := repo.CompleteLesson(progress, MockEvent)
+		assert.EqualError(t, err, "failed to commit transaction: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadCompletedLessons(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	lessonID := MockEntity.Sections[0].Lessons[0].ID
+	query := "SELECT lesson_id FROM course_lesson_progress WHERE course_id = $1 AND user_id = $2"
+
+	t.Run("Read Completed Lessons Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(MockEntity.ID, "student-uid").
+			WillReturnRows(sqlmock.NewRows([]string{"lesson_id"}).AddRow(lessonID))
+
+		lessonIDs, err := repo.ReadCompletedLessons(MockEntity.ID, "student-uid")
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{lessonID}, lessonIDs)
+	})
+
+	t.Run("Read Completed Lessons Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadCompletedLessons(MockEntity.ID, "student-uid")
+		assert.EqualError(t, err, "failed to read completed lessons: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadLessonCourseID(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	lessonID := MockEntity.Sections[0].Lessons[0].ID
+	query := "SELECT course_id FROM course_lessons WHERE id = $1"
+
+	t.Run("Read Lesson Course Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(lessonID).
+			WillReturnRows(sqlmock.NewRows([]string{"course_id"}).AddRow(MockEntity.ID))
+
+		courseID, err := repo.ReadLessonCourseID(lessonID)
+		assert.NoError(t, err)
+		assert.Equal(t, MockEntity.ID, courseID)
+	})
+
+	t.Run("Read Lesson Course Not Found", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(lessonID).WillReturnError(sql.ErrNoRows)
+
+		courseID, err := repo.ReadLessonCourseID(lessonID)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, courseID)
+	})
+
+	t.Run("Read Lesson Course Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadLessonCourseID(lessonID)
+		assert.EqualError(t, err, "failed to read lesson course: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadUserEnrollments(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT course_id, user_id, created_at FROM course_enrollments WHERE user_id = $1 ORDER BY created_at"
+
+	t.Run("Read User Enrollments Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs("student-uid").
+			WillReturnRows(sqlmock.NewRows([]string{"course_id", "user_id", "created_at"}).AddRow(MockEntity.ID, "student-uid", 121212))
+
+		enrollments, err := repo.ReadUserEnrollments("student-uid")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.CourseEnrollment{{CourseID: MockEntity.ID, UserID: "student-uid", CreatedAt: 121212}}, enrollments)
+	})
+
+	t.Run("Read User Enrollments Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadUserEnrollments("student-uid")
+		assert.EqualError(t, err, "failed to read enrollments: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadUserProgress(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT lesson_id, user_id, course_id, completed_at FROM course_lesson_progress WHERE user_id = $1 ORDER BY completed_at"
+	lessonID := MockEntity.Sections[0].Lessons[0].ID
+
+	t.Run("Read User Progress Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs("student-uid").
+			WillReturnRows(sqlmock.NewRows([]string{"lesson_id", "user_id", "course_id", "completed_at"}).AddRow(lessonID, "student-uid", MockEntity.ID, 131313))
+
+		progress, err := repo.ReadUserProgress("student-uid")
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.CourseLessonProgress{{LessonID: lessonID, UserID: "student-uid", CourseID: MockEntity.ID, CompletedAt: 131313}}, progress)
+	})
+
+	t.Run("Read User Progress Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadUserProgress("student-uid")
+		assert.EqualError(t, err, "failed to read lesson progress: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadUserReviews(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT id, course_id, user_id, value, comment, hidden_at, created_at, updated_at FROM course_reviews WHERE user_id::text = $1 ORDER BY created_at"
+	review := entity.CourseReviews{
+		ID:        uuid.MustParse("0b4bd5e1-3c8f-4b5a-9d6e-7f8a9b0c1d2e"),
+		CourseID:  MockEntity.ID,
+		UserID:    uuid.MustParse("1c5ce6f2-4d9a-4c6b-8e7f-8a9b0c1d2e3f"),
+		Value:     5,
+		Comment:   "Great course",
+		CreatedAt: 121212,
+		UpdatedAt: 121212,
+	}
+
+	t.Run("Read User Reviews Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(review.UserID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "course_id", "user_id", "value", "comment", "hidden_at", "created_at", "updated_at"}).
+				AddRow(review.ID, review.CourseID, review.UserID, review.Value, review.Comment, nil, review.CreatedAt, review.UpdatedAt))
+
+		reviews, err := repo.ReadUserReviews(review.UserID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.CourseReviews{review}, reviews)
+	})
+
+	t.Run("Read User Reviews Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadUserReviews(review.UserID.String())
+		assert.EqualError(t, err, "failed to read reviews: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_EraseUserData(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	eraseProgressQuery := "DELETE FROM course_lesson_progress WHERE user_id = $1"
+	eraseEnrollmentsQuery := "DELETE FROM course_enrollments WHERE user_id = $1"
+	anonymiseReviewsQuery := "UPDATE course_reviews SET user_id = NULL WHERE user_id::text = $1"
+
+	t.Run("Erase User Data Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseProgressQuery).WithArgs("student-uid").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(eraseEnrollmentsQuery).WithArgs("student-uid").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(anonymiseReviewsQuery).WithArgs("student-uid").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.EraseUserData("student-uid")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Erase User Data Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseProgressQuery).WithArgs("student-uid").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(eraseEnrollmentsQuery).WithArgs("student-uid").WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
+
+		err := repo.EraseUserData("student-uid")
+		assert.EqualError(t, err, "failed to erase enrollments: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadTags(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "SELECT t.id, t.name, t.slug, t.parent_id, t.created_at, t.updated_at, COUNT(c.id) FROM course_tags t LEFT JOIN course_tags_courses tc ON tc.course_tags_id = t.id LEFT JOIN courses c ON c.id = tc.course_id AND c.status = $1 GROUP BY t.id ORDER BY t.name"
+
+	t.Run("Read Tags Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "name", "slug", "parent_id", "created_at", "updated_at", "count"})
+		for i, tag := range mockTags {
+			rows.AddRow(tag.ID, tag.Name, tag.Slug, nullableUUID(tag.ParentID), tag.CreatedAt, tag.UpdatedAt, i+1)
+		}
+		mock.ExpectQuery(query).WithArgs(course_status_enum.Published).WillReturnRows(rows)
+
+		tags, err := repo.ReadTags()
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.CourseTagCount{
+			{CourseTags: mockTags[0], CourseCount: 1},
+			{CourseTags: mockTags[1], CourseCount: 2},
+		}, tags)
+	})
+
+	t.Run("Read Tags Scan Error", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "name", "slug", "parent_id", "created_at", "updated_at", "count"}).
+			AddRow("invalid id", "", "", nil, 0, 0, 0)
+		mock.ExpectQuery(query).WillReturnRows(rows)
+
+		_, err := repo.ReadTags()
+		assert.ErrorContains(t, err, "failed to scan tag")
+	})
+
+	t.Run("Read Tags Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadTags()
+		assert.EqualError(t, err, "failed to read tags: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadTag(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	tag := mockTags[1]
+	query := "SELECT id, name, slug, parent_id, created_at, updated_at FROM course_tags WHERE id = $1"
+
+	t.Run("Read Tag Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(tag.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "parent_id", "created_at", "updated_at"}).
+				AddRow(tag.ID, tag.Name, tag.Slug, nullableUUID(tag.ParentID), tag.CreatedAt, tag.UpdatedAt))
+
+		result, err := repo.ReadTag(tag.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, tag, result)
+	})
+
+	t.Run("Read Tag Not Found", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(tag.ID).WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.ReadTag(tag.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.CourseTags{}, result)
+	})
+
+	t.Run("Read Tag Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadTag(tag.ID)
+		assert.EqualError(t, err, "failed to read tag: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadTagsByIDs(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	ids := []uuid.UUID{mockTags[0].ID, uuid.New()}
+	query := "SELECT id, name, slug, parent_id, created_at, updated_at FROM course_tags WHERE id = ANY($1::uuid[])"
+
+	t.Run("Read Tags By IDs Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(pq.Array([]string{ids[0].String(), ids[1].String()})).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "parent_id", "created_at", "updated_at"}).
+				AddRow(mockTags[0].ID, mockTags[0].Name, mockTags[0].Slug, nil, mockTags[0].CreatedAt, mockTags[0].UpdatedAt))
+
+		tags, err := repo.ReadTagsByIDs(ids)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.CourseTags{mockTags[0]}, tags)
+	})
+
+	t.Run("Read Tags By IDs Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadTagsByIDs(ids)
+		assert.EqualError(t, err, "failed to read tags: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadTagAncestorIDs(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	query := "WITH RECURSIVE ancestors AS ( SELECT id, parent_id, 1 AS depth FROM course_tags WHERE id = $1 UNION ALL SELECT t.id, t.parent_id, a.depth + 1 FROM course_tags t JOIN ancestors a ON t.id = a.parent_id WHERE a.depth < $2 ) SELECT id FROM ancestors ORDER BY depth"
+
+	t.Run("Read Tag Ancestors Success", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(mockTags[1].ID, 100).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockTags[1].ID).AddRow(mockTags[0].ID))
+
+		ids, err := repo.ReadTagAncestorIDs(mockTags[1].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{mockTags[1].ID, mockTags[0].ID}, ids)
+	})
+
+	t.Run("Read Tag Ancestors Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("some error"))
+
+		_, err := repo.ReadTagAncestorIDs(mockTags[1].ID)
+		assert.EqualError(t, err, "failed to read tag ancestors: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateTag(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	tag := mockTags[1]
+	query := "INSERT INTO course_tags (id, name, slug, parent_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING"
+
+	mock.ExpectExec(query).
+		WithArgs(tag.ID, tag.Name, tag.Slug, tag.ParentID, tag.CreatedAt, tag.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	created, err := repo.CreateTag(tag)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), created)
+
+	mock.ExpectExec(query).WillReturnError(errors.New("some error"))
+
+	_, err = repo.CreateTag(tag)
+	assert.EqualError(t, err, "failed to create tag: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateTag(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	tag := mockTags[1]
+	query := "UPDATE course_tags SET name = $2, slug = $3, parent_id = $4, updated_at = $5 WHERE id = $1 AND NOT EXISTS ( SELECT 1 FROM course_tags o WHERE o.id <> $1 AND (o.name = $2 OR o.slug = $3) )"
+
+	mock.ExpectExec(query).
+		WithArgs(tag.ID, tag.Name, tag.Slug, tag.ParentID, tag.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	updated, err := repo.UpdateTag(tag)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+
+	mock.ExpectExec(query).WillReturnError(errors.New("some error"))
+
+	_, err = repo.UpdateTag(tag)
+	assert.EqualError(t, err, "failed to update tag: some error")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteTag(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	unlinkQuery := "DELETE FROM course_tags_courses WHERE course_tags_id = $1"
+	reparentQuery := "UPDATE course_tags SET parent_id = (SELECT parent_id FROM course_tags WHERE id = $1) WHERE parent_id = $1"
+	deleteQuery := "DELETE FROM course_tags WHERE id = $1"
+
+	t.Run("Delete Tag Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(unlinkQuery).WithArgs(mockTags[0].ID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(reparentQuery).WithArgs(mockTags[0].ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(deleteQuery).WithArgs(mockTags[0].ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.DeleteTag(mockTags[0].ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete Tag Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(unlinkQuery).WithArgs(mockTags[0].ID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(reparentQuery).WithArgs(mockTags[0].ID).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
+
+		err := repo.DeleteTag(mockTags[0].ID)
+		assert.EqualError(t, err, "failed to move up the children of the tag: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MergeTags(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	source, target := mockTags[1].ID, mockTags[0].ID
+	relinkQuery := "INSERT INTO course_tags_courses (course_id, course_tags_id) SELECT course_id, $2 FROM course_tags_courses WHERE course_tags_id = $1 ON CONFLICT DO NOTHING"
+	unlinkQuery := "DELETE FROM course_tags_courses WHERE course_tags_id = $1"
+	reparentQuery := "UPDATE course_tags SET parent_id = $2 WHERE parent_id = $1"
+	deleteQuery := "DELETE FROM course_tags WHERE id = $1"
+
+	t.Run("Merge Tags Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(relinkQuery).WithArgs(source, target).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(unlinkQuery).WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(reparentQuery).WithArgs(source, target).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(deleteQuery).WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.MergeTags(source, target)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Merge Tags Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(relinkQuery).WithArgs(source, target).WillReturnError(errors.New("some error"))
+		mock.ExpectRollback()
+
+		err := repo.MergeTags(source, target)
+		assert.EqualError(t, err, "failed to move courses to the merged tag: some error")
+	})
+
+	t.Run("Merge Tags Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("some error"))
+
+		err := repo.MergeTags(source, target)
+		assert.EqualError(t, err, "failed to begin transaction: some error")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
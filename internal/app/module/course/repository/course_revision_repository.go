@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// createRevisionQuery numbers revisions per course, starting at 1. It runs
+// while the course row is locked, so concurrent edits cannot both take the
+// same number.
+const createRevisionQuery = `
+	INSERT INTO course_revisions (id, course_id, revision, message, snapshot, created_by, created_at)
+	SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5, $6
+	FROM course_revisions
+	WHERE course_id = $2
+`
+
+// createBaselineRevisionQuery records the state of a course that predates
+// revision history as its first revision, unless it already has one.
+const createBaselineRevisionQuery = `
+	INSERT INTO course_revisions (id, course_id, revision, message, snapshot, created_by, created_at)
+	SELECT $1, $2, 1, $3, $4, $5, $6
+	WHERE NOT EXISTS (SELECT 1 FROM course_revisions WHERE course_id = $2)
+`
+
+func (r *Repository) ReadRevisions(courseID uuid.UUID) ([]entity.CourseRevision, error) {
+	query := `
+		SELECT id, course_id, revision, message, created_by, created_at
+		FROM course_revisions
+		WHERE course_id = $1
+		ORDER BY revision DESC
+	`
+
+	rows, err := r.db.Query(query, courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read course revisions: %v", err)
+	}
+	defer rows.Close()
+
+	var revisions []entity.CourseRevision
+	for rows.Next() {
+		var revision entity.CourseRevision
+		err := rows.Scan(&revision.ID, &revision.CourseID, &revision.Revision, &revision.Message, &revision.CreatedBy, &revision.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan course revision: %v", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (r *Repository) ReadRevision(courseID uuid.UUID, revision int) (entity.CourseRevision, error) {
+	query := `
+		SELECT id, course_id, revision, message, snapshot, created_by, created_at
+		FROM course_revisions
+		WHERE course_id = $1 AND revision = $2
+	`
+
+	var courseRevision entity.CourseRevision
+	err := r.db.QueryRow(query, courseID, revision).Scan(
+		&courseRevision.ID, &courseRevision.CourseID, &courseRevision.Revision, &courseRevision.Message,
+		&courseRevision.Snapshot, &courseRevision.CreatedBy, &courseRevision.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return entity.CourseRevision{}, nil
+	}
+	if err != nil {
+		return entity.CourseRevision{}, fmt.Errorf("failed to read course revision: %v", err)
+	}
+
+	return courseRevision, nil
+}
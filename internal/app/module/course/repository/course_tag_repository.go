@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	"database/sql"
+	"fmt"
+
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+
+	"github.com/google/uuid"
+)
+
+// maxTagDepth bounds the walk up the tag tree, so a cycle written around
+// the service cannot make it run forever.
+const maxTagDepth = 100
+
+// ReadTags lists every tag by name, with the number of published courses
+// tagged with it.
+func (r *Repository) ReadTags() ([]entity.CourseTagCount, error) {
+	query := `
+		SELECT t.id, t.name, t.slug, t.parent_id, t.created_at, t.updated_at, COUNT(c.id)
+		FROM course_tags t
+			LEFT JOIN course_tags_courses tc ON tc.course_tags_id = t.id
+			LEFT JOIN courses c ON c.id = tc.course_id AND c.status = $1
+		GROUP BY t.id
+		ORDER BY t.name
+	`
+
+	rows, err := r.db.Query(query, course_status_enum.Published)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tags: %v", err)
+	}
+	defer rows.Close()
+
+	tags := make([]entity.CourseTagCount, 0)
+	for rows.Next() {
+		var tag entity.CourseTagCount
+		err = rows.Scan(&tag.ID, &tag.Name, &tag.Slug, &tag.ParentID, &tag.CreatedAt, &tag.UpdatedAt, &tag.CourseCount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %v", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tags: %v", err)
+	}
+
+	return tags, nil
+}
+
+// ReadTag returns the tag with id, or an empty tag when there is none.
+func (r *Repository) ReadTag(id uuid.UUID) (entity.CourseTags, error) {
+	query := `
+		SELECT id, name, slug, parent_id, created_at, updated_at
+		FROM course_tags
+		WHERE id = $1
+	`
+
+	var tag entity.CourseTags
+	err := r.db.QueryRow(query, id).Scan(&tag.ID, &tag.Name, &tag.Slug, &tag.ParentID, &tag.CreatedAt, &tag.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return entity.CourseTags{}, nil
+	}
+	if err != nil {
+		return entity.CourseTags{}, fmt.Errorf("failed to read tag: %v", err)
+	}
+
+	return tag, nil
+}
+
+// ReadTagsByIDs returns the tags among ids that exist.
+func (r *Repository) ReadTagsByIDs(ids []uuid.UUID) ([]entity.CourseTags, error) {
+	query := `
+		SELECT id, name, slug, parent_id, created_at, updated_at
+		FROM course_tags
+		WHERE id = ANY($1::uuid[])
+	`
+
+	rows, err := r.db.Query(query, uuidArray(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tags: %v", err)
+	}
+	defer rows.Close()
+
+	tags := make([]entity.CourseTags, 0, len(ids))
+	for rows.Next() {
+		var tag entity.CourseTags
+		err = rows.Scan(&tag.ID, &tag.Name, &tag.Slug, &tag.ParentID, &tag.CreatedAt, &tag.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %v", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tags: %v", err)
+	}
+
+	return tags, nil
+}
+
+// ReadTagAncestorIDs returns id followed by the IDs of its parent, its
+// parent's parent and so on up to a top level tag.
+func (r *Repository) ReadTagAncestorIDs(id uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 1 AS depth FROM course_tags WHERE id = $1
+			UNION ALL
+			SELECT t.id, t.parent_id, a.depth + 1 FROM course_tags t JOIN ancestors a ON t.id = a.parent_id
+			WHERE a.depth < $2
+		)
+		SELECT id FROM ancestors ORDER BY depth
+	`
+
+	rows, err := r.db.Query(query, id, maxTagDepth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag ancestors: %v", err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var ancestorID uuid.UUID
+		err = rows.Scan(&ancestorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tag ancestor: %v", err)
+		}
+		ids = append(ids, ancestorID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tag ancestors: %v", err)
+	}
+
+	return ids, nil
+}
+
+// CreateTag stores tag unless its name or slug is taken, in which case no
+// rows are affected.
+func (r *Repository) CreateTag(tag entity.CourseTags) (int64, error) {
+	query := `
+		INSERT INTO course_tags (id, name, slug, parent_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := r.db.Exec(query, tag.ID, tag.Name, tag.Slug, tag.ParentID, tag.CreatedAt, tag.UpdatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create tag: %v", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// UpdateTag replaces the name, slug and parent of tag unless another tag
+// has its name or slug, in which case no rows are affected.
+func (r *Repository) UpdateTag(tag entity.CourseTags) (int64, error) {
+	query := `
+		UPDATE course_tags
+		SET name = $2, slug = $3, parent_id = $4, updated_at = $5
+		WHERE id = $1 AND NOT EXISTS (
+			SELECT 1 FROM course_tags o WHERE o.id <> $1 AND (o.name = $2 OR o.slug = $3)
+		)
+	`
+
+	result, err := r.db.Exec(query, tag.ID, tag.Name, tag.Slug, tag.ParentID, tag.UpdatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update tag: %v", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// DeleteTag removes a tag from its courses and deletes it. Its children
+// move up to its own parent.
+func (r *Repository) DeleteTag(id uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM course_tags_courses WHERE course_tags_id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to unlink tag from its courses: %v", err)
+	}
+
+	reparentQuery := `
+		UPDATE course_tags
+		SET parent_id = (SELECT parent_id FROM course_tags WHERE id = $1)
+		WHERE parent_id = $1
+	`
+	_, err = tx.Exec(reparentQuery, id)
+	if err != nil {
+		return fmt.Errorf("failed to move up the children of the tag: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM course_tags WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// MergeTags moves the courses and children of sourceID over to targetID and
+// deletes sourceID. Courses tagged with both keep a single link.
+func (r *Repository) MergeTags(sourceID uuid.UUID, targetID uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	relinkQuery := `
+		INSERT INTO course_tags_courses (course_id, course_tags_id)
+		SELECT course_id, $2 FROM course_tags_courses WHERE course_tags_id = $1
+		ON CONFLICT DO NOTHING
+	`
+	_, err = tx.Exec(relinkQuery, sourceID, targetID)
+	if err != nil {
+		return fmt.Errorf("failed to move courses to the merged tag: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM course_tags_courses WHERE course_tags_id = $1", sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to unlink tag from its courses: %v", err)
+	}
+
+	_, err = tx.Exec("UPDATE course_tags SET parent_id = $2 WHERE parent_id = $1", sourceID, targetID)
+	if err != nil {
+		return fmt.Errorf("failed to move children to the merged tag: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM course_tags WHERE id = $1", sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
@@ -4,9 +4,14 @@ package mocks
 
 import (
 	entity "CodeWithAzri/internal/app/module/course/entity"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+
+	evententity "CodeWithAzri/internal/app/module/event/entity"
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "CodeWithAzri/internal/app/module/course/repository"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -23,17 +28,151 @@ func (_m *CourseRepository) EXPECT() *CourseRepository_Expecter {
 	return &CourseRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: e
-func (_m *CourseRepository) Create(e entity.Course) error {
-	ret := _m.Called(e)
+// AddInstructor provides a mock function with given fields: instructor
+func (_m *CourseRepository) AddInstructor(instructor entity.CourseInstructor) (int64, error) {
+	ret := _m.Called(instructor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddInstructor")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.CourseInstructor) (int64, error)); ok {
+		return rf(instructor)
+	}
+	if rf, ok := ret.Get(0).(func(entity.CourseInstructor) int64); ok {
+		r0 = rf(instructor)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.CourseInstructor) error); ok {
+		r1 = rf(instructor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_AddInstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddInstructor'
+type CourseRepository_AddInstructor_Call struct {
+	*mock.Call
+}
+
+// AddInstructor is a helper method to define mock.On call
+//   - instructor entity.CourseInstructor
+func (_e *CourseRepository_Expecter) AddInstructor(instructor interface{}) *CourseRepository_AddInstructor_Call {
+	return &CourseRepository_AddInstructor_Call{Call: _e.mock.On("AddInstructor", instructor)}
+}
+
+func (_c *CourseRepository_AddInstructor_Call) Run(run func(instructor entity.CourseInstructor)) *CourseRepository_AddInstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.CourseInstructor))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_AddInstructor_Call) Return(_a0 int64, _a1 error) *CourseRepository_AddInstructor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_AddInstructor_Call) RunAndReturn(run func(entity.CourseInstructor) (int64, error)) *CourseRepository_AddInstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteLesson provides a mock function with given fields: progress, events
+func (_m *CourseRepository) CompleteLesson(progress entity.CourseLessonProgress, events ...evententity.Event) (int64, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, progress)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteLesson")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.CourseLessonProgress, ...evententity.Event) (int64, error)); ok {
+		return rf(progress, events...)
+	}
+	if rf, ok := ret.Get(0).(func(entity.CourseLessonProgress, ...evententity.Event) int64); ok {
+		r0 = rf(progress, events...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.CourseLessonProgress, ...evententity.Event) error); ok {
+		r1 = rf(progress, events...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_CompleteLesson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteLesson'
+type CourseRepository_CompleteLesson_Call struct {
+	*mock.Call
+}
+
+// CompleteLesson is a helper method to define mock.On call
+//   - progress entity.CourseLessonProgress
+//   - events ...evententity.Event
+func (_e *CourseRepository_Expecter) CompleteLesson(progress interface{}, events ...interface{}) *CourseRepository_CompleteLesson_Call {
+	return &CourseRepository_CompleteLesson_Call{Call: _e.mock.On("CompleteLesson",
+		append([]interface{}{progress}, events...)...)}
+}
+
+func (_c *CourseRepository_CompleteLesson_Call) Run(run func(progress entity.CourseLessonProgress, events ...evententity.Event)) *CourseRepository_CompleteLesson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]evententity.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(entity.CourseLessonProgress), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *CourseRepository_CompleteLesson_Call) Return(_a0 int64, _a1 error) *CourseRepository_CompleteLesson_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_CompleteLesson_Call) RunAndReturn(run func(entity.CourseLessonProgress, ...evententity.Event) (int64, error)) *CourseRepository_CompleteLesson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: e, events
+func (_m *CourseRepository) Create(e entity.Course, events ...evententity.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, e)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(entity.Course) error); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(entity.Course, ...evententity.Event) error); ok {
+		r0 = rf(e, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,13 +187,21 @@ type CourseRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - e entity.Course
-func (_e *CourseRepository_Expecter) Create(e interface{}) *CourseRepository_Create_Call {
-	return &CourseRepository_Create_Call{Call: _e.mock.On("Create", e)}
+//   - events ...evententity.Event
+func (_e *CourseRepository_Expecter) Create(e interface{}, events ...interface{}) *CourseRepository_Create_Call {
+	return &CourseRepository_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{e}, events...)...)}
 }
 
-func (_c *CourseRepository_Create_Call) Run(run func(e entity.Course)) *CourseRepository_Create_Call {
+func (_c *CourseRepository_Create_Call) Run(run func(e entity.Course, events ...evententity.Event)) *CourseRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.Course))
+		variadicArgs := make([]evententity.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(entity.Course), variadicArgs...)
 	})
 	return _c
 }
@@ -64,7 +211,63 @@ func (_c *CourseRepository_Create_Call) Return(_a0 error) *CourseRepository_Crea
 	return _c
 }
 
-func (_c *CourseRepository_Create_Call) RunAndReturn(run func(entity.Course) error) *CourseRepository_Create_Call {
+func (_c *CourseRepository_Create_Call) RunAndReturn(run func(entity.Course, ...evententity.Event) error) *CourseRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: tag
+func (_m *CourseRepository) CreateTag(tag entity.CourseTags) (int64, error) {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.CourseTags) (int64, error)); ok {
+		return rf(tag)
+	}
+	if rf, ok := ret.Get(0).(func(entity.CourseTags) int64); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.CourseTags) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type CourseRepository_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - tag entity.CourseTags
+func (_e *CourseRepository_Expecter) CreateTag(tag interface{}) *CourseRepository_CreateTag_Call {
+	return &CourseRepository_CreateTag_Call{Call: _e.mock.On("CreateTag", tag)}
+}
+
+func (_c *CourseRepository_CreateTag_Call) Run(run func(tag entity.CourseTags)) *CourseRepository_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.CourseTags))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_CreateTag_Call) Return(_a0 int64, _a1 error) *CourseRepository_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_CreateTag_Call) RunAndReturn(run func(entity.CourseTags) (int64, error)) *CourseRepository_CreateTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -115,29 +318,80 @@ func (_c *CourseRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error)
 	return _c
 }
 
-// ReadMany provides a mock function with given fields: limit, offset
-func (_m *CourseRepository) ReadMany(limit int, offset int) ([]entity.Course, error) {
-	ret := _m.Called(limit, offset)
+// DeleteTag provides a mock function with given fields: id
+func (_m *CourseRepository) DeleteTag(id uuid.UUID) error {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadMany")
+		panic("no return value specified for DeleteTag")
 	}
 
-	var r0 []entity.Course
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseRepository_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type CourseRepository_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *CourseRepository_Expecter) DeleteTag(id interface{}) *CourseRepository_DeleteTag_Call {
+	return &CourseRepository_DeleteTag_Call{Call: _e.mock.On("DeleteTag", id)}
+}
+
+func (_c *CourseRepository_DeleteTag_Call) Run(run func(id uuid.UUID)) *CourseRepository_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_DeleteTag_Call) Return(_a0 error) *CourseRepository_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseRepository_DeleteTag_Call) RunAndReturn(run func(uuid.UUID) error) *CourseRepository_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enroll provides a mock function with given fields: enrollment, events
+func (_m *CourseRepository) Enroll(enrollment entity.CourseEnrollment, events ...evententity.Event) (int64, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, enrollment)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enroll")
+	}
+
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]entity.Course, error)); ok {
-		return rf(limit, offset)
+	if rf, ok := ret.Get(0).(func(entity.CourseEnrollment, ...evententity.Event) (int64, error)); ok {
+		return rf(enrollment, events...)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []entity.Course); ok {
-		r0 = rf(limit, offset)
+	if rf, ok := ret.Get(0).(func(entity.CourseEnrollment, ...evententity.Event) int64); ok {
+		r0 = rf(enrollment, events...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Course)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(limit, offset)
+	if rf, ok := ret.Get(1).(func(entity.CourseEnrollment, ...evententity.Event) error); ok {
+		r1 = rf(enrollment, events...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -145,56 +399,109 @@ func (_m *CourseRepository) ReadMany(limit int, offset int) ([]entity.Course, er
 	return r0, r1
 }
 
-// CourseRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
-type CourseRepository_ReadMany_Call struct {
+// CourseRepository_Enroll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enroll'
+type CourseRepository_Enroll_Call struct {
 	*mock.Call
 }
 
-// ReadMany is a helper method to define mock.On call
-//   - limit int
-//   - offset int
-func (_e *CourseRepository_Expecter) ReadMany(limit interface{}, offset interface{}) *CourseRepository_ReadMany_Call {
-	return &CourseRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", limit, offset)}
+// Enroll is a helper method to define mock.On call
+//   - enrollment entity.CourseEnrollment
+//   - events ...evententity.Event
+func (_e *CourseRepository_Expecter) Enroll(enrollment interface{}, events ...interface{}) *CourseRepository_Enroll_Call {
+	return &CourseRepository_Enroll_Call{Call: _e.mock.On("Enroll",
+		append([]interface{}{enrollment}, events...)...)}
 }
 
-func (_c *CourseRepository_ReadMany_Call) Run(run func(limit int, offset int)) *CourseRepository_ReadMany_Call {
+func (_c *CourseRepository_Enroll_Call) Run(run func(enrollment entity.CourseEnrollment, events ...evententity.Event)) *CourseRepository_Enroll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		variadicArgs := make([]evententity.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(entity.CourseEnrollment), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *CourseRepository_ReadMany_Call) Return(_a0 []entity.Course, _a1 error) *CourseRepository_ReadMany_Call {
+func (_c *CourseRepository_Enroll_Call) Return(_a0 int64, _a1 error) *CourseRepository_Enroll_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *CourseRepository_ReadMany_Call) RunAndReturn(run func(int, int) ([]entity.Course, error)) *CourseRepository_ReadMany_Call {
+func (_c *CourseRepository_Enroll_Call) RunAndReturn(run func(entity.CourseEnrollment, ...evententity.Event) (int64, error)) *CourseRepository_Enroll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadOne provides a mock function with given fields: id
-func (_m *CourseRepository) ReadOne(id uuid.UUID) (entity.Course, error) {
-	ret := _m.Called(id)
+// EraseUserData provides a mock function with given fields: userID
+func (_m *CourseRepository) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadOne")
+		panic("no return value specified for EraseUserData")
 	}
 
-	var r0 entity.Course
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseRepository_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type CourseRepository_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *CourseRepository_Expecter) EraseUserData(userID interface{}) *CourseRepository_EraseUserData_Call {
+	return &CourseRepository_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *CourseRepository_EraseUserData_Call) Run(run func(userID string)) *CourseRepository_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_EraseUserData_Call) Return(_a0 error) *CourseRepository_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseRepository_EraseUserData_Call) RunAndReturn(run func(string) error) *CourseRepository_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEnrolled provides a mock function with given fields: courseID, userID
+func (_m *CourseRepository) IsEnrolled(courseID uuid.UUID, userID string) (bool, error) {
+	ret := _m.Called(courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEnrolled")
+	}
+
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.Course, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (bool, error)); ok {
+		return rf(courseID, userID)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.Course); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) bool); ok {
+		r0 = rf(courseID, userID)
 	} else {
-		r0 = ret.Get(0).(entity.Course)
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(courseID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -202,45 +509,46 @@ func (_m *CourseRepository) ReadOne(id uuid.UUID) (entity.Course, error) {
 	return r0, r1
 }
 
-// CourseRepository_ReadOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOne'
-type CourseRepository_ReadOne_Call struct {
+// CourseRepository_IsEnrolled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnrolled'
+type CourseRepository_IsEnrolled_Call struct {
 	*mock.Call
 }
 
-// ReadOne is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *CourseRepository_Expecter) ReadOne(id interface{}) *CourseRepository_ReadOne_Call {
-	return &CourseRepository_ReadOne_Call{Call: _e.mock.On("ReadOne", id)}
+// IsEnrolled is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseRepository_Expecter) IsEnrolled(courseID interface{}, userID interface{}) *CourseRepository_IsEnrolled_Call {
+	return &CourseRepository_IsEnrolled_Call{Call: _e.mock.On("IsEnrolled", courseID, userID)}
 }
 
-func (_c *CourseRepository_ReadOne_Call) Run(run func(id uuid.UUID)) *CourseRepository_ReadOne_Call {
+func (_c *CourseRepository_IsEnrolled_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseRepository_IsEnrolled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID))
+		run(args[0].(uuid.UUID), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *CourseRepository_ReadOne_Call) Return(_a0 entity.Course, _a1 error) *CourseRepository_ReadOne_Call {
+func (_c *CourseRepository_IsEnrolled_Call) Return(_a0 bool, _a1 error) *CourseRepository_IsEnrolled_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *CourseRepository_ReadOne_Call) RunAndReturn(run func(uuid.UUID) (entity.Course, error)) *CourseRepository_ReadOne_Call {
+func (_c *CourseRepository_IsEnrolled_Call) RunAndReturn(run func(uuid.UUID, string) (bool, error)) *CourseRepository_IsEnrolled_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: id, e
-func (_m *CourseRepository) Update(id uuid.UUID, e entity.Course) error {
-	ret := _m.Called(id, e)
+// MergeTags provides a mock function with given fields: sourceID, targetID
+func (_m *CourseRepository) MergeTags(sourceID uuid.UUID, targetID uuid.UUID) error {
+	ret := _m.Called(sourceID, targetID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for MergeTags")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, entity.Course) error); ok {
-		r0 = rf(id, e)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(sourceID, targetID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -248,31 +556,1349 @@ func (_m *CourseRepository) Update(id uuid.UUID, e entity.Course) error {
 	return r0
 }
 
-// CourseRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type CourseRepository_Update_Call struct {
+// CourseRepository_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type CourseRepository_MergeTags_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - id uuid.UUID
-//   - e entity.Course
-func (_e *CourseRepository_Expecter) Update(id interface{}, e interface{}) *CourseRepository_Update_Call {
-	return &CourseRepository_Update_Call{Call: _e.mock.On("Update", id, e)}
+// MergeTags is a helper method to define mock.On call
+//   - sourceID uuid.UUID
+//   - targetID uuid.UUID
+func (_e *CourseRepository_Expecter) MergeTags(sourceID interface{}, targetID interface{}) *CourseRepository_MergeTags_Call {
+	return &CourseRepository_MergeTags_Call{Call: _e.mock.On("MergeTags", sourceID, targetID)}
 }
 
-func (_c *CourseRepository_Update_Call) Run(run func(id uuid.UUID, e entity.Course)) *CourseRepository_Update_Call {
+func (_c *CourseRepository_MergeTags_Call) Run(run func(sourceID uuid.UUID, targetID uuid.UUID)) *CourseRepository_MergeTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID), args[1].(entity.Course))
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *CourseRepository_Update_Call) Return(_a0 error) *CourseRepository_Update_Call {
+func (_c *CourseRepository_MergeTags_Call) Return(_a0 error) *CourseRepository_MergeTags_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CourseRepository_Update_Call) RunAndReturn(run func(uuid.UUID, entity.Course) error) *CourseRepository_Update_Call {
+func (_c *CourseRepository_MergeTags_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *CourseRepository_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishDue provides a mock function with given fields: now
+func (_m *CourseRepository) PublishDue(now int64) (int64, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDue")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_PublishDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDue'
+type CourseRepository_PublishDue_Call struct {
+	*mock.Call
+}
+
+// PublishDue is a helper method to define mock.On call
+//   - now int64
+func (_e *CourseRepository_Expecter) PublishDue(now interface{}) *CourseRepository_PublishDue_Call {
+	return &CourseRepository_PublishDue_Call{Call: _e.mock.On("PublishDue", now)}
+}
+
+func (_c *CourseRepository_PublishDue_Call) Run(run func(now int64)) *CourseRepository_PublishDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_PublishDue_Call) Return(_a0 int64, _a1 error) *CourseRepository_PublishDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_PublishDue_Call) RunAndReturn(run func(int64) (int64, error)) *CourseRepository_PublishDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadCompletedLessons provides a mock function with given fields: courseID, userID
+func (_m *CourseRepository) ReadCompletedLessons(courseID uuid.UUID, userID string) ([]uuid.UUID, error) {
+	ret := _m.Called(courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadCompletedLessons")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) ([]uuid.UUID, error)); ok {
+		return rf(courseID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) []uuid.UUID); ok {
+		r0 = rf(courseID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadCompletedLessons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadCompletedLessons'
+type CourseRepository_ReadCompletedLessons_Call struct {
+	*mock.Call
+}
+
+// ReadCompletedLessons is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseRepository_Expecter) ReadCompletedLessons(courseID interface{}, userID interface{}) *CourseRepository_ReadCompletedLessons_Call {
+	return &CourseRepository_ReadCompletedLessons_Call{Call: _e.mock.On("ReadCompletedLessons", courseID, userID)}
+}
+
+func (_c *CourseRepository_ReadCompletedLessons_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseRepository_ReadCompletedLessons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadCompletedLessons_Call) Return(_a0 []uuid.UUID, _a1 error) *CourseRepository_ReadCompletedLessons_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadCompletedLessons_Call) RunAndReturn(run func(uuid.UUID, string) ([]uuid.UUID, error)) *CourseRepository_ReadCompletedLessons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadEnrolledUserIDs provides a mock function with given fields: courseID
+func (_m *CourseRepository) ReadEnrolledUserIDs(courseID uuid.UUID) ([]string, error) {
+	ret := _m.Called(courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadEnrolledUserIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]string, error)); ok {
+		return rf(courseID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []string); ok {
+		r0 = rf(courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadEnrolledUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadEnrolledUserIDs'
+type CourseRepository_ReadEnrolledUserIDs_Call struct {
+	*mock.Call
+}
+
+// ReadEnrolledUserIDs is a helper method to define mock.On call
+//   - courseID uuid.UUID
+func (_e *CourseRepository_Expecter) ReadEnrolledUserIDs(courseID interface{}) *CourseRepository_ReadEnrolledUserIDs_Call {
+	return &CourseRepository_ReadEnrolledUserIDs_Call{Call: _e.mock.On("ReadEnrolledUserIDs", courseID)}
+}
+
+func (_c *CourseRepository_ReadEnrolledUserIDs_Call) Run(run func(courseID uuid.UUID)) *CourseRepository_ReadEnrolledUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadEnrolledUserIDs_Call) Return(_a0 []string, _a1 error) *CourseRepository_ReadEnrolledUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadEnrolledUserIDs_Call) RunAndReturn(run func(uuid.UUID) ([]string, error)) *CourseRepository_ReadEnrolledUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadInstructors provides a mock function with given fields: courseIDs
+func (_m *CourseRepository) ReadInstructors(courseIDs []uuid.UUID) ([]entity.CourseInstructor, error) {
+	ret := _m.Called(courseIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadInstructors")
+	}
+
+	var r0 []entity.CourseInstructor
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]entity.CourseInstructor, error)); ok {
+		return rf(courseIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []entity.CourseInstructor); ok {
+		r0 = rf(courseIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseInstructor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(courseIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadInstructors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadInstructors'
+type CourseRepository_ReadInstructors_Call struct {
+	*mock.Call
+}
+
+// ReadInstructors is a helper method to define mock.On call
+//   - courseIDs []uuid.UUID
+func (_e *CourseRepository_Expecter) ReadInstructors(courseIDs interface{}) *CourseRepository_ReadInstructors_Call {
+	return &CourseRepository_ReadInstructors_Call{Call: _e.mock.On("ReadInstructors", courseIDs)}
+}
+
+func (_c *CourseRepository_ReadInstructors_Call) Run(run func(courseIDs []uuid.UUID)) *CourseRepository_ReadInstructors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadInstructors_Call) Return(_a0 []entity.CourseInstructor, _a1 error) *CourseRepository_ReadInstructors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadInstructors_Call) RunAndReturn(run func([]uuid.UUID) ([]entity.CourseInstructor, error)) *CourseRepository_ReadInstructors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadLessonCourseID provides a mock function with given fields: lessonID
+func (_m *CourseRepository) ReadLessonCourseID(lessonID uuid.UUID) (uuid.UUID, error) {
+	ret := _m.Called(lessonID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadLessonCourseID")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (uuid.UUID, error)); ok {
+		return rf(lessonID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) uuid.UUID); ok {
+		r0 = rf(lessonID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(lessonID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadLessonCourseID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadLessonCourseID'
+type CourseRepository_ReadLessonCourseID_Call struct {
+	*mock.Call
+}
+
+// ReadLessonCourseID is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+func (_e *CourseRepository_Expecter) ReadLessonCourseID(lessonID interface{}) *CourseRepository_ReadLessonCourseID_Call {
+	return &CourseRepository_ReadLessonCourseID_Call{Call: _e.mock.On("ReadLessonCourseID", lessonID)}
+}
+
+func (_c *CourseRepository_ReadLessonCourseID_Call) Run(run func(lessonID uuid.UUID)) *CourseRepository_ReadLessonCourseID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadLessonCourseID_Call) Return(_a0 uuid.UUID, _a1 error) *CourseRepository_ReadLessonCourseID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadLessonCourseID_Call) RunAndReturn(run func(uuid.UUID) (uuid.UUID, error)) *CourseRepository_ReadLessonCourseID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMany provides a mock function with given fields: limit, offset, viewerID, relations
+func (_m *CourseRepository) ReadMany(limit int, offset int, viewerID string, relations repository.Relations) ([]entity.Course, error) {
+	ret := _m.Called(limit, offset, viewerID, relations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMany")
+	}
+
+	var r0 []entity.Course
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string, repository.Relations) ([]entity.Course, error)); ok {
+		return rf(limit, offset, viewerID, relations)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, repository.Relations) []entity.Course); ok {
+		r0 = rf(limit, offset, viewerID, relations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Course)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, repository.Relations) error); ok {
+		r1 = rf(limit, offset, viewerID, relations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
+type CourseRepository_ReadMany_Call struct {
+	*mock.Call
+}
+
+// ReadMany is a helper method to define mock.On call
+//   - limit int
+//   - offset int
+//   - viewerID string
+//   - relations repository.Relations
+func (_e *CourseRepository_Expecter) ReadMany(limit interface{}, offset interface{}, viewerID interface{}, relations interface{}) *CourseRepository_ReadMany_Call {
+	return &CourseRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", limit, offset, viewerID, relations)}
+}
+
+func (_c *CourseRepository_ReadMany_Call) Run(run func(limit int, offset int, viewerID string, relations repository.Relations)) *CourseRepository_ReadMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string), args[3].(repository.Relations))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadMany_Call) Return(_a0 []entity.Course, _a1 error) *CourseRepository_ReadMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadMany_Call) RunAndReturn(run func(int, int, string, repository.Relations) ([]entity.Course, error)) *CourseRepository_ReadMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadManyByInstructor provides a mock function with given fields: instructorID, limit, offset, viewerID, relations
+func (_m *CourseRepository) ReadManyByInstructor(instructorID string, limit int, offset int, viewerID string, relations repository.Relations) ([]entity.Course, error) {
+	ret := _m.Called(instructorID, limit, offset, viewerID, relations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadManyByInstructor")
+	}
+
+	var r0 []entity.Course
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int, string, repository.Relations) ([]entity.Course, error)); ok {
+		return rf(instructorID, limit, offset, viewerID, relations)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, string, repository.Relations) []entity.Course); ok {
+		r0 = rf(instructorID, limit, offset, viewerID, relations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Course)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, string, repository.Relations) error); ok {
+		r1 = rf(instructorID, limit, offset, viewerID, relations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadManyByInstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadManyByInstructor'
+type CourseRepository_ReadManyByInstructor_Call struct {
+	*mock.Call
+}
+
+// ReadManyByInstructor is a helper method to define mock.On call
+//   - instructorID string
+//   - limit int
+//   - offset int
+//   - viewerID string
+//   - relations repository.Relations
+func (_e *CourseRepository_Expecter) ReadManyByInstructor(instructorID interface{}, limit interface{}, offset interface{}, viewerID interface{}, relations interface{}) *CourseRepository_ReadManyByInstructor_Call {
+	return &CourseRepository_ReadManyByInstructor_Call{Call: _e.mock.On("ReadManyByInstructor", instructorID, limit, offset, viewerID, relations)}
+}
+
+func (_c *CourseRepository_ReadManyByInstructor_Call) Run(run func(instructorID string, limit int, offset int, viewerID string, relations repository.Relations)) *CourseRepository_ReadManyByInstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int), args[3].(string), args[4].(repository.Relations))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadManyByInstructor_Call) Return(_a0 []entity.Course, _a1 error) *CourseRepository_ReadManyByInstructor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadManyByInstructor_Call) RunAndReturn(run func(string, int, int, string, repository.Relations) ([]entity.Course, error)) *CourseRepository_ReadManyByInstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOne provides a mock function with given fields: id
+func (_m *CourseRepository) ReadOne(id uuid.UUID) (entity.Course, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOne")
+	}
+
+	var r0 entity.Course
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.Course, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.Course); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.Course)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOne'
+type CourseRepository_ReadOne_Call struct {
+	*mock.Call
+}
+
+// ReadOne is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *CourseRepository_Expecter) ReadOne(id interface{}) *CourseRepository_ReadOne_Call {
+	return &CourseRepository_ReadOne_Call{Call: _e.mock.On("ReadOne", id)}
+}
+
+func (_c *CourseRepository_ReadOne_Call) Run(run func(id uuid.UUID)) *CourseRepository_ReadOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadOne_Call) Return(_a0 entity.Course, _a1 error) *CourseRepository_ReadOne_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadOne_Call) RunAndReturn(run func(uuid.UUID) (entity.Course, error)) *CourseRepository_ReadOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOneWith provides a mock function with given fields: id, relations
+func (_m *CourseRepository) ReadOneWith(id uuid.UUID, relations repository.Relations) (entity.Course, error) {
+	ret := _m.Called(id, relations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOneWith")
+	}
+
+	var r0 entity.Course
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, repository.Relations) (entity.Course, error)); ok {
+		return rf(id, relations)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, repository.Relations) entity.Course); ok {
+		r0 = rf(id, relations)
+	} else {
+		r0 = ret.Get(0).(entity.Course)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, repository.Relations) error); ok {
+		r1 = rf(id, relations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadOneWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOneWith'
+type CourseRepository_ReadOneWith_Call struct {
+	*mock.Call
+}
+
+// ReadOneWith is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - relations repository.Relations
+func (_e *CourseRepository_Expecter) ReadOneWith(id interface{}, relations interface{}) *CourseRepository_ReadOneWith_Call {
+	return &CourseRepository_ReadOneWith_Call{Call: _e.mock.On("ReadOneWith", id, relations)}
+}
+
+func (_c *CourseRepository_ReadOneWith_Call) Run(run func(id uuid.UUID, relations repository.Relations)) *CourseRepository_ReadOneWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(repository.Relations))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadOneWith_Call) Return(_a0 entity.Course, _a1 error) *CourseRepository_ReadOneWith_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadOneWith_Call) RunAndReturn(run func(uuid.UUID, repository.Relations) (entity.Course, error)) *CourseRepository_ReadOneWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRevision provides a mock function with given fields: courseID, revision
+func (_m *CourseRepository) ReadRevision(courseID uuid.UUID, revision int) (entity.CourseRevision, error) {
+	ret := _m.Called(courseID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRevision")
+	}
+
+	var r0 entity.CourseRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) (entity.CourseRevision, error)); ok {
+		return rf(courseID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) entity.CourseRevision); ok {
+		r0 = rf(courseID, revision)
+	} else {
+		r0 = ret.Get(0).(entity.CourseRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(courseID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRevision'
+type CourseRepository_ReadRevision_Call struct {
+	*mock.Call
+}
+
+// ReadRevision is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - revision int
+func (_e *CourseRepository_Expecter) ReadRevision(courseID interface{}, revision interface{}) *CourseRepository_ReadRevision_Call {
+	return &CourseRepository_ReadRevision_Call{Call: _e.mock.On("ReadRevision", courseID, revision)}
+}
+
+func (_c *CourseRepository_ReadRevision_Call) Run(run func(courseID uuid.UUID, revision int)) *CourseRepository_ReadRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadRevision_Call) Return(_a0 entity.CourseRevision, _a1 error) *CourseRepository_ReadRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadRevision_Call) RunAndReturn(run func(uuid.UUID, int) (entity.CourseRevision, error)) *CourseRepository_ReadRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRevisions provides a mock function with given fields: courseID
+func (_m *CourseRepository) ReadRevisions(courseID uuid.UUID) ([]entity.CourseRevision, error) {
+	ret := _m.Called(courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRevisions")
+	}
+
+	var r0 []entity.CourseRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]entity.CourseRevision, error)); ok {
+		return rf(courseID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []entity.CourseRevision); ok {
+		r0 = rf(courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRevisions'
+type CourseRepository_ReadRevisions_Call struct {
+	*mock.Call
+}
+
+// ReadRevisions is a helper method to define mock.On call
+//   - courseID uuid.UUID
+func (_e *CourseRepository_Expecter) ReadRevisions(courseID interface{}) *CourseRepository_ReadRevisions_Call {
+	return &CourseRepository_ReadRevisions_Call{Call: _e.mock.On("ReadRevisions", courseID)}
+}
+
+func (_c *CourseRepository_ReadRevisions_Call) Run(run func(courseID uuid.UUID)) *CourseRepository_ReadRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadRevisions_Call) Return(_a0 []entity.CourseRevision, _a1 error) *CourseRepository_ReadRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadRevisions_Call) RunAndReturn(run func(uuid.UUID) ([]entity.CourseRevision, error)) *CourseRepository_ReadRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTag provides a mock function with given fields: id
+func (_m *CourseRepository) ReadTag(id uuid.UUID) (entity.CourseTags, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTag")
+	}
+
+	var r0 entity.CourseTags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.CourseTags, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.CourseTags); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.CourseTags)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTag'
+type CourseRepository_ReadTag_Call struct {
+	*mock.Call
+}
+
+// ReadTag is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *CourseRepository_Expecter) ReadTag(id interface{}) *CourseRepository_ReadTag_Call {
+	return &CourseRepository_ReadTag_Call{Call: _e.mock.On("ReadTag", id)}
+}
+
+func (_c *CourseRepository_ReadTag_Call) Run(run func(id uuid.UUID)) *CourseRepository_ReadTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadTag_Call) Return(_a0 entity.CourseTags, _a1 error) *CourseRepository_ReadTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadTag_Call) RunAndReturn(run func(uuid.UUID) (entity.CourseTags, error)) *CourseRepository_ReadTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTagAncestorIDs provides a mock function with given fields: id
+func (_m *CourseRepository) ReadTagAncestorIDs(id uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTagAncestorIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]uuid.UUID, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadTagAncestorIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTagAncestorIDs'
+type CourseRepository_ReadTagAncestorIDs_Call struct {
+	*mock.Call
+}
+
+// ReadTagAncestorIDs is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *CourseRepository_Expecter) ReadTagAncestorIDs(id interface{}) *CourseRepository_ReadTagAncestorIDs_Call {
+	return &CourseRepository_ReadTagAncestorIDs_Call{Call: _e.mock.On("ReadTagAncestorIDs", id)}
+}
+
+func (_c *CourseRepository_ReadTagAncestorIDs_Call) Run(run func(id uuid.UUID)) *CourseRepository_ReadTagAncestorIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadTagAncestorIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *CourseRepository_ReadTagAncestorIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadTagAncestorIDs_Call) RunAndReturn(run func(uuid.UUID) ([]uuid.UUID, error)) *CourseRepository_ReadTagAncestorIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTags provides a mock function with given fields:
+func (_m *CourseRepository) ReadTags() ([]entity.CourseTagCount, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTags")
+	}
+
+	var r0 []entity.CourseTagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]entity.CourseTagCount, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []entity.CourseTagCount); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseTagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTags'
+type CourseRepository_ReadTags_Call struct {
+	*mock.Call
+}
+
+// ReadTags is a helper method to define mock.On call
+func (_e *CourseRepository_Expecter) ReadTags() *CourseRepository_ReadTags_Call {
+	return &CourseRepository_ReadTags_Call{Call: _e.mock.On("ReadTags")}
+}
+
+func (_c *CourseRepository_ReadTags_Call) Run(run func()) *CourseRepository_ReadTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadTags_Call) Return(_a0 []entity.CourseTagCount, _a1 error) *CourseRepository_ReadTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadTags_Call) RunAndReturn(run func() ([]entity.CourseTagCount, error)) *CourseRepository_ReadTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTagsByIDs provides a mock function with given fields: ids
+func (_m *CourseRepository) ReadTagsByIDs(ids []uuid.UUID) ([]entity.CourseTags, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTagsByIDs")
+	}
+
+	var r0 []entity.CourseTags
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]entity.CourseTags, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []entity.CourseTags); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseTags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadTagsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTagsByIDs'
+type CourseRepository_ReadTagsByIDs_Call struct {
+	*mock.Call
+}
+
+// ReadTagsByIDs is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *CourseRepository_Expecter) ReadTagsByIDs(ids interface{}) *CourseRepository_ReadTagsByIDs_Call {
+	return &CourseRepository_ReadTagsByIDs_Call{Call: _e.mock.On("ReadTagsByIDs", ids)}
+}
+
+func (_c *CourseRepository_ReadTagsByIDs_Call) Run(run func(ids []uuid.UUID)) *CourseRepository_ReadTagsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadTagsByIDs_Call) Return(_a0 []entity.CourseTags, _a1 error) *CourseRepository_ReadTagsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadTagsByIDs_Call) RunAndReturn(run func([]uuid.UUID) ([]entity.CourseTags, error)) *CourseRepository_ReadTagsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserEnrollments provides a mock function with given fields: userID
+func (_m *CourseRepository) ReadUserEnrollments(userID string) ([]entity.CourseEnrollment, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserEnrollments")
+	}
+
+	var r0 []entity.CourseEnrollment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.CourseEnrollment, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.CourseEnrollment); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseEnrollment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadUserEnrollments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserEnrollments'
+type CourseRepository_ReadUserEnrollments_Call struct {
+	*mock.Call
+}
+
+// ReadUserEnrollments is a helper method to define mock.On call
+//   - userID string
+func (_e *CourseRepository_Expecter) ReadUserEnrollments(userID interface{}) *CourseRepository_ReadUserEnrollments_Call {
+	return &CourseRepository_ReadUserEnrollments_Call{Call: _e.mock.On("ReadUserEnrollments", userID)}
+}
+
+func (_c *CourseRepository_ReadUserEnrollments_Call) Run(run func(userID string)) *CourseRepository_ReadUserEnrollments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadUserEnrollments_Call) Return(_a0 []entity.CourseEnrollment, _a1 error) *CourseRepository_ReadUserEnrollments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadUserEnrollments_Call) RunAndReturn(run func(string) ([]entity.CourseEnrollment, error)) *CourseRepository_ReadUserEnrollments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserProgress provides a mock function with given fields: userID
+func (_m *CourseRepository) ReadUserProgress(userID string) ([]entity.CourseLessonProgress, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserProgress")
+	}
+
+	var r0 []entity.CourseLessonProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.CourseLessonProgress, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.CourseLessonProgress); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseLessonProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadUserProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserProgress'
+type CourseRepository_ReadUserProgress_Call struct {
+	*mock.Call
+}
+
+// ReadUserProgress is a helper method to define mock.On call
+//   - userID string
+func (_e *CourseRepository_Expecter) ReadUserProgress(userID interface{}) *CourseRepository_ReadUserProgress_Call {
+	return &CourseRepository_ReadUserProgress_Call{Call: _e.mock.On("ReadUserProgress", userID)}
+}
+
+func (_c *CourseRepository_ReadUserProgress_Call) Run(run func(userID string)) *CourseRepository_ReadUserProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadUserProgress_Call) Return(_a0 []entity.CourseLessonProgress, _a1 error) *CourseRepository_ReadUserProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadUserProgress_Call) RunAndReturn(run func(string) ([]entity.CourseLessonProgress, error)) *CourseRepository_ReadUserProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserReviews provides a mock function with given fields: userID
+func (_m *CourseRepository) ReadUserReviews(userID string) ([]entity.CourseReviews, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserReviews")
+	}
+
+	var r0 []entity.CourseReviews
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.CourseReviews, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.CourseReviews); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CourseReviews)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_ReadUserReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserReviews'
+type CourseRepository_ReadUserReviews_Call struct {
+	*mock.Call
+}
+
+// ReadUserReviews is a helper method to define mock.On call
+//   - userID string
+func (_e *CourseRepository_Expecter) ReadUserReviews(userID interface{}) *CourseRepository_ReadUserReviews_Call {
+	return &CourseRepository_ReadUserReviews_Call{Call: _e.mock.On("ReadUserReviews", userID)}
+}
+
+func (_c *CourseRepository_ReadUserReviews_Call) Run(run func(userID string)) *CourseRepository_ReadUserReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_ReadUserReviews_Call) Return(_a0 []entity.CourseReviews, _a1 error) *CourseRepository_ReadUserReviews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_ReadUserReviews_Call) RunAndReturn(run func(string) ([]entity.CourseReviews, error)) *CourseRepository_ReadUserReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveInstructor provides a mock function with given fields: courseID, userID
+func (_m *CourseRepository) RemoveInstructor(courseID uuid.UUID, userID string) error {
+	ret := _m.Called(courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveInstructor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = rf(courseID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseRepository_RemoveInstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveInstructor'
+type CourseRepository_RemoveInstructor_Call struct {
+	*mock.Call
+}
+
+// RemoveInstructor is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseRepository_Expecter) RemoveInstructor(courseID interface{}, userID interface{}) *CourseRepository_RemoveInstructor_Call {
+	return &CourseRepository_RemoveInstructor_Call{Call: _e.mock.On("RemoveInstructor", courseID, userID)}
+}
+
+func (_c *CourseRepository_RemoveInstructor_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseRepository_RemoveInstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_RemoveInstructor_Call) Return(_a0 error) *CourseRepository_RemoveInstructor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseRepository_RemoveInstructor_Call) RunAndReturn(run func(uuid.UUID, string) error) *CourseRepository_RemoveInstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: id, readAt, e, baseline, revision, events
+func (_m *CourseRepository) Update(id uuid.UUID, readAt int64, e entity.Course, baseline entity.CourseRevision, revision entity.CourseRevision, events ...evententity.Event) (int64, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, readAt, e, baseline, revision)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64, entity.Course, entity.CourseRevision, entity.CourseRevision, ...evententity.Event) (int64, error)); ok {
+		return rf(id, readAt, e, baseline, revision, events...)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64, entity.Course, entity.CourseRevision, entity.CourseRevision, ...evententity.Event) int64); ok {
+		r0 = rf(id, readAt, e, baseline, revision, events...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int64, entity.Course, entity.CourseRevision, entity.CourseRevision, ...evententity.Event) error); ok {
+		r1 = rf(id, readAt, e, baseline, revision, events...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CourseRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - readAt int64
+//   - e entity.Course
+//   - baseline entity.CourseRevision
+//   - revision entity.CourseRevision
+//   - events ...evententity.Event
+func (_e *CourseRepository_Expecter) Update(id interface{}, readAt interface{}, e interface{}, baseline interface{}, revision interface{}, events ...interface{}) *CourseRepository_Update_Call {
+	return &CourseRepository_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{id, readAt, e, baseline, revision}, events...)...)}
+}
+
+func (_c *CourseRepository_Update_Call) Run(run func(id uuid.UUID, readAt int64, e entity.Course, baseline entity.CourseRevision, revision entity.CourseRevision, events ...evententity.Event)) *CourseRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]evententity.Event, len(args)-5)
+		for i, a := range args[5:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(uuid.UUID), args[1].(int64), args[2].(entity.Course), args[3].(entity.CourseRevision), args[4].(entity.CourseRevision), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *CourseRepository_Update_Call) Return(_a0 int64, _a1 error) *CourseRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_Update_Call) RunAndReturn(run func(uuid.UUID, int64, entity.Course, entity.CourseRevision, entity.CourseRevision, ...evententity.Event) (int64, error)) *CourseRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLessonDuration provides a mock function with given fields: mediaID, duration, updatedAt
+func (_m *CourseRepository) UpdateLessonDuration(mediaID uuid.UUID, duration int, updatedAt int64) error {
+	ret := _m.Called(mediaID, duration, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLessonDuration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int64) error); ok {
+		r0 = rf(mediaID, duration, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseRepository_UpdateLessonDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLessonDuration'
+type CourseRepository_UpdateLessonDuration_Call struct {
+	*mock.Call
+}
+
+// UpdateLessonDuration is a helper method to define mock.On call
+//   - mediaID uuid.UUID
+//   - duration int
+//   - updatedAt int64
+func (_e *CourseRepository_Expecter) UpdateLessonDuration(mediaID interface{}, duration interface{}, updatedAt interface{}) *CourseRepository_UpdateLessonDuration_Call {
+	return &CourseRepository_UpdateLessonDuration_Call{Call: _e.mock.On("UpdateLessonDuration", mediaID, duration, updatedAt)}
+}
+
+func (_c *CourseRepository_UpdateLessonDuration_Call) Run(run func(mediaID uuid.UUID, duration int, updatedAt int64)) *CourseRepository_UpdateLessonDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_UpdateLessonDuration_Call) Return(_a0 error) *CourseRepository_UpdateLessonDuration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseRepository_UpdateLessonDuration_Call) RunAndReturn(run func(uuid.UUID, int, int64) error) *CourseRepository_UpdateLessonDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: id, status, publishAt, updatedAt
+func (_m *CourseRepository) UpdateStatus(id uuid.UUID, status course_status_enum.CourseStatus, publishAt *int64, updatedAt int64) error {
+	ret := _m.Called(id, status, publishAt, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, course_status_enum.CourseStatus, *int64, int64) error); ok {
+		r0 = rf(id, status, publishAt, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type CourseRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - status course_status_enum.CourseStatus
+//   - publishAt *int64
+//   - updatedAt int64
+func (_e *CourseRepository_Expecter) UpdateStatus(id interface{}, status interface{}, publishAt interface{}, updatedAt interface{}) *CourseRepository_UpdateStatus_Call {
+	return &CourseRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", id, status, publishAt, updatedAt)}
+}
+
+func (_c *CourseRepository_UpdateStatus_Call) Run(run func(id uuid.UUID, status course_status_enum.CourseStatus, publishAt *int64, updatedAt int64)) *CourseRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(course_status_enum.CourseStatus), args[2].(*int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_UpdateStatus_Call) Return(_a0 error) *CourseRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseRepository_UpdateStatus_Call) RunAndReturn(run func(uuid.UUID, course_status_enum.CourseStatus, *int64, int64) error) *CourseRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: tag
+func (_m *CourseRepository) UpdateTag(tag entity.CourseTags) (int64, error) {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.CourseTags) (int64, error)); ok {
+		return rf(tag)
+	}
+	if rf, ok := ret.Get(0).(func(entity.CourseTags) int64); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.CourseTags) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type CourseRepository_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - tag entity.CourseTags
+func (_e *CourseRepository_Expecter) UpdateTag(tag interface{}) *CourseRepository_UpdateTag_Call {
+	return &CourseRepository_UpdateTag_Call{Call: _e.mock.On("UpdateTag", tag)}
+}
+
+func (_c *CourseRepository_UpdateTag_Call) Run(run func(tag entity.CourseTags)) *CourseRepository_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.CourseTags))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_UpdateTag_Call) Return(_a0 int64, _a1 error) *CourseRepository_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_UpdateTag_Call) RunAndReturn(run func(entity.CourseTags) (int64, error)) *CourseRepository_UpdateTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
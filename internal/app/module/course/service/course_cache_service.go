@@ -0,0 +1,190 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/repository"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	courseCacheTTL = 5 * time.Minute
+	// courseGenerationKey holds the generation every catalogue key is
+	// scoped to. Starting a new one invalidates them all at once, and a
+	// load that raced the write lands under the old generation where no
+	// one reads it anymore.
+	courseGenerationKey = "courses:generation"
+	courseGenerationTTL = 24 * time.Hour
+)
+
+// readCachedCourse returns a course with its instructors and the content
+// selected by relations, and an ETag of the cached data. Visibility is left
+// to the caller, so every viewer shares the entry.
+func (s *Service) readCachedCourse(courseID uuid.UUID, relations repository.Relations) (entity.Course, string, error) {
+	var course entity.Course
+	key := fmt.Sprintf("detail:%s:%s", courseID, relationsKey(relations))
+	etag, err := s.readCached(key, &course, func() (any, error) {
+		course, err := s.repository.ReadOneWith(courseID, relations)
+		if err != nil || course.ID == uuid.Nil {
+			return course, err
+		}
+
+		course.Instructors, err = s.repository.ReadInstructors([]uuid.UUID{course.ID})
+		return course, err
+	})
+	return course, etag, err
+}
+
+// readCachedCourses returns a page of the courses userID may see, with
+// their instructors and the content selected by relations, and an ETag of
+// the cached data.
+func (s *Service) readCachedCourses(limit int, offset int, userID string, relations repository.Relations) ([]entity.Course, string, error) {
+	var courses []entity.Course
+	key := fmt.Sprintf("list:%d:%d:%s:%s", limit, offset, userID, relationsKey(relations))
+	etag, err := s.readCached(key, &courses, func() (any, error) {
+		courses, err := s.repository.ReadMany(limit, offset, userID, relations)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.attachInstructors(courses)
+		return courses, err
+	})
+	return courses, etag, err
+}
+
+// readCached decodes the entry at key into out, loading and caching it on a
+// miss. Concurrent misses of a key share one load. The cache failing only
+// costs the load: it is logged and the database answers instead.
+func (s *Service) readCached(key string, out any, load func() (any, error)) (string, error) {
+	ctx := context.Background()
+	key = "courses:" + s.cacheGeneration(ctx) + ":" + key
+
+	data, ok, err := s.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("failed to read course cache %s: %v\n", key, err)
+	}
+
+	if !ok {
+		data, err = s.loads.Do(key, func() ([]byte, error) {
+			value, err := load()
+			if err != nil {
+				return nil, err
+			}
+
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := s.cache.Set(ctx, key, data, courseCacheTTL); err != nil {
+				log.Printf("failed to write course cache %s: %v\n", key, err)
+			}
+			return data, nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return "", err
+	}
+
+	return etagOf(data), nil
+}
+
+// cacheGeneration returns the current generation, starting one when there
+// is none.
+func (s *Service) cacheGeneration(ctx context.Context) string {
+	generation, ok, err := s.cache.Get(ctx, courseGenerationKey)
+	if err != nil {
+		log.Printf("failed to read course cache generation: %v\n", err)
+	}
+	if ok {
+		return string(generation)
+	}
+
+	return s.newCacheGeneration(ctx)
+}
+
+func (s *Service) newCacheGeneration(ctx context.Context) string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	generation := hex.EncodeToString(b)
+
+	if err := s.cache.Set(ctx, courseGenerationKey, []byte(generation), courseGenerationTTL); err != nil {
+		log.Printf("failed to write course cache generation: %v\n", err)
+	}
+
+	return generation
+}
+
+// invalidateCourses drops every cached catalogue read. Writes go through
+// here rather than deleting the keys they touch, since a single course
+// shows up on any number of cached pages.
+func (s *Service) invalidateCourses() {
+	s.newCacheGeneration(context.Background())
+}
+
+// RecordModeration is called by the moderation module whenever an action
+// hides, restores or removes reported content. Reviews are part of course
+// reads, so changing one invalidates them.
+func (s *Service) RecordModeration(targetType moderation_target_enum.ModerationTarget, targetID string) error {
+	if targetType == moderation_target_enum.Review {
+		s.invalidateCourses()
+	}
+	return nil
+}
+
+// etagOf returns a weak ETag of data. It is weak because responses built
+// from the same data still differ in their freshly signed media URLs.
+func etagOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// responseETag derives the ETag of a response from the ETag of its cached
+// data. Sparse fieldsets are different representations, so the fields are
+// part of it. So is the signing window: the ETag changes every half URL
+// TTL, which keeps a client revalidating a cached copy from holding on to
+// media URLs with less than half their lifetime left.
+func (s *Service) responseETag(etag string, view dto.CourseViewDTO) string {
+	window := int64(0)
+	if half := (s.media.URLTTL() / 2).Milliseconds(); half > 0 {
+		window = timepkg.NowUnixMilli() / half
+	}
+	return etagOf([]byte(fmt.Sprintf("%s fields=%s window=%d", etag, strings.Join(view.Fields, ","), window)))
+}
+
+// etagMatches reports whether the If-None-Match header ifNoneMatch lists
+// etag, comparing weakly as RFC 9110 asks for conditional GETs.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" || etag == "" {
+		return false
+	}
+
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,214 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/repository"
+	"CodeWithAzri/internal/app/module/course/repository/mocks"
+	"CodeWithAzri/internal/app/module/course/service"
+	serviceMocks "CodeWithAzri/internal/app/module/course/service/mocks"
+	"CodeWithAzri/pkg/cache"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// failingCache fails every call, like an unreachable Redis.
+type failingCache struct{}
+
+func (failingCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return nil, false, errors.New("Cache Failure")
+}
+
+func (failingCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return errors.New("Cache Failure")
+}
+
+func (failingCache) Delete(ctx context.Context, key string) error {
+	return errors.New("Cache Failure")
+}
+
+func initializeServiceWithCache(t *testing.T, c cache.Cache) (service.CourseService, *mocks.CourseRepository) {
+	mockRepo := mocks.NewCourseRepository(t)
+	mockMedia := serviceMocks.NewMediaReader(t)
+	mockMedia.On("URLTTL").Return(15 * time.Minute).Maybe()
+	return service.NewCourseService(mockRepo, mockMedia, c), mockRepo
+}
+
+func TestService_GetDetailCourse_Cache(t *testing.T) {
+	t.Run("Serve Repeated Reads From Cache", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+		first, firstETag, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		second, secondETag, err := courseService.GetDetailCourse(MockEntity.ID, "learner-uid", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+		assert.Len(t, second.Instructors, 2)
+		assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, firstETag)
+		assert.Equal(t, firstETag, secondETag)
+	})
+
+	t.Run("Check Visibility After Cache", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		draftCourse := MockEntity
+		draftCourse.Status = course_status_enum.Draft
+		mockRepo.On("ReadOneWith", draftCourse.ID, repository.ContentRelations).Return(draftCourse, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+		courseDTO, etag, err := courseService.GetDetailCourse(draftCourse.ID, draftCourse.OwnerID, dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, draftCourse.ID, courseDTO.ID)
+		assert.NotEmpty(t, etag)
+
+		courseDTO, etag, err = courseService.GetDetailCourse(draftCourse.ID, "learner-uid", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, courseDTO.ID)
+		assert.Empty(t, etag)
+	})
+
+	t.Run("Invalidate On Write", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		published := MockEntity
+		published.Name = "Published Course"
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(published, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Twice()
+		mockRepo.On("PublishDue", mock.AnythingOfType("int64")).Return(int64(0), nil).Once()
+		mockRepo.On("PublishDue", mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+
+		before, beforeETag, _ := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		courseService.PublishScheduledCourses()
+		unchanged, unchangedETag, _ := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		courseService.PublishScheduledCourses()
+		after, afterETag, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, before.Name, unchanged.Name)
+		assert.Equal(t, beforeETag, unchangedETag)
+		assert.Equal(t, "Published Course", after.Name)
+		assert.NotEqual(t, beforeETag, afterETag)
+	})
+
+	t.Run("Collapse Concurrent Misses", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadMany", 10, 0, "", summaryRelations).Return([]entity.Course{}, nil).Once()
+		courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Run(func(args mock.Arguments) {
+			close(started)
+			<-release
+		}).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+		var wg sync.WaitGroup
+		read := func() {
+			defer wg.Done()
+			courseDTO, _, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+			assert.NoError(t, err)
+			assert.Equal(t, MockEntity.ID, courseDTO.ID)
+		}
+
+		wg.Add(1)
+		go read()
+		<-started
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go read()
+		}
+		// Give the late readers time to join the running load.
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("Invalidate On Review Moderation", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(MockEntity, nil).Twice()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Twice()
+
+		courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, courseService.RecordModeration(moderation_target_enum.Comment, uuid.NewString()))
+		courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, courseService.RecordModeration(moderation_target_enum.Review, uuid.NewString()))
+		_, _, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fall Back To Repository When Cache Fails", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, failingCache{})
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(MockEntity, nil).Twice()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Twice()
+
+		for i := 0; i < 2; i++ {
+			courseDTO, etag, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, MockEntity.ID, courseDTO.ID)
+			assert.NotEmpty(t, etag)
+		}
+	})
+
+	t.Run("Do Not Cache Errors", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(entity.Course{}, errors.New("Repository Failure")).Once()
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.ContentRelations).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+		_, _, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		assert.EqualError(t, err, "Repository Failure")
+
+		courseDTO, _, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, MockEntity.ID, courseDTO.ID)
+	})
+}
+
+func TestService_GetPaginatedCourses_Cache(t *testing.T) {
+	t.Run("Cache Pages Per Viewer", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadMany", 10, 0, "", summaryRelations).Return(MockArrayEntity, nil).Once()
+		mockRepo.On("ReadMany", 10, 0, "instructor-uid", summaryRelations).Return(MockArrayEntity[:1], nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Twice()
+
+		anonymous, anonymousETag, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		cached, cachedETag, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		instructor, instructorETag, err := courseService.GetPaginatedCourses(10, 1, "instructor-uid", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+
+		assert.Equal(t, anonymous, cached)
+		assert.Equal(t, anonymousETag, cachedETag)
+		assert.Len(t, anonymous, 2)
+		assert.Len(t, instructor, 1)
+		assert.NotEqual(t, anonymousETag, instructorETag)
+	})
+
+	t.Run("Invalidate On Instructor Change", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadMany", 10, 0, "", summaryRelations).Return(MockArrayEntity, nil).Twice()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("RemoveInstructor", MockEntity.ID, "editor-uid").Return(nil).Once()
+
+		courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+		err := courseService.RemoveInstructor(MockEntity.ID, MockEntity.OwnerID, "editor-uid")
+		assert.NoError(t, err)
+		courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+	})
+}
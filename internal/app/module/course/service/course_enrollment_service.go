@@ -0,0 +1,49 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+
+	"github.com/google/uuid"
+)
+
+// Enroll gives userID access to the lessons of a published course.
+func (s *Service) Enroll(courseID uuid.UUID, userID string) (dto.CourseEnrollmentDTO, error) {
+	course, err := s.repository.ReadOne(courseID)
+	if err != nil {
+		return dto.CourseEnrollmentDTO{}, err
+	}
+
+	if course.ID == uuid.Nil || course.Status != course_status_enum.Published {
+		return dto.CourseEnrollmentDTO{}, ErrCourseNotFound
+	}
+
+	enrolled, err := eventService.NewEvent(event_type_enum.CourseEnrolled, courseID.String(), eventDTO.CourseEnrolledPayload{
+		CourseID: courseID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return dto.CourseEnrollmentDTO{}, err
+	}
+
+	createdAt, err := s.repository.Enroll(entity.CourseEnrollment{
+		CourseID:  courseID,
+		UserID:    userID,
+		CreatedAt: enrolled.CreatedAt,
+	}, enrolled)
+	if err != nil {
+		return dto.CourseEnrollmentDTO{}, err
+	}
+
+	return dto.CourseEnrollmentDTO{CourseID: courseID, UserID: userID, CreatedAt: createdAt}, nil
+}
+
+// GetEnrolledUserIDs lists the users enrolled in a course, for other modules
+// that tell them about changes to it.
+func (s *Service) GetEnrolledUserIDs(courseID uuid.UUID) ([]string, error) {
+	return s.repository.ReadEnrolledUserIDs(courseID)
+}
@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/service"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Enroll(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Enroll Success", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("Enroll", mock.MatchedBy(func(enrollment entity.CourseEnrollment) bool {
+			return enrollment.CourseID == MockEntity.ID && enrollment.UserID == "student-uid"
+		}), mock.MatchedBy(func(event eventEntity.Event) bool {
+			return event.Type == event_type_enum.CourseEnrolled && event.AggregateID == MockEntity.ID.String() &&
+				event.Payload == `{"course_id":"`+MockEntity.ID.String()+`","user_id":"student-uid"}`
+		})).Return(int64(121212), nil).Once()
+
+		enrollment, err := courseService.Enroll(MockEntity.ID, "student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockEntity.ID, enrollment.CourseID)
+		assert.Equal(t, "student-uid", enrollment.UserID)
+		assert.Equal(t, int64(121212), enrollment.CreatedAt)
+	})
+
+	t.Run("Enroll In Draft Course", func(t *testing.T) {
+		draft := MockEntity
+		draft.Status = course_status_enum.Draft
+		mockRepo.On("ReadOne", MockEntity.ID).Return(draft, nil).Once()
+
+		_, err := courseService.Enroll(MockEntity.ID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrCourseNotFound)
+	})
+
+	t.Run("Enroll Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("Enroll", mock.AnythingOfType("entity.CourseEnrollment"), mock.AnythingOfType("entity.Event")).Return(int64(0), errors.New("Repository Failure")).Once()
+
+		_, err := courseService.Enroll(MockEntity.ID, "student-uid")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetEnrolledUserIDs(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Get Enrolled User IDs Successfully", func(t *testing.T) {
+		mockRepo.On("ReadEnrolledUserIDs", MockEntity.ID).Return([]string{"student-uid"}, nil).Once()
+
+		userIDs, err := courseService.GetEnrolledUserIDs(MockEntity.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"student-uid"}, userIDs)
+	})
+
+	t.Run("Get Enrolled User IDs Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadEnrolledUserIDs", MockEntity.ID).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.GetEnrolledUserIDs(MockEntity.ID)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
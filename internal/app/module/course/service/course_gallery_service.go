@@ -0,0 +1,90 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	mediaDTO "CodeWithAzri/internal/app/module/media/dto"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaReader resolves the uploaded images that gallery items point at and
+// the uploaded videos of lessons. URLTTL tells how long the URLs it signs
+// stay valid.
+type MediaReader interface {
+	GetImages(ids []uuid.UUID) (map[uuid.UUID]mediaDTO.MediaDTO, error)
+	GetVideos(ids []uuid.UUID) (map[uuid.UUID]mediaDTO.MediaDTO, error)
+	StreamVideo(id uuid.UUID) (mediaDTO.StreamDTO, error)
+	URLTTL() time.Duration
+}
+
+// attachGalleryImages fills in the signed URL and the resized variants of
+// gallery items that reference an uploaded image. It takes the gallery of
+// every course so they are resolved together.
+func (s *Service) attachGalleryImages(galleries ...[]dto.CourseGalleryDTO) error {
+	mediaIDs := make([]uuid.UUID, 0)
+	for _, gallery := range galleries {
+		for _, galleryItem := range gallery {
+			if galleryItem.MediaID != nil {
+				mediaIDs = append(mediaIDs, *galleryItem.MediaID)
+			}
+		}
+	}
+
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+
+	images, err := s.media.GetImages(mediaIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, gallery := range galleries {
+		for i := range gallery {
+			galleryItem := &gallery[i]
+			if galleryItem.MediaID == nil {
+				continue
+			}
+
+			image, ok := images[*galleryItem.MediaID]
+			if !ok {
+				continue
+			}
+			galleryItem.URL = image.URL
+			galleryItem.Variants = image.Variants
+		}
+	}
+
+	return nil
+}
+
+// validateGalleryMedia makes sure every uploaded image referenced by input
+// has finished uploading and was uploaded for a course gallery.
+func (s *Service) validateGalleryMedia(input dto.UpdateCourseDTO) error {
+	mediaIDs := make([]uuid.UUID, 0)
+	for _, galleryInput := range input.Gallery {
+		if galleryInput.MediaID != nil {
+			mediaIDs = append(mediaIDs, *galleryInput.MediaID)
+		}
+	}
+
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+
+	images, err := s.media.GetImages(mediaIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, mediaID := range mediaIDs {
+		image, ok := images[mediaID]
+		if !ok || image.Purpose != media_purpose_enum.CourseGallery {
+			return ErrInvalidGalleryMedia
+		}
+	}
+
+	return nil
+}
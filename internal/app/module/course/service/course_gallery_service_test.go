@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/repository"
+	"CodeWithAzri/internal/app/module/course/service"
+	mediaDTO "CodeWithAzri/internal/app/module/media/dto"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var mockGalleryMediaID = uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+
+var mockGalleryImage = mediaDTO.MediaDTO{
+	ID:      mockGalleryMediaID,
+	OwnerID: "instructor-uid",
+	Purpose: "course_gallery",
+	Status:  "ready",
+	URL:     "http://localhost/api/v1/media/files/course_gallery/6a0c3e8e.png?expires=1&signature=abc",
+	Variants: []mediaDTO.ImageVariantDTO{
+		{Name: "thumbnail", Format: "jpeg", Width: 200, Height: 150, URL: "http://localhost/api/v1/media/files/course_gallery/6a0c3e8e/thumbnail.jpg?expires=1&signature=def"},
+	},
+}
+
+func courseWithUploadedImage() entity.Course {
+	course := MockEntity
+	course.Gallery = append([]entity.CourseGallery{}, MockEntity.Gallery...)
+	course.Gallery = append(course.Gallery, entity.CourseGallery{
+		ID:       uuid.MustParse("b2b71fda-f0f2-4358-9722-b3f13c4564a6"),
+		CourseID: MockEntity.ID,
+		MediaID:  &mockGalleryMediaID,
+	})
+	return course
+}
+
+func TestService_GetDetailCourse_GalleryImages(t *testing.T) {
+	courseService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	course := courseWithUploadedImage()
+	mockRepo.On("ReadOneWith", course.ID, repository.ContentRelations).Return(course, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Attach Gallery Images", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockGalleryMediaID: mockGalleryImage}, nil).Once()
+
+		courseDTO, _, err := courseService.GetDetailCourse(course.ID, "", dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+		uploaded := courseDTO.Gallery[len(courseDTO.Gallery)-1]
+		assert.Equal(t, mockGalleryImage.URL, uploaded.URL)
+		assert.Equal(t, mockGalleryImage.Variants, uploaded.Variants)
+		assert.Equal(t, MockEntity.Gallery[0].URL, courseDTO.Gallery[0].URL)
+		assert.Empty(t, courseDTO.Gallery[0].Variants)
+	})
+
+	t.Run("Attach Gallery Images Missing Media", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{}, nil).Once()
+
+		courseDTO, _, err := courseService.GetDetailCourse(course.ID, "", dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, courseDTO.Gallery[len(courseDTO.Gallery)-1].URL)
+	})
+
+	t.Run("Attach Gallery Images Error", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(nil, errors.New("Media Failure")).Once()
+
+		_, _, err := courseService.GetDetailCourse(course.ID, "", dto.CourseViewDTO{}, "")
+
+		assert.EqualError(t, err, "Media Failure")
+	})
+}
+
+func TestService_GetDetailCourse_NotModified(t *testing.T) {
+	courseService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	course := courseWithUploadedImage()
+	mockRepo.On("ReadOneWith", course.ID, repository.ContentRelations).Return(course, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+	mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockGalleryMediaID: mockGalleryImage}, nil).Once()
+
+	defer monkey.UnpatchAll()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return now })
+
+	_, etag, err := courseService.GetDetailCourse(course.ID, "", dto.CourseViewDTO{}, "")
+	assert.NoError(t, err)
+
+	t.Run("Skip Signing When Cached Copy Is Current", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			ifNoneMatch string
+		}{
+			{"Matching ETag", etag},
+			{"Strong Form Of ETag", strings.TrimPrefix(etag, "W/")},
+			{"ETag In List", `"stale", ` + etag},
+			{"Any ETag", "*"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				courseDTO, notModifiedETag, err := courseService.GetDetailCourse(course.ID, "", dto.CourseViewDTO{}, test.ifNoneMatch)
+
+				assert.ErrorIs(t, err, service.ErrNotModified)
+				assert.Equal(t, etag, notModifiedETag)
+				assert.Equal(t, uuid.Nil, courseDTO.ID)
+			})
+		}
+	})
+
+	t.Run("Change ETag With Signing Window", func(t *testing.T) {
+		now += (15 * time.Minute / 2).Milliseconds()
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockGalleryMediaID: mockGalleryImage}, nil).Once()
+
+		courseDTO, renewedETag, err := courseService.GetDetailCourse(course.ID, "", dto.CourseViewDTO{}, etag)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, etag, renewedETag)
+		assert.Equal(t, mockGalleryImage.URL, courseDTO.Gallery[len(courseDTO.Gallery)-1].URL)
+	})
+}
+
+func TestService_UpdateCourse_GalleryMedia(t *testing.T) {
+	courseService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	input := dto.UpdateCourseDTO{
+		Name:        "Updated Course",
+		Description: "Updated Description",
+		Language:    "en",
+		Gallery:     []dto.UpsertCourseGalleryDTO{{URL: "https://example.com/ignored.png", MediaID: &mockGalleryMediaID}},
+	}
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Update Course With Uploaded Image", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockGalleryMediaID: mockGalleryImage}, nil).Twice()
+
+		var savedCourse entity.Course
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision")).Run(func(args mock.Arguments) {
+			savedCourse = args.Get(2).(entity.Course)
+		}).Return(int64(1), nil).Once()
+
+		courseDTO, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &mockGalleryMediaID, savedCourse.Gallery[0].MediaID)
+		assert.Empty(t, savedCourse.Gallery[0].URL)
+		assert.Equal(t, mockGalleryImage.URL, courseDTO.Gallery[0].URL)
+		assert.Len(t, courseDTO.Gallery[0].Variants, 1)
+	})
+
+	t.Run("Update Course With Image Of Another Purpose", func(t *testing.T) {
+		profilePicture := mockGalleryImage
+		profilePicture.Purpose = "profile_picture"
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockGalleryMediaID: profilePicture}, nil).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.ErrorIs(t, err, service.ErrInvalidGalleryMedia)
+	})
+
+	t.Run("Update Course With Unknown Image", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mockGalleryMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{}, nil).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.ErrorIs(t, err, service.ErrInvalidGalleryMedia)
+	})
+}
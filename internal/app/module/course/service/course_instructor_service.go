@@ -0,0 +1,112 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/pkg/adapter"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
+	timepkg "CodeWithAzri/pkg/timePkg"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) GetInstructorCourses(instructorID string, limit int, page int, viewerID string) ([]dto.CourseSummaryDTO, error) {
+	offset := (page - 1) * limit
+
+	courses, err := s.repository.ReadManyByInstructor(instructorID, limit, offset, viewerID, summaryRelations)
+	if err != nil {
+		return []dto.CourseSummaryDTO{}, err
+	}
+
+	err = s.attachInstructors(courses)
+	if err != nil {
+		return []dto.CourseSummaryDTO{}, err
+	}
+
+	return s.toCourseSummaryDTOs(courses)
+}
+
+func (s *Service) AddInstructor(courseID uuid.UUID, userID string, input dto.AddCourseInstructorDTO) ([]dto.CourseInstructorDTO, error) {
+	course, err := s.readOwnedCourse(courseID, userID)
+	if err != nil {
+		return []dto.CourseInstructorDTO{}, err
+	}
+
+	if instructorRole(course, input.UserID) == instructor_role_enum.Owner {
+		return []dto.CourseInstructorDTO{}, ErrOwnerChange
+	}
+
+	now := timepkg.NowUnixMilli()
+	added, err := s.repository.AddInstructor(entity.CourseInstructor{
+		CourseID:  courseID,
+		UserID:    input.UserID,
+		Role:      input.Role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return []dto.CourseInstructorDTO{}, err
+	}
+
+	if added == 0 {
+		return []dto.CourseInstructorDTO{}, ErrUserNotFound
+	}
+	s.invalidateCourses()
+
+	instructors, err := s.repository.ReadInstructors([]uuid.UUID{courseID})
+	if err != nil {
+		return []dto.CourseInstructorDTO{}, err
+	}
+
+	return adapter.AnyToType[[]dto.CourseInstructorDTO](instructors)
+}
+
+func (s *Service) RemoveInstructor(courseID uuid.UUID, userID string, instructorID string) error {
+	course, err := s.readOwnedCourse(courseID, userID)
+	if err != nil {
+		return err
+	}
+
+	switch instructorRole(course, instructorID) {
+	case instructor_role_enum.Owner:
+		return ErrOwnerChange
+	case "":
+		return ErrInstructorNotFound
+	}
+
+	err = s.repository.RemoveInstructor(courseID, instructorID)
+	if err != nil {
+		return err
+	}
+
+	s.invalidateCourses()
+	return nil
+}
+
+// attachInstructors loads the instructors of every course in one query.
+func (s *Service) attachInstructors(courses []entity.Course) error {
+	if len(courses) == 0 {
+		return nil
+	}
+
+	courseIDs := make([]uuid.UUID, 0, len(courses))
+	for _, course := range courses {
+		courseIDs = append(courseIDs, course.ID)
+	}
+
+	instructors, err := s.repository.ReadInstructors(courseIDs)
+	if err != nil {
+		return err
+	}
+
+	byCourse := make(map[uuid.UUID][]entity.CourseInstructor)
+	for _, instructor := range instructors {
+		byCourse[instructor.CourseID] = append(byCourse[instructor.CourseID], instructor)
+	}
+
+	for i := range courses {
+		courses[i].Instructors = byCourse[courses[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/service"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetInstructorCourses(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Get Instructor Courses Success", func(t *testing.T) {
+		mockRepo.On("ReadManyByInstructor", "instructor-uid", 10, 10, "viewer-uid", summaryRelations).Return(MockArrayEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockArrayEntity[0].ID, MockArrayEntity[1].ID}).Return(MockInstructors, nil).Once()
+
+		courses, err := courseService.GetInstructorCourses("instructor-uid", 10, 2, "viewer-uid")
+
+		assert.NoError(t, err)
+		assert.Len(t, courses, 2)
+		assert.Equal(t, instructor_role_enum.Owner, courses[0].Instructors[0].Role)
+		assert.Equal(t, "instructor-uid", courses[0].Instructors[0].Profile.ID)
+	})
+
+	t.Run("Get Instructor Courses Empty", func(t *testing.T) {
+		mockRepo.On("ReadManyByInstructor", "nobody", 10, 0, "", summaryRelations).Return(nil, nil).Once()
+
+		courses, err := courseService.GetInstructorCourses("nobody", 10, 1, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.CourseSummaryDTO{}, courses)
+	})
+
+	t.Run("Get Instructor Courses Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadManyByInstructor", "instructor-uid", 10, 0, "", summaryRelations).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.GetInstructorCourses("instructor-uid", 10, 1, "")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_AddInstructor(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil)
+
+	input := dto.AddCourseInstructorDTO{UserID: "new-editor-uid", Role: instructor_role_enum.Editor}
+
+	t.Run("Add Instructor Success", func(t *testing.T) {
+		mockRepo.On("AddInstructor", mock.MatchedBy(func(instructor entity.CourseInstructor) bool {
+			return instructor.CourseID == MockEntity.ID && instructor.UserID == "new-editor-uid" && instructor.Role == instructor_role_enum.Editor
+		})).Return(int64(1), nil).Once()
+
+		instructors, err := courseService.AddInstructor(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.NoError(t, err)
+		assert.Len(t, instructors, 2)
+		assert.Equal(t, "Mock Instructor", instructors[0].Profile.Name)
+	})
+
+	t.Run("Add Instructor Unknown User", func(t *testing.T) {
+		mockRepo.On("AddInstructor", mock.AnythingOfType("entity.CourseInstructor")).Return(int64(0), nil).Once()
+
+		_, err := courseService.AddInstructor(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Add Instructor Demotes Owner", func(t *testing.T) {
+		_, err := courseService.AddInstructor(MockEntity.ID, MockEntity.OwnerID, dto.AddCourseInstructorDTO{UserID: MockEntity.OwnerID, Role: instructor_role_enum.Editor})
+
+		assert.ErrorIs(t, err, service.ErrOwnerChange)
+	})
+
+	t.Run("Add Instructor As Editor", func(t *testing.T) {
+		_, err := courseService.AddInstructor(MockEntity.ID, "editor-uid", input)
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("Add Instructor Repository Error", func(t *testing.T) {
+		mockRepo.On("AddInstructor", mock.AnythingOfType("entity.CourseInstructor")).Return(int64(0), errors.New("Repository Failure")).Once()
+
+		_, err := courseService.AddInstructor(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Repository Failure")
+	})
+}
+
+func TestService_RemoveInstructor(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil)
+
+	t.Run("Remove Instructor Success", func(t *testing.T) {
+		mockRepo.On("RemoveInstructor", MockEntity.ID, "editor-uid").Return(nil).Once()
+
+		err := courseService.RemoveInstructor(MockEntity.ID, MockEntity.OwnerID, "editor-uid")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Remove Instructor Owner", func(t *testing.T) {
+		err := courseService.RemoveInstructor(MockEntity.ID, MockEntity.OwnerID, MockEntity.OwnerID)
+
+		assert.ErrorIs(t, err, service.ErrOwnerChange)
+	})
+
+	t.Run("Remove Instructor Not An Instructor", func(t *testing.T) {
+		err := courseService.RemoveInstructor(MockEntity.ID, MockEntity.OwnerID, "learner-uid")
+
+		assert.ErrorIs(t, err, service.ErrInstructorNotFound)
+	})
+
+	t.Run("Remove Instructor As Editor", func(t *testing.T) {
+		err := courseService.RemoveInstructor(MockEntity.ID, "editor-uid", "editor-uid")
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+}
+
+func TestService_EditorRights(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	reviewCourse := MockEntity
+	reviewCourse.Status = course_status_enum.Review
+
+	mockRepo.On("ReadOne", reviewCourse.ID).Return(reviewCourse, nil)
+	mockRepo.On("ReadInstructors", []uuid.UUID{reviewCourse.ID}).Return(MockInstructors, nil)
+
+	t.Run("Editor Cannot Change Status", func(t *testing.T) {
+		_, err := courseService.ChangeStatus(reviewCourse.ID, "editor-uid", dto.UpdateCourseStatusDTO{Status: course_status_enum.Published})
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("Editor Can Update Content", func(t *testing.T) {
+		mockRepo.On("Update", reviewCourse.ID, reviewCourse.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.MatchedBy(func(revision entity.CourseRevision) bool {
+			return revision.CreatedBy == "editor-uid"
+		})).Return(int64(1), nil).Once()
+
+		_, err := courseService.UpdateCourse(reviewCourse.ID, "editor-uid", dto.UpdateCourseDTO{Name: "Edited", Description: "Edited", Language: "en"})
+
+		assert.NoError(t, err)
+	})
+}
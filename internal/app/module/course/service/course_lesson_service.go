@@ -0,0 +1,269 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	mediaDTO "CodeWithAzri/internal/app/module/media/dto"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// GetLessonStream hands out a short-lived manifest URL for the uploaded video
+// of a lesson. Instructors can always watch, everyone else has to be
+// enrolled in the published course.
+func (s *Service) GetLessonStream(courseID uuid.UUID, lessonID uuid.UUID, userID string) (dto.LessonStreamDTO, error) {
+	course, err := s.readLessonsAs(courseID, userID)
+	if err != nil {
+		return dto.LessonStreamDTO{}, err
+	}
+
+	lesson, ok := findLesson(course, lessonID)
+	if !ok {
+		return dto.LessonStreamDTO{}, ErrLessonNotFound
+	}
+
+	if lesson.MediaID == nil {
+		return dto.LessonStreamDTO{}, ErrLessonNotStreamable
+	}
+
+	videos, err := s.media.GetVideos([]uuid.UUID{*lesson.MediaID})
+	if err != nil {
+		return dto.LessonStreamDTO{}, err
+	}
+
+	video, ok := videos[*lesson.MediaID]
+	if !ok {
+		return dto.LessonStreamDTO{}, ErrLessonNotStreamable
+	}
+
+	if video.ProcessingStatus != processing_status_enum.Done {
+		return dto.LessonStreamDTO{}, ErrLessonVideoNotReady
+	}
+
+	stream, err := s.media.StreamVideo(*lesson.MediaID)
+	if err != nil {
+		return dto.LessonStreamDTO{}, err
+	}
+
+	return dto.LessonStreamDTO{
+		LessonID:    lesson.ID,
+		ManifestURL: stream.ManifestURL,
+		ExpiresAt:   stream.ExpiresAt,
+		Duration:    stream.Duration,
+	}, nil
+}
+
+// CompleteLesson marks a lesson as completed by userID. Completing it again
+// keeps the original date and does not publish another event.
+func (s *Service) CompleteLesson(courseID uuid.UUID, lessonID uuid.UUID, userID string) (dto.LessonProgressDTO, error) {
+	course, err := s.readLessonsAs(courseID, userID)
+	if err != nil {
+		return dto.LessonProgressDTO{}, err
+	}
+
+	_, ok := findLesson(course, lessonID)
+	if !ok {
+		return dto.LessonProgressDTO{}, ErrLessonNotFound
+	}
+
+	completed, err := eventService.NewEvent(event_type_enum.LessonCompleted, lessonID.String(), eventDTO.LessonCompletedPayload{
+		CourseID: courseID,
+		LessonID: lessonID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return dto.LessonProgressDTO{}, err
+	}
+
+	completedAt, err := s.repository.CompleteLesson(entity.CourseLessonProgress{
+		LessonID:    lessonID,
+		UserID:      userID,
+		CourseID:    courseID,
+		CompletedAt: completed.CreatedAt,
+	}, completed)
+	if err != nil {
+		return dto.LessonProgressDTO{}, err
+	}
+
+	return dto.LessonProgressDTO{CourseID: courseID, LessonID: lessonID, UserID: userID, CompletedAt: completedAt}, nil
+}
+
+// GetCourseProgress counts the lessons userID completed, with the same
+// access rules as completing them.
+func (s *Service) GetCourseProgress(courseID uuid.UUID, userID string) (dto.CourseProgressDTO, error) {
+	course, err := s.readLessonsAs(courseID, userID)
+	if err != nil {
+		return dto.CourseProgressDTO{}, err
+	}
+
+	completedIDs, err := s.repository.ReadCompletedLessons(courseID, userID)
+	if err != nil {
+		return dto.CourseProgressDTO{}, err
+	}
+
+	progress := dto.CourseProgressDTO{
+		CourseID: course.ID,
+		Name:     course.Name,
+		Language: course.Language,
+		UserID:   userID,
+	}
+	for _, section := range course.Sections {
+		for _, lesson := range section.Lessons {
+			progress.TotalLessons++
+			if slices.Contains(completedIDs, lesson.ID) {
+				progress.CompletedLessons++
+			}
+		}
+	}
+	progress.Completed = progress.TotalLessons > 0 && progress.CompletedLessons == progress.TotalLessons
+
+	return progress, nil
+}
+
+// readLessonsAs loads a course whose lessons userID may take. Instructors
+// always can, everyone else has to be enrolled in the published course.
+// GetLessonAccess finds the course of a lesson and checks userID may see it,
+// with the same rules as watching it. Other modules use it to guard what they
+// attach to lessons.
+func (s *Service) GetLessonAccess(lessonID uuid.UUID, userID string) (dto.LessonAccessDTO, error) {
+	courseID, err := s.repository.ReadLessonCourseID(lessonID)
+	if err != nil {
+		return dto.LessonAccessDTO{}, err
+	}
+
+	if courseID == uuid.Nil {
+		return dto.LessonAccessDTO{}, ErrLessonNotFound
+	}
+
+	course, err := s.readLessonsAs(courseID, userID)
+	if err != nil {
+		return dto.LessonAccessDTO{}, err
+	}
+
+	lesson, ok := findLesson(course, lessonID)
+	if !ok {
+		return dto.LessonAccessDTO{}, ErrLessonNotFound
+	}
+
+	return dto.LessonAccessDTO{
+		CourseID:    course.ID,
+		CourseName:  course.Name,
+		Language:    course.Language,
+		LessonID:    lesson.ID,
+		LessonTitle: lesson.Title,
+		Instructor:  instructorRole(course, userID) != "",
+	}, nil
+}
+
+func (s *Service) readLessonsAs(courseID uuid.UUID, userID string) (entity.Course, error) {
+	course, err := s.repository.ReadOne(courseID)
+	if err != nil {
+		return entity.Course{}, err
+	}
+
+	if course.ID == uuid.Nil {
+		return entity.Course{}, ErrCourseNotFound
+	}
+
+	course.Instructors, err = s.repository.ReadInstructors([]uuid.UUID{courseID})
+	if err != nil {
+		return entity.Course{}, err
+	}
+
+	if instructorRole(course, userID) != "" {
+		return course, nil
+	}
+
+	if course.Status != course_status_enum.Published {
+		return entity.Course{}, ErrCourseNotFound
+	}
+
+	enrolled, err := s.repository.IsEnrolled(courseID, userID)
+	if err != nil {
+		return entity.Course{}, err
+	}
+
+	if !enrolled {
+		return entity.Course{}, ErrNotEnrolled
+	}
+
+	return course, nil
+}
+
+// RecordVideoDuration is called by the media module once an uploaded video
+// has been packaged.
+func (s *Service) RecordVideoDuration(mediaID uuid.UUID, duration int) error {
+	err := s.repository.UpdateLessonDuration(mediaID, duration, timepkg.NowUnixMilli())
+	if err != nil {
+		return err
+	}
+
+	s.invalidateCourses()
+	return nil
+}
+
+// validateLessonMedia makes sure every video referenced by input has
+// finished uploading and was uploaded as a lesson video. It returns the
+// videos so their durations can be copied onto the lessons.
+func (s *Service) validateLessonMedia(input dto.UpdateCourseDTO) (map[uuid.UUID]mediaDTO.MediaDTO, error) {
+	mediaIDs := make([]uuid.UUID, 0)
+	for _, sectionInput := range input.Sections {
+		for _, lessonInput := range sectionInput.Lessons {
+			if lessonInput.MediaID != nil {
+				mediaIDs = append(mediaIDs, *lessonInput.MediaID)
+			}
+		}
+	}
+
+	if len(mediaIDs) == 0 {
+		return map[uuid.UUID]mediaDTO.MediaDTO{}, nil
+	}
+
+	videos, err := s.media.GetVideos(mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mediaID := range mediaIDs {
+		video, ok := videos[mediaID]
+		if !ok || video.Purpose != media_purpose_enum.LessonVideo {
+			return nil, ErrInvalidLessonMedia
+		}
+	}
+
+	return videos, nil
+}
+
+// applyLessonDurations copies the duration of uploaded videos onto their
+// lessons. Videos that are still being packaged report zero until
+// RecordVideoDuration is called.
+func applyLessonDurations(course *entity.Course, videos map[uuid.UUID]mediaDTO.MediaDTO) {
+	for i := range course.Sections {
+		for j := range course.Sections[i].Lessons {
+			lesson := &course.Sections[i].Lessons[j]
+			lesson.Duration = 0
+			if lesson.MediaID != nil {
+				lesson.Duration = videos[*lesson.MediaID].Duration
+			}
+		}
+	}
+}
+
+func findLesson(course entity.Course, lessonID uuid.UUID) (entity.CourseLesson, bool) {
+	for _, section := range course.Sections {
+		for _, lesson := range section.Lessons {
+			if lesson.ID == lessonID {
+				return lesson, true
+			}
+		}
+	}
+	return entity.CourseLesson{}, false
+}
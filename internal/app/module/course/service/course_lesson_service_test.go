@@ -0,0 +1,381 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/service"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	mediaDTO "CodeWithAzri/internal/app/module/media/dto"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	language_enum "CodeWithAzri/pkg/enums/language"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var mockVideoMediaID = uuid.MustParse("9d4b2c6e-7a1f-4e3b-8c5d-2f6a9b0e1c33")
+
+var mockLessonVideo = mediaDTO.MediaDTO{
+	ID:               mockVideoMediaID,
+	OwnerID:          "instructor-uid",
+	Purpose:          media_purpose_enum.LessonVideo,
+	Status:           "ready",
+	ProcessingStatus: processing_status_enum.Done,
+	Duration:         94,
+}
+
+var mockStream = mediaDTO.StreamDTO{
+	ManifestURL: "http://localhost/api/v1/media/streams/9d4b2c6e-7a1f-4e3b-8c5d-2f6a9b0e1c33/master.m3u8?expires=1&signature=abc",
+	ExpiresAt:   1000,
+	Duration:    94,
+}
+
+// courseWithLessonVideo returns MockEntity with its first lesson playing an
+// uploaded video.
+func courseWithLessonVideo() entity.Course {
+	course := MockEntity
+	course.Sections = append([]entity.CourseSection{}, MockEntity.Sections...)
+	course.Sections[0].Lessons = append([]entity.CourseLesson{}, MockEntity.Sections[0].Lessons...)
+	course.Sections[0].Lessons[0].VideoURL = ""
+	course.Sections[0].Lessons[0].MediaID = &mockVideoMediaID
+	course.Sections[0].Lessons[0].Duration = 94
+	return course
+}
+
+func TestService_GetLessonStream(t *testing.T) {
+	courseService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	course := courseWithLessonVideo()
+	lessonID := course.Sections[0].Lessons[0].ID
+
+	t.Run("Get Lesson Stream Enrolled", func(t *testing.T) {
+		mockRepo.On("ReadOne", course.ID).Return(course, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", course.ID, "student-uid").Return(true, nil).Once()
+		mockMedia.On("GetVideos", []uuid.UUID{mockVideoMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockVideoMediaID: mockLessonVideo}, nil).Once()
+		mockMedia.On("StreamVideo", mockVideoMediaID).Return(mockStream, nil).Once()
+
+		stream, err := courseService.GetLessonStream(course.ID, lessonID, "student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.LessonStreamDTO{LessonID: lessonID, ManifestURL: mockStream.ManifestURL, ExpiresAt: 1000, Duration: 94}, stream)
+	})
+
+	t.Run("Get Lesson Stream Instructor Of Draft", func(t *testing.T) {
+		draft := courseWithLessonVideo()
+		draft.Status = course_status_enum.Draft
+		mockRepo.On("ReadOne", course.ID).Return(draft, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+		mockMedia.On("GetVideos", []uuid.UUID{mockVideoMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockVideoMediaID: mockLessonVideo}, nil).Once()
+		mockMedia.On("StreamVideo", mockVideoMediaID).Return(mockStream, nil).Once()
+
+		_, err := courseService.GetLessonStream(course.ID, lessonID, "editor-uid")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Get Lesson Stream Not Enrolled", func(t *testing.T) {
+		mockRepo.On("ReadOne", course.ID).Return(course, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", course.ID, "student-uid").Return(false, nil).Once()
+
+		_, err := courseService.GetLessonStream(course.ID, lessonID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrNotEnrolled)
+	})
+
+	t.Run("Get Lesson Stream Of Draft Course", func(t *testing.T) {
+		draft := courseWithLessonVideo()
+		draft.Status = course_status_enum.Draft
+		mockRepo.On("ReadOne", course.ID).Return(draft, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+
+		_, err := courseService.GetLessonStream(course.ID, lessonID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrCourseNotFound)
+	})
+
+	t.Run("Get Lesson Stream Unknown Lesson", func(t *testing.T) {
+		mockRepo.On("ReadOne", course.ID).Return(course, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+
+		_, err := courseService.GetLessonStream(course.ID, uuid.New(), "instructor-uid")
+
+		assert.ErrorIs(t, err, service.ErrLessonNotFound)
+	})
+
+	t.Run("Get Lesson Stream External Video", func(t *testing.T) {
+		mockRepo.On("ReadOne", course.ID).Return(course, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+
+		_, err := courseService.GetLessonStream(course.ID, course.Sections[0].Lessons[1].ID, "instructor-uid")
+
+		assert.ErrorIs(t, err, service.ErrLessonNotStreamable)
+	})
+
+	t.Run("Get Lesson Stream Still Processing", func(t *testing.T) {
+		processing := mockLessonVideo
+		processing.ProcessingStatus = processing_status_enum.Pending
+		mockRepo.On("ReadOne", course.ID).Return(course, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{course.ID}).Return(MockInstructors, nil).Once()
+		mockMedia.On("GetVideos", []uuid.UUID{mockVideoMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockVideoMediaID: processing}, nil).Once()
+
+		_, err := courseService.GetLessonStream(course.ID, lessonID, "instructor-uid")
+
+		assert.ErrorIs(t, err, service.ErrLessonVideoNotReady)
+	})
+}
+
+func TestService_CompleteLesson(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	lessonID := MockEntity.Sections[0].Lessons[0].ID
+
+	t.Run("Complete Lesson Enrolled", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(true, nil).Once()
+		mockRepo.On("CompleteLesson", mock.MatchedBy(func(progress entity.CourseLessonProgress) bool {
+			return progress.LessonID == lessonID && progress.CourseID == MockEntity.ID && progress.UserID == "student-uid"
+		}), mock.MatchedBy(func(event eventEntity.Event) bool {
+			return event.Type == event_type_enum.LessonCompleted && event.AggregateID == lessonID.String() &&
+				event.Payload == `{"course_id":"`+MockEntity.ID.String()+`","lesson_id":"`+lessonID.String()+`","user_id":"student-uid"}`
+		})).Return(int64(121212), nil).Once()
+
+		progress, err := courseService.CompleteLesson(MockEntity.ID, lessonID, "student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.LessonProgressDTO{CourseID: MockEntity.ID, LessonID: lessonID, UserID: "student-uid", CompletedAt: 121212}, progress)
+	})
+
+	t.Run("Complete Lesson Not Enrolled", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(false, nil).Once()
+
+		_, err := courseService.CompleteLesson(MockEntity.ID, lessonID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrNotEnrolled)
+	})
+
+	t.Run("Complete Lesson Unknown Lesson", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+
+		_, err := courseService.CompleteLesson(MockEntity.ID, uuid.New(), "instructor-uid")
+
+		assert.ErrorIs(t, err, service.ErrLessonNotFound)
+	})
+
+	t.Run("Complete Lesson Course Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(entity.Course{}, nil).Once()
+
+		_, err := courseService.CompleteLesson(MockEntity.ID, lessonID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrCourseNotFound)
+	})
+
+	t.Run("Complete Lesson Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("CompleteLesson", mock.AnythingOfType("entity.CourseLessonProgress"), mock.AnythingOfType("entity.Event")).Return(int64(0), errors.New("Repository Failure")).Once()
+
+		_, err := courseService.CompleteLesson(MockEntity.ID, lessonID, "instructor-uid")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetCourseProgress(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	lessonIDs := make([]uuid.UUID, 0)
+	for _, section := range MockEntity.Sections {
+		for _, lesson := range section.Lessons {
+			lessonIDs = append(lessonIDs, lesson.ID)
+		}
+	}
+
+	t.Run("Get Course Progress Partly Completed", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(true, nil).Once()
+		mockRepo.On("ReadCompletedLessons", MockEntity.ID, "student-uid").Return([]uuid.UUID{lessonIDs[0], uuid.New()}, nil).Once()
+
+		progress, err := courseService.GetCourseProgress(MockEntity.ID, "student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Mock Course", progress.Name)
+		assert.Equal(t, language_enum.English, progress.Language)
+		assert.Equal(t, 1, progress.CompletedLessons)
+		assert.Equal(t, len(lessonIDs), progress.TotalLessons)
+		assert.False(t, progress.Completed)
+	})
+
+	t.Run("Get Course Progress Completed", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(true, nil).Once()
+		mockRepo.On("ReadCompletedLessons", MockEntity.ID, "student-uid").Return(lessonIDs, nil).Once()
+
+		progress, err := courseService.GetCourseProgress(MockEntity.ID, "student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(lessonIDs), progress.CompletedLessons)
+		assert.True(t, progress.Completed)
+	})
+
+	t.Run("Get Course Progress Not Enrolled", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(false, nil).Once()
+
+		_, err := courseService.GetCourseProgress(MockEntity.ID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrNotEnrolled)
+	})
+
+	t.Run("Get Course Progress Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("ReadCompletedLessons", MockEntity.ID, "instructor-uid").Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.GetCourseProgress(MockEntity.ID, "instructor-uid")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetLessonAccess(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+	lesson := MockEntity.Sections[0].Lessons[0]
+
+	t.Run("Get Lesson Access As Student", func(t *testing.T) {
+		mockRepo.On("ReadLessonCourseID", lesson.ID).Return(MockEntity.ID, nil).Once()
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(true, nil).Once()
+
+		access, err := courseService.GetLessonAccess(lesson.ID, "student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.LessonAccessDTO{
+			CourseID:    MockEntity.ID,
+			CourseName:  "Mock Course",
+			Language:    language_enum.English,
+			LessonID:    lesson.ID,
+			LessonTitle: lesson.Title,
+		}, access)
+	})
+
+	t.Run("Get Lesson Access As Instructor", func(t *testing.T) {
+		mockRepo.On("ReadLessonCourseID", lesson.ID).Return(MockEntity.ID, nil).Once()
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+
+		access, err := courseService.GetLessonAccess(lesson.ID, "instructor-uid")
+
+		assert.NoError(t, err)
+		assert.True(t, access.Instructor)
+	})
+
+	t.Run("Get Lesson Access Not Enrolled", func(t *testing.T) {
+		mockRepo.On("ReadLessonCourseID", lesson.ID).Return(MockEntity.ID, nil).Once()
+		mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", []uuid.UUID{MockEntity.ID}).Return(MockInstructors, nil).Once()
+		mockRepo.On("IsEnrolled", MockEntity.ID, "student-uid").Return(false, nil).Once()
+
+		_, err := courseService.GetLessonAccess(lesson.ID, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrNotEnrolled)
+	})
+
+	t.Run("Get Lesson Access Lesson Not Found", func(t *testing.T) {
+		missing := uuid.New()
+		mockRepo.On("ReadLessonCourseID", missing).Return(uuid.Nil, nil).Once()
+
+		_, err := courseService.GetLessonAccess(missing, "student-uid")
+
+		assert.ErrorIs(t, err, service.ErrLessonNotFound)
+	})
+
+	t.Run("Get Lesson Access Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadLessonCourseID", lesson.ID).Return(uuid.Nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.GetLessonAccess(lesson.ID, "student-uid")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_RecordVideoDuration(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("UpdateLessonDuration", mockVideoMediaID, 94, mock.AnythingOfType("int64")).Return(nil).Once()
+
+	err := courseService.RecordVideoDuration(mockVideoMediaID, 94)
+
+	assert.NoError(t, err)
+}
+
+func TestService_UpdateCourse_LessonMedia(t *testing.T) {
+	courseService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	input := dto.UpdateCourseDTO{
+		Name:        "Updated Course",
+		Description: "Updated Description",
+		Language:    "en",
+		Sections: []dto.UpsertCourseSectionDTO{{
+			ID:   MockEntity.Sections[0].ID,
+			Name: "Mock Section",
+			Lessons: []dto.UpsertCourseLessonDTO{
+				{ID: MockEntity.Sections[0].Lessons[0].ID, Title: "Mock Lesson", VideoURL: "https://www.youtube.com", MediaID: &mockVideoMediaID},
+			},
+		}},
+	}
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Update Course With Uploaded Video", func(t *testing.T) {
+		mockMedia.On("GetVideos", []uuid.UUID{mockVideoMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockVideoMediaID: mockLessonVideo}, nil).Once()
+
+		var savedCourse entity.Course
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision")).Run(func(args mock.Arguments) {
+			savedCourse = args.Get(2).(entity.Course)
+		}).Return(int64(1), nil).Once()
+
+		courseDTO, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.NoError(t, err)
+		lesson := savedCourse.Sections[0].Lessons[0]
+		assert.Equal(t, &mockVideoMediaID, lesson.MediaID)
+		assert.Empty(t, lesson.VideoURL)
+		assert.Equal(t, 94, lesson.Duration)
+		assert.Equal(t, 94, courseDTO.Sections[0].Lessons[0].Duration)
+	})
+
+	t.Run("Update Course With Video Of Another Purpose", func(t *testing.T) {
+		upload := mockLessonVideo
+		upload.Purpose = media_purpose_enum.CourseGallery
+		mockMedia.On("GetVideos", []uuid.UUID{mockVideoMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mockVideoMediaID: upload}, nil).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.ErrorIs(t, err, service.ErrInvalidLessonMedia)
+	})
+
+	t.Run("Update Course With Unknown Video", func(t *testing.T) {
+		mockMedia.On("GetVideos", []uuid.UUID{mockVideoMediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{}, nil).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.ErrorIs(t, err, service.ErrInvalidLessonMedia)
+	})
+}
@@ -0,0 +1,39 @@
+package service
+
+// ExportUserData returns the enrollments, lesson progress and reviews of a
+// user for their data export.
+func (s *Service) ExportUserData(userID string) (map[string]any, error) {
+	enrollments, err := s.repository.ReadUserEnrollments(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.repository.ReadUserProgress(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.repository.ReadUserReviews(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"enrollments": enrollments,
+		"progress":    progress,
+		"reviews":     reviews,
+	}, nil
+}
+
+// EraseUserData deletes the enrollments and progress of a user and keeps
+// their reviews without their name.
+func (s *Service) EraseUserData(userID string) error {
+	err := s.repository.EraseUserData(userID)
+	if err != nil {
+		return err
+	}
+
+	// Reviews show up in course details, and they lost their author.
+	s.invalidateCourses()
+	return nil
+}
@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/entity"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_ExportUserData(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	enrollments := []entity.CourseEnrollment{{CourseID: MockEntity.ID, UserID: "student-uid", CreatedAt: 121212}}
+	progress := []entity.CourseLessonProgress{{LessonID: MockEntity.Sections[0].Lessons[0].ID, UserID: "student-uid", CourseID: MockEntity.ID, CompletedAt: 131313}}
+	reviews := []entity.CourseReviews{{ID: MockEntity.ID, CourseID: MockEntity.ID, Value: 5, Comment: "Great course"}}
+
+	t.Run("Export User Data Successfully", func(t *testing.T) {
+		mockRepo.On("ReadUserEnrollments", "student-uid").Return(enrollments, nil).Once()
+		mockRepo.On("ReadUserProgress", "student-uid").Return(progress, nil).Once()
+		mockRepo.On("ReadUserReviews", "student-uid").Return(reviews, nil).Once()
+
+		data, err := courseService.ExportUserData("student-uid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"enrollments": enrollments, "progress": progress, "reviews": reviews}, data)
+	})
+
+	t.Run("Export User Data Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadUserEnrollments", "student-uid").Return(enrollments, nil).Once()
+		mockRepo.On("ReadUserProgress", "student-uid").Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.ExportUserData("student-uid")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_EraseUserData(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Erase User Data Successfully", func(t *testing.T) {
+		mockRepo.On("EraseUserData", "student-uid").Return(nil).Once()
+
+		err := courseService.EraseUserData("student-uid")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Erase User Data Repository Error", func(t *testing.T) {
+		mockRepo.On("EraseUserData", "student-uid").Return(errors.New("Repository Failure")).Once()
+
+		err := courseService.EraseUserData("student-uid")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+
+	"github.com/google/uuid"
+)
+
+// diffCourses reports what changed between two course snapshots.
+func diffCourses(from entity.Course, to entity.Course) dto.CourseRevisionDiffDTO {
+	var diff dto.CourseRevisionDiffDTO
+
+	diff.Changes = appendChange(diff.Changes, "name", from.Name, to.Name)
+	diff.Changes = appendChange(diff.Changes, "description", from.Description, to.Description)
+	diff.Changes = appendChange(diff.Changes, "language", from.Language, to.Language)
+
+	fromTags := make(map[uuid.UUID]entity.CourseTags)
+	for _, tag := range from.CourseTags {
+		fromTags[tag.ID] = tag
+	}
+	toTags := make(map[uuid.UUID]entity.CourseTags)
+	for _, tag := range to.CourseTags {
+		toTags[tag.ID] = tag
+		if _, ok := fromTags[tag.ID]; !ok {
+			diff.Tags.Added = append(diff.Tags.Added, tag.ID)
+		}
+	}
+	for _, tag := range from.CourseTags {
+		if _, ok := toTags[tag.ID]; !ok {
+			diff.Tags.Removed = append(diff.Tags.Removed, tag.ID)
+		}
+	}
+
+	fromGallery := make(map[uuid.UUID]entity.CourseGallery)
+	for _, galleryItem := range from.Gallery {
+		fromGallery[galleryItem.ID] = galleryItem
+	}
+	toGallery := make(map[uuid.UUID]entity.CourseGallery)
+	for _, galleryItem := range to.Gallery {
+		toGallery[galleryItem.ID] = galleryItem
+		previous, ok := fromGallery[galleryItem.ID]
+		if !ok {
+			diff.Gallery.Added = append(diff.Gallery.Added, galleryItem.ID)
+			continue
+		}
+		changes := appendChange(nil, "url", previous.URL, galleryItem.URL)
+		changes = appendChange(changes, "media_id", optionalUUID(previous.MediaID), optionalUUID(galleryItem.MediaID))
+		diff.Gallery.Modified = appendChildChange(diff.Gallery.Modified, galleryItem.ID, changes)
+	}
+	for _, galleryItem := range from.Gallery {
+		if _, ok := toGallery[galleryItem.ID]; !ok {
+			diff.Gallery.Removed = append(diff.Gallery.Removed, galleryItem.ID)
+		}
+	}
+
+	fromSections := make(map[uuid.UUID]entity.CourseSection)
+	fromLessons := make(map[uuid.UUID]entity.CourseLesson)
+	for _, section := range from.Sections {
+		fromSections[section.ID] = section
+		for _, lesson := range section.Lessons {
+			fromLessons[lesson.ID] = lesson
+		}
+	}
+	toSections := make(map[uuid.UUID]entity.CourseSection)
+	toLessons := make(map[uuid.UUID]entity.CourseLesson)
+	for _, section := range to.Sections {
+		toSections[section.ID] = section
+		previous, ok := fromSections[section.ID]
+		if !ok {
+			diff.Sections.Added = append(diff.Sections.Added, section.ID)
+		} else {
+			changes := appendChange(nil, "name", previous.Name, section.Name)
+			diff.Sections.Modified = appendChildChange(diff.Sections.Modified, section.ID, changes)
+		}
+
+		for _, lesson := range section.Lessons {
+			toLessons[lesson.ID] = lesson
+			previousLesson, ok := fromLessons[lesson.ID]
+			if !ok {
+				diff.Lessons.Added = append(diff.Lessons.Added, lesson.ID)
+				continue
+			}
+			changes := appendChange(nil, "title", previousLesson.Title, lesson.Title)
+			changes = appendChange(changes, "video_url", previousLesson.VideoURL, lesson.VideoURL)
+			changes = appendChange(changes, "media_id", optionalUUID(previousLesson.MediaID), optionalUUID(lesson.MediaID))
+			changes = appendChange(changes, "course_section_id", previousLesson.CourseSectionID, lesson.CourseSectionID)
+			diff.Lessons.Modified = appendChildChange(diff.Lessons.Modified, lesson.ID, changes)
+		}
+	}
+	for _, section := range from.Sections {
+		if _, ok := toSections[section.ID]; !ok {
+			diff.Sections.Removed = append(diff.Sections.Removed, section.ID)
+		}
+		for _, lesson := range section.Lessons {
+			if _, ok := toLessons[lesson.ID]; !ok {
+				diff.Lessons.Removed = append(diff.Lessons.Removed, lesson.ID)
+			}
+		}
+	}
+
+	return diff
+}
+
+func appendChange[T comparable](changes []dto.FieldChangeDTO, field string, from T, to T) []dto.FieldChangeDTO {
+	if from == to {
+		return changes
+	}
+	return append(changes, dto.FieldChangeDTO{Field: field, From: from, To: to})
+}
+
+func appendChildChange(modified []dto.ChildChangeDTO, id uuid.UUID, changes []dto.FieldChangeDTO) []dto.ChildChangeDTO {
+	if len(changes) == 0 {
+		return modified
+	}
+	return append(modified, dto.ChildChangeDTO{ID: id, Changes: changes})
+}
+
+// optionalUUID renders a nullable reference, using an empty string for nil.
+func optionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
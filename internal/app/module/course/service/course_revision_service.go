@@ -0,0 +1,387 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	"CodeWithAzri/pkg/adapter"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	initialRevisionMessage = "initial snapshot"
+	updateRevisionMessage  = "update"
+)
+
+func (s *Service) UpdateCourse(courseID uuid.UUID, userID string, input dto.UpdateCourseDTO) (dto.CourseDTO, error) {
+	current, err := s.readEditableCourse(courseID, userID)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	err = s.validateGalleryMedia(input)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	videos, err := s.validateLessonMedia(input)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	tags, err := s.readTagsByID(input.TagIDs)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	updated, err := applyCourseUpdate(current, input, tags, timepkg.NowUnixMilli())
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+	applyLessonDurations(&updated, videos)
+
+	return s.saveRevision(current, updated, userID, updateRevisionMessage)
+}
+
+func (s *Service) GetRevisions(courseID uuid.UUID, userID string) ([]dto.CourseRevisionDTO, error) {
+	_, err := s.readEditableCourse(courseID, userID)
+	if err != nil {
+		return []dto.CourseRevisionDTO{}, err
+	}
+
+	revisions, err := s.repository.ReadRevisions(courseID)
+	if err != nil {
+		return []dto.CourseRevisionDTO{}, err
+	}
+
+	revisionDTOs := make([]dto.CourseRevisionDTO, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionDTOs = append(revisionDTOs, dto.CourseRevisionDTO{
+			ID:        revision.ID,
+			CourseID:  revision.CourseID,
+			Revision:  revision.Revision,
+			Message:   revision.Message,
+			CreatedBy: revision.CreatedBy,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	return revisionDTOs, nil
+}
+
+func (s *Service) GetRevision(courseID uuid.UUID, userID string, revision int) (dto.CourseRevisionDTO, error) {
+	_, err := s.readEditableCourse(courseID, userID)
+	if err != nil {
+		return dto.CourseRevisionDTO{}, err
+	}
+
+	courseRevision, snapshot, err := s.readRevisionSnapshot(courseID, revision)
+	if err != nil {
+		return dto.CourseRevisionDTO{}, err
+	}
+
+	snapshotDTO, err := adapter.AnyToType[dto.CourseDTO](snapshot)
+	if err != nil {
+		return dto.CourseRevisionDTO{}, err
+	}
+
+	return dto.CourseRevisionDTO{
+		ID:        courseRevision.ID,
+		CourseID:  courseRevision.CourseID,
+		Revision:  courseRevision.Revision,
+		Message:   courseRevision.Message,
+		Snapshot:  &snapshotDTO,
+		CreatedBy: courseRevision.CreatedBy,
+		CreatedAt: courseRevision.CreatedAt,
+	}, nil
+}
+
+func (s *Service) DiffRevisions(courseID uuid.UUID, userID string, from int, to int) (dto.CourseRevisionDiffDTO, error) {
+	_, err := s.readEditableCourse(courseID, userID)
+	if err != nil {
+		return dto.CourseRevisionDiffDTO{}, err
+	}
+
+	_, fromSnapshot, err := s.readRevisionSnapshot(courseID, from)
+	if err != nil {
+		return dto.CourseRevisionDiffDTO{}, err
+	}
+
+	_, toSnapshot, err := s.readRevisionSnapshot(courseID, to)
+	if err != nil {
+		return dto.CourseRevisionDiffDTO{}, err
+	}
+
+	diff := diffCourses(fromSnapshot, toSnapshot)
+	diff.CourseID = courseID
+	diff.From = from
+	diff.To = to
+
+	return diff, nil
+}
+
+func (s *Service) RollbackCourse(courseID uuid.UUID, userID string, revision int) (dto.CourseDTO, error) {
+	current, err := s.readEditableCourse(courseID, userID)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	_, snapshot, err := s.readRevisionSnapshot(courseID, revision)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	snapshotTagIDs := make([]uuid.UUID, 0, len(snapshot.CourseTags))
+	for _, tag := range snapshot.CourseTags {
+		snapshotTagIDs = append(snapshotTagIDs, tag.ID)
+	}
+	tags, err := s.readTagsByID(snapshotTagIDs)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	// Only the content is rolled back, the lifecycle state stays as it is.
+	restored := current
+	restored.Name = snapshot.Name
+	restored.Description = snapshot.Description
+	restored.Language = snapshot.Language
+	restored.CourseTags = make([]entity.CourseTags, 0, len(snapshot.CourseTags))
+	for _, tagID := range snapshotTagIDs {
+		// Tags deleted or merged away since the snapshot are left out.
+		if tag, ok := tags[tagID]; ok {
+			restored.CourseTags = append(restored.CourseTags, tag)
+		}
+	}
+	restored.Gallery = snapshot.Gallery
+	restored.Sections = positionSections(snapshot.Sections)
+	restored.UpdatedAt = timepkg.NowUnixMilli()
+
+	return s.saveRevision(current, restored, userID, fmt.Sprintf("rollback to revision %d", revision))
+}
+
+// positionSections numbers sections and their lessons by their place in the
+// slice. Snapshots taken before positions were stored only keep the order.
+func positionSections(sections []entity.CourseSection) []entity.CourseSection {
+	for i := range sections {
+		sections[i].Position = i
+		for j := range sections[i].Lessons {
+			sections[i].Lessons[j].Position = j
+		}
+	}
+	return sections
+}
+
+// saveRevision persists updated together with a snapshot of it, unless the
+// course changed since current was read. Courses that predate revision
+// history get their current state recorded first so the very first update
+// can still be rolled back.
+func (s *Service) saveRevision(current entity.Course, updated entity.Course, userID string, message string) (dto.CourseDTO, error) {
+	baseline, err := newRevision(current, userID, initialRevisionMessage, current.UpdatedAt)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	revision, err := newRevision(updated, userID, message, updated.UpdatedAt)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	published, err := publishedLessonEvents(current, updated)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	saved, err := s.repository.Update(current.ID, current.UpdatedAt, updated, baseline, revision, published...)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+	if saved == 0 {
+		return dto.CourseDTO{}, ErrCourseConflict
+	}
+	s.invalidateCourses()
+
+	return s.toCourseDTO(updated)
+}
+
+// publishedLessonEvents announces the lessons added to a course that is
+// already published. Lessons of a course that is published later are not
+// announced, nobody can be enrolled in it yet.
+func publishedLessonEvents(current entity.Course, updated entity.Course) ([]eventEntity.Event, error) {
+	if current.Status != course_status_enum.Published {
+		return nil, nil
+	}
+
+	existing := make(map[uuid.UUID]bool)
+	for _, section := range current.Sections {
+		for _, lesson := range section.Lessons {
+			existing[lesson.ID] = true
+		}
+	}
+
+	events := make([]eventEntity.Event, 0)
+	for _, section := range updated.Sections {
+		for _, lesson := range section.Lessons {
+			if existing[lesson.ID] {
+				continue
+			}
+
+			event, err := eventService.NewEvent(event_type_enum.LessonPublished, lesson.ID.String(), eventDTO.LessonPublishedPayload{
+				CourseID:   updated.ID,
+				CourseName: updated.Name,
+				Language:   updated.Language,
+				LessonID:   lesson.ID,
+				Title:      lesson.Title,
+			})
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *Service) readRevisionSnapshot(courseID uuid.UUID, revision int) (entity.CourseRevision, entity.Course, error) {
+	courseRevision, err := s.repository.ReadRevision(courseID, revision)
+	if err != nil {
+		return entity.CourseRevision{}, entity.Course{}, err
+	}
+
+	if courseRevision.ID == uuid.Nil {
+		return entity.CourseRevision{}, entity.Course{}, ErrRevisionNotFound
+	}
+
+	var snapshot entity.Course
+	err = jsonpkg.Unmarshal([]byte(courseRevision.Snapshot), &snapshot)
+	if err != nil {
+		return entity.CourseRevision{}, entity.Course{}, err
+	}
+
+	return courseRevision, snapshot, nil
+}
+
+func newRevision(course entity.Course, userID string, message string, createdAt int64) (entity.CourseRevision, error) {
+	// Instructors are managed separately and are not part of the content.
+	course.Instructors = nil
+
+	snapshot, err := jsonpkg.Marshal(course)
+	if err != nil {
+		return entity.CourseRevision{}, err
+	}
+
+	return entity.CourseRevision{
+		ID:        uuid.New(),
+		CourseID:  course.ID,
+		Message:   message,
+		Snapshot:  string(snapshot),
+		CreatedBy: userID,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// applyCourseUpdate builds the new state of current from input. Children are
+// matched by ID; entries without an ID are created, and IDs that do not
+// belong to the course are rejected. Tags are looked up in tags, which holds
+// every tag that exists among input.TagIDs.
+func applyCourseUpdate(current entity.Course, input dto.UpdateCourseDTO, tags map[uuid.UUID]entity.CourseTags, now int64) (entity.Course, error) {
+	existingGallery := make(map[uuid.UUID]entity.CourseGallery)
+	for _, galleryItem := range current.Gallery {
+		existingGallery[galleryItem.ID] = galleryItem
+	}
+
+	existingSections := make(map[uuid.UUID]entity.CourseSection)
+	existingLessons := make(map[uuid.UUID]entity.CourseLesson)
+	for _, section := range current.Sections {
+		existingSections[section.ID] = section
+		for _, lesson := range section.Lessons {
+			existingLessons[lesson.ID] = lesson
+		}
+	}
+
+	updated := current
+	updated.Name = input.Name
+	updated.Description = input.Description
+	updated.Language = input.Language
+	updated.UpdatedAt = now
+
+	updated.CourseTags = make([]entity.CourseTags, 0, len(input.TagIDs))
+	for _, tagID := range input.TagIDs {
+		tag, ok := tags[tagID]
+		if !ok {
+			return entity.Course{}, ErrUnknownTag
+		}
+		updated.CourseTags = append(updated.CourseTags, tag)
+	}
+
+	updated.Gallery = make([]entity.CourseGallery, 0, len(input.Gallery))
+	for _, galleryInput := range input.Gallery {
+		galleryItem := entity.CourseGallery{ID: galleryInput.ID, CreatedAt: now}
+		if galleryInput.ID == uuid.Nil {
+			galleryItem.ID = uuid.New()
+		} else if existing, ok := existingGallery[galleryInput.ID]; ok {
+			galleryItem.CreatedAt = existing.CreatedAt
+		} else {
+			return entity.Course{}, ErrUnknownCourseContent
+		}
+		galleryItem.CourseID = current.ID
+		galleryItem.URL = galleryInput.URL
+		galleryItem.MediaID = galleryInput.MediaID
+		if galleryItem.MediaID != nil {
+			// The URL of an uploaded image is signed when it is read.
+			galleryItem.URL = ""
+		}
+		galleryItem.UpdatedAt = now
+		updated.Gallery = append(updated.Gallery, galleryItem)
+	}
+
+	updated.Sections = make([]entity.CourseSection, 0, len(input.Sections))
+	for i, sectionInput := range input.Sections {
+		section := entity.CourseSection{ID: sectionInput.ID, Position: i, CreatedAt: now}
+		if sectionInput.ID == uuid.Nil {
+			section.ID = uuid.New()
+		} else if existing, ok := existingSections[sectionInput.ID]; ok {
+			section.CreatedAt = existing.CreatedAt
+		} else {
+			return entity.Course{}, ErrUnknownCourseContent
+		}
+		section.CourseID = current.ID
+		section.Name = sectionInput.Name
+		section.UpdatedAt = now
+
+		section.Lessons = make([]entity.CourseLesson, 0, len(sectionInput.Lessons))
+		for j, lessonInput := range sectionInput.Lessons {
+			lesson := entity.CourseLesson{ID: lessonInput.ID, Position: j, CreatedAt: now}
+			if lessonInput.ID == uuid.Nil {
+				lesson.ID = uuid.New()
+			} else if existing, ok := existingLessons[lessonInput.ID]; ok {
+				lesson.CreatedAt = existing.CreatedAt
+			} else {
+				return entity.Course{}, ErrUnknownCourseContent
+			}
+			lesson.CourseID = current.ID
+			lesson.CourseSectionID = section.ID
+			lesson.Title = lessonInput.Title
+			lesson.VideoURL = lessonInput.VideoURL
+			lesson.MediaID = lessonInput.MediaID
+			if lesson.MediaID != nil {
+				// Uploaded videos are streamed through signed playlists.
+				lesson.VideoURL = ""
+			}
+			lesson.UpdatedAt = now
+			section.Lessons = append(section.Lessons, lesson)
+		}
+
+		updated.Sections = append(updated.Sections, section)
+	}
+
+	return updated, nil
+}
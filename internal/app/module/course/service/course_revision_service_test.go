@@ -0,0 +1,337 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/service"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockRevision(t *testing.T, revision int, course entity.Course) entity.CourseRevision {
+	snapshot, err := json.Marshal(course)
+	assert.NoError(t, err)
+
+	return entity.CourseRevision{
+		ID:        uuid.New(),
+		CourseID:  course.ID,
+		Revision:  revision,
+		Message:   "update",
+		Snapshot:  string(snapshot),
+		CreatedBy: course.OwnerID,
+		CreatedAt: 121212,
+	}
+}
+
+func TestService_UpdateCourse(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	existingSection := MockEntity.Sections[0]
+	input := dto.UpdateCourseDTO{
+		Name:        "Updated Course",
+		Description: "Updated Description",
+		Language:    "id",
+		TagIDs:      []uuid.UUID{mockTags[0].ID},
+		Gallery:     []dto.UpsertCourseGalleryDTO{{URL: "https://example.com/new.png"}},
+		Sections: []dto.UpsertCourseSectionDTO{
+			{
+				ID:   existingSection.ID,
+				Name: "Renamed Section",
+				Lessons: []dto.UpsertCourseLessonDTO{
+					{ID: existingSection.Lessons[0].ID, Title: "Renamed Lesson", VideoURL: "https://example.com/video"},
+					{Title: "New Lesson", VideoURL: "https://example.com/new-video"},
+				},
+			},
+		},
+	}
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+	mockRepo.On("ReadTagsByIDs", []uuid.UUID{mockTags[0].ID}).Return(mockTags[:1], nil)
+
+	t.Run("Update Course Records Baseline And Revision", func(t *testing.T) {
+		var savedCourse entity.Course
+		var published eventEntity.Event
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.MatchedBy(func(revision entity.CourseRevision) bool {
+			return revision.Message == "initial snapshot" && revision.CourseID == MockEntity.ID
+		}), mock.MatchedBy(func(revision entity.CourseRevision) bool {
+			return revision.Message == "update" && revision.CreatedBy == MockEntity.OwnerID
+		}), mock.AnythingOfType("entity.Event")).Run(func(args mock.Arguments) {
+			savedCourse = args.Get(2).(entity.Course)
+			published = args.Get(5).(eventEntity.Event)
+		}).Return(int64(1), nil).Once()
+
+		courseDTO, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Updated Course", courseDTO.Name)
+		assert.Equal(t, mockTags[:1], savedCourse.CourseTags)
+		assert.Len(t, savedCourse.Gallery, 1)
+		assert.NotEqual(t, uuid.Nil, savedCourse.Gallery[0].ID)
+		assert.Len(t, savedCourse.Sections, 1)
+		assert.Equal(t, existingSection.ID, savedCourse.Sections[0].ID)
+		assert.Equal(t, existingSection.CreatedAt, savedCourse.Sections[0].CreatedAt)
+		assert.Equal(t, existingSection.Lessons[0].ID, savedCourse.Sections[0].Lessons[0].ID)
+		assert.NotEqual(t, uuid.Nil, savedCourse.Sections[0].Lessons[1].ID)
+		assert.Equal(t, existingSection.ID, savedCourse.Sections[0].Lessons[1].CourseSectionID)
+
+		var payload eventDTO.LessonPublishedPayload
+		assert.NoError(t, json.Unmarshal([]byte(published.Payload), &payload))
+		assert.Equal(t, event_type_enum.LessonPublished, published.Type)
+		assert.Equal(t, savedCourse.Sections[0].Lessons[1].ID, payload.LessonID)
+		assert.Equal(t, "Updated Course", payload.CourseName)
+	})
+
+	t.Run("Update Course Keeps Section And Lesson Order", func(t *testing.T) {
+		var savedCourse entity.Course
+		reordered := input
+		reordered.Sections = []dto.UpsertCourseSectionDTO{
+			{Name: "Introduction"},
+			{
+				ID:   existingSection.ID,
+				Name: existingSection.Name,
+				Lessons: []dto.UpsertCourseLessonDTO{
+					{ID: existingSection.Lessons[1].ID, Title: existingSection.Lessons[1].Title, VideoURL: existingSection.Lessons[1].VideoURL},
+					{ID: existingSection.Lessons[0].ID, Title: existingSection.Lessons[0].Title, VideoURL: existingSection.Lessons[0].VideoURL},
+				},
+			},
+		}
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision")).Run(func(args mock.Arguments) {
+			savedCourse = args.Get(2).(entity.Course)
+		}).Return(int64(1), nil).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, reordered)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, savedCourse.Sections[0].Position)
+		assert.Equal(t, existingSection.ID, savedCourse.Sections[1].ID)
+		assert.Equal(t, 1, savedCourse.Sections[1].Position)
+		assert.Equal(t, existingSection.Lessons[1].ID, savedCourse.Sections[1].Lessons[0].ID)
+		assert.Equal(t, 0, savedCourse.Sections[1].Lessons[0].Position)
+		assert.Equal(t, existingSection.Lessons[0].ID, savedCourse.Sections[1].Lessons[1].ID)
+		assert.Equal(t, 1, savedCourse.Sections[1].Lessons[1].Position)
+	})
+
+	t.Run("Update Course Changed Meanwhile", func(t *testing.T) {
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.Event")).Return(int64(0), nil).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.ErrorIs(t, err, service.ErrCourseConflict)
+	})
+
+	t.Run("Update Course Unknown Tag", func(t *testing.T) {
+		unknownTagID := uuid.New()
+		mockRepo.On("ReadTagsByIDs", []uuid.UUID{unknownTagID}).Return([]entity.CourseTags{}, nil).Once()
+		unknown := input
+		unknown.TagIDs = []uuid.UUID{unknownTagID}
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, unknown)
+
+		assert.ErrorIs(t, err, service.ErrUnknownTag)
+	})
+
+	t.Run("Update Course Unknown Child ID", func(t *testing.T) {
+		unknown := input
+		unknown.Gallery = []dto.UpsertCourseGalleryDTO{{ID: uuid.New(), URL: "https://example.com/new.png"}}
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, unknown)
+
+		assert.ErrorIs(t, err, service.ErrUnknownCourseContent)
+	})
+
+	t.Run("Update Course Not Owner", func(t *testing.T) {
+		_, err := courseService.UpdateCourse(MockEntity.ID, "someone-else", input)
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("Update Course Repository Error", func(t *testing.T) {
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.Event")).Return(int64(0), errors.New("Repository Failure")).Once()
+
+		_, err := courseService.UpdateCourse(MockEntity.ID, MockEntity.OwnerID, input)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Repository Failure")
+	})
+}
+
+func TestService_GetRevisions(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Get Revisions Success", func(t *testing.T) {
+		mockRepo.On("ReadRevisions", MockEntity.ID).Return([]entity.CourseRevision{mockRevision(t, 2, MockEntity), mockRevision(t, 1, MockEntity)}, nil).Once()
+
+		revisions, err := courseService.GetRevisions(MockEntity.ID, MockEntity.OwnerID)
+
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 2)
+		assert.Equal(t, 2, revisions[0].Revision)
+		assert.Nil(t, revisions[0].Snapshot)
+	})
+
+	t.Run("Get Revisions Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadRevisions", MockEntity.ID).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.GetRevisions(MockEntity.ID, MockEntity.OwnerID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetRevision(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Get Revision Success", func(t *testing.T) {
+		mockRepo.On("ReadRevision", MockEntity.ID, 1).Return(mockRevision(t, 1, MockEntity), nil).Once()
+
+		revision, err := courseService.GetRevision(MockEntity.ID, MockEntity.OwnerID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, revision.Revision)
+		assert.Equal(t, MockEntity.Name, revision.Snapshot.Name)
+		assert.Len(t, revision.Snapshot.Sections, 2)
+	})
+
+	t.Run("Get Revision Not Found", func(t *testing.T) {
+		mockRepo.On("ReadRevision", MockEntity.ID, 9).Return(entity.CourseRevision{}, nil).Once()
+
+		_, err := courseService.GetRevision(MockEntity.ID, MockEntity.OwnerID, 9)
+
+		assert.ErrorIs(t, err, service.ErrRevisionNotFound)
+	})
+}
+
+func TestService_DiffRevisions(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	changed := MockEntity
+	changed.Name = "Renamed Course"
+	changed.CourseTags = mockTags[:1]
+	changed.Gallery = []entity.CourseGallery{{ID: MockEntity.Gallery[0].ID, URL: "https://example.com/changed.png"}}
+	changed.Sections = []entity.CourseSection{
+		{
+			ID:   MockEntity.Sections[0].ID,
+			Name: MockEntity.Sections[0].Name,
+			Lessons: []entity.CourseLesson{
+				{ID: MockEntity.Sections[0].Lessons[0].ID, CourseSectionID: MockEntity.Sections[0].ID, Title: "Renamed Lesson", VideoURL: MockEntity.Sections[0].Lessons[0].VideoURL},
+				{ID: uuid.MustParse("f3f1c0de-0000-4000-8000-000000000001"), CourseSectionID: MockEntity.Sections[0].ID, Title: "New Lesson"},
+			},
+		},
+	}
+
+	mockRepo.On("ReadRevision", MockEntity.ID, 1).Return(mockRevision(t, 1, MockEntity), nil)
+	mockRepo.On("ReadRevision", MockEntity.ID, 2).Return(mockRevision(t, 2, changed), nil)
+
+	t.Run("Diff Revisions Success", func(t *testing.T) {
+		diff, err := courseService.DiffRevisions(MockEntity.ID, MockEntity.OwnerID, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, diff.From)
+		assert.Equal(t, 2, diff.To)
+		assert.Equal(t, []dto.FieldChangeDTO{{Field: "name", From: "Mock Course", To: "Renamed Course"}}, diff.Changes)
+		assert.Equal(t, []uuid.UUID{mockTags[1].ID}, diff.Tags.Removed)
+		assert.Empty(t, diff.Tags.Added)
+		assert.Len(t, diff.Gallery.Modified, 1)
+		assert.Equal(t, []uuid.UUID{MockEntity.Sections[1].ID}, diff.Sections.Removed)
+		assert.Empty(t, diff.Sections.Modified)
+		assert.Equal(t, []uuid.UUID{uuid.MustParse("f3f1c0de-0000-4000-8000-000000000001")}, diff.Lessons.Added)
+		assert.Len(t, diff.Lessons.Removed, 3)
+		assert.Equal(t, MockEntity.Sections[0].Lessons[0].ID, diff.Lessons.Modified[0].ID)
+		assert.Equal(t, "title", diff.Lessons.Modified[0].Changes[0].Field)
+	})
+
+	t.Run("Diff Revisions Missing Revision", func(t *testing.T) {
+		mockRepo.On("ReadRevision", MockEntity.ID, 3).Return(entity.CourseRevision{}, nil).Once()
+
+		_, err := courseService.DiffRevisions(MockEntity.ID, MockEntity.OwnerID, 1, 3)
+
+		assert.ErrorIs(t, err, service.ErrRevisionNotFound)
+	})
+}
+
+func TestService_RollbackCourse(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	mockRepo.On("ReadOne", MockEntity.ID).Return(MockEntity, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	previous := MockEntity
+	previous.Name = "Old Name"
+	previous.Status = "draft"
+
+	t.Run("Rollback Course Success", func(t *testing.T) {
+		mockRepo.On("ReadRevision", MockEntity.ID, 1).Return(mockRevision(t, 1, previous), nil).Once()
+		mockRepo.On("ReadTagsByIDs", []uuid.UUID{mockTags[0].ID, mockTags[1].ID}).Return(mockTags, nil).Once()
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.MatchedBy(func(course entity.Course) bool {
+			return course.Name == "Old Name" && course.Status == MockEntity.Status
+		}), mock.AnythingOfType("entity.CourseRevision"), mock.MatchedBy(func(revision entity.CourseRevision) bool {
+			return revision.Message == "rollback to revision 1"
+		})).Return(int64(1), nil).Once()
+
+		courseDTO, err := courseService.RollbackCourse(MockEntity.ID, MockEntity.OwnerID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Old Name", courseDTO.Name)
+		assert.Equal(t, MockEntity.Status, courseDTO.Status)
+	})
+
+	t.Run("Rollback Course Drops Deleted Tags", func(t *testing.T) {
+		var restored entity.Course
+		mockRepo.On("ReadRevision", MockEntity.ID, 1).Return(mockRevision(t, 1, previous), nil).Once()
+		mockRepo.On("ReadTagsByIDs", []uuid.UUID{mockTags[0].ID, mockTags[1].ID}).Return(mockTags[1:], nil).Once()
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision")).Run(func(args mock.Arguments) {
+			restored = args.Get(2).(entity.Course)
+		}).Return(int64(1), nil).Once()
+
+		_, err := courseService.RollbackCourse(MockEntity.ID, MockEntity.OwnerID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockTags[1:], restored.CourseTags)
+	})
+
+	t.Run("Rollback Course Numbers Unpositioned Snapshot", func(t *testing.T) {
+		var restored entity.Course
+		unpositioned := previous
+		section := MockEntity.Sections[0]
+		section.Lessons = []entity.CourseLesson{section.Lessons[1], section.Lessons[0]}
+		unpositioned.Sections = []entity.CourseSection{section}
+		mockRepo.On("ReadRevision", MockEntity.ID, 1).Return(mockRevision(t, 1, unpositioned), nil).Once()
+		mockRepo.On("ReadTagsByIDs", []uuid.UUID{mockTags[0].ID, mockTags[1].ID}).Return(mockTags, nil).Once()
+		mockRepo.On("Update", MockEntity.ID, MockEntity.UpdatedAt, mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.CourseRevision"), mock.AnythingOfType("entity.CourseRevision")).Run(func(args mock.Arguments) {
+			restored = args.Get(2).(entity.Course)
+		}).Return(int64(1), nil).Once()
+
+		_, err := courseService.RollbackCourse(MockEntity.ID, MockEntity.OwnerID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, section.Lessons[0].ID, restored.Sections[0].Lessons[0].ID)
+		assert.Equal(t, 0, restored.Sections[0].Lessons[0].Position)
+		assert.Equal(t, 1, restored.Sections[0].Lessons[1].Position)
+	})
+
+	t.Run("Rollback Course Revision Not Found", func(t *testing.T) {
+		mockRepo.On("ReadRevision", MockEntity.ID, 5).Return(entity.CourseRevision{}, nil).Once()
+
+		_, err := courseService.RollbackCourse(MockEntity.ID, MockEntity.OwnerID, 5)
+
+		assert.ErrorIs(t, err, service.ErrRevisionNotFound)
+	})
+}
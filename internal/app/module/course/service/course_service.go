@@ -2,52 +2,392 @@ package service
 
 import (
 	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
 	"CodeWithAzri/internal/app/module/course/repository"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
 	"CodeWithAzri/pkg/adapter"
+	"CodeWithAzri/pkg/cache"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"errors"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrCourseNotFound          = errors.New("course not found")
+	ErrForbidden               = errors.New("you are not allowed to modify this course")
+	ErrInvalidStatusTransition = errors.New("invalid course status transition")
+	ErrInvalidPublishAt        = errors.New("publish_at can only be set when publishing a course")
+	ErrRevisionNotFound        = errors.New("course revision not found")
+	ErrUnknownCourseContent    = errors.New("course content references gallery, section or lesson IDs that do not belong to the course")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrInstructorNotFound      = errors.New("user is not an instructor of this course")
+	ErrOwnerChange             = errors.New("the course owner cannot be removed or demoted")
+	ErrInvalidGalleryMedia     = errors.New("gallery media must be an uploaded course gallery image")
+	ErrInvalidLessonMedia      = errors.New("lesson media must be an uploaded lesson video")
+	ErrLessonNotFound          = errors.New("lesson not found")
+	ErrLessonNotStreamable     = errors.New("lesson has no uploaded video to stream")
+	ErrLessonVideoNotReady     = errors.New("lesson video is still being processed")
+	ErrNotEnrolled             = errors.New("you must enroll in this course to watch its lessons")
+	ErrInvalidCourseView       = errors.New("invalid course fields or expansions")
+	ErrCourseConflict          = errors.New("the course was changed while you were editing it, reload it and try again")
+	ErrUnknownTag              = errors.New("course tags reference tags that do not exist")
+	ErrTagNotFound             = errors.New("tag not found")
+	ErrTagConflict             = errors.New("a tag with this name or slug already exists")
+	ErrInvalidTagSlug          = errors.New("tag slugs are made of lowercase letters and digits separated by single dashes")
+	ErrInvalidTagParent        = errors.New("a tag cannot be moved under itself or one of its descendants")
+	ErrInvalidTagMerge         = errors.New("a tag cannot be merged into itself or one of its descendants")
+	ErrNotModified             = errors.New("course not modified")
+)
+
+// allowedTransitions lists, for every course status, the statuses it may move to.
+var allowedTransitions = map[course_status_enum.CourseStatus][]course_status_enum.CourseStatus{
+	course_status_enum.Draft:     {course_status_enum.Review, course_status_enum.Archived},
+	course_status_enum.Review:    {course_status_enum.Draft, course_status_enum.Published},
+	course_status_enum.Published: {course_status_enum.Archived},
+	course_status_enum.Archived:  {course_status_enum.Draft},
+}
+
 type CourseService interface {
-	GetDetailCourse(courseID uuid.UUID) (dto.CourseDTO, error)
-	GetPaginatedCourses(limit int, page int) ([]dto.CourseDTO, error)
+	CreateCourse(userID string, input dto.UpdateCourseDTO) (dto.CourseDTO, error)
+	GetDetailCourse(courseID uuid.UUID, userID string, view dto.CourseViewDTO, ifNoneMatch string) (dto.CourseDTO, string, error)
+	GetPaginatedCourses(limit int, page int, userID string, view dto.CourseViewDTO, ifNoneMatch string) ([]dto.ExpandedCourseSummaryDTO, string, error)
+	ChangeStatus(courseID uuid.UUID, userID string, input dto.UpdateCourseStatusDTO) (dto.CourseDTO, error)
+	PublishScheduledCourses() (int64, error)
+	UpdateCourse(courseID uuid.UUID, userID string, input dto.UpdateCourseDTO) (dto.CourseDTO, error)
+	GetRevisions(courseID uuid.UUID, userID string) ([]dto.CourseRevisionDTO, error)
+	GetRevision(courseID uuid.UUID, userID string, revision int) (dto.CourseRevisionDTO, error)
+	DiffRevisions(courseID uuid.UUID, userID string, from int, to int) (dto.CourseRevisionDiffDTO, error)
+	RollbackCourse(courseID uuid.UUID, userID string, revision int) (dto.CourseDTO, error)
+	GetInstructorCourses(instructorID string, limit int, page int, viewerID string) ([]dto.CourseSummaryDTO, error)
+	AddInstructor(courseID uuid.UUID, userID string, input dto.AddCourseInstructorDTO) ([]dto.CourseInstructorDTO, error)
+	RemoveInstructor(courseID uuid.UUID, userID string, instructorID string) error
+	Enroll(courseID uuid.UUID, userID string) (dto.CourseEnrollmentDTO, error)
+	GetLessonStream(courseID uuid.UUID, lessonID uuid.UUID, userID string) (dto.LessonStreamDTO, error)
+	CompleteLesson(courseID uuid.UUID, lessonID uuid.UUID, userID string) (dto.LessonProgressDTO, error)
+	GetCourseProgress(courseID uuid.UUID, userID string) (dto.CourseProgressDTO, error)
+	GetLessonAccess(lessonID uuid.UUID, userID string) (dto.LessonAccessDTO, error)
+	GetEnrolledUserIDs(courseID uuid.UUID) ([]string, error)
+	RecordVideoDuration(mediaID uuid.UUID, duration int) error
+	RecordModeration(targetType moderation_target_enum.ModerationTarget, targetID string) error
+	ExportUserData(userID string) (map[string]any, error)
+	EraseUserData(userID string) error
+	GetTags() ([]dto.CourseTagCountDTO, error)
+	GetTag(id uuid.UUID) (dto.CourseTagsDTO, error)
+	CreateTag(input dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error)
+	UpdateTag(id uuid.UUID, input dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error)
+	DeleteTag(id uuid.UUID) error
+	MergeTags(sourceID uuid.UUID, input dto.MergeCourseTagDTO) (dto.CourseTagsDTO, error)
 }
 
 type Service struct {
 	repository repository.CourseRepository
+	media      MediaReader
+	cache      cache.Cache
+	loads      cache.Group
 }
 
-func NewCourseService(r repository.CourseRepository) CourseService {
+func NewCourseService(r repository.CourseRepository, m MediaReader, c cache.Cache) CourseService {
 	s := new(Service)
 	s.repository = r
+	s.media = m
+	s.cache = c
 	return s
 }
 
-func (s *Service) GetDetailCourse(courseID uuid.UUID) (dto.CourseDTO, error) {
-	course, err := s.repository.ReadOne(courseID)
+// CreateCourse creates a draft course owned by userID. Its content is given
+// like an update, so gallery items, sections and lessons must not carry IDs.
+func (s *Service) CreateCourse(userID string, input dto.UpdateCourseDTO) (dto.CourseDTO, error) {
+	err := s.validateGalleryMedia(input)
 	if err != nil {
 		return dto.CourseDTO{}, err
 	}
 
-	courseDTO, err := adapter.AnyToType[dto.CourseDTO](course)
+	videos, err := s.validateLessonMedia(input)
 	if err != nil {
 		return dto.CourseDTO{}, err
 	}
 
-	return courseDTO, nil
+	tags, err := s.readTagsByID(input.TagIDs)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	course, err := applyCourseUpdate(entity.Course{
+		ID:        uuid.New(),
+		Status:    course_status_enum.Draft,
+		OwnerID:   userID,
+		CreatedAt: now,
+	}, input, tags, now)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+	applyLessonDurations(&course, videos)
+
+	created, err := eventService.NewEvent(event_type_enum.CourseCreated, course.ID.String(), eventDTO.CourseCreatedPayload{
+		CourseID: course.ID,
+		OwnerID:  userID,
+		Name:     course.Name,
+	})
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	err = s.repository.Create(course, created)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+	s.invalidateCourses()
+
+	course.Instructors = []entity.CourseInstructor{{
+		CourseID:  course.ID,
+		UserID:    userID,
+		Role:      instructor_role_enum.Owner,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}}
+
+	return s.toCourseDTO(course)
+}
+
+// GetDetailCourse returns a course shaped by view and its ETag, served from
+// the cache when possible. Without expansions the course comes with its
+// tags, gallery, sections and lessons. When ifNoneMatch lists the ETag it
+// returns ErrNotModified without signing any media URLs.
+func (s *Service) GetDetailCourse(courseID uuid.UUID, userID string, view dto.CourseViewDTO, ifNoneMatch string) (dto.CourseDTO, string, error) {
+	relations, err := courseRelations(view, repository.ContentRelations)
+	if err != nil {
+		return dto.CourseDTO{}, "", err
+	}
+
+	course, etag, err := s.readCachedCourse(courseID, relations)
+	if err != nil {
+		return dto.CourseDTO{}, "", err
+	}
+
+	if course.ID == uuid.Nil {
+		return dto.CourseDTO{}, "", nil
+	}
+
+	// Unpublished courses are only visible to their instructors.
+	if course.Status != course_status_enum.Published && instructorRole(course, userID) == "" {
+		return dto.CourseDTO{}, "", nil
+	}
+
+	etag = s.responseETag(etag, view)
+	if etagMatches(ifNoneMatch, etag) {
+		return dto.CourseDTO{}, etag, ErrNotModified
+	}
+
+	courseDTO, err := s.toCourseDTO(course)
+	if err != nil {
+		return dto.CourseDTO{}, "", err
+	}
+
+	return courseDTO, etag, nil
 }
 
-func (s *Service) GetPaginatedCourses(limit int, page int) ([]dto.CourseDTO, error) {
+// GetPaginatedCourses returns a page of courses shaped by view and its
+// ETag, served from the cache when possible. Without expansions the courses
+// come with their tags and gallery. When ifNoneMatch lists the ETag it
+// returns ErrNotModified without signing any media URLs.
+func (s *Service) GetPaginatedCourses(limit int, page int, userID string, view dto.CourseViewDTO, ifNoneMatch string) ([]dto.ExpandedCourseSummaryDTO, string, error) {
+	relations, err := courseRelations(view, summaryRelations)
+	if err != nil {
+		return []dto.ExpandedCourseSummaryDTO{}, "", err
+	}
+
 	offset := (page - 1) * limit
 
-	courses, err := s.repository.ReadMany(limit, offset)
+	courses, etag, err := s.readCachedCourses(limit, offset, userID, relations)
+	if err != nil {
+		return []dto.ExpandedCourseSummaryDTO{}, "", err
+	}
+
+	etag = s.responseETag(etag, view)
+	if etagMatches(ifNoneMatch, etag) {
+		return []dto.ExpandedCourseSummaryDTO{}, etag, ErrNotModified
+	}
+
+	courseDTOs, err := s.toExpandedCourseSummaryDTOs(courses)
+	if err != nil {
+		return []dto.ExpandedCourseSummaryDTO{}, "", err
+	}
+
+	return courseDTOs, etag, nil
+}
+
+func (s *Service) ChangeStatus(courseID uuid.UUID, userID string, input dto.UpdateCourseStatusDTO) (dto.CourseDTO, error) {
+	course, err := s.readOwnedCourse(courseID, userID)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	if !canTransition(course.Status, input.Status) {
+		return dto.CourseDTO{}, ErrInvalidStatusTransition
+	}
+
+	now := timepkg.NowUnixMilli()
+	status := input.Status
+	var publishAt *int64
+
+	if input.PublishAt != nil {
+		if input.Status != course_status_enum.Published {
+			return dto.CourseDTO{}, ErrInvalidPublishAt
+		}
+
+		// A future publish_at keeps the course in review until the
+		// publish worker picks it up.
+		if *input.PublishAt > now {
+			status = course.Status
+			publishAt = input.PublishAt
+		}
+	}
+
+	err = s.repository.UpdateStatus(courseID, status, publishAt, now)
 	if err != nil {
-		return []dto.CourseDTO{}, err
+		return dto.CourseDTO{}, err
+	}
+	s.invalidateCourses()
+
+	course.Status = status
+	course.PublishAt = publishAt
+	course.UpdatedAt = now
+
+	return s.toCourseDTO(course)
+}
+
+func (s *Service) PublishScheduledCourses() (int64, error) {
+	published, err := s.repository.PublishDue(timepkg.NowUnixMilli())
+	if err != nil {
+		return 0, err
+	}
+
+	if published > 0 {
+		s.invalidateCourses()
+	}
+
+	return published, nil
+}
+
+// readOwnedCourse loads a course with its instructors and makes sure userID owns it.
+func (s *Service) readOwnedCourse(courseID uuid.UUID, userID string) (entity.Course, error) {
+	return s.readCourseAs(courseID, userID, instructor_role_enum.Owner)
+}
+
+// readEditableCourse loads a course and makes sure userID may edit its content.
+func (s *Service) readEditableCourse(courseID uuid.UUID, userID string) (entity.Course, error) {
+	return s.readCourseAs(courseID, userID, instructor_role_enum.Owner, instructor_role_enum.Editor)
+}
+
+func (s *Service) readCourseAs(courseID uuid.UUID, userID string, roles ...instructor_role_enum.InstructorRole) (entity.Course, error) {
+	course, err := s.repository.ReadOne(courseID)
+	if err != nil {
+		return entity.Course{}, err
+	}
+
+	if course.ID == uuid.Nil {
+		return entity.Course{}, ErrCourseNotFound
+	}
+
+	course.Instructors, err = s.repository.ReadInstructors([]uuid.UUID{courseID})
+	if err != nil {
+		return entity.Course{}, err
+	}
+
+	role := instructorRole(course, userID)
+	for _, allowed := range roles {
+		if role == allowed {
+			return course, nil
+		}
+	}
+
+	return entity.Course{}, ErrForbidden
+}
+
+// instructorRole returns the role userID holds on course, or an empty role
+// when they are not one of its instructors.
+func instructorRole(course entity.Course, userID string) instructor_role_enum.InstructorRole {
+	if userID == "" {
+		return ""
+	}
+
+	for _, instructor := range course.Instructors {
+		if instructor.UserID == userID {
+			return instructor.Role
+		}
 	}
 
-	courseDTOs, err := adapter.AnyToType[[]dto.CourseDTO](courses)
+	if course.OwnerID == userID {
+		return instructor_role_enum.Owner
+	}
+
+	return ""
+}
+
+func canTransition(from, to course_status_enum.CourseStatus) bool {
+	for _, status := range allowedTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// toCourseDTO converts course and resolves its uploaded gallery images.
+func (s *Service) toCourseDTO(course entity.Course) (dto.CourseDTO, error) {
+	courseDTO, err := adapter.AnyToType[dto.CourseDTO](course)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	err = s.attachGalleryImages(courseDTO.Gallery)
+	if err != nil {
+		return dto.CourseDTO{}, err
+	}
+
+	return courseDTO, nil
+}
+
+// toCourseSummaryDTOs converts courses for a listing that cannot be
+// expanded and resolves their uploaded gallery images.
+func (s *Service) toCourseSummaryDTOs(courses []entity.Course) ([]dto.CourseSummaryDTO, error) {
+	expanded, err := s.toExpandedCourseSummaryDTOs(courses)
+	if err != nil {
+		return []dto.CourseSummaryDTO{}, err
+	}
+
+	courseDTOs := make([]dto.CourseSummaryDTO, 0, len(expanded))
+	for _, courseDTO := range expanded {
+		courseDTOs = append(courseDTOs, courseDTO.CourseSummaryDTO)
+	}
+
+	return courseDTOs, nil
+}
+
+// toExpandedCourseSummaryDTOs converts courses for a listing, keeping the
+// sections and reviews that were read, and resolves their uploaded gallery
+// images.
+func (s *Service) toExpandedCourseSummaryDTOs(courses []entity.Course) ([]dto.ExpandedCourseSummaryDTO, error) {
+	courseDTOs, err := adapter.AnyToType[[]dto.ExpandedCourseSummaryDTO](courses)
 	if err != nil || courseDTOs == nil {
-		return []dto.CourseDTO{}, err
+		return []dto.ExpandedCourseSummaryDTO{}, err
+	}
+
+	galleries := make([][]dto.CourseGalleryDTO, 0, len(courseDTOs))
+	for _, courseDTO := range courseDTOs {
+		galleries = append(galleries, courseDTO.Gallery)
+	}
+
+	err = s.attachGalleryImages(galleries...)
+	if err != nil {
+		return []dto.ExpandedCourseSummaryDTO{}, err
 	}
 
 	return courseDTOs, nil
@@ -2,10 +2,15 @@ package service_test
 
 import (
 	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/repository"
+	userEntity "CodeWithAzri/internal/app/module/user/entity"
 
 	"github.com/google/uuid"
 )
 
+// summaryRelations is the content listed courses are read with by default.
+var summaryRelations = repository.Relations{Tags: true, Gallery: true}
+
 var mockTags []entity.CourseTags = []entity.CourseTags{
 	{
 		ID:        uuid.MustParse("345c2c39-5a19-4842-bab8-072a53cd020b"),
@@ -26,6 +31,8 @@ var MockEntity entity.Course = entity.Course{
 	Name:        "Mock Course",
 	Description: "Mock Course Description",
 	Language:    "en",
+	Status:      "published",
+	OwnerID:     "instructor-uid",
 	CourseTags:  mockTags,
 	Gallery: []entity.CourseGallery{
 		{
@@ -135,3 +142,22 @@ var MockArrayEntity []entity.Course = []entity.Course{
 		UpdatedAt: 121212,
 	},
 }
+
+var MockInstructors []entity.CourseInstructor = []entity.CourseInstructor{
+	{
+		CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+		UserID:    "instructor-uid",
+		Role:      "owner",
+		Profile:   userEntity.User{ID: "instructor-uid", Name: "Mock Instructor", Email: "instructor@example.com"},
+		CreatedAt: 121212,
+		UpdatedAt: 121212,
+	},
+	{
+		CourseID:  uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+		UserID:    "editor-uid",
+		Role:      "editor",
+		Profile:   userEntity.User{ID: "editor-uid", Name: "Mock Editor"},
+		CreatedAt: 131313,
+		UpdatedAt: 131313,
+	},
+}
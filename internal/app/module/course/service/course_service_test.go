@@ -3,22 +3,94 @@ package service_test
 import (
 	"CodeWithAzri/internal/app/module/course/dto"
 	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/repository"
 	"CodeWithAzri/internal/app/module/course/repository/mocks"
 	"CodeWithAzri/internal/app/module/course/service"
+	serviceMocks "CodeWithAzri/internal/app/module/course/service/mocks"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	"CodeWithAzri/pkg/cache"
+	course_status_enum "CodeWithAzri/pkg/enums/courseStatus"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	instructor_role_enum "CodeWithAzri/pkg/enums/instructorRole"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"bou.ke/monkey"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func initializeService(t *testing.T) (service.CourseService, *mocks.CourseRepository) {
+	courseService, mockRepo, _ := initializeServiceWithMedia(t)
+	return courseService, mockRepo
+}
+
+func initializeServiceWithMedia(t *testing.T) (service.CourseService, *mocks.CourseRepository, *serviceMocks.MediaReader) {
 	mockRepo := mocks.NewCourseRepository(t)
-	service := service.NewCourseService(mockRepo)
-	return service, mockRepo
+	mockMedia := serviceMocks.NewMediaReader(t)
+	mockMedia.On("URLTTL").Return(15 * time.Minute).Maybe()
+	service := service.NewCourseService(mockRepo, mockMedia, cache.NopCache{})
+	return service, mockRepo, mockMedia
+}
+
+func TestService_CreateCourse(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	input := dto.UpdateCourseDTO{
+		Name:        "New Course",
+		Description: "New Description",
+		Language:    "en",
+		TagIDs:      []uuid.UUID{mockTags[0].ID},
+		Gallery:     []dto.UpsertCourseGalleryDTO{{URL: "https://example.com/cover.png"}},
+		Sections: []dto.UpsertCourseSectionDTO{{
+			Name:    "Introduction",
+			Lessons: []dto.UpsertCourseLessonDTO{{Title: "Welcome", VideoURL: "https://example.com/welcome"}},
+		}},
+	}
+
+	mockRepo.On("ReadTagsByIDs", []uuid.UUID{mockTags[0].ID}).Return(mockTags[:1], nil)
+
+	t.Run("Create Course Publishes Event", func(t *testing.T) {
+		var savedCourse entity.Course
+		mockRepo.On("Create", mock.AnythingOfType("entity.Course"), mock.MatchedBy(func(event eventEntity.Event) bool {
+			return event.Type == event_type_enum.CourseCreated
+		})).Run(func(args mock.Arguments) {
+			savedCourse = args.Get(0).(entity.Course)
+			event := args.Get(1).(eventEntity.Event)
+			assert.Equal(t, savedCourse.ID.String(), event.AggregateID)
+			assert.JSONEq(t, `{"course_id":"`+savedCourse.ID.String()+`","owner_id":"instructor-uid","name":"New Course"}`, event.Payload)
+		}).Return(nil).Once()
+
+		courseDTO, err := courseService.CreateCourse("instructor-uid", input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, course_status_enum.Draft, savedCourse.Status)
+		assert.Equal(t, "instructor-uid", savedCourse.OwnerID)
+		assert.Equal(t, savedCourse.ID, savedCourse.Sections[0].Lessons[0].CourseID)
+		assert.Equal(t, savedCourse.ID, courseDTO.ID)
+		assert.Equal(t, instructor_role_enum.Owner, courseDTO.Instructors[0].Role)
+	})
+
+	t.Run("Create Course With Existing IDs", func(t *testing.T) {
+		withIDs := input
+		withIDs.Sections = []dto.UpsertCourseSectionDTO{{ID: MockEntity.Sections[0].ID, Name: "Introduction"}}
+
+		_, err := courseService.CreateCourse("instructor-uid", withIDs)
+
+		assert.ErrorIs(t, err, service.ErrUnknownCourseContent)
+	})
+
+	t.Run("Create Course Repository Error", func(t *testing.T) {
+		mockRepo.On("Create", mock.AnythingOfType("entity.Course"), mock.AnythingOfType("entity.Event")).Return(errors.New("Repository Failure")).Once()
+
+		_, err := courseService.CreateCourse("instructor-uid", input)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
 }
 
 func TestService_GetDetailCourse(t *testing.T) {
@@ -27,15 +99,18 @@ func TestService_GetDetailCourse(t *testing.T) {
 	t.Run("Get Detail Course Success", func(t *testing.T) {
 		expectedCourse := MockEntity
 
-		mockRepo.On("ReadOne", mock.AnythingOfType("uuid.UUID")).Return(expectedCourse, nil)
+		mockRepo.On("ReadOneWith", mock.AnythingOfType("uuid.UUID"), repository.ContentRelations).Return(expectedCourse, nil)
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
 
-		actualCourse, err := courseService.GetDetailCourse(expectedCourse.ID)
+		actualCourse, _, err := courseService.GetDetailCourse(expectedCourse.ID, "", dto.CourseViewDTO{}, "")
 
 		assert.NoError(t, err)
 		assert.NotNil(t, actualCourse)
 		assert.Equal(t, expectedCourse.ID, actualCourse.ID)
 		assert.Equal(t, expectedCourse.Name, actualCourse.Name)
 		assert.Equal(t, expectedCourse.Description, actualCourse.Description)
+		assert.Len(t, actualCourse.Instructors, 2)
+		assert.Equal(t, "Mock Instructor", actualCourse.Instructors[0].Profile.Name)
 	})
 
 }
@@ -46,9 +121,9 @@ func TestService_GetDetailCourseRepositoryError(t *testing.T) {
 	t.Run("Get Detail Course Failed Repository", func(t *testing.T) {
 		expectedCourse := MockEntity
 
-		mockRepo.On("ReadOne", mock.AnythingOfType("uuid.UUID")).Return(entity.Course{}, fmt.Errorf("Repository Failure"))
+		mockRepo.On("ReadOneWith", mock.AnythingOfType("uuid.UUID"), repository.ContentRelations).Return(entity.Course{}, fmt.Errorf("Repository Failure"))
 
-		courseDTO, err := courseService.GetDetailCourse(expectedCourse.ID)
+		courseDTO, _, err := courseService.GetDetailCourse(expectedCourse.ID, "", dto.CourseViewDTO{}, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, dto.CourseDTO{}, courseDTO)
@@ -68,9 +143,10 @@ func TestService_GetDetailCourseAdapterError(t *testing.T) {
 
 		expectedCourse := MockEntity
 
-		mockRepo.On("ReadOne", mock.AnythingOfType("uuid.UUID")).Return(expectedCourse, nil)
+		mockRepo.On("ReadOneWith", mock.AnythingOfType("uuid.UUID"), repository.ContentRelations).Return(expectedCourse, nil)
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
 
-		_, err := courseService.GetDetailCourse(expectedCourse.ID)
+		_, _, err := courseService.GetDetailCourse(expectedCourse.ID, "", dto.CourseViewDTO{}, "")
 
 		assert.Error(t, err)
 
@@ -84,13 +160,16 @@ func TestService_GetPaginatedCourse(t *testing.T) {
 	t.Run("Get Paginated Course Success", func(t *testing.T) {
 		expectedCourse := MockArrayEntity
 
-		mockRepo.On("ReadMany", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(expectedCourse, nil)
+		mockRepo.On("ReadMany", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), summaryRelations).Return(expectedCourse, nil)
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
 
-		actualCourse, err := courseService.GetPaginatedCourses(10, 1)
+		actualCourse, _, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
 
 		assert.NoError(t, err)
 		assert.NotNil(t, actualCourse)
 		assert.Equal(t, expectedCourse[0].ID, actualCourse[0].ID)
+		assert.Len(t, actualCourse[0].Instructors, 2)
+		assert.Empty(t, actualCourse[1].Instructors)
 
 	})
 }
@@ -101,9 +180,9 @@ func TestService_GetPaginatedCourseRepositoryError(t *testing.T) {
 	t.Run("Get Paginated Course Repository Error", func(t *testing.T) {
 		expectedCourse := MockArrayEntity
 
-		mockRepo.On("ReadMany", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(expectedCourse, fmt.Errorf("Repository Failure"))
+		mockRepo.On("ReadMany", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), summaryRelations).Return(expectedCourse, fmt.Errorf("Repository Failure"))
 
-		_, err := courseService.GetPaginatedCourses(10, 1)
+		_, _, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Repository Failure")
@@ -121,11 +200,129 @@ func TestService_GetPaginatedCourseAdapterError(t *testing.T) {
 
 		expectedCourse := MockArrayEntity
 
-		mockRepo.On("ReadMany", mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(expectedCourse, nil)
+		mockRepo.On("ReadMany", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), summaryRelations).Return(expectedCourse, nil)
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
 
-		_, err := courseService.GetPaginatedCourses(10, 1)
+		_, _, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "mocked error during json.Marshal")
 	})
 }
+
+func TestService_GetDetailCourseUnpublished(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	draftCourse := MockEntity
+	draftCourse.Status = course_status_enum.Draft
+
+	mockRepo.On("ReadOneWith", mock.AnythingOfType("uuid.UUID"), repository.ContentRelations).Return(draftCourse, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Get Detail Draft Course As Learner", func(t *testing.T) {
+		courseDTO, _, err := courseService.GetDetailCourse(draftCourse.ID, "learner-uid", dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, courseDTO.ID)
+	})
+
+	t.Run("Get Detail Draft Course As Editor", func(t *testing.T) {
+		courseDTO, _, err := courseService.GetDetailCourse(draftCourse.ID, "editor-uid", dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, draftCourse.ID, courseDTO.ID)
+	})
+
+	t.Run("Get Detail Draft Course As Owner", func(t *testing.T) {
+		courseDTO, _, err := courseService.GetDetailCourse(draftCourse.ID, draftCourse.OwnerID, dto.CourseViewDTO{}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, draftCourse.ID, courseDTO.ID)
+		assert.Equal(t, course_status_enum.Draft, courseDTO.Status)
+	})
+}
+
+func TestService_ChangeStatus(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	reviewCourse := MockEntity
+	reviewCourse.Status = course_status_enum.Review
+
+	mockRepo.On("ReadOne", reviewCourse.ID).Return(reviewCourse, nil)
+	mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil)
+
+	t.Run("Change Status Publish Immediately", func(t *testing.T) {
+		mockRepo.On("UpdateStatus", reviewCourse.ID, course_status_enum.Published, (*int64)(nil), mock.AnythingOfType("int64")).Return(nil).Once()
+
+		courseDTO, err := courseService.ChangeStatus(reviewCourse.ID, reviewCourse.OwnerID, dto.UpdateCourseStatusDTO{Status: course_status_enum.Published})
+
+		assert.NoError(t, err)
+		assert.Equal(t, course_status_enum.Published, courseDTO.Status)
+		assert.Nil(t, courseDTO.PublishAt)
+	})
+
+	t.Run("Change Status Schedule Publish", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour).UnixMilli()
+		mockRepo.On("UpdateStatus", reviewCourse.ID, course_status_enum.Review, &publishAt, mock.AnythingOfType("int64")).Return(nil).Once()
+
+		courseDTO, err := courseService.ChangeStatus(reviewCourse.ID, reviewCourse.OwnerID, dto.UpdateCourseStatusDTO{Status: course_status_enum.Published, PublishAt: &publishAt})
+
+		assert.NoError(t, err)
+		assert.Equal(t, course_status_enum.Review, courseDTO.Status)
+		assert.Equal(t, publishAt, *courseDTO.PublishAt)
+	})
+
+	t.Run("Change Status Not Owner", func(t *testing.T) {
+		_, err := courseService.ChangeStatus(reviewCourse.ID, "someone-else", dto.UpdateCourseStatusDTO{Status: course_status_enum.Published})
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("Change Status Invalid Transition", func(t *testing.T) {
+		_, err := courseService.ChangeStatus(reviewCourse.ID, reviewCourse.OwnerID, dto.UpdateCourseStatusDTO{Status: course_status_enum.Archived})
+
+		assert.ErrorIs(t, err, service.ErrInvalidStatusTransition)
+	})
+
+	t.Run("Change Status Publish At Without Publishing", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour).UnixMilli()
+
+		_, err := courseService.ChangeStatus(reviewCourse.ID, reviewCourse.OwnerID, dto.UpdateCourseStatusDTO{Status: course_status_enum.Draft, PublishAt: &publishAt})
+
+		assert.ErrorIs(t, err, service.ErrInvalidPublishAt)
+	})
+
+	t.Run("Change Status Repository Error", func(t *testing.T) {
+		mockRepo.On("UpdateStatus", reviewCourse.ID, course_status_enum.Draft, (*int64)(nil), mock.AnythingOfType("int64")).Return(errors.New("Repository Failure")).Once()
+
+		_, err := courseService.ChangeStatus(reviewCourse.ID, reviewCourse.OwnerID, dto.UpdateCourseStatusDTO{Status: course_status_enum.Draft})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Repository Failure")
+	})
+}
+
+func TestService_ChangeStatusNotFound(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Change Status Course Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", mock.AnythingOfType("uuid.UUID")).Return(entity.Course{}, nil)
+
+		_, err := courseService.ChangeStatus(MockEntity.ID, MockEntity.OwnerID, dto.UpdateCourseStatusDTO{Status: course_status_enum.Review})
+
+		assert.ErrorIs(t, err, service.ErrCourseNotFound)
+	})
+}
+
+func TestService_PublishScheduledCourses(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Publish Scheduled Courses", func(t *testing.T) {
+		mockRepo.On("PublishDue", mock.AnythingOfType("int64")).Return(int64(3), nil)
+
+		published, err := courseService.PublishScheduledCourses()
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), published)
+	})
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/pkg/adapter"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) GetTags() ([]dto.CourseTagCountDTO, error) {
+	tags, err := s.repository.ReadTags()
+	if err != nil {
+		return []dto.CourseTagCountDTO{}, err
+	}
+
+	tagDTOs, err := adapter.AnyToType[[]dto.CourseTagCountDTO](tags)
+	if err != nil || tagDTOs == nil {
+		return []dto.CourseTagCountDTO{}, err
+	}
+
+	return tagDTOs, nil
+}
+
+func (s *Service) GetTag(id uuid.UUID) (dto.CourseTagsDTO, error) {
+	tag, err := s.readTag(id)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+
+	return adapter.AnyToType[dto.CourseTagsDTO](tag)
+}
+
+// CreateTag creates a tag, under input.ParentID when it is set.
+func (s *Service) CreateTag(input dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error) {
+	now := timepkg.NowUnixMilli()
+	tag := entity.CourseTags{
+		ID:        uuid.New(),
+		CreatedAt: now,
+	}
+
+	tag, err := s.applyTagUpdate(tag, input, now)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+
+	created, err := s.repository.CreateTag(tag)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+	if created == 0 {
+		return dto.CourseTagsDTO{}, ErrTagConflict
+	}
+
+	return adapter.AnyToType[dto.CourseTagsDTO](tag)
+}
+
+// UpdateTag replaces the name, slug and parent of a tag. A tag cannot be
+// moved under itself or one of its descendants.
+func (s *Service) UpdateTag(id uuid.UUID, input dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error) {
+	tag, err := s.readTag(id)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+
+	tag, err = s.applyTagUpdate(tag, input, timepkg.NowUnixMilli())
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+
+	updated, err := s.repository.UpdateTag(tag)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+	if updated == 0 {
+		return dto.CourseTagsDTO{}, ErrTagConflict
+	}
+	s.invalidateCourses()
+
+	return adapter.AnyToType[dto.CourseTagsDTO](tag)
+}
+
+// DeleteTag removes a tag from its courses and deletes it. Its children
+// move up to its parent.
+func (s *Service) DeleteTag(id uuid.UUID) error {
+	_, err := s.readTag(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.DeleteTag(id)
+	if err != nil {
+		return err
+	}
+	s.invalidateCourses()
+
+	return nil
+}
+
+// MergeTags folds the tag sourceID into input.TargetID, which takes over
+// its courses and children, and returns the target.
+func (s *Service) MergeTags(sourceID uuid.UUID, input dto.MergeCourseTagDTO) (dto.CourseTagsDTO, error) {
+	_, err := s.readTag(sourceID)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+
+	target, err := s.readTag(input.TargetID)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+
+	// Children of the source would end up under a target that descends from
+	// them.
+	ancestorIDs, err := s.repository.ReadTagAncestorIDs(target.ID)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+	for _, ancestorID := range ancestorIDs {
+		if ancestorID == sourceID {
+			return dto.CourseTagsDTO{}, ErrInvalidTagMerge
+		}
+	}
+
+	err = s.repository.MergeTags(sourceID, target.ID)
+	if err != nil {
+		return dto.CourseTagsDTO{}, err
+	}
+	s.invalidateCourses()
+
+	return adapter.AnyToType[dto.CourseTagsDTO](target)
+}
+
+func (s *Service) readTag(id uuid.UUID) (entity.CourseTags, error) {
+	tag, err := s.repository.ReadTag(id)
+	if err != nil {
+		return entity.CourseTags{}, err
+	}
+	if tag.ID == uuid.Nil {
+		return entity.CourseTags{}, ErrTagNotFound
+	}
+
+	return tag, nil
+}
+
+// readTagsByID returns the tags among ids that exist, by ID.
+func (s *Service) readTagsByID(ids []uuid.UUID) (map[uuid.UUID]entity.CourseTags, error) {
+	tags := make(map[uuid.UUID]entity.CourseTags, len(ids))
+	if len(ids) == 0 {
+		return tags, nil
+	}
+
+	found, err := s.repository.ReadTagsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range found {
+		tags[tag.ID] = tag
+	}
+
+	return tags, nil
+}
+
+// applyTagUpdate sets the name, slug and parent of tag from input, checking
+// that the slug is well formed and that the parent exists and does not
+// descend from tag.
+func (s *Service) applyTagUpdate(tag entity.CourseTags, input dto.UpsertCourseTagDTO, now int64) (entity.CourseTags, error) {
+	slug := input.Slug
+	if slug == "" {
+		slug = slugify(input.Name)
+	}
+	if slug == "" || slugify(slug) != slug {
+		return entity.CourseTags{}, ErrInvalidTagSlug
+	}
+
+	if input.ParentID != nil {
+		ancestorIDs, err := s.repository.ReadTagAncestorIDs(*input.ParentID)
+		if err != nil {
+			return entity.CourseTags{}, err
+		}
+		if len(ancestorIDs) == 0 {
+			return entity.CourseTags{}, ErrTagNotFound
+		}
+		for _, ancestorID := range ancestorIDs {
+			if ancestorID == tag.ID {
+				return entity.CourseTags{}, ErrInvalidTagParent
+			}
+		}
+	}
+
+	tag.Name = input.Name
+	tag.Slug = slug
+	tag.ParentID = input.ParentID
+	tag.UpdatedAt = now
+
+	return tag, nil
+}
+
+// slugify lowercases s and joins its runs of ASCII letters and digits with
+// dashes, dropping everything else.
+func slugify(s string) string {
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(s) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			pendingDash = true
+			continue
+		}
+		if pendingDash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		pendingDash = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,244 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/entity"
+	"CodeWithAzri/internal/app/module/course/service"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetTags(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Get Tags Success", func(t *testing.T) {
+		mockRepo.On("ReadTags").Return([]entity.CourseTagCount{{CourseTags: mockTags[0], CourseCount: 3}}, nil).Once()
+
+		tags, err := courseService.GetTags()
+
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+		assert.Equal(t, mockTags[0].ID, tags[0].ID)
+		assert.Equal(t, int64(3), tags[0].CourseCount)
+	})
+
+	t.Run("Get Tags Empty", func(t *testing.T) {
+		mockRepo.On("ReadTags").Return(nil, nil).Once()
+
+		tags, err := courseService.GetTags()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.CourseTagCountDTO{}, tags)
+	})
+
+	t.Run("Get Tags Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadTags").Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := courseService.GetTags()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetTag(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Get Tag Success", func(t *testing.T) {
+		mockRepo.On("ReadTag", mockTags[0].ID).Return(mockTags[0], nil).Once()
+
+		tag, err := courseService.GetTag(mockTags[0].ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockTags[0].Name, tag.Name)
+	})
+
+	t.Run("Get Tag Not Found", func(t *testing.T) {
+		mockRepo.On("ReadTag", mockTags[0].ID).Return(entity.CourseTags{}, nil).Once()
+
+		_, err := courseService.GetTag(mockTags[0].ID)
+
+		assert.ErrorIs(t, err, service.ErrTagNotFound)
+	})
+}
+
+func TestService_CreateTag(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Create Tag Derives Slug", func(t *testing.T) {
+		mockRepo.On("CreateTag", mock.MatchedBy(func(tag entity.CourseTags) bool {
+			return tag.Name == "Go & Web APIs" && tag.Slug == "go-web-apis" && tag.ParentID == nil
+		})).Return(int64(1), nil).Once()
+
+		tag, err := courseService.CreateTag(dto.UpsertCourseTagDTO{Name: "Go & Web APIs"})
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, tag.ID)
+		assert.Equal(t, "go-web-apis", tag.Slug)
+	})
+
+	t.Run("Create Tag Under Parent", func(t *testing.T) {
+		parentID := mockTags[0].ID
+		mockRepo.On("ReadTagAncestorIDs", parentID).Return([]uuid.UUID{parentID}, nil).Once()
+		mockRepo.On("CreateTag", mock.MatchedBy(func(tag entity.CourseTags) bool {
+			return tag.ParentID != nil && *tag.ParentID == parentID
+		})).Return(int64(1), nil).Once()
+
+		tag, err := courseService.CreateTag(dto.UpsertCourseTagDTO{Name: "Goroutines", ParentID: &parentID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &parentID, tag.ParentID)
+	})
+
+	t.Run("Create Tag Unknown Parent", func(t *testing.T) {
+		parentID := uuid.New()
+		mockRepo.On("ReadTagAncestorIDs", parentID).Return([]uuid.UUID{}, nil).Once()
+
+		_, err := courseService.CreateTag(dto.UpsertCourseTagDTO{Name: "Goroutines", ParentID: &parentID})
+
+		assert.ErrorIs(t, err, service.ErrTagNotFound)
+	})
+
+	t.Run("Create Tag Invalid Slug", func(t *testing.T) {
+		_, err := courseService.CreateTag(dto.UpsertCourseTagDTO{Name: "Go", Slug: "Go Lang"})
+		assert.ErrorIs(t, err, service.ErrInvalidTagSlug)
+
+		_, err = courseService.CreateTag(dto.UpsertCourseTagDTO{Name: "日本語"})
+		assert.ErrorIs(t, err, service.ErrInvalidTagSlug)
+	})
+
+	t.Run("Create Tag Conflict", func(t *testing.T) {
+		mockRepo.On("CreateTag", mock.Anything).Return(int64(0), nil).Once()
+
+		_, err := courseService.CreateTag(dto.UpsertCourseTagDTO{Name: "Mock Tag"})
+
+		assert.ErrorIs(t, err, service.ErrTagConflict)
+	})
+}
+
+func TestService_UpdateTag(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	tag := mockTags[1]
+	mockRepo.On("ReadTag", tag.ID).Return(tag, nil)
+
+	t.Run("Update Tag Success", func(t *testing.T) {
+		mockRepo.On("UpdateTag", mock.MatchedBy(func(updated entity.CourseTags) bool {
+			return updated.ID == tag.ID && updated.Name == "Renamed" && updated.Slug == "renamed" && updated.CreatedAt == tag.CreatedAt
+		})).Return(int64(1), nil).Once()
+
+		updated, err := courseService.UpdateTag(tag.ID, dto.UpsertCourseTagDTO{Name: "Renamed"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "renamed", updated.Slug)
+	})
+
+	t.Run("Update Tag Under Descendant", func(t *testing.T) {
+		childID := uuid.New()
+		mockRepo.On("ReadTagAncestorIDs", childID).Return([]uuid.UUID{childID, tag.ID}, nil).Once()
+
+		_, err := courseService.UpdateTag(tag.ID, dto.UpsertCourseTagDTO{Name: "Renamed", ParentID: &childID})
+
+		assert.ErrorIs(t, err, service.ErrInvalidTagParent)
+	})
+
+	t.Run("Update Tag Under Itself", func(t *testing.T) {
+		mockRepo.On("ReadTagAncestorIDs", tag.ID).Return([]uuid.UUID{tag.ID}, nil).Once()
+
+		_, err := courseService.UpdateTag(tag.ID, dto.UpsertCourseTagDTO{Name: "Renamed", ParentID: &tag.ID})
+
+		assert.ErrorIs(t, err, service.ErrInvalidTagParent)
+	})
+
+	t.Run("Update Tag Conflict", func(t *testing.T) {
+		mockRepo.On("UpdateTag", mock.Anything).Return(int64(0), nil).Once()
+
+		_, err := courseService.UpdateTag(tag.ID, dto.UpsertCourseTagDTO{Name: "Mock Tag"})
+
+		assert.ErrorIs(t, err, service.ErrTagConflict)
+	})
+
+	t.Run("Update Tag Not Found", func(t *testing.T) {
+		mockRepo.On("ReadTag", mockTags[0].ID).Return(entity.CourseTags{}, nil).Once()
+
+		_, err := courseService.UpdateTag(mockTags[0].ID, dto.UpsertCourseTagDTO{Name: "Renamed"})
+
+		assert.ErrorIs(t, err, service.ErrTagNotFound)
+	})
+}
+
+func TestService_DeleteTag(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	t.Run("Delete Tag Success", func(t *testing.T) {
+		mockRepo.On("ReadTag", mockTags[0].ID).Return(mockTags[0], nil).Once()
+		mockRepo.On("DeleteTag", mockTags[0].ID).Return(nil).Once()
+
+		err := courseService.DeleteTag(mockTags[0].ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete Tag Not Found", func(t *testing.T) {
+		mockRepo.On("ReadTag", mockTags[0].ID).Return(entity.CourseTags{}, nil).Once()
+
+		err := courseService.DeleteTag(mockTags[0].ID)
+
+		assert.ErrorIs(t, err, service.ErrTagNotFound)
+	})
+
+	t.Run("Delete Tag Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadTag", mockTags[0].ID).Return(mockTags[0], nil).Once()
+		mockRepo.On("DeleteTag", mockTags[0].ID).Return(errors.New("Repository Failure")).Once()
+
+		err := courseService.DeleteTag(mockTags[0].ID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_MergeTags(t *testing.T) {
+	courseService, mockRepo := initializeService(t)
+
+	source, target := mockTags[1], mockTags[0]
+	mockRepo.On("ReadTag", source.ID).Return(source, nil)
+	mockRepo.On("ReadTag", target.ID).Return(target, nil)
+
+	t.Run("Merge Tags Success", func(t *testing.T) {
+		mockRepo.On("ReadTagAncestorIDs", target.ID).Return([]uuid.UUID{target.ID}, nil).Once()
+		mockRepo.On("MergeTags", source.ID, target.ID).Return(nil).Once()
+
+		merged, err := courseService.MergeTags(source.ID, dto.MergeCourseTagDTO{TargetID: target.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, target.ID, merged.ID)
+	})
+
+	t.Run("Merge Tags Into Descendant", func(t *testing.T) {
+		mockRepo.On("ReadTagAncestorIDs", target.ID).Return([]uuid.UUID{target.ID, source.ID}, nil).Once()
+
+		_, err := courseService.MergeTags(source.ID, dto.MergeCourseTagDTO{TargetID: target.ID})
+
+		assert.ErrorIs(t, err, service.ErrInvalidTagMerge)
+	})
+
+	t.Run("Merge Tags Into Itself", func(t *testing.T) {
+		mockRepo.On("ReadTagAncestorIDs", source.ID).Return([]uuid.UUID{source.ID}, nil).Once()
+
+		_, err := courseService.MergeTags(source.ID, dto.MergeCourseTagDTO{TargetID: source.ID})
+
+		assert.ErrorIs(t, err, service.ErrInvalidTagMerge)
+	})
+
+	t.Run("Merge Tags Unknown Target", func(t *testing.T) {
+		unknownID := uuid.New()
+		mockRepo.On("ReadTag", unknownID).Return(entity.CourseTags{}, nil).Once()
+
+		_, err := courseService.MergeTags(source.ID, dto.MergeCourseTagDTO{TargetID: unknownID})
+
+		assert.ErrorIs(t, err, service.ErrTagNotFound)
+	})
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/repository"
+	"fmt"
+	"strings"
+)
+
+// summaryRelations is the content a listed course is read with unless the
+// listing expands it.
+var summaryRelations = repository.Relations{Tags: true, Gallery: true}
+
+// courseFields are the top level fields a course read can be narrowed to.
+var courseFields = map[string]bool{
+	"id":          true,
+	"name":        true,
+	"description": true,
+	"language":    true,
+	"status":      true,
+	"publish_at":  true,
+	"owner_id":    true,
+	"tags":        true,
+	"reviews":     true,
+	"gallery":     true,
+	"sections":    true,
+	"instructors": true,
+	"created_at":  true,
+	"updated_at":  true,
+}
+
+// courseRelations resolves the content a read shaped by view loads, starting
+// from defaults. Expansions add to them, and when fields are listed the
+// content they leave out is not read at all.
+func courseRelations(view dto.CourseViewDTO, defaults repository.Relations) (repository.Relations, error) {
+	selected := make(map[string]bool, len(view.Fields))
+	for _, field := range view.Fields {
+		if !courseFields[field] {
+			return repository.Relations{}, fmt.Errorf("%w: unknown field %q", ErrInvalidCourseView, field)
+		}
+		selected[field] = true
+	}
+
+	relations := defaults
+	for _, expansion := range view.Expand {
+		switch expansion {
+		case "tags":
+			relations.Tags = true
+		case "gallery":
+			relations.Gallery = true
+		case "sections":
+			relations.Sections = true
+		case "lessons":
+			relations.Sections = true
+			relations.Lessons = true
+		case "reviews":
+			relations.Reviews = true
+		default:
+			return repository.Relations{}, fmt.Errorf("%w: unknown expansion %q", ErrInvalidCourseView, expansion)
+		}
+	}
+
+	if len(selected) > 0 {
+		relations.Tags = relations.Tags && selected["tags"]
+		relations.Gallery = relations.Gallery && selected["gallery"]
+		relations.Sections = relations.Sections && selected["sections"]
+		relations.Lessons = relations.Lessons && relations.Sections
+		relations.Reviews = relations.Reviews && selected["reviews"]
+	}
+
+	return relations, nil
+}
+
+// relationsKey names relations in cache keys, so reads of different content
+// are cached apart.
+func relationsKey(relations repository.Relations) string {
+	names := make([]string, 0, 5)
+	for _, relation := range []struct {
+		name     string
+		selected bool
+	}{
+		{"tags", relations.Tags},
+		{"gallery", relations.Gallery},
+		{"sections", relations.Sections},
+		{"lessons", relations.Lessons},
+		{"reviews", relations.Reviews},
+	} {
+		if relation.selected {
+			names = append(names, relation.name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
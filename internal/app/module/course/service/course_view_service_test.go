@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/course/repository"
+	"CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/pkg/cache"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetDetailCourse_View(t *testing.T) {
+	tests := []struct {
+		name      string
+		view      dto.CourseViewDTO
+		relations repository.Relations
+	}{
+		{"Default Content", dto.CourseViewDTO{}, repository.ContentRelations},
+		{"Expand Reviews", dto.CourseViewDTO{Expand: []string{"reviews"}},
+			repository.Relations{Tags: true, Gallery: true, Sections: true, Lessons: true, Reviews: true}},
+		{"Only Scalar Fields", dto.CourseViewDTO{Fields: []string{"id", "name"}}, repository.Relations{}},
+		{"Fields Narrow Expansions", dto.CourseViewDTO{Fields: []string{"id", "sections"}, Expand: []string{"reviews"}},
+			repository.Relations{Sections: true, Lessons: true}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			courseService, mockRepo := initializeService(t)
+			mockRepo.On("ReadOneWith", MockEntity.ID, test.relations).Return(MockEntity, nil).Once()
+			mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+			courseDTO, _, err := courseService.GetDetailCourse(MockEntity.ID, "", test.view, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, MockEntity.ID, courseDTO.ID)
+		})
+	}
+
+	t.Run("Unknown Field", func(t *testing.T) {
+		courseService, _ := initializeService(t)
+
+		_, _, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{Fields: []string{"password"}}, "")
+
+		assert.ErrorIs(t, err, service.ErrInvalidCourseView)
+		assert.Contains(t, err.Error(), `"password"`)
+	})
+
+	t.Run("Unknown Expansion", func(t *testing.T) {
+		courseService, _ := initializeService(t)
+
+		_, _, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{Expand: []string{"students"}}, "")
+
+		assert.ErrorIs(t, err, service.ErrInvalidCourseView)
+	})
+
+	t.Run("ETag Varies With Fields", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadOneWith", MockEntity.ID, repository.Relations{}).Return(MockEntity, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+		_, idETag, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{Fields: []string{"id"}}, "")
+		assert.NoError(t, err)
+		_, nameETag, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{Fields: []string{"name"}}, "")
+		assert.NoError(t, err)
+		_, againETag, err := courseService.GetDetailCourse(MockEntity.ID, "", dto.CourseViewDTO{Fields: []string{"id"}}, "")
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, idETag, nameETag)
+		assert.Equal(t, idETag, againETag)
+	})
+}
+
+func TestService_GetPaginatedCourses_View(t *testing.T) {
+	t.Run("Expand Lessons", func(t *testing.T) {
+		courseService, mockRepo := initializeService(t)
+		relations := repository.Relations{Tags: true, Gallery: true, Sections: true, Lessons: true}
+		mockRepo.On("ReadMany", 10, 0, "", relations).Return(MockArrayEntity, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Once()
+
+		courses, _, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{Expand: []string{"lessons"}}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(MockArrayEntity[0].Sections), len(courses[0].Sections))
+	})
+
+	t.Run("Cached Apart Per Content", func(t *testing.T) {
+		courseService, mockRepo := initializeServiceWithCache(t, cache.NewMemoryCache(10))
+		mockRepo.On("ReadMany", 10, 0, "", summaryRelations).Return(MockArrayEntity, nil).Once()
+		mockRepo.On("ReadMany", 10, 0, "", repository.Relations{}).Return(MockArrayEntity, nil).Once()
+		mockRepo.On("ReadInstructors", mock.Anything).Return(MockInstructors, nil).Twice()
+
+		_, _, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+		_, _, err = courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{Fields: []string{"id", "name"}}, "")
+		assert.NoError(t, err)
+		_, _, err = courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{}, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Unknown Expansion", func(t *testing.T) {
+		courseService, _ := initializeService(t)
+
+		_, _, err := courseService.GetPaginatedCourses(10, 1, "", dto.CourseViewDTO{Expand: []string{"students"}}, "")
+
+		assert.ErrorIs(t, err, service.ErrInvalidCourseView)
+	})
+}
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -23,27 +25,87 @@ func (_m *CourseService) EXPECT() *CourseService_Expecter {
 	return &CourseService_Expecter{mock: &_m.Mock}
 }
 
-// GetDetailCourse provides a mock function with given fields: courseID
-func (_m *CourseService) GetDetailCourse(courseID uuid.UUID) (dto.CourseDTO, error) {
-	ret := _m.Called(courseID)
+// AddInstructor provides a mock function with given fields: courseID, userID, input
+func (_m *CourseService) AddInstructor(courseID uuid.UUID, userID string, input dto.AddCourseInstructorDTO) ([]dto.CourseInstructorDTO, error) {
+	ret := _m.Called(courseID, userID, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDetailCourse")
+		panic("no return value specified for AddInstructor")
+	}
+
+	var r0 []dto.CourseInstructorDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.AddCourseInstructorDTO) ([]dto.CourseInstructorDTO, error)); ok {
+		return rf(courseID, userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.AddCourseInstructorDTO) []dto.CourseInstructorDTO); ok {
+		r0 = rf(courseID, userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CourseInstructorDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.AddCourseInstructorDTO) error); ok {
+		r1 = rf(courseID, userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_AddInstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddInstructor'
+type CourseService_AddInstructor_Call struct {
+	*mock.Call
+}
+
+// AddInstructor is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - input dto.AddCourseInstructorDTO
+func (_e *CourseService_Expecter) AddInstructor(courseID interface{}, userID interface{}, input interface{}) *CourseService_AddInstructor_Call {
+	return &CourseService_AddInstructor_Call{Call: _e.mock.On("AddInstructor", courseID, userID, input)}
+}
+
+func (_c *CourseService_AddInstructor_Call) Run(run func(courseID uuid.UUID, userID string, input dto.AddCourseInstructorDTO)) *CourseService_AddInstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.AddCourseInstructorDTO))
+	})
+	return _c
+}
+
+func (_c *CourseService_AddInstructor_Call) Return(_a0 []dto.CourseInstructorDTO, _a1 error) *CourseService_AddInstructor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_AddInstructor_Call) RunAndReturn(run func(uuid.UUID, string, dto.AddCourseInstructorDTO) ([]dto.CourseInstructorDTO, error)) *CourseService_AddInstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeStatus provides a mock function with given fields: courseID, userID, input
+func (_m *CourseService) ChangeStatus(courseID uuid.UUID, userID string, input dto.UpdateCourseStatusDTO) (dto.CourseDTO, error) {
+	ret := _m.Called(courseID, userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStatus")
 	}
 
 	var r0 dto.CourseDTO
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.CourseDTO, error)); ok {
-		return rf(courseID)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.UpdateCourseStatusDTO) (dto.CourseDTO, error)); ok {
+		return rf(courseID, userID, input)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.CourseDTO); ok {
-		r0 = rf(courseID)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.UpdateCourseStatusDTO) dto.CourseDTO); ok {
+		r0 = rf(courseID, userID, input)
 	} else {
 		r0 = ret.Get(0).(dto.CourseDTO)
 	}
 
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(courseID)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.UpdateCourseStatusDTO) error); ok {
+		r1 = rf(courseID, userID, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,57 +113,333 @@ func (_m *CourseService) GetDetailCourse(courseID uuid.UUID) (dto.CourseDTO, err
 	return r0, r1
 }
 
-// CourseService_GetDetailCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetailCourse'
-type CourseService_GetDetailCourse_Call struct {
+// CourseService_ChangeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStatus'
+type CourseService_ChangeStatus_Call struct {
 	*mock.Call
 }
 
-// GetDetailCourse is a helper method to define mock.On call
+// ChangeStatus is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - input dto.UpdateCourseStatusDTO
+func (_e *CourseService_Expecter) ChangeStatus(courseID interface{}, userID interface{}, input interface{}) *CourseService_ChangeStatus_Call {
+	return &CourseService_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus", courseID, userID, input)}
+}
+
+func (_c *CourseService_ChangeStatus_Call) Run(run func(courseID uuid.UUID, userID string, input dto.UpdateCourseStatusDTO)) *CourseService_ChangeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.UpdateCourseStatusDTO))
+	})
+	return _c
+}
+
+func (_c *CourseService_ChangeStatus_Call) Return(_a0 dto.CourseDTO, _a1 error) *CourseService_ChangeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_ChangeStatus_Call) RunAndReturn(run func(uuid.UUID, string, dto.UpdateCourseStatusDTO) (dto.CourseDTO, error)) *CourseService_ChangeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteLesson provides a mock function with given fields: courseID, lessonID, userID
+func (_m *CourseService) CompleteLesson(courseID uuid.UUID, lessonID uuid.UUID, userID string) (dto.LessonProgressDTO, error) {
+	ret := _m.Called(courseID, lessonID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteLesson")
+	}
+
+	var r0 dto.LessonProgressDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) (dto.LessonProgressDTO, error)); ok {
+		return rf(courseID, lessonID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) dto.LessonProgressDTO); ok {
+		r0 = rf(courseID, lessonID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.LessonProgressDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(courseID, lessonID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_CompleteLesson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteLesson'
+type CourseService_CompleteLesson_Call struct {
+	*mock.Call
+}
+
+// CompleteLesson is a helper method to define mock.On call
 //   - courseID uuid.UUID
-func (_e *CourseService_Expecter) GetDetailCourse(courseID interface{}) *CourseService_GetDetailCourse_Call {
-	return &CourseService_GetDetailCourse_Call{Call: _e.mock.On("GetDetailCourse", courseID)}
+//   - lessonID uuid.UUID
+//   - userID string
+func (_e *CourseService_Expecter) CompleteLesson(courseID interface{}, lessonID interface{}, userID interface{}) *CourseService_CompleteLesson_Call {
+	return &CourseService_CompleteLesson_Call{Call: _e.mock.On("CompleteLesson", courseID, lessonID, userID)}
+}
+
+func (_c *CourseService_CompleteLesson_Call) Run(run func(courseID uuid.UUID, lessonID uuid.UUID, userID string)) *CourseService_CompleteLesson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_CompleteLesson_Call) Return(_a0 dto.LessonProgressDTO, _a1 error) *CourseService_CompleteLesson_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_CompleteLesson_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, string) (dto.LessonProgressDTO, error)) *CourseService_CompleteLesson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCourse provides a mock function with given fields: userID, input
+func (_m *CourseService) CreateCourse(userID string, input dto.UpdateCourseDTO) (dto.CourseDTO, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCourse")
+	}
+
+	var r0 dto.CourseDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.UpdateCourseDTO) (dto.CourseDTO, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.UpdateCourseDTO) dto.CourseDTO); ok {
+		r0 = rf(userID, input)
+	} else {
+		r0 = ret.Get(0).(dto.CourseDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.UpdateCourseDTO) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_CreateCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCourse'
+type CourseService_CreateCourse_Call struct {
+	*mock.Call
+}
+
+// CreateCourse is a helper method to define mock.On call
+//   - userID string
+//   - input dto.UpdateCourseDTO
+func (_e *CourseService_Expecter) CreateCourse(userID interface{}, input interface{}) *CourseService_CreateCourse_Call {
+	return &CourseService_CreateCourse_Call{Call: _e.mock.On("CreateCourse", userID, input)}
+}
+
+func (_c *CourseService_CreateCourse_Call) Run(run func(userID string, input dto.UpdateCourseDTO)) *CourseService_CreateCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.UpdateCourseDTO))
+	})
+	return _c
+}
+
+func (_c *CourseService_CreateCourse_Call) Return(_a0 dto.CourseDTO, _a1 error) *CourseService_CreateCourse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_CreateCourse_Call) RunAndReturn(run func(string, dto.UpdateCourseDTO) (dto.CourseDTO, error)) *CourseService_CreateCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: input
+func (_m *CourseService) CreateTag(input dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 dto.CourseTagsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(dto.UpsertCourseTagDTO) dto.CourseTagsDTO); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(dto.CourseTagsDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.UpsertCourseTagDTO) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type CourseService_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - input dto.UpsertCourseTagDTO
+func (_e *CourseService_Expecter) CreateTag(input interface{}) *CourseService_CreateTag_Call {
+	return &CourseService_CreateTag_Call{Call: _e.mock.On("CreateTag", input)}
+}
+
+func (_c *CourseService_CreateTag_Call) Run(run func(input dto.UpsertCourseTagDTO)) *CourseService_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(dto.UpsertCourseTagDTO))
+	})
+	return _c
+}
+
+func (_c *CourseService_CreateTag_Call) Return(_a0 dto.CourseTagsDTO, _a1 error) *CourseService_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_CreateTag_Call) RunAndReturn(run func(dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error)) *CourseService_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: id
+func (_m *CourseService) DeleteTag(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseService_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type CourseService_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *CourseService_Expecter) DeleteTag(id interface{}) *CourseService_DeleteTag_Call {
+	return &CourseService_DeleteTag_Call{Call: _e.mock.On("DeleteTag", id)}
 }
 
-func (_c *CourseService_GetDetailCourse_Call) Run(run func(courseID uuid.UUID)) *CourseService_GetDetailCourse_Call {
+func (_c *CourseService_DeleteTag_Call) Run(run func(id uuid.UUID)) *CourseService_DeleteTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *CourseService_GetDetailCourse_Call) Return(_a0 dto.CourseDTO, _a1 error) *CourseService_GetDetailCourse_Call {
+func (_c *CourseService_DeleteTag_Call) Return(_a0 error) *CourseService_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseService_DeleteTag_Call) RunAndReturn(run func(uuid.UUID) error) *CourseService_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffRevisions provides a mock function with given fields: courseID, userID, from, to
+func (_m *CourseService) DiffRevisions(courseID uuid.UUID, userID string, from int, to int) (dto.CourseRevisionDiffDTO, error) {
+	ret := _m.Called(courseID, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRevisions")
+	}
+
+	var r0 dto.CourseRevisionDiffDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) (dto.CourseRevisionDiffDTO, error)); ok {
+		return rf(courseID, userID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) dto.CourseRevisionDiffDTO); ok {
+		r0 = rf(courseID, userID, from, to)
+	} else {
+		r0 = ret.Get(0).(dto.CourseRevisionDiffDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int, int) error); ok {
+		r1 = rf(courseID, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type CourseService_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - from int
+//   - to int
+func (_e *CourseService_Expecter) DiffRevisions(courseID interface{}, userID interface{}, from interface{}, to interface{}) *CourseService_DiffRevisions_Call {
+	return &CourseService_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", courseID, userID, from, to)}
+}
+
+func (_c *CourseService_DiffRevisions_Call) Run(run func(courseID uuid.UUID, userID string, from int, to int)) *CourseService_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *CourseService_DiffRevisions_Call) Return(_a0 dto.CourseRevisionDiffDTO, _a1 error) *CourseService_DiffRevisions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *CourseService_GetDetailCourse_Call) RunAndReturn(run func(uuid.UUID) (dto.CourseDTO, error)) *CourseService_GetDetailCourse_Call {
+func (_c *CourseService_DiffRevisions_Call) RunAndReturn(run func(uuid.UUID, string, int, int) (dto.CourseRevisionDiffDTO, error)) *CourseService_DiffRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPaginatedCourses provides a mock function with given fields: limit, page
-func (_m *CourseService) GetPaginatedCourses(limit int, page int) ([]dto.CourseDTO, error) {
-	ret := _m.Called(limit, page)
+// Enroll provides a mock function with given fields: courseID, userID
+func (_m *CourseService) Enroll(courseID uuid.UUID, userID string) (dto.CourseEnrollmentDTO, error) {
+	ret := _m.Called(courseID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPaginatedCourses")
+		panic("no return value specified for Enroll")
 	}
 
-	var r0 []dto.CourseDTO
+	var r0 dto.CourseEnrollmentDTO
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]dto.CourseDTO, error)); ok {
-		return rf(limit, page)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.CourseEnrollmentDTO, error)); ok {
+		return rf(courseID, userID)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []dto.CourseDTO); ok {
-		r0 = rf(limit, page)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.CourseEnrollmentDTO); ok {
+		r0 = rf(courseID, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.CourseDTO)
-		}
+		r0 = ret.Get(0).(dto.CourseEnrollmentDTO)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(limit, page)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(courseID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -109,31 +447,1218 @@ func (_m *CourseService) GetPaginatedCourses(limit int, page int) ([]dto.CourseD
 	return r0, r1
 }
 
-// CourseService_GetPaginatedCourses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginatedCourses'
-type CourseService_GetPaginatedCourses_Call struct {
+// CourseService_Enroll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enroll'
+type CourseService_Enroll_Call struct {
 	*mock.Call
 }
 
-// GetPaginatedCourses is a helper method to define mock.On call
-//   - limit int
-//   - page int
-func (_e *CourseService_Expecter) GetPaginatedCourses(limit interface{}, page interface{}) *CourseService_GetPaginatedCourses_Call {
-	return &CourseService_GetPaginatedCourses_Call{Call: _e.mock.On("GetPaginatedCourses", limit, page)}
+// Enroll is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseService_Expecter) Enroll(courseID interface{}, userID interface{}) *CourseService_Enroll_Call {
+	return &CourseService_Enroll_Call{Call: _e.mock.On("Enroll", courseID, userID)}
+}
+
+func (_c *CourseService_Enroll_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseService_Enroll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_Enroll_Call) Return(_a0 dto.CourseEnrollmentDTO, _a1 error) *CourseService_Enroll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_Enroll_Call) RunAndReturn(run func(uuid.UUID, string) (dto.CourseEnrollmentDTO, error)) *CourseService_Enroll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *CourseService) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseService_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type CourseService_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *CourseService_Expecter) EraseUserData(userID interface{}) *CourseService_EraseUserData_Call {
+	return &CourseService_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *CourseService_EraseUserData_Call) Run(run func(userID string)) *CourseService_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_EraseUserData_Call) Return(_a0 error) *CourseService_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseService_EraseUserData_Call) RunAndReturn(run func(string) error) *CourseService_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: userID
+func (_m *CourseService) ExportUserData(userID string) (map[string]any, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type CourseService_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *CourseService_Expecter) ExportUserData(userID interface{}) *CourseService_ExportUserData_Call {
+	return &CourseService_ExportUserData_Call{Call: _e.mock.On("ExportUserData", userID)}
+}
+
+func (_c *CourseService_ExportUserData_Call) Run(run func(userID string)) *CourseService_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_ExportUserData_Call) Return(_a0 map[string]any, _a1 error) *CourseService_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_ExportUserData_Call) RunAndReturn(run func(string) (map[string]any, error)) *CourseService_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCourseProgress provides a mock function with given fields: courseID, userID
+func (_m *CourseService) GetCourseProgress(courseID uuid.UUID, userID string) (dto.CourseProgressDTO, error) {
+	ret := _m.Called(courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCourseProgress")
+	}
+
+	var r0 dto.CourseProgressDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.CourseProgressDTO, error)); ok {
+		return rf(courseID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.CourseProgressDTO); ok {
+		r0 = rf(courseID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.CourseProgressDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetCourseProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCourseProgress'
+type CourseService_GetCourseProgress_Call struct {
+	*mock.Call
+}
+
+// GetCourseProgress is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseService_Expecter) GetCourseProgress(courseID interface{}, userID interface{}) *CourseService_GetCourseProgress_Call {
+	return &CourseService_GetCourseProgress_Call{Call: _e.mock.On("GetCourseProgress", courseID, userID)}
+}
+
+func (_c *CourseService_GetCourseProgress_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseService_GetCourseProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetCourseProgress_Call) Return(_a0 dto.CourseProgressDTO, _a1 error) *CourseService_GetCourseProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetCourseProgress_Call) RunAndReturn(run func(uuid.UUID, string) (dto.CourseProgressDTO, error)) *CourseService_GetCourseProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetailCourse provides a mock function with given fields: courseID, userID, view, ifNoneMatch
+func (_m *CourseService) GetDetailCourse(courseID uuid.UUID, userID string, view dto.CourseViewDTO, ifNoneMatch string) (dto.CourseDTO, string, error) {
+	ret := _m.Called(courseID, userID, view, ifNoneMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetailCourse")
+	}
+
+	var r0 dto.CourseDTO
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.CourseViewDTO, string) (dto.CourseDTO, string, error)); ok {
+		return rf(courseID, userID, view, ifNoneMatch)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.CourseViewDTO, string) dto.CourseDTO); ok {
+		r0 = rf(courseID, userID, view, ifNoneMatch)
+	} else {
+		r0 = ret.Get(0).(dto.CourseDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.CourseViewDTO, string) string); ok {
+		r1 = rf(courseID, userID, view, ifNoneMatch)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(uuid.UUID, string, dto.CourseViewDTO, string) error); ok {
+		r2 = rf(courseID, userID, view, ifNoneMatch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CourseService_GetDetailCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetailCourse'
+type CourseService_GetDetailCourse_Call struct {
+	*mock.Call
+}
+
+// GetDetailCourse is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - view dto.CourseViewDTO
+//   - ifNoneMatch string
+func (_e *CourseService_Expecter) GetDetailCourse(courseID interface{}, userID interface{}, view interface{}, ifNoneMatch interface{}) *CourseService_GetDetailCourse_Call {
+	return &CourseService_GetDetailCourse_Call{Call: _e.mock.On("GetDetailCourse", courseID, userID, view, ifNoneMatch)}
+}
+
+func (_c *CourseService_GetDetailCourse_Call) Run(run func(courseID uuid.UUID, userID string, view dto.CourseViewDTO, ifNoneMatch string)) *CourseService_GetDetailCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.CourseViewDTO), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetDetailCourse_Call) Return(_a0 dto.CourseDTO, _a1 string, _a2 error) *CourseService_GetDetailCourse_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CourseService_GetDetailCourse_Call) RunAndReturn(run func(uuid.UUID, string, dto.CourseViewDTO, string) (dto.CourseDTO, string, error)) *CourseService_GetDetailCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnrolledUserIDs provides a mock function with given fields: courseID
+func (_m *CourseService) GetEnrolledUserIDs(courseID uuid.UUID) ([]string, error) {
+	ret := _m.Called(courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnrolledUserIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]string, error)); ok {
+		return rf(courseID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []string); ok {
+		r0 = rf(courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetEnrolledUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrolledUserIDs'
+type CourseService_GetEnrolledUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetEnrolledUserIDs is a helper method to define mock.On call
+//   - courseID uuid.UUID
+func (_e *CourseService_Expecter) GetEnrolledUserIDs(courseID interface{}) *CourseService_GetEnrolledUserIDs_Call {
+	return &CourseService_GetEnrolledUserIDs_Call{Call: _e.mock.On("GetEnrolledUserIDs", courseID)}
+}
+
+func (_c *CourseService_GetEnrolledUserIDs_Call) Run(run func(courseID uuid.UUID)) *CourseService_GetEnrolledUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetEnrolledUserIDs_Call) Return(_a0 []string, _a1 error) *CourseService_GetEnrolledUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetEnrolledUserIDs_Call) RunAndReturn(run func(uuid.UUID) ([]string, error)) *CourseService_GetEnrolledUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstructorCourses provides a mock function with given fields: instructorID, limit, page, viewerID
+func (_m *CourseService) GetInstructorCourses(instructorID string, limit int, page int, viewerID string) ([]dto.CourseSummaryDTO, error) {
+	ret := _m.Called(instructorID, limit, page, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstructorCourses")
+	}
+
+	var r0 []dto.CourseSummaryDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int, string) ([]dto.CourseSummaryDTO, error)); ok {
+		return rf(instructorID, limit, page, viewerID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, string) []dto.CourseSummaryDTO); ok {
+		r0 = rf(instructorID, limit, page, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CourseSummaryDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, string) error); ok {
+		r1 = rf(instructorID, limit, page, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetInstructorCourses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstructorCourses'
+type CourseService_GetInstructorCourses_Call struct {
+	*mock.Call
+}
+
+// GetInstructorCourses is a helper method to define mock.On call
+//   - instructorID string
+//   - limit int
+//   - page int
+//   - viewerID string
+func (_e *CourseService_Expecter) GetInstructorCourses(instructorID interface{}, limit interface{}, page interface{}, viewerID interface{}) *CourseService_GetInstructorCourses_Call {
+	return &CourseService_GetInstructorCourses_Call{Call: _e.mock.On("GetInstructorCourses", instructorID, limit, page, viewerID)}
+}
+
+func (_c *CourseService_GetInstructorCourses_Call) Run(run func(instructorID string, limit int, page int, viewerID string)) *CourseService_GetInstructorCourses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetInstructorCourses_Call) Return(_a0 []dto.CourseSummaryDTO, _a1 error) *CourseService_GetInstructorCourses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetInstructorCourses_Call) RunAndReturn(run func(string, int, int, string) ([]dto.CourseSummaryDTO, error)) *CourseService_GetInstructorCourses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLessonAccess provides a mock function with given fields: lessonID, userID
+func (_m *CourseService) GetLessonAccess(lessonID uuid.UUID, userID string) (dto.LessonAccessDTO, error) {
+	ret := _m.Called(lessonID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLessonAccess")
+	}
+
+	var r0 dto.LessonAccessDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.LessonAccessDTO, error)); ok {
+		return rf(lessonID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.LessonAccessDTO); ok {
+		r0 = rf(lessonID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.LessonAccessDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(lessonID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetLessonAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLessonAccess'
+type CourseService_GetLessonAccess_Call struct {
+	*mock.Call
+}
+
+// GetLessonAccess is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - userID string
+func (_e *CourseService_Expecter) GetLessonAccess(lessonID interface{}, userID interface{}) *CourseService_GetLessonAccess_Call {
+	return &CourseService_GetLessonAccess_Call{Call: _e.mock.On("GetLessonAccess", lessonID, userID)}
+}
+
+func (_c *CourseService_GetLessonAccess_Call) Run(run func(lessonID uuid.UUID, userID string)) *CourseService_GetLessonAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetLessonAccess_Call) Return(_a0 dto.LessonAccessDTO, _a1 error) *CourseService_GetLessonAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetLessonAccess_Call) RunAndReturn(run func(uuid.UUID, string) (dto.LessonAccessDTO, error)) *CourseService_GetLessonAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLessonStream provides a mock function with given fields: courseID, lessonID, userID
+func (_m *CourseService) GetLessonStream(courseID uuid.UUID, lessonID uuid.UUID, userID string) (dto.LessonStreamDTO, error) {
+	ret := _m.Called(courseID, lessonID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLessonStream")
+	}
+
+	var r0 dto.LessonStreamDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) (dto.LessonStreamDTO, error)); ok {
+		return rf(courseID, lessonID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) dto.LessonStreamDTO); ok {
+		r0 = rf(courseID, lessonID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.LessonStreamDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(courseID, lessonID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetLessonStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLessonStream'
+type CourseService_GetLessonStream_Call struct {
+	*mock.Call
+}
+
+// GetLessonStream is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - lessonID uuid.UUID
+//   - userID string
+func (_e *CourseService_Expecter) GetLessonStream(courseID interface{}, lessonID interface{}, userID interface{}) *CourseService_GetLessonStream_Call {
+	return &CourseService_GetLessonStream_Call{Call: _e.mock.On("GetLessonStream", courseID, lessonID, userID)}
+}
+
+func (_c *CourseService_GetLessonStream_Call) Run(run func(courseID uuid.UUID, lessonID uuid.UUID, userID string)) *CourseService_GetLessonStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetLessonStream_Call) Return(_a0 dto.LessonStreamDTO, _a1 error) *CourseService_GetLessonStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetLessonStream_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, string) (dto.LessonStreamDTO, error)) *CourseService_GetLessonStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaginatedCourses provides a mock function with given fields: limit, page, userID, view, ifNoneMatch
+func (_m *CourseService) GetPaginatedCourses(limit int, page int, userID string, view dto.CourseViewDTO, ifNoneMatch string) ([]dto.ExpandedCourseSummaryDTO, string, error) {
+	ret := _m.Called(limit, page, userID, view, ifNoneMatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedCourses")
+	}
+
+	var r0 []dto.ExpandedCourseSummaryDTO
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string, dto.CourseViewDTO, string) ([]dto.ExpandedCourseSummaryDTO, string, error)); ok {
+		return rf(limit, page, userID, view, ifNoneMatch)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, dto.CourseViewDTO, string) []dto.ExpandedCourseSummaryDTO); ok {
+		r0 = rf(limit, page, userID, view, ifNoneMatch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ExpandedCourseSummaryDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, dto.CourseViewDTO, string) string); ok {
+		r1 = rf(limit, page, userID, view, ifNoneMatch)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string, dto.CourseViewDTO, string) error); ok {
+		r2 = rf(limit, page, userID, view, ifNoneMatch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CourseService_GetPaginatedCourses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaginatedCourses'
+type CourseService_GetPaginatedCourses_Call struct {
+	*mock.Call
+}
+
+// GetPaginatedCourses is a helper method to define mock.On call
+//   - limit int
+//   - page int
+//   - userID string
+//   - view dto.CourseViewDTO
+//   - ifNoneMatch string
+func (_e *CourseService_Expecter) GetPaginatedCourses(limit interface{}, page interface{}, userID interface{}, view interface{}, ifNoneMatch interface{}) *CourseService_GetPaginatedCourses_Call {
+	return &CourseService_GetPaginatedCourses_Call{Call: _e.mock.On("GetPaginatedCourses", limit, page, userID, view, ifNoneMatch)}
+}
+
+func (_c *CourseService_GetPaginatedCourses_Call) Run(run func(limit int, page int, userID string, view dto.CourseViewDTO, ifNoneMatch string)) *CourseService_GetPaginatedCourses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string), args[3].(dto.CourseViewDTO), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetPaginatedCourses_Call) Return(_a0 []dto.ExpandedCourseSummaryDTO, _a1 string, _a2 error) *CourseService_GetPaginatedCourses_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CourseService_GetPaginatedCourses_Call) RunAndReturn(run func(int, int, string, dto.CourseViewDTO, string) ([]dto.ExpandedCourseSummaryDTO, string, error)) *CourseService_GetPaginatedCourses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function with given fields: courseID, userID, revision
+func (_m *CourseService) GetRevision(courseID uuid.UUID, userID string, revision int) (dto.CourseRevisionDTO, error) {
+	ret := _m.Called(courseID, userID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 dto.CourseRevisionDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int) (dto.CourseRevisionDTO, error)); ok {
+		return rf(courseID, userID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int) dto.CourseRevisionDTO); ok {
+		r0 = rf(courseID, userID, revision)
+	} else {
+		r0 = ret.Get(0).(dto.CourseRevisionDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int) error); ok {
+		r1 = rf(courseID, userID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type CourseService_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - revision int
+func (_e *CourseService_Expecter) GetRevision(courseID interface{}, userID interface{}, revision interface{}) *CourseService_GetRevision_Call {
+	return &CourseService_GetRevision_Call{Call: _e.mock.On("GetRevision", courseID, userID, revision)}
+}
+
+func (_c *CourseService_GetRevision_Call) Run(run func(courseID uuid.UUID, userID string, revision int)) *CourseService_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetRevision_Call) Return(_a0 dto.CourseRevisionDTO, _a1 error) *CourseService_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetRevision_Call) RunAndReturn(run func(uuid.UUID, string, int) (dto.CourseRevisionDTO, error)) *CourseService_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function with given fields: courseID, userID
+func (_m *CourseService) GetRevisions(courseID uuid.UUID, userID string) ([]dto.CourseRevisionDTO, error) {
+	ret := _m.Called(courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []dto.CourseRevisionDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) ([]dto.CourseRevisionDTO, error)); ok {
+		return rf(courseID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) []dto.CourseRevisionDTO); ok {
+		r0 = rf(courseID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CourseRevisionDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type CourseService_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseService_Expecter) GetRevisions(courseID interface{}, userID interface{}) *CourseService_GetRevisions_Call {
+	return &CourseService_GetRevisions_Call{Call: _e.mock.On("GetRevisions", courseID, userID)}
+}
+
+func (_c *CourseService_GetRevisions_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseService_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetRevisions_Call) Return(_a0 []dto.CourseRevisionDTO, _a1 error) *CourseService_GetRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetRevisions_Call) RunAndReturn(run func(uuid.UUID, string) ([]dto.CourseRevisionDTO, error)) *CourseService_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTag provides a mock function with given fields: id
+func (_m *CourseService) GetTag(id uuid.UUID) (dto.CourseTagsDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTag")
+	}
+
+	var r0 dto.CourseTagsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.CourseTagsDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.CourseTagsDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.CourseTagsDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type CourseService_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *CourseService_Expecter) GetTag(id interface{}) *CourseService_GetTag_Call {
+	return &CourseService_GetTag_Call{Call: _e.mock.On("GetTag", id)}
+}
+
+func (_c *CourseService_GetTag_Call) Run(run func(id uuid.UUID)) *CourseService_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseService_GetTag_Call) Return(_a0 dto.CourseTagsDTO, _a1 error) *CourseService_GetTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetTag_Call) RunAndReturn(run func(uuid.UUID) (dto.CourseTagsDTO, error)) *CourseService_GetTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields:
+func (_m *CourseService) GetTags() ([]dto.CourseTagCountDTO, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []dto.CourseTagCountDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]dto.CourseTagCountDTO, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []dto.CourseTagCountDTO); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CourseTagCountDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type CourseService_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+func (_e *CourseService_Expecter) GetTags() *CourseService_GetTags_Call {
+	return &CourseService_GetTags_Call{Call: _e.mock.On("GetTags")}
+}
+
+func (_c *CourseService_GetTags_Call) Run(run func()) *CourseService_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CourseService_GetTags_Call) Return(_a0 []dto.CourseTagCountDTO, _a1 error) *CourseService_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_GetTags_Call) RunAndReturn(run func() ([]dto.CourseTagCountDTO, error)) *CourseService_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: sourceID, input
+func (_m *CourseService) MergeTags(sourceID uuid.UUID, input dto.MergeCourseTagDTO) (dto.CourseTagsDTO, error) {
+	ret := _m.Called(sourceID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 dto.CourseTagsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.MergeCourseTagDTO) (dto.CourseTagsDTO, error)); ok {
+		return rf(sourceID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.MergeCourseTagDTO) dto.CourseTagsDTO); ok {
+		r0 = rf(sourceID, input)
+	} else {
+		r0 = ret.Get(0).(dto.CourseTagsDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, dto.MergeCourseTagDTO) error); ok {
+		r1 = rf(sourceID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type CourseService_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - sourceID uuid.UUID
+//   - input dto.MergeCourseTagDTO
+func (_e *CourseService_Expecter) MergeTags(sourceID interface{}, input interface{}) *CourseService_MergeTags_Call {
+	return &CourseService_MergeTags_Call{Call: _e.mock.On("MergeTags", sourceID, input)}
+}
+
+func (_c *CourseService_MergeTags_Call) Run(run func(sourceID uuid.UUID, input dto.MergeCourseTagDTO)) *CourseService_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(dto.MergeCourseTagDTO))
+	})
+	return _c
+}
+
+func (_c *CourseService_MergeTags_Call) Return(_a0 dto.CourseTagsDTO, _a1 error) *CourseService_MergeTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_MergeTags_Call) RunAndReturn(run func(uuid.UUID, dto.MergeCourseTagDTO) (dto.CourseTagsDTO, error)) *CourseService_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishScheduledCourses provides a mock function with given fields:
+func (_m *CourseService) PublishScheduledCourses() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishScheduledCourses")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_PublishScheduledCourses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishScheduledCourses'
+type CourseService_PublishScheduledCourses_Call struct {
+	*mock.Call
+}
+
+// PublishScheduledCourses is a helper method to define mock.On call
+func (_e *CourseService_Expecter) PublishScheduledCourses() *CourseService_PublishScheduledCourses_Call {
+	return &CourseService_PublishScheduledCourses_Call{Call: _e.mock.On("PublishScheduledCourses")}
+}
+
+func (_c *CourseService_PublishScheduledCourses_Call) Run(run func()) *CourseService_PublishScheduledCourses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CourseService_PublishScheduledCourses_Call) Return(_a0 int64, _a1 error) *CourseService_PublishScheduledCourses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_PublishScheduledCourses_Call) RunAndReturn(run func() (int64, error)) *CourseService_PublishScheduledCourses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordModeration provides a mock function with given fields: targetType, targetID
+func (_m *CourseService) RecordModeration(targetType moderation_target_enum.ModerationTarget, targetID string) error {
+	ret := _m.Called(targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordModeration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(moderation_target_enum.ModerationTarget, string) error); ok {
+		r0 = rf(targetType, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseService_RecordModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordModeration'
+type CourseService_RecordModeration_Call struct {
+	*mock.Call
+}
+
+// RecordModeration is a helper method to define mock.On call
+//   - targetType moderation_target_enum.ModerationTarget
+//   - targetID string
+func (_e *CourseService_Expecter) RecordModeration(targetType interface{}, targetID interface{}) *CourseService_RecordModeration_Call {
+	return &CourseService_RecordModeration_Call{Call: _e.mock.On("RecordModeration", targetType, targetID)}
+}
+
+func (_c *CourseService_RecordModeration_Call) Run(run func(targetType moderation_target_enum.ModerationTarget, targetID string)) *CourseService_RecordModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(moderation_target_enum.ModerationTarget), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_RecordModeration_Call) Return(_a0 error) *CourseService_RecordModeration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseService_RecordModeration_Call) RunAndReturn(run func(moderation_target_enum.ModerationTarget, string) error) *CourseService_RecordModeration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordVideoDuration provides a mock function with given fields: mediaID, duration
+func (_m *CourseService) RecordVideoDuration(mediaID uuid.UUID, duration int) error {
+	ret := _m.Called(mediaID, duration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordVideoDuration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) error); ok {
+		r0 = rf(mediaID, duration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseService_RecordVideoDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordVideoDuration'
+type CourseService_RecordVideoDuration_Call struct {
+	*mock.Call
+}
+
+// RecordVideoDuration is a helper method to define mock.On call
+//   - mediaID uuid.UUID
+//   - duration int
+func (_e *CourseService_Expecter) RecordVideoDuration(mediaID interface{}, duration interface{}) *CourseService_RecordVideoDuration_Call {
+	return &CourseService_RecordVideoDuration_Call{Call: _e.mock.On("RecordVideoDuration", mediaID, duration)}
+}
+
+func (_c *CourseService_RecordVideoDuration_Call) Run(run func(mediaID uuid.UUID, duration int)) *CourseService_RecordVideoDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CourseService_RecordVideoDuration_Call) Return(_a0 error) *CourseService_RecordVideoDuration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseService_RecordVideoDuration_Call) RunAndReturn(run func(uuid.UUID, int) error) *CourseService_RecordVideoDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveInstructor provides a mock function with given fields: courseID, userID, instructorID
+func (_m *CourseService) RemoveInstructor(courseID uuid.UUID, userID string, instructorID string) error {
+	ret := _m.Called(courseID, userID, instructorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveInstructor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, string) error); ok {
+		r0 = rf(courseID, userID, instructorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CourseService_RemoveInstructor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveInstructor'
+type CourseService_RemoveInstructor_Call struct {
+	*mock.Call
+}
+
+// RemoveInstructor is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - instructorID string
+func (_e *CourseService_Expecter) RemoveInstructor(courseID interface{}, userID interface{}, instructorID interface{}) *CourseService_RemoveInstructor_Call {
+	return &CourseService_RemoveInstructor_Call{Call: _e.mock.On("RemoveInstructor", courseID, userID, instructorID)}
+}
+
+func (_c *CourseService_RemoveInstructor_Call) Run(run func(courseID uuid.UUID, userID string, instructorID string)) *CourseService_RemoveInstructor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CourseService_RemoveInstructor_Call) Return(_a0 error) *CourseService_RemoveInstructor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CourseService_RemoveInstructor_Call) RunAndReturn(run func(uuid.UUID, string, string) error) *CourseService_RemoveInstructor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackCourse provides a mock function with given fields: courseID, userID, revision
+func (_m *CourseService) RollbackCourse(courseID uuid.UUID, userID string, revision int) (dto.CourseDTO, error) {
+	ret := _m.Called(courseID, userID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackCourse")
+	}
+
+	var r0 dto.CourseDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int) (dto.CourseDTO, error)); ok {
+		return rf(courseID, userID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int) dto.CourseDTO); ok {
+		r0 = rf(courseID, userID, revision)
+	} else {
+		r0 = ret.Get(0).(dto.CourseDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int) error); ok {
+		r1 = rf(courseID, userID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_RollbackCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackCourse'
+type CourseService_RollbackCourse_Call struct {
+	*mock.Call
+}
+
+// RollbackCourse is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - revision int
+func (_e *CourseService_Expecter) RollbackCourse(courseID interface{}, userID interface{}, revision interface{}) *CourseService_RollbackCourse_Call {
+	return &CourseService_RollbackCourse_Call{Call: _e.mock.On("RollbackCourse", courseID, userID, revision)}
+}
+
+func (_c *CourseService_RollbackCourse_Call) Run(run func(courseID uuid.UUID, userID string, revision int)) *CourseService_RollbackCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *CourseService_RollbackCourse_Call) Return(_a0 dto.CourseDTO, _a1 error) *CourseService_RollbackCourse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_RollbackCourse_Call) RunAndReturn(run func(uuid.UUID, string, int) (dto.CourseDTO, error)) *CourseService_RollbackCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCourse provides a mock function with given fields: courseID, userID, input
+func (_m *CourseService) UpdateCourse(courseID uuid.UUID, userID string, input dto.UpdateCourseDTO) (dto.CourseDTO, error) {
+	ret := _m.Called(courseID, userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCourse")
+	}
+
+	var r0 dto.CourseDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.UpdateCourseDTO) (dto.CourseDTO, error)); ok {
+		return rf(courseID, userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.UpdateCourseDTO) dto.CourseDTO); ok {
+		r0 = rf(courseID, userID, input)
+	} else {
+		r0 = ret.Get(0).(dto.CourseDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.UpdateCourseDTO) error); ok {
+		r1 = rf(courseID, userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_UpdateCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCourse'
+type CourseService_UpdateCourse_Call struct {
+	*mock.Call
+}
+
+// UpdateCourse is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+//   - input dto.UpdateCourseDTO
+func (_e *CourseService_Expecter) UpdateCourse(courseID interface{}, userID interface{}, input interface{}) *CourseService_UpdateCourse_Call {
+	return &CourseService_UpdateCourse_Call{Call: _e.mock.On("UpdateCourse", courseID, userID, input)}
+}
+
+func (_c *CourseService_UpdateCourse_Call) Run(run func(courseID uuid.UUID, userID string, input dto.UpdateCourseDTO)) *CourseService_UpdateCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.UpdateCourseDTO))
+	})
+	return _c
+}
+
+func (_c *CourseService_UpdateCourse_Call) Return(_a0 dto.CourseDTO, _a1 error) *CourseService_UpdateCourse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseService_UpdateCourse_Call) RunAndReturn(run func(uuid.UUID, string, dto.UpdateCourseDTO) (dto.CourseDTO, error)) *CourseService_UpdateCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: id, input
+func (_m *CourseService) UpdateTag(id uuid.UUID, input dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error) {
+	ret := _m.Called(id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 dto.CourseTagsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error)); ok {
+		return rf(id, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.UpsertCourseTagDTO) dto.CourseTagsDTO); ok {
+		r0 = rf(id, input)
+	} else {
+		r0 = ret.Get(0).(dto.CourseTagsDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, dto.UpsertCourseTagDTO) error); ok {
+		r1 = rf(id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseService_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type CourseService_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - input dto.UpsertCourseTagDTO
+func (_e *CourseService_Expecter) UpdateTag(id interface{}, input interface{}) *CourseService_UpdateTag_Call {
+	return &CourseService_UpdateTag_Call{Call: _e.mock.On("UpdateTag", id, input)}
 }
 
-func (_c *CourseService_GetPaginatedCourses_Call) Run(run func(limit int, page int)) *CourseService_GetPaginatedCourses_Call {
+func (_c *CourseService_UpdateTag_Call) Run(run func(id uuid.UUID, input dto.UpsertCourseTagDTO)) *CourseService_UpdateTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(uuid.UUID), args[1].(dto.UpsertCourseTagDTO))
 	})
 	return _c
 }
 
-func (_c *CourseService_GetPaginatedCourses_Call) Return(_a0 []dto.CourseDTO, _a1 error) *CourseService_GetPaginatedCourses_Call {
+func (_c *CourseService_UpdateTag_Call) Return(_a0 dto.CourseTagsDTO, _a1 error) *CourseService_UpdateTag_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *CourseService_GetPaginatedCourses_Call) RunAndReturn(run func(int, int) ([]dto.CourseDTO, error)) *CourseService_GetPaginatedCourses_Call {
+func (_c *CourseService_UpdateTag_Call) RunAndReturn(run func(uuid.UUID, dto.UpsertCourseTagDTO) (dto.CourseTagsDTO, error)) *CourseService_UpdateTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"CodeWithAzri/internal/app/module/course/service"
+	"context"
+	"log"
+	"time"
+)
+
+// PublishWorker periodically publishes courses whose publish_at has passed.
+type PublishWorker struct {
+	service  service.CourseService
+	interval time.Duration
+}
+
+// NewPublishWorker creates a new PublishWorker instance.
+func NewPublishWorker(s service.CourseService, interval time.Duration) *PublishWorker {
+	w := new(PublishWorker)
+	w.service = s
+	w.interval = interval
+	return w
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *PublishWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce publishes every course that is due.
+func (w *PublishWorker) RunOnce() {
+	published, err := w.service.PublishScheduledCourses()
+	if err != nil {
+		log.Printf("failed to publish scheduled courses: %v\n", err)
+		return
+	}
+
+	if published > 0 {
+		log.Printf("published %d scheduled course(s)\n", published)
+	}
+}
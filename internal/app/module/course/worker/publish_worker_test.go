@@ -0,0 +1,47 @@
+package worker_test
+
+import (
+	"CodeWithAzri/internal/app/module/course/service/mocks"
+	"CodeWithAzri/internal/app/module/course/worker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishWorker_RunOnce(t *testing.T) {
+	mockService := mocks.NewCourseService(t)
+	publishWorker := worker.NewPublishWorker(mockService, time.Minute)
+
+	t.Run("Publish Scheduled Courses", func(t *testing.T) {
+		mockService.On("PublishScheduledCourses").Return(int64(2), nil).Once()
+
+		publishWorker.RunOnce()
+
+		mockService.AssertNumberOfCalls(t, "PublishScheduledCourses", 1)
+	})
+
+	t.Run("Publish Scheduled Courses Error", func(t *testing.T) {
+		mockService.On("PublishScheduledCourses").Return(int64(0), errors.New("Repository Failure")).Once()
+
+		assert.NotPanics(t, publishWorker.RunOnce)
+	})
+}
+
+func TestPublishWorker_Start(t *testing.T) {
+	mockService := mocks.NewCourseService(t)
+	publishWorker := worker.NewPublishWorker(mockService, time.Hour)
+
+	t.Run("Start Stops On Context Cancel", func(t *testing.T) {
+		mockService.On("PublishScheduledCourses").Return(int64(0), nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		publishWorker.Start(ctx)
+
+		mockService.AssertNumberOfCalls(t, "PublishScheduledCourses", 1)
+	})
+}
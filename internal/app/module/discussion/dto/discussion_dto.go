@@ -0,0 +1,57 @@
+package dto
+
+import "github.com/google/uuid"
+
+// CommentDTO is a comment on a lesson. Body is Markdown and has to be
+// sanitized by clients when rendered. Deleted comments, and comments hidden
+// by moderation, keep their place in the thread without their body or
+// author. InstructorAnswer marks comments
+// written by an instructor of the course, and Answered marks threads that
+// have one among their replies. Upvoted tells whether the signed in user
+// upvoted the comment.
+type CommentDTO struct {
+	ID               uuid.UUID    `json:"id"`
+	LessonID         uuid.UUID    `json:"lesson_id"`
+	ParentID         *uuid.UUID   `json:"parent_id,omitempty"`
+	UserID           string       `json:"user_id,omitempty"`
+	AuthorName       string       `json:"author_name,omitempty"`
+	AuthorPicture    string       `json:"author_picture,omitempty"`
+	Body             string       `json:"body"`
+	InstructorAnswer bool         `json:"instructor_answer"`
+	Answered         bool         `json:"answered,omitempty"`
+	Upvotes          int          `json:"upvotes"`
+	Upvoted          bool         `json:"upvoted"`
+	Edited           bool         `json:"edited"`
+	Deleted          bool         `json:"deleted"`
+	Hidden           bool         `json:"hidden"`
+	CreatedAt        int64        `json:"created_at"`
+	UpdatedAt        int64        `json:"updated_at"`
+	Replies          []CommentDTO `json:"replies,omitempty"`
+}
+
+// CommentChangeDTO is pushed to the clients following a lesson when one of
+// its comments changes. The body is left out so the message always fits in a
+// Postgres notification, and clients reload the thread to show it.
+type CommentChangeDTO struct {
+	ID               uuid.UUID  `json:"id"`
+	LessonID         uuid.UUID  `json:"lesson_id"`
+	ParentID         *uuid.UUID `json:"parent_id,omitempty"`
+	UserID           string     `json:"user_id,omitempty"`
+	InstructorAnswer bool       `json:"instructor_answer"`
+	Upvotes          int        `json:"upvotes"`
+	Edited           bool       `json:"edited"`
+	Deleted          bool       `json:"deleted"`
+	Hidden           bool       `json:"hidden"`
+	CreatedAt        int64      `json:"created_at"`
+	UpdatedAt        int64      `json:"updated_at"`
+}
+
+// CreateCommentDTO starts a thread, or replies to the comment ParentID.
+type CreateCommentDTO struct {
+	ParentID *uuid.UUID `json:"parent_id"`
+	Body     string     `json:"body" validate:"required,max=10000"`
+}
+
+type UpdateCommentDTO struct {
+	Body string `json:"body" validate:"required,max=10000"`
+}
@@ -0,0 +1,35 @@
+package entity
+
+import "github.com/google/uuid"
+
+// LessonComment is a comment on a lesson. A comment without a parent starts
+// a thread, and ThreadID is the ID of the comment that started it, so a
+// thread is read with one query however deep its replies go. Deleting a
+// comment only clears it and sets DeletedAt, so its replies keep their place.
+// HiddenAt is set while moderation keeps the comment out of sight.
+type LessonComment struct {
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+	LessonID         uuid.UUID  `json:"lesson_id" gorm:"type:uuid;not null;index:idx_lesson_comments_threads,priority:1"`
+	CourseID         uuid.UUID  `json:"course_id" gorm:"type:uuid;not null;index"`
+	ThreadID         uuid.UUID  `json:"thread_id" gorm:"type:uuid;not null;index"`
+	ParentID         *uuid.UUID `json:"parent_id" gorm:"type:uuid"`
+	UserID           string     `json:"user_id" gorm:"type:varchar(255);not null;index"`
+	Body             string     `json:"body" gorm:"type:text;not null"`
+	InstructorAnswer bool       `json:"instructor_answer" gorm:"not null;default:false"`
+	Upvotes          int        `json:"upvotes" gorm:"not null;default:0"`
+	AuthorName       string     `json:"author_name" gorm:"-"`
+	AuthorPicture    string     `json:"author_picture" gorm:"-"`
+	EditedAt         *int64     `json:"edited_at"`
+	DeletedAt        *int64     `json:"deleted_at"`
+	HiddenAt         *int64     `json:"hidden_at"`
+	CreatedAt        int64      `json:"created_at" gorm:"index:idx_lesson_comments_threads,priority:2"`
+	UpdatedAt        int64      `json:"updated_at"`
+}
+
+// LessonCommentVote records an upvote, so a user upvotes a comment at most
+// once.
+type LessonCommentVote struct {
+	CommentID uuid.UUID `json:"comment_id" gorm:"type:uuid;primaryKey"`
+	UserID    string    `json:"user_id" gorm:"type:varchar(255);primaryKey;index"`
+	CreatedAt int64     `json:"created_at"`
+}
@@ -0,0 +1,278 @@
+package handler
+
+import (
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/internal/app/module/discussion/dto"
+	"CodeWithAzri/internal/app/module/discussion/service"
+	moderationService "CodeWithAzri/internal/app/module/moderation/service"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	service  service.DiscussionService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.DiscussionService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// GetLessonComments godoc
+//
+//	@Summary		List the discussion of a lesson
+//	@Tags			Discussion
+//	@Description	Page through the threads of a lesson, newest first. Every thread holds all of its replies, nested under the comments they answer. Only instructors and enrolled users can read a discussion.
+//	@ID				get-lesson-comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Lesson ID"
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of threads per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.CommentDTO}	"Successful response with threads"
+//	@Failure		400	{object}	response.ResponseError						"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not enrolled in the course"
+//	@Failure		404	{object}	response.ResponseError						"Lesson not found"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/lessons/{id}/comments [get]
+func (h *Handler) GetLessonComments(w http.ResponseWriter, r *http.Request) {
+	lessonID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	limit, page := requestPkg.ParsePagination(r)
+	threads, err := h.service.GetThreads(lessonID, requestPkg.GetUserID(r), limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Comments Fetched Successfully", "Success", threads, w)
+}
+
+// CreateLessonComment godoc
+//
+//	@Summary		Comment on a lesson
+//	@Tags			Discussion
+//	@Description	Start a thread on a lesson, or reply to a comment of the same lesson by passing parent_id. The body is Markdown. Comments of the course's instructors are marked as instructor answers.
+//	@ID				create-lesson-comment
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string					true	"Lesson ID"
+//	@Param			input			body	dto.CreateCommentDTO	true	"Comment to post"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.CommentDTO}	"Successful response with the comment"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not enrolled in the course or banned"
+//	@Failure		404	{object}	response.ResponseError					"Lesson or parent comment not found"
+//	@Failure		422	{object}	response.ResponseError					"Body contains banned words"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/lessons/{id}/comments [post]
+func (h *Handler) CreateLessonComment(w http.ResponseWriter, r *http.Request) {
+	lessonID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.CreateCommentDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	comment, err := h.service.CreateComment(lessonID, requestPkg.GetUserID(r), d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Comment Created Successfully", "Success", comment, w)
+}
+
+// UpdateComment godoc
+//
+//	@Summary		Edit a comment
+//	@Tags			Discussion
+//	@Description	Replace the body of a comment of the signed in user. The comment is marked as edited.
+//	@ID				update-comment
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string					true	"Comment ID"
+//	@Param			input			body	dto.UpdateCommentDTO	true	"New body"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CommentDTO}	"Successful response with the comment"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not the author of the comment or banned"
+//	@Failure		404	{object}	response.ResponseError					"Comment not found"
+//	@Failure		422	{object}	response.ResponseError					"Body contains banned words"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/comments/{id} [put]
+func (h *Handler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	commentID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.UpdateCommentDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	comment, err := h.service.UpdateComment(commentID, requestPkg.GetUserID(r), d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Comment Updated Successfully", "Success", comment, w)
+}
+
+// DeleteComment godoc
+//
+//	@Summary		Delete a comment
+//	@Tags			Discussion
+//	@Description	Delete a comment of the signed in user. Replies to it stay in the thread, under a comment without body or author.
+//	@ID				delete-comment
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Comment ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not the author of the comment"
+//	@Failure		404	{object}	response.ResponseError	"Comment not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/comments/{id} [delete]
+func (h *Handler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	commentID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.DeleteComment(commentID, requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Comment Deleted Successfully", "Success", nil, w)
+}
+
+// UpvoteComment godoc
+//
+//	@Summary		Upvote a comment
+//	@Tags			Discussion
+//	@Description	Upvote a comment. Upvoting it again does not count twice.
+//	@ID				upvote-comment
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Comment ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CommentDTO}	"Successful response with the comment"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not enrolled in the course"
+//	@Failure		404	{object}	response.ResponseError					"Comment not found"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/comments/{id}/upvote [post]
+func (h *Handler) UpvoteComment(w http.ResponseWriter, r *http.Request) {
+	commentID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	comment, err := h.service.Upvote(commentID, requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Comment Upvoted Successfully", "Success", comment, w)
+}
+
+// RemoveCommentUpvote godoc
+//
+//	@Summary		Remove an upvote
+//	@Tags			Discussion
+//	@Description	Withdraw the upvote of the signed in user from a comment.
+//	@ID				remove-comment-upvote
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Comment ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.CommentDTO}	"Successful response with the comment"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not enrolled in the course"
+//	@Failure		404	{object}	response.ResponseError					"Comment not found"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/comments/{id}/upvote [delete]
+func (h *Handler) RemoveCommentUpvote(w http.ResponseWriter, r *http.Request) {
+	commentID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	comment, err := h.service.RemoveUpvote(commentID, requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Comment Upvote Removed Successfully", "Success", comment, w)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCommentNotFound), errors.Is(err, service.ErrParentNotFound),
+		errors.Is(err, courseService.ErrCourseNotFound), errors.Is(err, courseService.ErrLessonNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotCommentAuthor), errors.Is(err, courseService.ErrNotEnrolled),
+		errors.Is(err, moderationService.ErrUserBanned):
+		return http.StatusForbidden
+	case errors.Is(err, moderationService.ErrBannedWords):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,31 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/discussion/handler"
+	"CodeWithAzri/internal/app/module/discussion/service/mocks"
+	"CodeWithAzri/pkg/requestPkg"
+	"net/http"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/go-playground/validator/v10"
+)
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.DiscussionService) {
+	mockService := mocks.NewDiscussionService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
+
+func patchUserID(userID string) {
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return userID
+	})
+}
+
+func patchURLParam(id string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return id
+	})
+}
@@ -0,0 +1,279 @@
+package handler_test
+
+import (
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/internal/app/module/discussion/dto"
+	"CodeWithAzri/internal/app/module/discussion/service"
+	moderationService "CodeWithAzri/internal/app/module/moderation/service"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var MockLessonID uuid.UUID = uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+var MockCommentDTO dto.CommentDTO = dto.CommentDTO{
+	ID:         uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61"),
+	LessonID:   MockLessonID,
+	UserID:     "user123",
+	AuthorName: "Azri",
+	Body:       "Why does the loop variable change?",
+	Upvotes:    2,
+	CreatedAt:  121212,
+	UpdatedAt:  121212,
+}
+
+func TestHandler_GetLessonComments(t *testing.T) {
+	discussionHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Comments Successfully", func(t *testing.T) {
+		patchURLParam(MockLessonID.String())
+		mockService.On("GetThreads", MockLessonID, "user123", 5, 2).Return([]dto.CommentDTO{MockCommentDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/lessons/"+MockLessonID.String()+"/comments?page=2&limit=5", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.GetLessonComments(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"author_name":"Azri"`)
+	})
+
+	t.Run("Get Comments Invalid Lesson ID", func(t *testing.T) {
+		patchURLParam("invalid")
+
+		req, _ := http.NewRequest("GET", "/api/v1/lessons/invalid/comments", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.GetLessonComments(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Get Comments Not Enrolled", func(t *testing.T) {
+		patchURLParam(MockLessonID.String())
+		mockService.On("GetThreads", MockLessonID, "user123", 10, 1).Return(nil, courseService.ErrNotEnrolled).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/lessons/"+MockLessonID.String()+"/comments", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.GetLessonComments(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Get Comments Lesson Not Found", func(t *testing.T) {
+		patchURLParam(MockLessonID.String())
+		mockService.On("GetThreads", MockLessonID, "user123", 10, 1).Return(nil, courseService.ErrLessonNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/lessons/"+MockLessonID.String()+"/comments", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.GetLessonComments(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_CreateLessonComment(t *testing.T) {
+	discussionHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	patchURLParam(MockLessonID.String())
+	defer monkey.UnpatchAll()
+
+	t.Run("Create Comment Successfully", func(t *testing.T) {
+		mockService.On("CreateComment", MockLessonID, "user123", dto.CreateCommentDTO{Body: "Why?"}).Return(MockCommentDTO, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/lessons/"+MockLessonID.String()+"/comments", strings.NewReader(`{"body":"Why?"}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.CreateLessonComment(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	t.Run("Create Comment Without Body", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/lessons/"+MockLessonID.String()+"/comments", strings.NewReader(`{"body":""}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.CreateLessonComment(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Comment Invalid JSON", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/lessons/"+MockLessonID.String()+"/comments", strings.NewReader(`{`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.CreateLessonComment(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Comment With Banned Words", func(t *testing.T) {
+		mockService.On("CreateComment", MockLessonID, "user123", dto.CreateCommentDTO{Body: "Why?"}).
+			Return(dto.CommentDTO{}, moderationService.ErrBannedWords).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/lessons/"+MockLessonID.String()+"/comments", strings.NewReader(`{"body":"Why?"}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.CreateLessonComment(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+
+	t.Run("Create Comment As Banned User", func(t *testing.T) {
+		mockService.On("CreateComment", MockLessonID, "user123", dto.CreateCommentDTO{Body: "Why?"}).
+			Return(dto.CommentDTO{}, moderationService.ErrUserBanned).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/lessons/"+MockLessonID.String()+"/comments", strings.NewReader(`{"body":"Why?"}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.CreateLessonComment(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Create Reply Parent Not Found", func(t *testing.T) {
+		mockService.On("CreateComment", MockLessonID, "user123", dto.CreateCommentDTO{ParentID: &MockCommentDTO.ID, Body: "Hi"}).
+			Return(dto.CommentDTO{}, service.ErrParentNotFound).Once()
+
+		body := `{"parent_id":"` + MockCommentDTO.ID.String() + `","body":"Hi"}`
+		req, _ := http.NewRequest("POST", "/api/v1/lessons/"+MockLessonID.String()+"/comments", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		discussionHandler.CreateLessonComment(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_UpdateComment(t *testing.T) {
+	discussionHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	patchURLParam(MockCommentDTO.ID.String())
+	defer monkey.UnpatchAll()
+
+	t.Run("Update Comment Successfully", func(t *testing.T) {
+		mockService.On("UpdateComment", MockCommentDTO.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"}).Return(MockCommentDTO, nil).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/comments/"+MockCommentDTO.ID.String(), strings.NewReader(`{"body":"Edited"}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.UpdateComment(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Update Comment Of Someone Else", func(t *testing.T) {
+		mockService.On("UpdateComment", MockCommentDTO.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"}).
+			Return(dto.CommentDTO{}, service.ErrNotCommentAuthor).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/comments/"+MockCommentDTO.ID.String(), strings.NewReader(`{"body":"Edited"}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.UpdateComment(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Update Comment Without Body", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/api/v1/comments/"+MockCommentDTO.ID.String(), strings.NewReader(`{}`))
+		recorder := httptest.NewRecorder()
+		discussionHandler.UpdateComment(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_DeleteComment(t *testing.T) {
+	discussionHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Delete Comment Successfully", func(t *testing.T) {
+		patchURLParam(MockCommentDTO.ID.String())
+		mockService.On("DeleteComment", MockCommentDTO.ID, "user123").Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/comments/"+MockCommentDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.DeleteComment(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Delete Comment Not Found", func(t *testing.T) {
+		patchURLParam(MockCommentDTO.ID.String())
+		mockService.On("DeleteComment", MockCommentDTO.ID, "user123").Return(service.ErrCommentNotFound).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/comments/"+MockCommentDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.DeleteComment(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Delete Comment Invalid ID", func(t *testing.T) {
+		patchURLParam("invalid")
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/comments/invalid", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.DeleteComment(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_UpvoteComment(t *testing.T) {
+	discussionHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	patchURLParam(MockCommentDTO.ID.String())
+	defer monkey.UnpatchAll()
+
+	t.Run("Upvote Comment Successfully", func(t *testing.T) {
+		upvoted := MockCommentDTO
+		upvoted.Upvotes = 3
+		upvoted.Upvoted = true
+		mockService.On("Upvote", MockCommentDTO.ID, "user123").Return(upvoted, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/comments/"+MockCommentDTO.ID.String()+"/upvote", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.UpvoteComment(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"upvotes":3`)
+	})
+
+	t.Run("Upvote Comment Error", func(t *testing.T) {
+		mockService.On("Upvote", MockCommentDTO.ID, "user123").Return(dto.CommentDTO{}, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/comments/"+MockCommentDTO.ID.String()+"/upvote", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.UpvoteComment(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_RemoveCommentUpvote(t *testing.T) {
+	discussionHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	patchURLParam(MockCommentDTO.ID.String())
+	defer monkey.UnpatchAll()
+
+	t.Run("Remove Upvote Successfully", func(t *testing.T) {
+		mockService.On("RemoveUpvote", MockCommentDTO.ID, "user123").Return(MockCommentDTO, nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/comments/"+MockCommentDTO.ID.String()+"/upvote", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.RemoveCommentUpvote(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Remove Upvote Not Enrolled", func(t *testing.T) {
+		mockService.On("RemoveUpvote", MockCommentDTO.ID, "user123").Return(dto.CommentDTO{}, courseService.ErrNotEnrolled).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/comments/"+MockCommentDTO.ID.String()+"/upvote", nil)
+		recorder := httptest.NewRecorder()
+		discussionHandler.RemoveCommentUpvote(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
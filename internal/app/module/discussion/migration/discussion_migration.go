@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type DiscussionMigration struct{}
+
+func (m DiscussionMigration) CreateDiscussionTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.LessonComment{},
+		entity.LessonCommentVote{},
+	)
+}
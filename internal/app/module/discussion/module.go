@@ -0,0 +1,27 @@
+package discussion
+
+import (
+	"CodeWithAzri/internal/app/module/discussion/handler"
+	"CodeWithAzri/internal/app/module/discussion/migration"
+	"CodeWithAzri/internal/app/module/discussion/repository"
+	"CodeWithAzri/internal/app/module/discussion/service"
+	"database/sql"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.DiscussionService
+	Repository repository.DiscussionRepository
+	Migration  *migration.DiscussionMigration
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate, lessons service.LessonReader, moderator service.Moderator, publisher service.Publisher) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewDiscussionService(m.Repository, lessons, moderator, publisher)
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.DiscussionMigration{}
+	return m
+}
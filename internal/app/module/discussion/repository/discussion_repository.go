@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	eventRepository "CodeWithAzri/internal/app/module/event/repository"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// commentColumns reads a comment along with the name and picture of its
+// author.
+const commentColumns = `
+	c.id, c.lesson_id, c.course_id, c.thread_id, c.parent_id, c.user_id, c.body, c.instructor_answer,
+	c.upvotes, COALESCE(u.name, ''), COALESCE(u.profile_picture, ''), c.edited_at, c.deleted_at, c.hidden_at, c.created_at, c.updated_at
+`
+
+type DiscussionRepository interface {
+	CreateComment(comment entity.LessonComment, events ...eventEntity.Event) error
+	ReadComment(id uuid.UUID) (entity.LessonComment, error)
+	ReadThreads(lessonID uuid.UUID, limit int, offset int) ([]entity.LessonComment, error)
+	ReadReplies(threadIDs []uuid.UUID) ([]entity.LessonComment, error)
+	ReadUpvoted(commentIDs []uuid.UUID, userID string) ([]uuid.UUID, error)
+	UpdateBody(id uuid.UUID, body string, editedAt int64) (bool, error)
+	SoftDelete(id uuid.UUID, deletedAt int64) (bool, error)
+	Upvote(id uuid.UUID, userID string, createdAt int64) (int, error)
+	RemoveUpvote(id uuid.UUID, userID string) (int, error)
+	ReadUserComments(userID string) ([]entity.LessonComment, error)
+	EraseUserData(userID string) error
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) DiscussionRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+// CreateComment stores a comment and appends events to the outbox in the
+// same transaction.
+func (r *Repository) CreateComment(comment entity.LessonComment, events ...eventEntity.Event) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		INSERT INTO lesson_comments (id, lesson_id, course_id, thread_id, parent_id, user_id, body, instructor_answer, upvotes, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	_, err = tx.Exec(query, comment.ID, comment.LessonID, comment.CourseID, comment.ThreadID, comment.ParentID, comment.UserID,
+		comment.Body, comment.InstructorAnswer, comment.Upvotes, comment.CreatedAt, comment.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %v", err)
+	}
+
+	err = eventRepository.Append(tx, events...)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// ReadComment returns a zero value when there is no such comment. Deleted
+// comments are returned, as they still hold their thread together.
+func (r *Repository) ReadComment(id uuid.UUID) (entity.LessonComment, error) {
+	query := "SELECT " + commentColumns + " FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id WHERE c.id = $1"
+
+	comment, err := scanComment(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.LessonComment{}, nil
+	}
+	if err != nil {
+		return entity.LessonComment{}, fmt.Errorf("failed to read comment: %v", err)
+	}
+
+	return comment, nil
+}
+
+// ReadThreads pages through the comments that start the threads of a lesson,
+// newest first.
+func (r *Repository) ReadThreads(lessonID uuid.UUID, limit int, offset int) ([]entity.LessonComment, error) {
+	query := "SELECT " + commentColumns + `
+		FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id
+		WHERE c.lesson_id = $1 AND c.parent_id IS NULL
+		ORDER BY c.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(query, lessonID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read threads: %v", err)
+	}
+
+	return scanComments(rows)
+}
+
+// ReadReplies returns every reply in the given threads, oldest first.
+func (r *Repository) ReadReplies(threadIDs []uuid.UUID) ([]entity.LessonComment, error) {
+	query := "SELECT " + commentColumns + `
+		FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id
+		WHERE c.thread_id = ANY($1::uuid[]) AND c.parent_id IS NOT NULL
+		ORDER BY c.created_at
+	`
+
+	rows, err := r.db.Query(query, pq.Array(threadIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replies: %v", err)
+	}
+
+	return scanComments(rows)
+}
+
+// ReadUpvoted returns which of the comments userID upvoted.
+func (r *Repository) ReadUpvoted(commentIDs []uuid.UUID, userID string) ([]uuid.UUID, error) {
+	query := "SELECT comment_id FROM lesson_comment_votes WHERE comment_id = ANY($1::uuid[]) AND user_id = $2"
+
+	rows, err := r.db.Query(query, pq.Array(commentIDs), userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upvotes: %v", err)
+	}
+	defer rows.Close()
+
+	upvoted := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var commentID uuid.UUID
+		err = rows.Scan(&commentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan upvote: %v", err)
+		}
+		upvoted = append(upvoted, commentID)
+	}
+
+	return upvoted, nil
+}
+
+// UpdateBody reports false when the comment is gone or was deleted.
+func (r *Repository) UpdateBody(id uuid.UUID, body string, editedAt int64) (bool, error) {
+	query := "UPDATE lesson_comments SET body = $1, edited_at = $2, updated_at = $2 WHERE id = $3 AND deleted_at IS NULL"
+
+	result, err := r.db.Exec(query, body, editedAt, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to update comment: %v", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update comment: %v", err)
+	}
+
+	return updated > 0, nil
+}
+
+// SoftDelete clears the body of a comment but keeps the row, so replies to it
+// stay in their thread. It reports false when the comment is gone or was
+// already deleted.
+func (r *Repository) SoftDelete(id uuid.UUID, deletedAt int64) (bool, error) {
+	query := "UPDATE lesson_comments SET body = '', deleted_at = $1, updated_at = $1 WHERE id = $2 AND deleted_at IS NULL"
+
+	result, err := r.db.Exec(query, deletedAt, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete comment: %v", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete comment: %v", err)
+	}
+
+	return deleted > 0, nil
+}
+
+// Upvote records the vote of userID and returns the new upvote count. Voting
+// twice counts once. The vote and the count change in one statement, so they
+// never disagree.
+func (r *Repository) Upvote(id uuid.UUID, userID string, createdAt int64) (int, error) {
+	query := `
+		WITH vote AS (
+			INSERT INTO lesson_comment_votes (comment_id, user_id, created_at) VALUES ($1, $2, $3)
+			ON CONFLICT (comment_id, user_id) DO NOTHING
+			RETURNING comment_id
+		)
+		UPDATE lesson_comments SET upvotes = upvotes + (SELECT COUNT(*) FROM vote)
+		WHERE id = $1
+		RETURNING upvotes
+	`
+
+	var upvotes int
+	err := r.db.QueryRow(query, id, userID, createdAt).Scan(&upvotes)
+	if err != nil {
+		return 0, fmt.Errorf("failed to upvote comment: %v", err)
+	}
+
+	return upvotes, nil
+}
+
+// RemoveUpvote withdraws the vote of userID, if any, and returns the new
+// upvote count.
+func (r *Repository) RemoveUpvote(id uuid.UUID, userID string) (int, error) {
+	query := `
+		WITH vote AS (
+			DELETE FROM lesson_comment_votes WHERE comment_id = $1 AND user_id = $2
+			RETURNING comment_id
+		)
+		UPDATE lesson_comments SET upvotes = upvotes - (SELECT COUNT(*) FROM vote)
+		WHERE id = $1
+		RETURNING upvotes
+	`
+
+	var upvotes int
+	err := r.db.QueryRow(query, id, userID).Scan(&upvotes)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove upvote: %v", err)
+	}
+
+	return upvotes, nil
+}
+
+// ReadUserComments returns every comment userID wrote, oldest first.
+func (r *Repository) ReadUserComments(userID string) ([]entity.LessonComment, error) {
+	query := "SELECT " + commentColumns + `
+		FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id
+		WHERE c.user_id = $1
+		ORDER BY c.created_at
+	`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read comments: %v", err)
+	}
+
+	return scanComments(rows)
+}
+
+// EraseUserData withdraws the upvotes of userID and detaches their comments
+// from them. The comments stay, so the threads they belong to still read.
+func (r *Repository) EraseUserData(userID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		WITH vote AS (
+			DELETE FROM lesson_comment_votes WHERE user_id = $1
+			RETURNING comment_id
+		)
+		UPDATE lesson_comments SET upvotes = upvotes - 1
+		WHERE id IN (SELECT comment_id FROM vote)
+	`
+	_, err = tx.Exec(query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to erase upvotes: %v", err)
+	}
+
+	_, err = tx.Exec("UPDATE lesson_comments SET user_id = '' WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to anonymise comments: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanComment(row rowScanner) (entity.LessonComment, error) {
+	var comment entity.LessonComment
+	err := row.Scan(&comment.ID, &comment.LessonID, &comment.CourseID, &comment.ThreadID, &comment.ParentID, &comment.UserID,
+		&comment.Body, &comment.InstructorAnswer, &comment.Upvotes, &comment.AuthorName, &comment.AuthorPicture,
+		&comment.EditedAt, &comment.DeletedAt, &comment.HiddenAt, &comment.CreatedAt, &comment.UpdatedAt)
+	return comment, err
+}
+
+func scanComments(rows *sql.Rows) ([]entity.LessonComment, error) {
+	defer rows.Close()
+
+	comments := make([]entity.LessonComment, 0)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %v", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	return comments, nil
+}
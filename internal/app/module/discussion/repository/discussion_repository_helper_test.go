@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	"CodeWithAzri/internal/app/module/discussion/repository"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	commentColumns         = "c.id, c.lesson_id, c.course_id, c.thread_id, c.parent_id, c.user_id, c.body, c.instructor_answer, c.upvotes, COALESCE(u.name, ''), COALESCE(u.profile_picture, ''), c.edited_at, c.deleted_at, c.hidden_at, c.created_at, c.updated_at"
+	createCommentQuery     = "INSERT INTO lesson_comments (id, lesson_id, course_id, thread_id, parent_id, user_id, body, instructor_answer, upvotes, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"
+	appendEventQuery       = "INSERT INTO outbox_events (id, type, aggregate_id, payload, created_at) VALUES ($1, $2, $3, $4, $5)"
+	readCommentQuery       = "SELECT " + commentColumns + " FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id WHERE c.id = $1"
+	readThreadsQuery       = "SELECT " + commentColumns + " FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id WHERE c.lesson_id = $1 AND c.parent_id IS NULL ORDER BY c.created_at DESC LIMIT $2 OFFSET $3"
+	readRepliesQuery       = "SELECT " + commentColumns + " FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id WHERE c.thread_id = ANY($1::uuid[]) AND c.parent_id IS NOT NULL ORDER BY c.created_at"
+	readUpvotedQuery       = "SELECT comment_id FROM lesson_comment_votes WHERE comment_id = ANY($1::uuid[]) AND user_id = $2"
+	updateBodyQuery        = "UPDATE lesson_comments SET body = $1, edited_at = $2, updated_at = $2 WHERE id = $3 AND deleted_at IS NULL"
+	softDeleteQuery        = "UPDATE lesson_comments SET body = '', deleted_at = $1, updated_at = $1 WHERE id = $2 AND deleted_at IS NULL"
+	upvoteQuery            = "WITH vote AS ( INSERT INTO lesson_comment_votes (comment_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT (comment_id, user_id) DO NOTHING RETURNING comment_id ) UPDATE lesson_comments SET upvotes = upvotes + (SELECT COUNT(*) FROM vote) WHERE id = $1 RETURNING upvotes"
+	removeUpvoteQuery      = "WITH vote AS ( DELETE FROM lesson_comment_votes WHERE comment_id = $1 AND user_id = $2 RETURNING comment_id ) UPDATE lesson_comments SET upvotes = upvotes - (SELECT COUNT(*) FROM vote) WHERE id = $1 RETURNING upvotes"
+	readUserCommentsQuery  = "SELECT " + commentColumns + " FROM lesson_comments c LEFT JOIN users u ON u.id = c.user_id WHERE c.user_id = $1 ORDER BY c.created_at"
+	eraseUpvotesQuery      = "WITH vote AS ( DELETE FROM lesson_comment_votes WHERE user_id = $1 RETURNING comment_id ) UPDATE lesson_comments SET upvotes = upvotes - 1 WHERE id IN (SELECT comment_id FROM vote)"
+	anonymiseCommentsQuery = "UPDATE lesson_comments SET user_id = '' WHERE user_id = $1"
+)
+
+var MockComment entity.LessonComment = entity.LessonComment{
+	ID:            uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61"),
+	LessonID:      uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80"),
+	CourseID:      uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+	ThreadID:      uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61"),
+	UserID:        "user123",
+	Body:          "Why does the **loop** variable change?",
+	Upvotes:       2,
+	AuthorName:    "Azri",
+	AuthorPicture: "https://example.com/azri.png",
+	CreatedAt:     121212,
+	UpdatedAt:     121212,
+}
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.DiscussionRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	repo := repository.NewRepository(db)
+	return db, mock, repo
+}
+
+func prepareCommentRows(comments ...entity.LessonComment) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "lesson_id", "course_id", "thread_id", "parent_id", "user_id", "body", "instructor_answer",
+		"upvotes", "name", "profile_picture", "edited_at", "deleted_at", "hidden_at", "created_at", "updated_at"})
+	for _, c := range comments {
+		rows.AddRow(c.ID, c.LessonID, c.CourseID, c.ThreadID, c.ParentID, c.UserID, c.Body, c.InstructorAnswer,
+			c.Upvotes, c.AuthorName, c.AuthorPicture, c.EditedAt, c.DeletedAt, c.HiddenAt, c.CreatedAt, c.UpdatedAt)
+	}
+	return rows
+}
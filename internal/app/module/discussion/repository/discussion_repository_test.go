@@ -0,0 +1,380 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_CreateComment(t *testing.T) {
+	event := eventEntity.Event{
+		ID:          uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"),
+		Type:        "comment.created",
+		AggregateID: MockComment.ID.String(),
+		Payload:     `{"comment_id":"5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61"}`,
+		CreatedAt:   121212,
+	}
+
+	expectCreate := func(mock sqlmock.Sqlmock) *sqlmock.ExpectedExec {
+		c := MockComment
+		return mock.ExpectExec(createCommentQuery).WithArgs(c.ID, c.LessonID, c.CourseID, c.ThreadID, c.ParentID, c.UserID,
+			c.Body, c.InstructorAnswer, c.Upvotes, c.CreatedAt, c.UpdatedAt)
+	}
+
+	t.Run("Create Comment Success", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(appendEventQuery).WithArgs(event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.CreateComment(MockComment, event))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Comment Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.CreateComment(MockComment, event), "failed to create comment: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Comment Append Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(appendEventQuery).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.CreateComment(MockComment, event), "failed to append event: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Comment Commit Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit().WillReturnError(errors.New("commit failed"))
+
+		assert.EqualError(t, repo.CreateComment(MockComment), "failed to commit transaction: commit failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReadComment(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Comment Success", func(t *testing.T) {
+		mock.ExpectQuery(readCommentQuery).WithArgs(MockComment.ID).WillReturnRows(prepareCommentRows(MockComment))
+
+		comment, err := repo.ReadComment(MockComment.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockComment, comment)
+	})
+
+	t.Run("Read Comment Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readCommentQuery).WithArgs(MockComment.ID).WillReturnError(sql.ErrNoRows)
+
+		comment, err := repo.ReadComment(MockComment.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.LessonComment{}, comment)
+	})
+
+	t.Run("Read Comment Error", func(t *testing.T) {
+		mock.ExpectQuery(readCommentQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadComment(MockComment.ID)
+
+		assert.EqualError(t, err, "failed to read comment: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadThreads(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Threads Success", func(t *testing.T) {
+		mock.ExpectQuery(readThreadsQuery).WithArgs(MockComment.LessonID, 10, 20).WillReturnRows(prepareCommentRows(MockComment))
+
+		threads, err := repo.ReadThreads(MockComment.LessonID, 10, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.LessonComment{MockComment}, threads)
+	})
+
+	t.Run("Read Threads Error", func(t *testing.T) {
+		mock.ExpectQuery(readThreadsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadThreads(MockComment.LessonID, 10, 0)
+
+		assert.EqualError(t, err, "failed to read threads: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadReplies(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	deletedAt := int64(131313)
+	reply := MockComment
+	reply.ID = uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b62")
+	reply.ParentID = &MockComment.ID
+	reply.Body = ""
+	reply.DeletedAt = &deletedAt
+
+	t.Run("Read Replies Success", func(t *testing.T) {
+		mock.ExpectQuery(readRepliesQuery).WithArgs(pq.Array([]uuid.UUID{MockComment.ID})).WillReturnRows(prepareCommentRows(reply))
+
+		replies, err := repo.ReadReplies([]uuid.UUID{MockComment.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.LessonComment{reply}, replies)
+	})
+
+	t.Run("Read Replies Error", func(t *testing.T) {
+		mock.ExpectQuery(readRepliesQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadReplies([]uuid.UUID{MockComment.ID})
+
+		assert.EqualError(t, err, "failed to read replies: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadUpvoted(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Upvoted Success", func(t *testing.T) {
+		mock.ExpectQuery(readUpvotedQuery).WithArgs(pq.Array([]uuid.UUID{MockComment.ID}), "user456").
+			WillReturnRows(sqlmock.NewRows([]string{"comment_id"}).AddRow(MockComment.ID))
+
+		upvoted, err := repo.ReadUpvoted([]uuid.UUID{MockComment.ID}, "user456")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{MockComment.ID}, upvoted)
+	})
+
+	t.Run("Read Upvoted Error", func(t *testing.T) {
+		mock.ExpectQuery(readUpvotedQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadUpvoted([]uuid.UUID{MockComment.ID}, "user456")
+
+		assert.EqualError(t, err, "failed to read upvotes: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateBody(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Update Body Success", func(t *testing.T) {
+		mock.ExpectExec(updateBodyQuery).WithArgs("Edited", int64(131313), MockComment.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		updated, err := repo.UpdateBody(MockComment.ID, "Edited", 131313)
+
+		assert.NoError(t, err)
+		assert.True(t, updated)
+	})
+
+	t.Run("Update Body Of Deleted Comment", func(t *testing.T) {
+		mock.ExpectExec(updateBodyQuery).WithArgs("Edited", int64(131313), MockComment.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		updated, err := repo.UpdateBody(MockComment.ID, "Edited", 131313)
+
+		assert.NoError(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("Update Body Error", func(t *testing.T) {
+		mock.ExpectExec(updateBodyQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.UpdateBody(MockComment.ID, "Edited", 131313)
+
+		assert.EqualError(t, err, "failed to update comment: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SoftDelete(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Soft Delete Success", func(t *testing.T) {
+		mock.ExpectExec(softDeleteQuery).WithArgs(int64(131313), MockComment.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		deleted, err := repo.SoftDelete(MockComment.ID, 131313)
+
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("Soft Delete Already Deleted", func(t *testing.T) {
+		mock.ExpectExec(softDeleteQuery).WithArgs(int64(131313), MockComment.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		deleted, err := repo.SoftDelete(MockComment.ID, 131313)
+
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("Soft Delete Error", func(t *testing.T) {
+		mock.ExpectExec(softDeleteQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.SoftDelete(MockComment.ID, 131313)
+
+		assert.EqualError(t, err, "failed to delete comment: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Upvote(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Upvote Success", func(t *testing.T) {
+		mock.ExpectQuery(upvoteQuery).WithArgs(MockComment.ID, "user456", int64(131313)).
+			WillReturnRows(sqlmock.NewRows([]string{"upvotes"}).AddRow(3))
+
+		upvotes, err := repo.Upvote(MockComment.ID, "user456", 131313)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, upvotes)
+	})
+
+	t.Run("Upvote Error", func(t *testing.T) {
+		mock.ExpectQuery(upvoteQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.Upvote(MockComment.ID, "user456", 131313)
+
+		assert.EqualError(t, err, "failed to upvote comment: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RemoveUpvote(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Remove Upvote Success", func(t *testing.T) {
+		mock.ExpectQuery(removeUpvoteQuery).WithArgs(MockComment.ID, "user456").
+			WillReturnRows(sqlmock.NewRows([]string{"upvotes"}).AddRow(1))
+
+		upvotes, err := repo.RemoveUpvote(MockComment.ID, "user456")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, upvotes)
+	})
+
+	t.Run("Remove Upvote Error", func(t *testing.T) {
+		mock.ExpectQuery(removeUpvoteQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.RemoveUpvote(MockComment.ID, "user456")
+
+		assert.EqualError(t, err, "failed to remove upvote: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadUserComments(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read User Comments Success", func(t *testing.T) {
+		mock.ExpectQuery(readUserCommentsQuery).WithArgs(MockComment.UserID).WillReturnRows(prepareCommentRows(MockComment))
+
+		comments, err := repo.ReadUserComments(MockComment.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.LessonComment{MockComment}, comments)
+	})
+
+	t.Run("Read User Comments Error", func(t *testing.T) {
+		mock.ExpectQuery(readUserCommentsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadUserComments(MockComment.UserID)
+
+		assert.EqualError(t, err, "failed to read comments: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_EraseUserData(t *testing.T) {
+	t.Run("Erase User Data Success", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseUpvotesQuery).WithArgs("user456").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(anonymiseCommentsQuery).WithArgs("user456").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+
+		err := repo.EraseUserData("user456")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Erase Upvotes Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseUpvotesQuery).WillReturnError(errors.New("exec failed"))
+		mock.ExpectRollback()
+
+		err := repo.EraseUserData("user456")
+
+		assert.EqualError(t, err, "failed to erase upvotes: exec failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Anonymise Comments Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseUpvotesQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(anonymiseCommentsQuery).WillReturnError(errors.New("exec failed"))
+		mock.ExpectRollback()
+
+		err := repo.EraseUserData("user456")
+
+		assert.EqualError(t, err, "failed to anonymise comments: exec failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,667 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/discussion/entity"
+	evententity "CodeWithAzri/internal/app/module/event/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DiscussionRepository is an autogenerated mock type for the DiscussionRepository type
+type DiscussionRepository struct {
+	mock.Mock
+}
+
+type DiscussionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiscussionRepository) EXPECT() *DiscussionRepository_Expecter {
+	return &DiscussionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: comment, events
+func (_m *DiscussionRepository) CreateComment(comment entity.LessonComment, events ...evententity.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, comment)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.LessonComment, ...evententity.Event) error); ok {
+		r0 = rf(comment, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscussionRepository_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type DiscussionRepository_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - comment entity.LessonComment
+//   - events ...evententity.Event
+func (_e *DiscussionRepository_Expecter) CreateComment(comment interface{}, events ...interface{}) *DiscussionRepository_CreateComment_Call {
+	return &DiscussionRepository_CreateComment_Call{Call: _e.mock.On("CreateComment",
+		append([]interface{}{comment}, events...)...)}
+}
+
+func (_c *DiscussionRepository_CreateComment_Call) Run(run func(comment entity.LessonComment, events ...evententity.Event)) *DiscussionRepository_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]evententity.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(entity.LessonComment), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_CreateComment_Call) Return(_a0 error) *DiscussionRepository_CreateComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscussionRepository_CreateComment_Call) RunAndReturn(run func(entity.LessonComment, ...evententity.Event) error) *DiscussionRepository_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *DiscussionRepository) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscussionRepository_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type DiscussionRepository_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *DiscussionRepository_Expecter) EraseUserData(userID interface{}) *DiscussionRepository_EraseUserData_Call {
+	return &DiscussionRepository_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *DiscussionRepository_EraseUserData_Call) Run(run func(userID string)) *DiscussionRepository_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_EraseUserData_Call) Return(_a0 error) *DiscussionRepository_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscussionRepository_EraseUserData_Call) RunAndReturn(run func(string) error) *DiscussionRepository_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadComment provides a mock function with given fields: id
+func (_m *DiscussionRepository) ReadComment(id uuid.UUID) (entity.LessonComment, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadComment")
+	}
+
+	var r0 entity.LessonComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.LessonComment, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.LessonComment); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.LessonComment)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_ReadComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadComment'
+type DiscussionRepository_ReadComment_Call struct {
+	*mock.Call
+}
+
+// ReadComment is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *DiscussionRepository_Expecter) ReadComment(id interface{}) *DiscussionRepository_ReadComment_Call {
+	return &DiscussionRepository_ReadComment_Call{Call: _e.mock.On("ReadComment", id)}
+}
+
+func (_c *DiscussionRepository_ReadComment_Call) Run(run func(id uuid.UUID)) *DiscussionRepository_ReadComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadComment_Call) Return(_a0 entity.LessonComment, _a1 error) *DiscussionRepository_ReadComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadComment_Call) RunAndReturn(run func(uuid.UUID) (entity.LessonComment, error)) *DiscussionRepository_ReadComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadReplies provides a mock function with given fields: threadIDs
+func (_m *DiscussionRepository) ReadReplies(threadIDs []uuid.UUID) ([]entity.LessonComment, error) {
+	ret := _m.Called(threadIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadReplies")
+	}
+
+	var r0 []entity.LessonComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]entity.LessonComment, error)); ok {
+		return rf(threadIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []entity.LessonComment); ok {
+		r0 = rf(threadIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LessonComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(threadIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_ReadReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadReplies'
+type DiscussionRepository_ReadReplies_Call struct {
+	*mock.Call
+}
+
+// ReadReplies is a helper method to define mock.On call
+//   - threadIDs []uuid.UUID
+func (_e *DiscussionRepository_Expecter) ReadReplies(threadIDs interface{}) *DiscussionRepository_ReadReplies_Call {
+	return &DiscussionRepository_ReadReplies_Call{Call: _e.mock.On("ReadReplies", threadIDs)}
+}
+
+func (_c *DiscussionRepository_ReadReplies_Call) Run(run func(threadIDs []uuid.UUID)) *DiscussionRepository_ReadReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadReplies_Call) Return(_a0 []entity.LessonComment, _a1 error) *DiscussionRepository_ReadReplies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadReplies_Call) RunAndReturn(run func([]uuid.UUID) ([]entity.LessonComment, error)) *DiscussionRepository_ReadReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadThreads provides a mock function with given fields: lessonID, limit, offset
+func (_m *DiscussionRepository) ReadThreads(lessonID uuid.UUID, limit int, offset int) ([]entity.LessonComment, error) {
+	ret := _m.Called(lessonID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadThreads")
+	}
+
+	var r0 []entity.LessonComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) ([]entity.LessonComment, error)); ok {
+		return rf(lessonID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) []entity.LessonComment); ok {
+		r0 = rf(lessonID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LessonComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int) error); ok {
+		r1 = rf(lessonID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_ReadThreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadThreads'
+type DiscussionRepository_ReadThreads_Call struct {
+	*mock.Call
+}
+
+// ReadThreads is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *DiscussionRepository_Expecter) ReadThreads(lessonID interface{}, limit interface{}, offset interface{}) *DiscussionRepository_ReadThreads_Call {
+	return &DiscussionRepository_ReadThreads_Call{Call: _e.mock.On("ReadThreads", lessonID, limit, offset)}
+}
+
+func (_c *DiscussionRepository_ReadThreads_Call) Run(run func(lessonID uuid.UUID, limit int, offset int)) *DiscussionRepository_ReadThreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadThreads_Call) Return(_a0 []entity.LessonComment, _a1 error) *DiscussionRepository_ReadThreads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadThreads_Call) RunAndReturn(run func(uuid.UUID, int, int) ([]entity.LessonComment, error)) *DiscussionRepository_ReadThreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUpvoted provides a mock function with given fields: commentIDs, userID
+func (_m *DiscussionRepository) ReadUpvoted(commentIDs []uuid.UUID, userID string) ([]uuid.UUID, error) {
+	ret := _m.Called(commentIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUpvoted")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, string) ([]uuid.UUID, error)); ok {
+		return rf(commentIDs, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, string) []uuid.UUID); ok {
+		r0 = rf(commentIDs, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID, string) error); ok {
+		r1 = rf(commentIDs, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_ReadUpvoted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUpvoted'
+type DiscussionRepository_ReadUpvoted_Call struct {
+	*mock.Call
+}
+
+// ReadUpvoted is a helper method to define mock.On call
+//   - commentIDs []uuid.UUID
+//   - userID string
+func (_e *DiscussionRepository_Expecter) ReadUpvoted(commentIDs interface{}, userID interface{}) *DiscussionRepository_ReadUpvoted_Call {
+	return &DiscussionRepository_ReadUpvoted_Call{Call: _e.mock.On("ReadUpvoted", commentIDs, userID)}
+}
+
+func (_c *DiscussionRepository_ReadUpvoted_Call) Run(run func(commentIDs []uuid.UUID, userID string)) *DiscussionRepository_ReadUpvoted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadUpvoted_Call) Return(_a0 []uuid.UUID, _a1 error) *DiscussionRepository_ReadUpvoted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadUpvoted_Call) RunAndReturn(run func([]uuid.UUID, string) ([]uuid.UUID, error)) *DiscussionRepository_ReadUpvoted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserComments provides a mock function with given fields: userID
+func (_m *DiscussionRepository) ReadUserComments(userID string) ([]entity.LessonComment, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserComments")
+	}
+
+	var r0 []entity.LessonComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.LessonComment, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.LessonComment); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LessonComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_ReadUserComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserComments'
+type DiscussionRepository_ReadUserComments_Call struct {
+	*mock.Call
+}
+
+// ReadUserComments is a helper method to define mock.On call
+//   - userID string
+func (_e *DiscussionRepository_Expecter) ReadUserComments(userID interface{}) *DiscussionRepository_ReadUserComments_Call {
+	return &DiscussionRepository_ReadUserComments_Call{Call: _e.mock.On("ReadUserComments", userID)}
+}
+
+func (_c *DiscussionRepository_ReadUserComments_Call) Run(run func(userID string)) *DiscussionRepository_ReadUserComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadUserComments_Call) Return(_a0 []entity.LessonComment, _a1 error) *DiscussionRepository_ReadUserComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_ReadUserComments_Call) RunAndReturn(run func(string) ([]entity.LessonComment, error)) *DiscussionRepository_ReadUserComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUpvote provides a mock function with given fields: id, userID
+func (_m *DiscussionRepository) RemoveUpvote(id uuid.UUID, userID string) (int, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUpvote")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (int, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) int); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_RemoveUpvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUpvote'
+type DiscussionRepository_RemoveUpvote_Call struct {
+	*mock.Call
+}
+
+// RemoveUpvote is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - userID string
+func (_e *DiscussionRepository_Expecter) RemoveUpvote(id interface{}, userID interface{}) *DiscussionRepository_RemoveUpvote_Call {
+	return &DiscussionRepository_RemoveUpvote_Call{Call: _e.mock.On("RemoveUpvote", id, userID)}
+}
+
+func (_c *DiscussionRepository_RemoveUpvote_Call) Run(run func(id uuid.UUID, userID string)) *DiscussionRepository_RemoveUpvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_RemoveUpvote_Call) Return(_a0 int, _a1 error) *DiscussionRepository_RemoveUpvote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_RemoveUpvote_Call) RunAndReturn(run func(uuid.UUID, string) (int, error)) *DiscussionRepository_RemoveUpvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function with given fields: id, deletedAt
+func (_m *DiscussionRepository) SoftDelete(id uuid.UUID, deletedAt int64) (bool, error) {
+	ret := _m.Called(id, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64) (bool, error)); ok {
+		return rf(id, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64) bool); ok {
+		r0 = rf(id, deletedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int64) error); ok {
+		r1 = rf(id, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type DiscussionRepository_SoftDelete_Call struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - deletedAt int64
+func (_e *DiscussionRepository_Expecter) SoftDelete(id interface{}, deletedAt interface{}) *DiscussionRepository_SoftDelete_Call {
+	return &DiscussionRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", id, deletedAt)}
+}
+
+func (_c *DiscussionRepository_SoftDelete_Call) Run(run func(id uuid.UUID, deletedAt int64)) *DiscussionRepository_SoftDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_SoftDelete_Call) Return(_a0 bool, _a1 error) *DiscussionRepository_SoftDelete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_SoftDelete_Call) RunAndReturn(run func(uuid.UUID, int64) (bool, error)) *DiscussionRepository_SoftDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBody provides a mock function with given fields: id, body, editedAt
+func (_m *DiscussionRepository) UpdateBody(id uuid.UUID, body string, editedAt int64) (bool, error) {
+	ret := _m.Called(id, body, editedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBody")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64) (bool, error)); ok {
+		return rf(id, body, editedAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64) bool); ok {
+		r0 = rf(id, body, editedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int64) error); ok {
+		r1 = rf(id, body, editedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_UpdateBody_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBody'
+type DiscussionRepository_UpdateBody_Call struct {
+	*mock.Call
+}
+
+// UpdateBody is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - body string
+//   - editedAt int64
+func (_e *DiscussionRepository_Expecter) UpdateBody(id interface{}, body interface{}, editedAt interface{}) *DiscussionRepository_UpdateBody_Call {
+	return &DiscussionRepository_UpdateBody_Call{Call: _e.mock.On("UpdateBody", id, body, editedAt)}
+}
+
+func (_c *DiscussionRepository_UpdateBody_Call) Run(run func(id uuid.UUID, body string, editedAt int64)) *DiscussionRepository_UpdateBody_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_UpdateBody_Call) Return(_a0 bool, _a1 error) *DiscussionRepository_UpdateBody_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_UpdateBody_Call) RunAndReturn(run func(uuid.UUID, string, int64) (bool, error)) *DiscussionRepository_UpdateBody_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upvote provides a mock function with given fields: id, userID, createdAt
+func (_m *DiscussionRepository) Upvote(id uuid.UUID, userID string, createdAt int64) (int, error) {
+	ret := _m.Called(id, userID, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upvote")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64) (int, error)); ok {
+		return rf(id, userID, createdAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64) int); ok {
+		r0 = rf(id, userID, createdAt)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int64) error); ok {
+		r1 = rf(id, userID, createdAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionRepository_Upvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upvote'
+type DiscussionRepository_Upvote_Call struct {
+	*mock.Call
+}
+
+// Upvote is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - userID string
+//   - createdAt int64
+func (_e *DiscussionRepository_Expecter) Upvote(id interface{}, userID interface{}, createdAt interface{}) *DiscussionRepository_Upvote_Call {
+	return &DiscussionRepository_Upvote_Call{Call: _e.mock.On("Upvote", id, userID, createdAt)}
+}
+
+func (_c *DiscussionRepository_Upvote_Call) Run(run func(id uuid.UUID, userID string, createdAt int64)) *DiscussionRepository_Upvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DiscussionRepository_Upvote_Call) Return(_a0 int, _a1 error) *DiscussionRepository_Upvote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionRepository_Upvote_Call) RunAndReturn(run func(uuid.UUID, string, int64) (int, error)) *DiscussionRepository_Upvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDiscussionRepository creates a new instance of DiscussionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDiscussionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DiscussionRepository {
+	mock := &DiscussionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/discussion/dto"
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	"CodeWithAzri/internal/app/module/discussion/repository"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrParentNotFound   = errors.New("the comment to reply to was not found on this lesson")
+	ErrNotCommentAuthor = errors.New("you can only change your own comments")
+)
+
+type DiscussionService interface {
+	GetThreads(lessonID uuid.UUID, userID string, limit int, page int) ([]dto.CommentDTO, error)
+	CreateComment(lessonID uuid.UUID, userID string, input dto.CreateCommentDTO) (dto.CommentDTO, error)
+	UpdateComment(commentID uuid.UUID, userID string, input dto.UpdateCommentDTO) (dto.CommentDTO, error)
+	DeleteComment(commentID uuid.UUID, userID string) error
+	Upvote(commentID uuid.UUID, userID string) (dto.CommentDTO, error)
+	RemoveUpvote(commentID uuid.UUID, userID string) (dto.CommentDTO, error)
+	ExportUserData(userID string) (map[string]any, error)
+	EraseUserData(userID string) error
+}
+
+// LessonReader checks who may read and write the discussion of a lesson.
+type LessonReader interface {
+	GetLessonAccess(lessonID uuid.UUID, userID string) (courseDTO.LessonAccessDTO, error)
+}
+
+// Moderator keeps banned users and banned words out of discussions.
+type Moderator interface {
+	CheckContent(userID string, text string) error
+}
+
+// Publisher pushes changed comments to the clients following a lesson.
+type Publisher interface {
+	PublishToLesson(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any) error
+}
+
+type Service struct {
+	repository repository.DiscussionRepository
+	lessons    LessonReader
+	moderator  Moderator
+	publisher  Publisher
+}
+
+// NewDiscussionService creates the service behind lesson discussions. Only
+// users who may watch a lesson can read and write its discussion.
+func NewDiscussionService(r repository.DiscussionRepository, lessons LessonReader, moderator Moderator, publisher Publisher) DiscussionService {
+	s := new(Service)
+	s.repository = r
+	s.lessons = lessons
+	s.moderator = moderator
+	s.publisher = publisher
+	return s
+}
+
+// GetThreads pages through the threads of a lesson, newest first, each with
+// all of its replies nested under the comments they answer.
+func (s *Service) GetThreads(lessonID uuid.UUID, userID string, limit int, page int) ([]dto.CommentDTO, error) {
+	_, err := s.lessons.GetLessonAccess(lessonID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * limit
+	threads, err := s.repository.ReadThreads(lessonID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(threads) == 0 {
+		return []dto.CommentDTO{}, nil
+	}
+
+	threadIDs := make([]uuid.UUID, 0, len(threads))
+	for _, thread := range threads {
+		threadIDs = append(threadIDs, thread.ID)
+	}
+
+	replies, err := s.repository.ReadReplies(threadIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	commentIDs := make([]uuid.UUID, 0, len(threads)+len(replies))
+	commentIDs = append(commentIDs, threadIDs...)
+	for _, reply := range replies {
+		commentIDs = append(commentIDs, reply.ID)
+	}
+
+	upvotedIDs, err := s.repository.ReadUpvoted(commentIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upvoted := make(map[uuid.UUID]bool, len(upvotedIDs))
+	for _, id := range upvotedIDs {
+		upvoted[id] = true
+	}
+
+	return buildThreads(threads, replies, upvoted), nil
+}
+
+// CreateComment starts a thread, or replies to a comment on the same lesson.
+// Comments of instructors are marked as instructor answers.
+func (s *Service) CreateComment(lessonID uuid.UUID, userID string, input dto.CreateCommentDTO) (dto.CommentDTO, error) {
+	access, err := s.lessons.GetLessonAccess(lessonID, userID)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	err = s.moderator.CheckContent(userID, input.Body)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	comment := entity.LessonComment{
+		ID:               uuid.New(),
+		LessonID:         lessonID,
+		CourseID:         access.CourseID,
+		ParentID:         input.ParentID,
+		UserID:           userID,
+		Body:             input.Body,
+		InstructorAnswer: access.Instructor,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	comment.ThreadID = comment.ID
+
+	payload := eventDTO.CommentCreatedPayload{
+		CommentID:   comment.ID,
+		CourseID:    access.CourseID,
+		Language:    access.Language,
+		LessonID:    lessonID,
+		LessonTitle: access.LessonTitle,
+		UserID:      userID,
+	}
+
+	if input.ParentID != nil {
+		parent, err := s.repository.ReadComment(*input.ParentID)
+		if err != nil {
+			return dto.CommentDTO{}, err
+		}
+
+		if parent.ID == uuid.Nil || parent.LessonID != lessonID || parent.DeletedAt != nil || parent.HiddenAt != nil {
+			return dto.CommentDTO{}, ErrParentNotFound
+		}
+
+		comment.ThreadID = parent.ThreadID
+		payload.ParentID = &parent.ID
+		payload.ParentUserID = parent.UserID
+	}
+
+	created, err := eventService.NewEvent(event_type_enum.CommentCreated, comment.ID.String(), payload)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	err = s.repository.CreateComment(comment, created)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	// Read the comment back for the name and picture of its author.
+	comment, err = s.repository.ReadComment(comment.ID)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	commentDTO := toDTO(comment, false)
+	s.push(commentDTO)
+	return commentDTO, nil
+}
+
+// UpdateComment replaces the body of a comment of userID.
+func (s *Service) UpdateComment(commentID uuid.UUID, userID string, input dto.UpdateCommentDTO) (dto.CommentDTO, error) {
+	comment, err := s.readOwnComment(commentID, userID)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	err = s.moderator.CheckContent(userID, input.Body)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	editedAt := timepkg.NowUnixMilli()
+	updated, err := s.repository.UpdateBody(commentID, input.Body, editedAt)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	if !updated {
+		return dto.CommentDTO{}, ErrCommentNotFound
+	}
+
+	comment.Body = input.Body
+	comment.EditedAt = &editedAt
+	comment.UpdatedAt = editedAt
+
+	commentDTO := toDTO(comment, false)
+	s.push(commentDTO)
+	return commentDTO, nil
+}
+
+// DeleteComment soft deletes a comment of userID, so its replies stay in the
+// thread.
+func (s *Service) DeleteComment(commentID uuid.UUID, userID string) error {
+	comment, err := s.readOwnComment(commentID, userID)
+	if err != nil {
+		return err
+	}
+
+	deletedAt := timepkg.NowUnixMilli()
+	deleted, err := s.repository.SoftDelete(commentID, deletedAt)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return ErrCommentNotFound
+	}
+
+	comment.DeletedAt = &deletedAt
+	comment.UpdatedAt = deletedAt
+	s.push(toDTO(comment, false))
+	return nil
+}
+
+// Upvote counts the vote of userID once, however often they upvote.
+func (s *Service) Upvote(commentID uuid.UUID, userID string) (dto.CommentDTO, error) {
+	comment, err := s.readVisibleComment(commentID, userID)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	comment.Upvotes, err = s.repository.Upvote(commentID, userID, timepkg.NowUnixMilli())
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	s.push(toDTO(comment, false))
+	return toDTO(comment, true), nil
+}
+
+func (s *Service) RemoveUpvote(commentID uuid.UUID, userID string) (dto.CommentDTO, error) {
+	comment, err := s.readVisibleComment(commentID, userID)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	comment.Upvotes, err = s.repository.RemoveUpvote(commentID, userID)
+	if err != nil {
+		return dto.CommentDTO{}, err
+	}
+
+	commentDTO := toDTO(comment, false)
+	s.push(commentDTO)
+	return commentDTO, nil
+}
+
+// readVisibleComment reads a comment that is neither deleted nor hidden, on a
+// lesson userID may see.
+func (s *Service) readVisibleComment(commentID uuid.UUID, userID string) (entity.LessonComment, error) {
+	comment, err := s.repository.ReadComment(commentID)
+	if err != nil {
+		return entity.LessonComment{}, err
+	}
+
+	if comment.ID == uuid.Nil || comment.DeletedAt != nil || comment.HiddenAt != nil {
+		return entity.LessonComment{}, ErrCommentNotFound
+	}
+
+	_, err = s.lessons.GetLessonAccess(comment.LessonID, userID)
+	if err != nil {
+		return entity.LessonComment{}, err
+	}
+
+	return comment, nil
+}
+
+// readOwnComment is readVisibleComment for comments written by userID.
+func (s *Service) readOwnComment(commentID uuid.UUID, userID string) (entity.LessonComment, error) {
+	comment, err := s.readVisibleComment(commentID, userID)
+	if err != nil {
+		return entity.LessonComment{}, err
+	}
+
+	if comment.UserID != userID {
+		return entity.LessonComment{}, ErrNotCommentAuthor
+	}
+
+	return comment, nil
+}
+
+// push tells the clients following its lesson that a comment changed. They
+// can always reload the thread, so failures are only logged.
+func (s *Service) push(comment dto.CommentDTO) {
+	err := s.publisher.PublishToLesson(comment.LessonID, message_type_enum.Comment, dto.CommentChangeDTO{
+		ID:               comment.ID,
+		LessonID:         comment.LessonID,
+		ParentID:         comment.ParentID,
+		UserID:           comment.UserID,
+		InstructorAnswer: comment.InstructorAnswer,
+		Upvotes:          comment.Upvotes,
+		Edited:           comment.Edited,
+		Deleted:          comment.Deleted,
+		Hidden:           comment.Hidden,
+		CreatedAt:        comment.CreatedAt,
+		UpdatedAt:        comment.UpdatedAt,
+	})
+	if err != nil {
+		log.Printf("failed to push comment %s: %v\n", comment.ID, err)
+	}
+}
+
+// buildThreads nests replies under the comments they answer. replies are
+// ordered oldest first, so every level of a thread reads chronologically.
+func buildThreads(threads []entity.LessonComment, replies []entity.LessonComment, upvoted map[uuid.UUID]bool) []dto.CommentDTO {
+	children := make(map[uuid.UUID][]entity.LessonComment)
+	answered := make(map[uuid.UUID]bool)
+	for _, reply := range replies {
+		children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		if reply.InstructorAnswer && reply.DeletedAt == nil && reply.HiddenAt == nil {
+			answered[reply.ThreadID] = true
+		}
+	}
+
+	var nest func(comment entity.LessonComment) dto.CommentDTO
+	nest = func(comment entity.LessonComment) dto.CommentDTO {
+		commentDTO := toDTO(comment, upvoted[comment.ID])
+		for _, child := range children[comment.ID] {
+			commentDTO.Replies = append(commentDTO.Replies, nest(child))
+		}
+		return commentDTO
+	}
+
+	threadDTOs := make([]dto.CommentDTO, 0, len(threads))
+	for _, thread := range threads {
+		threadDTO := nest(thread)
+		threadDTO.Answered = answered[thread.ID]
+		threadDTOs = append(threadDTOs, threadDTO)
+	}
+	return threadDTOs
+}
+
+// ExportUserData returns every comment a user wrote for their data export,
+// including the ones they deleted or moderation hid.
+func (s *Service) ExportUserData(userID string) (map[string]any, error) {
+	comments, err := s.repository.ReadUserComments(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"comments": comments}, nil
+}
+
+// EraseUserData withdraws the upvotes of a user and removes their name from
+// their comments.
+func (s *Service) EraseUserData(userID string) error {
+	return s.repository.EraseUserData(userID)
+}
+
+// toDTO hides the body and author of deleted comments, and of comments hidden
+// by moderation.
+func toDTO(comment entity.LessonComment, upvoted bool) dto.CommentDTO {
+	commentDTO := dto.CommentDTO{
+		ID:               comment.ID,
+		LessonID:         comment.LessonID,
+		ParentID:         comment.ParentID,
+		UserID:           comment.UserID,
+		AuthorName:       comment.AuthorName,
+		AuthorPicture:    comment.AuthorPicture,
+		Body:             comment.Body,
+		InstructorAnswer: comment.InstructorAnswer,
+		Upvotes:          comment.Upvotes,
+		Upvoted:          upvoted,
+		Edited:           comment.EditedAt != nil,
+		CreatedAt:        comment.CreatedAt,
+		UpdatedAt:        comment.UpdatedAt,
+	}
+
+	if comment.DeletedAt != nil || comment.HiddenAt != nil {
+		commentDTO.UserID = ""
+		commentDTO.AuthorName = ""
+		commentDTO.AuthorPicture = ""
+		commentDTO.Body = ""
+		commentDTO.Deleted = comment.DeletedAt != nil
+		commentDTO.Hidden = comment.HiddenAt != nil
+	}
+
+	return commentDTO
+}
@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	repositoryMocks "CodeWithAzri/internal/app/module/discussion/repository/mocks"
+	"CodeWithAzri/internal/app/module/discussion/service"
+	"CodeWithAzri/internal/app/module/discussion/service/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var MockAccess courseDTO.LessonAccessDTO = courseDTO.LessonAccessDTO{
+	CourseID:    uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+	CourseName:  "Go for Beginners",
+	Language:    "en",
+	LessonID:    uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80"),
+	LessonTitle: "Goroutines",
+}
+
+var MockComment entity.LessonComment = entity.LessonComment{
+	ID:         uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61"),
+	LessonID:   MockAccess.LessonID,
+	CourseID:   MockAccess.CourseID,
+	ThreadID:   uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61"),
+	UserID:     "user123",
+	Body:       "Why does the **loop** variable change?",
+	Upvotes:    2,
+	AuthorName: "Azri",
+	CreatedAt:  121212,
+	UpdatedAt:  121212,
+}
+
+type discussionTest struct {
+	repository *repositoryMocks.DiscussionRepository
+	lessons    *mocks.LessonReader
+	moderator  *mocks.Moderator
+	publisher  *mocks.Publisher
+	service    service.DiscussionService
+}
+
+func initializeService(t *testing.T) discussionTest {
+	test := discussionTest{
+		repository: repositoryMocks.NewDiscussionRepository(t),
+		lessons:    mocks.NewLessonReader(t),
+		moderator:  mocks.NewModerator(t),
+		publisher:  mocks.NewPublisher(t),
+	}
+	test.service = service.NewDiscussionService(test.repository, test.lessons, test.moderator, test.publisher)
+	return test
+}
+
+// reply builds a reply to parent in the same thread.
+func reply(parent entity.LessonComment, id string, userID string, createdAt int64) entity.LessonComment {
+	comment := parent
+	comment.ID = uuid.MustParse(id)
+	comment.ParentID = &parent.ID
+	comment.UserID = userID
+	comment.InstructorAnswer = false
+	comment.Upvotes = 0
+	comment.CreatedAt = createdAt
+	comment.UpdatedAt = createdAt
+	return comment
+}
@@ -0,0 +1,511 @@
+package service_test
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/internal/app/module/discussion/dto"
+	"CodeWithAzri/internal/app/module/discussion/entity"
+	"CodeWithAzri/internal/app/module/discussion/service"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	moderationService "CodeWithAzri/internal/app/module/moderation/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetThreads(t *testing.T) {
+	first := reply(MockComment, "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b62", "instructor-uid", 131313)
+	first.InstructorAnswer = true
+	nested := reply(first, "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b63", "user123", 141414)
+	deletedAt := int64(151515)
+	deleted := reply(MockComment, "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b64", "user456", 151515)
+	deleted.Body = ""
+	deleted.DeletedAt = &deletedAt
+	unanswered := MockComment
+	unanswered.ID = uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b65")
+	unanswered.ThreadID = unanswered.ID
+
+	t.Run("Get Threads With Nested Replies", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("ReadThreads", MockAccess.LessonID, 10, 10).Return([]entity.LessonComment{MockComment, unanswered}, nil)
+		test.repository.On("ReadReplies", []uuid.UUID{MockComment.ID, unanswered.ID}).Return([]entity.LessonComment{first, nested, deleted}, nil)
+		test.repository.On("ReadUpvoted", []uuid.UUID{MockComment.ID, unanswered.ID, first.ID, nested.ID, deleted.ID}, "user456").
+			Return([]uuid.UUID{first.ID}, nil)
+
+		threads, err := test.service.GetThreads(MockAccess.LessonID, "user456", 10, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, threads, 2)
+		assert.True(t, threads[0].Answered)
+		assert.False(t, threads[1].Answered)
+		assert.Len(t, threads[0].Replies, 2)
+		assert.Equal(t, first.ID, threads[0].Replies[0].ID)
+		assert.True(t, threads[0].Replies[0].InstructorAnswer)
+		assert.True(t, threads[0].Replies[0].Upvoted)
+		assert.Equal(t, []dto.CommentDTO{{
+			ID:         nested.ID,
+			LessonID:   nested.LessonID,
+			ParentID:   &first.ID,
+			UserID:     "user123",
+			AuthorName: "Azri",
+			Body:       nested.Body,
+			CreatedAt:  141414,
+			UpdatedAt:  141414,
+		}}, threads[0].Replies[0].Replies)
+		assert.Equal(t, dto.CommentDTO{
+			ID:        deleted.ID,
+			LessonID:  deleted.LessonID,
+			ParentID:  &MockComment.ID,
+			Deleted:   true,
+			CreatedAt: 151515,
+			UpdatedAt: 151515,
+		}, threads[0].Replies[1])
+	})
+
+	t.Run("Hidden Answers Do Not Count", func(t *testing.T) {
+		test := initializeService(t)
+		hiddenAt := int64(161616)
+		hidden := first
+		hidden.HiddenAt = &hiddenAt
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("ReadThreads", MockAccess.LessonID, 10, 0).Return([]entity.LessonComment{MockComment}, nil)
+		test.repository.On("ReadReplies", []uuid.UUID{MockComment.ID}).Return([]entity.LessonComment{hidden}, nil)
+		test.repository.On("ReadUpvoted", []uuid.UUID{MockComment.ID, hidden.ID}, "user456").Return([]uuid.UUID{}, nil)
+
+		threads, err := test.service.GetThreads(MockAccess.LessonID, "user456", 10, 1)
+
+		assert.NoError(t, err)
+		assert.False(t, threads[0].Answered)
+		assert.True(t, threads[0].Replies[0].Hidden)
+		assert.False(t, threads[0].Replies[0].Deleted)
+		assert.Empty(t, threads[0].Replies[0].Body)
+		assert.Empty(t, threads[0].Replies[0].UserID)
+	})
+
+	t.Run("Get Threads Of A Quiet Lesson", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("ReadThreads", MockAccess.LessonID, 10, 0).Return([]entity.LessonComment{}, nil)
+
+		threads, err := test.service.GetThreads(MockAccess.LessonID, "user456", 10, 1)
+
+		assert.NoError(t, err)
+		assert.Empty(t, threads)
+	})
+
+	t.Run("Get Threads Not Enrolled", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(courseDTO.LessonAccessDTO{}, courseService.ErrNotEnrolled)
+
+		_, err := test.service.GetThreads(MockAccess.LessonID, "user456", 10, 1)
+
+		assert.ErrorIs(t, err, courseService.ErrNotEnrolled)
+	})
+
+	t.Run("Get Threads Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("ReadThreads", MockAccess.LessonID, 10, 0).Return([]entity.LessonComment{MockComment}, nil)
+		test.repository.On("ReadReplies", []uuid.UUID{MockComment.ID}).Return(nil, errors.New("Repository Failure"))
+
+		_, err := test.service.GetThreads(MockAccess.LessonID, "user456", 10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_CreateComment(t *testing.T) {
+	t.Run("Create Thread", func(t *testing.T) {
+		test := initializeService(t)
+		var created entity.LessonComment
+		var event eventEntity.Event
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user123", "Why?").Return(nil)
+		test.repository.On("CreateComment", mock.AnythingOfType("entity.LessonComment"), mock.AnythingOfType("entity.Event")).
+			Run(func(args mock.Arguments) {
+				created = args.Get(0).(entity.LessonComment)
+				event = args.Get(1).(eventEntity.Event)
+			}).Return(nil)
+		test.repository.On("ReadComment", mock.AnythingOfType("uuid.UUID")).
+			Return(func(id uuid.UUID) entity.LessonComment {
+				comment := created
+				comment.AuthorName = "Azri"
+				return comment
+			}, nil)
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.AnythingOfType("dto.CommentChangeDTO")).Return(nil)
+
+		comment, err := test.service.CreateComment(MockAccess.LessonID, "user123", dto.CreateCommentDTO{Body: "Why?"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, created.ThreadID)
+		assert.Equal(t, MockAccess.CourseID, created.CourseID)
+		assert.False(t, created.InstructorAnswer)
+		assert.Equal(t, "Azri", comment.AuthorName)
+		assert.Equal(t, "Why?", comment.Body)
+		assert.Equal(t, event_type_enum.CommentCreated, event.Type)
+
+		var payload eventDTO.CommentCreatedPayload
+		assert.NoError(t, json.Unmarshal([]byte(event.Payload), &payload))
+		assert.Equal(t, eventDTO.CommentCreatedPayload{
+			CommentID:   created.ID,
+			CourseID:    MockAccess.CourseID,
+			Language:    "en",
+			LessonID:    MockAccess.LessonID,
+			LessonTitle: "Goroutines",
+			UserID:      "user123",
+		}, payload)
+	})
+
+	t.Run("Instructor Replies", func(t *testing.T) {
+		test := initializeService(t)
+		var created entity.LessonComment
+		var event eventEntity.Event
+		access := MockAccess
+		access.Instructor = true
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "instructor-uid").Return(access, nil)
+		test.moderator.On("CheckContent", "instructor-uid", "Closures!").Return(nil)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil).Once()
+		test.repository.On("CreateComment", mock.AnythingOfType("entity.LessonComment"), mock.AnythingOfType("entity.Event")).
+			Run(func(args mock.Arguments) {
+				created = args.Get(0).(entity.LessonComment)
+				event = args.Get(1).(eventEntity.Event)
+			}).Return(nil)
+		test.repository.On("ReadComment", mock.AnythingOfType("uuid.UUID")).
+			Return(func(id uuid.UUID) entity.LessonComment { return created }, nil).Once()
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.AnythingOfType("dto.CommentChangeDTO")).
+			Return(errors.New("Repository Failure"))
+
+		comment, err := test.service.CreateComment(MockAccess.LessonID, "instructor-uid", dto.CreateCommentDTO{ParentID: &MockComment.ID, Body: "Closures!"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockComment.ThreadID, created.ThreadID)
+		assert.True(t, comment.InstructorAnswer)
+		assert.Equal(t, &MockComment.ID, comment.ParentID)
+
+		var payload eventDTO.CommentCreatedPayload
+		assert.NoError(t, json.Unmarshal([]byte(event.Payload), &payload))
+		assert.Equal(t, &MockComment.ID, payload.ParentID)
+		assert.Equal(t, "user123", payload.ParentUserID)
+	})
+
+	t.Run("Reply To A Comment Of Another Lesson", func(t *testing.T) {
+		test := initializeService(t)
+		other := MockComment
+		other.LessonID = uuid.New()
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user456", "Hi").Return(nil)
+		test.repository.On("ReadComment", MockComment.ID).Return(other, nil)
+
+		_, err := test.service.CreateComment(MockAccess.LessonID, "user456", dto.CreateCommentDTO{ParentID: &MockComment.ID, Body: "Hi"})
+
+		assert.ErrorIs(t, err, service.ErrParentNotFound)
+	})
+
+	t.Run("Reply To A Deleted Comment", func(t *testing.T) {
+		test := initializeService(t)
+		deletedAt := int64(131313)
+		deleted := MockComment
+		deleted.DeletedAt = &deletedAt
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user456", "Hi").Return(nil)
+		test.repository.On("ReadComment", MockComment.ID).Return(deleted, nil)
+
+		_, err := test.service.CreateComment(MockAccess.LessonID, "user456", dto.CreateCommentDTO{ParentID: &MockComment.ID, Body: "Hi"})
+
+		assert.ErrorIs(t, err, service.ErrParentNotFound)
+	})
+
+	t.Run("Create Comment Lesson Not Found", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(courseDTO.LessonAccessDTO{}, courseService.ErrLessonNotFound)
+
+		_, err := test.service.CreateComment(MockAccess.LessonID, "user456", dto.CreateCommentDTO{Body: "Hi"})
+
+		assert.ErrorIs(t, err, courseService.ErrLessonNotFound)
+	})
+
+	t.Run("Reply To A Hidden Comment", func(t *testing.T) {
+		test := initializeService(t)
+		hiddenAt := int64(131313)
+		hidden := MockComment
+		hidden.HiddenAt = &hiddenAt
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user456", "Hi").Return(nil)
+		test.repository.On("ReadComment", MockComment.ID).Return(hidden, nil)
+
+		_, err := test.service.CreateComment(MockAccess.LessonID, "user456", dto.CreateCommentDTO{ParentID: &MockComment.ID, Body: "Hi"})
+
+		assert.ErrorIs(t, err, service.ErrParentNotFound)
+	})
+
+	t.Run("Create Comment As Banned User", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user456", "Hi").Return(moderationService.ErrUserBanned)
+
+		_, err := test.service.CreateComment(MockAccess.LessonID, "user456", dto.CreateCommentDTO{Body: "Hi"})
+
+		assert.ErrorIs(t, err, moderationService.ErrUserBanned)
+	})
+
+	t.Run("Create Comment Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user456", "Hi").Return(nil)
+		test.repository.On("CreateComment", mock.AnythingOfType("entity.LessonComment"), mock.AnythingOfType("entity.Event")).
+			Return(errors.New("Repository Failure"))
+
+		_, err := test.service.CreateComment(MockAccess.LessonID, "user456", dto.CreateCommentDTO{Body: "Hi"})
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_UpdateComment(t *testing.T) {
+	t.Run("Update Own Comment", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user123", "Edited").Return(nil)
+		test.repository.On("UpdateBody", MockComment.ID, "Edited", mock.AnythingOfType("int64")).Return(true, nil)
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.MatchedBy(func(comment dto.CommentChangeDTO) bool {
+			return comment.ID == MockComment.ID && comment.Edited
+		})).Return(nil)
+
+		comment, err := test.service.UpdateComment(MockComment.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Edited", comment.Body)
+		assert.True(t, comment.Edited)
+	})
+
+	t.Run("Push Longest Comment Without Body", func(t *testing.T) {
+		test := initializeService(t)
+		body := strings.Repeat("a", 10000)
+		var pushed []byte
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user123", body).Return(nil)
+		test.repository.On("UpdateBody", MockComment.ID, body, mock.AnythingOfType("int64")).Return(true, nil)
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.AnythingOfType("dto.CommentChangeDTO")).
+			Run(func(args mock.Arguments) {
+				pushed, _ = json.Marshal(args.Get(2))
+			}).Return(nil)
+
+		comment, err := test.service.UpdateComment(MockComment.ID, "user123", dto.UpdateCommentDTO{Body: body})
+
+		assert.NoError(t, err)
+		assert.Equal(t, body, comment.Body)
+		assert.NotContains(t, string(pushed), body)
+		assert.Less(t, len(pushed), 1000)
+	})
+
+	t.Run("Update Comment Of Someone Else", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+
+		_, err := test.service.UpdateComment(MockComment.ID, "user456", dto.UpdateCommentDTO{Body: "Edited"})
+
+		assert.ErrorIs(t, err, service.ErrNotCommentAuthor)
+	})
+
+	t.Run("Update Comment With Banned Words", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user123", "Edited").Return(moderationService.ErrBannedWords)
+
+		_, err := test.service.UpdateComment(MockComment.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"})
+
+		assert.ErrorIs(t, err, moderationService.ErrBannedWords)
+	})
+
+	t.Run("Update Hidden Comment", func(t *testing.T) {
+		test := initializeService(t)
+		hiddenAt := int64(131313)
+		hidden := MockComment
+		hidden.HiddenAt = &hiddenAt
+		test.repository.On("ReadComment", MockComment.ID).Return(hidden, nil)
+
+		_, err := test.service.UpdateComment(MockComment.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"})
+
+		assert.ErrorIs(t, err, service.ErrCommentNotFound)
+	})
+
+	t.Run("Update Comment Not Found", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(entity.LessonComment{}, nil)
+
+		_, err := test.service.UpdateComment(MockComment.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"})
+
+		assert.ErrorIs(t, err, service.ErrCommentNotFound)
+	})
+
+	t.Run("Update Comment Deleted Meanwhile", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.moderator.On("CheckContent", "user123", "Edited").Return(nil)
+		test.repository.On("UpdateBody", MockComment.ID, "Edited", mock.AnythingOfType("int64")).Return(false, nil)
+
+		_, err := test.service.UpdateComment(MockComment.ID, "user123", dto.UpdateCommentDTO{Body: "Edited"})
+
+		assert.ErrorIs(t, err, service.ErrCommentNotFound)
+	})
+}
+
+func TestService_DeleteComment(t *testing.T) {
+	t.Run("Delete Own Comment", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.repository.On("SoftDelete", MockComment.ID, mock.AnythingOfType("int64")).Return(true, nil)
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.MatchedBy(func(comment dto.CommentChangeDTO) bool {
+			return comment.Deleted && comment.UserID == ""
+		})).Return(nil)
+
+		assert.NoError(t, test.service.DeleteComment(MockComment.ID, "user123"))
+	})
+
+	t.Run("Delete Deleted Comment", func(t *testing.T) {
+		test := initializeService(t)
+		deletedAt := int64(131313)
+		deleted := MockComment
+		deleted.DeletedAt = &deletedAt
+		test.repository.On("ReadComment", MockComment.ID).Return(deleted, nil)
+
+		assert.ErrorIs(t, test.service.DeleteComment(MockComment.ID, "user123"), service.ErrCommentNotFound)
+	})
+
+	t.Run("Delete Comment Of Someone Else", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+
+		assert.ErrorIs(t, test.service.DeleteComment(MockComment.ID, "user456"), service.ErrNotCommentAuthor)
+	})
+
+	t.Run("Delete Comment Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user123").Return(MockAccess, nil)
+		test.repository.On("SoftDelete", MockComment.ID, mock.AnythingOfType("int64")).Return(false, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.service.DeleteComment(MockComment.ID, "user123"), "Repository Failure")
+	})
+}
+
+func TestService_Upvote(t *testing.T) {
+	t.Run("Upvote Comment", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("Upvote", MockComment.ID, "user456", mock.AnythingOfType("int64")).Return(3, nil)
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.MatchedBy(func(comment dto.CommentChangeDTO) bool {
+			return comment.Upvotes == 3
+		})).Return(nil)
+
+		comment, err := test.service.Upvote(MockComment.ID, "user456")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, comment.Upvotes)
+		assert.True(t, comment.Upvoted)
+	})
+
+	t.Run("Upvote Comment Not Enrolled", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(courseDTO.LessonAccessDTO{}, courseService.ErrNotEnrolled)
+
+		_, err := test.service.Upvote(MockComment.ID, "user456")
+
+		assert.ErrorIs(t, err, courseService.ErrNotEnrolled)
+	})
+
+	t.Run("Upvote Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(entity.LessonComment{}, errors.New("Repository Failure"))
+
+		_, err := test.service.Upvote(MockComment.ID, "user456")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_RemoveUpvote(t *testing.T) {
+	t.Run("Remove Upvote", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("RemoveUpvote", MockComment.ID, "user456").Return(1, nil)
+		test.publisher.On("PublishToLesson", MockAccess.LessonID, message_type_enum.Comment, mock.AnythingOfType("dto.CommentChangeDTO")).Return(nil)
+
+		comment, err := test.service.RemoveUpvote(MockComment.ID, "user456")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, comment.Upvotes)
+		assert.False(t, comment.Upvoted)
+	})
+
+	t.Run("Remove Upvote Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadComment", MockComment.ID).Return(MockComment, nil)
+		test.lessons.On("GetLessonAccess", MockAccess.LessonID, "user456").Return(MockAccess, nil)
+		test.repository.On("RemoveUpvote", MockComment.ID, "user456").Return(0, errors.New("Repository Failure"))
+
+		_, err := test.service.RemoveUpvote(MockComment.ID, "user456")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_ExportUserData(t *testing.T) {
+	t.Run("Export User Data", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadUserComments", "user123").Return([]entity.LessonComment{MockComment}, nil)
+
+		data, err := test.service.ExportUserData("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"comments": []entity.LessonComment{MockComment}}, data)
+	})
+
+	t.Run("Export User Data Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadUserComments", "user123").Return(nil, errors.New("Repository Failure"))
+
+		_, err := test.service.ExportUserData("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_EraseUserData(t *testing.T) {
+	t.Run("Erase User Data", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("EraseUserData", "user123").Return(nil)
+
+		err := test.service.EraseUserData("user123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Erase User Data Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("EraseUserData", "user123").Return(errors.New("Repository Failure"))
+
+		err := test.service.EraseUserData("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
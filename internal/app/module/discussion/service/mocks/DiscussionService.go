@@ -0,0 +1,480 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/discussion/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// DiscussionService is an autogenerated mock type for the DiscussionService type
+type DiscussionService struct {
+	mock.Mock
+}
+
+type DiscussionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiscussionService) EXPECT() *DiscussionService_Expecter {
+	return &DiscussionService_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: lessonID, userID, input
+func (_m *DiscussionService) CreateComment(lessonID uuid.UUID, userID string, input dto.CreateCommentDTO) (dto.CommentDTO, error) {
+	ret := _m.Called(lessonID, userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 dto.CommentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.CreateCommentDTO) (dto.CommentDTO, error)); ok {
+		return rf(lessonID, userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.CreateCommentDTO) dto.CommentDTO); ok {
+		r0 = rf(lessonID, userID, input)
+	} else {
+		r0 = ret.Get(0).(dto.CommentDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.CreateCommentDTO) error); ok {
+		r1 = rf(lessonID, userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type DiscussionService_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - userID string
+//   - input dto.CreateCommentDTO
+func (_e *DiscussionService_Expecter) CreateComment(lessonID interface{}, userID interface{}, input interface{}) *DiscussionService_CreateComment_Call {
+	return &DiscussionService_CreateComment_Call{Call: _e.mock.On("CreateComment", lessonID, userID, input)}
+}
+
+func (_c *DiscussionService_CreateComment_Call) Run(run func(lessonID uuid.UUID, userID string, input dto.CreateCommentDTO)) *DiscussionService_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.CreateCommentDTO))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_CreateComment_Call) Return(_a0 dto.CommentDTO, _a1 error) *DiscussionService_CreateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_CreateComment_Call) RunAndReturn(run func(uuid.UUID, string, dto.CreateCommentDTO) (dto.CommentDTO, error)) *DiscussionService_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: commentID, userID
+func (_m *DiscussionService) DeleteComment(commentID uuid.UUID, userID string) error {
+	ret := _m.Called(commentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = rf(commentID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscussionService_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type DiscussionService_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - commentID uuid.UUID
+//   - userID string
+func (_e *DiscussionService_Expecter) DeleteComment(commentID interface{}, userID interface{}) *DiscussionService_DeleteComment_Call {
+	return &DiscussionService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", commentID, userID)}
+}
+
+func (_c *DiscussionService_DeleteComment_Call) Run(run func(commentID uuid.UUID, userID string)) *DiscussionService_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_DeleteComment_Call) Return(_a0 error) *DiscussionService_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscussionService_DeleteComment_Call) RunAndReturn(run func(uuid.UUID, string) error) *DiscussionService_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *DiscussionService) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscussionService_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type DiscussionService_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *DiscussionService_Expecter) EraseUserData(userID interface{}) *DiscussionService_EraseUserData_Call {
+	return &DiscussionService_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *DiscussionService_EraseUserData_Call) Run(run func(userID string)) *DiscussionService_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_EraseUserData_Call) Return(_a0 error) *DiscussionService_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscussionService_EraseUserData_Call) RunAndReturn(run func(string) error) *DiscussionService_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: userID
+func (_m *DiscussionService) ExportUserData(userID string) (map[string]any, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type DiscussionService_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *DiscussionService_Expecter) ExportUserData(userID interface{}) *DiscussionService_ExportUserData_Call {
+	return &DiscussionService_ExportUserData_Call{Call: _e.mock.On("ExportUserData", userID)}
+}
+
+func (_c *DiscussionService_ExportUserData_Call) Run(run func(userID string)) *DiscussionService_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_ExportUserData_Call) Return(_a0 map[string]any, _a1 error) *DiscussionService_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_ExportUserData_Call) RunAndReturn(run func(string) (map[string]any, error)) *DiscussionService_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetThreads provides a mock function with given fields: lessonID, userID, limit, page
+func (_m *DiscussionService) GetThreads(lessonID uuid.UUID, userID string, limit int, page int) ([]dto.CommentDTO, error) {
+	ret := _m.Called(lessonID, userID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThreads")
+	}
+
+	var r0 []dto.CommentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) ([]dto.CommentDTO, error)); ok {
+		return rf(lessonID, userID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) []dto.CommentDTO); ok {
+		r0 = rf(lessonID, userID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CommentDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int, int) error); ok {
+		r1 = rf(lessonID, userID, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_GetThreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThreads'
+type DiscussionService_GetThreads_Call struct {
+	*mock.Call
+}
+
+// GetThreads is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - userID string
+//   - limit int
+//   - page int
+func (_e *DiscussionService_Expecter) GetThreads(lessonID interface{}, userID interface{}, limit interface{}, page interface{}) *DiscussionService_GetThreads_Call {
+	return &DiscussionService_GetThreads_Call{Call: _e.mock.On("GetThreads", lessonID, userID, limit, page)}
+}
+
+func (_c *DiscussionService_GetThreads_Call) Run(run func(lessonID uuid.UUID, userID string, limit int, page int)) *DiscussionService_GetThreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_GetThreads_Call) Return(_a0 []dto.CommentDTO, _a1 error) *DiscussionService_GetThreads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_GetThreads_Call) RunAndReturn(run func(uuid.UUID, string, int, int) ([]dto.CommentDTO, error)) *DiscussionService_GetThreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUpvote provides a mock function with given fields: commentID, userID
+func (_m *DiscussionService) RemoveUpvote(commentID uuid.UUID, userID string) (dto.CommentDTO, error) {
+	ret := _m.Called(commentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUpvote")
+	}
+
+	var r0 dto.CommentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.CommentDTO, error)); ok {
+		return rf(commentID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.CommentDTO); ok {
+		r0 = rf(commentID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.CommentDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(commentID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_RemoveUpvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUpvote'
+type DiscussionService_RemoveUpvote_Call struct {
+	*mock.Call
+}
+
+// RemoveUpvote is a helper method to define mock.On call
+//   - commentID uuid.UUID
+//   - userID string
+func (_e *DiscussionService_Expecter) RemoveUpvote(commentID interface{}, userID interface{}) *DiscussionService_RemoveUpvote_Call {
+	return &DiscussionService_RemoveUpvote_Call{Call: _e.mock.On("RemoveUpvote", commentID, userID)}
+}
+
+func (_c *DiscussionService_RemoveUpvote_Call) Run(run func(commentID uuid.UUID, userID string)) *DiscussionService_RemoveUpvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_RemoveUpvote_Call) Return(_a0 dto.CommentDTO, _a1 error) *DiscussionService_RemoveUpvote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_RemoveUpvote_Call) RunAndReturn(run func(uuid.UUID, string) (dto.CommentDTO, error)) *DiscussionService_RemoveUpvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: commentID, userID, input
+func (_m *DiscussionService) UpdateComment(commentID uuid.UUID, userID string, input dto.UpdateCommentDTO) (dto.CommentDTO, error) {
+	ret := _m.Called(commentID, userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 dto.CommentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.UpdateCommentDTO) (dto.CommentDTO, error)); ok {
+		return rf(commentID, userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.UpdateCommentDTO) dto.CommentDTO); ok {
+		r0 = rf(commentID, userID, input)
+	} else {
+		r0 = ret.Get(0).(dto.CommentDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.UpdateCommentDTO) error); ok {
+		r1 = rf(commentID, userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type DiscussionService_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - commentID uuid.UUID
+//   - userID string
+//   - input dto.UpdateCommentDTO
+func (_e *DiscussionService_Expecter) UpdateComment(commentID interface{}, userID interface{}, input interface{}) *DiscussionService_UpdateComment_Call {
+	return &DiscussionService_UpdateComment_Call{Call: _e.mock.On("UpdateComment", commentID, userID, input)}
+}
+
+func (_c *DiscussionService_UpdateComment_Call) Run(run func(commentID uuid.UUID, userID string, input dto.UpdateCommentDTO)) *DiscussionService_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.UpdateCommentDTO))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_UpdateComment_Call) Return(_a0 dto.CommentDTO, _a1 error) *DiscussionService_UpdateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_UpdateComment_Call) RunAndReturn(run func(uuid.UUID, string, dto.UpdateCommentDTO) (dto.CommentDTO, error)) *DiscussionService_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upvote provides a mock function with given fields: commentID, userID
+func (_m *DiscussionService) Upvote(commentID uuid.UUID, userID string) (dto.CommentDTO, error) {
+	ret := _m.Called(commentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upvote")
+	}
+
+	var r0 dto.CommentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.CommentDTO, error)); ok {
+		return rf(commentID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.CommentDTO); ok {
+		r0 = rf(commentID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.CommentDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(commentID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_Upvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upvote'
+type DiscussionService_Upvote_Call struct {
+	*mock.Call
+}
+
+// Upvote is a helper method to define mock.On call
+//   - commentID uuid.UUID
+//   - userID string
+func (_e *DiscussionService_Expecter) Upvote(commentID interface{}, userID interface{}) *DiscussionService_Upvote_Call {
+	return &DiscussionService_Upvote_Call{Call: _e.mock.On("Upvote", commentID, userID)}
+}
+
+func (_c *DiscussionService_Upvote_Call) Run(run func(commentID uuid.UUID, userID string)) *DiscussionService_Upvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_Upvote_Call) Return(_a0 dto.CommentDTO, _a1 error) *DiscussionService_Upvote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_Upvote_Call) RunAndReturn(run func(uuid.UUID, string) (dto.CommentDTO, error)) *DiscussionService_Upvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDiscussionService creates a new instance of DiscussionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDiscussionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DiscussionService {
+	mock := &DiscussionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
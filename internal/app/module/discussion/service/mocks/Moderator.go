@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Moderator is an autogenerated mock type for the Moderator type
+type Moderator struct {
+	mock.Mock
+}
+
+type Moderator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Moderator) EXPECT() *Moderator_Expecter {
+	return &Moderator_Expecter{mock: &_m.Mock}
+}
+
+// CheckContent provides a mock function with given fields: userID, text
+func (_m *Moderator) CheckContent(userID string, text string) error {
+	ret := _m.Called(userID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, text)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Moderator_CheckContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckContent'
+type Moderator_CheckContent_Call struct {
+	*mock.Call
+}
+
+// CheckContent is a helper method to define mock.On call
+//   - userID string
+//   - text string
+func (_e *Moderator_Expecter) CheckContent(userID interface{}, text interface{}) *Moderator_CheckContent_Call {
+	return &Moderator_CheckContent_Call{Call: _e.mock.On("CheckContent", userID, text)}
+}
+
+func (_c *Moderator_CheckContent_Call) Run(run func(userID string, text string)) *Moderator_CheckContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Moderator_CheckContent_Call) Return(_a0 error) *Moderator_CheckContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Moderator_CheckContent_Call) RunAndReturn(run func(string, string) error) *Moderator_CheckContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModerator creates a new instance of Moderator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Moderator {
+	mock := &Moderator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
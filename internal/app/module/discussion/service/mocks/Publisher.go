@@ -0,0 +1,86 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Publisher is an autogenerated mock type for the Publisher type
+type Publisher struct {
+	mock.Mock
+}
+
+type Publisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Publisher) EXPECT() *Publisher_Expecter {
+	return &Publisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishToLesson provides a mock function with given fields: lessonID, messageType, data
+func (_m *Publisher) PublishToLesson(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any) error {
+	ret := _m.Called(lessonID, messageType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishToLesson")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, message_type_enum.MessageType, any) error); ok {
+		r0 = rf(lessonID, messageType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Publisher_PublishToLesson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishToLesson'
+type Publisher_PublishToLesson_Call struct {
+	*mock.Call
+}
+
+// PublishToLesson is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - messageType message_type_enum.MessageType
+//   - data any
+func (_e *Publisher_Expecter) PublishToLesson(lessonID interface{}, messageType interface{}, data interface{}) *Publisher_PublishToLesson_Call {
+	return &Publisher_PublishToLesson_Call{Call: _e.mock.On("PublishToLesson", lessonID, messageType, data)}
+}
+
+func (_c *Publisher_PublishToLesson_Call) Run(run func(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any)) *Publisher_PublishToLesson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(message_type_enum.MessageType), args[2].(any))
+	})
+	return _c
+}
+
+func (_c *Publisher_PublishToLesson_Call) Return(_a0 error) *Publisher_PublishToLesson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Publisher_PublishToLesson_Call) RunAndReturn(run func(uuid.UUID, message_type_enum.MessageType, any) error) *Publisher_PublishToLesson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublisher creates a new instance of Publisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Publisher {
+	mock := &Publisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	language_enum "CodeWithAzri/pkg/enums/language"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// EventDTO is what subscribers and webhooks receive. ID is stable across
+// redeliveries, so receivers can use it to drop duplicates.
+type EventDTO struct {
+	ID          uuid.UUID                 `json:"id"`
+	Type        event_type_enum.EventType `json:"type"`
+	AggregateID string                    `json:"aggregate_id"`
+	Payload     json.RawMessage           `json:"payload" swaggertype:"object"`
+	OccurredAt  int64                     `json:"occurred_at"`
+}
+
+type UserRegisteredPayload struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+type UserDeletedPayload struct {
+	UserID string `json:"user_id"`
+}
+
+type CourseCreatedPayload struct {
+	CourseID uuid.UUID `json:"course_id"`
+	OwnerID  string    `json:"owner_id"`
+	Name     string    `json:"name"`
+}
+
+type CourseEnrolledPayload struct {
+	CourseID uuid.UUID `json:"course_id"`
+	UserID   string    `json:"user_id"`
+}
+
+type LessonCompletedPayload struct {
+	CourseID uuid.UUID `json:"course_id"`
+	LessonID uuid.UUID `json:"lesson_id"`
+	UserID   string    `json:"user_id"`
+}
+
+// LessonPublishedPayload is sent when a lesson is added to a course that is
+// already published, so enrolled users can be told about it.
+type LessonPublishedPayload struct {
+	CourseID   uuid.UUID              `json:"course_id"`
+	CourseName string                 `json:"course_name"`
+	Language   language_enum.Language `json:"language"`
+	LessonID   uuid.UUID              `json:"lesson_id"`
+	Title      string                 `json:"title"`
+}
+
+// CommentCreatedPayload is sent for every comment on a lesson. ParentID and
+// ParentUserID are only set for replies.
+type CommentCreatedPayload struct {
+	CommentID    uuid.UUID              `json:"comment_id"`
+	CourseID     uuid.UUID              `json:"course_id"`
+	Language     language_enum.Language `json:"language"`
+	LessonID     uuid.UUID              `json:"lesson_id"`
+	LessonTitle  string                 `json:"lesson_title"`
+	ParentID     *uuid.UUID             `json:"parent_id,omitempty"`
+	ParentUserID string                 `json:"parent_user_id,omitempty"`
+	UserID       string                 `json:"user_id"`
+}
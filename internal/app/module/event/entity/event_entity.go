@@ -0,0 +1,22 @@
+package entity
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+
+	"github.com/google/uuid"
+)
+
+// Event is a domain event in the outbox. It is written in the transaction
+// of the change it describes and stays pending until DispatchedAt is set.
+type Event struct {
+	ID           uuid.UUID                 `json:"id" gorm:"type:uuid;primaryKey"`
+	Type         event_type_enum.EventType `json:"type" gorm:"type:varchar(100);not null"`
+	AggregateID  string                    `json:"aggregate_id" gorm:"type:varchar(255);not null"`
+	Payload      string                    `json:"payload" gorm:"type:jsonb;not null"`
+	DispatchedAt *int64                    `json:"dispatched_at" gorm:"index"`
+	CreatedAt    int64                     `json:"created_at" gorm:"not null;index:idx_outbox_events_pending,where:dispatched_at IS NULL"`
+}
+
+func (Event) TableName() string {
+	return "outbox_events"
+}
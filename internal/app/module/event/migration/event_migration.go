@@ -0,0 +1,17 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/event/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type EventMigration struct{}
+
+func (m EventMigration) CreateEventTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.Event{},
+	)
+}
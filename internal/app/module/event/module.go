@@ -0,0 +1,33 @@
+package event
+
+import (
+	"CodeWithAzri/internal/app/module/event/migration"
+	"CodeWithAzri/internal/app/module/event/repository"
+	"CodeWithAzri/internal/app/module/event/service"
+	"CodeWithAzri/internal/app/module/event/worker"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	"database/sql"
+	"time"
+)
+
+type Module struct {
+	Service    service.EventService
+	Repository repository.EventRepository
+	Migration  *migration.EventMigration
+	Worker     *worker.EventDispatcher
+}
+
+func NewModule(db *sql.DB, jobs jobService.JobService) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewEventService(m.Repository, jobs)
+	m.Migration = &migration.EventMigration{}
+	m.Worker = worker.NewEventDispatcher(m.Service, time.Second, 50)
+
+	err := jobs.Schedule(service.PruneJobType, "30 3 * * *", service.PruneJobType, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/event/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type EventRepository interface {
+	Dispatch(limit int, dispatchedAt int64, deliver func(event entity.Event) error) (int, error)
+	DeleteDispatched(before int64) (int64, error)
+	ReplacePayloads(eventType event_type_enum.EventType, aggregateID string, payload string) error
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	r := &Repository{db: db}
+	return r
+}
+
+// Append writes events to the outbox inside tx. Other modules call it from
+// their repositories, so an event is stored if and only if the change it
+// describes is committed.
+func Append(tx *sql.Tx, events ...entity.Event) error {
+	query := `
+		INSERT INTO outbox_events (id, type, aggregate_id, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for _, event := range events {
+		_, err := tx.Exec(query, event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to append event: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Dispatch hands up to limit pending events to deliver, oldest first, and
+// marks the delivered ones as dispatched. The events stay locked until then,
+// so instances never dispatch the same event concurrently. It stops at the
+// first delivery error, which it returns along with how many events were
+// dispatched before it.
+func (r *Repository) Dispatch(limit int, dispatchedAt int64, deliver func(event entity.Event) error) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		SELECT id, type, aggregate_id, payload, created_at
+		FROM outbox_events
+		WHERE dispatched_at IS NULL
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	events, err := readEvents(tx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := make([]uuid.UUID, 0, len(events))
+	var deliverErr error
+	for _, event := range events {
+		deliverErr = deliver(event)
+		if deliverErr != nil {
+			break
+		}
+		dispatched = append(dispatched, event.ID)
+	}
+
+	if len(dispatched) > 0 {
+		_, err = tx.Exec("UPDATE outbox_events SET dispatched_at = $1 WHERE id = ANY($2::uuid[])", dispatchedAt, uuidArray(dispatched))
+		if err != nil {
+			return 0, fmt.Errorf("failed to mark events as dispatched: %v", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return len(dispatched), deliverErr
+}
+
+func (r *Repository) DeleteDispatched(before int64) (int64, error) {
+	result, err := r.db.Exec("DELETE FROM outbox_events WHERE dispatched_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete dispatched events: %v", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// ReplacePayloads overwrites the payload of every event of eventType about
+// aggregateID, dispatched or not.
+func (r *Repository) ReplacePayloads(eventType event_type_enum.EventType, aggregateID string, payload string) error {
+	_, err := r.db.Exec("UPDATE outbox_events SET payload = $1 WHERE type = $2 AND aggregate_id = $3", payload, eventType, aggregateID)
+	if err != nil {
+		return fmt.Errorf("failed to replace event payloads: %v", err)
+	}
+
+	return nil
+}
+
+func readEvents(tx *sql.Tx, query string, args ...any) ([]entity.Event, error) {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pending events: %v", err)
+	}
+	defer rows.Close()
+
+	events := make([]entity.Event, 0)
+	for rows.Next() {
+		var event entity.Event
+		err = rows.Scan(&event.ID, &event.Type, &event.AggregateID, &event.Payload, &event.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %v", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func uuidArray(ids []uuid.UUID) interface{} {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return pq.Array(values)
+}
@@ -0,0 +1,42 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/event/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	appendEventQuery      = "INSERT INTO outbox_events (id, type, aggregate_id, payload, created_at) VALUES ($1, $2, $3, $4, $5)"
+	readPendingQuery      = "SELECT id, type, aggregate_id, payload, created_at FROM outbox_events WHERE dispatched_at IS NULL ORDER BY created_at LIMIT $1 FOR UPDATE SKIP LOCKED"
+	markDispatchedQuery   = "UPDATE outbox_events SET dispatched_at = $1 WHERE id = ANY($2::uuid[])"
+	deleteDispatchedQuery = "DELETE FROM outbox_events WHERE dispatched_at < $1"
+	replacePayloadsQuery  = "UPDATE outbox_events SET payload = $1 WHERE type = $2 AND aggregate_id = $3"
+)
+
+var MockEvents []entity.Event = []entity.Event{
+	{
+		ID:          uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+		Type:        event_type_enum.UserRegistered,
+		AggregateID: "user123",
+		Payload:     `{"user_id":"user123"}`,
+		CreatedAt:   121212,
+	},
+	{
+		ID:          uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c62"),
+		Type:        event_type_enum.CourseEnrolled,
+		AggregateID: "18a95d2f-a941-4a64-bbe5-256be7626db2",
+		Payload:     `{"course_id":"18a95d2f-a941-4a64-bbe5-256be7626db2","user_id":"user123"}`,
+		CreatedAt:   131313,
+	},
+}
+
+func preparePendingRows(events ...entity.Event) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "type", "aggregate_id", "payload", "created_at"})
+	for _, event := range events {
+		rows.AddRow(event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt)
+	}
+	return rows
+}
@@ -0,0 +1,180 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/event/entity"
+	"CodeWithAzri/internal/app/module/event/repository"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.EventRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func TestAppend(t *testing.T) {
+	db, mock, _ := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Append Events", func(t *testing.T) {
+		mock.ExpectBegin()
+		for _, event := range MockEvents {
+			mock.ExpectExec(appendEventQuery).
+				WithArgs(event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		tx, err := db.Begin()
+		assert.NoError(t, err)
+
+		assert.NoError(t, repository.Append(tx, MockEvents...))
+	})
+
+	t.Run("Append Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(appendEventQuery).WillReturnError(errors.New("insert failed"))
+
+		tx, err := db.Begin()
+		assert.NoError(t, err)
+
+		err = repository.Append(tx, MockEvents...)
+
+		assert.EqualError(t, err, "failed to append event: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Dispatch(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Dispatch Marks Delivered Events", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readPendingQuery).WithArgs(10).WillReturnRows(preparePendingRows(MockEvents...))
+		mock.ExpectExec(markDispatchedQuery).
+			WithArgs(int64(141414), pq.Array([]string{MockEvents[0].ID.String(), MockEvents[1].ID.String()})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		delivered := make([]entity.Event, 0)
+		dispatched, err := repo.Dispatch(10, 141414, func(event entity.Event) error {
+			delivered = append(delivered, event)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, dispatched)
+		assert.Equal(t, MockEvents, delivered)
+	})
+
+	t.Run("Dispatch Stops At First Failure", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readPendingQuery).WithArgs(10).WillReturnRows(preparePendingRows(MockEvents...))
+		mock.ExpectExec(markDispatchedQuery).
+			WithArgs(int64(141414), pq.Array([]string{MockEvents[0].ID.String()})).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		dispatched, err := repo.Dispatch(10, 141414, func(event entity.Event) error {
+			if event.ID == MockEvents[1].ID {
+				return errors.New("queue unavailable")
+			}
+			return nil
+		})
+
+		assert.EqualError(t, err, "queue unavailable")
+		assert.Equal(t, 1, dispatched)
+	})
+
+	t.Run("Dispatch Nothing Pending", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readPendingQuery).WithArgs(10).WillReturnRows(preparePendingRows())
+		mock.ExpectCommit()
+
+		dispatched, err := repo.Dispatch(10, 141414, func(event entity.Event) error { return nil })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, dispatched)
+	})
+
+	t.Run("Dispatch Read Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readPendingQuery).WillReturnError(errors.New("query failed"))
+		mock.ExpectRollback()
+
+		_, err := repo.Dispatch(10, 141414, func(event entity.Event) error { return nil })
+
+		assert.EqualError(t, err, "failed to read pending events: query failed")
+	})
+
+	t.Run("Dispatch Mark Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(readPendingQuery).WithArgs(10).WillReturnRows(preparePendingRows(MockEvents[0]))
+		mock.ExpectExec(markDispatchedQuery).WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		_, err := repo.Dispatch(10, 141414, func(event entity.Event) error { return nil })
+
+		assert.EqualError(t, err, "failed to mark events as dispatched: update failed")
+	})
+
+	t.Run("Dispatch Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("begin failed"))
+
+		_, err := repo.Dispatch(10, 141414, func(event entity.Event) error { return nil })
+
+		assert.EqualError(t, err, "failed to begin transaction: begin failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteDispatched(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(deleteDispatchedQuery).WithArgs(int64(121212)).WillReturnResult(sqlmock.NewResult(0, 5))
+
+	deleted, err := repo.DeleteDispatched(121212)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), deleted)
+
+	mock.ExpectExec(deleteDispatchedQuery).WillReturnError(errors.New("delete failed"))
+
+	_, err = repo.DeleteDispatched(121212)
+
+	assert.EqualError(t, err, "failed to delete dispatched events: delete failed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReplacePayloads(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(replacePayloadsQuery).WithArgs(`{"user_id":"user123"}`, "user.registered", "user123").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.ReplacePayloads(event_type_enum.UserRegistered, "user123", `{"user_id":"user123"}`)
+
+	assert.NoError(t, err)
+
+	mock.ExpectExec(replacePayloadsQuery).WillReturnError(errors.New("update failed"))
+
+	err = repo.ReplacePayloads(event_type_enum.UserRegistered, "user123", `{"user_id":"user123"}`)
+
+	assert.EqualError(t, err, "failed to replace event payloads: update failed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
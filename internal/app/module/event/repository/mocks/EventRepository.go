@@ -0,0 +1,199 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/event/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventRepository is an autogenerated mock type for the EventRepository type
+type EventRepository struct {
+	mock.Mock
+}
+
+type EventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventRepository) EXPECT() *EventRepository_Expecter {
+	return &EventRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteDispatched provides a mock function with given fields: before
+func (_m *EventRepository) DeleteDispatched(before int64) (int64, error) {
+	ret := _m.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDispatched")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, error)); ok {
+		return rf(before)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventRepository_DeleteDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDispatched'
+type EventRepository_DeleteDispatched_Call struct {
+	*mock.Call
+}
+
+// DeleteDispatched is a helper method to define mock.On call
+//   - before int64
+func (_e *EventRepository_Expecter) DeleteDispatched(before interface{}) *EventRepository_DeleteDispatched_Call {
+	return &EventRepository_DeleteDispatched_Call{Call: _e.mock.On("DeleteDispatched", before)}
+}
+
+func (_c *EventRepository_DeleteDispatched_Call) Run(run func(before int64)) *EventRepository_DeleteDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *EventRepository_DeleteDispatched_Call) Return(_a0 int64, _a1 error) *EventRepository_DeleteDispatched_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventRepository_DeleteDispatched_Call) RunAndReturn(run func(int64) (int64, error)) *EventRepository_DeleteDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function with given fields: limit, dispatchedAt, deliver
+func (_m *EventRepository) Dispatch(limit int, dispatchedAt int64, deliver func(entity.Event) error) (int, error) {
+	ret := _m.Called(limit, dispatchedAt, deliver)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int64, func(entity.Event) error) (int, error)); ok {
+		return rf(limit, dispatchedAt, deliver)
+	}
+	if rf, ok := ret.Get(0).(func(int, int64, func(entity.Event) error) int); ok {
+		r0 = rf(limit, dispatchedAt, deliver)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int64, func(entity.Event) error) error); ok {
+		r1 = rf(limit, dispatchedAt, deliver)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventRepository_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type EventRepository_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - limit int
+//   - dispatchedAt int64
+//   - deliver func(entity.Event) error
+func (_e *EventRepository_Expecter) Dispatch(limit interface{}, dispatchedAt interface{}, deliver interface{}) *EventRepository_Dispatch_Call {
+	return &EventRepository_Dispatch_Call{Call: _e.mock.On("Dispatch", limit, dispatchedAt, deliver)}
+}
+
+func (_c *EventRepository_Dispatch_Call) Run(run func(limit int, dispatchedAt int64, deliver func(entity.Event) error)) *EventRepository_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int64), args[2].(func(entity.Event) error))
+	})
+	return _c
+}
+
+func (_c *EventRepository_Dispatch_Call) Return(_a0 int, _a1 error) *EventRepository_Dispatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventRepository_Dispatch_Call) RunAndReturn(run func(int, int64, func(entity.Event) error) (int, error)) *EventRepository_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePayloads provides a mock function with given fields: eventType, aggregateID, payload
+func (_m *EventRepository) ReplacePayloads(eventType event_type_enum.EventType, aggregateID string, payload string) error {
+	ret := _m.Called(eventType, aggregateID, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePayloads")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(event_type_enum.EventType, string, string) error); ok {
+		r0 = rf(eventType, aggregateID, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventRepository_ReplacePayloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePayloads'
+type EventRepository_ReplacePayloads_Call struct {
+	*mock.Call
+}
+
+// ReplacePayloads is a helper method to define mock.On call
+//   - eventType event_type_enum.EventType
+//   - aggregateID string
+//   - payload string
+func (_e *EventRepository_Expecter) ReplacePayloads(eventType interface{}, aggregateID interface{}, payload interface{}) *EventRepository_ReplacePayloads_Call {
+	return &EventRepository_ReplacePayloads_Call{Call: _e.mock.On("ReplacePayloads", eventType, aggregateID, payload)}
+}
+
+func (_c *EventRepository_ReplacePayloads_Call) Run(run func(eventType event_type_enum.EventType, aggregateID string, payload string)) *EventRepository_ReplacePayloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(event_type_enum.EventType), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *EventRepository_ReplacePayloads_Call) Return(_a0 error) *EventRepository_ReplacePayloads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EventRepository_ReplacePayloads_Call) RunAndReturn(run func(event_type_enum.EventType, string, string) error) *EventRepository_ReplacePayloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventRepository creates a new instance of EventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventRepository {
+	mock := &EventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
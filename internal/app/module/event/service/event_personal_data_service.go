@@ -0,0 +1,25 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/event/dto"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"encoding/json"
+	"fmt"
+)
+
+// ExportUserData exports nothing: the outbox only repeats what the modules
+// that published the events already export.
+func (s *Service) ExportUserData(userID string) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+// EraseUserData strips the name and email from the registration event of a
+// user. The other events only refer to users by ID.
+func (s *Service) EraseUserData(userID string) error {
+	payload, err := json.Marshal(dto.UserRegisteredPayload{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("failed to encode event payload: %v", err)
+	}
+
+	return s.repository.ReplacePayloads(event_type_enum.UserRegistered, userID, string(payload))
+}
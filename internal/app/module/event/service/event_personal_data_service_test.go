@@ -0,0 +1,38 @@
+package service_test
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_ExportUserData(t *testing.T) {
+	_, _, eventService, _ := initializeService(t)
+
+	data, err := eventService.ExportUserData("user123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+}
+
+func TestService_EraseUserData(t *testing.T) {
+	t.Run("Erase User Data Successfully", func(t *testing.T) {
+		mockRepo, _, eventService, _ := initializeService(t)
+		mockRepo.On("ReplacePayloads", event_type_enum.UserRegistered, "user123", `{"user_id":"user123","name":"","email":""}`).Return(nil)
+
+		err := eventService.EraseUserData("user123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Erase User Data Repository Error", func(t *testing.T) {
+		mockRepo, _, eventService, _ := initializeService(t)
+		mockRepo.On("ReplacePayloads", event_type_enum.UserRegistered, "user123", `{"user_id":"user123","name":"","email":""}`).Return(errors.New("Repository Failure"))
+
+		err := eventService.EraseUserData("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
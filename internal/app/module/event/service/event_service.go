@@ -0,0 +1,184 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/event/dto"
+	"CodeWithAzri/internal/app/module/event/entity"
+	"CodeWithAzri/internal/app/module/event/repository"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrUnknownSubscriber = errors.New("no subscriber is registered under this name")
+
+const (
+	// DeliverJobType hands one event to one subscriber. Every subscriber gets
+	// its own job, so a failing subscriber is retried without the others
+	// seeing the event again.
+	DeliverJobType = "events.deliver"
+
+	// PruneJobType deletes dispatched events once they are older than
+	// dispatchedEventRetention.
+	PruneJobType             = "events.prune"
+	dispatchedEventRetention = 7 * 24 * time.Hour
+)
+
+// Subscriber reacts to an event. Delivery is at-least-once: an event can
+// reach a subscriber more than once, so subscribers have to be idempotent.
+// Returning an error retries the delivery with backoff.
+type Subscriber func(ctx context.Context, event dto.EventDTO) error
+
+// Handle adapts a subscriber for a typed payload.
+func Handle[T any](fn func(ctx context.Context, event dto.EventDTO, payload T) error) Subscriber {
+	return func(ctx context.Context, event dto.EventDTO) error {
+		var payload T
+		err := json.Unmarshal(event.Payload, &payload)
+		if err != nil {
+			return fmt.Errorf("failed to decode event payload: %v", err)
+		}
+		return fn(ctx, event, payload)
+	}
+}
+
+// NewEvent builds an event for the outbox. Publishers pass it to their
+// repository, which appends it in the transaction of the change.
+func NewEvent(eventType event_type_enum.EventType, aggregateID string, payload any) (entity.Event, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return entity.Event{}, fmt.Errorf("failed to encode event payload: %v", err)
+	}
+
+	return entity.Event{
+		ID:          uuid.New(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     string(encoded),
+		CreatedAt:   timepkg.NowUnixMilli(),
+	}, nil
+}
+
+type EventService interface {
+	Subscribe(name string, subscriber Subscriber, eventTypes ...event_type_enum.EventType)
+	DispatchEvents(limit int) (int, error)
+	ExportUserData(userID string) (map[string]any, error)
+	EraseUserData(userID string) error
+}
+
+type subscription struct {
+	subscriber Subscriber
+	// eventTypes is empty for subscribers that want every event.
+	eventTypes []event_type_enum.EventType
+}
+
+// delivery is the payload of a DeliverJobType job.
+type delivery struct {
+	Subscriber string       `json:"subscriber"`
+	Event      dto.EventDTO `json:"event"`
+}
+
+type Service struct {
+	repository repository.EventRepository
+	jobs       jobService.JobService
+
+	mu            sync.RWMutex
+	subscriptions map[string]subscription
+}
+
+func NewEventService(r repository.EventRepository, jobs jobService.JobService) EventService {
+	s := new(Service)
+	s.repository = r
+	s.jobs = jobs
+	s.subscriptions = make(map[string]subscription)
+	jobs.Register(DeliverJobType, jobService.Handle(s.deliver))
+	jobs.Register(PruneJobType, jobService.Handle(s.pruneEvents))
+	return s
+}
+
+// Subscribe delivers events of the given types to subscriber, or every event
+// when no type is given. name identifies the subscriber in queued deliveries,
+// so it has to stay the same across restarts.
+func (s *Service) Subscribe(name string, subscriber Subscriber, eventTypes ...event_type_enum.EventType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions[name] = subscription{subscriber: subscriber, eventTypes: eventTypes}
+}
+
+// DispatchEvents queues a delivery for every subscriber of up to limit
+// pending events and returns how many events were dispatched.
+func (s *Service) DispatchEvents(limit int) (int, error) {
+	return s.repository.Dispatch(limit, timepkg.NowUnixMilli(), s.dispatch)
+}
+
+func (s *Service) dispatch(event entity.Event) error {
+	eventDTO := toDTO(event)
+
+	for _, name := range s.subscribersOf(event.Type) {
+		_, err := s.jobs.Enqueue(DeliverJobType, delivery{Subscriber: name, Event: eventDTO})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deliver(ctx context.Context, input delivery) error {
+	s.mu.RLock()
+	subscription, ok := s.subscriptions[input.Subscriber]
+	s.mu.RUnlock()
+
+	if !ok {
+		return ErrUnknownSubscriber
+	}
+
+	return subscription.subscriber(ctx, input.Event)
+}
+
+func (s *Service) subscribersOf(eventType event_type_enum.EventType) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0)
+	for name, subscription := range s.subscriptions {
+		if len(subscription.eventTypes) == 0 || slices.Contains(subscription.eventTypes, eventType) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Service) pruneEvents(ctx context.Context, payload struct{}) error {
+	before := timepkg.ToUnixMilli(timepkg.Now().Add(-dispatchedEventRetention))
+
+	deleted, err := s.repository.DeleteDispatched(before)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		log.Printf("pruned %d dispatched event(s)\n", deleted)
+	}
+	return nil
+}
+
+func toDTO(event entity.Event) dto.EventDTO {
+	return dto.EventDTO{
+		ID:          event.ID,
+		Type:        event.Type,
+		AggregateID: event.AggregateID,
+		Payload:     json.RawMessage(event.Payload),
+		OccurredAt:  event.CreatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/event/entity"
+	repositoryMocks "CodeWithAzri/internal/app/module/event/repository/mocks"
+	"CodeWithAzri/internal/app/module/event/service"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	jobMocks "CodeWithAzri/internal/app/module/job/service/mocks"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+var MockEvent entity.Event = entity.Event{
+	ID:          uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+	Type:        event_type_enum.CourseEnrolled,
+	AggregateID: "18a95d2f-a941-4a64-bbe5-256be7626db2",
+	Payload:     `{"course_id":"18a95d2f-a941-4a64-bbe5-256be7626db2","user_id":"user123"}`,
+	CreatedAt:   121212,
+}
+
+// initializeService creates the service and captures the job handlers it
+// registers, so tests can run deliveries like the job worker would.
+func initializeService(t *testing.T) (*repositoryMocks.EventRepository, *jobMocks.JobService, service.EventService, map[string]jobService.Handler) {
+	mockRepo := repositoryMocks.NewEventRepository(t)
+	mockJobs := jobMocks.NewJobService(t)
+
+	handlers := make(map[string]jobService.Handler)
+	mockJobs.On("Register", mock.AnythingOfType("string"), mock.AnythingOfType("service.Handler")).
+		Run(func(args mock.Arguments) {
+			handlers[args.String(0)] = args.Get(1).(jobService.Handler)
+		}).Twice()
+
+	return mockRepo, mockJobs, service.NewEventService(mockRepo, mockJobs), handlers
+}
@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/event/dto"
+	"CodeWithAzri/internal/app/module/event/entity"
+	"CodeWithAzri/internal/app/module/event/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewEvent(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 121212 })
+	defer monkey.UnpatchAll()
+
+	t.Run("New Event", func(t *testing.T) {
+		courseID := uuid.MustParse(MockEvent.AggregateID)
+		event, err := service.NewEvent(event_type_enum.CourseEnrolled, MockEvent.AggregateID,
+			dto.CourseEnrolledPayload{CourseID: courseID, UserID: "user123"})
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, event.ID)
+		assert.Equal(t, event_type_enum.CourseEnrolled, event.Type)
+		assert.Equal(t, MockEvent.AggregateID, event.AggregateID)
+		assert.JSONEq(t, MockEvent.Payload, event.Payload)
+		assert.Equal(t, int64(121212), event.CreatedAt)
+	})
+
+	t.Run("New Event Encode Error", func(t *testing.T) {
+		_, err := service.NewEvent(event_type_enum.CourseEnrolled, "course123", make(chan int))
+
+		assert.ErrorContains(t, err, "failed to encode event payload")
+	})
+}
+
+func TestService_DispatchEvents(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Dispatch To Matching Subscribers", func(t *testing.T) {
+		mockRepo, mockJobs, s, _ := initializeService(t)
+		noop := func(ctx context.Context, event dto.EventDTO) error { return nil }
+		s.Subscribe("progress", noop, event_type_enum.LessonCompleted)
+		s.Subscribe("enrollments", noop, event_type_enum.CourseEnrolled)
+		s.Subscribe("audit", noop)
+
+		enqueued := make([]string, 0)
+		mockJobs.On("Enqueue", service.DeliverJobType, mock.Anything).
+			Run(func(args mock.Arguments) {
+				var delivery struct {
+					Subscriber string       `json:"subscriber"`
+					Event      dto.EventDTO `json:"event"`
+				}
+				encoded, _ := json.Marshal(args.Get(1))
+				json.Unmarshal(encoded, &delivery)
+				assert.Equal(t, MockEvent.ID, delivery.Event.ID)
+				assert.Equal(t, int64(121212), delivery.Event.OccurredAt)
+				enqueued = append(enqueued, delivery.Subscriber)
+			}).Return(uuid.New(), nil).Twice()
+		mockRepo.On("Dispatch", 10, int64(131313), mock.Anything).
+			Return(func(limit int, dispatchedAt int64, deliver func(entity.Event) error) (int, error) {
+				return 1, deliver(MockEvent)
+			})
+
+		dispatched, err := s.DispatchEvents(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, dispatched)
+		assert.Equal(t, []string{"audit", "enrollments"}, enqueued)
+	})
+
+	t.Run("Dispatch Enqueue Error", func(t *testing.T) {
+		mockRepo, mockJobs, s, _ := initializeService(t)
+		s.Subscribe("audit", func(ctx context.Context, event dto.EventDTO) error { return nil })
+
+		mockJobs.On("Enqueue", service.DeliverJobType, mock.Anything).Return(uuid.Nil, errors.New("queue unavailable"))
+		mockRepo.On("Dispatch", 10, int64(131313), mock.Anything).
+			Return(func(limit int, dispatchedAt int64, deliver func(entity.Event) error) (int, error) {
+				return 0, deliver(MockEvent)
+			})
+
+		_, err := s.DispatchEvents(10)
+
+		assert.EqualError(t, err, "queue unavailable")
+	})
+}
+
+func TestService_Deliver(t *testing.T) {
+	payload := func(subscriber string) []byte {
+		encoded, _ := json.Marshal(map[string]any{
+			"subscriber": subscriber,
+			"event": dto.EventDTO{
+				ID:          MockEvent.ID,
+				Type:        MockEvent.Type,
+				AggregateID: MockEvent.AggregateID,
+				Payload:     json.RawMessage(MockEvent.Payload),
+				OccurredAt:  MockEvent.CreatedAt,
+			},
+		})
+		return encoded
+	}
+
+	t.Run("Deliver To Subscriber", func(t *testing.T) {
+		_, _, s, handlers := initializeService(t)
+
+		var received dto.CourseEnrolledPayload
+		s.Subscribe("enrollments", service.Handle(func(ctx context.Context, event dto.EventDTO, p dto.CourseEnrolledPayload) error {
+			received = p
+			return nil
+		}))
+
+		err := handlers[service.DeliverJobType](context.Background(), payload("enrollments"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.CourseEnrolledPayload{CourseID: uuid.MustParse(MockEvent.AggregateID), UserID: "user123"}, received)
+	})
+
+	t.Run("Deliver Subscriber Error", func(t *testing.T) {
+		_, _, s, handlers := initializeService(t)
+		s.Subscribe("failing", func(ctx context.Context, event dto.EventDTO) error {
+			return errors.New("subscriber failed")
+		})
+
+		err := handlers[service.DeliverJobType](context.Background(), payload("failing"))
+
+		assert.EqualError(t, err, "subscriber failed")
+	})
+
+	t.Run("Deliver Unknown Subscriber", func(t *testing.T) {
+		_, _, _, handlers := initializeService(t)
+
+		err := handlers[service.DeliverJobType](context.Background(), payload("removed"))
+
+		assert.ErrorIs(t, err, service.ErrUnknownSubscriber)
+	})
+
+	t.Run("Deliver Payload Decode Error", func(t *testing.T) {
+		_, _, s, handlers := initializeService(t)
+		s.Subscribe("typed", service.Handle(func(ctx context.Context, event dto.EventDTO, p []string) error {
+			return nil
+		}))
+
+		err := handlers[service.DeliverJobType](context.Background(), payload("typed"))
+
+		assert.ErrorContains(t, err, "failed to decode event payload")
+	})
+}
+
+func TestService_PruneEvents(t *testing.T) {
+	now := time.UnixMilli(8 * 24 * time.Hour.Milliseconds())
+	monkey.Patch(timepkg.Now, func() time.Time { return now })
+	defer monkey.UnpatchAll()
+
+	before := now.Add(-7 * 24 * time.Hour).UnixMilli()
+
+	t.Run("Prune Dispatched Events", func(t *testing.T) {
+		mockRepo, _, _, handlers := initializeService(t)
+		mockRepo.On("DeleteDispatched", before).Return(int64(3), nil)
+
+		err := handlers[service.PruneJobType](context.Background(), []byte("{}"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Prune Error", func(t *testing.T) {
+		mockRepo, _, _, handlers := initializeService(t)
+		mockRepo.On("DeleteDispatched", before).Return(int64(0), errors.New("Repository Failure"))
+
+		err := handlers[service.PruneJobType](context.Background(), []byte("{}"))
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
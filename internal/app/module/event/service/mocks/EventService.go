@@ -0,0 +1,247 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "CodeWithAzri/internal/app/module/event/service"
+)
+
+// EventService is an autogenerated mock type for the EventService type
+type EventService struct {
+	mock.Mock
+}
+
+type EventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventService) EXPECT() *EventService_Expecter {
+	return &EventService_Expecter{mock: &_m.Mock}
+}
+
+// DispatchEvents provides a mock function with given fields: limit
+func (_m *EventService) DispatchEvents(limit int) (int, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventService_DispatchEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchEvents'
+type EventService_DispatchEvents_Call struct {
+	*mock.Call
+}
+
+// DispatchEvents is a helper method to define mock.On call
+//   - limit int
+func (_e *EventService_Expecter) DispatchEvents(limit interface{}) *EventService_DispatchEvents_Call {
+	return &EventService_DispatchEvents_Call{Call: _e.mock.On("DispatchEvents", limit)}
+}
+
+func (_c *EventService_DispatchEvents_Call) Run(run func(limit int)) *EventService_DispatchEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *EventService_DispatchEvents_Call) Return(_a0 int, _a1 error) *EventService_DispatchEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventService_DispatchEvents_Call) RunAndReturn(run func(int) (int, error)) *EventService_DispatchEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *EventService) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventService_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type EventService_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *EventService_Expecter) EraseUserData(userID interface{}) *EventService_EraseUserData_Call {
+	return &EventService_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *EventService_EraseUserData_Call) Run(run func(userID string)) *EventService_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EventService_EraseUserData_Call) Return(_a0 error) *EventService_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EventService_EraseUserData_Call) RunAndReturn(run func(string) error) *EventService_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: userID
+func (_m *EventService) ExportUserData(userID string) (map[string]any, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventService_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type EventService_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *EventService_Expecter) ExportUserData(userID interface{}) *EventService_ExportUserData_Call {
+	return &EventService_ExportUserData_Call{Call: _e.mock.On("ExportUserData", userID)}
+}
+
+func (_c *EventService_ExportUserData_Call) Run(run func(userID string)) *EventService_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EventService_ExportUserData_Call) Return(_a0 map[string]any, _a1 error) *EventService_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventService_ExportUserData_Call) RunAndReturn(run func(string) (map[string]any, error)) *EventService_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: name, subscriber, eventTypes
+func (_m *EventService) Subscribe(name string, subscriber service.Subscriber, eventTypes ...event_type_enum.EventType) {
+	_va := make([]interface{}, len(eventTypes))
+	for _i := range eventTypes {
+		_va[_i] = eventTypes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, subscriber)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// EventService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type EventService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - name string
+//   - subscriber service.Subscriber
+//   - eventTypes ...event_type_enum.EventType
+func (_e *EventService_Expecter) Subscribe(name interface{}, subscriber interface{}, eventTypes ...interface{}) *EventService_Subscribe_Call {
+	return &EventService_Subscribe_Call{Call: _e.mock.On("Subscribe",
+		append([]interface{}{name, subscriber}, eventTypes...)...)}
+}
+
+func (_c *EventService_Subscribe_Call) Run(run func(name string, subscriber service.Subscriber, eventTypes ...event_type_enum.EventType)) *EventService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]event_type_enum.EventType, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(event_type_enum.EventType)
+			}
+		}
+		run(args[0].(string), args[1].(service.Subscriber), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EventService_Subscribe_Call) Return() *EventService_Subscribe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EventService_Subscribe_Call) RunAndReturn(run func(string, service.Subscriber, ...event_type_enum.EventType)) *EventService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventService creates a new instance of EventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventService {
+	mock := &EventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
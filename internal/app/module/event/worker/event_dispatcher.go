@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"CodeWithAzri/internal/app/module/event/service"
+	"context"
+	"log"
+	"time"
+)
+
+// EventDispatcher moves events from the outbox to their subscribers.
+type EventDispatcher struct {
+	service   service.EventService
+	interval  time.Duration
+	batchSize int
+}
+
+// NewEventDispatcher creates a new EventDispatcher instance.
+func NewEventDispatcher(s service.EventService, interval time.Duration, batchSize int) *EventDispatcher {
+	d := new(EventDispatcher)
+	d.service = s
+	d.interval = interval
+	d.batchSize = batchSize
+	return d
+}
+
+// Start runs the dispatcher until the context is cancelled.
+func (d *EventDispatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce dispatches batches of events until the outbox has no more pending
+// events.
+func (d *EventDispatcher) RunOnce() {
+	for {
+		dispatched, err := d.service.DispatchEvents(d.batchSize)
+		if err != nil {
+			log.Printf("failed to dispatch events: %v\n", err)
+			return
+		}
+
+		if dispatched < d.batchSize {
+			return
+		}
+	}
+}
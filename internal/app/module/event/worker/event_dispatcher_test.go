@@ -0,0 +1,49 @@
+package worker_test
+
+import (
+	"CodeWithAzri/internal/app/module/event/service/mocks"
+	"CodeWithAzri/internal/app/module/event/worker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventDispatcher_RunOnce(t *testing.T) {
+	mockService := mocks.NewEventService(t)
+	dispatcher := worker.NewEventDispatcher(mockService, time.Minute, 2)
+
+	t.Run("Dispatch Until Batch Is Not Full", func(t *testing.T) {
+		mockService.On("DispatchEvents", 2).Return(2, nil).Once()
+		mockService.On("DispatchEvents", 2).Return(0, nil).Once()
+
+		dispatcher.RunOnce()
+
+		mockService.AssertNumberOfCalls(t, "DispatchEvents", 2)
+	})
+
+	t.Run("Dispatch Error", func(t *testing.T) {
+		mockService.On("DispatchEvents", 2).Return(0, errors.New("Repository Failure")).Once()
+
+		assert.NotPanics(t, dispatcher.RunOnce)
+		mockService.AssertNumberOfCalls(t, "DispatchEvents", 3)
+	})
+}
+
+func TestEventDispatcher_Start(t *testing.T) {
+	mockService := mocks.NewEventService(t)
+	dispatcher := worker.NewEventDispatcher(mockService, time.Hour, 1)
+
+	t.Run("Start Stops On Context Cancel", func(t *testing.T) {
+		mockService.On("DispatchEvents", 1).Return(0, nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		dispatcher.Start(ctx)
+
+		mockService.AssertNumberOfCalls(t, "DispatchEvents", 1)
+	})
+}
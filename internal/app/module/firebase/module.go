@@ -5,6 +5,7 @@ import (
 	"log"
 
 	firebase "firebase.google.com/go"
+	"firebase.google.com/go/auth"
 	"google.golang.org/api/option"
 )
 
@@ -29,3 +30,28 @@ func NewModule() *Module {
 		FirebaseApp: app,
 	}
 }
+
+// RevokeAccount revokes the refresh tokens of uid and deletes its Firebase
+// account. ID tokens already issued stay signed until they expire, so the
+// auth middleware checks for revocation on every request. An account that is
+// already gone counts as revoked, so an erasure that failed halfway can be
+// run again.
+func (m *Module) RevokeAccount(uid string) error {
+	ctx := context.Background()
+	client, err := m.FirebaseApp.Auth(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.RevokeRefreshTokens(ctx, uid)
+	if err != nil && !auth.IsUserNotFound(err) {
+		return err
+	}
+
+	err = client.DeleteUser(ctx, uid)
+	if err != nil && !auth.IsUserNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type JobDTO struct {
+	ID          uuid.UUID                 `json:"id"`
+	Type        string                    `json:"type"`
+	Payload     json.RawMessage           `json:"payload" swaggertype:"object"`
+	Status      job_status_enum.JobStatus `json:"status"`
+	Attempts    int                       `json:"attempts"`
+	MaxAttempts int                       `json:"max_attempts"`
+	RunAt       int64                     `json:"run_at"`
+	LastError   string                    `json:"last_error,omitempty"`
+	CreatedAt   int64                     `json:"created_at"`
+	UpdatedAt   int64                     `json:"updated_at"`
+}
+
+// JobFilterDTO narrows the jobs listed on the admin endpoint. Empty fields
+// match every job.
+type JobFilterDTO struct {
+	Status job_status_enum.JobStatus
+	Type   string
+}
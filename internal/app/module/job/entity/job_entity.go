@@ -0,0 +1,34 @@
+package entity
+
+import (
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+
+	"github.com/google/uuid"
+)
+
+// Job is a unit of background work. While a job is running, RunAt is the end
+// of its lease; a job whose worker died is picked up again once it passes.
+// Payload is the JSON handed to the handler registered for Type.
+type Job struct {
+	ID          uuid.UUID                 `json:"id" gorm:"type:uuid;primaryKey"`
+	Type        string                    `json:"type" gorm:"type:varchar(100);not null;index"`
+	Payload     string                    `json:"payload" gorm:"type:jsonb;not null"`
+	Status      job_status_enum.JobStatus `json:"status" gorm:"type:varchar(20);not null;index:idx_jobs_due,priority:1"`
+	Attempts    int                       `json:"attempts" gorm:"not null;default:0"`
+	MaxAttempts int                       `json:"max_attempts" gorm:"not null"`
+	RunAt       int64                     `json:"run_at" gorm:"not null;index:idx_jobs_due,priority:2"`
+	LastError   string                    `json:"last_error" gorm:"type:text;not null;default:''"`
+	CreatedAt   int64                     `json:"created_at"`
+	UpdatedAt   int64                     `json:"updated_at"`
+}
+
+// JobSchedule enqueues a job of Type whenever its cron Spec comes due.
+type JobSchedule struct {
+	Name      string `json:"name" gorm:"type:varchar(100);primaryKey"`
+	Type      string `json:"type" gorm:"type:varchar(100);not null"`
+	Payload   string `json:"payload" gorm:"type:jsonb;not null"`
+	Spec      string `json:"spec" gorm:"type:varchar(100);not null"`
+	NextRunAt int64  `json:"next_run_at" gorm:"not null;index"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/job/dto"
+	"CodeWithAzri/internal/app/module/job/service"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var errInvalidJobStatus = errors.New("invalid job status")
+
+type Handler struct {
+	service  service.JobService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.JobService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// GetJobs godoc
+//
+//	@Summary		List background jobs
+//	@Tags			Admin
+//	@Description	List background jobs from newest to oldest, optionally filtered by status and type. Requires the admin claim.
+//	@ID				get-jobs
+//	@Accept			json
+//	@Produce		json
+//	@Param			status			query	string	false	"Job status: pending, running, succeeded or dead"
+//	@Param			type			query	string	false	"Job type"
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.JobDTO}	"Successful response with jobs"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/admin/jobs [get]
+func (h *Handler) GetJobs(w http.ResponseWriter, r *http.Request) {
+	filter := dto.JobFilterDTO{
+		Status: job_status_enum.JobStatus(requestPkg.GetQueryParam(r, "status")),
+		Type:   requestPkg.GetQueryParam(r, "type"),
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		response.RespondError(http.StatusBadRequest, errInvalidJobStatus, w)
+		return
+	}
+
+	limit, page := requestPkg.ParsePagination(r)
+
+	jobs, err := h.service.GetJobs(filter, limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Jobs Fetched Successfully", "Success", jobs, w)
+}
+
+// GetJob godoc
+//
+//	@Summary		Get a background job
+//	@Tags			Admin
+//	@Description	Fetch a background job with its payload and the error of its last attempt. Requires the admin claim.
+//	@ID				get-job
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Job ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.JobDTO}	"Successful response with the job"
+//	@Failure		400	{object}	response.ResponseError				"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError				"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError				"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError				"Job not found"
+//	@Failure		500	{object}	response.ResponseError				"Internal server error"
+//	@Router			/api/v1/admin/jobs/{id} [get]
+func (h *Handler) GetJob(w http.ResponseWriter, r *http.Request) {
+	jobID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	job, err := h.service.GetJob(jobID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Job Fetched Successfully", "Success", job, w)
+}
+
+// RetryJob godoc
+//
+//	@Summary		Retry a dead job
+//	@Tags			Admin
+//	@Description	Queue a job that ran out of attempts again, with a fresh set of attempts. Requires the admin claim.
+//	@ID				retry-job
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Job ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.JobDTO}	"Successful response with the queued job"
+//	@Failure		400	{object}	response.ResponseError				"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError				"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError				"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError				"Job not found"
+//	@Failure		409	{object}	response.ResponseError				"The job is not dead"
+//	@Failure		500	{object}	response.ResponseError				"Internal server error"
+//	@Router			/api/v1/admin/jobs/{id}/retry [post]
+func (h *Handler) RetryJob(w http.ResponseWriter, r *http.Request) {
+	jobID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	job, err := h.service.RetryJob(jobID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Job Queued Successfully", "Success", job, w)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrJobNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrJobNotRetryable):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
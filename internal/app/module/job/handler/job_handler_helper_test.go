@@ -0,0 +1,30 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/dto"
+	"encoding/json"
+	"net/http"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+)
+
+var MockJobDTO dto.JobDTO = dto.JobDTO{
+	ID:          uuid.MustParse("0b6f1d2e-3c4a-4e5b-8f6a-7b8c9d0e1f21"),
+	Type:        "email.send",
+	Payload:     json.RawMessage(`{"to":"student@example.com"}`),
+	Status:      "dead",
+	Attempts:    5,
+	MaxAttempts: 5,
+	RunAt:       121212,
+	LastError:   "connection refused",
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+func patchJobRequest(id string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return id
+	})
+}
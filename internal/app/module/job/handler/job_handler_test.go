@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/dto"
+	"CodeWithAzri/internal/app/module/job/handler"
+	"CodeWithAzri/internal/app/module/job/service"
+	"CodeWithAzri/internal/app/module/job/service/mocks"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.JobService) {
+	mockService := mocks.NewJobService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
+
+func TestHandler_GetJobs(t *testing.T) {
+	jobHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Jobs Successfully", func(t *testing.T) {
+		filter := dto.JobFilterDTO{Status: job_status_enum.Dead, Type: "email.send"}
+		mockService.On("GetJobs", filter, 20, 2).Return([]dto.JobDTO{MockJobDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs?status=dead&type=email.send&page=2&limit=20", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJobs(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"payload":{"to":"student@example.com"}`)
+		assert.Contains(t, recorder.Body.String(), `"last_error":"connection refused"`)
+	})
+
+	t.Run("Get Jobs Default Pagination", func(t *testing.T) {
+		mockService.On("GetJobs", dto.JobFilterDTO{}, 10, 1).Return([]dto.JobDTO{}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs?page=0&limit=abc", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJobs(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Jobs Invalid Status", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs?status=failed", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJobs(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Get Jobs Service Error", func(t *testing.T) {
+		mockService.On("GetJobs", dto.JobFilterDTO{}, 10, 1).Return(nil, errors.New("Service Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJobs(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_GetJob(t *testing.T) {
+	jobHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Job Successfully", func(t *testing.T) {
+		patchJobRequest(MockJobDTO.ID.String())
+		mockService.On("GetJob", MockJobDTO.ID).Return(MockJobDTO, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs/"+MockJobDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJob(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Job Not Found", func(t *testing.T) {
+		patchJobRequest(MockJobDTO.ID.String())
+		mockService.On("GetJob", MockJobDTO.ID).Return(dto.JobDTO{}, service.ErrJobNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs/"+MockJobDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJob(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Job Invalid ID", func(t *testing.T) {
+		patchJobRequest("invalid")
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/jobs/invalid", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.GetJob(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_RetryJob(t *testing.T) {
+	jobHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Retry Job Successfully", func(t *testing.T) {
+		patchJobRequest(MockJobDTO.ID.String())
+		retried := MockJobDTO
+		retried.Status = job_status_enum.Pending
+		retried.Attempts = 0
+		mockService.On("RetryJob", MockJobDTO.ID).Return(retried, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/jobs/"+MockJobDTO.ID.String()+"/retry", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.RetryJob(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"status":"pending"`)
+	})
+
+	t.Run("Retry Job Not Dead", func(t *testing.T) {
+		patchJobRequest(MockJobDTO.ID.String())
+		mockService.On("RetryJob", MockJobDTO.ID).Return(dto.JobDTO{}, service.ErrJobNotRetryable).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/jobs/"+MockJobDTO.ID.String()+"/retry", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.RetryJob(recorder, req)
+
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+
+	t.Run("Retry Job Invalid ID", func(t *testing.T) {
+		patchJobRequest("invalid")
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/jobs/invalid/retry", nil)
+		recorder := httptest.NewRecorder()
+		jobHandler.RetryJob(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type JobMigration struct{}
+
+func (m JobMigration) CreateJobTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.Job{},
+		entity.JobSchedule{},
+	)
+}
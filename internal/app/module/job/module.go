@@ -0,0 +1,37 @@
+package job
+
+import (
+	"CodeWithAzri/internal/app/module/job/handler"
+	"CodeWithAzri/internal/app/module/job/migration"
+	"CodeWithAzri/internal/app/module/job/repository"
+	"CodeWithAzri/internal/app/module/job/service"
+	"CodeWithAzri/internal/app/module/job/worker"
+	"database/sql"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.JobService
+	Repository repository.JobRepository
+	Migration  *migration.JobMigration
+	Worker     *worker.JobWorker
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewJobService(m.Repository)
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.JobMigration{}
+	m.Worker = worker.NewJobWorker(m.Service, 5*time.Second, 10)
+
+	err := m.Service.Schedule(service.PruneJobType, "0 3 * * *", service.PruneJobType, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
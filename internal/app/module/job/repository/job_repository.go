@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const jobColumns = `id, type, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at`
+
+// expiredLeaseError is recorded on jobs whose worker stopped during the last
+// attempt.
+const expiredLeaseError = "lease expired after the last attempt"
+
+type JobRepository interface {
+	Create(job entity.Job) error
+	ReadOne(id uuid.UUID) (entity.Job, error)
+	ReadMany(status job_status_enum.JobStatus, jobType string, limit int, offset int) ([]entity.Job, error)
+	Claim(types []string, now int64, leaseUntil int64, limit int) ([]entity.Job, error)
+	Renew(id uuid.UUID, attempts int, leaseUntil int64, updatedAt int64) (int64, error)
+	Complete(id uuid.UUID, attempts int, updatedAt int64) error
+	Fail(id uuid.UUID, attempts int, status job_status_enum.JobStatus, runAt int64, message string, updatedAt int64) error
+	Retry(id uuid.UUID, runAt int64, updatedAt int64) (int64, error)
+	DeleteSucceeded(before int64) (int64, error)
+	UpsertSchedule(schedule entity.JobSchedule) error
+	ReadDueSchedules(now int64) ([]entity.JobSchedule, error)
+	AdvanceSchedule(schedule entity.JobSchedule, nextRunAt int64, job entity.Job) (bool, error)
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) JobRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+const createJobQuery = `
+	INSERT INTO jobs (id, type, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+func (r *Repository) Create(job entity.Job) error {
+	_, err := r.db.Exec(createJobQuery, job.ID, job.Type, job.Payload, job.Status, job.Attempts, job.MaxAttempts,
+		job.RunAt, job.LastError, job.CreatedAt, job.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create job: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadOne(id uuid.UUID) (entity.Job, error) {
+	query := "SELECT " + jobColumns + " FROM jobs WHERE id = $1"
+
+	job, err := scanJob(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.Job{}, nil
+	}
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("failed to read job: %v", err)
+	}
+
+	return job, nil
+}
+
+// ReadMany lists jobs from newest to oldest. An empty status or type matches
+// every job.
+func (r *Repository) ReadMany(status job_status_enum.JobStatus, jobType string, limit int, offset int) ([]entity.Job, error) {
+	query := `
+		SELECT ` + jobColumns + ` FROM jobs
+		WHERE ($1 = '' OR status = $1) AND ($2 = '' OR type = $2)
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(query, status, jobType, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jobs: %v", err)
+	}
+	defer rows.Close()
+
+	return scanJobRows(rows)
+}
+
+// Claim leases up to limit due jobs of the given types until leaseUntil.
+// Running jobs whose lease has expired are claimed again, so work is not
+// lost when a worker dies, unless they already used their last attempt, in
+// which case they are marked dead instead. Concurrent workers skip each
+// other's rows.
+func (r *Repository) Claim(types []string, now int64, leaseUntil int64, limit int) ([]entity.Job, error) {
+	buryQuery := `
+		UPDATE jobs SET status = $1, last_error = $2, updated_at = $3
+		WHERE status = $4 AND run_at <= $3 AND attempts >= max_attempts AND type = ANY($5::text[])
+	`
+
+	_, err := r.db.Exec(buryQuery, job_status_enum.Dead, expiredLeaseError, now, job_status_enum.Running, pq.Array(types))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim jobs: %v", err)
+	}
+
+	query := `
+		UPDATE jobs SET status = $1, run_at = $2, attempts = attempts + 1, updated_at = $3
+		WHERE id IN (
+			SELECT id FROM jobs
+			WHERE status IN ($4, $1) AND run_at <= $3 AND attempts < max_attempts AND type = ANY($5::text[])
+			ORDER BY run_at
+			LIMIT $6
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns
+
+	rows, err := r.db.Query(query, job_status_enum.Running, leaseUntil, now, job_status_enum.Pending, pq.Array(types), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim jobs: %v", err)
+	}
+	defer rows.Close()
+
+	return scanJobRows(rows)
+}
+
+// Renew extends the lease of a claimed job until leaseUntil. It returns 0
+// when the lease already ran out and another worker claimed the job again.
+func (r *Repository) Renew(id uuid.UUID, attempts int, leaseUntil int64, updatedAt int64) (int64, error) {
+	query := `
+		UPDATE jobs SET run_at = $1, updated_at = $2
+		WHERE id = $3 AND attempts = $4 AND status = $5
+	`
+
+	result, err := r.db.Exec(query, leaseUntil, updatedAt, id, attempts, job_status_enum.Running)
+	if err != nil {
+		return 0, fmt.Errorf("failed to renew job lease: %v", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to renew job lease: %v", err)
+	}
+
+	return affected, nil
+}
+
+// Complete marks a job as succeeded. attempts identifies the claim, so a
+// worker whose lease expired cannot overwrite the outcome of a later one.
+func (r *Repository) Complete(id uuid.UUID, attempts int, updatedAt int64) error {
+	query := `
+		UPDATE jobs SET status = $1, last_error = '', updated_at = $2
+		WHERE id = $3 AND attempts = $4 AND status = $5
+	`
+
+	_, err := r.db.Exec(query, job_status_enum.Succeeded, updatedAt, id, attempts, job_status_enum.Running)
+	if err != nil {
+		return fmt.Errorf("failed to complete job: %v", err)
+	}
+
+	return nil
+}
+
+// Fail records why a claimed job failed and either schedules it again at
+// runAt or, with status dead, gives up on it.
+func (r *Repository) Fail(id uuid.UUID, attempts int, status job_status_enum.JobStatus, runAt int64, message string, updatedAt int64) error {
+	query := `
+		UPDATE jobs SET status = $1, run_at = $2, last_error = $3, updated_at = $4
+		WHERE id = $5 AND attempts = $6 AND status = $7
+	`
+
+	_, err := r.db.Exec(query, status, runAt, message, updatedAt, id, attempts, job_status_enum.Running)
+	if err != nil {
+		return fmt.Errorf("failed to record job failure: %v", err)
+	}
+
+	return nil
+}
+
+// Retry gives a dead job a fresh set of attempts. It returns the number of
+// jobs that were dead.
+func (r *Repository) Retry(id uuid.UUID, runAt int64, updatedAt int64) (int64, error) {
+	query := `
+		UPDATE jobs SET status = $1, attempts = 0, run_at = $2, updated_at = $3
+		WHERE id = $4 AND status = $5
+	`
+
+	result, err := r.db.Exec(query, job_status_enum.Pending, runAt, updatedAt, id, job_status_enum.Dead)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retry job: %v", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retry job: %v", err)
+	}
+
+	return affected, nil
+}
+
+// DeleteSucceeded removes jobs that succeeded before the given time. Dead
+// jobs are kept until an admin retries them.
+func (r *Repository) DeleteSucceeded(before int64) (int64, error) {
+	result, err := r.db.Exec("DELETE FROM jobs WHERE status = $1 AND updated_at < $2", job_status_enum.Succeeded, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete succeeded jobs: %v", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete succeeded jobs: %v", err)
+	}
+
+	return affected, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanJob(row rowScanner) (entity.Job, error) {
+	var job entity.Job
+	err := row.Scan(
+		&job.ID, &job.Type, &job.Payload, &job.Status, &job.Attempts, &job.MaxAttempts,
+		&job.RunAt, &job.LastError, &job.CreatedAt, &job.UpdatedAt,
+	)
+	return job, err
+}
+
+func scanJobRows(rows *sql.Rows) ([]entity.Job, error) {
+	jobs := make([]entity.Job, 0)
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job: %v", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	createJobQuery        = "INSERT INTO jobs (id, type, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)"
+	readJobQuery          = "SELECT id, type, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at FROM jobs WHERE id = $1"
+	readJobsQuery         = "SELECT id, type, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at FROM jobs WHERE ($1 = '' OR status = $1) AND ($2 = '' OR type = $2) ORDER BY created_at DESC LIMIT $3 OFFSET $4"
+	buryJobsQuery         = "UPDATE jobs SET status = $1, last_error = $2, updated_at = $3 WHERE status = $4 AND run_at <= $3 AND attempts >= max_attempts AND type = ANY($5::text[])"
+	claimJobsQuery        = "UPDATE jobs SET status = $1, run_at = $2, attempts = attempts + 1, updated_at = $3 WHERE id IN ( SELECT id FROM jobs WHERE status IN ($4, $1) AND run_at <= $3 AND attempts < max_attempts AND type = ANY($5::text[]) ORDER BY run_at LIMIT $6 FOR UPDATE SKIP LOCKED ) RETURNING id, type, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at"
+	renewJobQuery         = "UPDATE jobs SET run_at = $1, updated_at = $2 WHERE id = $3 AND attempts = $4 AND status = $5"
+	completeJobQuery      = "UPDATE jobs SET status = $1, last_error = '', updated_at = $2 WHERE id = $3 AND attempts = $4 AND status = $5"
+	failJobQuery          = "UPDATE jobs SET status = $1, run_at = $2, last_error = $3, updated_at = $4 WHERE id = $5 AND attempts = $6 AND status = $7"
+	retryJobQuery         = "UPDATE jobs SET status = $1, attempts = 0, run_at = $2, updated_at = $3 WHERE id = $4 AND status = $5"
+	deleteSucceededQuery  = "DELETE FROM jobs WHERE status = $1 AND updated_at < $2"
+	upsertScheduleQuery   = "INSERT INTO job_schedules (name, type, payload, spec, next_run_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (name) DO UPDATE SET type = $2, payload = $3, spec = $4, next_run_at = CASE WHEN job_schedules.spec = $4 THEN job_schedules.next_run_at ELSE $5 END, updated_at = $7"
+	readDueSchedulesQuery = "SELECT name, type, payload, spec, next_run_at, created_at, updated_at FROM job_schedules WHERE next_run_at <= $1 ORDER BY next_run_at"
+	advanceScheduleQuery  = "UPDATE job_schedules SET next_run_at = $1, updated_at = $2 WHERE name = $3 AND next_run_at = $4"
+)
+
+var MockJob entity.Job = entity.Job{
+	ID:          uuid.MustParse("0b6f1d2e-3c4a-4e5b-8f6a-7b8c9d0e1f21"),
+	Type:        "email.send",
+	Payload:     `{"to":"student@example.com"}`,
+	Status:      "pending",
+	Attempts:    0,
+	MaxAttempts: 5,
+	RunAt:       121212,
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+var MockSchedule entity.JobSchedule = entity.JobSchedule{
+	Name:      "jobs.prune",
+	Type:      "jobs.prune",
+	Payload:   "null",
+	Spec:      "0 3 * * *",
+	NextRunAt: 121212,
+	CreatedAt: 121212,
+	UpdatedAt: 121212,
+}
+
+func prepareJobRows(jobs ...entity.Job) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "type", "payload", "status", "attempts", "max_attempts", "run_at", "last_error", "created_at", "updated_at"})
+	for _, job := range jobs {
+		rows.AddRow(job.ID, job.Type, job.Payload, job.Status, job.Attempts, job.MaxAttempts, job.RunAt, job.LastError, job.CreatedAt, job.UpdatedAt)
+	}
+	return rows
+}
@@ -0,0 +1,265 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/repository"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.JobRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	j := MockJob
+
+	t.Run("Create Success", func(t *testing.T) {
+		mock.ExpectExec(createJobQuery).WithArgs(j.ID, j.Type, j.Payload, string(j.Status), j.Attempts, j.MaxAttempts, j.RunAt, j.LastError, j.CreatedAt, j.UpdatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Create(j))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		mock.ExpectExec(createJobQuery).WillReturnError(errors.New("insert failed"))
+
+		err := repo.Create(j)
+
+		assert.EqualError(t, err, "failed to create job: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReadOne(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read One Success", func(t *testing.T) {
+		mock.ExpectQuery(readJobQuery).WithArgs(MockJob.ID).WillReturnRows(prepareJobRows(MockJob))
+
+		job, err := repo.ReadOne(MockJob.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockJob, job)
+	})
+
+	t.Run("Read One Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readJobQuery).WithArgs(MockJob.ID).WillReturnRows(prepareJobRows())
+
+		job, err := repo.ReadOne(MockJob.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, job.ID)
+	})
+
+	t.Run("Read One Error", func(t *testing.T) {
+		mock.ExpectQuery(readJobQuery).WithArgs(MockJob.ID).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadOne(MockJob.ID)
+
+		assert.EqualError(t, err, "failed to read job: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadMany(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Many Success", func(t *testing.T) {
+		mock.ExpectQuery(readJobsQuery).WithArgs("dead", "email.send", 10, 20).WillReturnRows(prepareJobRows(MockJob))
+
+		jobs, err := repo.ReadMany(job_status_enum.Dead, "email.send", 10, 20)
+
+		assert.NoError(t, err)
+		assert.Len(t, jobs, 1)
+	})
+
+	t.Run("Read Many Error", func(t *testing.T) {
+		mock.ExpectQuery(readJobsQuery).WithArgs("", "", 10, 0).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadMany("", "", 10, 0)
+
+		assert.EqualError(t, err, "failed to read jobs: query failed")
+	})
+
+	t.Run("Read Many Scan Error", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id"}).AddRow("invalid")
+		mock.ExpectQuery(readJobsQuery).WithArgs("", "", 10, 0).WillReturnRows(rows)
+
+		_, err := repo.ReadMany("", "", 10, 0)
+
+		assert.ErrorContains(t, err, "failed to scan job")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Claim(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	types := []string{"email.send", "jobs.prune"}
+
+	t.Run("Claim Success", func(t *testing.T) {
+		claimed := MockJob
+		claimed.Status = job_status_enum.Running
+		claimed.Attempts = 1
+		mock.ExpectExec(buryJobsQuery).WithArgs("dead", "lease expired after the last attempt", int64(1000), "running", pq.Array(types)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(claimJobsQuery).WithArgs("running", int64(600000), int64(1000), "pending", pq.Array(types), 10).WillReturnRows(prepareJobRows(claimed))
+
+		jobs, err := repo.Claim(types, 1000, 600000, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, jobs[0].Attempts)
+	})
+
+	t.Run("Claim Buries Exhausted Expired Leases", func(t *testing.T) {
+		mock.ExpectExec(buryJobsQuery).WithArgs("dead", "lease expired after the last attempt", int64(1000), "running", pq.Array(types)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(claimJobsQuery).WithArgs("running", int64(600000), int64(1000), "pending", pq.Array(types), 10).WillReturnRows(sqlmock.NewRows([]string{"id", "type", "payload", "status", "attempts", "max_attempts", "run_at", "last_error", "created_at", "updated_at"}))
+
+		jobs, err := repo.Claim(types, 1000, 600000, 10)
+
+		assert.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("Claim Bury Error", func(t *testing.T) {
+		mock.ExpectExec(buryJobsQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.Claim(types, 1000, 600000, 10)
+
+		assert.EqualError(t, err, "failed to claim jobs: update failed")
+	})
+
+	t.Run("Claim Error", func(t *testing.T) {
+		mock.ExpectExec(buryJobsQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(claimJobsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.Claim(types, 1000, 600000, 10)
+
+		assert.EqualError(t, err, "failed to claim jobs: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Renew(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Renew Lease", func(t *testing.T) {
+		mock.ExpectExec(renewJobQuery).WithArgs(int64(731313), int64(131313), MockJob.ID, 2, "running").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		renewed, err := repo.Renew(MockJob.ID, 2, 731313, 131313)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), renewed)
+	})
+
+	t.Run("Renew Lease Claimed Elsewhere", func(t *testing.T) {
+		mock.ExpectExec(renewJobQuery).WithArgs(int64(731313), int64(131313), MockJob.ID, 2, "running").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		renewed, err := repo.Renew(MockJob.ID, 2, 731313, 131313)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), renewed)
+	})
+
+	t.Run("Renew Lease Error", func(t *testing.T) {
+		mock.ExpectExec(renewJobQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.Renew(MockJob.ID, 2, 731313, 131313)
+
+		assert.EqualError(t, err, "failed to renew job lease: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Complete(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(completeJobQuery).WithArgs("succeeded", int64(131313), MockJob.ID, 2, "running").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Complete(MockJob.ID, 2, 131313))
+
+	mock.ExpectExec(completeJobQuery).WillReturnError(errors.New("update failed"))
+	assert.EqualError(t, repo.Complete(MockJob.ID, 2, 131313), "failed to complete job: update failed")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Fail(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(failJobQuery).WithArgs("pending", int64(161313), "timeout", int64(131313), MockJob.ID, 2, "running").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Fail(MockJob.ID, 2, job_status_enum.Pending, 161313, "timeout", 131313))
+
+	mock.ExpectExec(failJobQuery).WillReturnError(errors.New("update failed"))
+	assert.EqualError(t, repo.Fail(MockJob.ID, 2, job_status_enum.Dead, 161313, "timeout", 131313), "failed to record job failure: update failed")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Retry(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Retry Dead Job", func(t *testing.T) {
+		mock.ExpectExec(retryJobQuery).WithArgs("pending", int64(131313), int64(131313), MockJob.ID, "dead").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		retried, err := repo.Retry(MockJob.ID, 131313, 131313)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), retried)
+	})
+
+	t.Run("Retry Error", func(t *testing.T) {
+		mock.ExpectExec(retryJobQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.Retry(MockJob.ID, 131313, 131313)
+
+		assert.EqualError(t, err, "failed to retry job: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteSucceeded(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(deleteSucceededQuery).WithArgs("succeeded", int64(121212)).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	deleted, err := repo.DeleteSucceeded(121212)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	mock.ExpectExec(deleteSucceededQuery).WillReturnError(errors.New("delete failed"))
+
+	_, err = repo.DeleteSucceeded(121212)
+
+	assert.EqualError(t, err, "failed to delete succeeded jobs: delete failed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
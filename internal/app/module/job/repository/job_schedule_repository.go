@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	"fmt"
+)
+
+// UpsertSchedule registers a schedule or updates its job. The next run is
+// only moved when the cron spec changed, so restarts do not delay it.
+func (r *Repository) UpsertSchedule(schedule entity.JobSchedule) error {
+	query := `
+		INSERT INTO job_schedules (name, type, payload, spec, next_run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (name) DO UPDATE SET type = $2, payload = $3, spec = $4,
+			next_run_at = CASE WHEN job_schedules.spec = $4 THEN job_schedules.next_run_at ELSE $5 END,
+			updated_at = $7
+	`
+
+	_, err := r.db.Exec(query, schedule.Name, schedule.Type, schedule.Payload, schedule.Spec, schedule.NextRunAt,
+		schedule.CreatedAt, schedule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save job schedule: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadDueSchedules(now int64) ([]entity.JobSchedule, error) {
+	query := `
+		SELECT name, type, payload, spec, next_run_at, created_at, updated_at
+		FROM job_schedules
+		WHERE next_run_at <= $1
+		ORDER BY next_run_at
+	`
+
+	rows, err := r.db.Query(query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read due job schedules: %v", err)
+	}
+	defer rows.Close()
+
+	schedules := make([]entity.JobSchedule, 0)
+	for rows.Next() {
+		var schedule entity.JobSchedule
+		err = rows.Scan(&schedule.Name, &schedule.Type, &schedule.Payload, &schedule.Spec, &schedule.NextRunAt,
+			&schedule.CreatedAt, &schedule.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job schedule: %v", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// AdvanceSchedule moves a due schedule to nextRunAt and enqueues its job in
+// the same transaction. It returns false when another instance advanced the
+// schedule first, in which case nothing is enqueued.
+func (r *Repository) AdvanceSchedule(schedule entity.JobSchedule, nextRunAt int64, job entity.Job) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result, err := tx.Exec("UPDATE job_schedules SET next_run_at = $1, updated_at = $2 WHERE name = $3 AND next_run_at = $4",
+		nextRunAt, job.CreatedAt, schedule.Name, schedule.NextRunAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to advance job schedule: %v", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to advance job schedule: %v", err)
+	}
+
+	if affected == 0 {
+		err = tx.Rollback()
+		if err != nil {
+			return false, fmt.Errorf("failed to rollback transaction: %v", err)
+		}
+		return false, nil
+	}
+
+	_, err = tx.Exec(createJobQuery, job.ID, job.Type, job.Payload, job.Status, job.Attempts, job.MaxAttempts,
+		job.RunAt, job.LastError, job.CreatedAt, job.UpdatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to create job: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return true, nil
+}
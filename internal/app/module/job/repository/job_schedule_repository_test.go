@@ -0,0 +1,112 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_UpsertSchedule(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	s := MockSchedule
+
+	mock.ExpectExec(upsertScheduleQuery).WithArgs(s.Name, s.Type, s.Payload, s.Spec, s.NextRunAt, s.CreatedAt, s.UpdatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.UpsertSchedule(s))
+
+	mock.ExpectExec(upsertScheduleQuery).WillReturnError(errors.New("insert failed"))
+	assert.EqualError(t, repo.UpsertSchedule(s), "failed to save job schedule: insert failed")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadDueSchedules(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Due Schedules Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"name", "type", "payload", "spec", "next_run_at", "created_at", "updated_at"}).
+			AddRow(MockSchedule.Name, MockSchedule.Type, MockSchedule.Payload, MockSchedule.Spec, MockSchedule.NextRunAt, MockSchedule.CreatedAt, MockSchedule.UpdatedAt)
+		mock.ExpectQuery(readDueSchedulesQuery).WithArgs(int64(131313)).WillReturnRows(rows)
+
+		schedules, err := repo.ReadDueSchedules(131313)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockSchedule, schedules[0])
+	})
+
+	t.Run("Read Due Schedules Error", func(t *testing.T) {
+		mock.ExpectQuery(readDueSchedulesQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadDueSchedules(131313)
+
+		assert.EqualError(t, err, "failed to read due job schedules: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AdvanceSchedule(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	j := MockJob
+
+	t.Run("Advance Schedule Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(advanceScheduleQuery).WithArgs(int64(212121), j.CreatedAt, MockSchedule.Name, MockSchedule.NextRunAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createJobQuery).WithArgs(j.ID, j.Type, j.Payload, string(j.Status), j.Attempts, j.MaxAttempts, j.RunAt, j.LastError, j.CreatedAt, j.UpdatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		advanced, err := repo.AdvanceSchedule(MockSchedule, 212121, j)
+
+		assert.NoError(t, err)
+		assert.True(t, advanced)
+	})
+
+	t.Run("Advance Schedule Taken By Another Instance", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(advanceScheduleQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		advanced, err := repo.AdvanceSchedule(MockSchedule, 212121, j)
+
+		assert.NoError(t, err)
+		assert.False(t, advanced)
+	})
+
+	t.Run("Advance Schedule Create Job Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(advanceScheduleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createJobQuery).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		_, err := repo.AdvanceSchedule(MockSchedule, 212121, j)
+
+		assert.EqualError(t, err, "failed to create job: insert failed")
+	})
+
+	t.Run("Advance Schedule Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("begin failed"))
+
+		_, err := repo.AdvanceSchedule(MockSchedule, 212121, j)
+
+		assert.EqualError(t, err, "failed to begin transaction: begin failed")
+	})
+
+	t.Run("Advance Schedule Commit Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(advanceScheduleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createJobQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit().WillReturnError(errors.New("commit failed"))
+
+		_, err := repo.AdvanceSchedule(MockSchedule, 212121, j)
+
+		assert.EqualError(t, err, "failed to commit transaction: commit failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,697 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/job/entity"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// JobRepository is an autogenerated mock type for the JobRepository type
+type JobRepository struct {
+	mock.Mock
+}
+
+type JobRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobRepository) EXPECT() *JobRepository_Expecter {
+	return &JobRepository_Expecter{mock: &_m.Mock}
+}
+
+// AdvanceSchedule provides a mock function with given fields: schedule, nextRunAt, job
+func (_m *JobRepository) AdvanceSchedule(schedule entity.JobSchedule, nextRunAt int64, job entity.Job) (bool, error) {
+	ret := _m.Called(schedule, nextRunAt, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdvanceSchedule")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.JobSchedule, int64, entity.Job) (bool, error)); ok {
+		return rf(schedule, nextRunAt, job)
+	}
+	if rf, ok := ret.Get(0).(func(entity.JobSchedule, int64, entity.Job) bool); ok {
+		r0 = rf(schedule, nextRunAt, job)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.JobSchedule, int64, entity.Job) error); ok {
+		r1 = rf(schedule, nextRunAt, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_AdvanceSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdvanceSchedule'
+type JobRepository_AdvanceSchedule_Call struct {
+	*mock.Call
+}
+
+// AdvanceSchedule is a helper method to define mock.On call
+//   - schedule entity.JobSchedule
+//   - nextRunAt int64
+//   - job entity.Job
+func (_e *JobRepository_Expecter) AdvanceSchedule(schedule interface{}, nextRunAt interface{}, job interface{}) *JobRepository_AdvanceSchedule_Call {
+	return &JobRepository_AdvanceSchedule_Call{Call: _e.mock.On("AdvanceSchedule", schedule, nextRunAt, job)}
+}
+
+func (_c *JobRepository_AdvanceSchedule_Call) Run(run func(schedule entity.JobSchedule, nextRunAt int64, job entity.Job)) *JobRepository_AdvanceSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.JobSchedule), args[1].(int64), args[2].(entity.Job))
+	})
+	return _c
+}
+
+func (_c *JobRepository_AdvanceSchedule_Call) Return(_a0 bool, _a1 error) *JobRepository_AdvanceSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_AdvanceSchedule_Call) RunAndReturn(run func(entity.JobSchedule, int64, entity.Job) (bool, error)) *JobRepository_AdvanceSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Claim provides a mock function with given fields: types, now, leaseUntil, limit
+func (_m *JobRepository) Claim(types []string, now int64, leaseUntil int64, limit int) ([]entity.Job, error) {
+	ret := _m.Called(types, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 []entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, int64, int64, int) ([]entity.Job, error)); ok {
+		return rf(types, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func([]string, int64, int64, int) []entity.Job); ok {
+		r0 = rf(types, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, int64, int64, int) error); ok {
+		r1 = rf(types, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type JobRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - types []string
+//   - now int64
+//   - leaseUntil int64
+//   - limit int
+func (_e *JobRepository_Expecter) Claim(types interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *JobRepository_Claim_Call {
+	return &JobRepository_Claim_Call{Call: _e.mock.On("Claim", types, now, leaseUntil, limit)}
+}
+
+func (_c *JobRepository_Claim_Call) Run(run func(types []string, now int64, leaseUntil int64, limit int)) *JobRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(int64), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *JobRepository_Claim_Call) Return(_a0 []entity.Job, _a1 error) *JobRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_Claim_Call) RunAndReturn(run func([]string, int64, int64, int) ([]entity.Job, error)) *JobRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function with given fields: id, attempts, updatedAt
+func (_m *JobRepository) Complete(id uuid.UUID, attempts int, updatedAt int64) error {
+	ret := _m.Called(id, attempts, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int64) error); ok {
+		r0 = rf(id, attempts, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type JobRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - attempts int
+//   - updatedAt int64
+func (_e *JobRepository_Expecter) Complete(id interface{}, attempts interface{}, updatedAt interface{}) *JobRepository_Complete_Call {
+	return &JobRepository_Complete_Call{Call: _e.mock.On("Complete", id, attempts, updatedAt)}
+}
+
+func (_c *JobRepository_Complete_Call) Run(run func(id uuid.UUID, attempts int, updatedAt int64)) *JobRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRepository_Complete_Call) Return(_a0 error) *JobRepository_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepository_Complete_Call) RunAndReturn(run func(uuid.UUID, int, int64) error) *JobRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: job
+func (_m *JobRepository) Create(job entity.Job) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Job) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type JobRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - job entity.Job
+func (_e *JobRepository_Expecter) Create(job interface{}) *JobRepository_Create_Call {
+	return &JobRepository_Create_Call{Call: _e.mock.On("Create", job)}
+}
+
+func (_c *JobRepository_Create_Call) Run(run func(job entity.Job)) *JobRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Job))
+	})
+	return _c
+}
+
+func (_c *JobRepository_Create_Call) Return(_a0 error) *JobRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepository_Create_Call) RunAndReturn(run func(entity.Job) error) *JobRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSucceeded provides a mock function with given fields: before
+func (_m *JobRepository) DeleteSucceeded(before int64) (int64, error) {
+	ret := _m.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSucceeded")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, error)); ok {
+		return rf(before)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_DeleteSucceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSucceeded'
+type JobRepository_DeleteSucceeded_Call struct {
+	*mock.Call
+}
+
+// DeleteSucceeded is a helper method to define mock.On call
+//   - before int64
+func (_e *JobRepository_Expecter) DeleteSucceeded(before interface{}) *JobRepository_DeleteSucceeded_Call {
+	return &JobRepository_DeleteSucceeded_Call{Call: _e.mock.On("DeleteSucceeded", before)}
+}
+
+func (_c *JobRepository_DeleteSucceeded_Call) Run(run func(before int64)) *JobRepository_DeleteSucceeded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRepository_DeleteSucceeded_Call) Return(_a0 int64, _a1 error) *JobRepository_DeleteSucceeded_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_DeleteSucceeded_Call) RunAndReturn(run func(int64) (int64, error)) *JobRepository_DeleteSucceeded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function with given fields: id, attempts, status, runAt, message, updatedAt
+func (_m *JobRepository) Fail(id uuid.UUID, attempts int, status job_status_enum.JobStatus, runAt int64, message string, updatedAt int64) error {
+	ret := _m.Called(id, attempts, status, runAt, message, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, job_status_enum.JobStatus, int64, string, int64) error); ok {
+		r0 = rf(id, attempts, status, runAt, message, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepository_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type JobRepository_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - attempts int
+//   - status job_status_enum.JobStatus
+//   - runAt int64
+//   - message string
+//   - updatedAt int64
+func (_e *JobRepository_Expecter) Fail(id interface{}, attempts interface{}, status interface{}, runAt interface{}, message interface{}, updatedAt interface{}) *JobRepository_Fail_Call {
+	return &JobRepository_Fail_Call{Call: _e.mock.On("Fail", id, attempts, status, runAt, message, updatedAt)}
+}
+
+func (_c *JobRepository_Fail_Call) Run(run func(id uuid.UUID, attempts int, status job_status_enum.JobStatus, runAt int64, message string, updatedAt int64)) *JobRepository_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(job_status_enum.JobStatus), args[3].(int64), args[4].(string), args[5].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRepository_Fail_Call) Return(_a0 error) *JobRepository_Fail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepository_Fail_Call) RunAndReturn(run func(uuid.UUID, int, job_status_enum.JobStatus, int64, string, int64) error) *JobRepository_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDueSchedules provides a mock function with given fields: now
+func (_m *JobRepository) ReadDueSchedules(now int64) ([]entity.JobSchedule, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDueSchedules")
+	}
+
+	var r0 []entity.JobSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]entity.JobSchedule, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []entity.JobSchedule); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.JobSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_ReadDueSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDueSchedules'
+type JobRepository_ReadDueSchedules_Call struct {
+	*mock.Call
+}
+
+// ReadDueSchedules is a helper method to define mock.On call
+//   - now int64
+func (_e *JobRepository_Expecter) ReadDueSchedules(now interface{}) *JobRepository_ReadDueSchedules_Call {
+	return &JobRepository_ReadDueSchedules_Call{Call: _e.mock.On("ReadDueSchedules", now)}
+}
+
+func (_c *JobRepository_ReadDueSchedules_Call) Run(run func(now int64)) *JobRepository_ReadDueSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRepository_ReadDueSchedules_Call) Return(_a0 []entity.JobSchedule, _a1 error) *JobRepository_ReadDueSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_ReadDueSchedules_Call) RunAndReturn(run func(int64) ([]entity.JobSchedule, error)) *JobRepository_ReadDueSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMany provides a mock function with given fields: status, jobType, limit, offset
+func (_m *JobRepository) ReadMany(status job_status_enum.JobStatus, jobType string, limit int, offset int) ([]entity.Job, error) {
+	ret := _m.Called(status, jobType, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMany")
+	}
+
+	var r0 []entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(job_status_enum.JobStatus, string, int, int) ([]entity.Job, error)); ok {
+		return rf(status, jobType, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(job_status_enum.JobStatus, string, int, int) []entity.Job); ok {
+		r0 = rf(status, jobType, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(job_status_enum.JobStatus, string, int, int) error); ok {
+		r1 = rf(status, jobType, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
+type JobRepository_ReadMany_Call struct {
+	*mock.Call
+}
+
+// ReadMany is a helper method to define mock.On call
+//   - status job_status_enum.JobStatus
+//   - jobType string
+//   - limit int
+//   - offset int
+func (_e *JobRepository_Expecter) ReadMany(status interface{}, jobType interface{}, limit interface{}, offset interface{}) *JobRepository_ReadMany_Call {
+	return &JobRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", status, jobType, limit, offset)}
+}
+
+func (_c *JobRepository_ReadMany_Call) Run(run func(status job_status_enum.JobStatus, jobType string, limit int, offset int)) *JobRepository_ReadMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(job_status_enum.JobStatus), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *JobRepository_ReadMany_Call) Return(_a0 []entity.Job, _a1 error) *JobRepository_ReadMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_ReadMany_Call) RunAndReturn(run func(job_status_enum.JobStatus, string, int, int) ([]entity.Job, error)) *JobRepository_ReadMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOne provides a mock function with given fields: id
+func (_m *JobRepository) ReadOne(id uuid.UUID) (entity.Job, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOne")
+	}
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.Job, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.Job); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_ReadOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOne'
+type JobRepository_ReadOne_Call struct {
+	*mock.Call
+}
+
+// ReadOne is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *JobRepository_Expecter) ReadOne(id interface{}) *JobRepository_ReadOne_Call {
+	return &JobRepository_ReadOne_Call{Call: _e.mock.On("ReadOne", id)}
+}
+
+func (_c *JobRepository_ReadOne_Call) Run(run func(id uuid.UUID)) *JobRepository_ReadOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JobRepository_ReadOne_Call) Return(_a0 entity.Job, _a1 error) *JobRepository_ReadOne_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_ReadOne_Call) RunAndReturn(run func(uuid.UUID) (entity.Job, error)) *JobRepository_ReadOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Renew provides a mock function with given fields: id, attempts, leaseUntil, updatedAt
+func (_m *JobRepository) Renew(id uuid.UUID, attempts int, leaseUntil int64, updatedAt int64) (int64, error) {
+	ret := _m.Called(id, attempts, leaseUntil, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renew")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int64, int64) (int64, error)); ok {
+		return rf(id, attempts, leaseUntil, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int64, int64) int64); ok {
+		r0 = rf(id, attempts, leaseUntil, updatedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int64, int64) error); ok {
+		r1 = rf(id, attempts, leaseUntil, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_Renew_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Renew'
+type JobRepository_Renew_Call struct {
+	*mock.Call
+}
+
+// Renew is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - attempts int
+//   - leaseUntil int64
+//   - updatedAt int64
+func (_e *JobRepository_Expecter) Renew(id interface{}, attempts interface{}, leaseUntil interface{}, updatedAt interface{}) *JobRepository_Renew_Call {
+	return &JobRepository_Renew_Call{Call: _e.mock.On("Renew", id, attempts, leaseUntil, updatedAt)}
+}
+
+func (_c *JobRepository_Renew_Call) Run(run func(id uuid.UUID, attempts int, leaseUntil int64, updatedAt int64)) *JobRepository_Renew_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRepository_Renew_Call) Return(_a0 int64, _a1 error) *JobRepository_Renew_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_Renew_Call) RunAndReturn(run func(uuid.UUID, int, int64, int64) (int64, error)) *JobRepository_Renew_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: id, runAt, updatedAt
+func (_m *JobRepository) Retry(id uuid.UUID, runAt int64, updatedAt int64) (int64, error) {
+	ret := _m.Called(id, runAt, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64, int64) (int64, error)); ok {
+		return rf(id, runAt, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64, int64) int64); ok {
+		r0 = rf(id, runAt, updatedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int64, int64) error); ok {
+		r1 = rf(id, runAt, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type JobRepository_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - runAt int64
+//   - updatedAt int64
+func (_e *JobRepository_Expecter) Retry(id interface{}, runAt interface{}, updatedAt interface{}) *JobRepository_Retry_Call {
+	return &JobRepository_Retry_Call{Call: _e.mock.On("Retry", id, runAt, updatedAt)}
+}
+
+func (_c *JobRepository_Retry_Call) Run(run func(id uuid.UUID, runAt int64, updatedAt int64)) *JobRepository_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *JobRepository_Retry_Call) Return(_a0 int64, _a1 error) *JobRepository_Retry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_Retry_Call) RunAndReturn(run func(uuid.UUID, int64, int64) (int64, error)) *JobRepository_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertSchedule provides a mock function with given fields: schedule
+func (_m *JobRepository) UpsertSchedule(schedule entity.JobSchedule) error {
+	ret := _m.Called(schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.JobSchedule) error); ok {
+		r0 = rf(schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepository_UpsertSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertSchedule'
+type JobRepository_UpsertSchedule_Call struct {
+	*mock.Call
+}
+
+// UpsertSchedule is a helper method to define mock.On call
+//   - schedule entity.JobSchedule
+func (_e *JobRepository_Expecter) UpsertSchedule(schedule interface{}) *JobRepository_UpsertSchedule_Call {
+	return &JobRepository_UpsertSchedule_Call{Call: _e.mock.On("UpsertSchedule", schedule)}
+}
+
+func (_c *JobRepository_UpsertSchedule_Call) Run(run func(schedule entity.JobSchedule)) *JobRepository_UpsertSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.JobSchedule))
+	})
+	return _c
+}
+
+func (_c *JobRepository_UpsertSchedule_Call) Return(_a0 error) *JobRepository_UpsertSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepository_UpsertSchedule_Call) RunAndReturn(run func(entity.JobSchedule) error) *JobRepository_UpsertSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobRepository creates a new instance of JobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRepository {
+	mock := &JobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	// jobTimeout bounds a single run. Each job's lease is renewed right
+	// before it runs and is longer than the timeout, so a job that times out
+	// is marked failed before anyone else can claim it.
+	jobTimeout = 5 * time.Minute
+	jobLease   = 2 * jobTimeout
+
+	retryDelay    = 30 * time.Second
+	maxRetryDelay = time.Hour
+
+	// PruneJobType deletes succeeded jobs once they are older than
+	// succeededJobRetention.
+	PruneJobType          = "jobs.prune"
+	succeededJobRetention = 7 * 24 * time.Hour
+)
+
+// RunJobs claims up to limit due jobs of the registered types and runs them
+// one after another. Jobs later in the batch may have outlived the lease of
+// the claim, so each one is skipped if another worker has claimed it since.
+// It returns how many jobs were claimed.
+func (s *Service) RunJobs(limit int) (int, error) {
+	types := s.registeredTypes()
+	if len(types) == 0 {
+		return 0, nil
+	}
+
+	now := timepkg.NowUnixMilli()
+	jobs, err := s.repository.Claim(types, now, now+jobLease.Milliseconds(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, job := range jobs {
+		now = timepkg.NowUnixMilli()
+		renewed, err := s.repository.Renew(job.ID, job.Attempts, now+jobLease.Milliseconds(), now)
+		if err != nil {
+			log.Printf("failed to renew lease of job %s: %v\n", job.ID, err)
+			continue
+		}
+		if renewed == 0 {
+			log.Printf("job %s was claimed by another worker, skipping it\n", job.ID)
+			continue
+		}
+
+		err = s.runJob(job)
+		if err != nil {
+			log.Printf("failed to record outcome of job %s: %v\n", job.ID, err)
+		}
+	}
+
+	return len(jobs), nil
+}
+
+func (s *Service) runJob(job entity.Job) error {
+	s.mu.RLock()
+	handler, ok := s.handlers[job.Type]
+	s.mu.RUnlock()
+
+	cause := ErrUnknownJobType
+	if ok {
+		cause = runHandler(handler, job)
+	}
+
+	now := timepkg.NowUnixMilli()
+	if cause == nil {
+		return s.repository.Complete(job.ID, job.Attempts, now)
+	}
+
+	status := job_status_enum.Pending
+	if job.Attempts >= job.MaxAttempts || !ok {
+		status = job_status_enum.Dead
+	}
+	log.Printf("job %s %s failed (attempt %d of %d): %v\n", job.Type, job.ID, job.Attempts, job.MaxAttempts, cause)
+
+	return s.repository.Fail(job.ID, job.Attempts, status, now+backoff(job.Attempts).Milliseconds(), cause.Error(), now)
+}
+
+// runHandler turns a panic into an error, so one broken job cannot take the
+// worker down with it.
+func runHandler(handler Handler, job entity.Job) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+	defer cancel()
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+
+	return handler(ctx, []byte(job.Payload))
+}
+
+// backoff doubles the delay after every failed attempt, up to maxRetryDelay.
+func backoff(attempt int) time.Duration {
+	delay := retryDelay
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+func (s *Service) registeredTypes() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	types := make([]string, 0, len(s.handlers))
+	for jobType := range s.handlers {
+		types = append(types, jobType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func (s *Service) pruneJobs(ctx context.Context, payload struct{}) error {
+	before := timepkg.ToUnixMilli(timepkg.Now().Add(-succeededJobRetention))
+
+	deleted, err := s.repository.DeleteSucceeded(before)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		log.Printf("pruned %d succeeded job(s)\n", deleted)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/internal/app/module/job/service"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var claimedTypes = []string{"email.send", service.PruneJobType}
+
+func TestService_RunJobs(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+
+	var received []emailPayload
+	jobService.Register("email.send", service.Handle(func(ctx context.Context, payload emailPayload) error {
+		if payload.To == "panic@example.com" {
+			panic("smtp client is nil")
+		}
+		if payload.To == "bounce@example.com" {
+			return errors.New("mailbox unavailable")
+		}
+		received = append(received, payload)
+		return nil
+	}))
+
+	t.Run("Run Jobs Success", func(t *testing.T) {
+		job := runningJob("email.send", `{"to":"student@example.com"}`, 1)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{job}, nil).Once()
+		mockRepo.On("Renew", job.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Complete", job.ID, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+
+		ran, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, ran)
+		assert.Equal(t, []emailPayload{{To: "student@example.com"}}, received)
+	})
+
+	t.Run("Run Jobs Failure Schedules Retry With Backoff", func(t *testing.T) {
+		job := runningJob("email.send", `{"to":"bounce@example.com"}`, 3)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{job}, nil).Once()
+		mockRepo.On("Renew", job.ID, 3, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+
+		var runAt, now int64
+		mockRepo.On("Fail", job.ID, 3, job_status_enum.Pending, mock.AnythingOfType("int64"), "mailbox unavailable", mock.AnythingOfType("int64")).
+			Run(func(args mock.Arguments) {
+				runAt = args.Get(3).(int64)
+				now = args.Get(5).(int64)
+			}).Return(nil).Once()
+
+		ran, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, ran)
+		assert.Equal(t, (2 * time.Minute).Milliseconds(), runAt-now)
+	})
+
+	t.Run("Run Jobs Last Attempt Is Dead", func(t *testing.T) {
+		job := runningJob("email.send", `{"to":"bounce@example.com"}`, 5)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{job}, nil).Once()
+		mockRepo.On("Renew", job.ID, 5, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Fail", job.ID, 5, job_status_enum.Dead, mock.AnythingOfType("int64"), "mailbox unavailable", mock.AnythingOfType("int64")).Return(nil).Once()
+
+		_, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Run Jobs Recovers Panic", func(t *testing.T) {
+		job := runningJob("email.send", `{"to":"panic@example.com"}`, 1)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{job}, nil).Once()
+		mockRepo.On("Renew", job.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Fail", job.ID, 1, job_status_enum.Pending, mock.AnythingOfType("int64"), "job panicked: smtp client is nil", mock.AnythingOfType("int64")).Return(nil).Once()
+
+		_, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Run Jobs Invalid Payload", func(t *testing.T) {
+		job := runningJob("email.send", `[]`, 1)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{job}, nil).Once()
+		mockRepo.On("Renew", job.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Fail", job.ID, 1, job_status_enum.Pending, mock.AnythingOfType("int64"), mock.MatchedBy(func(message string) bool {
+			return strings.HasPrefix(message, "failed to decode job payload")
+		}), mock.AnythingOfType("int64")).Return(nil).Once()
+
+		_, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Run Jobs Outcome Error Is Not Fatal", func(t *testing.T) {
+		first := runningJob("email.send", `{"to":"student@example.com"}`, 1)
+		second := runningJob("email.send", `{"to":"teacher@example.com"}`, 1)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{first, second}, nil).Once()
+		mockRepo.On("Renew", first.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Renew", second.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Complete", first.ID, 1, mock.AnythingOfType("int64")).Return(errors.New("Repository Failure")).Once()
+		mockRepo.On("Complete", second.ID, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+
+		ran, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, ran)
+	})
+
+	t.Run("Run Jobs Renews Lease Before Each Job", func(t *testing.T) {
+		defer monkey.UnpatchAll()
+		clock := int64(131313)
+		monkey.Patch(timepkg.NowUnixMilli, func() int64 { return clock })
+
+		first := runningJob("email.send", `{"to":"slow@example.com"}`, 1)
+		second := runningJob("email.send", `{"to":"late@example.com"}`, 1)
+		mockRepo.On("Claim", claimedTypes, clock, clock+(10*time.Minute).Milliseconds(), 10).Return([]entity.Job{first, second}, nil).Once()
+		mockRepo.On("Renew", first.ID, 1, clock+(10*time.Minute).Milliseconds(), clock).Run(func(args mock.Arguments) {
+			// The first job runs past the lease taken for the whole batch.
+			clock += (15 * time.Minute).Milliseconds()
+		}).Return(int64(1), nil).Once()
+		mockRepo.On("Complete", first.ID, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+		mockRepo.On("Renew", second.ID, 1, 131313+(25*time.Minute).Milliseconds(), 131313+(15*time.Minute).Milliseconds()).Return(int64(1), nil).Once()
+		mockRepo.On("Complete", second.ID, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+
+		received = nil
+		ran, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, ran)
+		assert.Equal(t, []emailPayload{{To: "slow@example.com"}, {To: "late@example.com"}}, received)
+	})
+
+	t.Run("Run Jobs Skips Job Claimed Elsewhere", func(t *testing.T) {
+		first := runningJob("email.send", `{"to":"slow@example.com"}`, 1)
+		second := runningJob("email.send", `{"to":"late@example.com"}`, 1)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{first, second}, nil).Once()
+		mockRepo.On("Renew", first.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("Complete", first.ID, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+		mockRepo.On("Renew", second.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(0), nil).Once()
+
+		received = nil
+		ran, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, ran)
+		assert.Equal(t, []emailPayload{{To: "slow@example.com"}}, received)
+	})
+
+	t.Run("Run Jobs Renew Error Skips Job", func(t *testing.T) {
+		job := runningJob("email.send", `{"to":"student@example.com"}`, 1)
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Job{job}, nil).Once()
+		mockRepo.On("Renew", job.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(0), errors.New("Repository Failure")).Once()
+
+		received = nil
+		_, err := jobService.RunJobs(10)
+
+		assert.NoError(t, err)
+		assert.Empty(t, received)
+	})
+
+	t.Run("Run Jobs Claim Error", func(t *testing.T) {
+		mockRepo.On("Claim", claimedTypes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := jobService.RunJobs(10)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_PruneJobs(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+
+	job := runningJob(service.PruneJobType, "null", 1)
+	mockRepo.On("Claim", []string{service.PruneJobType}, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 1).Return([]entity.Job{job}, nil).Once()
+	mockRepo.On("Renew", job.ID, 1, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+
+	var before int64
+	mockRepo.On("DeleteSucceeded", mock.AnythingOfType("int64")).Run(func(args mock.Arguments) {
+		before = args.Get(0).(int64)
+	}).Return(int64(4), nil).Once()
+	mockRepo.On("Complete", job.ID, 1, mock.AnythingOfType("int64")).Return(nil).Once()
+
+	_, err := jobService.RunJobs(1)
+
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), time.UnixMilli(before), time.Minute)
+}
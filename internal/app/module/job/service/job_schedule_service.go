@@ -0,0 +1,125 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/pkg/cron"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// pendingSchedule is a schedule registered by a module that has not been
+// saved yet. Modules are created before the tables are migrated, so
+// schedules are saved by the first EnqueueScheduled call.
+type pendingSchedule struct {
+	schedule entity.JobSchedule
+	saved    bool
+}
+
+// Schedule enqueues a job of jobType whenever the cron spec comes due, on
+// exactly one instance. Registering the same name again replaces the job;
+// the next run only moves when spec changed.
+func (s *Service) Schedule(name string, spec string, jobType string, payload any) error {
+	parsed, err := cron.Parse(spec)
+	if err != nil {
+		return err
+	}
+
+	if !s.registered(jobType) {
+		return ErrUnknownJobType
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode job payload: %v", err)
+	}
+
+	now := timepkg.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schedules[name] = pendingSchedule{schedule: entity.JobSchedule{
+		Name:      name,
+		Type:      jobType,
+		Payload:   string(encoded),
+		Spec:      spec,
+		NextRunAt: timepkg.ToUnixMilli(parsed.Next(now)),
+		CreatedAt: timepkg.ToUnixMilli(now),
+		UpdatedAt: timepkg.ToUnixMilli(now),
+	}}
+
+	return nil
+}
+
+// EnqueueScheduled enqueues a job for every schedule that is due and moves
+// it to its next run. Runs missed while no instance was up are collapsed
+// into one. It returns how many jobs were enqueued.
+func (s *Service) EnqueueScheduled() (int, error) {
+	err := s.saveSchedules()
+	if err != nil {
+		return 0, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	schedules, err := s.repository.ReadDueSchedules(now)
+	if err != nil {
+		return 0, err
+	}
+
+	enqueued := 0
+	for _, schedule := range schedules {
+		parsed, err := cron.Parse(schedule.Spec)
+		if err != nil {
+			log.Printf("skipping job schedule %s: %v\n", schedule.Name, err)
+			continue
+		}
+
+		next := parsed.Next(time.UnixMilli(now))
+		if next.IsZero() {
+			log.Printf("job schedule %s will never run again\n", schedule.Name)
+			next = time.UnixMilli(now).AddDate(100, 0, 0)
+		}
+
+		job, err := newJob(schedule.Type, json.RawMessage(schedule.Payload), now)
+		if err != nil {
+			return enqueued, err
+		}
+
+		advanced, err := s.repository.AdvanceSchedule(schedule, timepkg.ToUnixMilli(next), job)
+		if err != nil {
+			return enqueued, err
+		}
+
+		if advanced {
+			enqueued++
+		}
+	}
+
+	if enqueued > 0 {
+		s.notify()
+	}
+
+	return enqueued, nil
+}
+
+func (s *Service) saveSchedules() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, pending := range s.schedules {
+		if pending.saved {
+			continue
+		}
+
+		err := s.repository.UpsertSchedule(pending.schedule)
+		if err != nil {
+			return err
+		}
+
+		pending.saved = true
+		s.schedules[name] = pending
+	}
+
+	return nil
+}
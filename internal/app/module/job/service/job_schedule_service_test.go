@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/internal/app/module/job/service"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Schedule(t *testing.T) {
+	jobService, _ := initializeService(t)
+
+	t.Run("Schedule Invalid Spec", func(t *testing.T) {
+		err := jobService.Schedule("nightly", "0 25 * * *", service.PruneJobType, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Schedule Unknown Type", func(t *testing.T) {
+		err := jobService.Schedule("nightly", "0 3 * * *", "reports.build", nil)
+
+		assert.ErrorIs(t, err, service.ErrUnknownJobType)
+	})
+}
+
+func TestService_EnqueueScheduled(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+	assert.NoError(t, jobService.Schedule("nightly-prune", "0 3 * * *", service.PruneJobType, nil))
+
+	due := entity.JobSchedule{Name: "nightly-prune", Type: service.PruneJobType, Payload: "null", Spec: "0 3 * * *", NextRunAt: 121212}
+
+	t.Run("Enqueue Scheduled Success", func(t *testing.T) {
+		mockRepo.On("UpsertSchedule", mock.MatchedBy(func(schedule entity.JobSchedule) bool {
+			return schedule.Name == "nightly-prune" && schedule.Payload == "null" && schedule.NextRunAt > 0
+		})).Return(nil).Once()
+		mockRepo.On("ReadDueSchedules", mock.AnythingOfType("int64")).Return([]entity.JobSchedule{due}, nil).Once()
+		mockRepo.On("AdvanceSchedule", due, mock.AnythingOfType("int64"), mock.MatchedBy(func(job entity.Job) bool {
+			return job.Type == service.PruneJobType && job.Payload == "null"
+		})).Return(true, nil).Once()
+
+		enqueued, err := jobService.EnqueueScheduled()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, enqueued)
+		assert.Len(t, jobService.JobQueued(), 1)
+		<-jobService.JobQueued()
+	})
+
+	t.Run("Enqueue Scheduled Saves Schedules Once", func(t *testing.T) {
+		mockRepo.On("ReadDueSchedules", mock.AnythingOfType("int64")).Return([]entity.JobSchedule{due}, nil).Once()
+		mockRepo.On("AdvanceSchedule", due, mock.AnythingOfType("int64"), mock.AnythingOfType("entity.Job")).Return(false, nil).Once()
+
+		enqueued, err := jobService.EnqueueScheduled()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, enqueued)
+		assert.Empty(t, jobService.JobQueued())
+		mockRepo.AssertNumberOfCalls(t, "UpsertSchedule", 1)
+	})
+
+	t.Run("Enqueue Scheduled Skips Invalid Spec", func(t *testing.T) {
+		broken := due
+		broken.Spec = "every day"
+		mockRepo.On("ReadDueSchedules", mock.AnythingOfType("int64")).Return([]entity.JobSchedule{broken}, nil).Once()
+
+		enqueued, err := jobService.EnqueueScheduled()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, enqueued)
+	})
+
+	t.Run("Enqueue Scheduled Advance Error", func(t *testing.T) {
+		mockRepo.On("ReadDueSchedules", mock.AnythingOfType("int64")).Return([]entity.JobSchedule{due}, nil).Once()
+		mockRepo.On("AdvanceSchedule", due, mock.AnythingOfType("int64"), mock.AnythingOfType("entity.Job")).Return(false, errors.New("Repository Failure")).Once()
+
+		_, err := jobService.EnqueueScheduled()
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+
+	t.Run("Enqueue Scheduled Read Error", func(t *testing.T) {
+		mockRepo.On("ReadDueSchedules", mock.AnythingOfType("int64")).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := jobService.EnqueueScheduled()
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_EnqueueScheduled_SaveError(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+	assert.NoError(t, jobService.Schedule("nightly-prune", "@daily", service.PruneJobType, nil))
+
+	mockRepo.On("UpsertSchedule", mock.AnythingOfType("entity.JobSchedule")).Return(errors.New("Repository Failure")).Once()
+
+	_, err := jobService.EnqueueScheduled()
+
+	assert.EqualError(t, err, "Repository Failure")
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/job/dto"
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/internal/app/module/job/repository"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobNotFound     = errors.New("job not found")
+	ErrJobNotRetryable = errors.New("only dead jobs can be retried")
+	ErrUnknownJobType  = errors.New("no handler is registered for this job type")
+)
+
+// defaultMaxAttempts is how often a job runs before it is dead.
+const defaultMaxAttempts = 5
+
+// Handler runs a single job. Returning an error schedules a retry.
+type Handler func(ctx context.Context, payload []byte) error
+
+// Handle adapts a handler for a typed payload. A payload that cannot be
+// decoded fails the job like any other error.
+func Handle[T any](fn func(ctx context.Context, payload T) error) Handler {
+	return func(ctx context.Context, payload []byte) error {
+		var input T
+		err := json.Unmarshal(payload, &input)
+		if err != nil {
+			return fmt.Errorf("failed to decode job payload: %v", err)
+		}
+		return fn(ctx, input)
+	}
+}
+
+type JobService interface {
+	Register(jobType string, handler Handler)
+	Enqueue(jobType string, payload any) (uuid.UUID, error)
+	EnqueueAt(jobType string, payload any, runAt time.Time) (uuid.UUID, error)
+	Schedule(name string, spec string, jobType string, payload any) error
+	EnqueueScheduled() (int, error)
+	RunJobs(limit int) (int, error)
+	JobQueued() <-chan struct{}
+	GetJobs(filter dto.JobFilterDTO, limit int, page int) ([]dto.JobDTO, error)
+	GetJob(id uuid.UUID) (dto.JobDTO, error)
+	RetryJob(id uuid.UUID) (dto.JobDTO, error)
+}
+
+type Service struct {
+	repository repository.JobRepository
+	queued     chan struct{}
+
+	mu        sync.RWMutex
+	handlers  map[string]Handler
+	schedules map[string]pendingSchedule
+}
+
+func NewJobService(r repository.JobRepository) JobService {
+	service := new(Service)
+	service.repository = r
+	service.queued = make(chan struct{}, 1)
+	service.handlers = make(map[string]Handler)
+	service.schedules = make(map[string]pendingSchedule)
+	service.Register(PruneJobType, Handle(service.pruneJobs))
+	return service
+}
+
+// Register makes this instance run jobs of jobType. Modules register their
+// handlers while they are created, before the worker starts.
+func (s *Service) Register(jobType string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[jobType] = handler
+}
+
+func (s *Service) registered(jobType string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.handlers[jobType]
+	return ok
+}
+
+// Enqueue stores a job to run as soon as a worker is free. payload is
+// encoded as JSON. The type has to be registered, which catches typos before
+// a job sits in the queue that nothing will ever run.
+func (s *Service) Enqueue(jobType string, payload any) (uuid.UUID, error) {
+	return s.EnqueueAt(jobType, payload, timepkg.Now())
+}
+
+func (s *Service) EnqueueAt(jobType string, payload any, runAt time.Time) (uuid.UUID, error) {
+	if !s.registered(jobType) {
+		return uuid.Nil, ErrUnknownJobType
+	}
+
+	job, err := newJob(jobType, payload, timepkg.ToUnixMilli(runAt))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = s.repository.Create(job)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if job.RunAt <= job.CreatedAt {
+		s.notify()
+	}
+
+	return job.ID, nil
+}
+
+// JobQueued receives a value whenever a job is ready to run, so workers do
+// not have to wait for their next tick.
+func (s *Service) JobQueued() <-chan struct{} {
+	return s.queued
+}
+
+func (s *Service) GetJobs(filter dto.JobFilterDTO, limit int, page int) ([]dto.JobDTO, error) {
+	offset := (page - 1) * limit
+
+	jobs, err := s.repository.ReadMany(filter.Status, filter.Type, limit, offset)
+	if err != nil {
+		return []dto.JobDTO{}, err
+	}
+
+	jobDTOs := make([]dto.JobDTO, 0, len(jobs))
+	for _, job := range jobs {
+		jobDTOs = append(jobDTOs, toDTO(job))
+	}
+
+	return jobDTOs, nil
+}
+
+func (s *Service) GetJob(id uuid.UUID) (dto.JobDTO, error) {
+	job, err := s.repository.ReadOne(id)
+	if err != nil {
+		return dto.JobDTO{}, err
+	}
+
+	if job.ID == uuid.Nil {
+		return dto.JobDTO{}, ErrJobNotFound
+	}
+
+	return toDTO(job), nil
+}
+
+// RetryJob runs a dead job again with a fresh set of attempts.
+func (s *Service) RetryJob(id uuid.UUID) (dto.JobDTO, error) {
+	_, err := s.GetJob(id)
+	if err != nil {
+		return dto.JobDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	retried, err := s.repository.Retry(id, now, now)
+	if err != nil {
+		return dto.JobDTO{}, err
+	}
+
+	if retried == 0 {
+		return dto.JobDTO{}, ErrJobNotRetryable
+	}
+	s.notify()
+
+	return s.GetJob(id)
+}
+
+func (s *Service) notify() {
+	select {
+	case s.queued <- struct{}{}:
+	default:
+	}
+}
+
+func newJob(jobType string, payload any, runAt int64) (entity.Job, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("failed to encode job payload: %v", err)
+	}
+
+	now := timepkg.NowUnixMilli()
+	return entity.Job{
+		ID:          uuid.New(),
+		Type:        jobType,
+		Payload:     string(encoded),
+		Status:      job_status_enum.Pending,
+		MaxAttempts: defaultMaxAttempts,
+		RunAt:       runAt,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func toDTO(job entity.Job) dto.JobDTO {
+	return dto.JobDTO{
+		ID:          job.ID,
+		Type:        job.Type,
+		Payload:     json.RawMessage(job.Payload),
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LastError:   job.LastError,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/internal/app/module/job/repository/mocks"
+	"CodeWithAzri/internal/app/module/job/service"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var MockJob entity.Job = entity.Job{
+	ID:          uuid.MustParse("0b6f1d2e-3c4a-4e5b-8f6a-7b8c9d0e1f21"),
+	Type:        "email.send",
+	Payload:     `{"to":"student@example.com"}`,
+	Status:      job_status_enum.Dead,
+	Attempts:    5,
+	MaxAttempts: 5,
+	RunAt:       121212,
+	LastError:   "connection refused",
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+type emailPayload struct {
+	To string `json:"to"`
+}
+
+func initializeService(t *testing.T) (service.JobService, *mocks.JobRepository) {
+	mockRepo := mocks.NewJobRepository(t)
+	return service.NewJobService(mockRepo), mockRepo
+}
+
+func runningJob(jobType string, payload string, attempts int) entity.Job {
+	job := MockJob
+	job.Type = jobType
+	job.Payload = payload
+	job.Status = job_status_enum.Running
+	job.Attempts = attempts
+	job.LastError = ""
+	return job
+}
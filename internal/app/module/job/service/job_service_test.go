@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/dto"
+	"CodeWithAzri/internal/app/module/job/entity"
+	"CodeWithAzri/internal/app/module/job/service"
+	job_status_enum "CodeWithAzri/pkg/enums/jobStatus"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Enqueue(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+	jobService.Register("email.send", func(ctx context.Context, payload []byte) error { return nil })
+
+	t.Run("Enqueue Success", func(t *testing.T) {
+		mockRepo.On("Create", mock.MatchedBy(func(job entity.Job) bool {
+			return job.Type == "email.send" && job.Payload == `{"to":"student@example.com"}` &&
+				job.Status == job_status_enum.Pending && job.MaxAttempts == 5 && job.RunAt <= job.CreatedAt
+		})).Return(nil).Once()
+
+		id, err := jobService.Enqueue("email.send", emailPayload{To: "student@example.com"})
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, id)
+
+		select {
+		case <-jobService.JobQueued():
+		default:
+			t.Fatal("expected the worker to be notified")
+		}
+	})
+
+	t.Run("Enqueue Later Does Not Notify", func(t *testing.T) {
+		mockRepo.On("Create", mock.AnythingOfType("entity.Job")).Return(nil).Once()
+
+		_, err := jobService.EnqueueAt("email.send", nil, time.Now().Add(time.Hour))
+
+		assert.NoError(t, err)
+		assert.Empty(t, jobService.JobQueued())
+	})
+
+	t.Run("Enqueue Unknown Type", func(t *testing.T) {
+		_, err := jobService.Enqueue("email.sned", nil)
+
+		assert.ErrorIs(t, err, service.ErrUnknownJobType)
+	})
+
+	t.Run("Enqueue Unencodable Payload", func(t *testing.T) {
+		_, err := jobService.Enqueue("email.send", make(chan int))
+
+		assert.ErrorContains(t, err, "failed to encode job payload")
+	})
+
+	t.Run("Enqueue Repository Error", func(t *testing.T) {
+		mockRepo.On("Create", mock.AnythingOfType("entity.Job")).Return(errors.New("Repository Failure")).Once()
+
+		_, err := jobService.Enqueue("email.send", nil)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetJobs(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+
+	t.Run("Get Jobs Success", func(t *testing.T) {
+		mockRepo.On("ReadMany", job_status_enum.Dead, "email.send", 10, 10).Return([]entity.Job{MockJob}, nil).Once()
+
+		jobs, err := jobService.GetJobs(dto.JobFilterDTO{Status: job_status_enum.Dead, Type: "email.send"}, 10, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, jobs, 1)
+		assert.Equal(t, json.RawMessage(MockJob.Payload), jobs[0].Payload)
+		assert.Equal(t, MockJob.LastError, jobs[0].LastError)
+	})
+
+	t.Run("Get Jobs Error", func(t *testing.T) {
+		mockRepo.On("ReadMany", job_status_enum.JobStatus(""), "", 10, 0).Return(nil, errors.New("Repository Failure")).Once()
+
+		jobs, err := jobService.GetJobs(dto.JobFilterDTO{}, 10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+		assert.Empty(t, jobs)
+	})
+}
+
+func TestService_GetJob(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+
+	t.Run("Get Job Success", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockJob.ID).Return(MockJob, nil).Once()
+
+		job, err := jobService.GetJob(MockJob.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockJob.ID, job.ID)
+	})
+
+	t.Run("Get Job Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockJob.ID).Return(entity.Job{}, nil).Once()
+
+		_, err := jobService.GetJob(MockJob.ID)
+
+		assert.ErrorIs(t, err, service.ErrJobNotFound)
+	})
+}
+
+func TestService_RetryJob(t *testing.T) {
+	jobService, mockRepo := initializeService(t)
+
+	t.Run("Retry Job Success", func(t *testing.T) {
+		retried := MockJob
+		retried.Status = job_status_enum.Pending
+		retried.Attempts = 0
+		mockRepo.On("ReadOne", MockJob.ID).Return(MockJob, nil).Once()
+		mockRepo.On("Retry", MockJob.ID, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(1), nil).Once()
+		mockRepo.On("ReadOne", MockJob.ID).Return(retried, nil).Once()
+
+		job, err := jobService.RetryJob(MockJob.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, job_status_enum.Pending, job.Status)
+		assert.Len(t, jobService.JobQueued(), 1)
+	})
+
+	t.Run("Retry Job Not Dead", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockJob.ID).Return(MockJob, nil).Once()
+		mockRepo.On("Retry", MockJob.ID, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(0), nil).Once()
+
+		_, err := jobService.RetryJob(MockJob.ID)
+
+		assert.ErrorIs(t, err, service.ErrJobNotRetryable)
+	})
+
+	t.Run("Retry Job Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockJob.ID).Return(entity.Job{}, nil).Once()
+
+		_, err := jobService.RetryJob(MockJob.ID)
+
+		assert.ErrorIs(t, err, service.ErrJobNotFound)
+	})
+
+	t.Run("Retry Job Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockJob.ID).Return(MockJob, nil).Once()
+		mockRepo.On("Retry", MockJob.ID, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(int64(0), errors.New("Repository Failure")).Once()
+
+		_, err := jobService.RetryJob(MockJob.ID)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
@@ -0,0 +1,574 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/job/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "CodeWithAzri/internal/app/module/job/service"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// JobService is an autogenerated mock type for the JobService type
+type JobService struct {
+	mock.Mock
+}
+
+type JobService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobService) EXPECT() *JobService_Expecter {
+	return &JobService_Expecter{mock: &_m.Mock}
+}
+
+// Enqueue provides a mock function with given fields: jobType, payload
+func (_m *JobService) Enqueue(jobType string, payload any) (uuid.UUID, error) {
+	ret := _m.Called(jobType, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, any) (uuid.UUID, error)); ok {
+		return rf(jobType, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, any) uuid.UUID); ok {
+		r0 = rf(jobType, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, any) error); ok {
+		r1 = rf(jobType, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type JobService_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - jobType string
+//   - payload any
+func (_e *JobService_Expecter) Enqueue(jobType interface{}, payload interface{}) *JobService_Enqueue_Call {
+	return &JobService_Enqueue_Call{Call: _e.mock.On("Enqueue", jobType, payload)}
+}
+
+func (_c *JobService_Enqueue_Call) Run(run func(jobType string, payload any)) *JobService_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(any))
+	})
+	return _c
+}
+
+func (_c *JobService_Enqueue_Call) Return(_a0 uuid.UUID, _a1 error) *JobService_Enqueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_Enqueue_Call) RunAndReturn(run func(string, any) (uuid.UUID, error)) *JobService_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueAt provides a mock function with given fields: jobType, payload, runAt
+func (_m *JobService) EnqueueAt(jobType string, payload any, runAt time.Time) (uuid.UUID, error) {
+	ret := _m.Called(jobType, payload, runAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueAt")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, any, time.Time) (uuid.UUID, error)); ok {
+		return rf(jobType, payload, runAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, any, time.Time) uuid.UUID); ok {
+		r0 = rf(jobType, payload, runAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, any, time.Time) error); ok {
+		r1 = rf(jobType, payload, runAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_EnqueueAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueAt'
+type JobService_EnqueueAt_Call struct {
+	*mock.Call
+}
+
+// EnqueueAt is a helper method to define mock.On call
+//   - jobType string
+//   - payload any
+//   - runAt time.Time
+func (_e *JobService_Expecter) EnqueueAt(jobType interface{}, payload interface{}, runAt interface{}) *JobService_EnqueueAt_Call {
+	return &JobService_EnqueueAt_Call{Call: _e.mock.On("EnqueueAt", jobType, payload, runAt)}
+}
+
+func (_c *JobService_EnqueueAt_Call) Run(run func(jobType string, payload any, runAt time.Time)) *JobService_EnqueueAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(any), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *JobService_EnqueueAt_Call) Return(_a0 uuid.UUID, _a1 error) *JobService_EnqueueAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_EnqueueAt_Call) RunAndReturn(run func(string, any, time.Time) (uuid.UUID, error)) *JobService_EnqueueAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueScheduled provides a mock function with given fields:
+func (_m *JobService) EnqueueScheduled() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueScheduled")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_EnqueueScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueScheduled'
+type JobService_EnqueueScheduled_Call struct {
+	*mock.Call
+}
+
+// EnqueueScheduled is a helper method to define mock.On call
+func (_e *JobService_Expecter) EnqueueScheduled() *JobService_EnqueueScheduled_Call {
+	return &JobService_EnqueueScheduled_Call{Call: _e.mock.On("EnqueueScheduled")}
+}
+
+func (_c *JobService_EnqueueScheduled_Call) Run(run func()) *JobService_EnqueueScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobService_EnqueueScheduled_Call) Return(_a0 int, _a1 error) *JobService_EnqueueScheduled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_EnqueueScheduled_Call) RunAndReturn(run func() (int, error)) *JobService_EnqueueScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJob provides a mock function with given fields: id
+func (_m *JobService) GetJob(id uuid.UUID) (dto.JobDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 dto.JobDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.JobDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.JobDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.JobDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
+type JobService_GetJob_Call struct {
+	*mock.Call
+}
+
+// GetJob is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *JobService_Expecter) GetJob(id interface{}) *JobService_GetJob_Call {
+	return &JobService_GetJob_Call{Call: _e.mock.On("GetJob", id)}
+}
+
+func (_c *JobService_GetJob_Call) Run(run func(id uuid.UUID)) *JobService_GetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JobService_GetJob_Call) Return(_a0 dto.JobDTO, _a1 error) *JobService_GetJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_GetJob_Call) RunAndReturn(run func(uuid.UUID) (dto.JobDTO, error)) *JobService_GetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobs provides a mock function with given fields: filter, limit, page
+func (_m *JobService) GetJobs(filter dto.JobFilterDTO, limit int, page int) ([]dto.JobDTO, error) {
+	ret := _m.Called(filter, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobs")
+	}
+
+	var r0 []dto.JobDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.JobFilterDTO, int, int) ([]dto.JobDTO, error)); ok {
+		return rf(filter, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(dto.JobFilterDTO, int, int) []dto.JobDTO); ok {
+		r0 = rf(filter, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.JobDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.JobFilterDTO, int, int) error); ok {
+		r1 = rf(filter, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type JobService_GetJobs_Call struct {
+	*mock.Call
+}
+
+// GetJobs is a helper method to define mock.On call
+//   - filter dto.JobFilterDTO
+//   - limit int
+//   - page int
+func (_e *JobService_Expecter) GetJobs(filter interface{}, limit interface{}, page interface{}) *JobService_GetJobs_Call {
+	return &JobService_GetJobs_Call{Call: _e.mock.On("GetJobs", filter, limit, page)}
+}
+
+func (_c *JobService_GetJobs_Call) Run(run func(filter dto.JobFilterDTO, limit int, page int)) *JobService_GetJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(dto.JobFilterDTO), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *JobService_GetJobs_Call) Return(_a0 []dto.JobDTO, _a1 error) *JobService_GetJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_GetJobs_Call) RunAndReturn(run func(dto.JobFilterDTO, int, int) ([]dto.JobDTO, error)) *JobService_GetJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JobQueued provides a mock function with given fields:
+func (_m *JobService) JobQueued() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobQueued")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// JobService_JobQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobQueued'
+type JobService_JobQueued_Call struct {
+	*mock.Call
+}
+
+// JobQueued is a helper method to define mock.On call
+func (_e *JobService_Expecter) JobQueued() *JobService_JobQueued_Call {
+	return &JobService_JobQueued_Call{Call: _e.mock.On("JobQueued")}
+}
+
+func (_c *JobService_JobQueued_Call) Run(run func()) *JobService_JobQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobService_JobQueued_Call) Return(_a0 <-chan struct{}) *JobService_JobQueued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobService_JobQueued_Call) RunAndReturn(run func() <-chan struct{}) *JobService_JobQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: jobType, handler
+func (_m *JobService) Register(jobType string, handler service.Handler) {
+	_m.Called(jobType, handler)
+}
+
+// JobService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type JobService_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - jobType string
+//   - handler service.Handler
+func (_e *JobService_Expecter) Register(jobType interface{}, handler interface{}) *JobService_Register_Call {
+	return &JobService_Register_Call{Call: _e.mock.On("Register", jobType, handler)}
+}
+
+func (_c *JobService_Register_Call) Run(run func(jobType string, handler service.Handler)) *JobService_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(service.Handler))
+	})
+	return _c
+}
+
+func (_c *JobService_Register_Call) Return() *JobService_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *JobService_Register_Call) RunAndReturn(run func(string, service.Handler)) *JobService_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryJob provides a mock function with given fields: id
+func (_m *JobService) RetryJob(id uuid.UUID) (dto.JobDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryJob")
+	}
+
+	var r0 dto.JobDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.JobDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.JobDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.JobDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_RetryJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryJob'
+type JobService_RetryJob_Call struct {
+	*mock.Call
+}
+
+// RetryJob is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *JobService_Expecter) RetryJob(id interface{}) *JobService_RetryJob_Call {
+	return &JobService_RetryJob_Call{Call: _e.mock.On("RetryJob", id)}
+}
+
+func (_c *JobService_RetryJob_Call) Run(run func(id uuid.UUID)) *JobService_RetryJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JobService_RetryJob_Call) Return(_a0 dto.JobDTO, _a1 error) *JobService_RetryJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_RetryJob_Call) RunAndReturn(run func(uuid.UUID) (dto.JobDTO, error)) *JobService_RetryJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunJobs provides a mock function with given fields: limit
+func (_m *JobService) RunJobs(limit int) (int, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunJobs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_RunJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunJobs'
+type JobService_RunJobs_Call struct {
+	*mock.Call
+}
+
+// RunJobs is a helper method to define mock.On call
+//   - limit int
+func (_e *JobService_Expecter) RunJobs(limit interface{}) *JobService_RunJobs_Call {
+	return &JobService_RunJobs_Call{Call: _e.mock.On("RunJobs", limit)}
+}
+
+func (_c *JobService_RunJobs_Call) Run(run func(limit int)) *JobService_RunJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *JobService_RunJobs_Call) Return(_a0 int, _a1 error) *JobService_RunJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_RunJobs_Call) RunAndReturn(run func(int) (int, error)) *JobService_RunJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedule provides a mock function with given fields: name, spec, jobType, payload
+func (_m *JobService) Schedule(name string, spec string, jobType string, payload any) error {
+	ret := _m.Called(name, spec, jobType, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, any) error); ok {
+		r0 = rf(name, spec, jobType, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobService_Schedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedule'
+type JobService_Schedule_Call struct {
+	*mock.Call
+}
+
+// Schedule is a helper method to define mock.On call
+//   - name string
+//   - spec string
+//   - jobType string
+//   - payload any
+func (_e *JobService_Expecter) Schedule(name interface{}, spec interface{}, jobType interface{}, payload interface{}) *JobService_Schedule_Call {
+	return &JobService_Schedule_Call{Call: _e.mock.On("Schedule", name, spec, jobType, payload)}
+}
+
+func (_c *JobService_Schedule_Call) Run(run func(name string, spec string, jobType string, payload any)) *JobService_Schedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(any))
+	})
+	return _c
+}
+
+func (_c *JobService_Schedule_Call) Return(_a0 error) *JobService_Schedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobService_Schedule_Call) RunAndReturn(run func(string, string, string, any) error) *JobService_Schedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobService creates a new instance of JobService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobService {
+	mock := &JobService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"CodeWithAzri/internal/app/module/job/service"
+	"context"
+	"log"
+	"time"
+)
+
+// JobWorker enqueues scheduled jobs and runs due jobs in the background.
+type JobWorker struct {
+	service   service.JobService
+	interval  time.Duration
+	batchSize int
+}
+
+// NewJobWorker creates a new JobWorker instance.
+func NewJobWorker(s service.JobService, interval time.Duration, batchSize int) *JobWorker {
+	w := new(JobWorker)
+	w.service = s
+	w.interval = interval
+	w.batchSize = batchSize
+	return w
+}
+
+// Start runs the worker until the context is cancelled. Besides its regular
+// tick it wakes up as soon as a job is enqueued.
+func (w *JobWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.service.JobQueued():
+		}
+	}
+}
+
+// RunOnce enqueues due scheduled jobs, then runs batches of jobs until none
+// are due.
+func (w *JobWorker) RunOnce() {
+	_, err := w.service.EnqueueScheduled()
+	if err != nil {
+		log.Printf("failed to enqueue scheduled jobs: %v\n", err)
+	}
+
+	for {
+		ran, err := w.service.RunJobs(w.batchSize)
+		if err != nil {
+			log.Printf("failed to run jobs: %v\n", err)
+			return
+		}
+
+		if ran < w.batchSize {
+			return
+		}
+	}
+}
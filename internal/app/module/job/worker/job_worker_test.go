@@ -0,0 +1,62 @@
+package worker_test
+
+import (
+	"CodeWithAzri/internal/app/module/job/service/mocks"
+	"CodeWithAzri/internal/app/module/job/worker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobWorker_RunOnce(t *testing.T) {
+	mockService := mocks.NewJobService(t)
+	jobWorker := worker.NewJobWorker(mockService, time.Minute, 2)
+
+	t.Run("Run Until Batch Is Not Full", func(t *testing.T) {
+		mockService.On("EnqueueScheduled").Return(1, nil).Once()
+		mockService.On("RunJobs", 2).Return(2, nil).Once()
+		mockService.On("RunJobs", 2).Return(1, nil).Once()
+
+		jobWorker.RunOnce()
+
+		mockService.AssertNumberOfCalls(t, "RunJobs", 2)
+	})
+
+	t.Run("Enqueue Scheduled Error Still Runs Jobs", func(t *testing.T) {
+		mockService.On("EnqueueScheduled").Return(0, errors.New("Repository Failure")).Once()
+		mockService.On("RunJobs", 2).Return(0, nil).Once()
+
+		jobWorker.RunOnce()
+
+		mockService.AssertNumberOfCalls(t, "RunJobs", 3)
+	})
+
+	t.Run("Run Jobs Error", func(t *testing.T) {
+		mockService.On("EnqueueScheduled").Return(0, nil).Once()
+		mockService.On("RunJobs", 2).Return(0, errors.New("Repository Failure")).Once()
+
+		assert.NotPanics(t, jobWorker.RunOnce)
+	})
+}
+
+func TestJobWorker_Start(t *testing.T) {
+	mockService := mocks.NewJobService(t)
+	jobWorker := worker.NewJobWorker(mockService, time.Hour, 1)
+
+	t.Run("Start Stops On Context Cancel", func(t *testing.T) {
+		queued := make(chan struct{})
+		mockService.On("EnqueueScheduled").Return(0, nil).Once()
+		mockService.On("RunJobs", 1).Return(0, nil).Once()
+		mockService.On("JobQueued").Return((<-chan struct{})(queued)).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		jobWorker.Start(ctx)
+
+		mockService.AssertNumberOfCalls(t, "RunJobs", 1)
+	})
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+
+	"github.com/google/uuid"
+)
+
+type MediaDTO struct {
+	ID               uuid.UUID                               `json:"id"`
+	OwnerID          string                                  `json:"owner_id"`
+	Purpose          media_purpose_enum.MediaPurpose         `json:"purpose"`
+	Status           media_status_enum.MediaStatus           `json:"status"`
+	FileName         string                                  `json:"file_name"`
+	ContentType      string                                  `json:"content_type,omitempty"`
+	Size             int64                                   `json:"size"`
+	Offset           int64                                   `json:"offset"`
+	URL              string                                  `json:"url,omitempty"`
+	ExpiresAt        int64                                   `json:"expires_at,omitempty"`
+	ProcessingStatus processing_status_enum.ProcessingStatus `json:"processing_status,omitempty"`
+	Variants         []ImageVariantDTO                       `json:"variants,omitempty"`
+	Duration         int                                     `json:"duration,omitempty"`
+	CreatedAt        int64                                   `json:"created_at"`
+	UpdatedAt        int64                                   `json:"updated_at"`
+}
+
+// UploadMediaDTO describes a file before its content is received, either
+// from a multipart form or from the headers of a resumable upload.
+type UploadMediaDTO struct {
+	Purpose  media_purpose_enum.MediaPurpose `json:"purpose" validate:"required,oneof=course_gallery lesson_video profile_picture"`
+	FileName string                          `json:"file_name" validate:"max=255"`
+	Size     int64                           `json:"size" validate:"gt=0"`
+}
+
+// ImageVariantDTO is a resized copy of an uploaded image with a signed,
+// time-limited URL.
+type ImageVariantDTO struct {
+	Name   string `json:"name"`
+	Format string `json:"format"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Size   int64  `json:"size"`
+	URL    string `json:"url"`
+}
+
+// StreamDTO points a player at the master playlist of a processed video. The
+// URL and the playlists it leads to stop working at ExpiresAt.
+type StreamDTO struct {
+	ManifestURL string `json:"manifest_url"`
+	ExpiresAt   int64  `json:"expires_at"`
+	Duration    int    `json:"duration"`
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+
+	"github.com/google/uuid"
+)
+
+// Media is an uploaded file. While Status is uploading, Offset tracks how
+// many bytes of a resumable upload have been received and StorageKey is
+// empty. Duration is the length of a processed video in seconds.
+type Media struct {
+	ID               uuid.UUID                               `json:"id" gorm:"type:uuid;primaryKey"`
+	OwnerID          string                                  `json:"owner_id" gorm:"type:varchar(255);not null;index"`
+	Purpose          media_purpose_enum.MediaPurpose         `json:"purpose" gorm:"type:varchar(30);not null"`
+	Status           media_status_enum.MediaStatus           `json:"status" gorm:"type:varchar(20);not null"`
+	FileName         string                                  `json:"file_name" gorm:"type:varchar(255)"`
+	ContentType      string                                  `json:"content_type" gorm:"type:varchar(100)"`
+	Size             int64                                   `json:"size" gorm:"not null"`
+	Offset           int64                                   `json:"offset" gorm:"column:upload_offset;not null;default:0"`
+	StorageKey       string                                  `json:"storage_key" gorm:"type:text"`
+	ProcessingStatus processing_status_enum.ProcessingStatus `json:"processing_status" gorm:"type:varchar(20);not null;default:''"`
+	ProcessAttempts  int                                     `json:"process_attempts" gorm:"not null;default:0"`
+	ProcessAfter     int64                                   `json:"process_after" gorm:"not null;default:0;index"`
+	ProcessError     string                                  `json:"process_error" gorm:"type:text;not null;default:''"`
+	Duration         int                                     `json:"duration" gorm:"not null;default:0"`
+	Variants         []MediaVariant                          `json:"variants,omitempty" gorm:"-"`
+	CreatedAt        int64                                   `json:"created_at"`
+	UpdatedAt        int64                                   `json:"updated_at"`
+}
+
+func (Media) TableName() string {
+	return "media"
+}
+
+// MediaVariant is a resized copy of an image, such as its WebP thumbnail, or
+// an HLS rendition of a video, in which case StorageKey is its playlist.
+type MediaVariant struct {
+	MediaID    uuid.UUID `json:"media_id" gorm:"type:uuid;primaryKey"`
+	Name       string    `json:"name" gorm:"type:varchar(20);primaryKey"`
+	Format     string    `json:"format" gorm:"type:varchar(10);primaryKey"`
+	Width      int       `json:"width" gorm:"not null"`
+	Height     int       `json:"height" gorm:"not null"`
+	Size       int64     `json:"size" gorm:"not null"`
+	StorageKey string    `json:"storage_key" gorm:"type:text;not null"`
+	CreatedAt  int64     `json:"created_at"`
+}
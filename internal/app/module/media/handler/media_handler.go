@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/service"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"CodeWithAzri/pkg/storage"
+	"CodeWithAzri/pkg/video"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// multipartMemory is how much of a multipart form is kept in memory before
+// the rest is spilled to temporary files.
+const multipartMemory = 32 << 20
+
+type Handler struct {
+	service  service.MediaService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.MediaService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// UploadMedia godoc
+//
+//	@Summary		Upload a file
+//	@Tags			Media
+//	@Description	Upload a whole file in a multipart form. The content type is detected from the file itself and must match the purpose: course_gallery and profile_picture accept images, lesson_video accepts MP4 and WebM.
+//	@ID				upload-media
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			file			formData	file	true	"File to upload"
+//	@Param			purpose			formData	string	true	"What the file is used for"	Enums(course_gallery, lesson_video, profile_picture)
+//	@Param			Authorization	header		string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.MediaDTO}	"Uploaded media with a signed download URL"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		413	{object}	response.ResponseError					"File too large for its purpose"
+//	@Failure		415	{object}	response.ResponseError					"File type not allowed for its purpose"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/media [post]
+func (h *Handler) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, service.MaxUploadSize+multipartMemory)
+
+	err := r.ParseMultipartForm(multipartMemory)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.RespondError(http.StatusRequestEntityTooLarge, service.ErrFileTooLarge, w)
+			return
+		}
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+	defer file.Close()
+
+	d := dto.UploadMediaDTO{
+		Purpose:  media_purpose_enum.MediaPurpose(r.FormValue("purpose")),
+		FileName: path.Base(header.Filename),
+		Size:     header.Size,
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	media, err := h.service.Upload(userID, d, file)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Media Uploaded Successfully", "Success", media, w)
+}
+
+// GetMedia godoc
+//
+//	@Summary		Get a media file
+//	@Tags			Media
+//	@Description	Fetch media metadata together with a signed, time-limited download URL. Uploads that are still in progress are only visible to their owner.
+//	@ID				get-media
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Media ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.MediaDTO}	"Media with a signed download URL"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		404	{object}	response.ResponseError					"Media not found"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/media/{id} [get]
+func (h *Handler) GetMedia(w http.ResponseWriter, r *http.Request) {
+	mediaID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	media, err := h.service.GetMedia(mediaID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Media Fetched Successfully", "Success", media, w)
+}
+
+// DeleteMedia godoc
+//
+//	@Summary		Delete a media file
+//	@Tags			Media
+//	@Description	Delete an uploaded file or cancel an upload in progress. Only the owner may delete it.
+//	@ID				delete-media
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Media ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not the owner"
+//	@Failure		404	{object}	response.ResponseError	"Media not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/media/{id} [delete]
+func (h *Handler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	mediaID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	err = h.service.DeleteMedia(mediaID, userID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Media Deleted Successfully", "Success", nil, w)
+}
+
+// ServeFile godoc
+//
+//	@Summary		Download a file through a signed URL
+//	@Tags			Media
+//	@Description	Serve a file stored on the local filesystem storage. The URL is only valid with the signature and expiry returned by the media endpoints.
+//	@ID				serve-media-file
+//	@Produce		octet-stream
+//	@Param			key			path		string	true	"Storage key"
+//	@Param			expires		query		int		true	"Unix time the URL expires at"
+//	@Param			signature	query		string	true	"URL signature"
+//	@Success		200			{file}		file	"File content"
+//	@Failure		403			{object}	response.ResponseError	"Invalid or expired signature"
+//	@Failure		404			{object}	response.ResponseError	"File not found"
+//	@Router			/api/v1/media/files/{key} [get]
+func (h *Handler) ServeFile(w http.ResponseWriter, r *http.Request) {
+	key := requestPkg.GetURLParam(r, "*")
+	expires, _ := strconv.ParseInt(requestPkg.GetQueryParam(r, "expires"), 10, 64)
+	signature := requestPkg.GetQueryParam(r, "signature")
+
+	file, err := h.service.OpenFile(key, expires, signature)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Cache-Control", "private, max-age=300")
+
+	if seeker, ok := file.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, path.Base(key), time.Time{}, seeker)
+		return
+	}
+
+	io.Copy(w, file)
+}
+
+// ServePlaylist godoc
+//
+//	@Summary		Stream a lesson video through a signed playlist
+//	@Tags			Media
+//	@Description	Serve an HLS playlist of a lesson video. Start from the manifest URL returned by the lesson stream endpoint; playlist URLs inside are signed with the same short expiry, and segment URLs stay valid for that expiry plus the length of the video so playback can finish. Players need no other credentials.
+//	@ID				serve-media-playlist
+//	@Produce		application/vnd.apple.mpegurl
+//	@Param			id			path		string	true	"Media ID"
+//	@Param			playlist	path		string	true	"Playlist path, such as master.m3u8 or 720p/index.m3u8"
+//	@Param			expires		query		int		true	"Unix time the URL expires at"
+//	@Param			signature	query		string	true	"URL signature"
+//	@Success		200			{string}	string					"HLS playlist"
+//	@Failure		400			{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		403			{object}	response.ResponseError	"Invalid or expired signature"
+//	@Failure		404			{object}	response.ResponseError	"Playlist not found"
+//	@Router			/api/v1/media/streams/{id}/{playlist} [get]
+func (h *Handler) ServePlaylist(w http.ResponseWriter, r *http.Request) {
+	mediaID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	name := requestPkg.GetURLParam(r, "*")
+	expires, _ := strconv.ParseInt(requestPkg.GetQueryParam(r, "expires"), 10, 64)
+	signature := requestPkg.GetQueryParam(r, "signature")
+
+	playlist, err := h.service.OpenPlaylist(mediaID, name, expires, signature)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	// Playlists embed signed URLs, so shared caches must not keep them.
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("Content-Type", video.PlaylistContentType)
+	w.Write(playlist)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrMediaNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrForbidden), errors.Is(err, storage.ErrInvalidSignature):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrFileTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, service.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, service.ErrOffsetMismatch), errors.Is(err, service.ErrUploadComplete), errors.Is(err, service.ErrVideoNotReady):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
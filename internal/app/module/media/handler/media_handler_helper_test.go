@@ -0,0 +1,44 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/pkg/requestPkg"
+	"net/http"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+)
+
+var MockMediaDTO dto.MediaDTO = dto.MediaDTO{
+	ID:          uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11"),
+	OwnerID:     "user123",
+	Purpose:     "course_gallery",
+	Status:      "ready",
+	FileName:    "cover.png",
+	ContentType: "image/png",
+	Size:        1024,
+	Offset:      1024,
+	URL:         "http://localhost/api/v1/media/files/course_gallery/6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11.png?expires=1&signature=abc",
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+var MockUploadDTO dto.MediaDTO = dto.MediaDTO{
+	ID:       uuid.MustParse("c1e7b7a0-5d1e-4c4e-8b0e-6b7f3c2d9e22"),
+	OwnerID:  "user123",
+	Purpose:  "lesson_video",
+	Status:   "uploading",
+	FileName: "intro.mp4",
+	Size:     4096,
+	Offset:   1024,
+}
+
+func patchMediaRequest(id string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return id
+	})
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+}
@@ -0,0 +1,265 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/handler"
+	"CodeWithAzri/internal/app/module/media/service"
+	"CodeWithAzri/internal/app/module/media/service/mocks"
+	"CodeWithAzri/pkg/signedurl"
+	"CodeWithAzri/pkg/storage"
+	"CodeWithAzri/pkg/video"
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.MediaService) {
+	mockService := mocks.NewMediaService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
+
+func newMultipartRequest(t *testing.T, purpose string, content []byte) *http.Request {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	if purpose != "" {
+		writer.WriteField("purpose", purpose)
+	}
+	if content != nil {
+		part, err := writer.CreateFormFile("file", "../cover.png")
+		assert.NoError(t, err)
+		part.Write(content)
+	}
+	writer.Close()
+
+	req, err := http.NewRequest("POST", "/api/v1/media", body)
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestHandler_UploadMedia(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchMediaRequest("")
+
+	t.Run("Upload Media Successfully", func(t *testing.T) {
+		expected := dto.UploadMediaDTO{Purpose: "course_gallery", FileName: "cover.png", Size: 4}
+		mockService.On("Upload", "user123", expected, mock.Anything).Return(MockMediaDTO, nil).Once()
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.UploadMedia(recorder, newMultipartRequest(t, "course_gallery", []byte("data")))
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	t.Run("Upload Media Invalid Purpose", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.UploadMedia(recorder, newMultipartRequest(t, "avatar", []byte("data")))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Upload Media Missing File", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.UploadMedia(recorder, newMultipartRequest(t, "course_gallery", nil))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Upload Media Not Multipart", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/media", bytes.NewBufferString("{}"))
+		req.Header.Set("Content-Type", "application/json")
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.UploadMedia(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Upload Media Unsupported Type", func(t *testing.T) {
+		mockService.On("Upload", "user123", mock.Anything, mock.Anything).Return(dto.MediaDTO{}, service.ErrUnsupportedMediaType).Once()
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.UploadMedia(recorder, newMultipartRequest(t, "course_gallery", []byte("data")))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+	})
+
+	t.Run("Upload Media Too Large", func(t *testing.T) {
+		mockService.On("Upload", "user123", mock.Anything, mock.Anything).Return(dto.MediaDTO{}, service.ErrFileTooLarge).Once()
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.UploadMedia(recorder, newMultipartRequest(t, "course_gallery", []byte("data")))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+}
+
+func TestHandler_GetMedia(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Media Successfully", func(t *testing.T) {
+		patchMediaRequest(MockMediaDTO.ID.String())
+		mockService.On("GetMedia", MockMediaDTO.ID, "user123").Return(MockMediaDTO, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/"+MockMediaDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.GetMedia(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"url":"http://localhost/api/v1/media/files/course_gallery/`)
+	})
+
+	t.Run("Get Media Not Found", func(t *testing.T) {
+		patchMediaRequest(MockMediaDTO.ID.String())
+		mockService.On("GetMedia", MockMediaDTO.ID, "user123").Return(dto.MediaDTO{}, service.ErrMediaNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/"+MockMediaDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.GetMedia(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Media Invalid ID", func(t *testing.T) {
+		patchMediaRequest("invalid")
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/invalid", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.GetMedia(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_DeleteMedia(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchMediaRequest(MockMediaDTO.ID.String())
+
+	t.Run("Delete Media Successfully", func(t *testing.T) {
+		mockService.On("DeleteMedia", MockMediaDTO.ID, "user123").Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/media/"+MockMediaDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.DeleteMedia(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Delete Media Forbidden", func(t *testing.T) {
+		mockService.On("DeleteMedia", MockMediaDTO.ID, "user123").Return(service.ErrForbidden).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/media/"+MockMediaDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.DeleteMedia(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestHandler_ServeFile(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchMediaRequest("course_gallery/cover.png")
+
+	t.Run("Serve File Successfully", func(t *testing.T) {
+		file, err := os.CreateTemp(t.TempDir(), "cover")
+		assert.NoError(t, err)
+		file.WriteString("content")
+		file.Seek(0, io.SeekStart)
+
+		mockService.On("OpenFile", "course_gallery/cover.png", int64(1700000000), "abc").Return(file, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/files/course_gallery/cover.png?expires=1700000000&signature=abc", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.ServeFile(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "content", recorder.Body.String())
+		assert.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+	})
+
+	t.Run("Serve File Invalid Signature", func(t *testing.T) {
+		mockService.On("OpenFile", "course_gallery/cover.png", int64(0), "").Return(nil, storage.ErrInvalidSignature).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/files/course_gallery/cover.png", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.ServeFile(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Serve File Error", func(t *testing.T) {
+		mockService.On("OpenFile", "course_gallery/cover.png", int64(0), "").Return(nil, errors.New("Service Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/files/course_gallery/cover.png", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.ServeFile(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_ServePlaylist(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		if key == "*" {
+			return "720p/index.m3u8"
+		}
+		return MockMediaDTO.ID.String()
+	})
+
+	t.Run("Serve Playlist Successfully", func(t *testing.T) {
+		playlist := []byte("#EXTM3U\n#EXTINF:6.0,\nhttp://localhost/segment_0000.ts\n")
+		mockService.On("OpenPlaylist", MockMediaDTO.ID, "720p/index.m3u8", int64(1700000000), "abc").Return(playlist, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/streams/id/720p/index.m3u8?expires=1700000000&signature=abc", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.ServePlaylist(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, string(playlist), recorder.Body.String())
+		assert.Equal(t, video.PlaylistContentType, recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "private, no-store", recorder.Header().Get("Cache-Control"))
+	})
+
+	t.Run("Serve Playlist Invalid Signature", func(t *testing.T) {
+		mockService.On("OpenPlaylist", MockMediaDTO.ID, "720p/index.m3u8", int64(0), "").Return(nil, signedurl.ErrInvalidSignature).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/streams/id/720p/index.m3u8", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.ServePlaylist(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Serve Playlist Not Found", func(t *testing.T) {
+		mockService.On("OpenPlaylist", MockMediaDTO.ID, "720p/index.m3u8", int64(0), "").Return(nil, service.ErrMediaNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/media/streams/id/720p/index.m3u8", nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.ServePlaylist(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/pkg/constant"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Resumable uploads follow the core tus 1.0.0 protocol: create an upload,
+// ask for its offset with HEAD, and PATCH the remaining bytes.
+const (
+	tusVersion        = "1.0.0"
+	offsetContentType = "application/offset+octet-stream"
+)
+
+// CreateUpload godoc
+//
+//	@Summary		Start a resumable upload
+//	@Tags			Media
+//	@Description	Start a tus-style resumable upload. Upload-Metadata is a comma separated list of "key base64(value)" pairs and must contain purpose; filename is optional. The upload URL is returned in the Location header.
+//	@ID				create-media-upload
+//	@Produce		json
+//	@Param			Upload-Length	header	int		true	"Total size of the file in bytes"
+//	@Param			Upload-Metadata	header	string	true	"Upload metadata, e.g. purpose Y291cnNlX2dhbGxlcnk=,filename Y292ZXIucG5n"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.MediaDTO}	"Upload created"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		413	{object}	response.ResponseError					"File too large for its purpose"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/media/uploads [post]
+func (h *Handler) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	size, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, errors.New("invalid Upload-Length header"), w)
+		return
+	}
+
+	metadata, err := parseUploadMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	d := dto.UploadMediaDTO{
+		Purpose: media_purpose_enum.MediaPurpose(metadata["purpose"]),
+		Size:    size,
+	}
+	if fileName := metadata["filename"]; fileName != "" {
+		d.FileName = path.Base(fileName)
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	upload, err := h.service.CreateUpload(userID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Location", constant.ApiPattern+constant.V1+constant.MediaPattern+constant.UploadsPattern+"/"+upload.ID.String())
+	response.BuildResponse(http.StatusCreated, "Upload Created Successfully", "Success", upload, w)
+}
+
+// GetUploadOffset godoc
+//
+//	@Summary		Get the offset of a resumable upload
+//	@Tags			Media
+//	@Description	Return how many bytes of the upload have been received in the Upload-Offset header, so an interrupted upload can be resumed.
+//	@ID				head-media-upload
+//	@Param			id				path	string	true	"Upload ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	"Upload-Offset and Upload-Length headers"
+//	@Failure		400	"Bad request, invalid input"
+//	@Failure		403	"Forbidden, not the owner"
+//	@Failure		404	"Upload not found"
+//	@Router			/api/v1/media/uploads/{id} [head]
+func (h *Handler) GetUploadOffset(w http.ResponseWriter, r *http.Request) {
+	uploadID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	upload, err := h.service.GetUpload(uploadID, userID)
+	if err != nil {
+		w.WriteHeader(statusCodeFromError(err))
+		return
+	}
+
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(upload.Size, 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+// AppendUpload godoc
+//
+//	@Summary		Send a chunk of a resumable upload
+//	@Tags			Media
+//	@Description	Append the request body at Upload-Offset, which must equal the bytes received so far. Once the last byte arrives the file type is checked and the file becomes available.
+//	@ID				patch-media-upload
+//	@Accept			octet-stream
+//	@Param			id				path	string	true	"Upload ID"
+//	@Param			Upload-Offset	header	int		true	"Offset the chunk starts at"
+//	@Param			Content-Type	header	string	true	"Must be application/offset+octet-stream"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		204	"Chunk stored, the new offset is in the Upload-Offset header"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not the owner"
+//	@Failure		404	{object}	response.ResponseError	"Upload not found"
+//	@Failure		409	{object}	response.ResponseError	"Offset mismatch or upload already complete"
+//	@Failure		413	{object}	response.ResponseError	"More bytes than Upload-Length"
+//	@Failure		415	{object}	response.ResponseError	"Wrong Content-Type, or file type not allowed for its purpose"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/media/uploads/{id} [patch]
+func (h *Handler) AppendUpload(w http.ResponseWriter, r *http.Request) {
+	uploadID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if r.Header.Get("Content-Type") != offsetContentType {
+		response.RespondError(http.StatusUnsupportedMediaType, errors.New("content type must be "+offsetContentType), w)
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		response.RespondError(http.StatusBadRequest, errors.New("invalid Upload-Offset header"), w)
+		return
+	}
+
+	userID := requestPkg.GetUserID(r)
+
+	upload, err := h.service.AppendUpload(uploadID, userID, offset, r.Body)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseUploadMetadata decodes the tus Upload-Metadata header.
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if header == "" {
+		return metadata, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.New("invalid Upload-Metadata header")
+		}
+		metadata[key] = string(value)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,149 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/service"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_CreateUpload(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchMediaRequest("")
+
+	newRequest := func(length string, metadata string) *http.Request {
+		req, _ := http.NewRequest("POST", "/api/v1/media/uploads", nil)
+		req.Header.Set("Upload-Length", length)
+		req.Header.Set("Upload-Metadata", metadata)
+		return req
+	}
+
+	t.Run("Create Upload Successfully", func(t *testing.T) {
+		expected := dto.UploadMediaDTO{Purpose: "lesson_video", FileName: "intro.mp4", Size: 4096}
+		mockService.On("CreateUpload", "user123", expected).Return(MockUploadDTO, nil).Once()
+
+		recorder := httptest.NewRecorder()
+		// purpose=lesson_video, filename=intro.mp4
+		mediaHandler.CreateUpload(recorder, newRequest("4096", "purpose bGVzc29uX3ZpZGVv,filename aW50cm8ubXA0"))
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		assert.Equal(t, "/api/v1/media/uploads/"+MockUploadDTO.ID.String(), recorder.Header().Get("Location"))
+		assert.Equal(t, "1.0.0", recorder.Header().Get("Tus-Resumable"))
+	})
+
+	t.Run("Create Upload Invalid Length", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.CreateUpload(recorder, newRequest("abc", "purpose bGVzc29uX3ZpZGVv"))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Upload Invalid Metadata", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.CreateUpload(recorder, newRequest("4096", "purpose !!!"))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Upload Missing Purpose", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.CreateUpload(recorder, newRequest("4096", ""))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Upload Too Large", func(t *testing.T) {
+		mockService.On("CreateUpload", "user123", mock.Anything).Return(dto.MediaDTO{}, service.ErrFileTooLarge).Once()
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.CreateUpload(recorder, newRequest("4096", "purpose bGVzc29uX3ZpZGVv"))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+}
+
+func TestHandler_GetUploadOffset(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchMediaRequest(MockUploadDTO.ID.String())
+
+	t.Run("Get Upload Offset Successfully", func(t *testing.T) {
+		mockService.On("GetUpload", MockUploadDTO.ID, "user123").Return(MockUploadDTO, nil).Once()
+
+		req, _ := http.NewRequest("HEAD", "/api/v1/media/uploads/"+MockUploadDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.GetUploadOffset(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "1024", recorder.Header().Get("Upload-Offset"))
+		assert.Equal(t, "4096", recorder.Header().Get("Upload-Length"))
+	})
+
+	t.Run("Get Upload Offset Not Found", func(t *testing.T) {
+		mockService.On("GetUpload", MockUploadDTO.ID, "user123").Return(dto.MediaDTO{}, service.ErrMediaNotFound).Once()
+
+		req, _ := http.NewRequest("HEAD", "/api/v1/media/uploads/"+MockUploadDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		mediaHandler.GetUploadOffset(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_AppendUpload(t *testing.T) {
+	mediaHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+	patchMediaRequest(MockUploadDTO.ID.String())
+
+	newRequest := func(contentType string, offset string) *http.Request {
+		req, _ := http.NewRequest("PATCH", "/api/v1/media/uploads/"+MockUploadDTO.ID.String(), bytes.NewBufferString("chunk"))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Upload-Offset", offset)
+		return req
+	}
+
+	t.Run("Append Upload Successfully", func(t *testing.T) {
+		updated := MockUploadDTO
+		updated.Offset = 1029
+		mockService.On("AppendUpload", MockUploadDTO.ID, "user123", int64(1024), mock.Anything).Return(updated, nil).Once()
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.AppendUpload(recorder, newRequest("application/offset+octet-stream", "1024"))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		assert.Equal(t, "1029", recorder.Header().Get("Upload-Offset"))
+	})
+
+	t.Run("Append Upload Wrong Content Type", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.AppendUpload(recorder, newRequest("application/json", "1024"))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+	})
+
+	t.Run("Append Upload Invalid Offset", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mediaHandler.AppendUpload(recorder, newRequest("application/offset+octet-stream", "-1"))
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Append Upload Offset Mismatch", func(t *testing.T) {
+		mockService.On("AppendUpload", MockUploadDTO.ID, "user123", int64(0), mock.Anything).Return(dto.MediaDTO{}, service.ErrOffsetMismatch).Once()
+
+		recorder := httptest.NewRecorder()
+		mediaHandler.AppendUpload(recorder, newRequest("application/offset+octet-stream", "0"))
+
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+}
@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type MediaMigration struct{}
+
+func (m MediaMigration) CreateMediaTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.Media{},
+		entity.MediaVariant{},
+	)
+}
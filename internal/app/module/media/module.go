@@ -0,0 +1,125 @@
+package media
+
+import (
+	"CodeWithAzri/internal/app/module/media/handler"
+	"CodeWithAzri/internal/app/module/media/migration"
+	"CodeWithAzri/internal/app/module/media/repository"
+	"CodeWithAzri/internal/app/module/media/service"
+	"CodeWithAzri/internal/app/module/media/worker"
+	"CodeWithAzri/pkg/config"
+	"CodeWithAzri/pkg/imaging"
+	"CodeWithAzri/pkg/signedurl"
+	"CodeWithAzri/pkg/storage"
+	"CodeWithAzri/pkg/video"
+	"database/sql"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	defaultURLTTL        = 15 * time.Minute
+	defaultMaxMegapixels = 50
+)
+
+type Module struct {
+	Handler     *handler.Handler
+	Service     service.MediaService
+	Repository  repository.MediaRepository
+	Migration   *migration.MediaMigration
+	Worker      *worker.ImageWorker
+	VideoWorker *worker.VideoWorker
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate, s storage.Storage) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewMediaService(m.Repository, s, webpEncoder(), transcoder(), streamSigner(), stagingDir(), urlTTL(), maxImagePixels())
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.MediaMigration{}
+	m.Worker = worker.NewImageWorker(m.Service, time.Minute, 10)
+	m.VideoWorker = worker.NewVideoWorker(m.Service, time.Minute, 1)
+
+	return m
+}
+
+func stagingDir() string {
+	dir := config.GetEnvValue("UPLOAD_TMP_DIR")
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "codewithazri-uploads")
+	}
+	return dir
+}
+
+func urlTTL() time.Duration {
+	ttl, err := time.ParseDuration(config.GetEnvValue("MEDIA_URL_TTL"))
+	if err != nil || ttl <= 0 {
+		return defaultURLTTL
+	}
+	return ttl
+}
+
+// maxImagePixels reads the cap on image dimensions from IMAGE_MAX_MEGAPIXELS.
+// Decoding takes four bytes per pixel, so 50 megapixels already needs 200MB.
+func maxImagePixels() int {
+	megapixels, err := strconv.Atoi(config.GetEnvValue("IMAGE_MAX_MEGAPIXELS"))
+	if err != nil || megapixels <= 0 {
+		megapixels = defaultMaxMegapixels
+	}
+	return megapixels * 1_000_000
+}
+
+// webpEncoder uses cwebp from IMAGE_CWEBP_PATH or the PATH. Without it images
+// only get JPEG or PNG variants.
+func webpEncoder() imaging.WebPEncoder {
+	binary := config.GetEnvValue("IMAGE_CWEBP_PATH")
+	if binary == "" {
+		binary = "cwebp"
+	}
+
+	encoder, err := imaging.NewCWebPEncoder(binary)
+	if err != nil {
+		log.Printf("WebP variants are disabled: %v\n", err)
+		return nil
+	}
+	return encoder
+}
+
+// transcoder uses ffmpeg and ffprobe from FFMPEG_PATH and FFPROBE_PATH or the
+// PATH. Without them lesson videos stay pending and cannot be streamed.
+func transcoder() video.Transcoder {
+	ffmpeg := config.GetEnvValue("FFMPEG_PATH")
+	if ffmpeg == "" {
+		ffmpeg = "ffmpeg"
+	}
+	ffprobe := config.GetEnvValue("FFPROBE_PATH")
+	if ffprobe == "" {
+		ffprobe = "ffprobe"
+	}
+
+	t, err := video.NewFFmpegTranscoder(ffmpeg, ffprobe)
+	if err != nil {
+		log.Printf("HLS packaging is disabled: %v\n", err)
+		return nil
+	}
+	return t
+}
+
+// streamSigner signs playlist URLs below STREAM_BASE_URL, which must point
+// at the stream route of this module.
+func streamSigner() *signedurl.Signer {
+	baseURL := config.GetEnvValue("STREAM_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8080/api/v1/media/streams"
+	}
+
+	signer, err := signedurl.NewSigner(baseURL, config.GetEnvValue("STREAM_SIGNING_SECRET"))
+	if err != nil {
+		panic(err)
+	}
+	return signer
+}
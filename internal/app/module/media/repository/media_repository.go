@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const mediaColumns = `id, owner_id, purpose, status, file_name, content_type, size, upload_offset, storage_key,
+	processing_status, process_attempts, duration, created_at, updated_at`
+
+type MediaRepository interface {
+	Create(e entity.Media) error
+	ReadOne(id uuid.UUID) (entity.Media, error)
+	ReadMany(ids []uuid.UUID) ([]entity.Media, error)
+	UpdateOffset(id uuid.UUID, offset int64, updatedAt int64) error
+	Complete(id uuid.UUID, contentType string, storageKey string, processingStatus processing_status_enum.ProcessingStatus, updatedAt int64) error
+	Delete(id uuid.UUID) error
+	ClaimUnprocessed(purposes []media_purpose_enum.MediaPurpose, now int64, leaseUntil int64, limit int) ([]entity.Media, error)
+	SaveVariants(id uuid.UUID, size int64, variants []entity.MediaVariant, updatedAt int64) error
+	SaveRenditions(id uuid.UUID, duration int, renditions []entity.MediaVariant, updatedAt int64) error
+	FailProcessing(id uuid.UUID, status processing_status_enum.ProcessingStatus, processAfter int64, message string, updatedAt int64) error
+	ReadVariants(ids []uuid.UUID) ([]entity.MediaVariant, error)
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) MediaRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+func (r *Repository) Create(e entity.Media) error {
+	query := `
+		INSERT INTO media (id, owner_id, purpose, status, file_name, content_type, size, upload_offset, storage_key,
+			processing_status, process_after, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.Exec(query, e.ID, e.OwnerID, e.Purpose, e.Status, e.FileName, e.ContentType, e.Size, e.Offset, e.StorageKey,
+		e.ProcessingStatus, e.ProcessAfter, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create media: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadOne(id uuid.UUID) (entity.Media, error) {
+	query := "SELECT " + mediaColumns + " FROM media WHERE id = $1"
+
+	media, err := scanMedia(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.Media{}, nil
+	}
+	if err != nil {
+		return entity.Media{}, fmt.Errorf("failed to read media: %v", err)
+	}
+
+	return media, nil
+}
+
+func (r *Repository) ReadMany(ids []uuid.UUID) ([]entity.Media, error) {
+	query := "SELECT " + mediaColumns + " FROM media WHERE id = ANY($1::uuid[])"
+
+	rows, err := r.db.Query(query, uuidArray(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media: %v", err)
+	}
+	defer rows.Close()
+
+	return scanMediaRows(rows)
+}
+
+func (r *Repository) UpdateOffset(id uuid.UUID, offset int64, updatedAt int64) error {
+	query := "UPDATE media SET upload_offset = $1, updated_at = $2 WHERE id = $3"
+
+	_, err := r.db.Exec(query, offset, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update upload offset: %v", err)
+	}
+
+	return nil
+}
+
+// Complete marks an upload as ready once its content has been stored.
+func (r *Repository) Complete(id uuid.UUID, contentType string, storageKey string, processingStatus processing_status_enum.ProcessingStatus, updatedAt int64) error {
+	query := `
+		UPDATE media
+		SET status = $1, content_type = $2, storage_key = $3, upload_offset = size, processing_status = $4, process_after = $5, updated_at = $5
+		WHERE id = $6
+	`
+
+	_, err := r.db.Exec(query, media_status_enum.Ready, contentType, storageKey, processingStatus, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to complete upload: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(id uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM media_variants WHERE media_id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete media variants: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM media WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete media: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMedia(row rowScanner) (entity.Media, error) {
+	var media entity.Media
+	err := row.Scan(
+		&media.ID, &media.OwnerID, &media.Purpose, &media.Status, &media.FileName, &media.ContentType,
+		&media.Size, &media.Offset, &media.StorageKey, &media.ProcessingStatus, &media.ProcessAttempts,
+		&media.Duration, &media.CreatedAt, &media.UpdatedAt,
+	)
+	return media, err
+}
+
+func scanMediaRows(rows *sql.Rows) ([]entity.Media, error) {
+	media := make([]entity.Media, 0)
+	for rows.Next() {
+		m, err := scanMedia(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan media: %v", err)
+		}
+		media = append(media, m)
+	}
+
+	return media, nil
+}
+
+func uuidArray(ids []uuid.UUID) interface{} {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return pq.Array(values)
+}
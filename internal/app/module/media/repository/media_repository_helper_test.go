@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	createMediaQuery       = "INSERT INTO media (id, owner_id, purpose, status, file_name, content_type, size, upload_offset, storage_key, processing_status, process_after, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)"
+	readMediaQuery         = "SELECT id, owner_id, purpose, status, file_name, content_type, size, upload_offset, storage_key, processing_status, process_attempts, duration, created_at, updated_at FROM media WHERE id = $1"
+	readManyMediaQuery     = "SELECT id, owner_id, purpose, status, file_name, content_type, size, upload_offset, storage_key, processing_status, process_attempts, duration, created_at, updated_at FROM media WHERE id = ANY($1::uuid[])"
+	updateOffsetQuery      = "UPDATE media SET upload_offset = $1, updated_at = $2 WHERE id = $3"
+	completeMediaQuery     = "UPDATE media SET status = $1, content_type = $2, storage_key = $3, upload_offset = size, processing_status = $4, process_after = $5, updated_at = $5 WHERE id = $6"
+	deleteVariantsQuery    = "DELETE FROM media_variants WHERE media_id = $1"
+	deleteMediaQuery       = "DELETE FROM media WHERE id = $1"
+	claimUnprocessedQuery  = "UPDATE media SET process_after = $1, process_attempts = process_attempts + 1 WHERE id IN ( SELECT id FROM media WHERE processing_status = $2 AND process_after <= $3 AND purpose = ANY($4::text[]) ORDER BY process_after LIMIT $5 FOR UPDATE SKIP LOCKED ) RETURNING id, owner_id, purpose, status, file_name, content_type, size, upload_offset, storage_key, processing_status, process_attempts, duration, created_at, updated_at"
+	createVariantQuery     = "INSERT INTO media_variants (media_id, name, format, width, height, size, storage_key, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	markProcessedQuery     = "UPDATE media SET processing_status = $1, process_error = '', size = $2, upload_offset = $2, updated_at = $3 WHERE id = $4"
+	markPackagedQuery      = "UPDATE media SET processing_status = $1, process_error = '', duration = $2, updated_at = $3 WHERE id = $4"
+	failProcessingQuery    = "UPDATE media SET processing_status = $1, process_after = $2, process_error = $3, updated_at = $4 WHERE id = $5"
+	readMediaVariantsQuery = "SELECT media_id, name, format, width, height, size, storage_key, created_at FROM media_variants WHERE media_id = ANY($1::uuid[]) ORDER BY media_id, width, format"
+)
+
+var MockMedia entity.Media = entity.Media{
+	ID:               uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11"),
+	OwnerID:          "user123",
+	Purpose:          "course_gallery",
+	Status:           "ready",
+	FileName:         "cover.png",
+	ContentType:      "image/png",
+	Size:             2048,
+	Offset:           2048,
+	StorageKey:       "course_gallery/6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11.png",
+	ProcessingStatus: "pending",
+	ProcessAttempts:  1,
+	CreatedAt:        121212,
+	UpdatedAt:        121212,
+}
+
+var MockVariant entity.MediaVariant = entity.MediaVariant{
+	MediaID:    MockMedia.ID,
+	Name:       "thumbnail",
+	Format:     "jpeg",
+	Width:      200,
+	Height:     150,
+	Size:       512,
+	StorageKey: "course_gallery/6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11/thumbnail.jpg",
+	CreatedAt:  131313,
+}
+
+func prepareMediaRows(media entity.Media) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "owner_id", "purpose", "status", "file_name", "content_type", "size", "upload_offset", "storage_key",
+		"processing_status", "process_attempts", "duration", "created_at", "updated_at",
+	}).AddRow(
+		media.ID, media.OwnerID, media.Purpose, media.Status, media.FileName, media.ContentType,
+		media.Size, media.Offset, media.StorageKey, media.ProcessingStatus, media.ProcessAttempts,
+		media.Duration, media.CreatedAt, media.UpdatedAt,
+	)
+}
+
+func prepareVariantRows(variant entity.MediaVariant) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"media_id", "name", "format", "width", "height", "size", "storage_key", "created_at",
+	}).AddRow(
+		variant.MediaID, variant.Name, variant.Format, variant.Width, variant.Height, variant.Size, variant.StorageKey, variant.CreatedAt,
+	)
+}
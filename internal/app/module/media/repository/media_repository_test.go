@@ -0,0 +1,190 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	"CodeWithAzri/internal/app/module/media/repository"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.MediaRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	m := MockMedia
+
+	t.Run("Create Success", func(t *testing.T) {
+		mock.ExpectExec(createMediaQuery).WithArgs(m.ID, m.OwnerID, string(m.Purpose), string(m.Status), m.FileName, m.ContentType, m.Size, m.Offset, m.StorageKey, string(m.ProcessingStatus), m.ProcessAfter, m.CreatedAt, m.UpdatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Create(m))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		mock.ExpectExec(createMediaQuery).WithArgs(m.ID, m.OwnerID, string(m.Purpose), string(m.Status), m.FileName, m.ContentType, m.Size, m.Offset, m.StorageKey, string(m.ProcessingStatus), m.ProcessAfter, m.CreatedAt, m.UpdatedAt).WillReturnError(errors.New("insert failed"))
+
+		err := repo.Create(m)
+
+		assert.EqualError(t, err, "failed to create media: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReadOne(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read One Success", func(t *testing.T) {
+		mock.ExpectQuery(readMediaQuery).WithArgs(MockMedia.ID).WillReturnRows(prepareMediaRows(MockMedia))
+
+		media, err := repo.ReadOne(MockMedia.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockMedia, media)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Read One Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readMediaQuery).WithArgs(MockMedia.ID).WillReturnError(sql.ErrNoRows)
+
+		media, err := repo.ReadOne(MockMedia.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.Media{}, media)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Read One Error", func(t *testing.T) {
+		mock.ExpectQuery(readMediaQuery).WithArgs(MockMedia.ID).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadOne(MockMedia.ID)
+
+		assert.EqualError(t, err, "failed to read media: query failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateOffset(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Update Offset Success", func(t *testing.T) {
+		mock.ExpectExec(updateOffsetQuery).WithArgs(int64(1024), int64(131313), MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateOffset(MockMedia.ID, 1024, 131313))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Update Offset Error", func(t *testing.T) {
+		mock.ExpectExec(updateOffsetQuery).WithArgs(int64(1024), int64(131313), MockMedia.ID).WillReturnError(errors.New("update failed"))
+
+		err := repo.UpdateOffset(MockMedia.ID, 1024, 131313)
+
+		assert.EqualError(t, err, "failed to update upload offset: update failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Complete(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Complete Success", func(t *testing.T) {
+		mock.ExpectExec(completeMediaQuery).WithArgs(string(media_status_enum.Ready), "image/png", MockMedia.StorageKey, string(processing_status_enum.Pending), int64(131313), MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Complete(MockMedia.ID, "image/png", MockMedia.StorageKey, processing_status_enum.Pending, 131313))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Complete Error", func(t *testing.T) {
+		mock.ExpectExec(completeMediaQuery).WithArgs(string(media_status_enum.Ready), "image/png", MockMedia.StorageKey, string(processing_status_enum.Pending), int64(131313), MockMedia.ID).WillReturnError(errors.New("update failed"))
+
+		err := repo.Complete(MockMedia.ID, "image/png", MockMedia.StorageKey, processing_status_enum.Pending, 131313)
+
+		assert.EqualError(t, err, "failed to complete upload: update failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReadMany(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	ids := []uuid.UUID{MockMedia.ID}
+
+	t.Run("Read Many Success", func(t *testing.T) {
+		mock.ExpectQuery(readManyMediaQuery).WithArgs(pq.Array([]string{MockMedia.ID.String()})).WillReturnRows(prepareMediaRows(MockMedia))
+
+		media, err := repo.ReadMany(ids)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Media{MockMedia}, media)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Read Many Error", func(t *testing.T) {
+		mock.ExpectQuery(readManyMediaQuery).WithArgs(pq.Array([]string{MockMedia.ID.String()})).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadMany(ids)
+
+		assert.EqualError(t, err, "failed to read media: query failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Delete Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(deleteMediaQuery).WithArgs(MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Delete(MockMedia.ID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Delete Variants Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(MockMedia.ID).WillReturnError(errors.New("delete failed"))
+		mock.ExpectRollback()
+
+		err := repo.Delete(MockMedia.ID)
+
+		assert.EqualError(t, err, "failed to delete media variants: delete failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Delete Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(uuid.Nil).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(deleteMediaQuery).WithArgs(uuid.Nil).WillReturnError(errors.New("delete failed"))
+		mock.ExpectRollback()
+
+		err := repo.Delete(uuid.Nil)
+
+		assert.EqualError(t, err, "failed to delete media: delete failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
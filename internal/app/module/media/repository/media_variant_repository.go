@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ClaimUnprocessed picks media of the given purposes waiting for their
+// pipeline and pushes their process_after to leaseUntil, so another worker
+// only picks them up again if this one dies before finishing. SKIP LOCKED
+// keeps concurrent workers from claiming the same rows.
+func (r *Repository) ClaimUnprocessed(purposes []media_purpose_enum.MediaPurpose, now int64, leaseUntil int64, limit int) ([]entity.Media, error) {
+	query := `
+		UPDATE media SET process_after = $1, process_attempts = process_attempts + 1
+		WHERE id IN (
+			SELECT id FROM media
+			WHERE processing_status = $2 AND process_after <= $3 AND purpose = ANY($4::text[])
+			ORDER BY process_after
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + mediaColumns
+
+	rows, err := r.db.Query(query, leaseUntil, processing_status_enum.Pending, now, purposeArray(purposes), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim unprocessed media: %v", err)
+	}
+	defer rows.Close()
+
+	return scanMediaRows(rows)
+}
+
+// SaveVariants replaces the variants of an image and marks it processed.
+// size is the size of the original once its metadata has been stripped.
+func (r *Repository) SaveVariants(id uuid.UUID, size int64, variants []entity.MediaVariant, updatedAt int64) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = replaceVariants(tx, id, variants)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE media SET processing_status = $1, process_error = '', size = $2, upload_offset = $2, updated_at = $3 WHERE id = $4", processing_status_enum.Done, size, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update processing status: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// SaveRenditions replaces the HLS renditions of a video, records its
+// duration in seconds and marks it processed.
+func (r *Repository) SaveRenditions(id uuid.UUID, duration int, renditions []entity.MediaVariant, updatedAt int64) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = replaceVariants(tx, id, renditions)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE media SET processing_status = $1, process_error = '', duration = $2, updated_at = $3 WHERE id = $4", processing_status_enum.Done, duration, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update processing status: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// FailProcessing records a failed attempt. status stays pending while the
+// image will be retried at processAfter.
+func (r *Repository) FailProcessing(id uuid.UUID, status processing_status_enum.ProcessingStatus, processAfter int64, message string, updatedAt int64) error {
+	query := "UPDATE media SET processing_status = $1, process_after = $2, process_error = $3, updated_at = $4 WHERE id = $5"
+
+	_, err := r.db.Exec(query, status, processAfter, message, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to record processing failure: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadVariants(ids []uuid.UUID) ([]entity.MediaVariant, error) {
+	query := `
+		SELECT media_id, name, format, width, height, size, storage_key, created_at
+		FROM media_variants
+		WHERE media_id = ANY($1::uuid[])
+		ORDER BY media_id, width, format
+	`
+
+	rows, err := r.db.Query(query, uuidArray(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media variants: %v", err)
+	}
+	defer rows.Close()
+
+	variants := make([]entity.MediaVariant, 0)
+	for rows.Next() {
+		var variant entity.MediaVariant
+		err := rows.Scan(&variant.MediaID, &variant.Name, &variant.Format, &variant.Width, &variant.Height, &variant.Size, &variant.StorageKey, &variant.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan media variant: %v", err)
+		}
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+func replaceVariants(tx *sql.Tx, id uuid.UUID, variants []entity.MediaVariant) error {
+	_, err := tx.Exec("DELETE FROM media_variants WHERE media_id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete media variants: %v", err)
+	}
+
+	for _, variant := range variants {
+		variantQuery := `
+			INSERT INTO media_variants (media_id, name, format, width, height, size, storage_key, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`
+		_, err = tx.Exec(variantQuery, id, variant.Name, variant.Format, variant.Width, variant.Height, variant.Size, variant.StorageKey, variant.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create media variant: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func purposeArray(purposes []media_purpose_enum.MediaPurpose) interface{} {
+	values := make([]string, 0, len(purposes))
+	for _, purpose := range purposes {
+		values = append(values, string(purpose))
+	}
+	return pq.Array(values)
+}
@@ -0,0 +1,163 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ClaimUnprocessed(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	purposes := []media_purpose_enum.MediaPurpose{media_purpose_enum.CourseGallery, media_purpose_enum.ProfilePicture}
+
+	t.Run("Claim Unprocessed Success", func(t *testing.T) {
+		mock.ExpectQuery(claimUnprocessedQuery).WithArgs(int64(431313), string(processing_status_enum.Pending), int64(131313), pq.Array([]string{"course_gallery", "profile_picture"}), 10).WillReturnRows(prepareMediaRows(MockMedia))
+
+		media, err := repo.ClaimUnprocessed(purposes, 131313, 431313, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Media{MockMedia}, media)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Claim Unprocessed Error", func(t *testing.T) {
+		mock.ExpectQuery(claimUnprocessedQuery).WithArgs(int64(431313), string(processing_status_enum.Pending), int64(131313), pq.Array([]string{"course_gallery", "profile_picture"}), 10).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ClaimUnprocessed(purposes, 131313, 431313, 10)
+
+		assert.EqualError(t, err, "failed to claim unprocessed media: query failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_SaveVariants(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	v := MockVariant
+
+	t.Run("Save Variants Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(createVariantQuery).WithArgs(MockMedia.ID, v.Name, v.Format, v.Width, v.Height, v.Size, v.StorageKey, v.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(markProcessedQuery).WithArgs(string(processing_status_enum.Done), int64(1900), int64(131313), MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.SaveVariants(MockMedia.ID, 1900, []entity.MediaVariant{v}, 131313)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Save Variants Insert Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(createVariantQuery).WithArgs(MockMedia.ID, v.Name, v.Format, v.Width, v.Height, v.Size, v.StorageKey, v.CreatedAt).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		err := repo.SaveVariants(MockMedia.ID, 1900, []entity.MediaVariant{v}, 131313)
+
+		assert.EqualError(t, err, "failed to create media variant: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_SaveRenditions(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	rendition := entity.MediaVariant{
+		MediaID:    MockMedia.ID,
+		Name:       "720p",
+		Format:     "hls",
+		Width:      1280,
+		Height:     720,
+		Size:       4096,
+		StorageKey: "lesson_video/6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11/hls/720p/index.m3u8",
+		CreatedAt:  131313,
+	}
+
+	t.Run("Save Renditions Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(createVariantQuery).WithArgs(MockMedia.ID, rendition.Name, rendition.Format, rendition.Width, rendition.Height, rendition.Size, rendition.StorageKey, rendition.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(markPackagedQuery).WithArgs(string(processing_status_enum.Done), 94, int64(131313), MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.SaveRenditions(MockMedia.ID, 94, []entity.MediaVariant{rendition}, 131313)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Save Renditions Update Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteVariantsQuery).WithArgs(MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(createVariantQuery).WithArgs(MockMedia.ID, rendition.Name, rendition.Format, rendition.Width, rendition.Height, rendition.Size, rendition.StorageKey, rendition.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(markPackagedQuery).WithArgs(string(processing_status_enum.Done), 94, int64(131313), MockMedia.ID).WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		err := repo.SaveRenditions(MockMedia.ID, 94, []entity.MediaVariant{rendition}, 131313)
+
+		assert.EqualError(t, err, "failed to update processing status: update failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_FailProcessing(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Fail Processing Success", func(t *testing.T) {
+		mock.ExpectExec(failProcessingQuery).WithArgs(string(processing_status_enum.Pending), int64(161313), "decode failed", int64(131313), MockMedia.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.FailProcessing(MockMedia.ID, processing_status_enum.Pending, 161313, "decode failed", 131313)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail Processing Error", func(t *testing.T) {
+		mock.ExpectExec(failProcessingQuery).WithArgs(string(processing_status_enum.Failed), int64(161313), "decode failed", int64(131313), MockMedia.ID).WillReturnError(errors.New("update failed"))
+
+		err := repo.FailProcessing(MockMedia.ID, processing_status_enum.Failed, 161313, "decode failed", 131313)
+
+		assert.EqualError(t, err, "failed to record processing failure: update failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReadVariants(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	ids := []uuid.UUID{MockMedia.ID}
+
+	t.Run("Read Variants Success", func(t *testing.T) {
+		mock.ExpectQuery(readMediaVariantsQuery).WithArgs(pq.Array([]string{MockMedia.ID.String()})).WillReturnRows(prepareVariantRows(MockVariant))
+
+		variants, err := repo.ReadVariants(ids)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.MediaVariant{MockVariant}, variants)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Read Variants Error", func(t *testing.T) {
+		mock.ExpectQuery(readMediaVariantsQuery).WithArgs(pq.Array([]string{MockMedia.ID.String()})).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadVariants(ids)
+
+		assert.EqualError(t, err, "failed to read media variants: query failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,612 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/media/entity"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+
+	mock "github.com/stretchr/testify/mock"
+
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+
+	uuid "github.com/google/uuid"
+)
+
+// MediaRepository is an autogenerated mock type for the MediaRepository type
+type MediaRepository struct {
+	mock.Mock
+}
+
+type MediaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MediaRepository) EXPECT() *MediaRepository_Expecter {
+	return &MediaRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimUnprocessed provides a mock function with given fields: purposes, now, leaseUntil, limit
+func (_m *MediaRepository) ClaimUnprocessed(purposes []media_purpose_enum.MediaPurpose, now int64, leaseUntil int64, limit int) ([]entity.Media, error) {
+	ret := _m.Called(purposes, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimUnprocessed")
+	}
+
+	var r0 []entity.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]media_purpose_enum.MediaPurpose, int64, int64, int) ([]entity.Media, error)); ok {
+		return rf(purposes, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func([]media_purpose_enum.MediaPurpose, int64, int64, int) []entity.Media); ok {
+		r0 = rf(purposes, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]media_purpose_enum.MediaPurpose, int64, int64, int) error); ok {
+		r1 = rf(purposes, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaRepository_ClaimUnprocessed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimUnprocessed'
+type MediaRepository_ClaimUnprocessed_Call struct {
+	*mock.Call
+}
+
+// ClaimUnprocessed is a helper method to define mock.On call
+//   - purposes []media_purpose_enum.MediaPurpose
+//   - now int64
+//   - leaseUntil int64
+//   - limit int
+func (_e *MediaRepository_Expecter) ClaimUnprocessed(purposes interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MediaRepository_ClaimUnprocessed_Call {
+	return &MediaRepository_ClaimUnprocessed_Call{Call: _e.mock.On("ClaimUnprocessed", purposes, now, leaseUntil, limit)}
+}
+
+func (_c *MediaRepository_ClaimUnprocessed_Call) Run(run func(purposes []media_purpose_enum.MediaPurpose, now int64, leaseUntil int64, limit int)) *MediaRepository_ClaimUnprocessed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]media_purpose_enum.MediaPurpose), args[1].(int64), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_ClaimUnprocessed_Call) Return(_a0 []entity.Media, _a1 error) *MediaRepository_ClaimUnprocessed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaRepository_ClaimUnprocessed_Call) RunAndReturn(run func([]media_purpose_enum.MediaPurpose, int64, int64, int) ([]entity.Media, error)) *MediaRepository_ClaimUnprocessed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function with given fields: id, contentType, storageKey, processingStatus, updatedAt
+func (_m *MediaRepository) Complete(id uuid.UUID, contentType string, storageKey string, processingStatus processing_status_enum.ProcessingStatus, updatedAt int64) error {
+	ret := _m.Called(id, contentType, storageKey, processingStatus, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, string, processing_status_enum.ProcessingStatus, int64) error); ok {
+		r0 = rf(id, contentType, storageKey, processingStatus, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MediaRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - contentType string
+//   - storageKey string
+//   - processingStatus processing_status_enum.ProcessingStatus
+//   - updatedAt int64
+func (_e *MediaRepository_Expecter) Complete(id interface{}, contentType interface{}, storageKey interface{}, processingStatus interface{}, updatedAt interface{}) *MediaRepository_Complete_Call {
+	return &MediaRepository_Complete_Call{Call: _e.mock.On("Complete", id, contentType, storageKey, processingStatus, updatedAt)}
+}
+
+func (_c *MediaRepository_Complete_Call) Run(run func(id uuid.UUID, contentType string, storageKey string, processingStatus processing_status_enum.ProcessingStatus, updatedAt int64)) *MediaRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(string), args[3].(processing_status_enum.ProcessingStatus), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_Complete_Call) Return(_a0 error) *MediaRepository_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_Complete_Call) RunAndReturn(run func(uuid.UUID, string, string, processing_status_enum.ProcessingStatus, int64) error) *MediaRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MediaRepository) Create(e entity.Media) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Media) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MediaRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e entity.Media
+func (_e *MediaRepository_Expecter) Create(e interface{}) *MediaRepository_Create_Call {
+	return &MediaRepository_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MediaRepository_Create_Call) Run(run func(e entity.Media)) *MediaRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.Media))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_Create_Call) Return(_a0 error) *MediaRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_Create_Call) RunAndReturn(run func(entity.Media) error) *MediaRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MediaRepository) Delete(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MediaRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MediaRepository_Expecter) Delete(id interface{}) *MediaRepository_Delete_Call {
+	return &MediaRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MediaRepository_Delete_Call) Run(run func(id uuid.UUID)) *MediaRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_Delete_Call) Return(_a0 error) *MediaRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error) *MediaRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailProcessing provides a mock function with given fields: id, status, processAfter, message, updatedAt
+func (_m *MediaRepository) FailProcessing(id uuid.UUID, status processing_status_enum.ProcessingStatus, processAfter int64, message string, updatedAt int64) error {
+	ret := _m.Called(id, status, processAfter, message, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailProcessing")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, processing_status_enum.ProcessingStatus, int64, string, int64) error); ok {
+		r0 = rf(id, status, processAfter, message, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_FailProcessing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailProcessing'
+type MediaRepository_FailProcessing_Call struct {
+	*mock.Call
+}
+
+// FailProcessing is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - status processing_status_enum.ProcessingStatus
+//   - processAfter int64
+//   - message string
+//   - updatedAt int64
+func (_e *MediaRepository_Expecter) FailProcessing(id interface{}, status interface{}, processAfter interface{}, message interface{}, updatedAt interface{}) *MediaRepository_FailProcessing_Call {
+	return &MediaRepository_FailProcessing_Call{Call: _e.mock.On("FailProcessing", id, status, processAfter, message, updatedAt)}
+}
+
+func (_c *MediaRepository_FailProcessing_Call) Run(run func(id uuid.UUID, status processing_status_enum.ProcessingStatus, processAfter int64, message string, updatedAt int64)) *MediaRepository_FailProcessing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(processing_status_enum.ProcessingStatus), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_FailProcessing_Call) Return(_a0 error) *MediaRepository_FailProcessing_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_FailProcessing_Call) RunAndReturn(run func(uuid.UUID, processing_status_enum.ProcessingStatus, int64, string, int64) error) *MediaRepository_FailProcessing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMany provides a mock function with given fields: ids
+func (_m *MediaRepository) ReadMany(ids []uuid.UUID) ([]entity.Media, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMany")
+	}
+
+	var r0 []entity.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]entity.Media, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []entity.Media); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
+type MediaRepository_ReadMany_Call struct {
+	*mock.Call
+}
+
+// ReadMany is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MediaRepository_Expecter) ReadMany(ids interface{}) *MediaRepository_ReadMany_Call {
+	return &MediaRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", ids)}
+}
+
+func (_c *MediaRepository_ReadMany_Call) Run(run func(ids []uuid.UUID)) *MediaRepository_ReadMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_ReadMany_Call) Return(_a0 []entity.Media, _a1 error) *MediaRepository_ReadMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaRepository_ReadMany_Call) RunAndReturn(run func([]uuid.UUID) ([]entity.Media, error)) *MediaRepository_ReadMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOne provides a mock function with given fields: id
+func (_m *MediaRepository) ReadOne(id uuid.UUID) (entity.Media, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOne")
+	}
+
+	var r0 entity.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.Media, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.Media); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.Media)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaRepository_ReadOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOne'
+type MediaRepository_ReadOne_Call struct {
+	*mock.Call
+}
+
+// ReadOne is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MediaRepository_Expecter) ReadOne(id interface{}) *MediaRepository_ReadOne_Call {
+	return &MediaRepository_ReadOne_Call{Call: _e.mock.On("ReadOne", id)}
+}
+
+func (_c *MediaRepository_ReadOne_Call) Run(run func(id uuid.UUID)) *MediaRepository_ReadOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_ReadOne_Call) Return(_a0 entity.Media, _a1 error) *MediaRepository_ReadOne_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaRepository_ReadOne_Call) RunAndReturn(run func(uuid.UUID) (entity.Media, error)) *MediaRepository_ReadOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadVariants provides a mock function with given fields: ids
+func (_m *MediaRepository) ReadVariants(ids []uuid.UUID) ([]entity.MediaVariant, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadVariants")
+	}
+
+	var r0 []entity.MediaVariant
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]entity.MediaVariant, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []entity.MediaVariant); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MediaVariant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaRepository_ReadVariants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadVariants'
+type MediaRepository_ReadVariants_Call struct {
+	*mock.Call
+}
+
+// ReadVariants is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MediaRepository_Expecter) ReadVariants(ids interface{}) *MediaRepository_ReadVariants_Call {
+	return &MediaRepository_ReadVariants_Call{Call: _e.mock.On("ReadVariants", ids)}
+}
+
+func (_c *MediaRepository_ReadVariants_Call) Run(run func(ids []uuid.UUID)) *MediaRepository_ReadVariants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_ReadVariants_Call) Return(_a0 []entity.MediaVariant, _a1 error) *MediaRepository_ReadVariants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaRepository_ReadVariants_Call) RunAndReturn(run func([]uuid.UUID) ([]entity.MediaVariant, error)) *MediaRepository_ReadVariants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRenditions provides a mock function with given fields: id, duration, renditions, updatedAt
+func (_m *MediaRepository) SaveRenditions(id uuid.UUID, duration int, renditions []entity.MediaVariant, updatedAt int64) error {
+	ret := _m.Called(id, duration, renditions, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRenditions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, []entity.MediaVariant, int64) error); ok {
+		r0 = rf(id, duration, renditions, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_SaveRenditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRenditions'
+type MediaRepository_SaveRenditions_Call struct {
+	*mock.Call
+}
+
+// SaveRenditions is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - duration int
+//   - renditions []entity.MediaVariant
+//   - updatedAt int64
+func (_e *MediaRepository_Expecter) SaveRenditions(id interface{}, duration interface{}, renditions interface{}, updatedAt interface{}) *MediaRepository_SaveRenditions_Call {
+	return &MediaRepository_SaveRenditions_Call{Call: _e.mock.On("SaveRenditions", id, duration, renditions, updatedAt)}
+}
+
+func (_c *MediaRepository_SaveRenditions_Call) Run(run func(id uuid.UUID, duration int, renditions []entity.MediaVariant, updatedAt int64)) *MediaRepository_SaveRenditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].([]entity.MediaVariant), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_SaveRenditions_Call) Return(_a0 error) *MediaRepository_SaveRenditions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_SaveRenditions_Call) RunAndReturn(run func(uuid.UUID, int, []entity.MediaVariant, int64) error) *MediaRepository_SaveRenditions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveVariants provides a mock function with given fields: id, size, variants, updatedAt
+func (_m *MediaRepository) SaveVariants(id uuid.UUID, size int64, variants []entity.MediaVariant, updatedAt int64) error {
+	ret := _m.Called(id, size, variants, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveVariants")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64, []entity.MediaVariant, int64) error); ok {
+		r0 = rf(id, size, variants, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_SaveVariants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveVariants'
+type MediaRepository_SaveVariants_Call struct {
+	*mock.Call
+}
+
+// SaveVariants is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - size int64
+//   - variants []entity.MediaVariant
+//   - updatedAt int64
+func (_e *MediaRepository_Expecter) SaveVariants(id interface{}, size interface{}, variants interface{}, updatedAt interface{}) *MediaRepository_SaveVariants_Call {
+	return &MediaRepository_SaveVariants_Call{Call: _e.mock.On("SaveVariants", id, size, variants, updatedAt)}
+}
+
+func (_c *MediaRepository_SaveVariants_Call) Run(run func(id uuid.UUID, size int64, variants []entity.MediaVariant, updatedAt int64)) *MediaRepository_SaveVariants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int64), args[2].([]entity.MediaVariant), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_SaveVariants_Call) Return(_a0 error) *MediaRepository_SaveVariants_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_SaveVariants_Call) RunAndReturn(run func(uuid.UUID, int64, []entity.MediaVariant, int64) error) *MediaRepository_SaveVariants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOffset provides a mock function with given fields: id, offset, updatedAt
+func (_m *MediaRepository) UpdateOffset(id uuid.UUID, offset int64, updatedAt int64) error {
+	ret := _m.Called(id, offset, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOffset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64, int64) error); ok {
+		r0 = rf(id, offset, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaRepository_UpdateOffset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOffset'
+type MediaRepository_UpdateOffset_Call struct {
+	*mock.Call
+}
+
+// UpdateOffset is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - offset int64
+//   - updatedAt int64
+func (_e *MediaRepository_Expecter) UpdateOffset(id interface{}, offset interface{}, updatedAt interface{}) *MediaRepository_UpdateOffset_Call {
+	return &MediaRepository_UpdateOffset_Call{Call: _e.mock.On("UpdateOffset", id, offset, updatedAt)}
+}
+
+func (_c *MediaRepository_UpdateOffset_Call) Run(run func(id uuid.UUID, offset int64, updatedAt int64)) *MediaRepository_UpdateOffset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MediaRepository_UpdateOffset_Call) Return(_a0 error) *MediaRepository_UpdateOffset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaRepository_UpdateOffset_Call) RunAndReturn(run func(uuid.UUID, int64, int64) error) *MediaRepository_UpdateOffset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMediaRepository creates a new instance of MediaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMediaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MediaRepository {
+	mock := &MediaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/entity"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"CodeWithAzri/pkg/imaging"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxProcessAttempts = 5
+	// processRetryDelay doubles after every failed attempt.
+	processRetryDelay = 30 * time.Second
+	// processLease is how long a claimed image is hidden from other workers.
+	processLease = 5 * time.Minute
+	jpegQuality  = 85
+	webpQuality  = 80
+)
+
+// imagePurposes are the uploads handled by the image pipeline.
+var imagePurposes = []media_purpose_enum.MediaPurpose{media_purpose_enum.CourseGallery, media_purpose_enum.ProfilePicture}
+
+// imageVariants are generated for every uploaded image, bounded by the
+// length of their longest edge.
+var imageVariants = []struct {
+	name    string
+	maxSize int
+}{
+	{name: "thumbnail", maxSize: 200},
+	{name: "medium", maxSize: 800},
+	{name: "large", maxSize: 1600},
+}
+
+// GetImages returns the processed images among ids with signed URLs for
+// their variants. Unknown, unfinished and non-image media is left out.
+func (s *Service) GetImages(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error) {
+	images := make(map[uuid.UUID]dto.MediaDTO)
+	if len(ids) == 0 {
+		return images, nil
+	}
+
+	media, err := s.repository.ReadMany(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := s.repository.ReadVariants(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	variantsByMedia := make(map[uuid.UUID][]entity.MediaVariant)
+	for _, variant := range variants {
+		variantsByMedia[variant.MediaID] = append(variantsByMedia[variant.MediaID], variant)
+	}
+
+	for _, m := range media {
+		if m.Status != media_status_enum.Ready || !isImage(m.Purpose) {
+			continue
+		}
+
+		m.Variants = variantsByMedia[m.ID]
+		images[m.ID], err = s.toDTO(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return images, nil
+}
+
+// ProcessImages runs the image pipeline on up to limit pending images and
+// reports how many of them succeeded. Failures are retried with exponential
+// backoff until maxProcessAttempts is reached.
+func (s *Service) ProcessImages(limit int) (int, error) {
+	now := timepkg.NowUnixMilli()
+
+	claimed, err := s.repository.ClaimUnprocessed(imagePurposes, now, now+processLease.Milliseconds(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, media := range claimed {
+		err := s.processImage(media)
+		if err == nil {
+			processed++
+			continue
+		}
+
+		err = s.retryProcessing(media, now, err)
+		if err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+// retryProcessing records a failed attempt of either pipeline and schedules
+// the next one, or gives up after maxProcessAttempts.
+func (s *Service) retryProcessing(media entity.Media, now int64, cause error) error {
+	// ProcessAttempts already counts this attempt.
+	attempt := max(media.ProcessAttempts, 1)
+	status := processing_status_enum.Pending
+	if attempt >= maxProcessAttempts {
+		status = processing_status_enum.Failed
+	}
+	retryAt := now + (processRetryDelay << (attempt - 1)).Milliseconds()
+	log.Printf("failed to process %s %s (attempt %d): %v\n", media.Purpose, media.ID, attempt, cause)
+
+	return s.repository.FailProcessing(media.ID, status, retryAt, cause.Error(), timepkg.NowUnixMilli())
+}
+
+// ImageQueued receives a value whenever a new image is waiting to be
+// processed, so workers do not have to wait for their next tick.
+func (s *Service) ImageQueued() <-chan struct{} {
+	return s.imageQueued
+}
+
+// queueProcessing wakes up the worker of the pipeline media is waiting for.
+func (s *Service) queueProcessing(media entity.Media) {
+	if media.ProcessingStatus != processing_status_enum.Pending {
+		return
+	}
+
+	queue := s.imageQueued
+	if media.Purpose == media_purpose_enum.LessonVideo {
+		queue = s.videoQueued
+	}
+
+	select {
+	case queue <- struct{}{}:
+	default:
+	}
+}
+
+// processImage strips metadata from the original and stores the resized
+// variants. Every step overwrites the same keys, so a retry after a partial
+// failure is safe.
+func (s *Service) processImage(media entity.Media) error {
+	ctx := context.Background()
+
+	body, err := s.storage.Get(ctx, media.StorageKey)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read image: %v", err)
+	}
+
+	img, _, err := imaging.Decode(data, s.maxImagePixels)
+	if err != nil {
+		return fmt.Errorf("failed to decode image: %v", err)
+	}
+
+	original, err := stripOriginal(data, img)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(original, data) {
+		err = s.storage.Put(ctx, media.StorageKey, bytes.NewReader(original), int64(len(original)), media.ContentType)
+		if err != nil {
+			return err
+		}
+	}
+
+	format := imaging.JPEG
+	if !imaging.IsOpaque(img) {
+		format = imaging.PNG
+	}
+
+	now := timepkg.NowUnixMilli()
+	variants := make([]entity.MediaVariant, 0, len(imageVariants)*2)
+
+	for _, size := range imageVariants {
+		resized := imaging.Fit(img, size.maxSize)
+
+		var encoded bytes.Buffer
+		err = imaging.Encode(&encoded, resized, format, jpegQuality)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s variant: %v", size.name, err)
+		}
+
+		variant, err := s.putVariant(media, size.name, format, resized, encoded.Bytes(), now)
+		if err != nil {
+			return err
+		}
+		variants = append(variants, variant)
+
+		if s.webp == nil {
+			continue
+		}
+
+		webp, err := s.webp.EncodeWebP(resized, webpQuality)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s WebP variant: %v", size.name, err)
+		}
+
+		variant, err = s.putVariant(media, size.name, imaging.WebP, resized, webp, now)
+		if err != nil {
+			return err
+		}
+		variants = append(variants, variant)
+	}
+
+	return s.repository.SaveVariants(media.ID, int64(len(original)), variants, now)
+}
+
+func (s *Service) putVariant(media entity.Media, name string, format imaging.Format, img image.Image, data []byte, now int64) (entity.MediaVariant, error) {
+	key := fmt.Sprintf("%s/%s/%s%s", media.Purpose, media.ID, name, format.Extension())
+
+	err := s.storage.Put(context.Background(), key, bytes.NewReader(data), int64(len(data)), format.ContentType())
+	if err != nil {
+		return entity.MediaVariant{}, err
+	}
+
+	return entity.MediaVariant{
+		MediaID:    media.ID,
+		Name:       name,
+		Format:     string(format),
+		Width:      img.Bounds().Dx(),
+		Height:     img.Bounds().Dy(),
+		Size:       int64(len(data)),
+		StorageKey: key,
+		CreatedAt:  now,
+	}, nil
+}
+
+// stripOriginal removes metadata from the uploaded file. JPEGs that rely on
+// their EXIF orientation are re-encoded upright instead, since dropping the
+// tag would turn them sideways.
+func stripOriginal(data []byte, upright image.Image) ([]byte, error) {
+	if imaging.Orientation(data) > 1 {
+		var encoded bytes.Buffer
+		err := imaging.Encode(&encoded, upright, imaging.JPEG, 92)
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encode image: %v", err)
+		}
+		return encoded.Bytes(), nil
+	}
+
+	stripped, err := imaging.StripMetadata(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to strip image metadata: %v", err)
+	}
+	return stripped, nil
+}
+
+func isImage(purpose media_purpose_enum.MediaPurpose) bool {
+	return purpose == media_purpose_enum.CourseGallery || purpose == media_purpose_enum.ProfilePicture
+}
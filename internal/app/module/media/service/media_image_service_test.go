@@ -0,0 +1,192 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	"CodeWithAzri/internal/app/module/media/repository/mocks"
+	"CodeWithAzri/internal/app/module/media/service"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"CodeWithAzri/pkg/storage"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeWebPEncoder stands in for cwebp, which is not installed on every
+// machine that runs the tests.
+type fakeWebPEncoder struct{}
+
+func (fakeWebPEncoder) EncodeWebP(img image.Image, quality int) ([]byte, error) {
+	return []byte("RIFF0000WEBP"), nil
+}
+
+var imagePurposes = []media_purpose_enum.MediaPurpose{media_purpose_enum.CourseGallery, media_purpose_enum.ProfilePicture}
+
+func encodedPNG(t *testing.T, width int, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestService_ProcessImages(t *testing.T) {
+	mockRepo := mocks.NewMediaRepository(t)
+	localStorage, err := storage.NewLocalStorage(t.TempDir(), "http://localhost/api/v1/media/files", "secret")
+	assert.NoError(t, err)
+	mediaService := service.NewMediaService(mockRepo, localStorage, fakeWebPEncoder{}, nil, nil, t.TempDir(), time.Minute, 50_000_000)
+
+	t.Run("Process Images Success", func(t *testing.T) {
+		original := encodedPNG(t, 1000, 500)
+		assert.NoError(t, localStorage.Put(context.Background(), MockMedia.StorageKey, bytes.NewReader(original), int64(len(original)), "image/png"))
+
+		claimed := MockMedia
+		claimed.ProcessingStatus = processing_status_enum.Pending
+		claimed.ProcessAttempts = 1
+
+		var saved []entity.MediaVariant
+		mockRepo.On("ClaimUnprocessed", imagePurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Media{claimed}, nil).Once()
+		mockRepo.On("SaveVariants", claimed.ID, mock.AnythingOfType("int64"), mock.Anything, mock.AnythingOfType("int64")).Run(func(args mock.Arguments) {
+			saved = args.Get(2).([]entity.MediaVariant)
+		}).Return(nil).Once()
+
+		processed, err := mediaService.ProcessImages(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+		assert.Len(t, saved, 6)
+
+		sizes := make(map[string][2]int)
+		for _, variant := range saved {
+			if variant.Format == "jpeg" {
+				sizes[variant.Name] = [2]int{variant.Width, variant.Height}
+			}
+
+			stored, err := localStorage.Get(context.Background(), variant.StorageKey)
+			assert.NoError(t, err)
+			stored.Close()
+		}
+		assert.Equal(t, [2]int{200, 100}, sizes["thumbnail"])
+		assert.Equal(t, [2]int{800, 400}, sizes["medium"])
+		// Images are never upscaled.
+		assert.Equal(t, [2]int{1000, 500}, sizes["large"])
+	})
+
+	t.Run("Process Images Retries With Backoff", func(t *testing.T) {
+		broken := MockMedia
+		broken.ID = uuid.MustParse("0b1f3d8c-9f51-4c35-9c2c-3a2b5e7c1d44")
+		broken.StorageKey = "course_gallery/broken.png"
+		broken.ProcessAttempts = 2
+		assert.NoError(t, localStorage.Put(context.Background(), broken.StorageKey, bytes.NewReader(mockText), int64(len(mockText)), "image/png"))
+
+		var retryAt int64
+		mockRepo.On("ClaimUnprocessed", imagePurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Media{broken}, nil).Once()
+		mockRepo.On("FailProcessing", broken.ID, processing_status_enum.Pending, mock.AnythingOfType("int64"), mock.AnythingOfType("string"), mock.AnythingOfType("int64")).Run(func(args mock.Arguments) {
+			retryAt = args.Get(2).(int64) - args.Get(4).(int64)
+		}).Return(nil).Once()
+
+		processed, err := mediaService.ProcessImages(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, processed)
+		assert.InDelta(t, time.Minute.Milliseconds(), retryAt, 1000)
+	})
+
+	t.Run("Process Images Gives Up", func(t *testing.T) {
+		missing := MockMedia
+		missing.StorageKey = "course_gallery/missing.png"
+		missing.ProcessAttempts = 5
+
+		mockRepo.On("ClaimUnprocessed", imagePurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return([]entity.Media{missing}, nil).Once()
+		mockRepo.On("FailProcessing", missing.ID, processing_status_enum.Failed, mock.AnythingOfType("int64"), storage.ErrObjectNotFound.Error(), mock.AnythingOfType("int64")).Return(nil).Once()
+
+		processed, err := mediaService.ProcessImages(10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+
+	t.Run("Process Images Claim Error", func(t *testing.T) {
+		mockRepo.On("ClaimUnprocessed", imagePurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 10).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := mediaService.ProcessImages(10)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_ProcessImages_StripsMetadata(t *testing.T) {
+	mockRepo := mocks.NewMediaRepository(t)
+	localStorage, err := storage.NewLocalStorage(t.TempDir(), "http://localhost/api/v1/media/files", "secret")
+	assert.NoError(t, err)
+	mediaService := service.NewMediaService(mockRepo, localStorage, nil, nil, nil, t.TempDir(), time.Minute, 50_000_000)
+
+	// A tEXt chunk right after IHDR, which ends 33 bytes into the file.
+	plain := encodedPNG(t, 20, 20)
+	textChunk := append([]byte{0, 0, 0, 7}, "tEXtAuthor\x00"...)
+	textChunk = binary.BigEndian.AppendUint32(textChunk, crc32.ChecksumIEEE(textChunk[4:]))
+	tagged := append(append(append([]byte{}, plain[:33]...), textChunk...), plain[33:]...)
+	assert.NoError(t, localStorage.Put(context.Background(), MockMedia.StorageKey, bytes.NewReader(tagged), int64(len(tagged)), "image/png"))
+
+	mockRepo.On("ClaimUnprocessed", imagePurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 1).Return([]entity.Media{MockMedia}, nil).Once()
+	mockRepo.On("SaveVariants", MockMedia.ID, int64(len(plain)), mock.Anything, mock.AnythingOfType("int64")).Return(nil).Once()
+
+	processed, err := mediaService.ProcessImages(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	stored, err := localStorage.Get(context.Background(), MockMedia.StorageKey)
+	assert.NoError(t, err)
+	content, _ := io.ReadAll(stored)
+	stored.Close()
+	assert.Equal(t, plain, content)
+}
+
+func TestService_GetImages(t *testing.T) {
+	mediaService, mockRepo, _, _ := initializeService(t)
+	ids := []uuid.UUID{MockMedia.ID, MockUpload.ID}
+
+	t.Run("Get Images Success", func(t *testing.T) {
+		mockRepo.On("ReadMany", ids).Return([]entity.Media{MockMedia, MockUpload}, nil).Once()
+		mockRepo.On("ReadVariants", ids).Return([]entity.MediaVariant{MockVariant}, nil).Once()
+
+		images, err := mediaService.GetImages(ids)
+
+		assert.NoError(t, err)
+		assert.Len(t, images, 1)
+		assert.Len(t, images[MockMedia.ID].Variants, 1)
+		assert.Contains(t, images[MockMedia.ID].Variants[0].URL, MockVariant.StorageKey)
+	})
+
+	t.Run("Get Images Empty", func(t *testing.T) {
+		images, err := mediaService.GetImages(nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, images)
+	})
+
+	t.Run("Get Images Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadMany", ids).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := mediaService.GetImages(ids)
+
+		assert.Error(t, err)
+	})
+}
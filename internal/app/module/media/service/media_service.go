@@ -0,0 +1,528 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/entity"
+	"CodeWithAzri/internal/app/module/media/repository"
+	"CodeWithAzri/pkg/adapter"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"CodeWithAzri/pkg/imaging"
+	"CodeWithAzri/pkg/signedurl"
+	"CodeWithAzri/pkg/storage"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"CodeWithAzri/pkg/video"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMediaNotFound        = errors.New("media not found")
+	ErrForbidden            = errors.New("you are not allowed to access this media")
+	ErrUnsupportedMediaType = errors.New("unsupported media type for this purpose")
+	ErrFileTooLarge         = errors.New("file is larger than allowed for this purpose")
+	ErrOffsetMismatch       = errors.New("upload offset does not match the received bytes")
+	ErrUploadComplete       = errors.New("upload is already complete")
+	ErrVideoNotReady        = errors.New("video is still being processed")
+)
+
+// sniffLength is the number of bytes http.DetectContentType looks at.
+const sniffLength = 512
+
+type purposeRule struct {
+	maxSize      int64
+	contentTypes []string
+}
+
+var purposeRules = map[media_purpose_enum.MediaPurpose]purposeRule{
+	media_purpose_enum.CourseGallery:  {maxSize: 10 << 20, contentTypes: []string{"image/jpeg", "image/png", "image/webp", "image/gif"}},
+	media_purpose_enum.ProfilePicture: {maxSize: 5 << 20, contentTypes: []string{"image/jpeg", "image/png", "image/webp"}},
+	media_purpose_enum.LessonVideo:    {maxSize: 2 << 30, contentTypes: []string{"video/mp4", "video/webm"}},
+}
+
+// MaxUploadSize is the largest file any purpose accepts.
+const MaxUploadSize int64 = 2 << 30
+
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+	"video/mp4":  ".mp4",
+	"video/webm": ".webm",
+}
+
+type MediaService interface {
+	Upload(ownerID string, input dto.UploadMediaDTO, body io.Reader) (dto.MediaDTO, error)
+	CreateUpload(ownerID string, input dto.UploadMediaDTO) (dto.MediaDTO, error)
+	GetUpload(id uuid.UUID, ownerID string) (dto.MediaDTO, error)
+	AppendUpload(id uuid.UUID, ownerID string, offset int64, chunk io.Reader) (dto.MediaDTO, error)
+	GetMedia(id uuid.UUID, userID string) (dto.MediaDTO, error)
+	DeleteMedia(id uuid.UUID, ownerID string) error
+	OpenFile(key string, expires int64, signature string) (io.ReadCloser, error)
+	GetImages(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)
+	ProcessImages(limit int) (int, error)
+	ImageQueued() <-chan struct{}
+	GetVideos(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)
+	StreamVideo(id uuid.UUID) (dto.StreamDTO, error)
+	OpenPlaylist(id uuid.UUID, name string, expires int64, signature string) ([]byte, error)
+	ProcessVideos(limit int) (int, error)
+	VideoQueued() <-chan struct{}
+	OnVideoProcessed(listener VideoListener)
+	URLTTL() time.Duration
+}
+
+type Service struct {
+	repository repository.MediaRepository
+	storage    storage.Storage
+	webp       imaging.WebPEncoder
+	transcoder video.Transcoder
+	streams    *signedurl.Signer
+	stagingDir string
+	urlTTL     time.Duration
+	// maxImagePixels caps the dimensions an image may declare before it is
+	// decoded.
+	maxImagePixels int
+	// uploadLocks serialises chunks of the same resumable upload.
+	uploadLocks    sync.Map
+	imageQueued    chan struct{}
+	videoQueued    chan struct{}
+	videoListeners []VideoListener
+}
+
+// NewMediaService stages resumable uploads in stagingDir until they are
+// complete, so every instance serving uploads must share it. WebP variants
+// are skipped when webp is nil, and lesson videos wait for their HLS
+// renditions until a transcoder is configured. streams signs the playlist
+// URLs handed to players. Images larger than maxImagePixels fail processing.
+func NewMediaService(r repository.MediaRepository, s storage.Storage, webp imaging.WebPEncoder, transcoder video.Transcoder, streams *signedurl.Signer, stagingDir string, urlTTL time.Duration, maxImagePixels int) MediaService {
+	service := new(Service)
+	service.repository = r
+	service.storage = s
+	service.webp = webp
+	service.transcoder = transcoder
+	service.streams = streams
+	service.stagingDir = stagingDir
+	service.urlTTL = urlTTL
+	service.maxImagePixels = maxImagePixels
+	service.imageQueued = make(chan struct{}, 1)
+	service.videoQueued = make(chan struct{}, 1)
+	return service
+}
+
+// URLTTL returns how long the signed URLs handed out by the service stay
+// valid.
+func (s *Service) URLTTL() time.Duration {
+	return s.urlTTL
+}
+
+func (s *Service) Upload(ownerID string, input dto.UploadMediaDTO, body io.Reader) (dto.MediaDTO, error) {
+	rule, err := ruleFor(input)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(body, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return dto.MediaDTO{}, err
+	}
+	head = head[:n]
+
+	contentType, err := detectContentType(rule, head)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	media := entity.Media{
+		ID:          uuid.New(),
+		OwnerID:     ownerID,
+		Purpose:     input.Purpose,
+		Status:      media_status_enum.Ready,
+		FileName:    input.FileName,
+		ContentType: contentType,
+		Size:        input.Size,
+		Offset:      input.Size,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	media.StorageKey = storageKey(media)
+	if needsProcessing(media.Purpose) {
+		media.ProcessingStatus = processing_status_enum.Pending
+		media.ProcessAfter = now
+	}
+
+	err = s.storage.Put(context.Background(), media.StorageKey, io.MultiReader(bytes.NewReader(head), body), media.Size, contentType)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	err = s.repository.Create(media)
+	if err != nil {
+		s.storage.Delete(context.Background(), media.StorageKey)
+		return dto.MediaDTO{}, err
+	}
+	s.queueProcessing(media)
+
+	return s.toDTO(media)
+}
+
+func (s *Service) CreateUpload(ownerID string, input dto.UploadMediaDTO) (dto.MediaDTO, error) {
+	_, err := ruleFor(input)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	media := entity.Media{
+		ID:        uuid.New(),
+		OwnerID:   ownerID,
+		Purpose:   input.Purpose,
+		Status:    media_status_enum.Uploading,
+		FileName:  input.FileName,
+		Size:      input.Size,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err = os.MkdirAll(s.stagingDir, 0o755)
+	if err != nil {
+		return dto.MediaDTO{}, fmt.Errorf("failed to create staging directory: %v", err)
+	}
+
+	file, err := os.Create(s.stagingPath(media.ID))
+	if err != nil {
+		return dto.MediaDTO{}, fmt.Errorf("failed to create staging file: %v", err)
+	}
+	file.Close()
+
+	err = s.repository.Create(media)
+	if err != nil {
+		os.Remove(s.stagingPath(media.ID))
+		return dto.MediaDTO{}, err
+	}
+
+	return s.toDTO(media)
+}
+
+func (s *Service) GetUpload(id uuid.UUID, ownerID string) (dto.MediaDTO, error) {
+	media, err := s.readOwnedMedia(id, ownerID)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	return s.toDTO(media)
+}
+
+// AppendUpload writes chunk at offset, which must match the bytes received
+// so far. The last chunk moves the file into storage and marks it ready.
+func (s *Service) AppendUpload(id uuid.UUID, ownerID string, offset int64, chunk io.Reader) (dto.MediaDTO, error) {
+	lock, _ := s.uploadLocks.LoadOrStore(id, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	media, err := s.readOwnedMedia(id, ownerID)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	if media.Status != media_status_enum.Uploading {
+		return dto.MediaDTO{}, ErrUploadComplete
+	}
+
+	if offset != media.Offset {
+		return dto.MediaDTO{}, ErrOffsetMismatch
+	}
+
+	file, err := os.OpenFile(s.stagingPath(id), os.O_WRONLY, 0)
+	if err != nil {
+		return dto.MediaDTO{}, fmt.Errorf("failed to open staging file: %v", err)
+	}
+
+	// Read one byte past the declared size so oversized uploads are caught.
+	remaining := media.Size - media.Offset
+	_, err = file.Seek(media.Offset, io.SeekStart)
+	if err == nil {
+		var written int64
+		written, err = io.Copy(file, io.LimitReader(chunk, remaining+1))
+		if err == nil && written > remaining {
+			err = ErrFileTooLarge
+		}
+		if err == nil {
+			media.Offset += written
+		}
+	}
+	if err != nil {
+		file.Truncate(media.Offset)
+		file.Close()
+		if errors.Is(err, ErrFileTooLarge) {
+			return dto.MediaDTO{}, err
+		}
+		return dto.MediaDTO{}, fmt.Errorf("failed to write upload chunk: %v", err)
+	}
+	file.Close()
+
+	media.UpdatedAt = timepkg.NowUnixMilli()
+	err = s.repository.UpdateOffset(id, media.Offset, media.UpdatedAt)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	if media.Offset == media.Size {
+		media, err = s.completeUpload(media)
+		if err != nil {
+			return dto.MediaDTO{}, err
+		}
+	}
+
+	return s.toDTO(media)
+}
+
+func (s *Service) GetMedia(id uuid.UUID, userID string) (dto.MediaDTO, error) {
+	media, err := s.repository.ReadOne(id)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	if media.ID == uuid.Nil {
+		return dto.MediaDTO{}, ErrMediaNotFound
+	}
+
+	// Finished images can be linked from courses and profiles, so any
+	// signed in user may read them. Unfinished uploads stay private, and so
+	// do lesson videos, which are only streamed to enrolled users.
+	if (media.Status != media_status_enum.Ready || media.Purpose == media_purpose_enum.LessonVideo) && media.OwnerID != userID {
+		return dto.MediaDTO{}, ErrMediaNotFound
+	}
+
+	media.Variants, err = s.repository.ReadVariants([]uuid.UUID{media.ID})
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	return s.toDTO(media)
+}
+
+func (s *Service) DeleteMedia(id uuid.UUID, ownerID string) error {
+	media, err := s.readOwnedMedia(id, ownerID)
+	if err != nil {
+		return err
+	}
+
+	variants, err := s.repository.ReadVariants([]uuid.UUID{id})
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range variants {
+		if variant.Format == hlsFormat {
+			err = s.deleteRendition(variant)
+		} else {
+			err = s.storage.Delete(context.Background(), variant.StorageKey)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if media.Purpose == media_purpose_enum.LessonVideo && media.ProcessingStatus == processing_status_enum.Done {
+		err = s.storage.Delete(context.Background(), hlsPrefix(media)+"/"+video.MasterPlaylist)
+		if err != nil {
+			return err
+		}
+	}
+
+	if media.StorageKey != "" {
+		return s.storage.Delete(context.Background(), media.StorageKey)
+	}
+
+	os.Remove(s.stagingPath(id))
+	return nil
+}
+
+// OpenFile serves signed URLs of storages that point back at the
+// application, such as the local filesystem storage.
+func (s *Service) OpenFile(key string, expires int64, signature string) (io.ReadCloser, error) {
+	verifier, ok := s.storage.(storage.SignatureVerifier)
+	if !ok {
+		return nil, ErrMediaNotFound
+	}
+
+	err := verifier.VerifySignature(key, expires, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := s.storage.Get(context.Background(), key)
+	if errors.Is(err, storage.ErrObjectNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		return nil, ErrMediaNotFound
+	}
+
+	return file, err
+}
+
+func (s *Service) completeUpload(media entity.Media) (entity.Media, error) {
+	stagingPath := s.stagingPath(media.ID)
+
+	file, err := os.Open(stagingPath)
+	if err != nil {
+		return entity.Media{}, fmt.Errorf("failed to open staging file: %v", err)
+	}
+	defer file.Close()
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return entity.Media{}, fmt.Errorf("failed to read staging file: %v", err)
+	}
+
+	contentType, err := detectContentType(purposeRules[media.Purpose], head[:n])
+	if err != nil {
+		// The content can never become valid, so drop the upload.
+		s.repository.Delete(media.ID)
+		os.Remove(stagingPath)
+		return entity.Media{}, err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return entity.Media{}, fmt.Errorf("failed to read staging file: %v", err)
+	}
+
+	media.ContentType = contentType
+	media.StorageKey = storageKey(media)
+
+	err = s.storage.Put(context.Background(), media.StorageKey, file, media.Size, contentType)
+	if err != nil {
+		return entity.Media{}, err
+	}
+
+	media.Status = media_status_enum.Ready
+	media.UpdatedAt = timepkg.NowUnixMilli()
+	if needsProcessing(media.Purpose) {
+		media.ProcessingStatus = processing_status_enum.Pending
+	}
+	err = s.repository.Complete(media.ID, media.ContentType, media.StorageKey, media.ProcessingStatus, media.UpdatedAt)
+	if err != nil {
+		return entity.Media{}, err
+	}
+
+	os.Remove(stagingPath)
+	s.uploadLocks.Delete(media.ID)
+	s.queueProcessing(media)
+
+	return media, nil
+}
+
+func (s *Service) readOwnedMedia(id uuid.UUID, ownerID string) (entity.Media, error) {
+	media, err := s.repository.ReadOne(id)
+	if err != nil {
+		return entity.Media{}, err
+	}
+
+	if media.ID == uuid.Nil {
+		return entity.Media{}, ErrMediaNotFound
+	}
+
+	if media.OwnerID != ownerID {
+		return entity.Media{}, ErrForbidden
+	}
+
+	return media, nil
+}
+
+// toDTO adds a signed download URL to ready media.
+func (s *Service) toDTO(media entity.Media) (dto.MediaDTO, error) {
+	mediaDTO, err := adapter.AnyToType[dto.MediaDTO](media)
+	if err != nil {
+		return dto.MediaDTO{}, err
+	}
+
+	if media.Status == media_status_enum.Ready {
+		mediaDTO.URL, err = s.storage.SignedURL(media.StorageKey, s.urlTTL)
+		if err != nil {
+			return dto.MediaDTO{}, err
+		}
+		mediaDTO.ExpiresAt = time.Now().Add(s.urlTTL).UnixMilli()
+	}
+
+	mediaDTO.Variants = make([]dto.ImageVariantDTO, 0, len(media.Variants))
+	for _, variant := range media.Variants {
+		// HLS renditions are only reachable through signed playlists.
+		if variant.Format == hlsFormat {
+			continue
+		}
+
+		variantURL, err := s.storage.SignedURL(variant.StorageKey, s.urlTTL)
+		if err != nil {
+			return dto.MediaDTO{}, err
+		}
+
+		mediaDTO.Variants = append(mediaDTO.Variants, dto.ImageVariantDTO{
+			Name:   variant.Name,
+			Format: variant.Format,
+			Width:  variant.Width,
+			Height: variant.Height,
+			Size:   variant.Size,
+			URL:    variantURL,
+		})
+	}
+
+	return mediaDTO, nil
+}
+
+func (s *Service) stagingPath(id uuid.UUID) string {
+	return filepath.Join(s.stagingDir, id.String()+".part")
+}
+
+func ruleFor(input dto.UploadMediaDTO) (purposeRule, error) {
+	rule, ok := purposeRules[input.Purpose]
+	if !ok {
+		return purposeRule{}, ErrUnsupportedMediaType
+	}
+
+	if input.Size > rule.maxSize {
+		return purposeRule{}, ErrFileTooLarge
+	}
+
+	return rule, nil
+}
+
+// detectContentType sniffs the content instead of trusting the type sent by
+// the client.
+func detectContentType(rule purposeRule, head []byte) (string, error) {
+	contentType := http.DetectContentType(head)
+	for _, allowed := range rule.contentTypes {
+		if contentType == allowed {
+			return contentType, nil
+		}
+	}
+	return "", ErrUnsupportedMediaType
+}
+
+// needsProcessing reports whether uploads of purpose go through the image or
+// video pipeline once they are stored.
+func needsProcessing(purpose media_purpose_enum.MediaPurpose) bool {
+	return isImage(purpose) || purpose == media_purpose_enum.LessonVideo
+}
+
+func storageKey(media entity.Media) string {
+	return string(media.Purpose) + "/" + media.ID.String() + extensions[media.ContentType]
+}
@@ -0,0 +1,66 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	"bytes"
+
+	"github.com/google/uuid"
+)
+
+// mockPNG starts with the PNG signature, which is all content sniffing
+// looks at.
+var mockPNG = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1016)...)
+
+var mockText = bytes.Repeat([]byte("plain text "), 10)
+
+var MockMedia entity.Media = entity.Media{
+	ID:          uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11"),
+	OwnerID:     "user123",
+	Purpose:     "course_gallery",
+	Status:      "ready",
+	FileName:    "cover.png",
+	ContentType: "image/png",
+	Size:        1024,
+	Offset:      1024,
+	StorageKey:  "course_gallery/6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11.png",
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+var MockUpload entity.Media = entity.Media{
+	ID:        uuid.MustParse("c1e7b7a0-5d1e-4c4e-8b0e-6b7f3c2d9e22"),
+	OwnerID:   "user123",
+	Purpose:   "course_gallery",
+	Status:    "uploading",
+	FileName:  "cover.png",
+	Size:      1024,
+	CreatedAt: 121212,
+	UpdatedAt: 121212,
+}
+
+var MockVariant entity.MediaVariant = entity.MediaVariant{
+	MediaID:    MockMedia.ID,
+	Name:       "thumbnail",
+	Format:     "jpeg",
+	Width:      200,
+	Height:     150,
+	Size:       512,
+	StorageKey: "course_gallery/6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11/thumbnail.jpg",
+	CreatedAt:  131313,
+}
+
+var MockVideo entity.Media = entity.Media{
+	ID:               uuid.MustParse("9d4b2c6e-7a1f-4e3b-8c5d-2f6a9b0e1c33"),
+	OwnerID:          "user123",
+	Purpose:          "lesson_video",
+	Status:           "ready",
+	FileName:         "intro.mp4",
+	ContentType:      "video/mp4",
+	Size:             4096,
+	Offset:           4096,
+	StorageKey:       "lesson_video/9d4b2c6e-7a1f-4e3b-8c5d-2f6a9b0e1c33.mp4",
+	ProcessingStatus: "done",
+	Duration:         94,
+	CreatedAt:        121212,
+	UpdatedAt:        121212,
+}
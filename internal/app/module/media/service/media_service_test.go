@@ -0,0 +1,359 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/entity"
+	"CodeWithAzri/internal/app/module/media/repository/mocks"
+	"CodeWithAzri/internal/app/module/media/service"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"CodeWithAzri/pkg/storage"
+	storageMocks "CodeWithAzri/pkg/storage/mocks"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func initializeService(t *testing.T) (service.MediaService, *mocks.MediaRepository, *storage.LocalStorage, string) {
+	mockRepo := mocks.NewMediaRepository(t)
+
+	localStorage, err := storage.NewLocalStorage(t.TempDir(), "http://localhost/api/v1/media/files", "secret")
+	assert.NoError(t, err)
+
+	stagingDir := t.TempDir()
+	mediaService := service.NewMediaService(mockRepo, localStorage, nil, nil, nil, stagingDir, time.Minute, 50_000_000)
+
+	return mediaService, mockRepo, localStorage, stagingDir
+}
+
+func TestService_Upload(t *testing.T) {
+	mediaService, mockRepo, localStorage, _ := initializeService(t)
+
+	t.Run("Upload Success", func(t *testing.T) {
+		var created entity.Media
+		mockRepo.On("Create", mock.AnythingOfType("entity.Media")).Run(func(args mock.Arguments) {
+			created = args.Get(0).(entity.Media)
+		}).Return(nil).Once()
+
+		input := dto.UploadMediaDTO{Purpose: "course_gallery", FileName: "cover.png", Size: int64(len(mockPNG))}
+		media, err := mediaService.Upload("user123", input, bytes.NewReader(mockPNG))
+
+		assert.NoError(t, err)
+		assert.Equal(t, media_status_enum.Ready, media.Status)
+		assert.Equal(t, "image/png", media.ContentType)
+		assert.Equal(t, "course_gallery/"+media.ID.String()+".png", created.StorageKey)
+		assert.Contains(t, media.URL, created.StorageKey+"?expires=")
+
+		stored, err := localStorage.Get(context.Background(), created.StorageKey)
+		assert.NoError(t, err)
+		content, _ := io.ReadAll(stored)
+		stored.Close()
+		assert.Equal(t, mockPNG, content)
+	})
+
+	t.Run("Upload Unsupported Media Type", func(t *testing.T) {
+		input := dto.UploadMediaDTO{Purpose: "course_gallery", FileName: "cover.png", Size: int64(len(mockText))}
+		_, err := mediaService.Upload("user123", input, bytes.NewReader(mockText))
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedMediaType)
+	})
+
+	t.Run("Upload Image As Video", func(t *testing.T) {
+		input := dto.UploadMediaDTO{Purpose: "lesson_video", FileName: "cover.png", Size: int64(len(mockPNG))}
+		_, err := mediaService.Upload("user123", input, bytes.NewReader(mockPNG))
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedMediaType)
+	})
+
+	t.Run("Upload Too Large", func(t *testing.T) {
+		input := dto.UploadMediaDTO{Purpose: "profile_picture", FileName: "me.png", Size: 6 << 20}
+		_, err := mediaService.Upload("user123", input, bytes.NewReader(mockPNG))
+
+		assert.ErrorIs(t, err, service.ErrFileTooLarge)
+	})
+
+	t.Run("Upload Repository Error Removes Object", func(t *testing.T) {
+		var created entity.Media
+		mockRepo.On("Create", mock.AnythingOfType("entity.Media")).Run(func(args mock.Arguments) {
+			created = args.Get(0).(entity.Media)
+		}).Return(errors.New("Repository Failure")).Once()
+
+		input := dto.UploadMediaDTO{Purpose: "course_gallery", FileName: "cover.png", Size: int64(len(mockPNG))}
+		_, err := mediaService.Upload("user123", input, bytes.NewReader(mockPNG))
+
+		assert.Error(t, err)
+		_, err = localStorage.Get(context.Background(), created.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+}
+
+func TestService_Upload_StorageError(t *testing.T) {
+	mockRepo := mocks.NewMediaRepository(t)
+	mockStorage := storageMocks.NewStorage(t)
+	mediaService := service.NewMediaService(mockRepo, mockStorage, nil, nil, nil, t.TempDir(), time.Minute, 50_000_000)
+
+	mockStorage.On("Put", mock.Anything, mock.Anything, mock.Anything, int64(len(mockPNG)), "image/png").Return(errors.New("Storage Failure")).Once()
+
+	input := dto.UploadMediaDTO{Purpose: "course_gallery", FileName: "cover.png", Size: int64(len(mockPNG))}
+	_, err := mediaService.Upload("user123", input, bytes.NewReader(mockPNG))
+
+	assert.EqualError(t, err, "Storage Failure")
+}
+
+func TestService_ResumableUpload(t *testing.T) {
+	mediaService, mockRepo, localStorage, stagingDir := initializeService(t)
+
+	var upload entity.Media
+	mockRepo.On("Create", mock.AnythingOfType("entity.Media")).Run(func(args mock.Arguments) {
+		upload = args.Get(0).(entity.Media)
+	}).Return(nil).Once()
+
+	created, err := mediaService.CreateUpload("user123", dto.UploadMediaDTO{Purpose: "course_gallery", FileName: "cover.png", Size: int64(len(mockPNG))})
+	assert.NoError(t, err)
+	assert.Equal(t, media_status_enum.Uploading, created.Status)
+	assert.Empty(t, created.URL)
+
+	readUpload := func() entity.Media { return upload }
+	mockRepo.On("ReadOne", created.ID).Return(func(uuid.UUID) entity.Media { return readUpload() }, nil)
+	mockRepo.On("UpdateOffset", created.ID, mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Run(func(args mock.Arguments) {
+		upload.Offset = args.Get(1).(int64)
+	}).Return(nil)
+
+	t.Run("Append First Chunk", func(t *testing.T) {
+		media, err := mediaService.AppendUpload(created.ID, "user123", 0, bytes.NewReader(mockPNG[:600]))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(600), media.Offset)
+	})
+
+	t.Run("Get Upload Offset", func(t *testing.T) {
+		media, err := mediaService.GetUpload(created.ID, "user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(600), media.Offset)
+	})
+
+	t.Run("Append Offset Mismatch", func(t *testing.T) {
+		_, err := mediaService.AppendUpload(created.ID, "user123", 0, bytes.NewReader(mockPNG[:600]))
+
+		assert.ErrorIs(t, err, service.ErrOffsetMismatch)
+	})
+
+	t.Run("Append Too Much", func(t *testing.T) {
+		_, err := mediaService.AppendUpload(created.ID, "user123", 600, bytes.NewReader(append(mockPNG[600:], 'x')))
+
+		assert.ErrorIs(t, err, service.ErrFileTooLarge)
+
+		info, err := os.Stat(filepath.Join(stagingDir, created.ID.String()+".part"))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(600), info.Size())
+	})
+
+	t.Run("Append Forbidden", func(t *testing.T) {
+		_, err := mediaService.AppendUpload(created.ID, "someone-else", 600, bytes.NewReader(mockPNG[600:]))
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+
+	t.Run("Append Last Chunk", func(t *testing.T) {
+		mockRepo.On("Complete", created.ID, "image/png", "course_gallery/"+created.ID.String()+".png", processing_status_enum.Pending, mock.AnythingOfType("int64")).Run(func(args mock.Arguments) {
+			upload.Status = media_status_enum.Ready
+		}).Return(nil).Once()
+
+		media, err := mediaService.AppendUpload(created.ID, "user123", 600, bytes.NewReader(mockPNG[600:]))
+
+		assert.NoError(t, err)
+		assert.Equal(t, media_status_enum.Ready, media.Status)
+		assert.NotEmpty(t, media.URL)
+
+		stored, err := localStorage.Get(context.Background(), "course_gallery/"+created.ID.String()+".png")
+		assert.NoError(t, err)
+		content, _ := io.ReadAll(stored)
+		stored.Close()
+		assert.Equal(t, mockPNG, content)
+
+		_, err = os.Stat(filepath.Join(stagingDir, created.ID.String()+".part"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Append After Completion", func(t *testing.T) {
+		_, err := mediaService.AppendUpload(created.ID, "user123", int64(len(mockPNG)), bytes.NewReader(nil))
+
+		assert.ErrorIs(t, err, service.ErrUploadComplete)
+	})
+}
+
+func TestService_ResumableUpload_Rejected(t *testing.T) {
+	mediaService, mockRepo, _, stagingDir := initializeService(t)
+
+	var upload entity.Media
+	mockRepo.On("Create", mock.AnythingOfType("entity.Media")).Run(func(args mock.Arguments) {
+		upload = args.Get(0).(entity.Media)
+	}).Return(nil).Once()
+
+	created, err := mediaService.CreateUpload("user123", dto.UploadMediaDTO{Purpose: "course_gallery", Size: int64(len(mockText))})
+	assert.NoError(t, err)
+
+	mockRepo.On("ReadOne", created.ID).Return(func(uuid.UUID) entity.Media { return upload }, nil).Once()
+	mockRepo.On("UpdateOffset", created.ID, int64(len(mockText)), mock.AnythingOfType("int64")).Return(nil).Once()
+	mockRepo.On("Delete", created.ID).Return(nil).Once()
+
+	_, err = mediaService.AppendUpload(created.ID, "user123", 0, bytes.NewReader(mockText))
+
+	assert.ErrorIs(t, err, service.ErrUnsupportedMediaType)
+	_, err = os.Stat(filepath.Join(stagingDir, created.ID.String()+".part"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestService_CreateUpload(t *testing.T) {
+	mediaService, mockRepo, _, _ := initializeService(t)
+
+	t.Run("Create Upload Too Large", func(t *testing.T) {
+		_, err := mediaService.CreateUpload("user123", dto.UploadMediaDTO{Purpose: "lesson_video", Size: 3 << 30})
+
+		assert.ErrorIs(t, err, service.ErrFileTooLarge)
+	})
+
+	t.Run("Create Upload Repository Error", func(t *testing.T) {
+		mockRepo.On("Create", mock.AnythingOfType("entity.Media")).Return(errors.New("Repository Failure")).Once()
+
+		_, err := mediaService.CreateUpload("user123", dto.UploadMediaDTO{Purpose: "lesson_video", Size: 1024})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetMedia(t *testing.T) {
+	mediaService, mockRepo, _, _ := initializeService(t)
+
+	t.Run("Get Media Success", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockMedia.ID).Return(MockMedia, nil).Once()
+		mockRepo.On("ReadVariants", []uuid.UUID{MockMedia.ID}).Return([]entity.MediaVariant{MockVariant}, nil).Once()
+
+		media, err := mediaService.GetMedia(MockMedia.ID, "viewer")
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockMedia.ID, media.ID)
+		assert.Contains(t, media.URL, MockMedia.StorageKey)
+		assert.NotZero(t, media.ExpiresAt)
+		assert.Len(t, media.Variants, 1)
+		assert.Equal(t, 200, media.Variants[0].Width)
+		assert.Contains(t, media.Variants[0].URL, MockVariant.StorageKey)
+	})
+
+	t.Run("Get Unfinished Upload Of Someone Else", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockUpload.ID).Return(MockUpload, nil).Once()
+
+		_, err := mediaService.GetMedia(MockUpload.ID, "viewer")
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+
+	t.Run("Get Lesson Video Of Someone Else", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockVideo.ID).Return(MockVideo, nil).Once()
+
+		_, err := mediaService.GetMedia(MockVideo.ID, "viewer")
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+
+	t.Run("Get Media Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", uuid.Nil).Return(entity.Media{}, nil).Once()
+
+		_, err := mediaService.GetMedia(uuid.Nil, "viewer")
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+
+	t.Run("Get Media Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockMedia.ID).Return(entity.Media{}, errors.New("Repository Failure")).Once()
+
+		_, err := mediaService.GetMedia(MockMedia.ID, "viewer")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_DeleteMedia(t *testing.T) {
+	mediaService, mockRepo, localStorage, _ := initializeService(t)
+
+	t.Run("Delete Media Success", func(t *testing.T) {
+		err := localStorage.Put(context.Background(), MockMedia.StorageKey, bytes.NewReader(mockPNG), int64(len(mockPNG)), "image/png")
+		assert.NoError(t, err)
+
+		err = localStorage.Put(context.Background(), MockVariant.StorageKey, bytes.NewReader(mockPNG), int64(len(mockPNG)), "image/png")
+		assert.NoError(t, err)
+
+		mockRepo.On("ReadOne", MockMedia.ID).Return(MockMedia, nil).Once()
+		mockRepo.On("ReadVariants", []uuid.UUID{MockMedia.ID}).Return([]entity.MediaVariant{MockVariant}, nil).Once()
+		mockRepo.On("Delete", MockMedia.ID).Return(nil).Once()
+
+		err = mediaService.DeleteMedia(MockMedia.ID, "user123")
+
+		assert.NoError(t, err)
+		_, err = localStorage.Get(context.Background(), MockMedia.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+		_, err = localStorage.Get(context.Background(), MockVariant.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+
+	t.Run("Delete Media Forbidden", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockMedia.ID).Return(MockMedia, nil).Once()
+
+		err := mediaService.DeleteMedia(MockMedia.ID, "someone-else")
+
+		assert.ErrorIs(t, err, service.ErrForbidden)
+	})
+}
+
+func TestService_OpenFile(t *testing.T) {
+	mediaService, _, localStorage, _ := initializeService(t)
+
+	err := localStorage.Put(context.Background(), MockMedia.StorageKey, bytes.NewReader(mockPNG), int64(len(mockPNG)), "image/png")
+	assert.NoError(t, err)
+
+	signedURL, _ := localStorage.SignedURL(MockMedia.StorageKey, time.Minute)
+	parsed, _ := url.Parse(signedURL)
+	expires, _ := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	signature := parsed.Query().Get("signature")
+
+	t.Run("Open File Success", func(t *testing.T) {
+		file, err := mediaService.OpenFile(MockMedia.StorageKey, expires, signature)
+
+		assert.NoError(t, err)
+		file.Close()
+	})
+
+	t.Run("Open File Invalid Signature", func(t *testing.T) {
+		_, err := mediaService.OpenFile(MockMedia.StorageKey, expires, "bad")
+
+		assert.ErrorIs(t, err, storage.ErrInvalidSignature)
+	})
+
+	t.Run("Open File Not Found", func(t *testing.T) {
+		assert.NoError(t, localStorage.Delete(context.Background(), MockMedia.StorageKey))
+
+		_, err := mediaService.OpenFile(MockMedia.StorageKey, expires, signature)
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+
+	t.Run("Open File Without Verifier", func(t *testing.T) {
+		s3Service := service.NewMediaService(mocks.NewMediaRepository(t), storageMocks.NewStorage(t), nil, nil, nil, t.TempDir(), time.Minute, 50_000_000)
+
+		_, err := s3Service.OpenFile(MockMedia.StorageKey, expires, signature)
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/media/dto"
+	"CodeWithAzri/internal/app/module/media/entity"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	media_status_enum "CodeWithAzri/pkg/enums/mediaStatus"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"CodeWithAzri/pkg/storage"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"CodeWithAzri/pkg/video"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// videoLease is how long a claimed video is hidden from other workers.
+	// Transcoding a long lecture into every rendition takes a while.
+	videoLease = time.Hour
+	hlsFormat  = "hls"
+)
+
+// videoPurposes are the uploads handled by the video pipeline.
+var videoPurposes = []media_purpose_enum.MediaPurpose{media_purpose_enum.LessonVideo}
+
+// VideoListener is told the duration in seconds of every video once its
+// renditions are stored.
+type VideoListener func(mediaID uuid.UUID, duration int) error
+
+// GetVideos returns the uploaded lesson videos among ids, whether or not
+// they have been packaged yet. Unknown, unfinished and non-video media is
+// left out.
+func (s *Service) GetVideos(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error) {
+	videos := make(map[uuid.UUID]dto.MediaDTO)
+	if len(ids) == 0 {
+		return videos, nil
+	}
+
+	media, err := s.repository.ReadMany(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range media {
+		if m.Status != media_status_enum.Ready || m.Purpose != media_purpose_enum.LessonVideo {
+			continue
+		}
+
+		videos[m.ID], err = s.toDTO(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return videos, nil
+}
+
+// StreamVideo signs the master playlist of a packaged video. It does not
+// check who is asking, so callers must authorize the viewer first.
+func (s *Service) StreamVideo(id uuid.UUID) (dto.StreamDTO, error) {
+	media, err := s.repository.ReadOne(id)
+	if err != nil {
+		return dto.StreamDTO{}, err
+	}
+
+	if media.ID == uuid.Nil || media.Purpose != media_purpose_enum.LessonVideo || media.Status != media_status_enum.Ready {
+		return dto.StreamDTO{}, ErrMediaNotFound
+	}
+
+	if media.ProcessingStatus != processing_status_enum.Done || s.streams == nil {
+		return dto.StreamDTO{}, ErrVideoNotReady
+	}
+
+	expires := time.Now().Add(s.urlTTL).Unix()
+
+	return dto.StreamDTO{
+		ManifestURL: s.streams.URL(id.String()+"/"+video.MasterPlaylist, expires),
+		ExpiresAt:   expires * 1000,
+		Duration:    media.Duration,
+	}, nil
+}
+
+// OpenPlaylist serves a signed playlist of a video with every URI in it
+// signed as well. Nested playlists share the expiry of the master playlist,
+// while segments stay valid long enough to watch the whole video.
+func (s *Service) OpenPlaylist(id uuid.UUID, name string, expires int64, signature string) ([]byte, error) {
+	if s.streams == nil {
+		return nil, ErrMediaNotFound
+	}
+
+	playlistPath := id.String() + "/" + name
+	err := s.streams.Verify(playlistPath, expires, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != video.MasterPlaylist && path.Base(name) != video.MediaPlaylist {
+		return nil, ErrMediaNotFound
+	}
+
+	media, err := s.repository.ReadOne(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if media.ID == uuid.Nil || media.Purpose != media_purpose_enum.LessonVideo || media.ProcessingStatus != processing_status_enum.Done {
+		return nil, ErrMediaNotFound
+	}
+
+	prefix := hlsPrefix(media)
+	playlist, err := s.readObject(prefix + "/" + name)
+	if errors.Is(err, storage.ErrObjectNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		return nil, ErrMediaNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dir := path.Dir(name)
+	segmentTTL := time.Until(time.Unix(expires, 0)) + time.Duration(media.Duration)*time.Second
+
+	return video.RewritePlaylist(playlist, func(uri string) (string, error) {
+		resolved, err := resolvePlaylistURI(dir, uri)
+		if err != nil {
+			return "", err
+		}
+
+		if strings.HasSuffix(resolved, ".m3u8") {
+			return s.streams.URL(id.String()+"/"+resolved, expires), nil
+		}
+		return s.storage.SignedURL(prefix+"/"+resolved, segmentTTL)
+	})
+}
+
+// ProcessVideos packages up to limit pending lesson videos for HLS and
+// reports how many of them succeeded. Without a transcoder videos stay
+// pending.
+func (s *Service) ProcessVideos(limit int) (int, error) {
+	if s.transcoder == nil {
+		return 0, nil
+	}
+
+	now := timepkg.NowUnixMilli()
+
+	claimed, err := s.repository.ClaimUnprocessed(videoPurposes, now, now+videoLease.Milliseconds(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, media := range claimed {
+		err := s.processVideo(media)
+		if err == nil {
+			processed++
+			continue
+		}
+
+		err = s.retryProcessing(media, now, err)
+		if err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+// VideoQueued receives a value whenever a new lesson video is waiting to be
+// packaged.
+func (s *Service) VideoQueued() <-chan struct{} {
+	return s.videoQueued
+}
+
+// OnVideoProcessed registers listener for every video packaged from now on.
+// Listeners are registered while the application starts, before any worker
+// runs.
+func (s *Service) OnVideoProcessed(listener VideoListener) {
+	s.videoListeners = append(s.videoListeners, listener)
+}
+
+// processVideo transcodes the original into every rendition that does not
+// upscale it and stores the playlists and segments next to it. Like the
+// image pipeline every step overwrites the same keys, so retries are safe.
+func (s *Service) processVideo(media entity.Media) error {
+	ctx := context.Background()
+
+	workDir, err := os.MkdirTemp("", "hls-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	input := filepath.Join(workDir, "source"+path.Ext(media.StorageKey))
+	err = s.downloadObject(ctx, media.StorageKey, input)
+	if err != nil {
+		return err
+	}
+
+	info, err := s.transcoder.Probe(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	prefix := hlsPrefix(media)
+	now := timepkg.NowUnixMilli()
+	renditions := video.RenditionsFor(info.Height)
+	variants := make([]video.Variant, 0, len(renditions))
+	saved := make([]entity.MediaVariant, 0, len(renditions))
+
+	for _, rendition := range renditions {
+		outputDir := filepath.Join(workDir, rendition.Name)
+		err = s.transcoder.PackageHLS(ctx, input, outputDir, rendition)
+		if err != nil {
+			return err
+		}
+
+		size, err := s.putDirectory(ctx, outputDir, prefix+"/"+rendition.Name)
+		if err != nil {
+			return err
+		}
+
+		uri := rendition.Name + "/" + video.MediaPlaylist
+		width := video.ScaledWidth(info, rendition.Height)
+		variants = append(variants, video.Variant{Rendition: rendition, Width: width, URI: uri})
+		saved = append(saved, entity.MediaVariant{
+			MediaID:    media.ID,
+			Name:       rendition.Name,
+			Format:     hlsFormat,
+			Width:      width,
+			Height:     rendition.Height,
+			Size:       size,
+			StorageKey: prefix + "/" + uri,
+			CreatedAt:  now,
+		})
+	}
+
+	master := video.WriteMasterPlaylist(variants)
+	err = s.storage.Put(ctx, prefix+"/"+video.MasterPlaylist, bytes.NewReader(master), int64(len(master)), video.PlaylistContentType)
+	if err != nil {
+		return err
+	}
+
+	duration := int(math.Round(info.Duration.Seconds()))
+	err = s.repository.SaveRenditions(media.ID, duration, saved, now)
+	if err != nil {
+		return err
+	}
+
+	// The video is ready either way, so a failing listener is only logged.
+	for _, listener := range s.videoListeners {
+		err := listener(media.ID, duration)
+		if err != nil {
+			log.Printf("failed to notify video listener about %s: %v\n", media.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// putDirectory stores every file of dir below prefix and returns their total
+// size. Playlists go last so they never point at missing segments.
+func (s *Service) putDirectory(ctx context.Context, dir string, prefix string) (int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read rendition directory: %v", err)
+	}
+
+	files := make([]os.DirEntry, 0, len(entries))
+	playlists := make([]os.DirEntry, 0, 1)
+	for _, entry := range entries {
+		switch {
+		case entry.IsDir():
+		case path.Ext(entry.Name()) == ".m3u8":
+			playlists = append(playlists, entry)
+		default:
+			files = append(files, entry)
+		}
+	}
+
+	var total int64
+	for _, entry := range append(files, playlists...) {
+		size, err := s.putFile(ctx, filepath.Join(dir, entry.Name()), prefix+"/"+entry.Name())
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+
+	return total, nil
+}
+
+func (s *Service) putFile(ctx context.Context, filePath string, key string) (int64, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open rendition file: %v", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read rendition file: %v", err)
+	}
+
+	contentType := "application/octet-stream"
+	switch path.Ext(key) {
+	case ".m3u8":
+		contentType = video.PlaylistContentType
+	case ".ts":
+		contentType = video.SegmentContentType
+	}
+
+	err = s.storage.Put(ctx, key, file, info.Size(), contentType)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+func (s *Service) downloadObject(ctx context.Context, key string, filePath string) error {
+	body, err := s.storage.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create video file: %v", err)
+	}
+
+	_, err = io.Copy(file, body)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download video: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) readObject(key string) ([]byte, error) {
+	body, err := s.storage.Get(context.Background(), key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", key, err)
+	}
+
+	return data, nil
+}
+
+// deleteRendition removes the segments listed in the playlist of a rendition
+// and then the playlist itself.
+func (s *Service) deleteRendition(rendition entity.MediaVariant) error {
+	ctx := context.Background()
+
+	playlist, err := s.readObject(rendition.StorageKey)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dir := path.Dir(rendition.StorageKey)
+	keys := make([]string, 0)
+	_, err = video.RewritePlaylist(playlist, func(uri string) (string, error) {
+		resolved, err := resolvePlaylistURI(".", uri)
+		if err != nil {
+			return "", err
+		}
+		keys = append(keys, dir+"/"+resolved)
+		return uri, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range append(keys, rendition.StorageKey) {
+		err = s.storage.Delete(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolvePlaylistURI turns a URI found in a playlist stored in dir into a
+// path relative to the HLS root of the video. Playlists are written by
+// ffmpeg and this service, so anything absolute or outside the root is
+// rejected rather than signed.
+func resolvePlaylistURI(dir string, uri string) (string, error) {
+	resolved := path.Join(dir, uri)
+	if strings.Contains(uri, "://") || strings.HasPrefix(uri, "/") || resolved == ".." || strings.HasPrefix(resolved, "../") {
+		return "", fmt.Errorf("invalid playlist entry %q", uri)
+	}
+	return resolved, nil
+}
+
+func hlsPrefix(media entity.Media) string {
+	return fmt.Sprintf("%s/%s/hls", media.Purpose, media.ID)
+}
@@ -0,0 +1,307 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/entity"
+	"CodeWithAzri/internal/app/module/media/repository/mocks"
+	"CodeWithAzri/internal/app/module/media/service"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
+	processing_status_enum "CodeWithAzri/pkg/enums/processingStatus"
+	"CodeWithAzri/pkg/signedurl"
+	"CodeWithAzri/pkg/storage"
+	"CodeWithAzri/pkg/video"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var videoPurposes = []media_purpose_enum.MediaPurpose{media_purpose_enum.LessonVideo}
+
+// fakeTranscoder stands in for ffmpeg and writes a two segment rendition.
+type fakeTranscoder struct {
+	info     video.Info
+	probeErr error
+}
+
+func (f fakeTranscoder) Probe(ctx context.Context, input string) (video.Info, error) {
+	return f.info, f.probeErr
+}
+
+func (f fakeTranscoder) PackageHLS(ctx context.Context, input string, outputDir string, rendition video.Rendition) error {
+	err := os.MkdirAll(outputDir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range []string{"segment_0000.ts", "segment_0001.ts"} {
+		err = os.WriteFile(filepath.Join(outputDir, segment), []byte(rendition.Name), 0o644)
+		if err != nil {
+			return err
+		}
+	}
+
+	playlist := "#EXTM3U\n#EXTINF:6.0,\nsegment_0000.ts\n#EXTINF:3.5,\nsegment_0001.ts\n#EXT-X-ENDLIST\n"
+	return os.WriteFile(filepath.Join(outputDir, video.MediaPlaylist), []byte(playlist), 0o644)
+}
+
+func initializeVideoService(t *testing.T, transcoder video.Transcoder) (service.MediaService, *mocks.MediaRepository, *storage.LocalStorage, *signedurl.Signer) {
+	mockRepo := mocks.NewMediaRepository(t)
+
+	localStorage, err := storage.NewLocalStorage(t.TempDir(), "http://localhost/api/v1/media/files", "secret")
+	assert.NoError(t, err)
+
+	signer, err := signedurl.NewSigner("http://localhost/api/v1/media/streams", "stream-secret")
+	assert.NoError(t, err)
+
+	mediaService := service.NewMediaService(mockRepo, localStorage, nil, transcoder, signer, t.TempDir(), time.Minute, 50_000_000)
+
+	return mediaService, mockRepo, localStorage, signer
+}
+
+// signedQuery extracts the expiry and signature of a signed URL.
+func signedQuery(t *testing.T, signedURL string) (string, int64, string) {
+	parsed, err := url.Parse(signedURL)
+	assert.NoError(t, err)
+
+	expires, _ := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	return parsed.Path, expires, parsed.Query().Get("signature")
+}
+
+func TestService_ProcessVideos(t *testing.T) {
+	transcoder := fakeTranscoder{info: video.Info{Duration: 93600 * time.Millisecond, Width: 1280, Height: 720}}
+	mediaService, mockRepo, localStorage, _ := initializeVideoService(t, transcoder)
+
+	claimed := MockVideo
+	claimed.ProcessingStatus = processing_status_enum.Pending
+	claimed.Duration = 0
+	claimed.ProcessAttempts = 1
+
+	t.Run("Process Videos Success", func(t *testing.T) {
+		assert.NoError(t, localStorage.Put(context.Background(), claimed.StorageKey, bytes.NewReader([]byte("mp4")), 3, "video/mp4"))
+
+		var notified []int
+		mediaService.OnVideoProcessed(func(mediaID uuid.UUID, duration int) error {
+			assert.Equal(t, claimed.ID, mediaID)
+			notified = append(notified, duration)
+			return errors.New("listener failures are only logged")
+		})
+
+		var saved []entity.MediaVariant
+		mockRepo.On("ClaimUnprocessed", videoPurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 1).Return([]entity.Media{claimed}, nil).Once()
+		mockRepo.On("SaveRenditions", claimed.ID, 94, mock.Anything, mock.AnythingOfType("int64")).Run(func(args mock.Arguments) {
+			saved = args.Get(2).([]entity.MediaVariant)
+		}).Return(nil).Once()
+
+		processed, err := mediaService.ProcessVideos(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+		assert.Equal(t, []int{94}, notified)
+
+		names := make([]string, 0, len(saved))
+		for _, rendition := range saved {
+			names = append(names, rendition.Name)
+			assert.Equal(t, "hls", rendition.Format)
+			assert.Equal(t, int64(len(rendition.Name))*2+int64(len("#EXTM3U\n#EXTINF:6.0,\nsegment_0000.ts\n#EXTINF:3.5,\nsegment_0001.ts\n#EXT-X-ENDLIST\n")), rendition.Size)
+		}
+		// 1080p would upscale the 720p original.
+		assert.Equal(t, []string{"360p", "480p", "720p"}, names)
+		assert.Equal(t, 1280, saved[2].Width)
+		assert.Equal(t, "lesson_video/"+claimed.ID.String()+"/hls/720p/index.m3u8", saved[2].StorageKey)
+
+		body, err := localStorage.Get(context.Background(), "lesson_video/"+claimed.ID.String()+"/hls/master.m3u8")
+		assert.NoError(t, err)
+		master, _ := io.ReadAll(body)
+		body.Close()
+		assert.Contains(t, string(master), "RESOLUTION=640x360")
+		assert.Contains(t, string(master), "720p/index.m3u8")
+
+		segment, err := localStorage.Get(context.Background(), "lesson_video/"+claimed.ID.String()+"/hls/480p/segment_0001.ts")
+		assert.NoError(t, err)
+		segment.Close()
+	})
+
+	t.Run("Process Videos Probe Failure", func(t *testing.T) {
+		failing, failingRepo, failingStorage, _ := initializeVideoService(t, fakeTranscoder{probeErr: errors.New("moov atom not found")})
+		assert.NoError(t, failingStorage.Put(context.Background(), claimed.StorageKey, bytes.NewReader([]byte("mp4")), 3, "video/mp4"))
+
+		failingRepo.On("ClaimUnprocessed", videoPurposes, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), 1).Return([]entity.Media{claimed}, nil).Once()
+		failingRepo.On("FailProcessing", claimed.ID, processing_status_enum.Pending, mock.AnythingOfType("int64"), "moov atom not found", mock.AnythingOfType("int64")).Return(nil).Once()
+
+		processed, err := failing.ProcessVideos(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+
+	t.Run("Process Videos Without Transcoder", func(t *testing.T) {
+		disabled, _, _, _ := initializeVideoService(t, nil)
+
+		processed, err := disabled.ProcessVideos(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+}
+
+func TestService_GetVideos(t *testing.T) {
+	mediaService, mockRepo, _, _ := initializeVideoService(t, nil)
+
+	t.Run("Get Videos Filters Other Media", func(t *testing.T) {
+		ids := []uuid.UUID{MockVideo.ID, MockMedia.ID}
+		mockRepo.On("ReadMany", ids).Return([]entity.Media{MockVideo, MockMedia}, nil).Once()
+
+		videos, err := mediaService.GetVideos(ids)
+
+		assert.NoError(t, err)
+		assert.Len(t, videos, 1)
+		assert.Equal(t, 94, videos[MockVideo.ID].Duration)
+	})
+
+	t.Run("Get Videos Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadMany", []uuid.UUID{MockVideo.ID}).Return(nil, errors.New("Repository Failure")).Once()
+
+		_, err := mediaService.GetVideos([]uuid.UUID{MockVideo.ID})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_StreamVideo(t *testing.T) {
+	mediaService, mockRepo, _, signer := initializeVideoService(t, nil)
+
+	t.Run("Stream Video Success", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockVideo.ID).Return(MockVideo, nil).Once()
+
+		stream, err := mediaService.StreamVideo(MockVideo.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 94, stream.Duration)
+
+		path, expires, signature := signedQuery(t, stream.ManifestURL)
+		assert.Equal(t, "/api/v1/media/streams/"+MockVideo.ID.String()+"/master.m3u8", path)
+		assert.Equal(t, expires*1000, stream.ExpiresAt)
+		assert.NoError(t, signer.Verify(MockVideo.ID.String()+"/master.m3u8", expires, signature))
+	})
+
+	t.Run("Stream Video Not Ready", func(t *testing.T) {
+		pending := MockVideo
+		pending.ProcessingStatus = processing_status_enum.Pending
+		mockRepo.On("ReadOne", MockVideo.ID).Return(pending, nil).Once()
+
+		_, err := mediaService.StreamVideo(MockVideo.ID)
+
+		assert.ErrorIs(t, err, service.ErrVideoNotReady)
+	})
+
+	t.Run("Stream Image", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockMedia.ID).Return(MockMedia, nil).Once()
+
+		_, err := mediaService.StreamVideo(MockMedia.ID)
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+}
+
+func TestService_OpenPlaylist(t *testing.T) {
+	mediaService, mockRepo, localStorage, signer := initializeVideoService(t, nil)
+
+	prefix := "lesson_video/" + MockVideo.ID.String() + "/hls/"
+	master := video.WriteMasterPlaylist([]video.Variant{{Rendition: video.Ladder[0], Width: 640, URI: "360p/index.m3u8"}})
+	rendition := []byte("#EXTM3U\n#EXTINF:6.0,\nsegment_0000.ts\n#EXT-X-ENDLIST\n")
+	assert.NoError(t, localStorage.Put(context.Background(), prefix+"master.m3u8", bytes.NewReader(master), int64(len(master)), video.PlaylistContentType))
+	assert.NoError(t, localStorage.Put(context.Background(), prefix+"360p/index.m3u8", bytes.NewReader(rendition), int64(len(rendition)), video.PlaylistContentType))
+	assert.NoError(t, localStorage.Put(context.Background(), prefix+"360p/segment_0000.ts", strings.NewReader("ts"), 2, video.SegmentContentType))
+
+	expires := time.Now().Add(time.Minute).Unix()
+	sign := func(name string) string {
+		_, _, signature := signedQuery(t, signer.URL(MockVideo.ID.String()+"/"+name, expires))
+		return signature
+	}
+
+	t.Run("Open Master Playlist", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockVideo.ID).Return(MockVideo, nil).Once()
+
+		playlist, err := mediaService.OpenPlaylist(MockVideo.ID, "master.m3u8", expires, sign("master.m3u8"))
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(playlist)), "\n")
+		path, nestedExpires, signature := signedQuery(t, lines[len(lines)-1])
+		assert.Equal(t, "/api/v1/media/streams/"+MockVideo.ID.String()+"/360p/index.m3u8", path)
+		assert.Equal(t, expires, nestedExpires)
+		assert.NoError(t, signer.Verify(MockVideo.ID.String()+"/360p/index.m3u8", nestedExpires, signature))
+	})
+
+	t.Run("Open Rendition Playlist", func(t *testing.T) {
+		mockRepo.On("ReadOne", MockVideo.ID).Return(MockVideo, nil).Once()
+
+		playlist, err := mediaService.OpenPlaylist(MockVideo.ID, "360p/index.m3u8", expires, sign("360p/index.m3u8"))
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(playlist)), "\n")
+		path, segmentExpires, signature := signedQuery(t, lines[2])
+		assert.Equal(t, "/api/v1/media/files/"+prefix+"360p/segment_0000.ts", path)
+		// Segments outlive the playlist by the length of the video.
+		assert.InDelta(t, expires+94, segmentExpires, 1)
+		assert.NoError(t, localStorage.VerifySignature(prefix+"360p/segment_0000.ts", segmentExpires, signature))
+	})
+
+	t.Run("Open Playlist Invalid Signature", func(t *testing.T) {
+		_, err := mediaService.OpenPlaylist(MockVideo.ID, "360p/index.m3u8", expires, sign("master.m3u8"))
+
+		assert.ErrorIs(t, err, signedurl.ErrInvalidSignature)
+	})
+
+	t.Run("Open Playlist Of Unprocessed Video", func(t *testing.T) {
+		pending := MockVideo
+		pending.ProcessingStatus = processing_status_enum.Pending
+		mockRepo.On("ReadOne", MockVideo.ID).Return(pending, nil).Once()
+
+		_, err := mediaService.OpenPlaylist(MockVideo.ID, "master.m3u8", expires, sign("master.m3u8"))
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+
+	t.Run("Open Segment As Playlist", func(t *testing.T) {
+		_, err := mediaService.OpenPlaylist(MockVideo.ID, "360p/segment_0000.ts", expires, sign("360p/segment_0000.ts"))
+
+		assert.ErrorIs(t, err, service.ErrMediaNotFound)
+	})
+}
+
+func TestService_DeleteVideo(t *testing.T) {
+	mediaService, mockRepo, localStorage, _ := initializeVideoService(t, nil)
+
+	prefix := "lesson_video/" + MockVideo.ID.String() + "/hls/"
+	rendition := entity.MediaVariant{MediaID: MockVideo.ID, Name: "360p", Format: "hls", StorageKey: prefix + "360p/index.m3u8"}
+	playlist := []byte("#EXTM3U\n#EXTINF:6.0,\nsegment_0000.ts\n#EXT-X-ENDLIST\n")
+
+	keys := []string{MockVideo.StorageKey, prefix + "master.m3u8", prefix + "360p/segment_0000.ts"}
+	for _, key := range keys {
+		assert.NoError(t, localStorage.Put(context.Background(), key, strings.NewReader("x"), 1, "application/octet-stream"))
+	}
+	assert.NoError(t, localStorage.Put(context.Background(), rendition.StorageKey, bytes.NewReader(playlist), int64(len(playlist)), video.PlaylistContentType))
+
+	mockRepo.On("ReadOne", MockVideo.ID).Return(MockVideo, nil).Once()
+	mockRepo.On("ReadVariants", []uuid.UUID{MockVideo.ID}).Return([]entity.MediaVariant{rendition}, nil).Once()
+	mockRepo.On("Delete", MockVideo.ID).Return(nil).Once()
+
+	err := mediaService.DeleteMedia(MockVideo.ID, "user123")
+
+	assert.NoError(t, err)
+	for _, key := range append(keys, rendition.StorageKey) {
+		_, err = localStorage.Get(context.Background(), key)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound, key)
+	}
+}
@@ -0,0 +1,955 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/media/dto"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "CodeWithAzri/internal/app/module/media/service"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MediaService is an autogenerated mock type for the MediaService type
+type MediaService struct {
+	mock.Mock
+}
+
+type MediaService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MediaService) EXPECT() *MediaService_Expecter {
+	return &MediaService_Expecter{mock: &_m.Mock}
+}
+
+// AppendUpload provides a mock function with given fields: id, ownerID, offset, chunk
+func (_m *MediaService) AppendUpload(id uuid.UUID, ownerID string, offset int64, chunk io.Reader) (dto.MediaDTO, error) {
+	ret := _m.Called(id, ownerID, offset, chunk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendUpload")
+	}
+
+	var r0 dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64, io.Reader) (dto.MediaDTO, error)); ok {
+		return rf(id, ownerID, offset, chunk)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64, io.Reader) dto.MediaDTO); ok {
+		r0 = rf(id, ownerID, offset, chunk)
+	} else {
+		r0 = ret.Get(0).(dto.MediaDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int64, io.Reader) error); ok {
+		r1 = rf(id, ownerID, offset, chunk)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_AppendUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendUpload'
+type MediaService_AppendUpload_Call struct {
+	*mock.Call
+}
+
+// AppendUpload is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - ownerID string
+//   - offset int64
+//   - chunk io.Reader
+func (_e *MediaService_Expecter) AppendUpload(id interface{}, ownerID interface{}, offset interface{}, chunk interface{}) *MediaService_AppendUpload_Call {
+	return &MediaService_AppendUpload_Call{Call: _e.mock.On("AppendUpload", id, ownerID, offset, chunk)}
+}
+
+func (_c *MediaService_AppendUpload_Call) Run(run func(id uuid.UUID, ownerID string, offset int64, chunk io.Reader)) *MediaService_AppendUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int64), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MediaService_AppendUpload_Call) Return(_a0 dto.MediaDTO, _a1 error) *MediaService_AppendUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_AppendUpload_Call) RunAndReturn(run func(uuid.UUID, string, int64, io.Reader) (dto.MediaDTO, error)) *MediaService_AppendUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUpload provides a mock function with given fields: ownerID, input
+func (_m *MediaService) CreateUpload(ownerID string, input dto.UploadMediaDTO) (dto.MediaDTO, error) {
+	ret := _m.Called(ownerID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUpload")
+	}
+
+	var r0 dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.UploadMediaDTO) (dto.MediaDTO, error)); ok {
+		return rf(ownerID, input)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.UploadMediaDTO) dto.MediaDTO); ok {
+		r0 = rf(ownerID, input)
+	} else {
+		r0 = ret.Get(0).(dto.MediaDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.UploadMediaDTO) error); ok {
+		r1 = rf(ownerID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_CreateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUpload'
+type MediaService_CreateUpload_Call struct {
+	*mock.Call
+}
+
+// CreateUpload is a helper method to define mock.On call
+//   - ownerID string
+//   - input dto.UploadMediaDTO
+func (_e *MediaService_Expecter) CreateUpload(ownerID interface{}, input interface{}) *MediaService_CreateUpload_Call {
+	return &MediaService_CreateUpload_Call{Call: _e.mock.On("CreateUpload", ownerID, input)}
+}
+
+func (_c *MediaService_CreateUpload_Call) Run(run func(ownerID string, input dto.UploadMediaDTO)) *MediaService_CreateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.UploadMediaDTO))
+	})
+	return _c
+}
+
+func (_c *MediaService_CreateUpload_Call) Return(_a0 dto.MediaDTO, _a1 error) *MediaService_CreateUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_CreateUpload_Call) RunAndReturn(run func(string, dto.UploadMediaDTO) (dto.MediaDTO, error)) *MediaService_CreateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMedia provides a mock function with given fields: id, ownerID
+func (_m *MediaService) DeleteMedia(id uuid.UUID, ownerID string) error {
+	ret := _m.Called(id, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = rf(id, ownerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MediaService_DeleteMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMedia'
+type MediaService_DeleteMedia_Call struct {
+	*mock.Call
+}
+
+// DeleteMedia is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - ownerID string
+func (_e *MediaService_Expecter) DeleteMedia(id interface{}, ownerID interface{}) *MediaService_DeleteMedia_Call {
+	return &MediaService_DeleteMedia_Call{Call: _e.mock.On("DeleteMedia", id, ownerID)}
+}
+
+func (_c *MediaService_DeleteMedia_Call) Run(run func(id uuid.UUID, ownerID string)) *MediaService_DeleteMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MediaService_DeleteMedia_Call) Return(_a0 error) *MediaService_DeleteMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaService_DeleteMedia_Call) RunAndReturn(run func(uuid.UUID, string) error) *MediaService_DeleteMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImages provides a mock function with given fields: ids
+func (_m *MediaService) GetImages(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImages")
+	}
+
+	var r0 map[uuid.UUID]dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) map[uuid.UUID]dto.MediaDTO); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]dto.MediaDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_GetImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImages'
+type MediaService_GetImages_Call struct {
+	*mock.Call
+}
+
+// GetImages is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MediaService_Expecter) GetImages(ids interface{}) *MediaService_GetImages_Call {
+	return &MediaService_GetImages_Call{Call: _e.mock.On("GetImages", ids)}
+}
+
+func (_c *MediaService_GetImages_Call) Run(run func(ids []uuid.UUID)) *MediaService_GetImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaService_GetImages_Call) Return(_a0 map[uuid.UUID]dto.MediaDTO, _a1 error) *MediaService_GetImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_GetImages_Call) RunAndReturn(run func([]uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)) *MediaService_GetImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMedia provides a mock function with given fields: id, userID
+func (_m *MediaService) GetMedia(id uuid.UUID, userID string) (dto.MediaDTO, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMedia")
+	}
+
+	var r0 dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.MediaDTO, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.MediaDTO); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Get(0).(dto.MediaDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_GetMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMedia'
+type MediaService_GetMedia_Call struct {
+	*mock.Call
+}
+
+// GetMedia is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - userID string
+func (_e *MediaService_Expecter) GetMedia(id interface{}, userID interface{}) *MediaService_GetMedia_Call {
+	return &MediaService_GetMedia_Call{Call: _e.mock.On("GetMedia", id, userID)}
+}
+
+func (_c *MediaService_GetMedia_Call) Run(run func(id uuid.UUID, userID string)) *MediaService_GetMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MediaService_GetMedia_Call) Return(_a0 dto.MediaDTO, _a1 error) *MediaService_GetMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_GetMedia_Call) RunAndReturn(run func(uuid.UUID, string) (dto.MediaDTO, error)) *MediaService_GetMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpload provides a mock function with given fields: id, ownerID
+func (_m *MediaService) GetUpload(id uuid.UUID, ownerID string) (dto.MediaDTO, error) {
+	ret := _m.Called(id, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpload")
+	}
+
+	var r0 dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.MediaDTO, error)); ok {
+		return rf(id, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.MediaDTO); ok {
+		r0 = rf(id, ownerID)
+	} else {
+		r0 = ret.Get(0).(dto.MediaDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(id, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_GetUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpload'
+type MediaService_GetUpload_Call struct {
+	*mock.Call
+}
+
+// GetUpload is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - ownerID string
+func (_e *MediaService_Expecter) GetUpload(id interface{}, ownerID interface{}) *MediaService_GetUpload_Call {
+	return &MediaService_GetUpload_Call{Call: _e.mock.On("GetUpload", id, ownerID)}
+}
+
+func (_c *MediaService_GetUpload_Call) Run(run func(id uuid.UUID, ownerID string)) *MediaService_GetUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MediaService_GetUpload_Call) Return(_a0 dto.MediaDTO, _a1 error) *MediaService_GetUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_GetUpload_Call) RunAndReturn(run func(uuid.UUID, string) (dto.MediaDTO, error)) *MediaService_GetUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVideos provides a mock function with given fields: ids
+func (_m *MediaService) GetVideos(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVideos")
+	}
+
+	var r0 map[uuid.UUID]dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) map[uuid.UUID]dto.MediaDTO); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]dto.MediaDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_GetVideos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVideos'
+type MediaService_GetVideos_Call struct {
+	*mock.Call
+}
+
+// GetVideos is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MediaService_Expecter) GetVideos(ids interface{}) *MediaService_GetVideos_Call {
+	return &MediaService_GetVideos_Call{Call: _e.mock.On("GetVideos", ids)}
+}
+
+func (_c *MediaService_GetVideos_Call) Run(run func(ids []uuid.UUID)) *MediaService_GetVideos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaService_GetVideos_Call) Return(_a0 map[uuid.UUID]dto.MediaDTO, _a1 error) *MediaService_GetVideos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_GetVideos_Call) RunAndReturn(run func([]uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)) *MediaService_GetVideos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImageQueued provides a mock function with given fields:
+func (_m *MediaService) ImageQueued() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImageQueued")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// MediaService_ImageQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImageQueued'
+type MediaService_ImageQueued_Call struct {
+	*mock.Call
+}
+
+// ImageQueued is a helper method to define mock.On call
+func (_e *MediaService_Expecter) ImageQueued() *MediaService_ImageQueued_Call {
+	return &MediaService_ImageQueued_Call{Call: _e.mock.On("ImageQueued")}
+}
+
+func (_c *MediaService_ImageQueued_Call) Run(run func()) *MediaService_ImageQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaService_ImageQueued_Call) Return(_a0 <-chan struct{}) *MediaService_ImageQueued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaService_ImageQueued_Call) RunAndReturn(run func() <-chan struct{}) *MediaService_ImageQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnVideoProcessed provides a mock function with given fields: listener
+func (_m *MediaService) OnVideoProcessed(listener service.VideoListener) {
+	_m.Called(listener)
+}
+
+// MediaService_OnVideoProcessed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnVideoProcessed'
+type MediaService_OnVideoProcessed_Call struct {
+	*mock.Call
+}
+
+// OnVideoProcessed is a helper method to define mock.On call
+//   - listener service.VideoListener
+func (_e *MediaService_Expecter) OnVideoProcessed(listener interface{}) *MediaService_OnVideoProcessed_Call {
+	return &MediaService_OnVideoProcessed_Call{Call: _e.mock.On("OnVideoProcessed", listener)}
+}
+
+func (_c *MediaService_OnVideoProcessed_Call) Run(run func(listener service.VideoListener)) *MediaService_OnVideoProcessed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.VideoListener))
+	})
+	return _c
+}
+
+func (_c *MediaService_OnVideoProcessed_Call) Return() *MediaService_OnVideoProcessed_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MediaService_OnVideoProcessed_Call) RunAndReturn(run func(service.VideoListener)) *MediaService_OnVideoProcessed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenFile provides a mock function with given fields: key, expires, signature
+func (_m *MediaService) OpenFile(key string, expires int64, signature string) (io.ReadCloser, error) {
+	ret := _m.Called(key, expires, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenFile")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, string) (io.ReadCloser, error)); ok {
+		return rf(key, expires, signature)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, string) io.ReadCloser); ok {
+		r0 = rf(key, expires, signature)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, string) error); ok {
+		r1 = rf(key, expires, signature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_OpenFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenFile'
+type MediaService_OpenFile_Call struct {
+	*mock.Call
+}
+
+// OpenFile is a helper method to define mock.On call
+//   - key string
+//   - expires int64
+//   - signature string
+func (_e *MediaService_Expecter) OpenFile(key interface{}, expires interface{}, signature interface{}) *MediaService_OpenFile_Call {
+	return &MediaService_OpenFile_Call{Call: _e.mock.On("OpenFile", key, expires, signature)}
+}
+
+func (_c *MediaService_OpenFile_Call) Run(run func(key string, expires int64, signature string)) *MediaService_OpenFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MediaService_OpenFile_Call) Return(_a0 io.ReadCloser, _a1 error) *MediaService_OpenFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_OpenFile_Call) RunAndReturn(run func(string, int64, string) (io.ReadCloser, error)) *MediaService_OpenFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenPlaylist provides a mock function with given fields: id, name, expires, signature
+func (_m *MediaService) OpenPlaylist(id uuid.UUID, name string, expires int64, signature string) ([]byte, error) {
+	ret := _m.Called(id, name, expires, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenPlaylist")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64, string) ([]byte, error)); ok {
+		return rf(id, name, expires, signature)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64, string) []byte); ok {
+		r0 = rf(id, name, expires, signature)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int64, string) error); ok {
+		r1 = rf(id, name, expires, signature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_OpenPlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenPlaylist'
+type MediaService_OpenPlaylist_Call struct {
+	*mock.Call
+}
+
+// OpenPlaylist is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - name string
+//   - expires int64
+//   - signature string
+func (_e *MediaService_Expecter) OpenPlaylist(id interface{}, name interface{}, expires interface{}, signature interface{}) *MediaService_OpenPlaylist_Call {
+	return &MediaService_OpenPlaylist_Call{Call: _e.mock.On("OpenPlaylist", id, name, expires, signature)}
+}
+
+func (_c *MediaService_OpenPlaylist_Call) Run(run func(id uuid.UUID, name string, expires int64, signature string)) *MediaService_OpenPlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MediaService_OpenPlaylist_Call) Return(_a0 []byte, _a1 error) *MediaService_OpenPlaylist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_OpenPlaylist_Call) RunAndReturn(run func(uuid.UUID, string, int64, string) ([]byte, error)) *MediaService_OpenPlaylist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessImages provides a mock function with given fields: limit
+func (_m *MediaService) ProcessImages(limit int) (int, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessImages")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_ProcessImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessImages'
+type MediaService_ProcessImages_Call struct {
+	*mock.Call
+}
+
+// ProcessImages is a helper method to define mock.On call
+//   - limit int
+func (_e *MediaService_Expecter) ProcessImages(limit interface{}) *MediaService_ProcessImages_Call {
+	return &MediaService_ProcessImages_Call{Call: _e.mock.On("ProcessImages", limit)}
+}
+
+func (_c *MediaService_ProcessImages_Call) Run(run func(limit int)) *MediaService_ProcessImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MediaService_ProcessImages_Call) Return(_a0 int, _a1 error) *MediaService_ProcessImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_ProcessImages_Call) RunAndReturn(run func(int) (int, error)) *MediaService_ProcessImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessVideos provides a mock function with given fields: limit
+func (_m *MediaService) ProcessVideos(limit int) (int, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessVideos")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_ProcessVideos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessVideos'
+type MediaService_ProcessVideos_Call struct {
+	*mock.Call
+}
+
+// ProcessVideos is a helper method to define mock.On call
+//   - limit int
+func (_e *MediaService_Expecter) ProcessVideos(limit interface{}) *MediaService_ProcessVideos_Call {
+	return &MediaService_ProcessVideos_Call{Call: _e.mock.On("ProcessVideos", limit)}
+}
+
+func (_c *MediaService_ProcessVideos_Call) Run(run func(limit int)) *MediaService_ProcessVideos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MediaService_ProcessVideos_Call) Return(_a0 int, _a1 error) *MediaService_ProcessVideos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_ProcessVideos_Call) RunAndReturn(run func(int) (int, error)) *MediaService_ProcessVideos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamVideo provides a mock function with given fields: id
+func (_m *MediaService) StreamVideo(id uuid.UUID) (dto.StreamDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamVideo")
+	}
+
+	var r0 dto.StreamDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.StreamDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.StreamDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.StreamDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_StreamVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamVideo'
+type MediaService_StreamVideo_Call struct {
+	*mock.Call
+}
+
+// StreamVideo is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MediaService_Expecter) StreamVideo(id interface{}) *MediaService_StreamVideo_Call {
+	return &MediaService_StreamVideo_Call{Call: _e.mock.On("StreamVideo", id)}
+}
+
+func (_c *MediaService_StreamVideo_Call) Run(run func(id uuid.UUID)) *MediaService_StreamVideo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaService_StreamVideo_Call) Return(_a0 dto.StreamDTO, _a1 error) *MediaService_StreamVideo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_StreamVideo_Call) RunAndReturn(run func(uuid.UUID) (dto.StreamDTO, error)) *MediaService_StreamVideo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URLTTL provides a mock function with given fields:
+func (_m *MediaService) URLTTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for URLTTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// MediaService_URLTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URLTTL'
+type MediaService_URLTTL_Call struct {
+	*mock.Call
+}
+
+// URLTTL is a helper method to define mock.On call
+func (_e *MediaService_Expecter) URLTTL() *MediaService_URLTTL_Call {
+	return &MediaService_URLTTL_Call{Call: _e.mock.On("URLTTL")}
+}
+
+func (_c *MediaService_URLTTL_Call) Run(run func()) *MediaService_URLTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaService_URLTTL_Call) Return(_a0 time.Duration) *MediaService_URLTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaService_URLTTL_Call) RunAndReturn(run func() time.Duration) *MediaService_URLTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upload provides a mock function with given fields: ownerID, input, body
+func (_m *MediaService) Upload(ownerID string, input dto.UploadMediaDTO, body io.Reader) (dto.MediaDTO, error) {
+	ret := _m.Called(ownerID, input, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.UploadMediaDTO, io.Reader) (dto.MediaDTO, error)); ok {
+		return rf(ownerID, input, body)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.UploadMediaDTO, io.Reader) dto.MediaDTO); ok {
+		r0 = rf(ownerID, input, body)
+	} else {
+		r0 = ret.Get(0).(dto.MediaDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.UploadMediaDTO, io.Reader) error); ok {
+		r1 = rf(ownerID, input, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaService_Upload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upload'
+type MediaService_Upload_Call struct {
+	*mock.Call
+}
+
+// Upload is a helper method to define mock.On call
+//   - ownerID string
+//   - input dto.UploadMediaDTO
+//   - body io.Reader
+func (_e *MediaService_Expecter) Upload(ownerID interface{}, input interface{}, body interface{}) *MediaService_Upload_Call {
+	return &MediaService_Upload_Call{Call: _e.mock.On("Upload", ownerID, input, body)}
+}
+
+func (_c *MediaService_Upload_Call) Run(run func(ownerID string, input dto.UploadMediaDTO, body io.Reader)) *MediaService_Upload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.UploadMediaDTO), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MediaService_Upload_Call) Return(_a0 dto.MediaDTO, _a1 error) *MediaService_Upload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaService_Upload_Call) RunAndReturn(run func(string, dto.UploadMediaDTO, io.Reader) (dto.MediaDTO, error)) *MediaService_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VideoQueued provides a mock function with given fields:
+func (_m *MediaService) VideoQueued() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VideoQueued")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// MediaService_VideoQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VideoQueued'
+type MediaService_VideoQueued_Call struct {
+	*mock.Call
+}
+
+// VideoQueued is a helper method to define mock.On call
+func (_e *MediaService_Expecter) VideoQueued() *MediaService_VideoQueued_Call {
+	return &MediaService_VideoQueued_Call{Call: _e.mock.On("VideoQueued")}
+}
+
+func (_c *MediaService_VideoQueued_Call) Run(run func()) *MediaService_VideoQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaService_VideoQueued_Call) Return(_a0 <-chan struct{}) *MediaService_VideoQueued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaService_VideoQueued_Call) RunAndReturn(run func() <-chan struct{}) *MediaService_VideoQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMediaService creates a new instance of MediaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMediaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MediaService {
+	mock := &MediaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
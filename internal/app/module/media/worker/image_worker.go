@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"CodeWithAzri/internal/app/module/media/service"
+	"context"
+	"log"
+	"time"
+)
+
+// ImageWorker generates variants for uploaded images in the background.
+type ImageWorker struct {
+	service   service.MediaService
+	interval  time.Duration
+	batchSize int
+}
+
+// NewImageWorker creates a new ImageWorker instance.
+func NewImageWorker(s service.MediaService, interval time.Duration, batchSize int) *ImageWorker {
+	w := new(ImageWorker)
+	w.service = s
+	w.interval = interval
+	w.batchSize = batchSize
+	return w
+}
+
+// Start runs the worker until the context is cancelled. Besides its regular
+// tick it wakes up as soon as a new image is uploaded.
+func (w *ImageWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.service.ImageQueued():
+		}
+	}
+}
+
+// RunOnce processes batches of pending images until none are due.
+func (w *ImageWorker) RunOnce() {
+	for {
+		processed, err := w.service.ProcessImages(w.batchSize)
+		if err != nil {
+			log.Printf("failed to process images: %v\n", err)
+			return
+		}
+
+		if processed > 0 {
+			log.Printf("processed %d image(s)\n", processed)
+		}
+
+		if processed < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package worker_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/service/mocks"
+	"CodeWithAzri/internal/app/module/media/worker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageWorker_RunOnce(t *testing.T) {
+	mockService := mocks.NewMediaService(t)
+	imageWorker := worker.NewImageWorker(mockService, time.Minute, 2)
+
+	t.Run("Process Until Batch Is Not Full", func(t *testing.T) {
+		mockService.On("ProcessImages", 2).Return(2, nil).Once()
+		mockService.On("ProcessImages", 2).Return(1, nil).Once()
+
+		imageWorker.RunOnce()
+
+		mockService.AssertNumberOfCalls(t, "ProcessImages", 2)
+	})
+
+	t.Run("Process Images Error", func(t *testing.T) {
+		mockService.On("ProcessImages", 2).Return(0, errors.New("Repository Failure")).Once()
+
+		assert.NotPanics(t, imageWorker.RunOnce)
+	})
+}
+
+func TestImageWorker_Start(t *testing.T) {
+	mockService := mocks.NewMediaService(t)
+	imageWorker := worker.NewImageWorker(mockService, time.Hour, 10)
+
+	t.Run("Start Stops On Context Cancel", func(t *testing.T) {
+		queued := make(chan struct{})
+		mockService.On("ProcessImages", 10).Return(0, nil).Once()
+		mockService.On("ImageQueued").Return((<-chan struct{})(queued)).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		imageWorker.Start(ctx)
+
+		mockService.AssertNumberOfCalls(t, "ProcessImages", 1)
+	})
+}
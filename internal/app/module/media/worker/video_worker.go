@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"CodeWithAzri/internal/app/module/media/service"
+	"context"
+	"log"
+	"time"
+)
+
+// VideoWorker packages uploaded lesson videos for HLS in the background.
+type VideoWorker struct {
+	service   service.MediaService
+	interval  time.Duration
+	batchSize int
+}
+
+// NewVideoWorker creates a new VideoWorker instance.
+func NewVideoWorker(s service.MediaService, interval time.Duration, batchSize int) *VideoWorker {
+	w := new(VideoWorker)
+	w.service = s
+	w.interval = interval
+	w.batchSize = batchSize
+	return w
+}
+
+// Start runs the worker until the context is cancelled. Besides its regular
+// tick it wakes up as soon as a new video is uploaded.
+func (w *VideoWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.service.VideoQueued():
+		}
+	}
+}
+
+// RunOnce packages batches of pending videos until none are due.
+func (w *VideoWorker) RunOnce() {
+	for {
+		processed, err := w.service.ProcessVideos(w.batchSize)
+		if err != nil {
+			log.Printf("failed to process videos: %v\n", err)
+			return
+		}
+
+		if processed > 0 {
+			log.Printf("processed %d video(s)\n", processed)
+		}
+
+		if processed < w.batchSize {
+			return
+		}
+	}
+}
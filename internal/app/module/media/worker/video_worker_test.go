@@ -0,0 +1,50 @@
+package worker_test
+
+import (
+	"CodeWithAzri/internal/app/module/media/service/mocks"
+	"CodeWithAzri/internal/app/module/media/worker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideoWorker_RunOnce(t *testing.T) {
+	mockService := mocks.NewMediaService(t)
+	videoWorker := worker.NewVideoWorker(mockService, time.Minute, 1)
+
+	t.Run("Process Until Batch Is Not Full", func(t *testing.T) {
+		mockService.On("ProcessVideos", 1).Return(1, nil).Once()
+		mockService.On("ProcessVideos", 1).Return(0, nil).Once()
+
+		videoWorker.RunOnce()
+
+		mockService.AssertNumberOfCalls(t, "ProcessVideos", 2)
+	})
+
+	t.Run("Process Videos Error", func(t *testing.T) {
+		mockService.On("ProcessVideos", 1).Return(0, errors.New("Repository Failure")).Once()
+
+		assert.NotPanics(t, videoWorker.RunOnce)
+	})
+}
+
+func TestVideoWorker_Start(t *testing.T) {
+	mockService := mocks.NewMediaService(t)
+	videoWorker := worker.NewVideoWorker(mockService, time.Hour, 1)
+
+	t.Run("Start Stops On Context Cancel", func(t *testing.T) {
+		queued := make(chan struct{})
+		mockService.On("ProcessVideos", 1).Return(0, nil).Once()
+		mockService.On("VideoQueued").Return((<-chan struct{})(queued)).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		videoWorker.Start(ctx)
+
+		mockService.AssertNumberOfCalls(t, "ProcessVideos", 1)
+	})
+}
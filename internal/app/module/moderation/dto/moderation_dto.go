@@ -0,0 +1,60 @@
+package dto
+
+import (
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+
+	"github.com/google/uuid"
+)
+
+// CreateReportDTO reports a review or comment by its ID, or a user by their
+// user ID.
+type CreateReportDTO struct {
+	TargetType moderation_target_enum.ModerationTarget `json:"target_type" validate:"required,oneof=review comment user"`
+	TargetID   string                                  `json:"target_id" validate:"required,max=255"`
+	Reason     string                                  `json:"reason" validate:"required,max=1000"`
+}
+
+// ModerationCaseDTO is one reported target in the moderation queue. Reports
+// and Actions are only filled in when a single case is fetched.
+type ModerationCaseDTO struct {
+	ID          uuid.UUID                               `json:"id"`
+	TargetType  moderation_target_enum.ModerationTarget `json:"target_type"`
+	TargetID    string                                  `json:"target_id"`
+	AuthorID    string                                  `json:"author_id"`
+	Status      moderation_status_enum.ModerationStatus `json:"status"`
+	ReportCount int                                     `json:"report_count"`
+	ResolvedBy  string                                  `json:"resolved_by,omitempty"`
+	ResolvedAt  *int64                                  `json:"resolved_at,omitempty"`
+	Reports     []ReportDTO                             `json:"reports,omitempty"`
+	Actions     []ModerationActionDTO                   `json:"actions,omitempty"`
+	CreatedAt   int64                                   `json:"created_at"`
+	UpdatedAt   int64                                   `json:"updated_at"`
+}
+
+type ReportDTO struct {
+	ID         uuid.UUID `json:"id"`
+	ReporterID string    `json:"reporter_id"`
+	Reason     string    `json:"reason"`
+	CreatedAt  int64     `json:"created_at"`
+}
+
+// ModerationActionDTO is an entry of the moderation audit log.
+type ModerationActionDTO struct {
+	ID         uuid.UUID                               `json:"id"`
+	CaseID     uuid.UUID                               `json:"case_id"`
+	TargetType moderation_target_enum.ModerationTarget `json:"target_type"`
+	TargetID   string                                  `json:"target_id"`
+	Action     moderation_action_enum.ModerationAction `json:"action"`
+	ActorID    string                                  `json:"actor_id"`
+	Note       string                                  `json:"note,omitempty"`
+	CreatedAt  int64                                   `json:"created_at"`
+}
+
+// TakeActionDTO resolves a case. Approve keeps the target, remove takes the
+// reported content down and ban also bans its author.
+type TakeActionDTO struct {
+	Action moderation_action_enum.ModerationAction `json:"action" validate:"required,oneof=approve remove ban"`
+	Note   string                                  `json:"note" validate:"max=1000"`
+}
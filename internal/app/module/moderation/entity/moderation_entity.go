@@ -0,0 +1,58 @@
+package entity
+
+import (
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+
+	"github.com/google/uuid"
+)
+
+// ModerationCase collects the reports on one target, so the queue shows each
+// reported review, comment or user once. AuthorID is the user behind the
+// target, who is banned when an admin bans it.
+type ModerationCase struct {
+	ID          uuid.UUID                               `json:"id" gorm:"type:uuid;primaryKey"`
+	TargetType  moderation_target_enum.ModerationTarget `json:"target_type" gorm:"type:varchar(20);not null;uniqueIndex:idx_moderation_cases_target,priority:1"`
+	TargetID    string                                  `json:"target_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_moderation_cases_target,priority:2"`
+	AuthorID    string                                  `json:"author_id" gorm:"type:varchar(255);not null;index"`
+	Status      moderation_status_enum.ModerationStatus `json:"status" gorm:"type:varchar(20);not null;index:idx_moderation_cases_queue,priority:1"`
+	ReportCount int                                     `json:"report_count" gorm:"not null;default:0;index:idx_moderation_cases_queue,priority:2"`
+	ResolvedBy  string                                  `json:"resolved_by" gorm:"type:varchar(255);not null;default:''"`
+	ResolvedAt  *int64                                  `json:"resolved_at"`
+	CreatedAt   int64                                   `json:"created_at"`
+	UpdatedAt   int64                                   `json:"updated_at"`
+}
+
+// ModerationReport is one user reporting a case. A user reports a case at
+// most once.
+type ModerationReport struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+	CaseID     uuid.UUID `json:"case_id" gorm:"type:uuid;not null;uniqueIndex:idx_moderation_reports_reporter,priority:1"`
+	ReporterID string    `json:"reporter_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_moderation_reports_reporter,priority:2"`
+	Reason     string    `json:"reason" gorm:"type:text;not null"`
+	CreatedAt  int64     `json:"created_at"`
+}
+
+// ModerationAction is the audit record of an action taken on a case. Actions
+// taken automatically have SystemActor as their ActorID.
+type ModerationAction struct {
+	ID         uuid.UUID                               `json:"id" gorm:"type:uuid;primaryKey"`
+	CaseID     uuid.UUID                               `json:"case_id" gorm:"type:uuid;not null;index"`
+	TargetType moderation_target_enum.ModerationTarget `json:"target_type" gorm:"type:varchar(20);not null"`
+	TargetID   string                                  `json:"target_id" gorm:"type:varchar(255);not null"`
+	Action     moderation_action_enum.ModerationAction `json:"action" gorm:"type:varchar(20);not null"`
+	ActorID    string                                  `json:"actor_id" gorm:"type:varchar(255);not null"`
+	Note       string                                  `json:"note" gorm:"type:text;not null;default:''"`
+	CreatedAt  int64                                   `json:"created_at" gorm:"index"`
+}
+
+// UserBan keeps a user from writing reviews and comments.
+type UserBan struct {
+	UserID    string    `json:"user_id" gorm:"type:varchar(255);primaryKey"`
+	CaseID    uuid.UUID `json:"case_id" gorm:"type:uuid;not null"`
+	BannedBy  string    `json:"banned_by" gorm:"type:varchar(255);not null"`
+	CreatedAt int64     `json:"created_at"`
+}
+
+const SystemActor = "system"
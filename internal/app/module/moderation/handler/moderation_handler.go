@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/dto"
+	"CodeWithAzri/internal/app/module/moderation/service"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	service  service.ModerationService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.ModerationService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// CreateReport godoc
+//
+//	@Summary		Report a review, comment or user
+//	@Tags			Moderation
+//	@Description	Report a review or comment by its ID, or a user by their user ID, to the moderators. Reporting the same target twice counts once. Content reported by enough users is hidden until a moderator looks at it.
+//	@ID				create-report
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.CreateReportDTO	true	"Target and reason of the report"
+//	@Param			Authorization	header	string				true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		404	{object}	response.ResponseError	"Reported content not found"
+//	@Failure		422	{object}	response.ResponseError	"Reporting yourself or your own content"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/reports [post]
+func (h *Handler) CreateReport(w http.ResponseWriter, r *http.Request) {
+	var d dto.CreateReportDTO
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.Report(requestPkg.GetUserID(r), d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Report Submitted Successfully", "Success", nil, w)
+}
+
+// GetModerationQueue godoc
+//
+//	@Summary		List the moderation queue
+//	@Tags			Admin
+//	@Description	Page through the reported targets that wait for a moderator, hidden content first and then the most reported. Requires the admin claim.
+//	@ID				get-moderation-queue
+//	@Accept			json
+//	@Produce		json
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of cases per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.ModerationCaseDTO}	"Successful response with cases"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/moderation/cases [get]
+func (h *Handler) GetModerationQueue(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+	cases, err := h.service.GetQueue(limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Moderation Queue Fetched Successfully", "Success", cases, w)
+}
+
+// GetModerationCase godoc
+//
+//	@Summary		Get a moderation case
+//	@Tags			Admin
+//	@Description	Fetch a reported target with all of its reports and the actions taken on it. Requires the admin claim.
+//	@ID				get-moderation-case
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Case ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.ModerationCaseDTO}	"Successful response with the case"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError							"Case not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/moderation/cases/{id} [get]
+func (h *Handler) GetModerationCase(w http.ResponseWriter, r *http.Request) {
+	caseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	c, err := h.service.GetCase(caseID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Moderation Case Fetched Successfully", "Success", c, w)
+}
+
+// TakeModerationAction godoc
+//
+//	@Summary		Resolve a moderation case
+//	@Tags			Admin
+//	@Description	Approve a reported target, which shows hidden content again; remove the reported content; or ban its author, which also removes the content. Reported users can only be approved or banned. Every action is recorded in the moderation log. Requires the admin claim.
+//	@ID				take-moderation-action
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string				true	"Case ID"
+//	@Param			input			body	dto.TakeActionDTO	true	"Action and optional note"
+//	@Param			Authorization	header	string				true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.ModerationCaseDTO}	"Successful response with the resolved case"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError							"Case not found"
+//	@Failure		409	{object}	response.ResponseError							"Case already resolved"
+//	@Failure		422	{object}	response.ResponseError							"Action not allowed on the target"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/moderation/cases/{id}/actions [post]
+func (h *Handler) TakeModerationAction(w http.ResponseWriter, r *http.Request) {
+	caseID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.TakeActionDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	c, err := h.service.TakeAction(caseID, requestPkg.GetUserID(r), d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Moderation Action Taken Successfully", "Success", c, w)
+}
+
+// GetModerationActions godoc
+//
+//	@Summary		List the moderation log
+//	@Tags			Admin
+//	@Description	Page through every moderation action, newest first, including content hidden automatically after enough reports, whose actor is "system". Requires the admin claim.
+//	@ID				get-moderation-actions
+//	@Accept			json
+//	@Produce		json
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of actions per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.ModerationActionDTO}	"Successful response with actions"
+//	@Failure		401	{object}	response.ResponseError								"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError								"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError								"Internal server error"
+//	@Router			/api/v1/admin/moderation/actions [get]
+func (h *Handler) GetModerationActions(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+	actions, err := h.service.GetActions(limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Moderation Actions Fetched Successfully", "Success", actions, w)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrTargetNotFound), errors.Is(err, service.ErrCaseNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrCaseResolved):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrCannotReportSelf), errors.Is(err, service.ErrActionNotAllowed):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
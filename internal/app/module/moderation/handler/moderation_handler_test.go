@@ -0,0 +1,214 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/dto"
+	"CodeWithAzri/internal/app/module/moderation/service"
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var MockCaseDTO dto.ModerationCaseDTO = dto.ModerationCaseDTO{
+	ID:          uuid.MustParse("3b9d2c4e-6f1a-4d8b-9e2c-7a5f1b3d6e81"),
+	TargetType:  moderation_target_enum.Comment,
+	TargetID:    "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61",
+	AuthorID:    "user123",
+	Status:      moderation_status_enum.Hidden,
+	ReportCount: 3,
+	CreatedAt:   121212,
+	UpdatedAt:   131313,
+}
+
+func TestHandler_CreateReport(t *testing.T) {
+	moderationHandler, mockService := initializeHandler(t)
+	patchUserID("user456")
+	defer monkey.UnpatchAll()
+
+	report := dto.CreateReportDTO{TargetType: moderation_target_enum.Comment, TargetID: MockCaseDTO.TargetID, Reason: "Spam"}
+	body := `{"target_type":"comment","target_id":"` + MockCaseDTO.TargetID + `","reason":"Spam"}`
+
+	t.Run("Create Report Successfully", func(t *testing.T) {
+		mockService.On("Report", "user456", report).Return(nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/reports", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		moderationHandler.CreateReport(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	t.Run("Create Report Of Unknown Target Type", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/reports", strings.NewReader(`{"target_type":"course","target_id":"1","reason":"Spam"}`))
+		recorder := httptest.NewRecorder()
+		moderationHandler.CreateReport(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Report Target Not Found", func(t *testing.T) {
+		mockService.On("Report", "user456", report).Return(service.ErrTargetNotFound).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/reports", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		moderationHandler.CreateReport(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Create Report Of Own Content", func(t *testing.T) {
+		mockService.On("Report", "user456", report).Return(service.ErrCannotReportSelf).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/reports", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		moderationHandler.CreateReport(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+}
+
+func TestHandler_GetModerationQueue(t *testing.T) {
+	moderationHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Queue Successfully", func(t *testing.T) {
+		mockService.On("GetQueue", 5, 2).Return([]dto.ModerationCaseDTO{MockCaseDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/cases?page=2&limit=5", nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationQueue(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"status":"hidden"`)
+	})
+
+	t.Run("Get Queue Error", func(t *testing.T) {
+		mockService.On("GetQueue", 10, 1).Return(nil, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/cases", nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationQueue(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_GetModerationCase(t *testing.T) {
+	moderationHandler, mockService := initializeHandler(t)
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Case Successfully", func(t *testing.T) {
+		patchURLParam(MockCaseDTO.ID.String())
+		mockService.On("GetCase", MockCaseDTO.ID).Return(MockCaseDTO, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/cases/"+MockCaseDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationCase(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Case Not Found", func(t *testing.T) {
+		patchURLParam(MockCaseDTO.ID.String())
+		mockService.On("GetCase", MockCaseDTO.ID).Return(dto.ModerationCaseDTO{}, service.ErrCaseNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/cases/"+MockCaseDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationCase(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Case Invalid ID", func(t *testing.T) {
+		patchURLParam("invalid")
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/cases/invalid", nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationCase(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_TakeModerationAction(t *testing.T) {
+	moderationHandler, mockService := initializeHandler(t)
+	patchUserID("admin-uid")
+	patchURLParam(MockCaseDTO.ID.String())
+	defer monkey.UnpatchAll()
+
+	ban := dto.TakeActionDTO{Action: moderation_action_enum.Ban, Note: "Spam"}
+
+	t.Run("Take Action Successfully", func(t *testing.T) {
+		banned := MockCaseDTO
+		banned.Status = moderation_status_enum.Banned
+		mockService.On("TakeAction", MockCaseDTO.ID, "admin-uid", ban).Return(banned, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/moderation/cases/"+MockCaseDTO.ID.String()+"/actions", strings.NewReader(`{"action":"ban","note":"Spam"}`))
+		recorder := httptest.NewRecorder()
+		moderationHandler.TakeModerationAction(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"status":"banned"`)
+	})
+
+	t.Run("Take Automatic Action", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/admin/moderation/cases/"+MockCaseDTO.ID.String()+"/actions", strings.NewReader(`{"action":"hide"}`))
+		recorder := httptest.NewRecorder()
+		moderationHandler.TakeModerationAction(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Take Action On Resolved Case", func(t *testing.T) {
+		mockService.On("TakeAction", MockCaseDTO.ID, "admin-uid", ban).Return(dto.ModerationCaseDTO{}, service.ErrCaseResolved).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/moderation/cases/"+MockCaseDTO.ID.String()+"/actions", strings.NewReader(`{"action":"ban","note":"Spam"}`))
+		recorder := httptest.NewRecorder()
+		moderationHandler.TakeModerationAction(recorder, req)
+
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+
+	t.Run("Take Action Not Allowed", func(t *testing.T) {
+		mockService.On("TakeAction", MockCaseDTO.ID, "admin-uid", ban).Return(dto.ModerationCaseDTO{}, service.ErrActionNotAllowed).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/moderation/cases/"+MockCaseDTO.ID.String()+"/actions", strings.NewReader(`{"action":"ban","note":"Spam"}`))
+		recorder := httptest.NewRecorder()
+		moderationHandler.TakeModerationAction(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+}
+
+func TestHandler_GetModerationActions(t *testing.T) {
+	moderationHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Actions Successfully", func(t *testing.T) {
+		action := dto.ModerationActionDTO{ID: uuid.New(), CaseID: MockCaseDTO.ID, Action: moderation_action_enum.Hide, ActorID: "system"}
+		mockService.On("GetActions", 10, 1).Return([]dto.ModerationActionDTO{action}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/actions", nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationActions(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"actor_id":"system"`)
+	})
+
+	t.Run("Get Actions Error", func(t *testing.T) {
+		mockService.On("GetActions", 10, 1).Return(nil, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/actions", nil)
+		recorder := httptest.NewRecorder()
+		moderationHandler.GetModerationActions(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
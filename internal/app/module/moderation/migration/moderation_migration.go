@@ -0,0 +1,20 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type ModerationMigration struct{}
+
+func (m ModerationMigration) CreateModerationTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.ModerationCase{},
+		entity.ModerationReport{},
+		entity.ModerationAction{},
+		entity.UserBan{},
+	)
+}
@@ -0,0 +1,47 @@
+package moderation
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/handler"
+	"CodeWithAzri/internal/app/module/moderation/migration"
+	"CodeWithAzri/internal/app/module/moderation/repository"
+	"CodeWithAzri/internal/app/module/moderation/service"
+	"CodeWithAzri/pkg/config"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const defaultHideThreshold = 3
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.ModerationService
+	Repository repository.ModerationRepository
+	Migration  *migration.ModerationMigration
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewModerationService(m.Repository, wordFilter(), hideThreshold())
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.ModerationMigration{}
+	return m
+}
+
+// wordFilter bans the comma separated words of MODERATION_BANNED_WORDS.
+func wordFilter() *service.WordFilter {
+	return service.NewWordFilter(strings.Split(config.GetEnvValue("MODERATION_BANNED_WORDS"), ","))
+}
+
+// hideThreshold is the number of reports after which content is hidden, from
+// MODERATION_HIDE_THRESHOLD.
+func hideThreshold() int {
+	threshold, err := strconv.Atoi(config.GetEnvValue("MODERATION_HIDE_THRESHOLD"))
+	if err != nil || threshold <= 0 {
+		return defaultHideThreshold
+	}
+	return threshold
+}
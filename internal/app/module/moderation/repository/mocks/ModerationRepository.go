@@ -0,0 +1,510 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/moderation/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+
+	uuid "github.com/google/uuid"
+)
+
+// ModerationRepository is an autogenerated mock type for the ModerationRepository type
+type ModerationRepository struct {
+	mock.Mock
+}
+
+type ModerationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModerationRepository) EXPECT() *ModerationRepository_Expecter {
+	return &ModerationRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddReport provides a mock function with given fields: c, report
+func (_m *ModerationRepository) AddReport(c entity.ModerationCase, report entity.ModerationReport) (entity.ModerationCase, bool, error) {
+	ret := _m.Called(c, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReport")
+	}
+
+	var r0 entity.ModerationCase
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(entity.ModerationCase, entity.ModerationReport) (entity.ModerationCase, bool, error)); ok {
+		return rf(c, report)
+	}
+	if rf, ok := ret.Get(0).(func(entity.ModerationCase, entity.ModerationReport) entity.ModerationCase); ok {
+		r0 = rf(c, report)
+	} else {
+		r0 = ret.Get(0).(entity.ModerationCase)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.ModerationCase, entity.ModerationReport) bool); ok {
+		r1 = rf(c, report)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(entity.ModerationCase, entity.ModerationReport) error); ok {
+		r2 = rf(c, report)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ModerationRepository_AddReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReport'
+type ModerationRepository_AddReport_Call struct {
+	*mock.Call
+}
+
+// AddReport is a helper method to define mock.On call
+//   - c entity.ModerationCase
+//   - report entity.ModerationReport
+func (_e *ModerationRepository_Expecter) AddReport(c interface{}, report interface{}) *ModerationRepository_AddReport_Call {
+	return &ModerationRepository_AddReport_Call{Call: _e.mock.On("AddReport", c, report)}
+}
+
+func (_c *ModerationRepository_AddReport_Call) Run(run func(c entity.ModerationCase, report entity.ModerationReport)) *ModerationRepository_AddReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.ModerationCase), args[1].(entity.ModerationReport))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_AddReport_Call) Return(_a0 entity.ModerationCase, _a1 bool, _a2 error) *ModerationRepository_AddReport_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ModerationRepository_AddReport_Call) RunAndReturn(run func(entity.ModerationCase, entity.ModerationReport) (entity.ModerationCase, bool, error)) *ModerationRepository_AddReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBanned provides a mock function with given fields: userID
+func (_m *ModerationRepository) IsBanned(userID string) (bool, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBanned")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_IsBanned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBanned'
+type ModerationRepository_IsBanned_Call struct {
+	*mock.Call
+}
+
+// IsBanned is a helper method to define mock.On call
+//   - userID string
+func (_e *ModerationRepository_Expecter) IsBanned(userID interface{}) *ModerationRepository_IsBanned_Call {
+	return &ModerationRepository_IsBanned_Call{Call: _e.mock.On("IsBanned", userID)}
+}
+
+func (_c *ModerationRepository_IsBanned_Call) Run(run func(userID string)) *ModerationRepository_IsBanned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_IsBanned_Call) Return(_a0 bool, _a1 error) *ModerationRepository_IsBanned_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_IsBanned_Call) RunAndReturn(run func(string) (bool, error)) *ModerationRepository_IsBanned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadActions provides a mock function with given fields: caseID, limit, offset
+func (_m *ModerationRepository) ReadActions(caseID *uuid.UUID, limit int, offset int) ([]entity.ModerationAction, error) {
+	ret := _m.Called(caseID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadActions")
+	}
+
+	var r0 []entity.ModerationAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, int, int) ([]entity.ModerationAction, error)); ok {
+		return rf(caseID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, int, int) []entity.ModerationAction); ok {
+		r0 = rf(caseID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ModerationAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, int, int) error); ok {
+		r1 = rf(caseID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ReadActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadActions'
+type ModerationRepository_ReadActions_Call struct {
+	*mock.Call
+}
+
+// ReadActions is a helper method to define mock.On call
+//   - caseID *uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *ModerationRepository_Expecter) ReadActions(caseID interface{}, limit interface{}, offset interface{}) *ModerationRepository_ReadActions_Call {
+	return &ModerationRepository_ReadActions_Call{Call: _e.mock.On("ReadActions", caseID, limit, offset)}
+}
+
+func (_c *ModerationRepository_ReadActions_Call) Run(run func(caseID *uuid.UUID, limit int, offset int)) *ModerationRepository_ReadActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*uuid.UUID), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ReadActions_Call) Return(_a0 []entity.ModerationAction, _a1 error) *ModerationRepository_ReadActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ReadActions_Call) RunAndReturn(run func(*uuid.UUID, int, int) ([]entity.ModerationAction, error)) *ModerationRepository_ReadActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadCase provides a mock function with given fields: id
+func (_m *ModerationRepository) ReadCase(id uuid.UUID) (entity.ModerationCase, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadCase")
+	}
+
+	var r0 entity.ModerationCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.ModerationCase, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.ModerationCase); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.ModerationCase)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ReadCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadCase'
+type ModerationRepository_ReadCase_Call struct {
+	*mock.Call
+}
+
+// ReadCase is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *ModerationRepository_Expecter) ReadCase(id interface{}) *ModerationRepository_ReadCase_Call {
+	return &ModerationRepository_ReadCase_Call{Call: _e.mock.On("ReadCase", id)}
+}
+
+func (_c *ModerationRepository_ReadCase_Call) Run(run func(id uuid.UUID)) *ModerationRepository_ReadCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ReadCase_Call) Return(_a0 entity.ModerationCase, _a1 error) *ModerationRepository_ReadCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ReadCase_Call) RunAndReturn(run func(uuid.UUID) (entity.ModerationCase, error)) *ModerationRepository_ReadCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadQueue provides a mock function with given fields: limit, offset
+func (_m *ModerationRepository) ReadQueue(limit int, offset int) ([]entity.ModerationCase, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadQueue")
+	}
+
+	var r0 []entity.ModerationCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]entity.ModerationCase, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []entity.ModerationCase); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ModerationCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ReadQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadQueue'
+type ModerationRepository_ReadQueue_Call struct {
+	*mock.Call
+}
+
+// ReadQueue is a helper method to define mock.On call
+//   - limit int
+//   - offset int
+func (_e *ModerationRepository_Expecter) ReadQueue(limit interface{}, offset interface{}) *ModerationRepository_ReadQueue_Call {
+	return &ModerationRepository_ReadQueue_Call{Call: _e.mock.On("ReadQueue", limit, offset)}
+}
+
+func (_c *ModerationRepository_ReadQueue_Call) Run(run func(limit int, offset int)) *ModerationRepository_ReadQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ReadQueue_Call) Return(_a0 []entity.ModerationCase, _a1 error) *ModerationRepository_ReadQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ReadQueue_Call) RunAndReturn(run func(int, int) ([]entity.ModerationCase, error)) *ModerationRepository_ReadQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadReports provides a mock function with given fields: caseID
+func (_m *ModerationRepository) ReadReports(caseID uuid.UUID) ([]entity.ModerationReport, error) {
+	ret := _m.Called(caseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadReports")
+	}
+
+	var r0 []entity.ModerationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]entity.ModerationReport, error)); ok {
+		return rf(caseID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []entity.ModerationReport); ok {
+		r0 = rf(caseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ModerationReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(caseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ReadReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadReports'
+type ModerationRepository_ReadReports_Call struct {
+	*mock.Call
+}
+
+// ReadReports is a helper method to define mock.On call
+//   - caseID uuid.UUID
+func (_e *ModerationRepository_Expecter) ReadReports(caseID interface{}) *ModerationRepository_ReadReports_Call {
+	return &ModerationRepository_ReadReports_Call{Call: _e.mock.On("ReadReports", caseID)}
+}
+
+func (_c *ModerationRepository_ReadReports_Call) Run(run func(caseID uuid.UUID)) *ModerationRepository_ReadReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ReadReports_Call) Return(_a0 []entity.ModerationReport, _a1 error) *ModerationRepository_ReadReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ReadReports_Call) RunAndReturn(run func(uuid.UUID) ([]entity.ModerationReport, error)) *ModerationRepository_ReadReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTargetAuthor provides a mock function with given fields: targetType, targetID
+func (_m *ModerationRepository) ReadTargetAuthor(targetType moderation_target_enum.ModerationTarget, targetID string) (string, error) {
+	ret := _m.Called(targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTargetAuthor")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(moderation_target_enum.ModerationTarget, string) (string, error)); ok {
+		return rf(targetType, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(moderation_target_enum.ModerationTarget, string) string); ok {
+		r0 = rf(targetType, targetID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(moderation_target_enum.ModerationTarget, string) error); ok {
+		r1 = rf(targetType, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_ReadTargetAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTargetAuthor'
+type ModerationRepository_ReadTargetAuthor_Call struct {
+	*mock.Call
+}
+
+// ReadTargetAuthor is a helper method to define mock.On call
+//   - targetType moderation_target_enum.ModerationTarget
+//   - targetID string
+func (_e *ModerationRepository_Expecter) ReadTargetAuthor(targetType interface{}, targetID interface{}) *ModerationRepository_ReadTargetAuthor_Call {
+	return &ModerationRepository_ReadTargetAuthor_Call{Call: _e.mock.On("ReadTargetAuthor", targetType, targetID)}
+}
+
+func (_c *ModerationRepository_ReadTargetAuthor_Call) Run(run func(targetType moderation_target_enum.ModerationTarget, targetID string)) *ModerationRepository_ReadTargetAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(moderation_target_enum.ModerationTarget), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_ReadTargetAuthor_Call) Return(_a0 string, _a1 error) *ModerationRepository_ReadTargetAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_ReadTargetAuthor_Call) RunAndReturn(run func(moderation_target_enum.ModerationTarget, string) (string, error)) *ModerationRepository_ReadTargetAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: c, from, action
+func (_m *ModerationRepository) Resolve(c entity.ModerationCase, from moderation_status_enum.ModerationStatus, action entity.ModerationAction) (bool, error) {
+	ret := _m.Called(c, from, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.ModerationCase, moderation_status_enum.ModerationStatus, entity.ModerationAction) (bool, error)); ok {
+		return rf(c, from, action)
+	}
+	if rf, ok := ret.Get(0).(func(entity.ModerationCase, moderation_status_enum.ModerationStatus, entity.ModerationAction) bool); ok {
+		r0 = rf(c, from, action)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.ModerationCase, moderation_status_enum.ModerationStatus, entity.ModerationAction) error); ok {
+		r1 = rf(c, from, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationRepository_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type ModerationRepository_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - c entity.ModerationCase
+//   - from moderation_status_enum.ModerationStatus
+//   - action entity.ModerationAction
+func (_e *ModerationRepository_Expecter) Resolve(c interface{}, from interface{}, action interface{}) *ModerationRepository_Resolve_Call {
+	return &ModerationRepository_Resolve_Call{Call: _e.mock.On("Resolve", c, from, action)}
+}
+
+func (_c *ModerationRepository_Resolve_Call) Run(run func(c entity.ModerationCase, from moderation_status_enum.ModerationStatus, action entity.ModerationAction)) *ModerationRepository_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.ModerationCase), args[1].(moderation_status_enum.ModerationStatus), args[2].(entity.ModerationAction))
+	})
+	return _c
+}
+
+func (_c *ModerationRepository_Resolve_Call) Return(_a0 bool, _a1 error) *ModerationRepository_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationRepository_Resolve_Call) RunAndReturn(run func(entity.ModerationCase, moderation_status_enum.ModerationStatus, entity.ModerationAction) (bool, error)) *ModerationRepository_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModerationRepository creates a new instance of ModerationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModerationRepository {
+	mock := &ModerationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
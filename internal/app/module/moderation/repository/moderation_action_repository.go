@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const actionColumns = `id, case_id, target_type, target_id, action, actor_id, note, created_at`
+
+// hideQueries set or clear hidden_at on reported content.
+var hideQueries = map[moderation_target_enum.ModerationTarget]string{
+	moderation_target_enum.Comment: "UPDATE lesson_comments SET hidden_at = $1, updated_at = $2 WHERE id = $3",
+	moderation_target_enum.Review:  "UPDATE course_reviews SET hidden_at = $1, updated_at = $2 WHERE id = $3",
+}
+
+// Resolve moves c out of the status from and applies action to its target,
+// recording the action in the same transaction. It reports false when the
+// case is no longer in the status from, so two admins never act on the same
+// case twice.
+func (r *Repository) Resolve(c entity.ModerationCase, from moderation_status_enum.ModerationStatus, action entity.ModerationAction) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := "UPDATE moderation_cases SET status = $1, resolved_by = $2, resolved_at = $3, updated_at = $4 WHERE id = $5 AND status = $6"
+	result, err := tx.Exec(query, c.Status, c.ResolvedBy, c.ResolvedAt, c.UpdatedAt, c.ID, from)
+	if err != nil {
+		return false, fmt.Errorf("failed to update moderation case: %v", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update moderation case: %v", err)
+	}
+
+	if updated == 0 {
+		err = tx.Rollback()
+		if err != nil {
+			return false, fmt.Errorf("failed to rollback transaction: %v", err)
+		}
+		return false, nil
+	}
+
+	err = applyAction(tx, c, action)
+	if err != nil {
+		return false, err
+	}
+
+	actionQuery := `
+		INSERT INTO moderation_actions (` + actionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.Exec(actionQuery, action.ID, action.CaseID, action.TargetType, action.TargetID, action.Action,
+		action.ActorID, action.Note, action.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to record moderation action: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return true, nil
+}
+
+// ReadActions lists the audit trail, newest first. Without caseID it lists
+// the actions taken on every case.
+func (r *Repository) ReadActions(caseID *uuid.UUID, limit int, offset int) ([]entity.ModerationAction, error) {
+	query := `
+		SELECT ` + actionColumns + ` FROM moderation_actions
+		WHERE $1::uuid IS NULL OR case_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(query, caseID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read moderation actions: %v", err)
+	}
+	defer rows.Close()
+
+	actions := make([]entity.ModerationAction, 0)
+	for rows.Next() {
+		var action entity.ModerationAction
+		err = rows.Scan(&action.ID, &action.CaseID, &action.TargetType, &action.TargetID, &action.Action,
+			&action.ActorID, &action.Note, &action.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation action: %v", err)
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+func (r *Repository) IsBanned(userID string) (bool, error) {
+	var banned bool
+	err := r.db.QueryRow("SELECT EXISTS (SELECT 1 FROM user_bans WHERE user_id = $1)", userID).Scan(&banned)
+	if err != nil {
+		return false, fmt.Errorf("failed to read user ban: %v", err)
+	}
+
+	return banned, nil
+}
+
+// applyAction changes the target of c. Approving shows hidden content again,
+// and banning a user also removes the content they were reported for.
+func applyAction(tx *sql.Tx, c entity.ModerationCase, action entity.ModerationAction) error {
+	switch action.Action {
+	case moderation_action_enum.Hide:
+		return setHidden(tx, c, &action.CreatedAt, action.CreatedAt)
+	case moderation_action_enum.Approve:
+		return setHidden(tx, c, nil, action.CreatedAt)
+	case moderation_action_enum.Remove:
+		return removeContent(tx, c, action.CreatedAt)
+	case moderation_action_enum.Ban:
+		err := removeContent(tx, c, action.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		query := `
+			INSERT INTO user_bans (user_id, case_id, banned_by, created_at) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_id) DO NOTHING
+		`
+		_, err = tx.Exec(query, c.AuthorID, c.ID, action.ActorID, action.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to ban user: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func setHidden(tx *sql.Tx, c entity.ModerationCase, hiddenAt *int64, updatedAt int64) error {
+	query, ok := hideQueries[c.TargetType]
+	if !ok {
+		return nil
+	}
+
+	_, err := tx.Exec(query, hiddenAt, updatedAt, c.TargetID)
+	if err != nil {
+		return fmt.Errorf("failed to hide %s: %v", c.TargetType, err)
+	}
+
+	return nil
+}
+
+// removeContent takes reported content down for good. Comments are cleared
+// the way their authors delete them, so replies keep their place.
+func removeContent(tx *sql.Tx, c entity.ModerationCase, removedAt int64) error {
+	var err error
+	switch c.TargetType {
+	case moderation_target_enum.Comment:
+		query := "UPDATE lesson_comments SET body = '', deleted_at = $1, updated_at = $1 WHERE id = $2 AND deleted_at IS NULL"
+		_, err = tx.Exec(query, removedAt, c.TargetID)
+	case moderation_target_enum.Review:
+		_, err = tx.Exec("DELETE FROM course_reviews_courses WHERE course_reviews_id = $1", c.TargetID)
+		if err == nil {
+			_, err = tx.Exec("DELETE FROM course_reviews WHERE id = $1", c.TargetID)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove %s: %v", c.TargetType, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func expectResolve(mock sqlmock.Sqlmock, c entity.ModerationCase, from moderation_status_enum.ModerationStatus, updated int64) {
+	mock.ExpectBegin()
+	mock.ExpectExec(resolveCaseQuery).
+		WithArgs(c.Status, c.ResolvedBy, c.ResolvedAt, c.UpdatedAt, c.ID, from).
+		WillReturnResult(sqlmock.NewResult(0, updated))
+}
+
+func expectAction(mock sqlmock.Sqlmock, action entity.ModerationAction) {
+	mock.ExpectExec(createActionQuery).
+		WithArgs(action.ID, action.CaseID, action.TargetType, action.TargetID, action.Action, action.ActorID, action.Note, action.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+func TestRepository_Resolve(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Hide Comment", func(t *testing.T) {
+		hidden := MockCase
+		hidden.Status = moderation_status_enum.Hidden
+		action := MockAction
+		action.Action = moderation_action_enum.Hide
+		action.ActorID = entity.SystemActor
+		expectResolve(mock, hidden, moderation_status_enum.Pending, 1)
+		mock.ExpectExec(hideCommentQuery).WithArgs(&action.CreatedAt, action.CreatedAt, MockCase.TargetID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectAction(mock, action)
+
+		ok, err := repo.Resolve(hidden, moderation_status_enum.Pending, action)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Approve Hidden Review", func(t *testing.T) {
+		c := resolved(moderation_status_enum.Approved)
+		c.TargetType = moderation_target_enum.Review
+		action := MockAction
+		action.TargetType = moderation_target_enum.Review
+		action.Action = moderation_action_enum.Approve
+		expectResolve(mock, c, moderation_status_enum.Hidden, 1)
+		mock.ExpectExec(hideReviewQuery).WithArgs(nil, action.CreatedAt, c.TargetID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectAction(mock, action)
+
+		ok, err := repo.Resolve(c, moderation_status_enum.Hidden, action)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Approve User", func(t *testing.T) {
+		c := resolved(moderation_status_enum.Approved)
+		c.TargetType = moderation_target_enum.User
+		c.TargetID = "user123"
+		action := MockAction
+		action.TargetType = moderation_target_enum.User
+		action.TargetID = "user123"
+		action.Action = moderation_action_enum.Approve
+		expectResolve(mock, c, moderation_status_enum.Pending, 1)
+		expectAction(mock, action)
+
+		ok, err := repo.Resolve(c, moderation_status_enum.Pending, action)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Remove Review", func(t *testing.T) {
+		c := resolved(moderation_status_enum.Removed)
+		c.TargetType = moderation_target_enum.Review
+		action := MockAction
+		action.TargetType = moderation_target_enum.Review
+		expectResolve(mock, c, moderation_status_enum.Pending, 1)
+		mock.ExpectExec(removeReviewLinks).WithArgs(c.TargetID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(removeReviewQuery).WithArgs(c.TargetID).WillReturnResult(sqlmock.NewResult(0, 1))
+		expectAction(mock, action)
+
+		ok, err := repo.Resolve(c, moderation_status_enum.Pending, action)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Ban Comment Author", func(t *testing.T) {
+		c := resolved(moderation_status_enum.Banned)
+		action := MockAction
+		action.Action = moderation_action_enum.Ban
+		expectResolve(mock, c, moderation_status_enum.Hidden, 1)
+		mock.ExpectExec(removeCommentQuery).WithArgs(action.CreatedAt, c.TargetID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(banUserQuery).WithArgs("user123", c.ID, "admin-uid", action.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		expectAction(mock, action)
+
+		ok, err := repo.Resolve(c, moderation_status_enum.Hidden, action)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Resolve Case Resolved Meanwhile", func(t *testing.T) {
+		c := resolved(moderation_status_enum.Removed)
+		expectResolve(mock, c, moderation_status_enum.Pending, 0)
+		mock.ExpectRollback()
+
+		ok, err := repo.Resolve(c, moderation_status_enum.Pending, MockAction)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Resolve Error", func(t *testing.T) {
+		c := resolved(moderation_status_enum.Removed)
+		expectResolve(mock, c, moderation_status_enum.Pending, 1)
+		mock.ExpectExec(removeCommentQuery).WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		_, err := repo.Resolve(c, moderation_status_enum.Pending, MockAction)
+
+		assert.EqualError(t, err, "failed to remove comment: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadActions(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	columns := []string{"id", "case_id", "target_type", "target_id", "action", "actor_id", "note", "created_at"}
+
+	t.Run("Read Actions Of A Case", func(t *testing.T) {
+		a := MockAction
+		mock.ExpectQuery(readActionsQuery).WithArgs(&MockCase.ID, 50, 0).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(a.ID, a.CaseID, a.TargetType, a.TargetID, a.Action, a.ActorID, a.Note, a.CreatedAt))
+
+		actions, err := repo.ReadActions(&MockCase.ID, 50, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.ModerationAction{MockAction}, actions)
+	})
+
+	t.Run("Read All Actions", func(t *testing.T) {
+		mock.ExpectQuery(readActionsQuery).WithArgs((*uuid.UUID)(nil), 10, 10).WillReturnRows(sqlmock.NewRows(columns))
+
+		actions, err := repo.ReadActions(nil, 10, 10)
+
+		assert.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+
+	t.Run("Read Actions Error", func(t *testing.T) {
+		mock.ExpectQuery(readActionsQuery).WillReturnError(errors.New("select failed"))
+
+		_, err := repo.ReadActions(nil, 10, 0)
+
+		assert.EqualError(t, err, "failed to read moderation actions: select failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_IsBanned(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Banned User", func(t *testing.T) {
+		mock.ExpectQuery(readBannedQuery).WithArgs("user123").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		banned, err := repo.IsBanned("user123")
+
+		assert.NoError(t, err)
+		assert.True(t, banned)
+	})
+
+	t.Run("Is Banned Error", func(t *testing.T) {
+		mock.ExpectQuery(readBannedQuery).WithArgs("user123").WillReturnError(errors.New("select failed"))
+
+		_, err := repo.IsBanned("user123")
+
+		assert.EqualError(t, err, "failed to read user ban: select failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const caseColumns = `id, target_type, target_id, author_id, status, report_count, resolved_by, resolved_at, created_at, updated_at`
+
+// targetAuthorQueries read the author of a target. Content that is already
+// gone cannot be reported.
+var targetAuthorQueries = map[moderation_target_enum.ModerationTarget]string{
+	moderation_target_enum.Comment: "SELECT user_id FROM lesson_comments WHERE id = $1 AND deleted_at IS NULL",
+	moderation_target_enum.Review:  "SELECT user_id::text FROM course_reviews WHERE id = $1",
+	moderation_target_enum.User:    "SELECT id FROM users WHERE id = $1",
+}
+
+type ModerationRepository interface {
+	ReadTargetAuthor(targetType moderation_target_enum.ModerationTarget, targetID string) (string, error)
+	AddReport(c entity.ModerationCase, report entity.ModerationReport) (entity.ModerationCase, bool, error)
+	ReadCase(id uuid.UUID) (entity.ModerationCase, error)
+	ReadQueue(limit int, offset int) ([]entity.ModerationCase, error)
+	ReadReports(caseID uuid.UUID) ([]entity.ModerationReport, error)
+	Resolve(c entity.ModerationCase, from moderation_status_enum.ModerationStatus, action entity.ModerationAction) (bool, error)
+	ReadActions(caseID *uuid.UUID, limit int, offset int) ([]entity.ModerationAction, error)
+	IsBanned(userID string) (bool, error)
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ModerationRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+// ReadTargetAuthor returns the ID of the user behind a target, or an empty
+// string when there is no such target.
+func (r *Repository) ReadTargetAuthor(targetType moderation_target_enum.ModerationTarget, targetID string) (string, error) {
+	query, ok := targetAuthorQueries[targetType]
+	if !ok {
+		return "", nil
+	}
+
+	var authorID string
+	err := r.db.QueryRow(query, targetID).Scan(&authorID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read reported %s: %v", targetType, err)
+	}
+
+	return authorID, nil
+}
+
+// AddReport files report on the case of its target, opening the case with c
+// on the first report. It returns the case with its new report count, and
+// false when the reporter had already reported it.
+func (r *Repository) AddReport(c entity.ModerationCase, report entity.ModerationReport) (entity.ModerationCase, bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return entity.ModerationCase{}, false, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	openQuery := `
+		INSERT INTO moderation_cases (id, target_type, target_id, author_id, status, report_count, resolved_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 0, '', $6, $6)
+		ON CONFLICT (target_type, target_id) DO NOTHING
+	`
+	_, err = tx.Exec(openQuery, c.ID, c.TargetType, c.TargetID, c.AuthorID, c.Status, c.CreatedAt)
+	if err != nil {
+		return entity.ModerationCase{}, false, fmt.Errorf("failed to open moderation case: %v", err)
+	}
+
+	reportQuery := `
+		INSERT INTO moderation_reports (id, case_id, reporter_id, reason, created_at)
+		SELECT $1, id, $2, $3, $4 FROM moderation_cases WHERE target_type = $5 AND target_id = $6
+		ON CONFLICT (case_id, reporter_id) DO NOTHING
+	`
+	result, err := tx.Exec(reportQuery, report.ID, report.ReporterID, report.Reason, report.CreatedAt, c.TargetType, c.TargetID)
+	if err != nil {
+		return entity.ModerationCase{}, false, fmt.Errorf("failed to create report: %v", err)
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return entity.ModerationCase{}, false, fmt.Errorf("failed to create report: %v", err)
+	}
+
+	countQuery := `
+		UPDATE moderation_cases SET report_count = report_count + $1, updated_at = $2
+		WHERE target_type = $3 AND target_id = $4
+		RETURNING ` + caseColumns
+	updated, err := scanCase(tx.QueryRow(countQuery, added, report.CreatedAt, c.TargetType, c.TargetID))
+	if err != nil {
+		return entity.ModerationCase{}, false, fmt.Errorf("failed to count report: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return entity.ModerationCase{}, false, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return updated, added > 0, nil
+}
+
+// ReadCase returns a zero value when there is no such case.
+func (r *Repository) ReadCase(id uuid.UUID) (entity.ModerationCase, error) {
+	query := "SELECT " + caseColumns + " FROM moderation_cases WHERE id = $1"
+
+	c, err := scanCase(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.ModerationCase{}, nil
+	}
+	if err != nil {
+		return entity.ModerationCase{}, fmt.Errorf("failed to read moderation case: %v", err)
+	}
+
+	return c, nil
+}
+
+// ReadQueue lists the open cases, hidden ones first and then the most
+// reported.
+func (r *Repository) ReadQueue(limit int, offset int) ([]entity.ModerationCase, error) {
+	query := `
+		SELECT ` + caseColumns + ` FROM moderation_cases
+		WHERE status IN ($1, $2)
+		ORDER BY status = $2 DESC, report_count DESC, created_at
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(query, moderation_status_enum.Pending, moderation_status_enum.Hidden, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read moderation queue: %v", err)
+	}
+	defer rows.Close()
+
+	cases := make([]entity.ModerationCase, 0)
+	for rows.Next() {
+		c, err := scanCase(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation case: %v", err)
+		}
+		cases = append(cases, c)
+	}
+
+	return cases, nil
+}
+
+func (r *Repository) ReadReports(caseID uuid.UUID) ([]entity.ModerationReport, error) {
+	query := "SELECT id, case_id, reporter_id, reason, created_at FROM moderation_reports WHERE case_id = $1 ORDER BY created_at"
+
+	rows, err := r.db.Query(query, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reports: %v", err)
+	}
+	defer rows.Close()
+
+	reports := make([]entity.ModerationReport, 0)
+	for rows.Next() {
+		var report entity.ModerationReport
+		err = rows.Scan(&report.ID, &report.CaseID, &report.ReporterID, &report.Reason, &report.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report: %v", err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCase(row rowScanner) (entity.ModerationCase, error) {
+	var c entity.ModerationCase
+	err := row.Scan(&c.ID, &c.TargetType, &c.TargetID, &c.AuthorID, &c.Status, &c.ReportCount,
+		&c.ResolvedBy, &c.ResolvedAt, &c.CreatedAt, &c.UpdatedAt)
+	return c, err
+}
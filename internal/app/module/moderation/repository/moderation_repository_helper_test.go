@@ -0,0 +1,97 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	"CodeWithAzri/internal/app/module/moderation/repository"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	caseColumns        = "id, target_type, target_id, author_id, status, report_count, resolved_by, resolved_at, created_at, updated_at"
+	readCommentAuthor  = "SELECT user_id FROM lesson_comments WHERE id = $1 AND deleted_at IS NULL"
+	readReviewAuthor   = "SELECT user_id::text FROM course_reviews WHERE id = $1"
+	readUserQuery      = "SELECT id FROM users WHERE id = $1"
+	openCaseQuery      = "INSERT INTO moderation_cases (id, target_type, target_id, author_id, status, report_count, resolved_by, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, 0, '', $6, $6) ON CONFLICT (target_type, target_id) DO NOTHING"
+	createReportQuery  = "INSERT INTO moderation_reports (id, case_id, reporter_id, reason, created_at) SELECT $1, id, $2, $3, $4 FROM moderation_cases WHERE target_type = $5 AND target_id = $6 ON CONFLICT (case_id, reporter_id) DO NOTHING"
+	countReportQuery   = "UPDATE moderation_cases SET report_count = report_count + $1, updated_at = $2 WHERE target_type = $3 AND target_id = $4 RETURNING " + caseColumns
+	readCaseQuery      = "SELECT " + caseColumns + " FROM moderation_cases WHERE id = $1"
+	readQueueQuery     = "SELECT " + caseColumns + " FROM moderation_cases WHERE status IN ($1, $2) ORDER BY status = $2 DESC, report_count DESC, created_at LIMIT $3 OFFSET $4"
+	readReportsQuery   = "SELECT id, case_id, reporter_id, reason, created_at FROM moderation_reports WHERE case_id = $1 ORDER BY created_at"
+	resolveCaseQuery   = "UPDATE moderation_cases SET status = $1, resolved_by = $2, resolved_at = $3, updated_at = $4 WHERE id = $5 AND status = $6"
+	hideCommentQuery   = "UPDATE lesson_comments SET hidden_at = $1, updated_at = $2 WHERE id = $3"
+	hideReviewQuery    = "UPDATE course_reviews SET hidden_at = $1, updated_at = $2 WHERE id = $3"
+	removeCommentQuery = "UPDATE lesson_comments SET body = '', deleted_at = $1, updated_at = $1 WHERE id = $2 AND deleted_at IS NULL"
+	removeReviewLinks  = "DELETE FROM course_reviews_courses WHERE course_reviews_id = $1"
+	removeReviewQuery  = "DELETE FROM course_reviews WHERE id = $1"
+	banUserQuery       = "INSERT INTO user_bans (user_id, case_id, banned_by, created_at) VALUES ($1, $2, $3, $4) ON CONFLICT (user_id) DO NOTHING"
+	createActionQuery  = "INSERT INTO moderation_actions (id, case_id, target_type, target_id, action, actor_id, note, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	readActionsQuery   = "SELECT id, case_id, target_type, target_id, action, actor_id, note, created_at FROM moderation_actions WHERE $1::uuid IS NULL OR case_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3"
+	readBannedQuery    = "SELECT EXISTS (SELECT 1 FROM user_bans WHERE user_id = $1)"
+)
+
+var MockCase entity.ModerationCase = entity.ModerationCase{
+	ID:          uuid.MustParse("3b9d2c4e-6f1a-4d8b-9e2c-7a5f1b3d6e81"),
+	TargetType:  "comment",
+	TargetID:    "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61",
+	AuthorID:    "user123",
+	Status:      "pending",
+	ReportCount: 1,
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+var MockReport entity.ModerationReport = entity.ModerationReport{
+	ID:         uuid.MustParse("3b9d2c4e-6f1a-4d8b-9e2c-7a5f1b3d6e91"),
+	CaseID:     MockCase.ID,
+	ReporterID: "user456",
+	Reason:     "Spam",
+	CreatedAt:  121212,
+}
+
+var MockAction entity.ModerationAction = entity.ModerationAction{
+	ID:         uuid.MustParse("3b9d2c4e-6f1a-4d8b-9e2c-7a5f1b3d6ea1"),
+	CaseID:     MockCase.ID,
+	TargetType: MockCase.TargetType,
+	TargetID:   MockCase.TargetID,
+	Action:     "remove",
+	ActorID:    "admin-uid",
+	Note:       "Spam",
+	CreatedAt:  131313,
+}
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ModerationRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func prepareCaseRows(cases ...entity.ModerationCase) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "target_type", "target_id", "author_id", "status", "report_count",
+		"resolved_by", "resolved_at", "created_at", "updated_at"})
+	for _, c := range cases {
+		rows.AddRow(c.ID, c.TargetType, c.TargetID, c.AuthorID, c.Status, c.ReportCount,
+			c.ResolvedBy, c.ResolvedAt, c.CreatedAt, c.UpdatedAt)
+	}
+	return rows
+}
+
+// resolved returns MockCase resolved by admin-uid with status.
+func resolved(status moderation_status_enum.ModerationStatus) entity.ModerationCase {
+	resolvedAt := int64(131313)
+	c := MockCase
+	c.Status = status
+	c.ResolvedBy = "admin-uid"
+	c.ResolvedAt = &resolvedAt
+	c.UpdatedAt = resolvedAt
+	return c
+}
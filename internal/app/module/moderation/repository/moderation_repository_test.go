@@ -0,0 +1,201 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ReadTargetAuthor(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Comment Author", func(t *testing.T) {
+		mock.ExpectQuery(readCommentAuthor).WithArgs(MockCase.TargetID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow("user123"))
+
+		authorID, err := repo.ReadTargetAuthor(moderation_target_enum.Comment, MockCase.TargetID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user123", authorID)
+	})
+
+	t.Run("Read Review Author", func(t *testing.T) {
+		mock.ExpectQuery(readReviewAuthor).WithArgs(MockCase.TargetID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow("user123"))
+
+		authorID, err := repo.ReadTargetAuthor(moderation_target_enum.Review, MockCase.TargetID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user123", authorID)
+	})
+
+	t.Run("Read Missing User", func(t *testing.T) {
+		mock.ExpectQuery(readUserQuery).WithArgs("user789").WillReturnError(sql.ErrNoRows)
+
+		authorID, err := repo.ReadTargetAuthor(moderation_target_enum.User, "user789")
+
+		assert.NoError(t, err)
+		assert.Empty(t, authorID)
+	})
+
+	t.Run("Read Target Author Error", func(t *testing.T) {
+		mock.ExpectQuery(readCommentAuthor).WillReturnError(errors.New("select failed"))
+
+		_, err := repo.ReadTargetAuthor(moderation_target_enum.Comment, MockCase.TargetID)
+
+		assert.EqualError(t, err, "failed to read reported comment: select failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddReport(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	opened := MockCase
+	opened.ReportCount = 0
+
+	t.Run("Add First Report", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(openCaseQuery).
+			WithArgs(opened.ID, opened.TargetType, opened.TargetID, opened.AuthorID, opened.Status, opened.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createReportQuery).
+			WithArgs(MockReport.ID, MockReport.ReporterID, MockReport.Reason, MockReport.CreatedAt, opened.TargetType, opened.TargetID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(countReportQuery).
+			WithArgs(int64(1), MockReport.CreatedAt, opened.TargetType, opened.TargetID).
+			WillReturnRows(prepareCaseRows(MockCase))
+		mock.ExpectCommit()
+
+		c, added, err := repo.AddReport(opened, MockReport)
+
+		assert.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, MockCase, c)
+	})
+
+	t.Run("Add Report Twice", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(openCaseQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(createReportQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(countReportQuery).
+			WithArgs(int64(0), MockReport.CreatedAt, opened.TargetType, opened.TargetID).
+			WillReturnRows(prepareCaseRows(MockCase))
+		mock.ExpectCommit()
+
+		c, added, err := repo.AddReport(opened, MockReport)
+
+		assert.NoError(t, err)
+		assert.False(t, added)
+		assert.Equal(t, 1, c.ReportCount)
+	})
+
+	t.Run("Add Report Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(openCaseQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createReportQuery).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		_, _, err := repo.AddReport(opened, MockReport)
+
+		assert.EqualError(t, err, "failed to create report: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadCase(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Case Success", func(t *testing.T) {
+		mock.ExpectQuery(readCaseQuery).WithArgs(MockCase.ID).WillReturnRows(prepareCaseRows(MockCase))
+
+		c, err := repo.ReadCase(MockCase.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockCase, c)
+	})
+
+	t.Run("Read Case Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readCaseQuery).WithArgs(MockCase.ID).WillReturnError(sql.ErrNoRows)
+
+		c, err := repo.ReadCase(MockCase.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ModerationCase{}, c)
+	})
+
+	t.Run("Read Case Error", func(t *testing.T) {
+		mock.ExpectQuery(readCaseQuery).WithArgs(MockCase.ID).WillReturnError(errors.New("select failed"))
+
+		_, err := repo.ReadCase(MockCase.ID)
+
+		assert.EqualError(t, err, "failed to read moderation case: select failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadQueue(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Queue Success", func(t *testing.T) {
+		hidden := MockCase
+		hidden.Status = moderation_status_enum.Hidden
+		mock.ExpectQuery(readQueueQuery).
+			WithArgs(moderation_status_enum.Pending, moderation_status_enum.Hidden, 10, 10).
+			WillReturnRows(prepareCaseRows(hidden, MockCase))
+
+		cases, err := repo.ReadQueue(10, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.ModerationCase{hidden, MockCase}, cases)
+	})
+
+	t.Run("Read Queue Error", func(t *testing.T) {
+		mock.ExpectQuery(readQueueQuery).WillReturnError(errors.New("select failed"))
+
+		_, err := repo.ReadQueue(10, 0)
+
+		assert.EqualError(t, err, "failed to read moderation queue: select failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadReports(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Reports Success", func(t *testing.T) {
+		mock.ExpectQuery(readReportsQuery).WithArgs(MockCase.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "case_id", "reporter_id", "reason", "created_at"}).
+				AddRow(MockReport.ID, MockReport.CaseID, MockReport.ReporterID, MockReport.Reason, MockReport.CreatedAt))
+
+		reports, err := repo.ReadReports(MockCase.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.ModerationReport{MockReport}, reports)
+	})
+
+	t.Run("Read Reports Error", func(t *testing.T) {
+		mock.ExpectQuery(readReportsQuery).WithArgs(MockCase.ID).WillReturnError(errors.New("select failed"))
+
+		_, err := repo.ReadReports(MockCase.ID)
+
+		assert.EqualError(t, err, "failed to read reports: select failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,399 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/moderation/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "CodeWithAzri/internal/app/module/moderation/service"
+
+	uuid "github.com/google/uuid"
+)
+
+// ModerationService is an autogenerated mock type for the ModerationService type
+type ModerationService struct {
+	mock.Mock
+}
+
+type ModerationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModerationService) EXPECT() *ModerationService_Expecter {
+	return &ModerationService_Expecter{mock: &_m.Mock}
+}
+
+// CheckContent provides a mock function with given fields: userID, text
+func (_m *ModerationService) CheckContent(userID string, text string) error {
+	ret := _m.Called(userID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, text)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModerationService_CheckContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckContent'
+type ModerationService_CheckContent_Call struct {
+	*mock.Call
+}
+
+// CheckContent is a helper method to define mock.On call
+//   - userID string
+//   - text string
+func (_e *ModerationService_Expecter) CheckContent(userID interface{}, text interface{}) *ModerationService_CheckContent_Call {
+	return &ModerationService_CheckContent_Call{Call: _e.mock.On("CheckContent", userID, text)}
+}
+
+func (_c *ModerationService_CheckContent_Call) Run(run func(userID string, text string)) *ModerationService_CheckContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ModerationService_CheckContent_Call) Return(_a0 error) *ModerationService_CheckContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModerationService_CheckContent_Call) RunAndReturn(run func(string, string) error) *ModerationService_CheckContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActions provides a mock function with given fields: limit, page
+func (_m *ModerationService) GetActions(limit int, page int) ([]dto.ModerationActionDTO, error) {
+	ret := _m.Called(limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActions")
+	}
+
+	var r0 []dto.ModerationActionDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]dto.ModerationActionDTO, error)); ok {
+		return rf(limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []dto.ModerationActionDTO); ok {
+		r0 = rf(limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ModerationActionDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_GetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActions'
+type ModerationService_GetActions_Call struct {
+	*mock.Call
+}
+
+// GetActions is a helper method to define mock.On call
+//   - limit int
+//   - page int
+func (_e *ModerationService_Expecter) GetActions(limit interface{}, page interface{}) *ModerationService_GetActions_Call {
+	return &ModerationService_GetActions_Call{Call: _e.mock.On("GetActions", limit, page)}
+}
+
+func (_c *ModerationService_GetActions_Call) Run(run func(limit int, page int)) *ModerationService_GetActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ModerationService_GetActions_Call) Return(_a0 []dto.ModerationActionDTO, _a1 error) *ModerationService_GetActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_GetActions_Call) RunAndReturn(run func(int, int) ([]dto.ModerationActionDTO, error)) *ModerationService_GetActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCase provides a mock function with given fields: id
+func (_m *ModerationService) GetCase(id uuid.UUID) (dto.ModerationCaseDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCase")
+	}
+
+	var r0 dto.ModerationCaseDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.ModerationCaseDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.ModerationCaseDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.ModerationCaseDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_GetCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCase'
+type ModerationService_GetCase_Call struct {
+	*mock.Call
+}
+
+// GetCase is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *ModerationService_Expecter) GetCase(id interface{}) *ModerationService_GetCase_Call {
+	return &ModerationService_GetCase_Call{Call: _e.mock.On("GetCase", id)}
+}
+
+func (_c *ModerationService_GetCase_Call) Run(run func(id uuid.UUID)) *ModerationService_GetCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ModerationService_GetCase_Call) Return(_a0 dto.ModerationCaseDTO, _a1 error) *ModerationService_GetCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_GetCase_Call) RunAndReturn(run func(uuid.UUID) (dto.ModerationCaseDTO, error)) *ModerationService_GetCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueue provides a mock function with given fields: limit, page
+func (_m *ModerationService) GetQueue(limit int, page int) ([]dto.ModerationCaseDTO, error) {
+	ret := _m.Called(limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueue")
+	}
+
+	var r0 []dto.ModerationCaseDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]dto.ModerationCaseDTO, error)); ok {
+		return rf(limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []dto.ModerationCaseDTO); ok {
+		r0 = rf(limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ModerationCaseDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_GetQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueue'
+type ModerationService_GetQueue_Call struct {
+	*mock.Call
+}
+
+// GetQueue is a helper method to define mock.On call
+//   - limit int
+//   - page int
+func (_e *ModerationService_Expecter) GetQueue(limit interface{}, page interface{}) *ModerationService_GetQueue_Call {
+	return &ModerationService_GetQueue_Call{Call: _e.mock.On("GetQueue", limit, page)}
+}
+
+func (_c *ModerationService_GetQueue_Call) Run(run func(limit int, page int)) *ModerationService_GetQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ModerationService_GetQueue_Call) Return(_a0 []dto.ModerationCaseDTO, _a1 error) *ModerationService_GetQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_GetQueue_Call) RunAndReturn(run func(int, int) ([]dto.ModerationCaseDTO, error)) *ModerationService_GetQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnContentModerated provides a mock function with given fields: listener
+func (_m *ModerationService) OnContentModerated(listener service.ContentListener) {
+	_m.Called(listener)
+}
+
+// ModerationService_OnContentModerated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnContentModerated'
+type ModerationService_OnContentModerated_Call struct {
+	*mock.Call
+}
+
+// OnContentModerated is a helper method to define mock.On call
+//   - listener service.ContentListener
+func (_e *ModerationService_Expecter) OnContentModerated(listener interface{}) *ModerationService_OnContentModerated_Call {
+	return &ModerationService_OnContentModerated_Call{Call: _e.mock.On("OnContentModerated", listener)}
+}
+
+func (_c *ModerationService_OnContentModerated_Call) Run(run func(listener service.ContentListener)) *ModerationService_OnContentModerated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ContentListener))
+	})
+	return _c
+}
+
+func (_c *ModerationService_OnContentModerated_Call) Return() *ModerationService_OnContentModerated_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ModerationService_OnContentModerated_Call) RunAndReturn(run func(service.ContentListener)) *ModerationService_OnContentModerated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with given fields: reporterID, input
+func (_m *ModerationService) Report(reporterID string, input dto.CreateReportDTO) error {
+	ret := _m.Called(reporterID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dto.CreateReportDTO) error); ok {
+		r0 = rf(reporterID, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModerationService_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type ModerationService_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - reporterID string
+//   - input dto.CreateReportDTO
+func (_e *ModerationService_Expecter) Report(reporterID interface{}, input interface{}) *ModerationService_Report_Call {
+	return &ModerationService_Report_Call{Call: _e.mock.On("Report", reporterID, input)}
+}
+
+func (_c *ModerationService_Report_Call) Run(run func(reporterID string, input dto.CreateReportDTO)) *ModerationService_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.CreateReportDTO))
+	})
+	return _c
+}
+
+func (_c *ModerationService_Report_Call) Return(_a0 error) *ModerationService_Report_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModerationService_Report_Call) RunAndReturn(run func(string, dto.CreateReportDTO) error) *ModerationService_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeAction provides a mock function with given fields: caseID, actorID, input
+func (_m *ModerationService) TakeAction(caseID uuid.UUID, actorID string, input dto.TakeActionDTO) (dto.ModerationCaseDTO, error) {
+	ret := _m.Called(caseID, actorID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeAction")
+	}
+
+	var r0 dto.ModerationCaseDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.TakeActionDTO) (dto.ModerationCaseDTO, error)); ok {
+		return rf(caseID, actorID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, dto.TakeActionDTO) dto.ModerationCaseDTO); ok {
+		r0 = rf(caseID, actorID, input)
+	} else {
+		r0 = ret.Get(0).(dto.ModerationCaseDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, dto.TakeActionDTO) error); ok {
+		r1 = rf(caseID, actorID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerationService_TakeAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeAction'
+type ModerationService_TakeAction_Call struct {
+	*mock.Call
+}
+
+// TakeAction is a helper method to define mock.On call
+//   - caseID uuid.UUID
+//   - actorID string
+//   - input dto.TakeActionDTO
+func (_e *ModerationService_Expecter) TakeAction(caseID interface{}, actorID interface{}, input interface{}) *ModerationService_TakeAction_Call {
+	return &ModerationService_TakeAction_Call{Call: _e.mock.On("TakeAction", caseID, actorID, input)}
+}
+
+func (_c *ModerationService_TakeAction_Call) Run(run func(caseID uuid.UUID, actorID string, input dto.TakeActionDTO)) *ModerationService_TakeAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(dto.TakeActionDTO))
+	})
+	return _c
+}
+
+func (_c *ModerationService_TakeAction_Call) Return(_a0 dto.ModerationCaseDTO, _a1 error) *ModerationService_TakeAction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModerationService_TakeAction_Call) RunAndReturn(run func(uuid.UUID, string, dto.TakeActionDTO) (dto.ModerationCaseDTO, error)) *ModerationService_TakeAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModerationService creates a new instance of ModerationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModerationService {
+	mock := &ModerationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
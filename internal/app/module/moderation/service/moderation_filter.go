@@ -0,0 +1,39 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WordFilter finds banned words in text. Words match whole and regardless of
+// case, so banning "ass" does not catch "class".
+type WordFilter struct {
+	words map[string]bool
+}
+
+func NewWordFilter(words []string) *WordFilter {
+	f := &WordFilter{words: make(map[string]bool, len(words))}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			f.words[word] = true
+		}
+	}
+	return f
+}
+
+func (f *WordFilter) Contains(text string) bool {
+	if len(f.words) == 0 {
+		return false
+	}
+
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, token := range tokens {
+		if f.words[token] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordFilter_Contains(t *testing.T) {
+	filter := service.NewWordFilter([]string{"scam", " Crypto ", ""})
+
+	tests := []struct {
+		name     string
+		text     string
+		expected bool
+	}{
+		{name: "Banned Word", text: "This course is a scam", expected: true},
+		{name: "Banned Word In Another Case", text: "Buy CRYPTO now!", expected: true},
+		{name: "Banned Word Between Punctuation", text: "total-scam.", expected: true},
+		{name: "Banned Word Inside Another Word", text: "Scammers beware", expected: false},
+		{name: "Clean Text", text: "Great explanation of goroutines", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, filter.Contains(test.text))
+		})
+	}
+
+	t.Run("Empty Filter", func(t *testing.T) {
+		assert.False(t, service.NewWordFilter([]string{""}).Contains("scam"))
+	})
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/dto"
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	"CodeWithAzri/internal/app/module/moderation/repository"
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTargetNotFound   = errors.New("the reported content was not found")
+	ErrCannotReportSelf = errors.New("you cannot report yourself or your own content")
+	ErrCaseNotFound     = errors.New("moderation case not found")
+	ErrCaseResolved     = errors.New("the moderation case was already resolved")
+	ErrActionNotAllowed = errors.New("reported users can only be approved or banned")
+	ErrUserBanned       = errors.New("your account is banned from posting")
+	ErrBannedWords      = errors.New("the text contains words that are not allowed")
+)
+
+// maxCaseActions bounds the audit trail shown with a case. A case is
+// resolved once, so it rarely has more than a hide and a resolution.
+const maxCaseActions = 50
+
+// resolvedStatusByAction is the status a case ends in after an admin action.
+var resolvedStatusByAction = map[moderation_action_enum.ModerationAction]moderation_status_enum.ModerationStatus{
+	moderation_action_enum.Approve: moderation_status_enum.Approved,
+	moderation_action_enum.Remove:  moderation_status_enum.Removed,
+	moderation_action_enum.Ban:     moderation_status_enum.Banned,
+}
+
+type ModerationService interface {
+	Report(reporterID string, input dto.CreateReportDTO) error
+	GetQueue(limit int, page int) ([]dto.ModerationCaseDTO, error)
+	GetCase(id uuid.UUID) (dto.ModerationCaseDTO, error)
+	TakeAction(caseID uuid.UUID, actorID string, input dto.TakeActionDTO) (dto.ModerationCaseDTO, error)
+	GetActions(limit int, page int) ([]dto.ModerationActionDTO, error)
+	CheckContent(userID string, text string) error
+	OnContentModerated(listener ContentListener)
+}
+
+// ContentListener is told about reported content whose visibility a
+// moderation action may have changed.
+type ContentListener func(targetType moderation_target_enum.ModerationTarget, targetID string) error
+
+type Service struct {
+	repository       repository.ModerationRepository
+	filter           *WordFilter
+	hideThreshold    int
+	contentListeners []ContentListener
+}
+
+// NewModerationService creates the service behind reports and the moderation
+// queue. Content is hidden automatically once hideThreshold users have
+// reported it.
+func NewModerationService(r repository.ModerationRepository, filter *WordFilter, hideThreshold int) ModerationService {
+	s := new(Service)
+	s.repository = r
+	s.filter = filter
+	s.hideThreshold = hideThreshold
+	return s
+}
+
+// Report files a report of reporterID. Reporting the same target twice
+// counts once.
+func (s *Service) Report(reporterID string, input dto.CreateReportDTO) error {
+	if input.TargetType.IsContent() {
+		if _, err := uuid.Parse(input.TargetID); err != nil {
+			return ErrTargetNotFound
+		}
+	}
+
+	authorID, err := s.repository.ReadTargetAuthor(input.TargetType, input.TargetID)
+	if err != nil {
+		return err
+	}
+
+	if authorID == "" {
+		return ErrTargetNotFound
+	}
+
+	if authorID == reporterID {
+		return ErrCannotReportSelf
+	}
+
+	now := timepkg.NowUnixMilli()
+	c := entity.ModerationCase{
+		ID:         uuid.New(),
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		AuthorID:   authorID,
+		Status:     moderation_status_enum.Pending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	report := entity.ModerationReport{
+		ID:         uuid.New(),
+		ReporterID: reporterID,
+		Reason:     input.Reason,
+		CreatedAt:  now,
+	}
+
+	c, added, err := s.repository.AddReport(c, report)
+	if err != nil {
+		return err
+	}
+
+	if added {
+		s.hideIfReportedEnough(c)
+	}
+	return nil
+}
+
+// hideIfReportedEnough hides pending content once it has been reported
+// hideThreshold times. The report is already filed, so failures are only
+// logged; the next report tries again.
+func (s *Service) hideIfReportedEnough(c entity.ModerationCase) {
+	if !c.TargetType.IsContent() || c.Status != moderation_status_enum.Pending || c.ReportCount < s.hideThreshold {
+		return
+	}
+
+	now := timepkg.NowUnixMilli()
+	hidden := c
+	hidden.Status = moderation_status_enum.Hidden
+	hidden.UpdatedAt = now
+	action := newAction(c, moderation_action_enum.Hide, entity.SystemActor, fmt.Sprintf("Hidden after %d reports", c.ReportCount), now)
+
+	ok, err := s.repository.Resolve(hidden, moderation_status_enum.Pending, action)
+	if err != nil {
+		log.Printf("failed to hide %s %s: %v\n", c.TargetType, c.TargetID, err)
+		return
+	}
+
+	if ok {
+		s.notifyContentListeners(c)
+	}
+}
+
+// GetQueue pages through the open cases, hidden content first and then the
+// most reported.
+func (s *Service) GetQueue(limit int, page int) ([]dto.ModerationCaseDTO, error) {
+	offset := (page - 1) * limit
+	cases, err := s.repository.ReadQueue(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	caseDTOs := make([]dto.ModerationCaseDTO, 0, len(cases))
+	for _, c := range cases {
+		caseDTOs = append(caseDTOs, toCaseDTO(c))
+	}
+	return caseDTOs, nil
+}
+
+// GetCase returns a case with all of its reports and the actions taken on
+// it.
+func (s *Service) GetCase(id uuid.UUID) (dto.ModerationCaseDTO, error) {
+	c, err := s.readCase(id)
+	if err != nil {
+		return dto.ModerationCaseDTO{}, err
+	}
+
+	reports, err := s.repository.ReadReports(id)
+	if err != nil {
+		return dto.ModerationCaseDTO{}, err
+	}
+
+	actions, err := s.repository.ReadActions(&id, maxCaseActions, 0)
+	if err != nil {
+		return dto.ModerationCaseDTO{}, err
+	}
+
+	caseDTO := toCaseDTO(c)
+	for _, report := range reports {
+		caseDTO.Reports = append(caseDTO.Reports, dto.ReportDTO{
+			ID:         report.ID,
+			ReporterID: report.ReporterID,
+			Reason:     report.Reason,
+			CreatedAt:  report.CreatedAt,
+		})
+	}
+	for _, action := range actions {
+		caseDTO.Actions = append(caseDTO.Actions, toActionDTO(action))
+	}
+	return caseDTO, nil
+}
+
+// TakeAction resolves an open case on behalf of the admin actorID.
+func (s *Service) TakeAction(caseID uuid.UUID, actorID string, input dto.TakeActionDTO) (dto.ModerationCaseDTO, error) {
+	c, err := s.readCase(caseID)
+	if err != nil {
+		return dto.ModerationCaseDTO{}, err
+	}
+
+	if !c.Status.IsOpen() {
+		return dto.ModerationCaseDTO{}, ErrCaseResolved
+	}
+
+	if c.TargetType == moderation_target_enum.User && input.Action == moderation_action_enum.Remove {
+		return dto.ModerationCaseDTO{}, ErrActionNotAllowed
+	}
+
+	now := timepkg.NowUnixMilli()
+	resolved := c
+	resolved.Status = resolvedStatusByAction[input.Action]
+	resolved.ResolvedBy = actorID
+	resolved.ResolvedAt = &now
+	resolved.UpdatedAt = now
+	action := newAction(c, input.Action, actorID, input.Note, now)
+
+	ok, err := s.repository.Resolve(resolved, c.Status, action)
+	if err != nil {
+		return dto.ModerationCaseDTO{}, err
+	}
+
+	// Another admin got there first.
+	if !ok {
+		return dto.ModerationCaseDTO{}, ErrCaseResolved
+	}
+
+	s.notifyContentListeners(c)
+	return toCaseDTO(resolved), nil
+}
+
+// OnContentModerated registers listener for every action taken on reported
+// content from now on. Listeners are registered while the application
+// starts, before any request is served.
+func (s *Service) OnContentModerated(listener ContentListener) {
+	s.contentListeners = append(s.contentListeners, listener)
+}
+
+// notifyContentListeners tells the listeners about an action taken on the
+// content of c. The action is recorded either way, so a failing listener is
+// only logged.
+func (s *Service) notifyContentListeners(c entity.ModerationCase) {
+	if !c.TargetType.IsContent() {
+		return
+	}
+
+	for _, listener := range s.contentListeners {
+		err := listener(c.TargetType, c.TargetID)
+		if err != nil {
+			log.Printf("failed to notify content listener about %s %s: %v\n", c.TargetType, c.TargetID, err)
+		}
+	}
+}
+
+// GetActions pages through the audit log of every moderation action, newest
+// first.
+func (s *Service) GetActions(limit int, page int) ([]dto.ModerationActionDTO, error) {
+	offset := (page - 1) * limit
+	actions, err := s.repository.ReadActions(nil, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	actionDTOs := make([]dto.ModerationActionDTO, 0, len(actions))
+	for _, action := range actions {
+		actionDTOs = append(actionDTOs, toActionDTO(action))
+	}
+	return actionDTOs, nil
+}
+
+// CheckContent keeps banned users from writing and text with banned words
+// from being stored.
+func (s *Service) CheckContent(userID string, text string) error {
+	banned, err := s.repository.IsBanned(userID)
+	if err != nil {
+		return err
+	}
+
+	if banned {
+		return ErrUserBanned
+	}
+
+	if s.filter.Contains(text) {
+		return ErrBannedWords
+	}
+	return nil
+}
+
+func (s *Service) readCase(id uuid.UUID) (entity.ModerationCase, error) {
+	c, err := s.repository.ReadCase(id)
+	if err != nil {
+		return entity.ModerationCase{}, err
+	}
+
+	if c.ID == uuid.Nil {
+		return entity.ModerationCase{}, ErrCaseNotFound
+	}
+	return c, nil
+}
+
+func newAction(c entity.ModerationCase, action moderation_action_enum.ModerationAction, actorID string, note string, createdAt int64) entity.ModerationAction {
+	return entity.ModerationAction{
+		ID:         uuid.New(),
+		CaseID:     c.ID,
+		TargetType: c.TargetType,
+		TargetID:   c.TargetID,
+		Action:     action,
+		ActorID:    actorID,
+		Note:       note,
+		CreatedAt:  createdAt,
+	}
+}
+
+func toCaseDTO(c entity.ModerationCase) dto.ModerationCaseDTO {
+	return dto.ModerationCaseDTO{
+		ID:          c.ID,
+		TargetType:  c.TargetType,
+		TargetID:    c.TargetID,
+		AuthorID:    c.AuthorID,
+		Status:      c.Status,
+		ReportCount: c.ReportCount,
+		ResolvedBy:  c.ResolvedBy,
+		ResolvedAt:  c.ResolvedAt,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func toActionDTO(action entity.ModerationAction) dto.ModerationActionDTO {
+	return dto.ModerationActionDTO{
+		ID:         action.ID,
+		CaseID:     action.CaseID,
+		TargetType: action.TargetType,
+		TargetID:   action.TargetID,
+		Action:     action.Action,
+		ActorID:    action.ActorID,
+		Note:       action.Note,
+		CreatedAt:  action.CreatedAt,
+	}
+}
@@ -0,0 +1,27 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	"CodeWithAzri/internal/app/module/moderation/repository/mocks"
+	"CodeWithAzri/internal/app/module/moderation/service"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var MockCase entity.ModerationCase = entity.ModerationCase{
+	ID:          uuid.MustParse("3b9d2c4e-6f1a-4d8b-9e2c-7a5f1b3d6e81"),
+	TargetType:  "comment",
+	TargetID:    "5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61",
+	AuthorID:    "user123",
+	Status:      "pending",
+	ReportCount: 1,
+	CreatedAt:   121212,
+	UpdatedAt:   121212,
+}
+
+func initializeService(t *testing.T) (service.ModerationService, *mocks.ModerationRepository) {
+	mockRepo := mocks.NewModerationRepository(t)
+	s := service.NewModerationService(mockRepo, service.NewWordFilter([]string{"scam", " Crypto "}), 3)
+	return s, mockRepo
+}
@@ -0,0 +1,373 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/moderation/dto"
+	"CodeWithAzri/internal/app/module/moderation/entity"
+	"CodeWithAzri/internal/app/module/moderation/service"
+	moderation_action_enum "CodeWithAzri/pkg/enums/moderationAction"
+	moderation_status_enum "CodeWithAzri/pkg/enums/moderationStatus"
+	moderation_target_enum "CodeWithAzri/pkg/enums/moderationTarget"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Report(t *testing.T) {
+	input := dto.CreateReportDTO{TargetType: moderation_target_enum.Comment, TargetID: MockCase.TargetID, Reason: "Spam"}
+
+	t.Run("Report Comment", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		var opened entity.ModerationCase
+		var report entity.ModerationReport
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("user123", nil)
+		mockRepo.On("AddReport", mock.AnythingOfType("entity.ModerationCase"), mock.AnythingOfType("entity.ModerationReport")).
+			Run(func(args mock.Arguments) {
+				opened = args.Get(0).(entity.ModerationCase)
+				report = args.Get(1).(entity.ModerationReport)
+			}).Return(MockCase, true, nil)
+
+		err := s.Report("user456", input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user123", opened.AuthorID)
+		assert.Equal(t, moderation_status_enum.Pending, opened.Status)
+		assert.Equal(t, "user456", report.ReporterID)
+		assert.Equal(t, "Spam", report.Reason)
+	})
+
+	t.Run("Report Hides Content After Enough Reports", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		reported := MockCase
+		reported.ReportCount = 3
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("user123", nil)
+		mockRepo.On("AddReport", mock.AnythingOfType("entity.ModerationCase"), mock.AnythingOfType("entity.ModerationReport")).
+			Return(reported, true, nil)
+		mockRepo.On("Resolve",
+			mock.MatchedBy(func(c entity.ModerationCase) bool {
+				return c.ID == MockCase.ID && c.Status == moderation_status_enum.Hidden && c.ResolvedAt == nil
+			}),
+			moderation_status_enum.Pending,
+			mock.MatchedBy(func(a entity.ModerationAction) bool {
+				return a.Action == moderation_action_enum.Hide && a.ActorID == entity.SystemActor && a.Note == "Hidden after 3 reports"
+			}),
+		).Return(true, nil)
+
+		var notified []string
+		s.OnContentModerated(func(targetType moderation_target_enum.ModerationTarget, targetID string) error {
+			notified = append(notified, string(targetType)+":"+targetID)
+			return nil
+		})
+
+		assert.NoError(t, s.Report("user456", input))
+		assert.Equal(t, []string{"comment:" + MockCase.TargetID}, notified)
+	})
+
+	t.Run("Report Failing To Hide Content", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		reported := MockCase
+		reported.ReportCount = 4
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("user123", nil)
+		mockRepo.On("AddReport", mock.AnythingOfType("entity.ModerationCase"), mock.AnythingOfType("entity.ModerationReport")).
+			Return(reported, true, nil)
+		mockRepo.On("Resolve", mock.AnythingOfType("entity.ModerationCase"), moderation_status_enum.Pending, mock.AnythingOfType("entity.ModerationAction")).
+			Return(false, errors.New("Repository Failure"))
+
+		assert.NoError(t, s.Report("user456", input))
+	})
+
+	t.Run("Report Twice Does Not Hide Again", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		reported := MockCase
+		reported.ReportCount = 3
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("user123", nil)
+		mockRepo.On("AddReport", mock.AnythingOfType("entity.ModerationCase"), mock.AnythingOfType("entity.ModerationReport")).
+			Return(reported, false, nil)
+
+		assert.NoError(t, s.Report("user456", input))
+	})
+
+	t.Run("Reported Users Are Not Hidden", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		reported := MockCase
+		reported.TargetType = moderation_target_enum.User
+		reported.TargetID = "user123"
+		reported.ReportCount = 5
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.User, "user123").Return("user123", nil)
+		mockRepo.On("AddReport", mock.AnythingOfType("entity.ModerationCase"), mock.AnythingOfType("entity.ModerationReport")).
+			Return(reported, true, nil)
+
+		err := s.Report("user456", dto.CreateReportDTO{TargetType: moderation_target_enum.User, TargetID: "user123", Reason: "Spam"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Report Content With Invalid ID", func(t *testing.T) {
+		s, _ := initializeService(t)
+
+		err := s.Report("user456", dto.CreateReportDTO{TargetType: moderation_target_enum.Review, TargetID: "invalid", Reason: "Spam"})
+
+		assert.ErrorIs(t, err, service.ErrTargetNotFound)
+	})
+
+	t.Run("Report Missing Content", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("", nil)
+
+		assert.ErrorIs(t, s.Report("user456", input), service.ErrTargetNotFound)
+	})
+
+	t.Run("Report Own Content", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("user123", nil)
+
+		assert.ErrorIs(t, s.Report("user123", input), service.ErrCannotReportSelf)
+	})
+
+	t.Run("Report Repository Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadTargetAuthor", moderation_target_enum.Comment, MockCase.TargetID).Return("user123", nil)
+		mockRepo.On("AddReport", mock.AnythingOfType("entity.ModerationCase"), mock.AnythingOfType("entity.ModerationReport")).
+			Return(entity.ModerationCase{}, false, errors.New("Repository Failure"))
+
+		assert.EqualError(t, s.Report("user456", input), "Repository Failure")
+	})
+}
+
+func TestService_GetQueue(t *testing.T) {
+	t.Run("Get Queue Success", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadQueue", 10, 10).Return([]entity.ModerationCase{MockCase}, nil)
+
+		cases, err := s.GetQueue(10, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.ModerationCaseDTO{{
+			ID:          MockCase.ID,
+			TargetType:  MockCase.TargetType,
+			TargetID:    MockCase.TargetID,
+			AuthorID:    "user123",
+			Status:      moderation_status_enum.Pending,
+			ReportCount: 1,
+			CreatedAt:   121212,
+			UpdatedAt:   121212,
+		}}, cases)
+	})
+
+	t.Run("Get Queue Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadQueue", 10, 0).Return(nil, errors.New("Repository Failure"))
+
+		_, err := s.GetQueue(10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetCase(t *testing.T) {
+	report := entity.ModerationReport{ID: uuid.New(), CaseID: MockCase.ID, ReporterID: "user456", Reason: "Spam", CreatedAt: 121212}
+	action := entity.ModerationAction{ID: uuid.New(), CaseID: MockCase.ID, TargetType: MockCase.TargetType, TargetID: MockCase.TargetID,
+		Action: moderation_action_enum.Hide, ActorID: entity.SystemActor, CreatedAt: 131313}
+
+	t.Run("Get Case With Reports And Actions", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(MockCase, nil)
+		mockRepo.On("ReadReports", MockCase.ID).Return([]entity.ModerationReport{report}, nil)
+		mockRepo.On("ReadActions", &MockCase.ID, 50, 0).Return([]entity.ModerationAction{action}, nil)
+
+		c, err := s.GetCase(MockCase.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.ReportDTO{{ID: report.ID, ReporterID: "user456", Reason: "Spam", CreatedAt: 121212}}, c.Reports)
+		assert.Len(t, c.Actions, 1)
+		assert.Equal(t, moderation_action_enum.Hide, c.Actions[0].Action)
+	})
+
+	t.Run("Get Case Not Found", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(entity.ModerationCase{}, nil)
+
+		_, err := s.GetCase(MockCase.ID)
+
+		assert.ErrorIs(t, err, service.ErrCaseNotFound)
+	})
+
+	t.Run("Get Case Repository Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(MockCase, nil)
+		mockRepo.On("ReadReports", MockCase.ID).Return(nil, errors.New("Repository Failure"))
+
+		_, err := s.GetCase(MockCase.ID)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_TakeAction(t *testing.T) {
+	t.Run("Ban Author Of Hidden Comment", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		hidden := MockCase
+		hidden.Status = moderation_status_enum.Hidden
+		mockRepo.On("ReadCase", MockCase.ID).Return(hidden, nil)
+		mockRepo.On("Resolve",
+			mock.MatchedBy(func(c entity.ModerationCase) bool {
+				return c.Status == moderation_status_enum.Banned && c.ResolvedBy == "admin-uid" && c.ResolvedAt != nil
+			}),
+			moderation_status_enum.Hidden,
+			mock.MatchedBy(func(a entity.ModerationAction) bool {
+				return a.Action == moderation_action_enum.Ban && a.ActorID == "admin-uid" && a.Note == "Repeated spam" && a.CaseID == MockCase.ID
+			}),
+		).Return(true, nil)
+
+		c, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Ban, Note: "Repeated spam"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, moderation_status_enum.Banned, c.Status)
+		assert.Equal(t, "admin-uid", c.ResolvedBy)
+	})
+
+	t.Run("Approve Pending Comment", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(MockCase, nil)
+		mockRepo.On("Resolve", mock.AnythingOfType("entity.ModerationCase"), moderation_status_enum.Pending, mock.AnythingOfType("entity.ModerationAction")).
+			Return(true, nil)
+
+		c, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Approve})
+
+		assert.NoError(t, err)
+		assert.Equal(t, moderation_status_enum.Approved, c.Status)
+	})
+
+	t.Run("Remove Review Notifies Listeners", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		review := MockCase
+		review.TargetType = moderation_target_enum.Review
+		mockRepo.On("ReadCase", MockCase.ID).Return(review, nil)
+		mockRepo.On("Resolve", mock.AnythingOfType("entity.ModerationCase"), moderation_status_enum.Pending, mock.AnythingOfType("entity.ModerationAction")).
+			Return(true, nil)
+
+		var notified []string
+		s.OnContentModerated(func(targetType moderation_target_enum.ModerationTarget, targetID string) error {
+			notified = append(notified, string(targetType)+":"+targetID)
+			return errors.New("Listener Failure")
+		})
+
+		c, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Remove})
+
+		assert.NoError(t, err)
+		assert.Equal(t, moderation_status_enum.Removed, c.Status)
+		assert.Equal(t, []string{"review:" + MockCase.TargetID}, notified)
+	})
+
+	t.Run("Remove A User", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		user := MockCase
+		user.TargetType = moderation_target_enum.User
+		mockRepo.On("ReadCase", MockCase.ID).Return(user, nil)
+
+		_, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Remove})
+
+		assert.ErrorIs(t, err, service.ErrActionNotAllowed)
+	})
+
+	t.Run("Act On Resolved Case", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		approved := MockCase
+		approved.Status = moderation_status_enum.Approved
+		mockRepo.On("ReadCase", MockCase.ID).Return(approved, nil)
+
+		_, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Remove})
+
+		assert.ErrorIs(t, err, service.ErrCaseResolved)
+	})
+
+	t.Run("Act On Case Resolved Meanwhile", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(MockCase, nil)
+		mockRepo.On("Resolve", mock.AnythingOfType("entity.ModerationCase"), moderation_status_enum.Pending, mock.AnythingOfType("entity.ModerationAction")).
+			Return(false, nil)
+		s.OnContentModerated(func(moderation_target_enum.ModerationTarget, string) error {
+			t.Error("listener called for an action that was not taken")
+			return nil
+		})
+
+		_, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Remove})
+
+		assert.ErrorIs(t, err, service.ErrCaseResolved)
+	})
+
+	t.Run("Act On Missing Case", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(entity.ModerationCase{}, nil)
+
+		_, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Remove})
+
+		assert.ErrorIs(t, err, service.ErrCaseNotFound)
+	})
+
+	t.Run("Take Action Repository Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadCase", MockCase.ID).Return(MockCase, nil)
+		mockRepo.On("Resolve", mock.AnythingOfType("entity.ModerationCase"), moderation_status_enum.Pending, mock.AnythingOfType("entity.ModerationAction")).
+			Return(false, errors.New("Repository Failure"))
+
+		_, err := s.TakeAction(MockCase.ID, "admin-uid", dto.TakeActionDTO{Action: moderation_action_enum.Remove})
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetActions(t *testing.T) {
+	t.Run("Get Actions Success", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		action := entity.ModerationAction{ID: uuid.New(), CaseID: MockCase.ID, Action: moderation_action_enum.Remove, ActorID: "admin-uid"}
+		mockRepo.On("ReadActions", (*uuid.UUID)(nil), 10, 0).Return([]entity.ModerationAction{action}, nil)
+
+		actions, err := s.GetActions(10, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, actions, 1)
+		assert.Equal(t, "admin-uid", actions[0].ActorID)
+	})
+
+	t.Run("Get Actions Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("ReadActions", (*uuid.UUID)(nil), 10, 0).Return(nil, errors.New("Repository Failure"))
+
+		_, err := s.GetActions(10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_CheckContent(t *testing.T) {
+	t.Run("Clean Content", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("IsBanned", "user123").Return(false, nil)
+
+		assert.NoError(t, s.CheckContent("user123", "Great lesson"))
+	})
+
+	t.Run("Content With Banned Words", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("IsBanned", "user123").Return(false, nil)
+
+		assert.ErrorIs(t, s.CheckContent("user123", "Free crypto here"), service.ErrBannedWords)
+	})
+
+	t.Run("Banned User", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("IsBanned", "user123").Return(true, nil)
+
+		assert.ErrorIs(t, s.CheckContent("user123", "Great lesson"), service.ErrUserBanned)
+	})
+
+	t.Run("Check Content Repository Error", func(t *testing.T) {
+		s, mockRepo := initializeService(t)
+		mockRepo.On("IsBanned", "user123").Return(false, errors.New("Repository Failure"))
+
+		assert.EqualError(t, s.CheckContent("user123", "Great lesson"), "Repository Failure")
+	})
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	language_enum "CodeWithAzri/pkg/enums/language"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceDTO is what a user chose to receive. An empty
+// language sends every email in the language of the course it is about.
+type NotificationPreferenceDTO struct {
+	Language          language_enum.Language `json:"language" validate:"omitempty,oneof=id en"`
+	EnrollmentEmails  bool                   `json:"enrollment_emails"`
+	CertificateEmails bool                   `json:"certificate_emails"`
+	UpdatedAt         int64                  `json:"updated_at,omitempty"`
+}
+
+type NotificationDTO struct {
+	ID        uuid.UUID                               `json:"id"`
+	Kind      notification_kind_enum.NotificationKind `json:"kind"`
+	Title     string                                  `json:"title"`
+	Body      string                                  `json:"body"`
+	Data      json.RawMessage                         `json:"data" swaggertype:"object"`
+	Read      bool                                    `json:"read"`
+	ReadAt    *int64                                  `json:"read_at,omitempty"`
+	CreatedAt int64                                   `json:"created_at"`
+}
+
+type UnreadCountDTO struct {
+	Unread int `json:"unread"`
+}
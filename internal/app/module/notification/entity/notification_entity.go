@@ -0,0 +1,42 @@
+package entity
+
+import (
+	language_enum "CodeWithAzri/pkg/enums/language"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreference is saved once a user changes their settings. Users
+// without one get every email in the language of the course it is about.
+type NotificationPreference struct {
+	UserID            string                 `json:"user_id" gorm:"type:varchar(255);primaryKey"`
+	Language          language_enum.Language `json:"language" gorm:"type:varchar(2);not null;default:''"`
+	EnrollmentEmails  bool                   `json:"enrollment_emails" gorm:"not null;default:true"`
+	CertificateEmails bool                   `json:"certificate_emails" gorm:"not null;default:true"`
+	UpdatedAt         int64                  `json:"updated_at"`
+}
+
+// SentNotification records that a notification was queued, so an event that
+// is delivered twice does not email the user twice.
+type SentNotification struct {
+	ID          uuid.UUID                               `json:"id" gorm:"type:uuid;primaryKey"`
+	UserID      string                                  `json:"user_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_sent_notifications_reference,priority:1"`
+	Kind        notification_kind_enum.NotificationKind `json:"kind" gorm:"type:varchar(50);not null;uniqueIndex:idx_sent_notifications_reference,priority:2"`
+	ReferenceID string                                  `json:"reference_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_sent_notifications_reference,priority:3"`
+	CreatedAt   int64                                   `json:"created_at"`
+}
+
+// Notification is an item in the in-app inbox of a user. Data holds the IDs
+// the app needs to open what the notification is about.
+type Notification struct {
+	ID          uuid.UUID                               `json:"id" gorm:"type:uuid;primaryKey"`
+	UserID      string                                  `json:"user_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_notifications_reference,priority:1;index:idx_notifications_inbox,priority:1"`
+	Kind        notification_kind_enum.NotificationKind `json:"kind" gorm:"type:varchar(50);not null;uniqueIndex:idx_notifications_reference,priority:2"`
+	ReferenceID string                                  `json:"reference_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_notifications_reference,priority:3"`
+	Title       string                                  `json:"title" gorm:"type:varchar(255);not null"`
+	Body        string                                  `json:"body" gorm:"type:text;not null"`
+	Data        string                                  `json:"data" gorm:"type:jsonb;not null;default:'{}'"`
+	ReadAt      *int64                                  `json:"read_at"`
+	CreatedAt   int64                                   `json:"created_at" gorm:"index:idx_notifications_inbox,priority:2"`
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/service"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Handler struct {
+	service  service.NotificationService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.NotificationService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// GetNotificationPreferences godoc
+//
+//	@Summary		Get notification preferences
+//	@Tags			Notification
+//	@Description	Get which emails the signed in user receives and in which language. Users who never changed them receive every email in the language of its course.
+//	@ID				get-notification-preferences
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.NotificationPreferenceDTO}	"Successful response with the preferences"
+//	@Failure		401	{object}	response.ResponseError									"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError									"Internal server error"
+//	@Router			/api/v1/notifications/preferences [get]
+func (h *Handler) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	preference, err := h.service.GetPreference(requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Notification Preferences Fetched Successfully", "Success", preference, w)
+}
+
+// UpdateNotificationPreferences godoc
+//
+//	@Summary		Update notification preferences
+//	@Tags			Notification
+//	@Description	Choose which emails the signed in user receives. An empty language sends every email in the language of its course. Welcome emails are always sent.
+//	@ID				update-notification-preferences
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.NotificationPreferenceDTO	true	"Language and the emails to receive"
+//	@Param			Authorization	header	string							true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.NotificationPreferenceDTO}	"Successful response with the preferences"
+//	@Failure		400	{object}	response.ResponseError									"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError									"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError									"Internal server error"
+//	@Router			/api/v1/notifications/preferences [put]
+func (h *Handler) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var d dto.NotificationPreferenceDTO
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	preference, err := h.service.UpdatePreference(requestPkg.GetUserID(r), d)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Notification Preferences Updated Successfully", "Success", preference, w)
+}
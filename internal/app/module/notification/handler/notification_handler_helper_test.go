@@ -0,0 +1,24 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/handler"
+	"CodeWithAzri/internal/app/module/notification/service/mocks"
+	"CodeWithAzri/pkg/requestPkg"
+	"net/http"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-playground/validator/v10"
+)
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.NotificationService) {
+	mockService := mocks.NewNotificationService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
+
+func patchUserID(userID string) {
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return userID
+	})
+}
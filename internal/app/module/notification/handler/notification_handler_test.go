@@ -0,0 +1,86 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_GetNotificationPreferences(t *testing.T) {
+	notificationHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Preferences Successfully", func(t *testing.T) {
+		mockService.On("GetPreference", "user123").Return(dto.NotificationPreferenceDTO{EnrollmentEmails: true, CertificateEmails: true}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications/preferences", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.GetNotificationPreferences(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"enrollment_emails":true`)
+	})
+
+	t.Run("Get Preferences Error", func(t *testing.T) {
+		mockService.On("GetPreference", "user123").Return(dto.NotificationPreferenceDTO{}, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications/preferences", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.GetNotificationPreferences(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_UpdateNotificationPreferences(t *testing.T) {
+	notificationHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Update Preferences Successfully", func(t *testing.T) {
+		input := dto.NotificationPreferenceDTO{Language: "id", CertificateEmails: true}
+		updated := input
+		updated.UpdatedAt = 131313
+		mockService.On("UpdatePreference", "user123", input).Return(updated, nil).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/notifications/preferences", bytes.NewBufferString(`{"language": "id", "enrollment_emails": false, "certificate_emails": true}`))
+		recorder := httptest.NewRecorder()
+		notificationHandler.UpdateNotificationPreferences(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"language":"id"`)
+	})
+
+	t.Run("Update Preferences Invalid Language", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/api/v1/notifications/preferences", bytes.NewBufferString(`{"language": "fr"}`))
+		recorder := httptest.NewRecorder()
+		notificationHandler.UpdateNotificationPreferences(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Update Preferences Invalid Body", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/api/v1/notifications/preferences", bytes.NewBufferString(`{`))
+		recorder := httptest.NewRecorder()
+		notificationHandler.UpdateNotificationPreferences(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Update Preferences Error", func(t *testing.T) {
+		mockService.On("UpdatePreference", "user123", dto.NotificationPreferenceDTO{}).Return(dto.NotificationPreferenceDTO{}, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/notifications/preferences", bytes.NewBufferString(`{}`))
+		recorder := httptest.NewRecorder()
+		notificationHandler.UpdateNotificationPreferences(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
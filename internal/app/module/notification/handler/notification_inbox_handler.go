@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/notification/service"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// GetNotifications godoc
+//
+//	@Summary		List notifications
+//	@Tags			Notification
+//	@Description	List the inbox of the signed in user from newest to oldest. Data holds the IDs of what a notification is about, such as course_id and lesson_id.
+//	@ID				get-notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			unread			query	bool	false	"Only list unread notifications"
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.NotificationDTO}	"Successful response with notifications"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/notifications [get]
+func (h *Handler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+	unreadOnly, _ := strconv.ParseBool(requestPkg.GetQueryParam(r, "unread"))
+
+	notifications, err := h.service.GetNotifications(requestPkg.GetUserID(r), unreadOnly, limit, page)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Notifications Fetched Successfully", "Success", notifications, w)
+}
+
+// CountUnreadNotifications godoc
+//
+//	@Summary		Count unread notifications
+//	@Tags			Notification
+//	@Description	Count the unread notifications of the signed in user, for the badge on the bell icon.
+//	@ID				count-unread-notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.UnreadCountDTO}	"Successful response with the unread count"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/notifications/unread-count [get]
+func (h *Handler) CountUnreadNotifications(w http.ResponseWriter, r *http.Request) {
+	unread, err := h.service.CountUnread(requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Unread Notifications Counted Successfully", "Success", unread, w)
+}
+
+// MarkNotificationRead godoc
+//
+//	@Summary		Mark a notification as read
+//	@Tags			Notification
+//	@Description	Mark one notification of the signed in user as read. Marking it again keeps when it was first read.
+//	@ID				mark-notification-read
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Notification ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		404	{object}	response.ResponseError	"Notification not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/notifications/{id}/read [post]
+func (h *Handler) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.MarkRead(requestPkg.GetUserID(r), id)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Notification Marked As Read Successfully", "Success", nil, w)
+}
+
+// MarkAllNotificationsRead godoc
+//
+//	@Summary		Mark all notifications as read
+//	@Tags			Notification
+//	@Description	Mark every unread notification of the signed in user as read.
+//	@ID				mark-all-notifications-read
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/notifications/read-all [post]
+func (h *Handler) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	err := h.service.MarkAllRead(requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Notifications Marked As Read Successfully", "Success", nil, w)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrNotificationNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,154 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/service"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var MockNotificationDTO dto.NotificationDTO = dto.NotificationDTO{
+	ID:        uuid.MustParse("8e2a4b6c-1d3f-4a5b-9c7d-0e1f2a3b4c51"),
+	Kind:      "lesson_published",
+	Title:     "New lesson in Go for Beginners",
+	Body:      "Goroutines is now available.",
+	Data:      []byte(`{"course_id":"18a95d2f-a941-4a64-bbe5-256be7626db2"}`),
+	CreatedAt: 121212,
+}
+
+func TestHandler_GetNotifications(t *testing.T) {
+	notificationHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Notifications Successfully", func(t *testing.T) {
+		mockService.On("GetNotifications", "user123", false, 10, 1).Return([]dto.NotificationDTO{MockNotificationDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.GetNotifications(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"title":"New lesson in Go for Beginners"`)
+	})
+
+	t.Run("Get Unread Notifications Page", func(t *testing.T) {
+		mockService.On("GetNotifications", "user123", true, 5, 2).Return([]dto.NotificationDTO{}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications?unread=true&page=2&limit=5", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.GetNotifications(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Notifications Error", func(t *testing.T) {
+		mockService.On("GetNotifications", "user123", false, 10, 1).Return(nil, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.GetNotifications(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_CountUnreadNotifications(t *testing.T) {
+	notificationHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Count Unread Successfully", func(t *testing.T) {
+		mockService.On("CountUnread", "user123").Return(dto.UnreadCountDTO{Unread: 3}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications/unread-count", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.CountUnreadNotifications(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"unread":3`)
+	})
+
+	t.Run("Count Unread Error", func(t *testing.T) {
+		mockService.On("CountUnread", "user123").Return(dto.UnreadCountDTO{}, errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/notifications/unread-count", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.CountUnreadNotifications(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_MarkNotificationRead(t *testing.T) {
+	notificationHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	id := MockNotificationDTO.ID.String()
+
+	t.Run("Mark Read Successfully", func(t *testing.T) {
+		monkey.Patch(chi.URLParam, func(r *http.Request, key string) string { return id })
+		mockService.On("MarkRead", "user123", MockNotificationDTO.ID).Return(nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/notifications/"+id+"/read", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.MarkNotificationRead(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Mark Read Not Found", func(t *testing.T) {
+		monkey.Patch(chi.URLParam, func(r *http.Request, key string) string { return id })
+		mockService.On("MarkRead", "user123", MockNotificationDTO.ID).Return(service.ErrNotificationNotFound).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/notifications/"+id+"/read", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.MarkNotificationRead(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Mark Read Invalid ID", func(t *testing.T) {
+		monkey.Patch(chi.URLParam, func(r *http.Request, key string) string { return "invalid" })
+
+		req, _ := http.NewRequest("POST", "/api/v1/notifications/invalid/read", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.MarkNotificationRead(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_MarkAllNotificationsRead(t *testing.T) {
+	notificationHandler, mockService := initializeHandler(t)
+	patchUserID("user123")
+	defer monkey.UnpatchAll()
+
+	t.Run("Mark All Read Successfully", func(t *testing.T) {
+		mockService.On("MarkAllRead", "user123").Return(nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/notifications/read-all", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.MarkAllNotificationsRead(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Mark All Read Error", func(t *testing.T) {
+		mockService.On("MarkAllRead", "user123").Return(errors.New("Repository Failure")).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/notifications/read-all", nil)
+		recorder := httptest.NewRecorder()
+		notificationHandler.MarkAllNotificationsRead(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
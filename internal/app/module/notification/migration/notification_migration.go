@@ -0,0 +1,19 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type NotificationMigration struct{}
+
+func (m NotificationMigration) CreateNotificationTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.NotificationPreference{},
+		entity.SentNotification{},
+		entity.Notification{},
+	)
+}
@@ -0,0 +1,30 @@
+package notification
+
+import (
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	"CodeWithAzri/internal/app/module/notification/handler"
+	"CodeWithAzri/internal/app/module/notification/migration"
+	"CodeWithAzri/internal/app/module/notification/repository"
+	"CodeWithAzri/internal/app/module/notification/service"
+	"CodeWithAzri/pkg/mailer"
+	"database/sql"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.NotificationService
+	Repository repository.NotificationRepository
+	Migration  *migration.NotificationMigration
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate, jobs jobService.JobService, events eventService.EventService, users service.UserReader, courses service.CourseReader, sender mailer.Sender, publisher service.Publisher) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewNotificationService(m.Repository, jobs, events, users, courses, sender, publisher)
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.NotificationMigration{}
+	return m
+}
@@ -0,0 +1,694 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/notification/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+type NotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationRepository) EXPECT() *NotificationRepository_Expecter {
+	return &NotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUnread provides a mock function with given fields: userID
+func (_m *NotificationRepository) CountUnread(userID string) (int, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type NotificationRepository_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationRepository_Expecter) CountUnread(userID interface{}) *NotificationRepository_CountUnread_Call {
+	return &NotificationRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", userID)}
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Run(run func(userID string)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Return(_a0 int, _a1 error) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) RunAndReturn(run func(string) (int, error)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotifications provides a mock function with given fields: notifications
+func (_m *NotificationRepository) CreateNotifications(notifications []entity.Notification) ([]entity.Notification, error) {
+	ret := _m.Called(notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotifications")
+	}
+
+	var r0 []entity.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]entity.Notification) ([]entity.Notification, error)); ok {
+		return rf(notifications)
+	}
+	if rf, ok := ret.Get(0).(func([]entity.Notification) []entity.Notification); ok {
+		r0 = rf(notifications)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]entity.Notification) error); ok {
+		r1 = rf(notifications)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CreateNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotifications'
+type NotificationRepository_CreateNotifications_Call struct {
+	*mock.Call
+}
+
+// CreateNotifications is a helper method to define mock.On call
+//   - notifications []entity.Notification
+func (_e *NotificationRepository_Expecter) CreateNotifications(notifications interface{}) *NotificationRepository_CreateNotifications_Call {
+	return &NotificationRepository_CreateNotifications_Call{Call: _e.mock.On("CreateNotifications", notifications)}
+}
+
+func (_c *NotificationRepository_CreateNotifications_Call) Run(run func(notifications []entity.Notification)) *NotificationRepository_CreateNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CreateNotifications_Call) Return(_a0 []entity.Notification, _a1 error) *NotificationRepository_CreateNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CreateNotifications_Call) RunAndReturn(run func([]entity.Notification) ([]entity.Notification, error)) *NotificationRepository_CreateNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSent provides a mock function with given fields: id
+func (_m *NotificationRepository) DeleteSent(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_DeleteSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSent'
+type NotificationRepository_DeleteSent_Call struct {
+	*mock.Call
+}
+
+// DeleteSent is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *NotificationRepository_Expecter) DeleteSent(id interface{}) *NotificationRepository_DeleteSent_Call {
+	return &NotificationRepository_DeleteSent_Call{Call: _e.mock.On("DeleteSent", id)}
+}
+
+func (_c *NotificationRepository_DeleteSent_Call) Run(run func(id uuid.UUID)) *NotificationRepository_DeleteSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteSent_Call) Return(_a0 error) *NotificationRepository_DeleteSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_DeleteSent_Call) RunAndReturn(run func(uuid.UUID) error) *NotificationRepository_DeleteSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *NotificationRepository) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type NotificationRepository_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationRepository_Expecter) EraseUserData(userID interface{}) *NotificationRepository_EraseUserData_Call {
+	return &NotificationRepository_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *NotificationRepository_EraseUserData_Call) Run(run func(userID string)) *NotificationRepository_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_EraseUserData_Call) Return(_a0 error) *NotificationRepository_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_EraseUserData_Call) RunAndReturn(run func(string) error) *NotificationRepository_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function with given fields: userID, readAt
+func (_m *NotificationRepository) MarkAllRead(userID string, readAt int64) (int64, error) {
+	ret := _m.Called(userID, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (int64, error)); ok {
+		return rf(userID, readAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) int64); ok {
+		r0 = rf(userID, readAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(userID, readAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type NotificationRepository_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - userID string
+//   - readAt int64
+func (_e *NotificationRepository_Expecter) MarkAllRead(userID interface{}, readAt interface{}) *NotificationRepository_MarkAllRead_Call {
+	return &NotificationRepository_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", userID, readAt)}
+}
+
+func (_c *NotificationRepository_MarkAllRead_Call) Run(run func(userID string, readAt int64)) *NotificationRepository_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllRead_Call) Return(_a0 int64, _a1 error) *NotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllRead_Call) RunAndReturn(run func(string, int64) (int64, error)) *NotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: id, userID, readAt
+func (_m *NotificationRepository) MarkRead(id uuid.UUID, userID string, readAt int64) (bool, error) {
+	ret := _m.Called(id, userID, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64) (bool, error)); ok {
+		return rf(id, userID, readAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int64) bool); ok {
+		r0 = rf(id, userID, readAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int64) error); ok {
+		r1 = rf(id, userID, readAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type NotificationRepository_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - userID string
+//   - readAt int64
+func (_e *NotificationRepository_Expecter) MarkRead(id interface{}, userID interface{}, readAt interface{}) *NotificationRepository_MarkRead_Call {
+	return &NotificationRepository_MarkRead_Call{Call: _e.mock.On("MarkRead", id, userID, readAt)}
+}
+
+func (_c *NotificationRepository_MarkRead_Call) Run(run func(id uuid.UUID, userID string, readAt int64)) *NotificationRepository_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkRead_Call) Return(_a0 bool, _a1 error) *NotificationRepository_MarkRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkRead_Call) RunAndReturn(run func(uuid.UUID, string, int64) (bool, error)) *NotificationRepository_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadNotifications provides a mock function with given fields: userID, unreadOnly, limit, offset
+func (_m *NotificationRepository) ReadNotifications(userID string, unreadOnly bool, limit int, offset int) ([]entity.Notification, error) {
+	ret := _m.Called(userID, unreadOnly, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadNotifications")
+	}
+
+	var r0 []entity.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool, int, int) ([]entity.Notification, error)); ok {
+		return rf(userID, unreadOnly, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool, int, int) []entity.Notification); ok {
+		r0 = rf(userID, unreadOnly, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool, int, int) error); ok {
+		r1 = rf(userID, unreadOnly, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_ReadNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadNotifications'
+type NotificationRepository_ReadNotifications_Call struct {
+	*mock.Call
+}
+
+// ReadNotifications is a helper method to define mock.On call
+//   - userID string
+//   - unreadOnly bool
+//   - limit int
+//   - offset int
+func (_e *NotificationRepository_Expecter) ReadNotifications(userID interface{}, unreadOnly interface{}, limit interface{}, offset interface{}) *NotificationRepository_ReadNotifications_Call {
+	return &NotificationRepository_ReadNotifications_Call{Call: _e.mock.On("ReadNotifications", userID, unreadOnly, limit, offset)}
+}
+
+func (_c *NotificationRepository_ReadNotifications_Call) Run(run func(userID string, unreadOnly bool, limit int, offset int)) *NotificationRepository_ReadNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ReadNotifications_Call) Return(_a0 []entity.Notification, _a1 error) *NotificationRepository_ReadNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_ReadNotifications_Call) RunAndReturn(run func(string, bool, int, int) ([]entity.Notification, error)) *NotificationRepository_ReadNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPreference provides a mock function with given fields: userID
+func (_m *NotificationRepository) ReadPreference(userID string) (entity.NotificationPreference, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPreference")
+	}
+
+	var r0 entity.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (entity.NotificationPreference, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) entity.NotificationPreference); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(entity.NotificationPreference)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_ReadPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPreference'
+type NotificationRepository_ReadPreference_Call struct {
+	*mock.Call
+}
+
+// ReadPreference is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationRepository_Expecter) ReadPreference(userID interface{}) *NotificationRepository_ReadPreference_Call {
+	return &NotificationRepository_ReadPreference_Call{Call: _e.mock.On("ReadPreference", userID)}
+}
+
+func (_c *NotificationRepository_ReadPreference_Call) Run(run func(userID string)) *NotificationRepository_ReadPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ReadPreference_Call) Return(_a0 entity.NotificationPreference, _a1 error) *NotificationRepository_ReadPreference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_ReadPreference_Call) RunAndReturn(run func(string) (entity.NotificationPreference, error)) *NotificationRepository_ReadPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPreferences provides a mock function with given fields: userIDs
+func (_m *NotificationRepository) ReadPreferences(userIDs []string) ([]entity.NotificationPreference, error) {
+	ret := _m.Called(userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPreferences")
+	}
+
+	var r0 []entity.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]entity.NotificationPreference, error)); ok {
+		return rf(userIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []entity.NotificationPreference); ok {
+		r0 = rf(userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_ReadPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPreferences'
+type NotificationRepository_ReadPreferences_Call struct {
+	*mock.Call
+}
+
+// ReadPreferences is a helper method to define mock.On call
+//   - userIDs []string
+func (_e *NotificationRepository_Expecter) ReadPreferences(userIDs interface{}) *NotificationRepository_ReadPreferences_Call {
+	return &NotificationRepository_ReadPreferences_Call{Call: _e.mock.On("ReadPreferences", userIDs)}
+}
+
+func (_c *NotificationRepository_ReadPreferences_Call) Run(run func(userIDs []string)) *NotificationRepository_ReadPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ReadPreferences_Call) Return(_a0 []entity.NotificationPreference, _a1 error) *NotificationRepository_ReadPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_ReadPreferences_Call) RunAndReturn(run func([]string) ([]entity.NotificationPreference, error)) *NotificationRepository_ReadPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUserNotifications provides a mock function with given fields: userID
+func (_m *NotificationRepository) ReadUserNotifications(userID string) ([]entity.Notification, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUserNotifications")
+	}
+
+	var r0 []entity.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Notification, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Notification); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_ReadUserNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUserNotifications'
+type NotificationRepository_ReadUserNotifications_Call struct {
+	*mock.Call
+}
+
+// ReadUserNotifications is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationRepository_Expecter) ReadUserNotifications(userID interface{}) *NotificationRepository_ReadUserNotifications_Call {
+	return &NotificationRepository_ReadUserNotifications_Call{Call: _e.mock.On("ReadUserNotifications", userID)}
+}
+
+func (_c *NotificationRepository_ReadUserNotifications_Call) Run(run func(userID string)) *NotificationRepository_ReadUserNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_ReadUserNotifications_Call) Return(_a0 []entity.Notification, _a1 error) *NotificationRepository_ReadUserNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_ReadUserNotifications_Call) RunAndReturn(run func(string) ([]entity.Notification, error)) *NotificationRepository_ReadUserNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSent provides a mock function with given fields: sent
+func (_m *NotificationRepository) RecordSent(sent entity.SentNotification) (bool, error) {
+	ret := _m.Called(sent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.SentNotification) (bool, error)); ok {
+		return rf(sent)
+	}
+	if rf, ok := ret.Get(0).(func(entity.SentNotification) bool); ok {
+		r0 = rf(sent)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.SentNotification) error); ok {
+		r1 = rf(sent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_RecordSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSent'
+type NotificationRepository_RecordSent_Call struct {
+	*mock.Call
+}
+
+// RecordSent is a helper method to define mock.On call
+//   - sent entity.SentNotification
+func (_e *NotificationRepository_Expecter) RecordSent(sent interface{}) *NotificationRepository_RecordSent_Call {
+	return &NotificationRepository_RecordSent_Call{Call: _e.mock.On("RecordSent", sent)}
+}
+
+func (_c *NotificationRepository_RecordSent_Call) Run(run func(sent entity.SentNotification)) *NotificationRepository_RecordSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.SentNotification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_RecordSent_Call) Return(_a0 bool, _a1 error) *NotificationRepository_RecordSent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_RecordSent_Call) RunAndReturn(run func(entity.SentNotification) (bool, error)) *NotificationRepository_RecordSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertPreference provides a mock function with given fields: preference
+func (_m *NotificationRepository) UpsertPreference(preference entity.NotificationPreference) error {
+	ret := _m.Called(preference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPreference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.NotificationPreference) error); ok {
+		r0 = rf(preference)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_UpsertPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPreference'
+type NotificationRepository_UpsertPreference_Call struct {
+	*mock.Call
+}
+
+// UpsertPreference is a helper method to define mock.On call
+//   - preference entity.NotificationPreference
+func (_e *NotificationRepository_Expecter) UpsertPreference(preference interface{}) *NotificationRepository_UpsertPreference_Call {
+	return &NotificationRepository_UpsertPreference_Call{Call: _e.mock.On("UpsertPreference", preference)}
+}
+
+func (_c *NotificationRepository_UpsertPreference_Call) Run(run func(preference entity.NotificationPreference)) *NotificationRepository_UpsertPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.NotificationPreference))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_UpsertPreference_Call) Return(_a0 error) *NotificationRepository_UpsertPreference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_UpsertPreference_Call) RunAndReturn(run func(entity.NotificationPreference) error) *NotificationRepository_UpsertPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const notificationColumns = `id, user_id, kind, reference_id, title, body, data, read_at, created_at`
+
+// CreateNotifications adds notifications to inboxes and returns the ones
+// that were new. A notification about something a user was already told
+// about is skipped, so a redelivered event does not show up twice.
+func (r *Repository) CreateNotifications(notifications []entity.Notification) ([]entity.Notification, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		INSERT INTO notifications (` + notificationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id, kind, reference_id) DO NOTHING
+	`
+
+	created := make([]entity.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		var result sql.Result
+		result, err = tx.Exec(query, notification.ID, notification.UserID, notification.Kind, notification.ReferenceID,
+			notification.Title, notification.Body, notification.Data, notification.ReadAt, notification.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create notification: %v", err)
+		}
+
+		var inserted int64
+		inserted, err = result.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create notification: %v", err)
+		}
+		if inserted == 1 {
+			created = append(created, notification)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return created, nil
+}
+
+// ReadNotifications lists the inbox of userID from newest to oldest.
+func (r *Repository) ReadNotifications(userID string, unreadOnly bool, limit int, offset int) ([]entity.Notification, error) {
+	query := `
+		SELECT ` + notificationColumns + ` FROM notifications
+		WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)
+		ORDER BY created_at DESC LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(query, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notifications: %v", err)
+	}
+
+	return scanNotifications(rows)
+}
+
+func (r *Repository) CountUnread(userID string) (int, error) {
+	query := "SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL"
+
+	var unread int
+	err := r.db.QueryRow(query, userID).Scan(&unread)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %v", err)
+	}
+
+	return unread, nil
+}
+
+// MarkRead reports false when userID has no such notification. Reading a
+// notification again keeps when it was first read.
+func (r *Repository) MarkRead(id uuid.UUID, userID string, readAt int64) (bool, error) {
+	query := "UPDATE notifications SET read_at = COALESCE(read_at, $1) WHERE id = $2 AND user_id = $3"
+
+	result, err := r.db.Exec(query, readAt, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark notification as read: %v", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark notification as read: %v", err)
+	}
+
+	return updated == 1, nil
+}
+
+func (r *Repository) MarkAllRead(userID string, readAt int64) (int64, error) {
+	query := "UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL"
+
+	result, err := r.db.Exec(query, readAt, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications as read: %v", err)
+	}
+
+	return result.RowsAffected()
+}
+
+func scanNotifications(rows *sql.Rows) ([]entity.Notification, error) {
+	defer rows.Close()
+
+	notifications := make([]entity.Notification, 0)
+	for rows.Next() {
+		var notification entity.Notification
+		err := rows.Scan(
+			&notification.ID, &notification.UserID, &notification.Kind, &notification.ReferenceID, &notification.Title,
+			&notification.Body, &notification.Data, &notification.ReadAt, &notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %v", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
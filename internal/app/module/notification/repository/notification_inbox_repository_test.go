@@ -0,0 +1,175 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_CreateNotifications(t *testing.T) {
+	other := MockNotification
+	other.ID = uuid.MustParse("8e2a4b6c-1d3f-4a5b-9c7d-0e1f2a3b4c52")
+	other.UserID = "user456"
+
+	expectCreate := func(mock sqlmock.Sqlmock, n entity.Notification) *sqlmock.ExpectedExec {
+		return mock.ExpectExec(createNotificationQuery).
+			WithArgs(n.ID, n.UserID, n.Kind, n.ReferenceID, n.Title, n.Body, n.Data, n.ReadAt, n.CreatedAt)
+	}
+
+	t.Run("Create Notifications Skips Existing Ones", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock, MockNotification).WillReturnResult(sqlmock.NewResult(0, 0))
+		expectCreate(mock, other).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := repo.CreateNotifications([]entity.Notification{MockNotification, other})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Notification{other}, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Notifications Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock, MockNotification).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateNotifications([]entity.Notification{MockNotification})
+
+		assert.EqualError(t, err, "failed to create notification: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Notifications Commit Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectCreate(mock, MockNotification).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit().WillReturnError(errors.New("commit failed"))
+
+		_, err := repo.CreateNotifications([]entity.Notification{MockNotification})
+
+		assert.EqualError(t, err, "failed to commit transaction: commit failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReadNotifications(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Notifications Success", func(t *testing.T) {
+		readAt := int64(131313)
+		read := MockNotification
+		read.ReadAt = &readAt
+		mock.ExpectQuery(readNotificationsQuery).WithArgs("user123", false, 10, 20).
+			WillReturnRows(prepareNotificationRows(MockNotification, read))
+
+		notifications, err := repo.ReadNotifications("user123", false, 10, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Notification{MockNotification, read}, notifications)
+	})
+
+	t.Run("Read Notifications Error", func(t *testing.T) {
+		mock.ExpectQuery(readNotificationsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadNotifications("user123", true, 10, 0)
+
+		assert.EqualError(t, err, "failed to read notifications: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountUnread(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Count Unread Success", func(t *testing.T) {
+		mock.ExpectQuery(countUnreadQuery).WithArgs("user123").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		unread, err := repo.CountUnread("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, unread)
+	})
+
+	t.Run("Count Unread Error", func(t *testing.T) {
+		mock.ExpectQuery(countUnreadQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.CountUnread("user123")
+
+		assert.EqualError(t, err, "failed to count unread notifications: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkRead(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Mark Read Success", func(t *testing.T) {
+		mock.ExpectExec(markReadQuery).WithArgs(int64(131313), MockNotification.ID, "user123").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		found, err := repo.MarkRead(MockNotification.ID, "user123", 131313)
+
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("Mark Read Not Found", func(t *testing.T) {
+		mock.ExpectExec(markReadQuery).WithArgs(int64(131313), MockNotification.ID, "user456").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		found, err := repo.MarkRead(MockNotification.ID, "user456", 131313)
+
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("Mark Read Error", func(t *testing.T) {
+		mock.ExpectExec(markReadQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.MarkRead(MockNotification.ID, "user123", 131313)
+
+		assert.EqualError(t, err, "failed to mark notification as read: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkAllRead(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Mark All Read Success", func(t *testing.T) {
+		mock.ExpectExec(markAllReadQuery).WithArgs(int64(131313), "user123").WillReturnResult(sqlmock.NewResult(0, 4))
+
+		marked, err := repo.MarkAllRead("user123", 131313)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), marked)
+	})
+
+	t.Run("Mark All Read Error", func(t *testing.T) {
+		mock.ExpectExec(markAllReadQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.MarkAllRead("user123", 131313)
+
+		assert.EqualError(t, err, "failed to mark notifications as read: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"fmt"
+)
+
+// ReadUserNotifications returns the whole inbox of userID, oldest first.
+func (r *Repository) ReadUserNotifications(userID string) ([]entity.Notification, error) {
+	query := "SELECT " + notificationColumns + " FROM notifications WHERE user_id = $1 ORDER BY created_at"
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notifications: %v", err)
+	}
+
+	return scanNotifications(rows)
+}
+
+// EraseUserData deletes the inbox, the sent email records and the saved
+// preference of userID.
+func (r *Repository) EraseUserData(userID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM notifications WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to erase notifications: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM sent_notifications WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to erase sent notifications: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM notification_preferences WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to erase notification preference: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
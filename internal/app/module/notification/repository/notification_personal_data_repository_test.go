@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	readUserNotificationsQuery = "SELECT id, user_id, kind, reference_id, title, body, data, read_at, created_at FROM notifications WHERE user_id = $1 ORDER BY created_at"
+	eraseNotificationsQuery    = "DELETE FROM notifications WHERE user_id = $1"
+	eraseSentQuery             = "DELETE FROM sent_notifications WHERE user_id = $1"
+	erasePreferenceQuery       = "DELETE FROM notification_preferences WHERE user_id = $1"
+)
+
+func TestRepository_ReadUserNotifications(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read User Notifications Success", func(t *testing.T) {
+		mock.ExpectQuery(readUserNotificationsQuery).WithArgs("user123").WillReturnRows(prepareNotificationRows(MockNotification))
+
+		notifications, err := repo.ReadUserNotifications("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Notification{MockNotification}, notifications)
+	})
+
+	t.Run("Read User Notifications Error", func(t *testing.T) {
+		mock.ExpectQuery(readUserNotificationsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadUserNotifications("user123")
+
+		assert.EqualError(t, err, "failed to read notifications: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_EraseUserData(t *testing.T) {
+	t.Run("Erase User Data Success", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseNotificationsQuery).WithArgs("user123").WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(eraseSentQuery).WithArgs("user123").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(erasePreferenceQuery).WithArgs("user123").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.EraseUserData("user123")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Erase User Data Error", func(t *testing.T) {
+		db, mock, repo := initializeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(eraseNotificationsQuery).WithArgs("user123").WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(eraseSentQuery).WithArgs("user123").WillReturnError(errors.New("exec failed"))
+		mock.ExpectRollback()
+
+		err := repo.EraseUserData("user123")
+
+		assert.EqualError(t, err, "failed to erase sent notifications: exec failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
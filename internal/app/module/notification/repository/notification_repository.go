@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type NotificationRepository interface {
+	ReadPreference(userID string) (entity.NotificationPreference, error)
+	UpsertPreference(preference entity.NotificationPreference) error
+	ReadPreferences(userIDs []string) ([]entity.NotificationPreference, error)
+	RecordSent(sent entity.SentNotification) (bool, error)
+	DeleteSent(id uuid.UUID) error
+	CreateNotifications(notifications []entity.Notification) ([]entity.Notification, error)
+	ReadNotifications(userID string, unreadOnly bool, limit int, offset int) ([]entity.Notification, error)
+	CountUnread(userID string) (int, error)
+	MarkRead(id uuid.UUID, userID string, readAt int64) (bool, error)
+	MarkAllRead(userID string, readAt int64) (int64, error)
+	ReadUserNotifications(userID string) ([]entity.Notification, error)
+	EraseUserData(userID string) error
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) NotificationRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+// ReadPreference returns a zero value when the user never saved one.
+func (r *Repository) ReadPreference(userID string) (entity.NotificationPreference, error) {
+	query := `
+		SELECT user_id, language, enrollment_emails, certificate_emails, updated_at
+		FROM notification_preferences WHERE user_id = $1
+	`
+
+	var preference entity.NotificationPreference
+	err := r.db.QueryRow(query, userID).Scan(
+		&preference.UserID, &preference.Language, &preference.EnrollmentEmails, &preference.CertificateEmails, &preference.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return entity.NotificationPreference{}, nil
+	}
+	if err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("failed to read notification preference: %v", err)
+	}
+
+	return preference, nil
+}
+
+func (r *Repository) UpsertPreference(preference entity.NotificationPreference) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, language, enrollment_emails, certificate_emails, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET
+			language = EXCLUDED.language,
+			enrollment_emails = EXCLUDED.enrollment_emails,
+			certificate_emails = EXCLUDED.certificate_emails,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(query, preference.UserID, preference.Language, preference.EnrollmentEmails,
+		preference.CertificateEmails, preference.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save notification preference: %v", err)
+	}
+
+	return nil
+}
+
+// ReadPreferences returns the preferences the given users saved. Users
+// without one are left out.
+func (r *Repository) ReadPreferences(userIDs []string) ([]entity.NotificationPreference, error) {
+	query := `
+		SELECT user_id, language, enrollment_emails, certificate_emails, updated_at
+		FROM notification_preferences WHERE user_id = ANY($1)
+	`
+
+	rows, err := r.db.Query(query, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification preferences: %v", err)
+	}
+	defer rows.Close()
+
+	preferences := make([]entity.NotificationPreference, 0)
+	for rows.Next() {
+		var preference entity.NotificationPreference
+		err = rows.Scan(
+			&preference.UserID, &preference.Language, &preference.EnrollmentEmails, &preference.CertificateEmails, &preference.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification preference: %v", err)
+		}
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, nil
+}
+
+// RecordSent reports false when the same notification was recorded before.
+func (r *Repository) RecordSent(sent entity.SentNotification) (bool, error) {
+	query := `
+		INSERT INTO sent_notifications (id, user_id, kind, reference_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, kind, reference_id) DO NOTHING
+	`
+
+	result, err := r.db.Exec(query, sent.ID, sent.UserID, sent.Kind, sent.ReferenceID, sent.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to record sent notification: %v", err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to record sent notification: %v", err)
+	}
+
+	return inserted == 1, nil
+}
+
+func (r *Repository) DeleteSent(id uuid.UUID) error {
+	_, err := r.db.Exec("DELETE FROM sent_notifications WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete sent notification: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"CodeWithAzri/internal/app/module/notification/repository"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+const (
+	readPreferenceQuery     = "SELECT user_id, language, enrollment_emails, certificate_emails, updated_at FROM notification_preferences WHERE user_id = $1"
+	upsertPreferenceQuery   = "INSERT INTO notification_preferences (user_id, language, enrollment_emails, certificate_emails, updated_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (user_id) DO UPDATE SET language = EXCLUDED.language, enrollment_emails = EXCLUDED.enrollment_emails, certificate_emails = EXCLUDED.certificate_emails, updated_at = EXCLUDED.updated_at"
+	recordSentQuery         = "INSERT INTO sent_notifications (id, user_id, kind, reference_id, created_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (user_id, kind, reference_id) DO NOTHING"
+	deleteSentQuery         = "DELETE FROM sent_notifications WHERE id = $1"
+	readPreferencesQuery    = "SELECT user_id, language, enrollment_emails, certificate_emails, updated_at FROM notification_preferences WHERE user_id = ANY($1)"
+	createNotificationQuery = "INSERT INTO notifications (id, user_id, kind, reference_id, title, body, data, read_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (user_id, kind, reference_id) DO NOTHING"
+	readNotificationsQuery  = "SELECT id, user_id, kind, reference_id, title, body, data, read_at, created_at FROM notifications WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL) ORDER BY created_at DESC LIMIT $3 OFFSET $4"
+	countUnreadQuery        = "SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL"
+	markReadQuery           = "UPDATE notifications SET read_at = COALESCE(read_at, $1) WHERE id = $2 AND user_id = $3"
+	markAllReadQuery        = "UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL"
+)
+
+var MockPreference entity.NotificationPreference = entity.NotificationPreference{
+	UserID:            "user123",
+	Language:          "id",
+	EnrollmentEmails:  true,
+	CertificateEmails: false,
+	UpdatedAt:         121212,
+}
+
+var MockSent entity.SentNotification = entity.SentNotification{
+	ID:          uuid.MustParse("3b6f1c2a-9d4e-4f8a-b7c1-2e5d8a9f0c11"),
+	UserID:      "user123",
+	Kind:        "enrollment",
+	ReferenceID: "18a95d2f-a941-4a64-bbe5-256be7626db2",
+	CreatedAt:   121212,
+}
+
+var MockNotification entity.Notification = entity.Notification{
+	ID:          uuid.MustParse("8e2a4b6c-1d3f-4a5b-9c7d-0e1f2a3b4c51"),
+	UserID:      "user123",
+	Kind:        "lesson_published",
+	ReferenceID: "d60619ae-cee9-4877-8f5d-8b294fe9cd80",
+	Title:       "New lesson in Go for Beginners",
+	Body:        "Goroutines is now available.",
+	Data:        `{"course_id":"18a95d2f-a941-4a64-bbe5-256be7626db2"}`,
+	CreatedAt:   121212,
+}
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.NotificationRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func preparePreferenceRows(preference entity.NotificationPreference) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"user_id", "language", "enrollment_emails", "certificate_emails", "updated_at"}).
+		AddRow(preference.UserID, preference.Language, preference.EnrollmentEmails, preference.CertificateEmails, preference.UpdatedAt)
+}
+
+func prepareNotificationRows(notifications ...entity.Notification) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "user_id", "kind", "reference_id", "title", "body", "data", "read_at", "created_at"})
+	for _, n := range notifications {
+		rows.AddRow(n.ID, n.UserID, n.Kind, n.ReferenceID, n.Title, n.Body, n.Data, n.ReadAt, n.CreatedAt)
+	}
+	return rows
+}
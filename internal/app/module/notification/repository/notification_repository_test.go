@@ -0,0 +1,148 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ReadPreference(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Preference Success", func(t *testing.T) {
+		mock.ExpectQuery(readPreferenceQuery).WithArgs(MockPreference.UserID).WillReturnRows(preparePreferenceRows(MockPreference))
+
+		preference, err := repo.ReadPreference(MockPreference.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockPreference, preference)
+	})
+
+	t.Run("Read Preference Not Saved", func(t *testing.T) {
+		mock.ExpectQuery(readPreferenceQuery).WithArgs(MockPreference.UserID).WillReturnError(sql.ErrNoRows)
+
+		preference, err := repo.ReadPreference(MockPreference.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.NotificationPreference{}, preference)
+	})
+
+	t.Run("Read Preference Error", func(t *testing.T) {
+		mock.ExpectQuery(readPreferenceQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadPreference(MockPreference.UserID)
+
+		assert.EqualError(t, err, "failed to read notification preference: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpsertPreference(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Upsert Preference Success", func(t *testing.T) {
+		mock.ExpectExec(upsertPreferenceQuery).
+			WithArgs(MockPreference.UserID, MockPreference.Language, MockPreference.EnrollmentEmails,
+				MockPreference.CertificateEmails, MockPreference.UpdatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpsertPreference(MockPreference))
+	})
+
+	t.Run("Upsert Preference Error", func(t *testing.T) {
+		mock.ExpectExec(upsertPreferenceQuery).WillReturnError(errors.New("insert failed"))
+
+		assert.EqualError(t, repo.UpsertPreference(MockPreference), "failed to save notification preference: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadPreferences(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Preferences Success", func(t *testing.T) {
+		mock.ExpectQuery(readPreferencesQuery).WithArgs(pq.Array([]string{"user123", "user456"})).
+			WillReturnRows(preparePreferenceRows(MockPreference))
+
+		preferences, err := repo.ReadPreferences([]string{"user123", "user456"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.NotificationPreference{MockPreference}, preferences)
+	})
+
+	t.Run("Read Preferences Error", func(t *testing.T) {
+		mock.ExpectQuery(readPreferencesQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadPreferences([]string{"user123"})
+
+		assert.EqualError(t, err, "failed to read notification preferences: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RecordSent(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Record Sent First Time", func(t *testing.T) {
+		mock.ExpectExec(recordSentQuery).
+			WithArgs(MockSent.ID, MockSent.UserID, MockSent.Kind, MockSent.ReferenceID, MockSent.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		inserted, err := repo.RecordSent(MockSent)
+
+		assert.NoError(t, err)
+		assert.True(t, inserted)
+	})
+
+	t.Run("Record Sent Again", func(t *testing.T) {
+		mock.ExpectExec(recordSentQuery).
+			WithArgs(MockSent.ID, MockSent.UserID, MockSent.Kind, MockSent.ReferenceID, MockSent.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		inserted, err := repo.RecordSent(MockSent)
+
+		assert.NoError(t, err)
+		assert.False(t, inserted)
+	})
+
+	t.Run("Record Sent Error", func(t *testing.T) {
+		mock.ExpectExec(recordSentQuery).WillReturnError(errors.New("insert failed"))
+
+		_, err := repo.RecordSent(MockSent)
+
+		assert.EqualError(t, err, "failed to record sent notification: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteSent(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Delete Sent Success", func(t *testing.T) {
+		mock.ExpectExec(deleteSentQuery).WithArgs(MockSent.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.DeleteSent(MockSent.ID))
+	})
+
+	t.Run("Delete Sent Error", func(t *testing.T) {
+		mock.ExpectExec(deleteSentQuery).WillReturnError(errors.New("delete failed"))
+
+		assert.EqualError(t, repo.DeleteSent(MockSent.ID), "failed to delete sent notification: delete failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
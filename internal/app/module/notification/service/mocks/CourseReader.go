@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/course/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// CourseReader is an autogenerated mock type for the CourseReader type
+type CourseReader struct {
+	mock.Mock
+}
+
+type CourseReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CourseReader) EXPECT() *CourseReader_Expecter {
+	return &CourseReader_Expecter{mock: &_m.Mock}
+}
+
+// GetCourseProgress provides a mock function with given fields: courseID, userID
+func (_m *CourseReader) GetCourseProgress(courseID uuid.UUID, userID string) (dto.CourseProgressDTO, error) {
+	ret := _m.Called(courseID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCourseProgress")
+	}
+
+	var r0 dto.CourseProgressDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.CourseProgressDTO, error)); ok {
+		return rf(courseID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.CourseProgressDTO); ok {
+		r0 = rf(courseID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.CourseProgressDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(courseID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseReader_GetCourseProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCourseProgress'
+type CourseReader_GetCourseProgress_Call struct {
+	*mock.Call
+}
+
+// GetCourseProgress is a helper method to define mock.On call
+//   - courseID uuid.UUID
+//   - userID string
+func (_e *CourseReader_Expecter) GetCourseProgress(courseID interface{}, userID interface{}) *CourseReader_GetCourseProgress_Call {
+	return &CourseReader_GetCourseProgress_Call{Call: _e.mock.On("GetCourseProgress", courseID, userID)}
+}
+
+func (_c *CourseReader_GetCourseProgress_Call) Run(run func(courseID uuid.UUID, userID string)) *CourseReader_GetCourseProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CourseReader_GetCourseProgress_Call) Return(_a0 dto.CourseProgressDTO, _a1 error) *CourseReader_GetCourseProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseReader_GetCourseProgress_Call) RunAndReturn(run func(uuid.UUID, string) (dto.CourseProgressDTO, error)) *CourseReader_GetCourseProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnrolledUserIDs provides a mock function with given fields: courseID
+func (_m *CourseReader) GetEnrolledUserIDs(courseID uuid.UUID) ([]string, error) {
+	ret := _m.Called(courseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnrolledUserIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]string, error)); ok {
+		return rf(courseID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []string); ok {
+		r0 = rf(courseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(courseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseReader_GetEnrolledUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrolledUserIDs'
+type CourseReader_GetEnrolledUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetEnrolledUserIDs is a helper method to define mock.On call
+//   - courseID uuid.UUID
+func (_e *CourseReader_Expecter) GetEnrolledUserIDs(courseID interface{}) *CourseReader_GetEnrolledUserIDs_Call {
+	return &CourseReader_GetEnrolledUserIDs_Call{Call: _e.mock.On("GetEnrolledUserIDs", courseID)}
+}
+
+func (_c *CourseReader_GetEnrolledUserIDs_Call) Run(run func(courseID uuid.UUID)) *CourseReader_GetEnrolledUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *CourseReader_GetEnrolledUserIDs_Call) Return(_a0 []string, _a1 error) *CourseReader_GetEnrolledUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseReader_GetEnrolledUserIDs_Call) RunAndReturn(run func(uuid.UUID) ([]string, error)) *CourseReader_GetEnrolledUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCourseReader creates a new instance of CourseReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCourseReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CourseReader {
+	mock := &CourseReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
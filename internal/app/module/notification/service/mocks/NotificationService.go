@@ -0,0 +1,465 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/notification/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// NotificationService is an autogenerated mock type for the NotificationService type
+type NotificationService struct {
+	mock.Mock
+}
+
+type NotificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationService) EXPECT() *NotificationService_Expecter {
+	return &NotificationService_Expecter{mock: &_m.Mock}
+}
+
+// CountUnread provides a mock function with given fields: userID
+func (_m *NotificationService) CountUnread(userID string) (dto.UnreadCountDTO, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 dto.UnreadCountDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (dto.UnreadCountDTO, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.UnreadCountDTO); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(dto.UnreadCountDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type NotificationService_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationService_Expecter) CountUnread(userID interface{}) *NotificationService_CountUnread_Call {
+	return &NotificationService_CountUnread_Call{Call: _e.mock.On("CountUnread", userID)}
+}
+
+func (_c *NotificationService_CountUnread_Call) Run(run func(userID string)) *NotificationService_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_CountUnread_Call) Return(_a0 dto.UnreadCountDTO, _a1 error) *NotificationService_CountUnread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_CountUnread_Call) RunAndReturn(run func(string) (dto.UnreadCountDTO, error)) *NotificationService_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *NotificationService) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type NotificationService_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationService_Expecter) EraseUserData(userID interface{}) *NotificationService_EraseUserData_Call {
+	return &NotificationService_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *NotificationService_EraseUserData_Call) Run(run func(userID string)) *NotificationService_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_EraseUserData_Call) Return(_a0 error) *NotificationService_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_EraseUserData_Call) RunAndReturn(run func(string) error) *NotificationService_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: userID
+func (_m *NotificationService) ExportUserData(userID string) (map[string]any, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type NotificationService_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationService_Expecter) ExportUserData(userID interface{}) *NotificationService_ExportUserData_Call {
+	return &NotificationService_ExportUserData_Call{Call: _e.mock.On("ExportUserData", userID)}
+}
+
+func (_c *NotificationService_ExportUserData_Call) Run(run func(userID string)) *NotificationService_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_ExportUserData_Call) Return(_a0 map[string]any, _a1 error) *NotificationService_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_ExportUserData_Call) RunAndReturn(run func(string) (map[string]any, error)) *NotificationService_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotifications provides a mock function with given fields: userID, unreadOnly, limit, page
+func (_m *NotificationService) GetNotifications(userID string, unreadOnly bool, limit int, page int) ([]dto.NotificationDTO, error) {
+	ret := _m.Called(userID, unreadOnly, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotifications")
+	}
+
+	var r0 []dto.NotificationDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool, int, int) ([]dto.NotificationDTO, error)); ok {
+		return rf(userID, unreadOnly, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool, int, int) []dto.NotificationDTO); ok {
+		r0 = rf(userID, unreadOnly, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.NotificationDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool, int, int) error); ok {
+		r1 = rf(userID, unreadOnly, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_GetNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotifications'
+type NotificationService_GetNotifications_Call struct {
+	*mock.Call
+}
+
+// GetNotifications is a helper method to define mock.On call
+//   - userID string
+//   - unreadOnly bool
+//   - limit int
+//   - page int
+func (_e *NotificationService_Expecter) GetNotifications(userID interface{}, unreadOnly interface{}, limit interface{}, page interface{}) *NotificationService_GetNotifications_Call {
+	return &NotificationService_GetNotifications_Call{Call: _e.mock.On("GetNotifications", userID, unreadOnly, limit, page)}
+}
+
+func (_c *NotificationService_GetNotifications_Call) Run(run func(userID string, unreadOnly bool, limit int, page int)) *NotificationService_GetNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationService_GetNotifications_Call) Return(_a0 []dto.NotificationDTO, _a1 error) *NotificationService_GetNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_GetNotifications_Call) RunAndReturn(run func(string, bool, int, int) ([]dto.NotificationDTO, error)) *NotificationService_GetNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPreference provides a mock function with given fields: userID
+func (_m *NotificationService) GetPreference(userID string) (dto.NotificationPreferenceDTO, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreference")
+	}
+
+	var r0 dto.NotificationPreferenceDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (dto.NotificationPreferenceDTO, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.NotificationPreferenceDTO); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(dto.NotificationPreferenceDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_GetPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreference'
+type NotificationService_GetPreference_Call struct {
+	*mock.Call
+}
+
+// GetPreference is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationService_Expecter) GetPreference(userID interface{}) *NotificationService_GetPreference_Call {
+	return &NotificationService_GetPreference_Call{Call: _e.mock.On("GetPreference", userID)}
+}
+
+func (_c *NotificationService_GetPreference_Call) Run(run func(userID string)) *NotificationService_GetPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_GetPreference_Call) Return(_a0 dto.NotificationPreferenceDTO, _a1 error) *NotificationService_GetPreference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_GetPreference_Call) RunAndReturn(run func(string) (dto.NotificationPreferenceDTO, error)) *NotificationService_GetPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function with given fields: userID
+func (_m *NotificationService) MarkAllRead(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type NotificationService_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - userID string
+func (_e *NotificationService_Expecter) MarkAllRead(userID interface{}) *NotificationService_MarkAllRead_Call {
+	return &NotificationService_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", userID)}
+}
+
+func (_c *NotificationService_MarkAllRead_Call) Run(run func(userID string)) *NotificationService_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_MarkAllRead_Call) Return(_a0 error) *NotificationService_MarkAllRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_MarkAllRead_Call) RunAndReturn(run func(string) error) *NotificationService_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: userID, id
+func (_m *NotificationService) MarkRead(userID string, id uuid.UUID) error {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uuid.UUID) error); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type NotificationService_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - userID string
+//   - id uuid.UUID
+func (_e *NotificationService_Expecter) MarkRead(userID interface{}, id interface{}) *NotificationService_MarkRead_Call {
+	return &NotificationService_MarkRead_Call{Call: _e.mock.On("MarkRead", userID, id)}
+}
+
+func (_c *NotificationService_MarkRead_Call) Run(run func(userID string, id uuid.UUID)) *NotificationService_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *NotificationService_MarkRead_Call) Return(_a0 error) *NotificationService_MarkRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_MarkRead_Call) RunAndReturn(run func(string, uuid.UUID) error) *NotificationService_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreference provides a mock function with given fields: userID, input
+func (_m *NotificationService) UpdatePreference(userID string, input dto.NotificationPreferenceDTO) (dto.NotificationPreferenceDTO, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreference")
+	}
+
+	var r0 dto.NotificationPreferenceDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.NotificationPreferenceDTO) (dto.NotificationPreferenceDTO, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.NotificationPreferenceDTO) dto.NotificationPreferenceDTO); ok {
+		r0 = rf(userID, input)
+	} else {
+		r0 = ret.Get(0).(dto.NotificationPreferenceDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.NotificationPreferenceDTO) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_UpdatePreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreference'
+type NotificationService_UpdatePreference_Call struct {
+	*mock.Call
+}
+
+// UpdatePreference is a helper method to define mock.On call
+//   - userID string
+//   - input dto.NotificationPreferenceDTO
+func (_e *NotificationService_Expecter) UpdatePreference(userID interface{}, input interface{}) *NotificationService_UpdatePreference_Call {
+	return &NotificationService_UpdatePreference_Call{Call: _e.mock.On("UpdatePreference", userID, input)}
+}
+
+func (_c *NotificationService_UpdatePreference_Call) Run(run func(userID string, input dto.NotificationPreferenceDTO)) *NotificationService_UpdatePreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.NotificationPreferenceDTO))
+	})
+	return _c
+}
+
+func (_c *NotificationService_UpdatePreference_Call) Return(_a0 dto.NotificationPreferenceDTO, _a1 error) *NotificationService_UpdatePreference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_UpdatePreference_Call) RunAndReturn(run func(string, dto.NotificationPreferenceDTO) (dto.NotificationPreferenceDTO, error)) *NotificationService_UpdatePreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationService {
+	mock := &NotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Publisher is an autogenerated mock type for the Publisher type
+type Publisher struct {
+	mock.Mock
+}
+
+type Publisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Publisher) EXPECT() *Publisher_Expecter {
+	return &Publisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishToUser provides a mock function with given fields: userID, messageType, data
+func (_m *Publisher) PublishToUser(userID string, messageType message_type_enum.MessageType, data any) error {
+	ret := _m.Called(userID, messageType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishToUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, message_type_enum.MessageType, any) error); ok {
+		r0 = rf(userID, messageType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Publisher_PublishToUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishToUser'
+type Publisher_PublishToUser_Call struct {
+	*mock.Call
+}
+
+// PublishToUser is a helper method to define mock.On call
+//   - userID string
+//   - messageType message_type_enum.MessageType
+//   - data any
+func (_e *Publisher_Expecter) PublishToUser(userID interface{}, messageType interface{}, data interface{}) *Publisher_PublishToUser_Call {
+	return &Publisher_PublishToUser_Call{Call: _e.mock.On("PublishToUser", userID, messageType, data)}
+}
+
+func (_c *Publisher_PublishToUser_Call) Run(run func(userID string, messageType message_type_enum.MessageType, data any)) *Publisher_PublishToUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(message_type_enum.MessageType), args[2].(any))
+	})
+	return _c
+}
+
+func (_c *Publisher_PublishToUser_Call) Return(_a0 error) *Publisher_PublishToUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Publisher_PublishToUser_Call) RunAndReturn(run func(string, message_type_enum.MessageType, any) error) *Publisher_PublishToUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublisher creates a new instance of Publisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Publisher {
+	mock := &Publisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/user/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserReader is an autogenerated mock type for the UserReader type
+type UserReader struct {
+	mock.Mock
+}
+
+type UserReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserReader) EXPECT() *UserReader_Expecter {
+	return &UserReader_Expecter{mock: &_m.Mock}
+}
+
+// GetUser provides a mock function with given fields: ID
+func (_m *UserReader) GetUser(ID string) (dto.UserDTO, error) {
+	ret := _m.Called(ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 dto.UserDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (dto.UserDTO, error)); ok {
+		return rf(ID)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.UserDTO); ok {
+		r0 = rf(ID)
+	} else {
+		r0 = ret.Get(0).(dto.UserDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserReader_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type UserReader_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ID string
+func (_e *UserReader_Expecter) GetUser(ID interface{}) *UserReader_GetUser_Call {
+	return &UserReader_GetUser_Call{Call: _e.mock.On("GetUser", ID)}
+}
+
+func (_c *UserReader_GetUser_Call) Run(run func(ID string)) *UserReader_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserReader_GetUser_Call) Return(_a0 dto.UserDTO, _a1 error) *UserReader_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserReader_GetUser_Call) RunAndReturn(run func(string) (dto.UserDTO, error)) *UserReader_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserReader creates a new instance of UserReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserReader {
+	mock := &UserReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
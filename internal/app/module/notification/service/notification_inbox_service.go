@@ -0,0 +1,178 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/entity"
+	language_enum "CodeWithAzri/pkg/enums/language"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+)
+
+// inboxText is the title and body of an inbox notification. Both are
+// templates filled in with templateData.
+type inboxText struct {
+	Title string
+	Body  string
+}
+
+var inboxTexts = map[language_enum.Language]map[notification_kind_enum.NotificationKind]inboxText{
+	language_enum.English: {
+		notification_kind_enum.LessonPublished: {
+			Title: "New lesson in {{.CourseName}}",
+			Body:  "{{.LessonTitle}} is now available.",
+		},
+		notification_kind_enum.Certificate: {
+			Title: "Course completed",
+			Body:  "You completed all {{.TotalLessons}} lessons of {{.CourseName}}. Congratulations!",
+		},
+		notification_kind_enum.CommentReply: {
+			Title: "New reply on {{.LessonTitle}}",
+			Body:  "{{if .AuthorName}}{{.AuthorName}}{{else}}Someone{{end}} replied to your comment.",
+		},
+	},
+	language_enum.Indonesian: {
+		notification_kind_enum.LessonPublished: {
+			Title: "Pelajaran baru di {{.CourseName}}",
+			Body:  "{{.LessonTitle}} sekarang tersedia.",
+		},
+		notification_kind_enum.Certificate: {
+			Title: "Kursus selesai",
+			Body:  "Kamu telah menyelesaikan semua {{.TotalLessons}} pelajaran di {{.CourseName}}. Selamat!",
+		},
+		notification_kind_enum.CommentReply: {
+			Title: "Balasan baru di {{.LessonTitle}}",
+			Body:  "{{if .AuthorName}}{{.AuthorName}}{{else}}Seseorang{{end}} membalas komentarmu.",
+		},
+	},
+}
+
+func (s *Service) GetNotifications(userID string, unreadOnly bool, limit int, page int) ([]dto.NotificationDTO, error) {
+	offset := (page - 1) * limit
+
+	notifications, err := s.repository.ReadNotifications(userID, unreadOnly, limit, offset)
+	if err != nil {
+		return []dto.NotificationDTO{}, err
+	}
+
+	notificationDTOs := make([]dto.NotificationDTO, 0, len(notifications))
+	for _, notification := range notifications {
+		notificationDTOs = append(notificationDTOs, toNotificationDTO(notification))
+	}
+
+	return notificationDTOs, nil
+}
+
+func (s *Service) CountUnread(userID string) (dto.UnreadCountDTO, error) {
+	unread, err := s.repository.CountUnread(userID)
+	if err != nil {
+		return dto.UnreadCountDTO{}, err
+	}
+
+	return dto.UnreadCountDTO{Unread: unread}, nil
+}
+
+func (s *Service) MarkRead(userID string, id uuid.UUID) error {
+	found, err := s.repository.MarkRead(id, userID, timepkg.NowUnixMilli())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) MarkAllRead(userID string) error {
+	_, err := s.repository.MarkAllRead(userID, timepkg.NowUnixMilli())
+	return err
+}
+
+// newNotification builds an inbox notification of kind in language. link
+// is stored as the data of the notification.
+func newNotification(userID string, kind notification_kind_enum.NotificationKind, referenceID string, language language_enum.Language, values templateData, link any) (entity.Notification, error) {
+	texts, ok := inboxTexts[language]
+	if !ok {
+		texts = inboxTexts[language_enum.English]
+	}
+
+	text, ok := texts[kind]
+	if !ok {
+		return entity.Notification{}, fmt.Errorf("no inbox text for notification %s", kind)
+	}
+
+	title, err := renderText(text.Title, values)
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	body, err := renderText(text.Body, values)
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	data, err := json.Marshal(link)
+	if err != nil {
+		return entity.Notification{}, fmt.Errorf("failed to encode notification data: %v", err)
+	}
+
+	return entity.Notification{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Kind:        kind,
+		ReferenceID: referenceID,
+		Title:       title,
+		Body:        body,
+		Data:        string(data),
+		CreatedAt:   timepkg.NowUnixMilli(),
+	}, nil
+}
+
+func renderText(text string, values templateData) (string, error) {
+	tmpl, err := template.New("inbox").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse notification text: %v", err)
+	}
+
+	var rendered strings.Builder
+	err = tmpl.Execute(&rendered, values)
+	if err != nil {
+		return "", fmt.Errorf("failed to render notification text: %v", err)
+	}
+
+	return rendered.String(), nil
+}
+
+// push sends new notifications to the devices their users have connected.
+// Clients that miss one still find it in the inbox, so failures are only
+// logged.
+func (s *Service) push(notifications []entity.Notification) {
+	for _, notification := range notifications {
+		err := s.publisher.PublishToUser(notification.UserID, message_type_enum.Notification, toNotificationDTO(notification))
+		if err != nil {
+			log.Printf("failed to push notification %s: %v\n", notification.ID, err)
+		}
+	}
+}
+
+func toNotificationDTO(notification entity.Notification) dto.NotificationDTO {
+	return dto.NotificationDTO{
+		ID:        notification.ID,
+		Kind:      notification.Kind,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Data:      json.RawMessage(notification.Data),
+		Read:      notification.ReadAt != nil,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
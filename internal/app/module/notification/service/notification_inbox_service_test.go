@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"CodeWithAzri/internal/app/module/notification/service"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var MockNotification entity.Notification = entity.Notification{
+	ID:          uuid.MustParse("8e2a4b6c-1d3f-4a5b-9c7d-0e1f2a3b4c51"),
+	UserID:      "user123",
+	Kind:        "lesson_published",
+	ReferenceID: "d60619ae-cee9-4877-8f5d-8b294fe9cd80",
+	Title:       "New lesson in Go for Beginners",
+	Body:        "Goroutines is now available.",
+	Data:        `{"course_id":"18a95d2f-a941-4a64-bbe5-256be7626db2"}`,
+	CreatedAt:   121212,
+}
+
+func TestService_GetNotifications(t *testing.T) {
+	t.Run("Get Notifications Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		readAt := int64(131313)
+		read := MockNotification
+		read.ReadAt = &readAt
+		test.repository.On("ReadNotifications", "user123", false, 10, 10).Return([]entity.Notification{MockNotification, read}, nil)
+
+		notifications, err := test.service.GetNotifications("user123", false, 10, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.NotificationDTO{
+			{
+				ID:        MockNotification.ID,
+				Kind:      MockNotification.Kind,
+				Title:     MockNotification.Title,
+				Body:      MockNotification.Body,
+				Data:      json.RawMessage(MockNotification.Data),
+				CreatedAt: 121212,
+			},
+			{
+				ID:        MockNotification.ID,
+				Kind:      MockNotification.Kind,
+				Title:     MockNotification.Title,
+				Body:      MockNotification.Body,
+				Data:      json.RawMessage(MockNotification.Data),
+				Read:      true,
+				ReadAt:    &readAt,
+				CreatedAt: 121212,
+			},
+		}, notifications)
+	})
+
+	t.Run("Get Notifications Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadNotifications", "user123", true, 10, 0).Return(nil, errors.New("Repository Failure"))
+
+		_, err := test.service.GetNotifications("user123", true, 10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_CountUnread(t *testing.T) {
+	t.Run("Count Unread Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("CountUnread", "user123").Return(3, nil)
+
+		unread, err := test.service.CountUnread("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.UnreadCountDTO{Unread: 3}, unread)
+	})
+
+	t.Run("Count Unread Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("CountUnread", "user123").Return(0, errors.New("Repository Failure"))
+
+		_, err := test.service.CountUnread("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_MarkRead(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Mark Read Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("MarkRead", MockNotification.ID, "user123", int64(131313)).Return(true, nil)
+
+		assert.NoError(t, test.service.MarkRead("user123", MockNotification.ID))
+	})
+
+	t.Run("Mark Read Of Another User", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("MarkRead", MockNotification.ID, "user456", int64(131313)).Return(false, nil)
+
+		assert.ErrorIs(t, test.service.MarkRead("user456", MockNotification.ID), service.ErrNotificationNotFound)
+	})
+
+	t.Run("Mark Read Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("MarkRead", MockNotification.ID, "user123", int64(131313)).Return(false, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.service.MarkRead("user123", MockNotification.ID), "Repository Failure")
+	})
+}
+
+func TestService_MarkAllRead(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Mark All Read Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("MarkAllRead", "user123", int64(131313)).Return(int64(4), nil)
+
+		assert.NoError(t, test.service.MarkAllRead("user123"))
+	})
+
+	t.Run("Mark All Read Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("MarkAllRead", "user123", int64(131313)).Return(int64(0), errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.service.MarkAllRead("user123"), "Repository Failure")
+	})
+}
@@ -0,0 +1,28 @@
+package service
+
+// ExportUserData returns the inbox of a user and the preference they saved,
+// if any, for their data export.
+func (s *Service) ExportUserData(userID string) (map[string]any, error) {
+	preference, err := s.repository.ReadPreference(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.repository.ReadUserNotifications(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]any{"notifications": notifications, "notification_preference": nil}
+	if preference.UserID != "" {
+		data["notification_preference"] = preference
+	}
+
+	return data, nil
+}
+
+// EraseUserData deletes the inbox, the email history and the preference of
+// a user.
+func (s *Service) EraseUserData(userID string) error {
+	return s.repository.EraseUserData(userID)
+}
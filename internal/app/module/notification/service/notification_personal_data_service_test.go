@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_ExportUserData(t *testing.T) {
+	preference := entity.NotificationPreference{UserID: "user123", Language: "id", EnrollmentEmails: true, UpdatedAt: 121212}
+
+	t.Run("Export User Data Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", "user123").Return(preference, nil)
+		test.repository.On("ReadUserNotifications", "user123").Return([]entity.Notification{MockNotification}, nil)
+
+		data, err := test.service.ExportUserData("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"notifications":           []entity.Notification{MockNotification},
+			"notification_preference": preference,
+		}, data)
+	})
+
+	t.Run("Export User Data Without Saved Preference", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", "user123").Return(entity.NotificationPreference{}, nil)
+		test.repository.On("ReadUserNotifications", "user123").Return([]entity.Notification{}, nil)
+
+		data, err := test.service.ExportUserData("user123")
+
+		assert.NoError(t, err)
+		assert.Nil(t, data["notification_preference"])
+	})
+
+	t.Run("Export User Data Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", "user123").Return(entity.NotificationPreference{}, errors.New("Repository Failure"))
+
+		_, err := test.service.ExportUserData("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_EraseUserData(t *testing.T) {
+	t.Run("Erase User Data Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("EraseUserData", "user123").Return(nil)
+
+		err := test.service.EraseUserData("user123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Erase User Data Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("EraseUserData", "user123").Return(errors.New("Repository Failure"))
+
+		err := test.service.EraseUserData("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
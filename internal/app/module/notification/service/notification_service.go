@@ -0,0 +1,180 @@
+package service
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"CodeWithAzri/internal/app/module/notification/repository"
+	userDTO "CodeWithAzri/internal/app/module/user/dto"
+	language_enum "CodeWithAzri/pkg/enums/language"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+	"CodeWithAzri/pkg/mailer"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+type NotificationService interface {
+	GetPreference(userID string) (dto.NotificationPreferenceDTO, error)
+	UpdatePreference(userID string, input dto.NotificationPreferenceDTO) (dto.NotificationPreferenceDTO, error)
+	GetNotifications(userID string, unreadOnly bool, limit int, page int) ([]dto.NotificationDTO, error)
+	CountUnread(userID string) (dto.UnreadCountDTO, error)
+	MarkRead(userID string, id uuid.UUID) error
+	MarkAllRead(userID string) error
+	ExportUserData(userID string) (map[string]any, error)
+	EraseUserData(userID string) error
+}
+
+// UserReader looks up who a notification goes to.
+type UserReader interface {
+	GetUser(ID string) (userDTO.UserDTO, error)
+}
+
+// CourseReader looks up the course a notification is about.
+type CourseReader interface {
+	GetCourseProgress(courseID uuid.UUID, userID string) (courseDTO.CourseProgressDTO, error)
+	GetEnrolledUserIDs(courseID uuid.UUID) ([]string, error)
+}
+
+// Publisher pushes new notifications to the devices a user has connected.
+type Publisher interface {
+	PublishToUser(userID string, messageType message_type_enum.MessageType, data any) error
+}
+
+type Service struct {
+	repository repository.NotificationRepository
+	jobs       jobService.JobService
+	users      UserReader
+	courses    CourseReader
+	sender     mailer.Sender
+	publisher  Publisher
+}
+
+// NewNotificationService subscribes to the events users are emailed about.
+// Emails are rendered by the subscribers and sent by a job, so a slow or
+// unreachable mail server is retried in the background and never holds up
+// the request that caused the email.
+func NewNotificationService(r repository.NotificationRepository, jobs jobService.JobService, events eventService.EventService, users UserReader, courses CourseReader, sender mailer.Sender, publisher Publisher) NotificationService {
+	s := new(Service)
+	s.repository = r
+	s.jobs = jobs
+	s.users = users
+	s.courses = courses
+	s.sender = sender
+	s.publisher = publisher
+	jobs.Register(EmailJobType, jobService.Handle(s.sendEmail))
+	s.subscribe(events)
+	return s
+}
+
+func (s *Service) GetPreference(userID string) (dto.NotificationPreferenceDTO, error) {
+	preference, err := s.readPreference(userID)
+	if err != nil {
+		return dto.NotificationPreferenceDTO{}, err
+	}
+
+	return toDTO(preference), nil
+}
+
+func (s *Service) UpdatePreference(userID string, input dto.NotificationPreferenceDTO) (dto.NotificationPreferenceDTO, error) {
+	preference := entity.NotificationPreference{
+		UserID:            userID,
+		Language:          input.Language,
+		EnrollmentEmails:  input.EnrollmentEmails,
+		CertificateEmails: input.CertificateEmails,
+		UpdatedAt:         timepkg.NowUnixMilli(),
+	}
+
+	err := s.repository.UpsertPreference(preference)
+	if err != nil {
+		return dto.NotificationPreferenceDTO{}, err
+	}
+
+	return toDTO(preference), nil
+}
+
+// readPreference returns the defaults for users who never saved one.
+func (s *Service) readPreference(userID string) (entity.NotificationPreference, error) {
+	preference, err := s.repository.ReadPreference(userID)
+	if err != nil {
+		return entity.NotificationPreference{}, err
+	}
+
+	if preference.UserID == "" {
+		return entity.NotificationPreference{UserID: userID, EnrollmentEmails: true, CertificateEmails: true}, nil
+	}
+
+	return preference, nil
+}
+
+// queueEmail renders kind for to and queues it. referenceID identifies what
+// the email is about, so it is queued once however often its event arrives.
+// The record is removed again when queueing fails, so the retried event
+// queues it then.
+func (s *Service) queueEmail(to userDTO.UserDTO, kind notification_kind_enum.NotificationKind, referenceID string, language language_enum.Language, data templateData) error {
+	subject, body, err := render(kind, language, data)
+	if err != nil {
+		return err
+	}
+
+	sent := entity.SentNotification{
+		ID:          uuid.New(),
+		UserID:      to.ID,
+		Kind:        kind,
+		ReferenceID: referenceID,
+		CreatedAt:   timepkg.NowUnixMilli(),
+	}
+
+	inserted, err := s.repository.RecordSent(sent)
+	if err != nil {
+		return err
+	}
+
+	if !inserted {
+		log.Printf("skipping %s notification of %s, it was sent before\n", kind, to.ID)
+		return nil
+	}
+
+	_, err = s.jobs.Enqueue(EmailJobType, mailer.Message{To: to.Email, Subject: subject, HTML: body})
+	if err != nil {
+		if deleteErr := s.repository.DeleteSent(sent.ID); deleteErr != nil {
+			log.Printf("failed to forget %s notification of %s: %v\n", kind, to.ID, deleteErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) sendEmail(ctx context.Context, message mailer.Message) error {
+	return s.sender.Send(ctx, message)
+}
+
+// preferredLanguage prefers the language the user chose, then the language of
+// what the notification is about.
+func preferredLanguage(preference entity.NotificationPreference, fallback language_enum.Language) language_enum.Language {
+	if preference.Language != "" {
+		return preference.Language
+	}
+	if fallback != "" {
+		return fallback
+	}
+	return language_enum.English
+}
+
+func toDTO(preference entity.NotificationPreference) dto.NotificationPreferenceDTO {
+	return dto.NotificationPreferenceDTO{
+		Language:          preference.Language,
+		EnrollmentEmails:  preference.EnrollmentEmails,
+		CertificateEmails: preference.CertificateEmails,
+		UpdatedAt:         preference.UpdatedAt,
+	}
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	eventMocks "CodeWithAzri/internal/app/module/event/service/mocks"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	jobMocks "CodeWithAzri/internal/app/module/job/service/mocks"
+	repositoryMocks "CodeWithAzri/internal/app/module/notification/repository/mocks"
+	"CodeWithAzri/internal/app/module/notification/service"
+	"CodeWithAzri/internal/app/module/notification/service/mocks"
+	userDTO "CodeWithAzri/internal/app/module/user/dto"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	mailerMocks "CodeWithAzri/pkg/mailer/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+var MockUser userDTO.UserDTO = userDTO.UserDTO{
+	ID:    "user123",
+	Name:  "John Doe",
+	Email: "john.doe@example.com",
+}
+
+var MockProgress courseDTO.CourseProgressDTO = courseDTO.CourseProgressDTO{
+	CourseID:         uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2"),
+	Name:             "Go for Beginners",
+	Language:         "en",
+	UserID:           "user123",
+	CompletedLessons: 2,
+	TotalLessons:     3,
+}
+
+// notificationTest holds what the service hands to the job queue and the
+// event service, so tests can run subscribers and jobs like the workers would.
+type notificationTest struct {
+	repository *repositoryMocks.NotificationRepository
+	jobs       *jobMocks.JobService
+	users      *mocks.UserReader
+	courses    *mocks.CourseReader
+	sender     *mailerMocks.Sender
+	publisher  *mocks.Publisher
+	service    service.NotificationService
+	sendEmail  jobService.Handler
+	welcome    eventService.Subscriber
+	enrollment eventService.Subscriber
+	completion eventService.Subscriber
+	lessons    eventService.Subscriber
+	replies    eventService.Subscriber
+}
+
+func initializeService(t *testing.T) notificationTest {
+	test := notificationTest{
+		repository: repositoryMocks.NewNotificationRepository(t),
+		jobs:       jobMocks.NewJobService(t),
+		users:      mocks.NewUserReader(t),
+		courses:    mocks.NewCourseReader(t),
+		sender:     mailerMocks.NewSender(t),
+		publisher:  mocks.NewPublisher(t),
+	}
+	events := eventMocks.NewEventService(t)
+
+	test.jobs.On("Register", service.EmailJobType, mock.AnythingOfType("service.Handler")).
+		Run(func(args mock.Arguments) {
+			test.sendEmail = args.Get(1).(jobService.Handler)
+		}).Once()
+	events.On("Subscribe", "notifications.welcome", mock.AnythingOfType("service.Subscriber"), event_type_enum.UserRegistered).
+		Run(func(args mock.Arguments) {
+			test.welcome = args.Get(1).(eventService.Subscriber)
+		}).Once()
+	events.On("Subscribe", "notifications.enrollment", mock.AnythingOfType("service.Subscriber"), event_type_enum.CourseEnrolled).
+		Run(func(args mock.Arguments) {
+			test.enrollment = args.Get(1).(eventService.Subscriber)
+		}).Once()
+	events.On("Subscribe", "notifications.certificate", mock.AnythingOfType("service.Subscriber"), event_type_enum.LessonCompleted).
+		Run(func(args mock.Arguments) {
+			test.completion = args.Get(1).(eventService.Subscriber)
+		}).Once()
+	events.On("Subscribe", "notifications.lessons", mock.AnythingOfType("service.Subscriber"), event_type_enum.LessonPublished).
+		Run(func(args mock.Arguments) {
+			test.lessons = args.Get(1).(eventService.Subscriber)
+		}).Once()
+	events.On("Subscribe", "notifications.replies", mock.AnythingOfType("service.Subscriber"), event_type_enum.CommentCreated).
+		Run(func(args mock.Arguments) {
+			test.replies = args.Get(1).(eventService.Subscriber)
+		}).Once()
+
+	test.service = service.NewNotificationService(test.repository, test.jobs, events, test.users, test.courses, test.sender, test.publisher)
+	return test
+}
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"CodeWithAzri/pkg/mailer"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"errors"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetPreference(t *testing.T) {
+	t.Run("Get Saved Preference", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", "user123").Return(entity.NotificationPreference{
+			UserID:            "user123",
+			Language:          "id",
+			EnrollmentEmails:  false,
+			CertificateEmails: true,
+			UpdatedAt:         121212,
+		}, nil)
+
+		preference, err := test.service.GetPreference("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.NotificationPreferenceDTO{Language: "id", CertificateEmails: true, UpdatedAt: 121212}, preference)
+	})
+
+	t.Run("Get Default Preference", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", "user123").Return(entity.NotificationPreference{}, nil)
+
+		preference, err := test.service.GetPreference("user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.NotificationPreferenceDTO{EnrollmentEmails: true, CertificateEmails: true}, preference)
+	})
+
+	t.Run("Get Preference Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", "user123").Return(entity.NotificationPreference{}, errors.New("Repository Failure"))
+
+		_, err := test.service.GetPreference("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_UpdatePreference(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	input := dto.NotificationPreferenceDTO{Language: "en", EnrollmentEmails: true}
+
+	t.Run("Update Preference Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("UpsertPreference", entity.NotificationPreference{
+			UserID:           "user123",
+			Language:         "en",
+			EnrollmentEmails: true,
+			UpdatedAt:        131313,
+		}).Return(nil)
+
+		preference, err := test.service.UpdatePreference("user123", input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.NotificationPreferenceDTO{Language: "en", EnrollmentEmails: true, UpdatedAt: 131313}, preference)
+	})
+
+	t.Run("Update Preference Repository Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("UpsertPreference", mock.Anything).Return(errors.New("Repository Failure"))
+
+		_, err := test.service.UpdatePreference("user123", input)
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_SendEmail(t *testing.T) {
+	message := mailer.Message{To: "john.doe@example.com", Subject: "Hello", HTML: "<p>Hello</p>"}
+	payload := []byte(`{"to":"john.doe@example.com","subject":"Hello","html":"<p>Hello</p>"}`)
+
+	t.Run("Send Email Successfully", func(t *testing.T) {
+		test := initializeService(t)
+		test.sender.On("Send", mock.Anything, message).Return(nil)
+
+		assert.NoError(t, test.sendEmail(context.Background(), payload))
+	})
+
+	t.Run("Send Email Fails So The Job Retries", func(t *testing.T) {
+		test := initializeService(t)
+		test.sender.On("Send", mock.Anything, message).Return(errors.New("connection refused"))
+
+		assert.EqualError(t, test.sendEmail(context.Background(), payload), "connection refused")
+	})
+}
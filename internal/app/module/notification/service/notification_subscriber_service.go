@@ -0,0 +1,219 @@
+package service
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	"CodeWithAzri/internal/app/module/notification/entity"
+	userDTO "CodeWithAzri/internal/app/module/user/dto"
+	userService "CodeWithAzri/internal/app/module/user/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+	"context"
+	"errors"
+	"log"
+)
+
+const (
+	// EmailJobType sends one rendered email. Failed attempts are retried by
+	// the job queue with exponential backoff.
+	EmailJobType = "notifications.email"
+
+	welcomeSubscriberName     = "notifications.welcome"
+	enrollmentSubscriberName  = "notifications.enrollment"
+	certificateSubscriberName = "notifications.certificate"
+	lessonSubscriberName      = "notifications.lessons"
+	replySubscriberName       = "notifications.replies"
+)
+
+func (s *Service) subscribe(events eventService.EventService) {
+	events.Subscribe(welcomeSubscriberName, eventService.Handle(s.welcome), event_type_enum.UserRegistered)
+	events.Subscribe(enrollmentSubscriberName, eventService.Handle(s.enrollment), event_type_enum.CourseEnrolled)
+	events.Subscribe(certificateSubscriberName, eventService.Handle(s.certificate), event_type_enum.LessonCompleted)
+	events.Subscribe(lessonSubscriberName, eventService.Handle(s.lessonPublished), event_type_enum.LessonPublished)
+	events.Subscribe(replySubscriberName, eventService.Handle(s.commentReply), event_type_enum.CommentCreated)
+}
+
+func (s *Service) welcome(ctx context.Context, event eventDTO.EventDTO, payload eventDTO.UserRegisteredPayload) error {
+	// The user erased their account before the event was delivered.
+	if payload.Email == "" {
+		return nil
+	}
+
+	preference, err := s.readPreference(payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	to := userDTO.UserDTO{ID: payload.UserID, Name: payload.Name, Email: payload.Email}
+	return s.queueEmail(to, notification_kind_enum.Welcome, payload.UserID, preferredLanguage(preference, ""), templateData{
+		Name: payload.Name,
+	})
+}
+
+func (s *Service) enrollment(ctx context.Context, event eventDTO.EventDTO, payload eventDTO.CourseEnrolledPayload) error {
+	preference, err := s.readPreference(payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !preference.EnrollmentEmails {
+		return nil
+	}
+
+	progress, ok, err := s.readProgress(payload)
+	if err != nil || !ok {
+		return err
+	}
+
+	return s.queueCourseEmail(notification_kind_enum.Enrollment, preference, progress)
+}
+
+// certificate congratulates a user once the lesson they completed was the
+// last one of the course, in their inbox and, unless they turned it off, by
+// email.
+func (s *Service) certificate(ctx context.Context, event eventDTO.EventDTO, payload eventDTO.LessonCompletedPayload) error {
+	progress, ok, err := s.readProgress(eventDTO.CourseEnrolledPayload{CourseID: payload.CourseID, UserID: payload.UserID})
+	if err != nil || !ok {
+		return err
+	}
+
+	if !progress.Completed {
+		return nil
+	}
+
+	preference, err := s.readPreference(payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	notification, err := newNotification(payload.UserID, notification_kind_enum.Certificate, progress.CourseID.String(),
+		preferredLanguage(preference, progress.Language), templateData{
+			CourseName:   progress.Name,
+			TotalLessons: progress.TotalLessons,
+		}, map[string]string{"course_id": progress.CourseID.String()})
+	if err != nil {
+		return err
+	}
+
+	created, err := s.repository.CreateNotifications([]entity.Notification{notification})
+	if err != nil {
+		return err
+	}
+	s.push(created)
+
+	if !preference.CertificateEmails {
+		return nil
+	}
+
+	return s.queueCourseEmail(notification_kind_enum.Certificate, preference, progress)
+}
+
+// lessonPublished tells every user enrolled in the course about the new
+// lesson, in the language each of them chose.
+func (s *Service) lessonPublished(ctx context.Context, event eventDTO.EventDTO, payload eventDTO.LessonPublishedPayload) error {
+	userIDs, err := s.courses.GetEnrolledUserIDs(payload.CourseID)
+	if err != nil || len(userIDs) == 0 {
+		return err
+	}
+
+	preferences, err := s.repository.ReadPreferences(userIDs)
+	if err != nil {
+		return err
+	}
+
+	preferenceOf := make(map[string]entity.NotificationPreference, len(preferences))
+	for _, preference := range preferences {
+		preferenceOf[preference.UserID] = preference
+	}
+
+	values := templateData{CourseName: payload.CourseName, LessonTitle: payload.Title}
+	link := map[string]string{"course_id": payload.CourseID.String(), "lesson_id": payload.LessonID.String()}
+
+	notifications := make([]entity.Notification, 0, len(userIDs))
+	for _, userID := range userIDs {
+		notification, err := newNotification(userID, notification_kind_enum.LessonPublished, payload.LessonID.String(),
+			preferredLanguage(preferenceOf[userID], payload.Language), values, link)
+		if err != nil {
+			return err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	created, err := s.repository.CreateNotifications(notifications)
+	if err != nil {
+		return err
+	}
+	s.push(created)
+	return nil
+}
+
+// commentReply tells the author of a comment that someone else replied to
+// it. Replies without a known author are still announced, without a name.
+func (s *Service) commentReply(ctx context.Context, event eventDTO.EventDTO, payload eventDTO.CommentCreatedPayload) error {
+	if payload.ParentUserID == "" || payload.ParentUserID == payload.UserID {
+		return nil
+	}
+
+	author, err := s.users.GetUser(payload.UserID)
+	if err != nil && !errors.Is(err, userService.ErrUserNotFound) {
+		return err
+	}
+
+	preference, err := s.readPreference(payload.ParentUserID)
+	if err != nil {
+		return err
+	}
+
+	link := map[string]string{
+		"course_id":  payload.CourseID.String(),
+		"lesson_id":  payload.LessonID.String(),
+		"comment_id": payload.CommentID.String(),
+	}
+	notification, err := newNotification(payload.ParentUserID, notification_kind_enum.CommentReply, payload.CommentID.String(),
+		preferredLanguage(preference, payload.Language), templateData{LessonTitle: payload.LessonTitle, AuthorName: author.Name}, link)
+	if err != nil {
+		return err
+	}
+
+	created, err := s.repository.CreateNotifications([]entity.Notification{notification})
+	if err != nil {
+		return err
+	}
+	s.push(created)
+	return nil
+}
+
+// readProgress reports false when the user lost access to the course before
+// the event arrived, as there is nothing left to tell them about.
+func (s *Service) readProgress(payload eventDTO.CourseEnrolledPayload) (courseDTO.CourseProgressDTO, bool, error) {
+	progress, err := s.courses.GetCourseProgress(payload.CourseID, payload.UserID)
+	if errors.Is(err, courseService.ErrCourseNotFound) || errors.Is(err, courseService.ErrNotEnrolled) {
+		log.Printf("skipping notification of %s about course %s: %v\n", payload.UserID, payload.CourseID, err)
+		return courseDTO.CourseProgressDTO{}, false, nil
+	}
+	if err != nil {
+		return courseDTO.CourseProgressDTO{}, false, err
+	}
+
+	return progress, true, nil
+}
+
+// queueCourseEmail sends kind once per user and course.
+func (s *Service) queueCourseEmail(kind notification_kind_enum.NotificationKind, preference entity.NotificationPreference, progress courseDTO.CourseProgressDTO) error {
+	to, err := s.users.GetUser(progress.UserID)
+	if errors.Is(err, userService.ErrUserNotFound) {
+		log.Printf("skipping %s notification of deleted user %s\n", kind, progress.UserID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.queueEmail(to, kind, progress.CourseID.String(), preferredLanguage(preference, progress.Language), templateData{
+		Name:         to.Name,
+		CourseName:   progress.Name,
+		TotalLessons: progress.TotalLessons,
+	})
+}
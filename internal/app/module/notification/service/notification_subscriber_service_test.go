@@ -0,0 +1,323 @@
+package service_test
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	"CodeWithAzri/internal/app/module/notification/dto"
+	"CodeWithAzri/internal/app/module/notification/entity"
+	"CodeWithAzri/internal/app/module/notification/service"
+	userDTO "CodeWithAzri/internal/app/module/user/dto"
+	userService "CodeWithAzri/internal/app/module/user/service"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+	"CodeWithAzri/pkg/mailer"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func event(payload any) eventDTO.EventDTO {
+	body, _ := json.Marshal(payload)
+	return eventDTO.EventDTO{ID: uuid.New(), Payload: body}
+}
+
+// expectEmail records the notification as new and captures the queued email.
+func expectEmail(test notificationTest, kind notification_kind_enum.NotificationKind, referenceID string) *mailer.Message {
+	queued := new(mailer.Message)
+	test.repository.On("RecordSent", mock.MatchedBy(func(sent entity.SentNotification) bool {
+		return sent.UserID == MockUser.ID && sent.Kind == kind && sent.ReferenceID == referenceID
+	})).Return(true, nil).Once()
+	test.jobs.On("Enqueue", service.EmailJobType, mock.AnythingOfType("mailer.Message")).
+		Run(func(args mock.Arguments) {
+			*queued = args.Get(1).(mailer.Message)
+		}).Return(uuid.New(), nil).Once()
+	return queued
+}
+
+func TestService_Welcome(t *testing.T) {
+	registered := event(eventDTO.UserRegisteredPayload{UserID: MockUser.ID, Name: "John O'Brien", Email: MockUser.Email})
+
+	t.Run("Welcome Email In English", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		queued := expectEmail(test, notification_kind_enum.Welcome, MockUser.ID)
+
+		assert.NoError(t, test.welcome(context.Background(), registered))
+		assert.Equal(t, MockUser.Email, queued.To)
+		assert.Equal(t, "Welcome to CodeWithAzri, John O'Brien!", queued.Subject)
+		assert.Contains(t, queued.HTML, "Hi John O&#39;Brien,")
+	})
+
+	t.Run("Welcome Email In The Preferred Language", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{UserID: MockUser.ID, Language: "id"}, nil)
+		queued := expectEmail(test, notification_kind_enum.Welcome, MockUser.ID)
+
+		assert.NoError(t, test.welcome(context.Background(), registered))
+		assert.Equal(t, "Selamat datang di CodeWithAzri, John O'Brien!", queued.Subject)
+		assert.Contains(t, queued.HTML, `<html lang="id">`)
+	})
+
+	t.Run("Welcome Email Sent Before", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.repository.On("RecordSent", mock.Anything).Return(false, nil)
+
+		assert.NoError(t, test.welcome(context.Background(), registered))
+		test.jobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Welcome Email For Erased User", func(t *testing.T) {
+		test := initializeService(t)
+
+		assert.NoError(t, test.welcome(context.Background(), event(eventDTO.UserRegisteredPayload{UserID: MockUser.ID})))
+		test.jobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Welcome Email Queue Failure", func(t *testing.T) {
+		test := initializeService(t)
+		var sentID uuid.UUID
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.repository.On("RecordSent", mock.Anything).
+			Run(func(args mock.Arguments) {
+				sentID = args.Get(0).(entity.SentNotification).ID
+			}).Return(true, nil)
+		test.jobs.On("Enqueue", service.EmailJobType, mock.Anything).Return(uuid.Nil, errors.New("queue down"))
+		test.repository.On("DeleteSent", mock.MatchedBy(func(id uuid.UUID) bool { return id == sentID })).Return(nil)
+
+		assert.EqualError(t, test.welcome(context.Background(), registered), "queue down")
+	})
+}
+
+func TestService_Enrollment(t *testing.T) {
+	enrolled := event(eventDTO.CourseEnrolledPayload{CourseID: MockProgress.CourseID, UserID: MockUser.ID})
+
+	t.Run("Enrollment Email In The Course Language", func(t *testing.T) {
+		test := initializeService(t)
+		progress := MockProgress
+		progress.Language = "id"
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(progress, nil)
+		test.users.On("GetUser", MockUser.ID).Return(MockUser, nil)
+		queued := expectEmail(test, notification_kind_enum.Enrollment, MockProgress.CourseID.String())
+
+		assert.NoError(t, test.enrollment(context.Background(), enrolled))
+		assert.Equal(t, MockUser.Email, queued.To)
+		assert.Equal(t, "Kamu terdaftar di Go for Beginners", queued.Subject)
+		assert.Contains(t, queued.HTML, "Ada 3 pelajaran")
+	})
+
+	t.Run("Enrollment Email Turned Off", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{UserID: MockUser.ID, CertificateEmails: true}, nil)
+
+		assert.NoError(t, test.enrollment(context.Background(), enrolled))
+	})
+
+	t.Run("Enrollment Email For A Course That Is Gone", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(courseDTO.CourseProgressDTO{}, courseService.ErrCourseNotFound)
+
+		assert.NoError(t, test.enrollment(context.Background(), enrolled))
+	})
+
+	t.Run("Enrollment Email For A Deleted User", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(MockProgress, nil)
+		test.users.On("GetUser", MockUser.ID).Return(MockUser, userService.ErrUserNotFound)
+
+		assert.NoError(t, test.enrollment(context.Background(), enrolled))
+	})
+
+	t.Run("Enrollment Email Course Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(courseDTO.CourseProgressDTO{}, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.enrollment(context.Background(), enrolled), "Repository Failure")
+	})
+}
+
+func TestService_Certificate(t *testing.T) {
+	completed := event(eventDTO.LessonCompletedPayload{CourseID: MockProgress.CourseID, LessonID: uuid.New(), UserID: MockUser.ID})
+	progress := MockProgress
+	progress.CompletedLessons = 3
+	progress.Completed = true
+
+	t.Run("Certificate Notification And Email Once The Course Is Completed", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(progress, nil)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.repository.On("CreateNotifications", mock.MatchedBy(func(notifications []entity.Notification) bool {
+			return len(notifications) == 1 && notifications[0].Kind == notification_kind_enum.Certificate &&
+				notifications[0].ReferenceID == MockProgress.CourseID.String() &&
+				notifications[0].Body == "You completed all 3 lessons of Go for Beginners. Congratulations!"
+		})).Return([]entity.Notification{}, nil)
+		test.users.On("GetUser", MockUser.ID).Return(MockUser, nil)
+		queued := expectEmail(test, notification_kind_enum.Certificate, MockProgress.CourseID.String())
+
+		assert.NoError(t, test.completion(context.Background(), completed))
+		assert.Equal(t, "Congratulations on completing Go for Beginners", queued.Subject)
+		assert.Contains(t, queued.HTML, "all 3 lessons of <strong>Go for Beginners</strong>")
+	})
+
+	t.Run("No Certificate Before The Course Is Completed", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(MockProgress, nil)
+
+		assert.NoError(t, test.completion(context.Background(), completed))
+	})
+
+	t.Run("Certificate Email Turned Off", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(progress, nil)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{UserID: MockUser.ID, Language: "id", EnrollmentEmails: true}, nil)
+		test.repository.On("CreateNotifications", mock.MatchedBy(func(notifications []entity.Notification) bool {
+			return len(notifications) == 1 && notifications[0].Title == "Kursus selesai"
+		})).Return([]entity.Notification{}, nil)
+
+		assert.NoError(t, test.completion(context.Background(), completed))
+	})
+
+	t.Run("Certificate After Unenrolling", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(courseDTO.CourseProgressDTO{}, courseService.ErrNotEnrolled)
+
+		assert.NoError(t, test.completion(context.Background(), completed))
+	})
+
+	t.Run("Certificate Notification Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetCourseProgress", MockProgress.CourseID, MockUser.ID).Return(progress, nil)
+		test.repository.On("ReadPreference", MockUser.ID).Return(entity.NotificationPreference{}, nil)
+		test.repository.On("CreateNotifications", mock.Anything).Return(nil, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.completion(context.Background(), completed), "Repository Failure")
+	})
+}
+
+func TestService_LessonPublished(t *testing.T) {
+	lessonID := uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+	published := event(eventDTO.LessonPublishedPayload{
+		CourseID:   MockProgress.CourseID,
+		CourseName: "Go for Beginners",
+		Language:   "en",
+		LessonID:   lessonID,
+		Title:      "Goroutines",
+	})
+
+	t.Run("Lesson Published To Enrolled Users", func(t *testing.T) {
+		test := initializeService(t)
+		var created []entity.Notification
+		test.courses.On("GetEnrolledUserIDs", MockProgress.CourseID).Return([]string{"user123", "user456"}, nil)
+		test.repository.On("ReadPreferences", []string{"user123", "user456"}).
+			Return([]entity.NotificationPreference{{UserID: "user456", Language: "id"}}, nil)
+		test.repository.On("CreateNotifications", mock.Anything).
+			Run(func(args mock.Arguments) {
+				created = args.Get(0).([]entity.Notification)
+			}).Return(func(notifications []entity.Notification) []entity.Notification {
+			return notifications
+		}, nil)
+		test.publisher.On("PublishToUser", "user123", message_type_enum.Notification, mock.MatchedBy(func(notification dto.NotificationDTO) bool {
+			return notification.Title == "New lesson in Go for Beginners"
+		})).Return(nil).Once()
+		test.publisher.On("PublishToUser", "user456", message_type_enum.Notification, mock.AnythingOfType("dto.NotificationDTO")).
+			Return(errors.New("Repository Failure")).Once()
+
+		assert.NoError(t, test.lessons(context.Background(), published))
+		assert.Len(t, created, 2)
+		assert.Equal(t, "user123", created[0].UserID)
+		assert.Equal(t, notification_kind_enum.LessonPublished, created[0].Kind)
+		assert.Equal(t, lessonID.String(), created[0].ReferenceID)
+		assert.Equal(t, "New lesson in Go for Beginners", created[0].Title)
+		assert.Equal(t, "Goroutines is now available.", created[0].Body)
+		assert.JSONEq(t, `{"course_id":"`+MockProgress.CourseID.String()+`","lesson_id":"`+lessonID.String()+`"}`, created[0].Data)
+		assert.Equal(t, "Pelajaran baru di Go for Beginners", created[1].Title)
+	})
+
+	t.Run("Lesson Published Without Enrolled Users", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetEnrolledUserIDs", MockProgress.CourseID).Return([]string{}, nil)
+
+		assert.NoError(t, test.lessons(context.Background(), published))
+	})
+
+	t.Run("Lesson Published Course Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.courses.On("GetEnrolledUserIDs", MockProgress.CourseID).Return(nil, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.lessons(context.Background(), published), "Repository Failure")
+	})
+}
+
+func TestService_CommentReply(t *testing.T) {
+	lessonID := uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+	commentID := uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b61")
+	parentID := uuid.MustParse("5c0f6a1e-7b2d-4e8f-9a3c-1d2e3f4a5b62")
+	payload := eventDTO.CommentCreatedPayload{
+		CommentID:    commentID,
+		CourseID:     MockProgress.CourseID,
+		Language:     "en",
+		LessonID:     lessonID,
+		LessonTitle:  "Goroutines",
+		ParentID:     &parentID,
+		ParentUserID: "user456",
+		UserID:       MockUser.ID,
+	}
+
+	t.Run("Reply Notifies The Parent Author", func(t *testing.T) {
+		test := initializeService(t)
+		test.users.On("GetUser", MockUser.ID).Return(MockUser, nil)
+		test.repository.On("ReadPreference", "user456").Return(entity.NotificationPreference{UserID: "user456", Language: "id"}, nil)
+		test.repository.On("CreateNotifications", mock.MatchedBy(func(notifications []entity.Notification) bool {
+			return len(notifications) == 1 && notifications[0].UserID == "user456" &&
+				notifications[0].Kind == notification_kind_enum.CommentReply &&
+				notifications[0].ReferenceID == commentID.String() &&
+				notifications[0].Title == "Balasan baru di Goroutines" &&
+				notifications[0].Body == MockUser.Name+" membalas komentarmu."
+		})).Return(func(notifications []entity.Notification) []entity.Notification {
+			return notifications
+		}, nil)
+		test.publisher.On("PublishToUser", "user456", message_type_enum.Notification, mock.AnythingOfType("dto.NotificationDTO")).Return(nil).Once()
+
+		assert.NoError(t, test.replies(context.Background(), event(payload)))
+	})
+
+	t.Run("Reply Of An Unknown Author", func(t *testing.T) {
+		test := initializeService(t)
+		test.users.On("GetUser", MockUser.ID).Return(userDTO.UserDTO{}, userService.ErrUserNotFound)
+		test.repository.On("ReadPreference", "user456").Return(entity.NotificationPreference{}, nil)
+		test.repository.On("CreateNotifications", mock.MatchedBy(func(notifications []entity.Notification) bool {
+			return len(notifications) == 1 && notifications[0].Body == "Someone replied to your comment."
+		})).Return([]entity.Notification{}, nil)
+
+		assert.NoError(t, test.replies(context.Background(), event(payload)))
+	})
+
+	t.Run("No Notification For Threads And Own Replies", func(t *testing.T) {
+		test := initializeService(t)
+		thread := payload
+		thread.ParentID = nil
+		thread.ParentUserID = ""
+		own := payload
+		own.ParentUserID = MockUser.ID
+
+		assert.NoError(t, test.replies(context.Background(), event(thread)))
+		assert.NoError(t, test.replies(context.Background(), event(own)))
+	})
+
+	t.Run("Reply Author Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.users.On("GetUser", MockUser.ID).Return(userDTO.UserDTO{}, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.replies(context.Background(), event(payload)), "Repository Failure")
+	})
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	language_enum "CodeWithAzri/pkg/enums/language"
+	notification_kind_enum "CodeWithAzri/pkg/enums/notificationKind"
+	"bytes"
+	"embed"
+	"fmt"
+	"html"
+	"html/template"
+	"strings"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// templateData is what every template can use. Fields a kind does not need
+// stay empty.
+type templateData struct {
+	Name         string
+	CourseName   string
+	LessonTitle  string
+	TotalLessons int
+	AuthorName   string
+}
+
+var templateLanguages = []language_enum.Language{language_enum.English, language_enum.Indonesian}
+
+var templateKinds = []notification_kind_enum.NotificationKind{
+	notification_kind_enum.Welcome,
+	notification_kind_enum.Enrollment,
+	notification_kind_enum.Certificate,
+}
+
+// templates holds templates/{language}/{kind}.html. Every file defines a
+// "subject" template next to the HTML body.
+var templates = parseTemplates()
+
+func parseTemplates() map[language_enum.Language]map[notification_kind_enum.NotificationKind]*template.Template {
+	parsed := make(map[language_enum.Language]map[notification_kind_enum.NotificationKind]*template.Template)
+	for _, language := range templateLanguages {
+		parsed[language] = make(map[notification_kind_enum.NotificationKind]*template.Template)
+		for _, kind := range templateKinds {
+			path := fmt.Sprintf("templates/%s/%s.html", language, kind)
+			parsed[language][kind] = template.Must(template.ParseFS(templateFS, path))
+		}
+	}
+	return parsed
+}
+
+// render returns the subject and HTML body of kind in language, falling
+// back to English for languages without templates.
+func render(kind notification_kind_enum.NotificationKind, language language_enum.Language, data templateData) (string, string, error) {
+	byKind, ok := templates[language]
+	if !ok {
+		byKind = templates[language_enum.English]
+	}
+
+	tmpl, ok := byKind[kind]
+	if !ok {
+		return "", "", fmt.Errorf("no template for notification %s", kind)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", fmt.Errorf("failed to render notification subject: %v", err)
+	}
+	if err := tmpl.Execute(&body, data); err != nil {
+		return "", "", fmt.Errorf("failed to render notification body: %v", err)
+	}
+
+	// The subject is plain text, so undo the escaping meant for HTML.
+	return strings.TrimSpace(html.UnescapeString(subject.String())), body.String(), nil
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// MessageDTO is one update pushed to a connected client. It is sent as a
+// Server-Sent Event named after Type, with Data as the event data.
+type MessageDTO struct {
+	Type message_type_enum.MessageType `json:"type"`
+	Data json.RawMessage               `json:"data" swaggertype:"object"`
+}
+
+// EnvelopeDTO carries a message between server instances over Postgres
+// NOTIFY. Topic names who receives it, such as a user or a lesson thread.
+type EnvelopeDTO struct {
+	Topic   string     `json:"topic"`
+	Message MessageDTO `json:"message"`
+}
+
+// ProgressDTO tells the other devices of a user that a lesson was completed.
+type ProgressDTO struct {
+	CourseID    uuid.UUID `json:"course_id"`
+	LessonID    uuid.UUID `json:"lesson_id"`
+	CompletedAt int64     `json:"completed_at"`
+}
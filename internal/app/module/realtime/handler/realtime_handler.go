@@ -0,0 +1,117 @@
+package handler
+
+import (
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/internal/app/module/realtime/service"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// heartbeatInterval keeps proxies from closing an idle stream.
+const heartbeatInterval = 25 * time.Second
+
+type Handler struct {
+	service service.RealtimeService
+}
+
+func NewHandler(s service.RealtimeService) *Handler {
+	h := new(Handler)
+	h.service = s
+	return h
+}
+
+// Stream godoc
+//
+//	@Summary		Stream real-time updates
+//	@Tags			Realtime
+//	@Description	Stream Server-Sent Events to the signed in user until they disconnect. A "notification" event carries a new inbox notification, a "progress" event a lesson the user completed on another device. Pass lesson_id to also follow the comment threads of those lessons, which takes the same access as watching them. A "comment" event names a comment that changed without its body, so clients reload the thread to show it. Clients that cannot set headers, such as EventSource, may pass the ID token as the access_token query parameter instead. Events sent while a client is disconnected are not replayed, so clients should refetch after reconnecting.
+//	@ID				stream-realtime
+//	@Produce		text/event-stream
+//	@Param			lesson_id		query	[]string	false	"Lessons whose comment threads to follow"	collectionFormat(multi)
+//	@Param			access_token	query	string		false	"ID token, for clients that cannot set the Authorization header"
+//	@Param			Authorization	header	string		false	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	dto.MessageDTO			"Stream of events"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid lesson ID"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not enrolled in the course of a lesson"
+//	@Failure		404	{object}	response.ResponseError	"Lesson not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error, streaming is not supported"
+//	@Router			/api/v1/realtime/stream [get]
+func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
+	lessonIDs := make([]uuid.UUID, 0)
+	for _, value := range r.URL.Query()["lesson_id"] {
+		lessonID, err := uuid.Parse(value)
+		if err != nil {
+			response.RespondErrorMessage(http.StatusBadRequest, "Invalid lesson ID", w)
+			return
+		}
+		lessonIDs = append(lessonIDs, lessonID)
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		response.RespondErrorMessage(http.StatusInternalServerError, "Streaming is not supported", w)
+		return
+	}
+
+	messages, unsubscribe, err := h.service.Subscribe(requestPkg.GetUserID(r), lessonIDs...)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 3000\n\n")
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case message, ok := <-messages:
+			// A closed channel means the client fell behind. Ending the
+			// stream makes it reconnect and refetch what it missed.
+			if !ok {
+				return
+			}
+			// Marshalling compacts the data onto one line, as the data of
+			// an event ends at the first newline.
+			data, err := json.Marshal(message.Data)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", message.Type, data)
+			flusher.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, courseService.ErrCourseNotFound), errors.Is(err, courseService.ErrLessonNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, courseService.ErrNotEnrolled):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	"CodeWithAzri/internal/app/module/realtime/dto"
+	"CodeWithAzri/internal/app/module/realtime/handler"
+	"CodeWithAzri/internal/app/module/realtime/service/mocks"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	"CodeWithAzri/pkg/requestPkg"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// notFlusher hides the Flush method of the recorder.
+type notFlusher struct {
+	http.ResponseWriter
+}
+
+func TestHandler_Stream(t *testing.T) {
+	mockService := mocks.NewRealtimeService(t)
+	realtimeHandler := handler.NewHandler(mockService)
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+	defer monkey.UnpatchAll()
+
+	lessonID := uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+	t.Run("Stream Messages Until The Subscription Closes", func(t *testing.T) {
+		messages := make(chan dto.MessageDTO, 2)
+		messages <- dto.MessageDTO{Type: message_type_enum.Notification, Data: []byte("{\n  \"title\": \"Hi\"\n}")}
+		messages <- dto.MessageDTO{Type: message_type_enum.Progress, Data: []byte(`{"lesson_id":"` + lessonID.String() + `"}`)}
+		close(messages)
+		unsubscribed := false
+		mockService.On("Subscribe", "user123", lessonID).
+			Return((<-chan dto.MessageDTO)(messages), func() { unsubscribed = true }, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/realtime/stream?lesson_id="+lessonID.String(), nil)
+		recorder := httptest.NewRecorder()
+		realtimeHandler.Stream(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "retry: 3000\n\n"+
+			"event: notification\ndata: {\"title\":\"Hi\"}\n\n"+
+			"event: progress\ndata: {\"lesson_id\":\""+lessonID.String()+"\"}\n\n", recorder.Body.String())
+		assert.True(t, unsubscribed)
+	})
+
+	t.Run("Stream Ends When The Client Disconnects", func(t *testing.T) {
+		messages := make(chan dto.MessageDTO)
+		mockService.On("Subscribe", "user123").Return((<-chan dto.MessageDTO)(messages), func() {}, nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, _ := http.NewRequestWithContext(ctx, "GET", "/api/v1/realtime/stream", nil)
+		recorder := httptest.NewRecorder()
+		realtimeHandler.Stream(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "retry: 3000\n\n", recorder.Body.String())
+	})
+
+	t.Run("Stream Lesson Without Access", func(t *testing.T) {
+		mockService.On("Subscribe", "user123", lessonID).Return(nil, nil, courseService.ErrNotEnrolled).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/realtime/stream?lesson_id="+lessonID.String(), nil)
+		recorder := httptest.NewRecorder()
+		realtimeHandler.Stream(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Stream Invalid Lesson ID", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/v1/realtime/stream?lesson_id=invalid", nil)
+		recorder := httptest.NewRecorder()
+		realtimeHandler.Stream(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Stream Not Supported", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/v1/realtime/stream", nil)
+		recorder := httptest.NewRecorder()
+		realtimeHandler.Stream(notFlusher{recorder}, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
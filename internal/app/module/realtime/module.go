@@ -0,0 +1,28 @@
+package realtime
+
+import (
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	"CodeWithAzri/internal/app/module/realtime/handler"
+	"CodeWithAzri/internal/app/module/realtime/repository"
+	"CodeWithAzri/internal/app/module/realtime/service"
+	"CodeWithAzri/internal/app/module/realtime/worker"
+	"CodeWithAzri/pkg/sqlPkg"
+	"database/sql"
+	"time"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.RealtimeService
+	Repository repository.RealtimeRepository
+	Worker     *worker.RealtimeListener
+}
+
+func NewModule(db *sql.DB, events eventService.EventService, lessons service.LessonReader) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewRealtimeService(m.Repository, events, lessons)
+	m.Handler = handler.NewHandler(m.Service)
+	m.Worker = worker.NewRealtimeListener(m.Service, worker.NewPostgresListener(sqlPkg.GetConnectionString()), 90*time.Second)
+	return m
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// RealtimeRepository is an autogenerated mock type for the RealtimeRepository type
+type RealtimeRepository struct {
+	mock.Mock
+}
+
+type RealtimeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RealtimeRepository) EXPECT() *RealtimeRepository_Expecter {
+	return &RealtimeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function with given fields: payload
+func (_m *RealtimeRepository) Notify(payload string) error {
+	ret := _m.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealtimeRepository_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type RealtimeRepository_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - payload string
+func (_e *RealtimeRepository_Expecter) Notify(payload interface{}) *RealtimeRepository_Notify_Call {
+	return &RealtimeRepository_Notify_Call{Call: _e.mock.On("Notify", payload)}
+}
+
+func (_c *RealtimeRepository_Notify_Call) Run(run func(payload string)) *RealtimeRepository_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RealtimeRepository_Notify_Call) Return(_a0 error) *RealtimeRepository_Notify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealtimeRepository_Notify_Call) RunAndReturn(run func(string) error) *RealtimeRepository_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRealtimeRepository creates a new instance of RealtimeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRealtimeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RealtimeRepository {
+	mock := &RealtimeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
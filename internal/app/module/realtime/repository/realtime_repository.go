@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Channel is the Postgres channel real-time messages are sent on.
+const Channel = "realtime"
+
+type RealtimeRepository interface {
+	Notify(payload string) error
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) RealtimeRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+// Notify sends payload to every connection listening on Channel, including
+// the ones of other server instances.
+func (r *Repository) Notify(payload string) error {
+	_, err := r.db.Exec("SELECT pg_notify($1, $2)", Channel, payload)
+	if err != nil {
+		return fmt.Errorf("failed to notify: %v", err)
+	}
+
+	return nil
+}
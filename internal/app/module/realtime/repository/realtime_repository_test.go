@@ -0,0 +1,33 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/realtime/repository"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const notifyQuery = "SELECT pg_notify($1, $2)"
+
+func TestRepository_Notify(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+	repo := repository.NewRepository(db)
+
+	t.Run("Notify Success", func(t *testing.T) {
+		mock.ExpectExec(notifyQuery).WithArgs(repository.Channel, `{"topic":"user:user123"}`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Notify(`{"topic":"user:user123"}`))
+	})
+
+	t.Run("Notify Error", func(t *testing.T) {
+		mock.ExpectExec(notifyQuery).WillReturnError(errors.New("connection lost"))
+
+		assert.EqualError(t, repo.Notify(`{"topic":"user:user123"}`), "failed to notify: connection lost")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
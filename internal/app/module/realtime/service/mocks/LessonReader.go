@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/course/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LessonReader is an autogenerated mock type for the LessonReader type
+type LessonReader struct {
+	mock.Mock
+}
+
+type LessonReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LessonReader) EXPECT() *LessonReader_Expecter {
+	return &LessonReader_Expecter{mock: &_m.Mock}
+}
+
+// GetLessonAccess provides a mock function with given fields: lessonID, userID
+func (_m *LessonReader) GetLessonAccess(lessonID uuid.UUID, userID string) (dto.LessonAccessDTO, error) {
+	ret := _m.Called(lessonID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLessonAccess")
+	}
+
+	var r0 dto.LessonAccessDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) (dto.LessonAccessDTO, error)); ok {
+		return rf(lessonID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) dto.LessonAccessDTO); ok {
+		r0 = rf(lessonID, userID)
+	} else {
+		r0 = ret.Get(0).(dto.LessonAccessDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(lessonID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LessonReader_GetLessonAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLessonAccess'
+type LessonReader_GetLessonAccess_Call struct {
+	*mock.Call
+}
+
+// GetLessonAccess is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - userID string
+func (_e *LessonReader_Expecter) GetLessonAccess(lessonID interface{}, userID interface{}) *LessonReader_GetLessonAccess_Call {
+	return &LessonReader_GetLessonAccess_Call{Call: _e.mock.On("GetLessonAccess", lessonID, userID)}
+}
+
+func (_c *LessonReader_GetLessonAccess_Call) Run(run func(lessonID uuid.UUID, userID string)) *LessonReader_GetLessonAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LessonReader_GetLessonAccess_Call) Return(_a0 dto.LessonAccessDTO, _a1 error) *LessonReader_GetLessonAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LessonReader_GetLessonAccess_Call) RunAndReturn(run func(uuid.UUID, string) (dto.LessonAccessDTO, error)) *LessonReader_GetLessonAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLessonReader creates a new instance of LessonReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLessonReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LessonReader {
+	mock := &LessonReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,250 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/realtime/dto"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RealtimeService is an autogenerated mock type for the RealtimeService type
+type RealtimeService struct {
+	mock.Mock
+}
+
+type RealtimeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RealtimeService) EXPECT() *RealtimeService_Expecter {
+	return &RealtimeService_Expecter{mock: &_m.Mock}
+}
+
+// Deliver provides a mock function with given fields: payload
+func (_m *RealtimeService) Deliver(payload string) {
+	_m.Called(payload)
+}
+
+// RealtimeService_Deliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliver'
+type RealtimeService_Deliver_Call struct {
+	*mock.Call
+}
+
+// Deliver is a helper method to define mock.On call
+//   - payload string
+func (_e *RealtimeService_Expecter) Deliver(payload interface{}) *RealtimeService_Deliver_Call {
+	return &RealtimeService_Deliver_Call{Call: _e.mock.On("Deliver", payload)}
+}
+
+func (_c *RealtimeService_Deliver_Call) Run(run func(payload string)) *RealtimeService_Deliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RealtimeService_Deliver_Call) Return() *RealtimeService_Deliver_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RealtimeService_Deliver_Call) RunAndReturn(run func(string)) *RealtimeService_Deliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishToLesson provides a mock function with given fields: lessonID, messageType, data
+func (_m *RealtimeService) PublishToLesson(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any) error {
+	ret := _m.Called(lessonID, messageType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishToLesson")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, message_type_enum.MessageType, any) error); ok {
+		r0 = rf(lessonID, messageType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealtimeService_PublishToLesson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishToLesson'
+type RealtimeService_PublishToLesson_Call struct {
+	*mock.Call
+}
+
+// PublishToLesson is a helper method to define mock.On call
+//   - lessonID uuid.UUID
+//   - messageType message_type_enum.MessageType
+//   - data any
+func (_e *RealtimeService_Expecter) PublishToLesson(lessonID interface{}, messageType interface{}, data interface{}) *RealtimeService_PublishToLesson_Call {
+	return &RealtimeService_PublishToLesson_Call{Call: _e.mock.On("PublishToLesson", lessonID, messageType, data)}
+}
+
+func (_c *RealtimeService_PublishToLesson_Call) Run(run func(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any)) *RealtimeService_PublishToLesson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(message_type_enum.MessageType), args[2].(any))
+	})
+	return _c
+}
+
+func (_c *RealtimeService_PublishToLesson_Call) Return(_a0 error) *RealtimeService_PublishToLesson_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealtimeService_PublishToLesson_Call) RunAndReturn(run func(uuid.UUID, message_type_enum.MessageType, any) error) *RealtimeService_PublishToLesson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishToUser provides a mock function with given fields: userID, messageType, data
+func (_m *RealtimeService) PublishToUser(userID string, messageType message_type_enum.MessageType, data any) error {
+	ret := _m.Called(userID, messageType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishToUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, message_type_enum.MessageType, any) error); ok {
+		r0 = rf(userID, messageType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealtimeService_PublishToUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishToUser'
+type RealtimeService_PublishToUser_Call struct {
+	*mock.Call
+}
+
+// PublishToUser is a helper method to define mock.On call
+//   - userID string
+//   - messageType message_type_enum.MessageType
+//   - data any
+func (_e *RealtimeService_Expecter) PublishToUser(userID interface{}, messageType interface{}, data interface{}) *RealtimeService_PublishToUser_Call {
+	return &RealtimeService_PublishToUser_Call{Call: _e.mock.On("PublishToUser", userID, messageType, data)}
+}
+
+func (_c *RealtimeService_PublishToUser_Call) Run(run func(userID string, messageType message_type_enum.MessageType, data any)) *RealtimeService_PublishToUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(message_type_enum.MessageType), args[2].(any))
+	})
+	return _c
+}
+
+func (_c *RealtimeService_PublishToUser_Call) Return(_a0 error) *RealtimeService_PublishToUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealtimeService_PublishToUser_Call) RunAndReturn(run func(string, message_type_enum.MessageType, any) error) *RealtimeService_PublishToUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: userID, lessonIDs
+func (_m *RealtimeService) Subscribe(userID string, lessonIDs ...uuid.UUID) (<-chan dto.MessageDTO, func(), error) {
+	_va := make([]interface{}, len(lessonIDs))
+	for _i := range lessonIDs {
+		_va[_i] = lessonIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, userID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan dto.MessageDTO
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, ...uuid.UUID) (<-chan dto.MessageDTO, func(), error)); ok {
+		return rf(userID, lessonIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...uuid.UUID) <-chan dto.MessageDTO); ok {
+		r0 = rf(userID, lessonIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan dto.MessageDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...uuid.UUID) func()); ok {
+		r1 = rf(userID, lessonIDs...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, ...uuid.UUID) error); ok {
+		r2 = rf(userID, lessonIDs...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RealtimeService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type RealtimeService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - userID string
+//   - lessonIDs ...uuid.UUID
+func (_e *RealtimeService_Expecter) Subscribe(userID interface{}, lessonIDs ...interface{}) *RealtimeService_Subscribe_Call {
+	return &RealtimeService_Subscribe_Call{Call: _e.mock.On("Subscribe",
+		append([]interface{}{userID}, lessonIDs...)...)}
+}
+
+func (_c *RealtimeService_Subscribe_Call) Run(run func(userID string, lessonIDs ...uuid.UUID)) *RealtimeService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]uuid.UUID, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(uuid.UUID)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RealtimeService_Subscribe_Call) Return(_a0 <-chan dto.MessageDTO, _a1 func(), _a2 error) *RealtimeService_Subscribe_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RealtimeService_Subscribe_Call) RunAndReturn(run func(string, ...uuid.UUID) (<-chan dto.MessageDTO, func(), error)) *RealtimeService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRealtimeService creates a new instance of RealtimeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRealtimeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RealtimeService {
+	mock := &RealtimeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/realtime/dto"
+	"sync"
+)
+
+// subscriberBuffer is how many messages a subscriber may fall behind before
+// it is dropped.
+const subscriberBuffer = 16
+
+type subscriber struct {
+	messages chan dto.MessageDTO
+	topics   []string
+	removed  bool
+}
+
+// hub fans messages out to the subscribers connected to this instance.
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+func newHub() *hub {
+	h := new(hub)
+	h.subscribers = make(map[string]map[*subscriber]struct{})
+	return h
+}
+
+// subscribe returns a channel receiving the messages of topics and a function
+// that stops the subscription. The channel is closed once the subscription
+// stops.
+func (h *hub) subscribe(topics []string) (<-chan dto.MessageDTO, func()) {
+	sub := &subscriber{messages: make(chan dto.MessageDTO, subscriberBuffer), topics: topics}
+
+	h.mu.Lock()
+	for _, topic := range topics {
+		if h.subscribers[topic] == nil {
+			h.subscribers[topic] = make(map[*subscriber]struct{})
+		}
+		h.subscribers[topic][sub] = struct{}{}
+	}
+	h.mu.Unlock()
+
+	return sub.messages, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(sub)
+	}
+}
+
+// broadcast never blocks. A subscriber whose buffer is full is dropped, so
+// its client reconnects and refetches instead of silently missing messages.
+func (h *hub) broadcast(topic string, message dto.MessageDTO) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[topic] {
+		select {
+		case sub.messages <- message:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// remove has to be called with mu held. Removing a subscriber twice is a
+// no-op.
+func (h *hub) remove(sub *subscriber) {
+	if sub.removed {
+		return
+	}
+
+	for _, topic := range sub.topics {
+		delete(h.subscribers[topic], sub)
+		if len(h.subscribers[topic]) == 0 {
+			delete(h.subscribers, topic)
+		}
+	}
+	sub.removed = true
+	close(sub.messages)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	"CodeWithAzri/internal/app/module/realtime/dto"
+	"CodeWithAzri/internal/app/module/realtime/repository"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+var ErrMessageTooLarge = errors.New("message is too large to publish")
+
+const (
+	progressSubscriberName = "realtime.progress"
+
+	// maxPayloadSize keeps envelopes under the 8000 byte limit Postgres puts
+	// on NOTIFY payloads.
+	maxPayloadSize = 7900
+)
+
+type RealtimeService interface {
+	PublishToUser(userID string, messageType message_type_enum.MessageType, data any) error
+	PublishToLesson(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any) error
+	Subscribe(userID string, lessonIDs ...uuid.UUID) (<-chan dto.MessageDTO, func(), error)
+	Deliver(payload string)
+}
+
+// LessonReader checks who may follow the comment threads of a lesson.
+type LessonReader interface {
+	GetLessonAccess(lessonID uuid.UUID, userID string) (courseDTO.LessonAccessDTO, error)
+}
+
+type Service struct {
+	repository repository.RealtimeRepository
+	lessons    LessonReader
+	hub        *hub
+}
+
+// NewRealtimeService pushes updates to the clients connected to this
+// instance. Messages are published through Postgres NOTIFY rather than handed
+// to the hub directly, and come back through Deliver on every instance, so a
+// user receives them whichever instance their devices are connected to.
+func NewRealtimeService(r repository.RealtimeRepository, events eventService.EventService, lessons LessonReader) RealtimeService {
+	s := new(Service)
+	s.repository = r
+	s.lessons = lessons
+	s.hub = newHub()
+	events.Subscribe(progressSubscriberName, eventService.Handle(s.progress), event_type_enum.LessonCompleted)
+	return s
+}
+
+// PublishToUser sends a message to every device userID has connected.
+func (s *Service) PublishToUser(userID string, messageType message_type_enum.MessageType, data any) error {
+	return s.publish(userTopic(userID), messageType, data)
+}
+
+// PublishToLesson sends a message to every client following the lesson.
+func (s *Service) PublishToLesson(lessonID uuid.UUID, messageType message_type_enum.MessageType, data any) error {
+	return s.publish(lessonTopic(lessonID), messageType, data)
+}
+
+// Subscribe receives the messages of userID and of the given lessons until
+// the returned function is called. The channel is also closed when the
+// subscriber falls too far behind. Following a lesson takes the same access
+// as watching it.
+func (s *Service) Subscribe(userID string, lessonIDs ...uuid.UUID) (<-chan dto.MessageDTO, func(), error) {
+	topics := []string{userTopic(userID)}
+	for _, lessonID := range lessonIDs {
+		_, err := s.lessons.GetLessonAccess(lessonID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		topics = append(topics, lessonTopic(lessonID))
+	}
+
+	messages, unsubscribe := s.hub.subscribe(topics)
+	return messages, unsubscribe, nil
+}
+
+// Deliver hands a message received over NOTIFY to the subscribers of its
+// topic on this instance.
+func (s *Service) Deliver(payload string) {
+	var envelope dto.EnvelopeDTO
+	err := json.Unmarshal([]byte(payload), &envelope)
+	if err != nil {
+		log.Printf("failed to decode realtime message: %v\n", err)
+		return
+	}
+
+	s.hub.broadcast(envelope.Topic, envelope.Message)
+}
+
+func (s *Service) publish(topic string, messageType message_type_enum.MessageType, data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode realtime message: %v", err)
+	}
+
+	payload, err := json.Marshal(dto.EnvelopeDTO{
+		Topic:   topic,
+		Message: dto.MessageDTO{Type: messageType, Data: encoded},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode realtime message: %v", err)
+	}
+
+	if len(payload) > maxPayloadSize {
+		return ErrMessageTooLarge
+	}
+
+	return s.repository.Notify(string(payload))
+}
+
+// progress keeps the other devices of a user in sync when they complete a
+// lesson.
+func (s *Service) progress(ctx context.Context, event eventDTO.EventDTO, payload eventDTO.LessonCompletedPayload) error {
+	return s.PublishToUser(payload.UserID, message_type_enum.Progress, dto.ProgressDTO{
+		CourseID:    payload.CourseID,
+		LessonID:    payload.LessonID,
+		CompletedAt: event.OccurredAt,
+	})
+}
+
+func userTopic(userID string) string {
+	return "user:" + userID
+}
+
+func lessonTopic(lessonID uuid.UUID) string {
+	return "lesson:" + lessonID.String()
+}
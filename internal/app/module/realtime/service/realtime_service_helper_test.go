@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	eventMocks "CodeWithAzri/internal/app/module/event/service/mocks"
+	"CodeWithAzri/internal/app/module/realtime/dto"
+	repositoryMocks "CodeWithAzri/internal/app/module/realtime/repository/mocks"
+	"CodeWithAzri/internal/app/module/realtime/service"
+	"CodeWithAzri/internal/app/module/realtime/service/mocks"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// realtimeTest holds the subscriber the service registers, so tests can run
+// it like the event dispatcher would.
+type realtimeTest struct {
+	repository *repositoryMocks.RealtimeRepository
+	lessons    *mocks.LessonReader
+	service    service.RealtimeService
+	progress   eventService.Subscriber
+}
+
+func initializeService(t *testing.T) realtimeTest {
+	test := realtimeTest{
+		repository: repositoryMocks.NewRealtimeRepository(t),
+		lessons:    mocks.NewLessonReader(t),
+	}
+	events := eventMocks.NewEventService(t)
+
+	events.On("Subscribe", "realtime.progress", mock.AnythingOfType("service.Subscriber"), event_type_enum.LessonCompleted).
+		Run(func(args mock.Arguments) {
+			test.progress = args.Get(1).(eventService.Subscriber)
+		}).Once()
+
+	test.service = service.NewRealtimeService(test.repository, events, test.lessons)
+	return test
+}
+
+func envelope(t *testing.T, topic string, message dto.MessageDTO) string {
+	encoded, err := json.Marshal(dto.EnvelopeDTO{Topic: topic, Message: message})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(encoded)
+}
+
+func event(payload any) eventDTO.EventDTO {
+	encoded, _ := json.Marshal(payload)
+	return eventDTO.EventDTO{Type: event_type_enum.LessonCompleted, Payload: encoded, OccurredAt: 121212}
+}
@@ -0,0 +1,162 @@
+package service_test
+
+import (
+	courseDTO "CodeWithAzri/internal/app/module/course/dto"
+	courseService "CodeWithAzri/internal/app/module/course/service"
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	"CodeWithAzri/internal/app/module/realtime/dto"
+	"CodeWithAzri/internal/app/module/realtime/service"
+	message_type_enum "CodeWithAzri/pkg/enums/messageType"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var MockLessonID = uuid.MustParse("d60619ae-cee9-4877-8f5d-8b294fe9cd80")
+
+var MockMessage dto.MessageDTO = dto.MessageDTO{
+	Type: message_type_enum.Notification,
+	Data: []byte(`{"title":"New lesson in Go for Beginners"}`),
+}
+
+func TestService_PublishToUser(t *testing.T) {
+	test := initializeService(t)
+
+	t.Run("Publish To User Success", func(t *testing.T) {
+		test.repository.On("Notify", envelope(t, "user:user123", MockMessage)).Return(nil).Once()
+
+		err := test.service.PublishToUser("user123", message_type_enum.Notification, map[string]string{"title": "New lesson in Go for Beginners"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Publish To User Too Large", func(t *testing.T) {
+		err := test.service.PublishToUser("user123", message_type_enum.Notification, strings.Repeat("a", 8000))
+
+		assert.ErrorIs(t, err, service.ErrMessageTooLarge)
+	})
+
+	t.Run("Publish To User Encode Error", func(t *testing.T) {
+		err := test.service.PublishToUser("user123", message_type_enum.Notification, make(chan int))
+
+		assert.ErrorContains(t, err, "failed to encode realtime message")
+	})
+
+	t.Run("Publish To User Error", func(t *testing.T) {
+		test.repository.On("Notify", envelope(t, "user:user123", MockMessage)).Return(errors.New("Repository Failure")).Once()
+
+		err := test.service.PublishToUser("user123", message_type_enum.Notification, map[string]string{"title": "New lesson in Go for Beginners"})
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_PublishToLesson(t *testing.T) {
+	test := initializeService(t)
+
+	t.Run("Publish To Lesson Success", func(t *testing.T) {
+		test.repository.On("Notify", envelope(t, "lesson:"+MockLessonID.String(), MockMessage)).Return(nil).Once()
+
+		err := test.service.PublishToLesson(MockLessonID, message_type_enum.Notification, map[string]string{"title": "New lesson in Go for Beginners"})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Deliver(t *testing.T) {
+	t.Run("Deliver To Subscribers Of The Topic", func(t *testing.T) {
+		test := initializeService(t)
+		test.lessons.On("GetLessonAccess", MockLessonID, "user456").Return(courseDTO.LessonAccessDTO{LessonID: MockLessonID}, nil).Once()
+		user, unsubscribeUser, _ := test.service.Subscribe("user123")
+		defer unsubscribeUser()
+		lesson, unsubscribeLesson, _ := test.service.Subscribe("user456", MockLessonID)
+		defer unsubscribeLesson()
+
+		test.service.Deliver(envelope(t, "user:user123", MockMessage))
+		test.service.Deliver(envelope(t, "lesson:"+MockLessonID.String(), MockMessage))
+
+		assert.Equal(t, MockMessage, <-user)
+		assert.Equal(t, MockMessage, <-lesson)
+		assert.Empty(t, user)
+		assert.Empty(t, lesson)
+	})
+
+	t.Run("Deliver Skips Invalid Payload", func(t *testing.T) {
+		test := initializeService(t)
+		user, unsubscribe, _ := test.service.Subscribe("user123")
+		defer unsubscribe()
+
+		test.service.Deliver("not json")
+
+		assert.Empty(t, user)
+	})
+
+	t.Run("Deliver Drops Slow Subscriber", func(t *testing.T) {
+		test := initializeService(t)
+		user, unsubscribe, _ := test.service.Subscribe("user123")
+		defer unsubscribe()
+
+		for i := 0; i < 17; i++ {
+			test.service.Deliver(envelope(t, "user:user123", MockMessage))
+		}
+
+		received := 0
+		for range user {
+			received++
+		}
+		assert.Equal(t, 16, received)
+	})
+
+	t.Run("Unsubscribe Closes The Channel", func(t *testing.T) {
+		test := initializeService(t)
+		user, unsubscribe, _ := test.service.Subscribe("user123")
+
+		unsubscribe()
+		unsubscribe()
+		test.service.Deliver(envelope(t, "user:user123", MockMessage))
+
+		_, ok := <-user
+		assert.False(t, ok)
+	})
+}
+
+func TestService_Subscribe(t *testing.T) {
+	test := initializeService(t)
+
+	t.Run("Subscribe To A Lesson Without Access", func(t *testing.T) {
+		test.lessons.On("GetLessonAccess", MockLessonID, "user123").Return(courseDTO.LessonAccessDTO{}, courseService.ErrNotEnrolled).Once()
+
+		messages, unsubscribe, err := test.service.Subscribe("user123", MockLessonID)
+
+		assert.ErrorIs(t, err, courseService.ErrNotEnrolled)
+		assert.Nil(t, messages)
+		assert.Nil(t, unsubscribe)
+	})
+}
+
+func TestService_Progress(t *testing.T) {
+	courseID := uuid.MustParse("18a95d2f-a941-4a64-bbe5-256be7626db2")
+	completed := event(eventDTO.LessonCompletedPayload{CourseID: courseID, LessonID: MockLessonID, UserID: "user123"})
+	message := dto.MessageDTO{
+		Type: message_type_enum.Progress,
+		Data: []byte(`{"course_id":"` + courseID.String() + `","lesson_id":"` + MockLessonID.String() + `","completed_at":121212}`),
+	}
+
+	t.Run("Progress Pushed To The User", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("Notify", envelope(t, "user:user123", message)).Return(nil).Once()
+
+		assert.NoError(t, test.progress(context.Background(), completed))
+	})
+
+	t.Run("Progress Error", func(t *testing.T) {
+		test := initializeService(t)
+		test.repository.On("Notify", envelope(t, "user:user123", message)).Return(errors.New("Repository Failure")).Once()
+
+		assert.EqualError(t, test.progress(context.Background(), completed), "Repository Failure")
+	})
+}
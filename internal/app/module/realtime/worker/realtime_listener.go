@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"CodeWithAzri/internal/app/module/realtime/repository"
+	"CodeWithAzri/internal/app/module/realtime/service"
+	"context"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Listener is the part of *pq.Listener the worker needs.
+type Listener interface {
+	Listen(channel string) error
+	NotificationChannel() <-chan *pq.Notification
+	Ping() error
+	Close() error
+}
+
+// RealtimeListener receives the messages published by every server instance
+// and delivers them to the clients connected to this one.
+type RealtimeListener struct {
+	service      service.RealtimeService
+	listener     Listener
+	pingInterval time.Duration
+}
+
+// NewPostgresListener opens a listener that reconnects on its own when the
+// connection drops.
+func NewPostgresListener(connStr string) *pq.Listener {
+	return pq.NewListener(connStr, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("realtime listener: %v\n", err)
+		}
+	})
+}
+
+// NewRealtimeListener creates a new RealtimeListener instance.
+func NewRealtimeListener(s service.RealtimeService, l Listener, pingInterval time.Duration) *RealtimeListener {
+	w := new(RealtimeListener)
+	w.service = s
+	w.listener = l
+	w.pingInterval = pingInterval
+	return w
+}
+
+// Start delivers messages until the context is cancelled. Pinging an idle
+// connection makes the listener notice a dead one and reconnect.
+func (w *RealtimeListener) Start(ctx context.Context) {
+	defer w.listener.Close()
+
+	err := w.listener.Listen(repository.Channel)
+	if err != nil {
+		log.Printf("failed to listen on %s: %v\n", repository.Channel, err)
+		return
+	}
+
+	ticker := time.NewTicker(w.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-w.listener.NotificationChannel():
+			// A nil notification means the connection was re-established.
+			// Messages sent while it was down are lost; clients catch up
+			// through the REST endpoints.
+			if notification != nil {
+				w.service.Deliver(notification.Extra)
+			}
+		case <-ticker.C:
+			err := w.listener.Ping()
+			if err != nil {
+				log.Printf("failed to ping realtime listener: %v\n", err)
+			}
+		}
+	}
+}
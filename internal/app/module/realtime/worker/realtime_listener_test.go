@@ -0,0 +1,82 @@
+package worker_test
+
+import (
+	"CodeWithAzri/internal/app/module/realtime/service/mocks"
+	"CodeWithAzri/internal/app/module/realtime/worker"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeListener stands in for *pq.Listener.
+type fakeListener struct {
+	listenErr     error
+	notifications chan *pq.Notification
+	channel       string
+	pings         int
+	closed        bool
+}
+
+func (l *fakeListener) Listen(channel string) error {
+	l.channel = channel
+	return l.listenErr
+}
+
+func (l *fakeListener) NotificationChannel() <-chan *pq.Notification {
+	return l.notifications
+}
+
+func (l *fakeListener) Ping() error {
+	l.pings++
+	return errors.New("connection lost")
+}
+
+func (l *fakeListener) Close() error {
+	l.closed = true
+	return nil
+}
+
+func TestRealtimeListener_Start(t *testing.T) {
+	t.Run("Start Delivers Notifications", func(t *testing.T) {
+		mockService := mocks.NewRealtimeService(t)
+		listener := &fakeListener{notifications: make(chan *pq.Notification, 2)}
+		listenerWorker := worker.NewRealtimeListener(mockService, listener, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		listener.notifications <- nil
+		listener.notifications <- &pq.Notification{Channel: "realtime", Extra: `{"topic":"user:user123"}`}
+		mockService.On("Deliver", `{"topic":"user:user123"}`).Run(func(args mock.Arguments) { cancel() }).Once()
+
+		listenerWorker.Start(ctx)
+
+		assert.Equal(t, "realtime", listener.channel)
+		assert.True(t, listener.closed)
+	})
+
+	t.Run("Start Pings An Idle Connection", func(t *testing.T) {
+		mockService := mocks.NewRealtimeService(t)
+		listener := &fakeListener{notifications: make(chan *pq.Notification)}
+		listenerWorker := worker.NewRealtimeListener(mockService, listener, time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		listenerWorker.Start(ctx)
+
+		assert.Greater(t, listener.pings, 0)
+	})
+
+	t.Run("Start Listen Error", func(t *testing.T) {
+		mockService := mocks.NewRealtimeService(t)
+		listener := &fakeListener{listenErr: errors.New("connection refused")}
+		listenerWorker := worker.NewRealtimeListener(mockService, listener, time.Hour)
+
+		assert.NotPanics(t, func() { listenerWorker.Start(context.Background()) })
+		assert.True(t, listener.closed)
+	})
+}
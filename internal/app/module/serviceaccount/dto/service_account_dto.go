@@ -0,0 +1,45 @@
+package dto
+
+import (
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+
+	"github.com/google/uuid"
+)
+
+type CreateServiceAccountDTO struct {
+	Name   string                    `json:"name" validate:"required,max=255"`
+	Scopes []api_scope_enum.APIScope `json:"scopes" validate:"required,min=1"`
+}
+
+type UpdateServiceAccountDTO struct {
+	Name   string                    `json:"name" validate:"required,max=255"`
+	Scopes []api_scope_enum.APIScope `json:"scopes" validate:"required,min=1"`
+	Active bool                      `json:"active"`
+}
+
+// RotateAPIKeyDTO sets how long the keys being replaced keep working, so
+// clients can switch over without downtime.
+type RotateAPIKeyDTO struct {
+	GracePeriodMinutes int `json:"grace_period_minutes" validate:"min=0,max=10080"`
+}
+
+type ServiceAccountDTO struct {
+	ID        uuid.UUID                 `json:"id"`
+	Name      string                    `json:"name"`
+	Scopes    []api_scope_enum.APIScope `json:"scopes"`
+	Active    bool                      `json:"active"`
+	CreatedBy string                    `json:"created_by"`
+	CreatedAt int64                     `json:"created_at"`
+	UpdatedAt int64                     `json:"updated_at"`
+	Keys      []APIKeyDTO               `json:"keys"`
+}
+
+// APIKeyDTO only carries the key itself when the key is issued.
+type APIKeyDTO struct {
+	ID         uuid.UUID `json:"id"`
+	Prefix     string    `json:"prefix"`
+	Key        string    `json:"key,omitempty"`
+	ExpiresAt  *int64    `json:"expires_at,omitempty"`
+	LastUsedAt *int64    `json:"last_used_at,omitempty"`
+	CreatedAt  int64     `json:"created_at"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ServiceAccount is a machine client, such as a batch job or a partner
+// system. It calls the API with one of its keys and only reaches the route
+// groups named by its scopes.
+type ServiceAccount struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey"`
+	Name      string         `json:"name" gorm:"type:varchar(255);not null"`
+	Scopes    pq.StringArray `json:"scopes" gorm:"type:text[];not null;default:'{}'"`
+	Active    bool           `json:"active" gorm:"not null;default:true"`
+	CreatedBy string         `json:"created_by" gorm:"type:varchar(255);not null"`
+	CreatedAt int64          `json:"created_at"`
+	UpdatedAt int64          `json:"updated_at"`
+}
+
+// APIKey is a key of a service account, sent as "cwa_{prefix}_{secret}".
+// Prefix finds the key and only a hash of the secret is kept. A key stops
+// working at ExpiresAt, which is set when it is rotated out or revoked.
+type APIKey struct {
+	ID               uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+	ServiceAccountID uuid.UUID `json:"service_account_id" gorm:"type:uuid;not null;index"`
+	Prefix           string    `json:"prefix" gorm:"type:varchar(32);not null;uniqueIndex"`
+	SecretHash       string    `json:"-" gorm:"type:varchar(64);not null"`
+	ExpiresAt        *int64    `json:"expires_at"`
+	LastUsedAt       *int64    `json:"last_used_at"`
+	CreatedAt        int64     `json:"created_at"`
+}
@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/dto"
+	"CodeWithAzri/internal/app/module/serviceaccount/service"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	service  service.ServiceAccountService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.ServiceAccountService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// CreateServiceAccount godoc
+//
+//	@Summary		Create a service account
+//	@Tags			Admin
+//	@Description	Create a service account for a machine client, with its first API key. The key is only returned here and is sent in the X-API-Key header instead of a Bearer token. Scopes name the route groups the account may call: courses, media, admin:users, admin:jobs, admin:webhooks, admin:moderation, admin:audit and admin:tags. Requires the admin claim.
+//	@ID				create-service-account
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.CreateServiceAccountDTO	true	"Service account name and scopes"
+//	@Param			Authorization	header	string						true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.ServiceAccountDTO}	"Successful response with the service account and its key"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/service-accounts [post]
+func (h *Handler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var d dto.CreateServiceAccountDTO
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	account, err := h.service.CreateServiceAccount(d, requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Service Account Created Successfully", "Success", account, w)
+}
+
+// GetServiceAccounts godoc
+//
+//	@Summary		List service accounts
+//	@Tags			Admin
+//	@Description	List service accounts from newest to oldest, with their keys. Requires the admin claim.
+//	@ID				get-service-accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.ServiceAccountDTO}	"Successful response with service accounts"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/service-accounts [get]
+func (h *Handler) GetServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+
+	accounts, err := h.service.GetServiceAccounts(limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Service Accounts Fetched Successfully", "Success", accounts, w)
+}
+
+// GetServiceAccount godoc
+//
+//	@Summary		Get a service account
+//	@Tags			Admin
+//	@Description	Fetch a service account with its keys and when they were last used. Requires the admin claim.
+//	@ID				get-service-account
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Service account ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.ServiceAccountDTO}	"Successful response with the service account"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError							"Service account not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/service-accounts/{id} [get]
+func (h *Handler) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	account, err := h.service.GetServiceAccount(accountID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Service Account Fetched Successfully", "Success", account, w)
+}
+
+// UpdateServiceAccount godoc
+//
+//	@Summary		Update a service account
+//	@Tags			Admin
+//	@Description	Rename a service account, change its scopes, or deactivate it. The keys of an inactive account are rejected. Requires the admin claim.
+//	@ID				update-service-account
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string						true	"Service account ID"
+//	@Param			input			body	dto.UpdateServiceAccountDTO	true	"Service account name, scopes and whether it is active"
+//	@Param			Authorization	header	string						true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.ServiceAccountDTO}	"Successful response with the service account"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError							"Service account not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/service-accounts/{id} [put]
+func (h *Handler) UpdateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.UpdateServiceAccountDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	account, err := h.service.UpdateServiceAccount(accountID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Service Account Updated Successfully", "Success", account, w)
+}
+
+// DeleteServiceAccount godoc
+//
+//	@Summary		Delete a service account
+//	@Tags			Admin
+//	@Description	Delete a service account together with its keys. Requires the admin claim.
+//	@ID				delete-service-account
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Service account ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError	"Service account not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/admin/service-accounts/{id} [delete]
+func (h *Handler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.DeleteServiceAccount(accountID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Service Account Deleted Successfully", "Success", nil, w)
+}
+
+// RotateKey godoc
+//
+//	@Summary		Rotate the API key of a service account
+//	@Tags			Admin
+//	@Description	Issue a new API key. The account's other keys keep working for the grace period, 0 to 10080 minutes, and stop working after it. The new key is only returned here. Requires the admin claim.
+//	@ID				rotate-service-account-key
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string				true	"Service account ID"
+//	@Param			input			body	dto.RotateAPIKeyDTO	true	"Grace period of the replaced keys"
+//	@Param			Authorization	header	string				true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.APIKeyDTO}	"Successful response with the new key"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError					"Service account not found"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/admin/service-accounts/{id}/keys [post]
+func (h *Handler) RotateKey(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.RotateAPIKeyDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	key, err := h.service.RotateKey(accountID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "API Key Rotated Successfully", "Success", key, w)
+}
+
+// RevokeKey godoc
+//
+//	@Summary		Revoke an API key
+//	@Tags			Admin
+//	@Description	Make an API key of a service account stop working right away. Requires the admin claim.
+//	@ID				revoke-service-account-key
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Service account ID"
+//	@Param			keyId			path	string	true	"API key ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError	"Service account or API key not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/admin/service-accounts/{id}/keys/{keyId} [delete]
+func (h *Handler) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	keyID, err := uuid.Parse(requestPkg.GetURLParam(r, "keyId"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.RevokeKey(accountID, keyID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "API Key Revoked Successfully", "Success", nil, w)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrServiceAccountNotFound), errors.Is(err, service.ErrAPIKeyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidScope):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
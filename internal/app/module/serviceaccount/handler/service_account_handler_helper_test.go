@@ -0,0 +1,44 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/dto"
+	"CodeWithAzri/internal/app/module/serviceaccount/handler"
+	"CodeWithAzri/internal/app/module/serviceaccount/service/mocks"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"net/http"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var MockKeyDTO dto.APIKeyDTO = dto.APIKeyDTO{
+	ID:        uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d61"),
+	Prefix:    "0a1b2c3d4e5f",
+	CreatedAt: 121212,
+}
+
+var MockServiceAccountDTO dto.ServiceAccountDTO = dto.ServiceAccountDTO{
+	ID:        uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d51"),
+	Name:      "Catalogue sync",
+	Scopes:    []api_scope_enum.APIScope{api_scope_enum.Courses},
+	Active:    true,
+	CreatedBy: "admin-1",
+	CreatedAt: 121212,
+	UpdatedAt: 121212,
+	Keys:      []dto.APIKeyDTO{MockKeyDTO},
+}
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.ServiceAccountService) {
+	mockService := mocks.NewServiceAccountService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
+
+func patchServiceAccountRequest(params map[string]string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return params[key]
+	})
+}
@@ -0,0 +1,246 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/dto"
+	"CodeWithAzri/internal/app/module/serviceaccount/service"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/requestPkg"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_CreateServiceAccount(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+	monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string { return "admin-1" })
+	defer monkey.UnpatchAll()
+
+	t.Run("Create Service Account Successfully", func(t *testing.T) {
+		created := MockServiceAccountDTO
+		key := MockKeyDTO
+		key.Key = "cwa_0a1b2c3d4e5f_secret"
+		created.Keys = []dto.APIKeyDTO{key}
+		mockService.On("CreateServiceAccount", dto.CreateServiceAccountDTO{
+			Name:   "Catalogue sync",
+			Scopes: []api_scope_enum.APIScope{api_scope_enum.Courses},
+		}, "admin-1").Return(created, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/service-accounts", bytes.NewBufferString(`{"name": "Catalogue sync", "scopes": ["courses"]}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.CreateServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"key":"cwa_0a1b2c3d4e5f_secret"`)
+	})
+
+	t.Run("Create Service Account Without Scopes", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/admin/service-accounts", bytes.NewBufferString(`{"name": "Catalogue sync", "scopes": []}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.CreateServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Service Account Invalid Scope", func(t *testing.T) {
+		mockService.On("CreateServiceAccount", dto.CreateServiceAccountDTO{
+			Name:   "Catalogue sync",
+			Scopes: []api_scope_enum.APIScope{"admin"},
+		}, "admin-1").Return(dto.ServiceAccountDTO{}, fmt.Errorf("%w: admin", service.ErrInvalidScope)).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/service-accounts", bytes.NewBufferString(`{"name": "Catalogue sync", "scopes": ["admin"]}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.CreateServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_GetServiceAccounts(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Service Accounts Successfully", func(t *testing.T) {
+		mockService.On("GetServiceAccounts", 5, 2).Return([]dto.ServiceAccountDTO{MockServiceAccountDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/service-accounts?page=2&limit=5", nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.GetServiceAccounts(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), `"key"`)
+	})
+
+	t.Run("Get Service Accounts Error", func(t *testing.T) {
+		mockService.On("GetServiceAccounts", 10, 1).Return(nil, errors.New("query failed")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/service-accounts", nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.GetServiceAccounts(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_GetServiceAccount(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Service Account Successfully", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+		mockService.On("GetServiceAccount", MockServiceAccountDTO.ID).Return(MockServiceAccountDTO, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.GetServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Service Account Not Found", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+		mockService.On("GetServiceAccount", MockServiceAccountDTO.ID).Return(dto.ServiceAccountDTO{}, service.ErrServiceAccountNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.GetServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Service Account Invalid ID", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": "not-a-uuid"})
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/service-accounts/not-a-uuid", nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.GetServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_UpdateServiceAccount(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+	defer monkey.UnpatchAll()
+
+	t.Run("Update Service Account Successfully", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+		input := dto.UpdateServiceAccountDTO{Name: "Catalogue sync", Scopes: []api_scope_enum.APIScope{api_scope_enum.Media}}
+		updated := MockServiceAccountDTO
+		updated.Active = false
+		mockService.On("UpdateServiceAccount", MockServiceAccountDTO.ID, input).Return(updated, nil).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String(), bytes.NewBufferString(`{"name": "Catalogue sync", "scopes": ["media"], "active": false}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.UpdateServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Update Service Account Invalid Body", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+
+		req, _ := http.NewRequest("PUT", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String(), bytes.NewBufferString(`{"scopes": ["media"]}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.UpdateServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_DeleteServiceAccount(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+	defer monkey.UnpatchAll()
+
+	t.Run("Delete Service Account Successfully", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+		mockService.On("DeleteServiceAccount", MockServiceAccountDTO.ID).Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.DeleteServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Delete Service Account Not Found", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+		mockService.On("DeleteServiceAccount", MockServiceAccountDTO.ID).Return(service.ErrServiceAccountNotFound).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.DeleteServiceAccount(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_RotateKey(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+	defer monkey.UnpatchAll()
+
+	t.Run("Rotate Key Successfully", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+		rotated := MockKeyDTO
+		rotated.Key = "cwa_0a1b2c3d4e5f_secret"
+		mockService.On("RotateKey", MockServiceAccountDTO.ID, dto.RotateAPIKeyDTO{GracePeriodMinutes: 60}).Return(rotated, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String()+"/keys", bytes.NewBufferString(`{"grace_period_minutes": 60}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.RotateKey(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"key":"cwa_0a1b2c3d4e5f_secret"`)
+	})
+
+	t.Run("Rotate Key Grace Period Too Long", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String()})
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String()+"/keys", bytes.NewBufferString(`{"grace_period_minutes": 10081}`))
+		recorder := httptest.NewRecorder()
+		accountHandler.RotateKey(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_RevokeKey(t *testing.T) {
+	accountHandler, mockService := initializeHandler(t)
+	defer monkey.UnpatchAll()
+
+	t.Run("Revoke Key Successfully", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String(), "keyId": MockKeyDTO.ID.String()})
+		mockService.On("RevokeKey", MockServiceAccountDTO.ID, MockKeyDTO.ID).Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String()+"/keys/"+MockKeyDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.RevokeKey(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Revoke Key Not Found", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String(), "keyId": MockKeyDTO.ID.String()})
+		mockService.On("RevokeKey", MockServiceAccountDTO.ID, MockKeyDTO.ID).Return(service.ErrAPIKeyNotFound).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String()+"/keys/"+MockKeyDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.RevokeKey(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Revoke Key Invalid Key ID", func(t *testing.T) {
+		patchServiceAccountRequest(map[string]string{"id": MockServiceAccountDTO.ID.String(), "keyId": "bad"})
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/service-accounts/"+MockServiceAccountDTO.ID.String()+"/keys/bad", nil)
+		recorder := httptest.NewRecorder()
+		accountHandler.RevokeKey(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
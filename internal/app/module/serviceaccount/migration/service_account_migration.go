@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type ServiceAccountMigration struct{}
+
+func (m ServiceAccountMigration) CreateServiceAccountTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.ServiceAccount{},
+		entity.APIKey{},
+	)
+}
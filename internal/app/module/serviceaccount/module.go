@@ -0,0 +1,27 @@
+package serviceaccount
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/handler"
+	"CodeWithAzri/internal/app/module/serviceaccount/migration"
+	"CodeWithAzri/internal/app/module/serviceaccount/repository"
+	"CodeWithAzri/internal/app/module/serviceaccount/service"
+	"database/sql"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.ServiceAccountService
+	Repository repository.ServiceAccountRepository
+	Migration  *migration.ServiceAccountMigration
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewService(m.Repository)
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.ServiceAccountMigration{}
+	return m
+}
@@ -0,0 +1,558 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/serviceaccount/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServiceAccountRepository is an autogenerated mock type for the ServiceAccountRepository type
+type ServiceAccountRepository struct {
+	mock.Mock
+}
+
+type ServiceAccountRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAccountRepository) EXPECT() *ServiceAccountRepository_Expecter {
+	return &ServiceAccountRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: account, key
+func (_m *ServiceAccountRepository) Create(account entity.ServiceAccount, key entity.APIKey) error {
+	ret := _m.Called(account, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.ServiceAccount, entity.APIKey) error); ok {
+		r0 = rf(account, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ServiceAccountRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - account entity.ServiceAccount
+//   - key entity.APIKey
+func (_e *ServiceAccountRepository_Expecter) Create(account interface{}, key interface{}) *ServiceAccountRepository_Create_Call {
+	return &ServiceAccountRepository_Create_Call{Call: _e.mock.On("Create", account, key)}
+}
+
+func (_c *ServiceAccountRepository_Create_Call) Run(run func(account entity.ServiceAccount, key entity.APIKey)) *ServiceAccountRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.ServiceAccount), args[1].(entity.APIKey))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Create_Call) Return(_a0 error) *ServiceAccountRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Create_Call) RunAndReturn(run func(entity.ServiceAccount, entity.APIKey) error) *ServiceAccountRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *ServiceAccountRepository) Delete(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ServiceAccountRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *ServiceAccountRepository_Expecter) Delete(id interface{}) *ServiceAccountRepository_Delete_Call {
+	return &ServiceAccountRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *ServiceAccountRepository_Delete_Call) Run(run func(id uuid.UUID)) *ServiceAccountRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Delete_Call) Return(_a0 error) *ServiceAccountRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error) *ServiceAccountRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireKey provides a mock function with given fields: id, accountID, expiresAt
+func (_m *ServiceAccountRepository) ExpireKey(id uuid.UUID, accountID uuid.UUID, expiresAt int64) (bool, error) {
+	ret := _m.Called(id, accountID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int64) (bool, error)); ok {
+		return rf(id, accountID, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int64) bool); ok {
+		r0 = rf(id, accountID, expiresAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, int64) error); ok {
+		r1 = rf(id, accountID, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_ExpireKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireKey'
+type ServiceAccountRepository_ExpireKey_Call struct {
+	*mock.Call
+}
+
+// ExpireKey is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - accountID uuid.UUID
+//   - expiresAt int64
+func (_e *ServiceAccountRepository_Expecter) ExpireKey(id interface{}, accountID interface{}, expiresAt interface{}) *ServiceAccountRepository_ExpireKey_Call {
+	return &ServiceAccountRepository_ExpireKey_Call{Call: _e.mock.On("ExpireKey", id, accountID, expiresAt)}
+}
+
+func (_c *ServiceAccountRepository_ExpireKey_Call) Run(run func(id uuid.UUID, accountID uuid.UUID, expiresAt int64)) *ServiceAccountRepository_ExpireKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ExpireKey_Call) Return(_a0 bool, _a1 error) *ServiceAccountRepository_ExpireKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ExpireKey_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, int64) (bool, error)) *ServiceAccountRepository_ExpireKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadKeyByPrefix provides a mock function with given fields: prefix
+func (_m *ServiceAccountRepository) ReadKeyByPrefix(prefix string) (entity.APIKey, error) {
+	ret := _m.Called(prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadKeyByPrefix")
+	}
+
+	var r0 entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (entity.APIKey, error)); ok {
+		return rf(prefix)
+	}
+	if rf, ok := ret.Get(0).(func(string) entity.APIKey); ok {
+		r0 = rf(prefix)
+	} else {
+		r0 = ret.Get(0).(entity.APIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_ReadKeyByPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadKeyByPrefix'
+type ServiceAccountRepository_ReadKeyByPrefix_Call struct {
+	*mock.Call
+}
+
+// ReadKeyByPrefix is a helper method to define mock.On call
+//   - prefix string
+func (_e *ServiceAccountRepository_Expecter) ReadKeyByPrefix(prefix interface{}) *ServiceAccountRepository_ReadKeyByPrefix_Call {
+	return &ServiceAccountRepository_ReadKeyByPrefix_Call{Call: _e.mock.On("ReadKeyByPrefix", prefix)}
+}
+
+func (_c *ServiceAccountRepository_ReadKeyByPrefix_Call) Run(run func(prefix string)) *ServiceAccountRepository_ReadKeyByPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadKeyByPrefix_Call) Return(_a0 entity.APIKey, _a1 error) *ServiceAccountRepository_ReadKeyByPrefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadKeyByPrefix_Call) RunAndReturn(run func(string) (entity.APIKey, error)) *ServiceAccountRepository_ReadKeyByPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadKeys provides a mock function with given fields: accountIDs
+func (_m *ServiceAccountRepository) ReadKeys(accountIDs []uuid.UUID) ([]entity.APIKey, error) {
+	ret := _m.Called(accountIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadKeys")
+	}
+
+	var r0 []entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]entity.APIKey, error)); ok {
+		return rf(accountIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []entity.APIKey); ok {
+		r0 = rf(accountIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(accountIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_ReadKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadKeys'
+type ServiceAccountRepository_ReadKeys_Call struct {
+	*mock.Call
+}
+
+// ReadKeys is a helper method to define mock.On call
+//   - accountIDs []uuid.UUID
+func (_e *ServiceAccountRepository_Expecter) ReadKeys(accountIDs interface{}) *ServiceAccountRepository_ReadKeys_Call {
+	return &ServiceAccountRepository_ReadKeys_Call{Call: _e.mock.On("ReadKeys", accountIDs)}
+}
+
+func (_c *ServiceAccountRepository_ReadKeys_Call) Run(run func(accountIDs []uuid.UUID)) *ServiceAccountRepository_ReadKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadKeys_Call) Return(_a0 []entity.APIKey, _a1 error) *ServiceAccountRepository_ReadKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadKeys_Call) RunAndReturn(run func([]uuid.UUID) ([]entity.APIKey, error)) *ServiceAccountRepository_ReadKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMany provides a mock function with given fields: limit, offset
+func (_m *ServiceAccountRepository) ReadMany(limit int, offset int) ([]entity.ServiceAccount, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMany")
+	}
+
+	var r0 []entity.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]entity.ServiceAccount, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []entity.ServiceAccount); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
+type ServiceAccountRepository_ReadMany_Call struct {
+	*mock.Call
+}
+
+// ReadMany is a helper method to define mock.On call
+//   - limit int
+//   - offset int
+func (_e *ServiceAccountRepository_Expecter) ReadMany(limit interface{}, offset interface{}) *ServiceAccountRepository_ReadMany_Call {
+	return &ServiceAccountRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", limit, offset)}
+}
+
+func (_c *ServiceAccountRepository_ReadMany_Call) Run(run func(limit int, offset int)) *ServiceAccountRepository_ReadMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadMany_Call) Return(_a0 []entity.ServiceAccount, _a1 error) *ServiceAccountRepository_ReadMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadMany_Call) RunAndReturn(run func(int, int) ([]entity.ServiceAccount, error)) *ServiceAccountRepository_ReadMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOne provides a mock function with given fields: id
+func (_m *ServiceAccountRepository) ReadOne(id uuid.UUID) (entity.ServiceAccount, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOne")
+	}
+
+	var r0 entity.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.ServiceAccount, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.ServiceAccount); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_ReadOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOne'
+type ServiceAccountRepository_ReadOne_Call struct {
+	*mock.Call
+}
+
+// ReadOne is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *ServiceAccountRepository_Expecter) ReadOne(id interface{}) *ServiceAccountRepository_ReadOne_Call {
+	return &ServiceAccountRepository_ReadOne_Call{Call: _e.mock.On("ReadOne", id)}
+}
+
+func (_c *ServiceAccountRepository_ReadOne_Call) Run(run func(id uuid.UUID)) *ServiceAccountRepository_ReadOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadOne_Call) Return(_a0 entity.ServiceAccount, _a1 error) *ServiceAccountRepository_ReadOne_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_ReadOne_Call) RunAndReturn(run func(uuid.UUID) (entity.ServiceAccount, error)) *ServiceAccountRepository_ReadOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateKey provides a mock function with given fields: key, expiresAt
+func (_m *ServiceAccountRepository) RotateKey(key entity.APIKey, expiresAt int64) error {
+	ret := _m.Called(key, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.APIKey, int64) error); ok {
+		r0 = rf(key, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_RotateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateKey'
+type ServiceAccountRepository_RotateKey_Call struct {
+	*mock.Call
+}
+
+// RotateKey is a helper method to define mock.On call
+//   - key entity.APIKey
+//   - expiresAt int64
+func (_e *ServiceAccountRepository_Expecter) RotateKey(key interface{}, expiresAt interface{}) *ServiceAccountRepository_RotateKey_Call {
+	return &ServiceAccountRepository_RotateKey_Call{Call: _e.mock.On("RotateKey", key, expiresAt)}
+}
+
+func (_c *ServiceAccountRepository_RotateKey_Call) Run(run func(key entity.APIKey, expiresAt int64)) *ServiceAccountRepository_RotateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.APIKey), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_RotateKey_Call) Return(_a0 error) *ServiceAccountRepository_RotateKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_RotateKey_Call) RunAndReturn(run func(entity.APIKey, int64) error) *ServiceAccountRepository_RotateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchKey provides a mock function with given fields: id, usedAt
+func (_m *ServiceAccountRepository) TouchKey(id uuid.UUID, usedAt int64) error {
+	ret := _m.Called(id, usedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64) error); ok {
+		r0 = rf(id, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_TouchKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchKey'
+type ServiceAccountRepository_TouchKey_Call struct {
+	*mock.Call
+}
+
+// TouchKey is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - usedAt int64
+func (_e *ServiceAccountRepository_Expecter) TouchKey(id interface{}, usedAt interface{}) *ServiceAccountRepository_TouchKey_Call {
+	return &ServiceAccountRepository_TouchKey_Call{Call: _e.mock.On("TouchKey", id, usedAt)}
+}
+
+func (_c *ServiceAccountRepository_TouchKey_Call) Run(run func(id uuid.UUID, usedAt int64)) *ServiceAccountRepository_TouchKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_TouchKey_Call) Return(_a0 error) *ServiceAccountRepository_TouchKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_TouchKey_Call) RunAndReturn(run func(uuid.UUID, int64) error) *ServiceAccountRepository_TouchKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: account
+func (_m *ServiceAccountRepository) Update(account entity.ServiceAccount) error {
+	ret := _m.Called(account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.ServiceAccount) error); ok {
+		r0 = rf(account)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ServiceAccountRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - account entity.ServiceAccount
+func (_e *ServiceAccountRepository_Expecter) Update(account interface{}) *ServiceAccountRepository_Update_Call {
+	return &ServiceAccountRepository_Update_Call{Call: _e.mock.On("Update", account)}
+}
+
+func (_c *ServiceAccountRepository_Update_Call) Run(run func(account entity.ServiceAccount)) *ServiceAccountRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.ServiceAccount))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Update_Call) Return(_a0 error) *ServiceAccountRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Update_Call) RunAndReturn(run func(entity.ServiceAccount) error) *ServiceAccountRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAccountRepository creates a new instance of ServiceAccountRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountRepository {
+	mock := &ServiceAccountRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	accountColumns = `id, name, scopes, active, created_by, created_at, updated_at`
+	keyColumns     = `id, service_account_id, prefix, secret_hash, expires_at, last_used_at, created_at`
+)
+
+type ServiceAccountRepository interface {
+	Create(account entity.ServiceAccount, key entity.APIKey) error
+	ReadOne(id uuid.UUID) (entity.ServiceAccount, error)
+	ReadMany(limit int, offset int) ([]entity.ServiceAccount, error)
+	Update(account entity.ServiceAccount) error
+	Delete(id uuid.UUID) error
+	ReadKeys(accountIDs []uuid.UUID) ([]entity.APIKey, error)
+	ReadKeyByPrefix(prefix string) (entity.APIKey, error)
+	RotateKey(key entity.APIKey, expiresAt int64) error
+	ExpireKey(id uuid.UUID, accountID uuid.UUID, expiresAt int64) (bool, error)
+	TouchKey(id uuid.UUID, usedAt int64) error
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ServiceAccountRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+// Create stores an account together with its first key.
+func (r *Repository) Create(account entity.ServiceAccount, key entity.APIKey) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		INSERT INTO service_accounts (id, name, scopes, active, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err = tx.Exec(query, account.ID, account.Name, account.Scopes, account.Active, account.CreatedBy,
+		account.CreatedAt, account.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create service account: %v", err)
+	}
+
+	err = insertKey(tx, key)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadOne(id uuid.UUID) (entity.ServiceAccount, error) {
+	query := "SELECT " + accountColumns + " FROM service_accounts WHERE id = $1"
+
+	account, err := scanAccount(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.ServiceAccount{}, nil
+	}
+	if err != nil {
+		return entity.ServiceAccount{}, fmt.Errorf("failed to read service account: %v", err)
+	}
+
+	return account, nil
+}
+
+func (r *Repository) ReadMany(limit int, offset int) ([]entity.ServiceAccount, error) {
+	query := "SELECT " + accountColumns + " FROM service_accounts ORDER BY created_at DESC LIMIT $1 OFFSET $2"
+
+	rows, err := r.db.Query(query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service accounts: %v", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]entity.ServiceAccount, 0)
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan service account: %v", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+func (r *Repository) Update(account entity.ServiceAccount) error {
+	query := "UPDATE service_accounts SET name = $1, scopes = $2, active = $3, updated_at = $4 WHERE id = $5"
+
+	_, err := r.db.Exec(query, account.Name, account.Scopes, account.Active, account.UpdatedAt, account.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update service account: %v", err)
+	}
+
+	return nil
+}
+
+// Delete removes an account together with its keys.
+func (r *Repository) Delete(id uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM api_keys WHERE service_account_id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete api keys: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM service_accounts WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete service account: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// ReadKeys lists the keys of the given accounts, newest first.
+func (r *Repository) ReadKeys(accountIDs []uuid.UUID) ([]entity.APIKey, error) {
+	ids := make(pq.StringArray, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := "SELECT " + keyColumns + " FROM api_keys WHERE service_account_id = ANY($1::uuid[]) ORDER BY created_at DESC"
+
+	rows, err := r.db.Query(query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read api keys: %v", err)
+	}
+	defer rows.Close()
+
+	keys := make([]entity.APIKey, 0)
+	for rows.Next() {
+		key, err := scanKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %v", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) ReadKeyByPrefix(prefix string) (entity.APIKey, error) {
+	query := "SELECT " + keyColumns + " FROM api_keys WHERE prefix = $1"
+
+	key, err := scanKey(r.db.QueryRow(query, prefix))
+	if err == sql.ErrNoRows {
+		return entity.APIKey{}, nil
+	}
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("failed to read api key: %v", err)
+	}
+
+	return key, nil
+}
+
+// RotateKey adds key to its account and makes the account's other live
+// keys expire at expiresAt.
+func (r *Repository) RotateKey(key entity.APIKey, expiresAt int64) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		UPDATE api_keys SET expires_at = $1
+		WHERE service_account_id = $2 AND (expires_at IS NULL OR expires_at > $1)
+	`
+	_, err = tx.Exec(query, expiresAt, key.ServiceAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to expire api keys: %v", err)
+	}
+
+	err = insertKey(tx, key)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// ExpireKey revokes a key of an account. It reports false when the account
+// has no such key.
+func (r *Repository) ExpireKey(id uuid.UUID, accountID uuid.UUID, expiresAt int64) (bool, error) {
+	query := "UPDATE api_keys SET expires_at = $1 WHERE id = $2 AND service_account_id = $3"
+
+	result, err := r.db.Exec(query, expiresAt, id, accountID)
+	if err != nil {
+		return false, fmt.Errorf("failed to expire api key: %v", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to expire api key: %v", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *Repository) TouchKey(id uuid.UUID, usedAt int64) error {
+	query := "UPDATE api_keys SET last_used_at = $1 WHERE id = $2"
+
+	_, err := r.db.Exec(query, usedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to touch api key: %v", err)
+	}
+
+	return nil
+}
+
+func insertKey(tx *sql.Tx, key entity.APIKey) error {
+	query := `
+		INSERT INTO api_keys (id, service_account_id, prefix, secret_hash, expires_at, last_used_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := tx.Exec(query, key.ID, key.ServiceAccountID, key.Prefix, key.SecretHash, key.ExpiresAt,
+		key.LastUsedAt, key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %v", err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccount(row rowScanner) (entity.ServiceAccount, error) {
+	var account entity.ServiceAccount
+	err := row.Scan(
+		&account.ID, &account.Name, &account.Scopes, &account.Active, &account.CreatedBy,
+		&account.CreatedAt, &account.UpdatedAt,
+	)
+	return account, err
+}
+
+func scanKey(row rowScanner) (entity.APIKey, error) {
+	var key entity.APIKey
+	err := row.Scan(
+		&key.ID, &key.ServiceAccountID, &key.Prefix, &key.SecretHash, &key.ExpiresAt, &key.LastUsedAt, &key.CreatedAt,
+	)
+	return key, err
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"CodeWithAzri/internal/app/module/serviceaccount/repository"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	createAccountQuery   = "INSERT INTO service_accounts (id, name, scopes, active, created_by, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	createKeyQuery       = "INSERT INTO api_keys (id, service_account_id, prefix, secret_hash, expires_at, last_used_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	readAccountQuery     = "SELECT id, name, scopes, active, created_by, created_at, updated_at FROM service_accounts WHERE id = $1"
+	readAccountsQuery    = "SELECT id, name, scopes, active, created_by, created_at, updated_at FROM service_accounts ORDER BY created_at DESC LIMIT $1 OFFSET $2"
+	updateAccountQuery   = "UPDATE service_accounts SET name = $1, scopes = $2, active = $3, updated_at = $4 WHERE id = $5"
+	deleteKeysQuery      = "DELETE FROM api_keys WHERE service_account_id = $1"
+	deleteAccountQuery   = "DELETE FROM service_accounts WHERE id = $1"
+	readKeysQuery        = "SELECT id, service_account_id, prefix, secret_hash, expires_at, last_used_at, created_at FROM api_keys WHERE service_account_id = ANY($1::uuid[]) ORDER BY created_at DESC"
+	readKeyByPrefixQuery = "SELECT id, service_account_id, prefix, secret_hash, expires_at, last_used_at, created_at FROM api_keys WHERE prefix = $1"
+	expireKeysQuery      = "UPDATE api_keys SET expires_at = $1 WHERE service_account_id = $2 AND (expires_at IS NULL OR expires_at > $1)"
+	expireKeyQuery       = "UPDATE api_keys SET expires_at = $1 WHERE id = $2 AND service_account_id = $3"
+	touchKeyQuery        = "UPDATE api_keys SET last_used_at = $1 WHERE id = $2"
+)
+
+var MockAccount entity.ServiceAccount = entity.ServiceAccount{
+	ID:        uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d51"),
+	Name:      "Catalogue sync",
+	Scopes:    pq.StringArray{"courses", "media"},
+	Active:    true,
+	CreatedBy: "admin-1",
+	CreatedAt: 121212,
+	UpdatedAt: 121212,
+}
+
+var MockKey entity.APIKey = entity.APIKey{
+	ID:               uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d61"),
+	ServiceAccountID: MockAccount.ID,
+	Prefix:           "0a1b2c3d4e5f",
+	SecretHash:       "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	CreatedAt:        121212,
+}
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ServiceAccountRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func prepareAccountRows(accounts ...entity.ServiceAccount) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "name", "scopes", "active", "created_by", "created_at", "updated_at"})
+	for _, a := range accounts {
+		scopes, _ := a.Scopes.Value()
+		rows.AddRow(a.ID, a.Name, scopes, a.Active, a.CreatedBy, a.CreatedAt, a.UpdatedAt)
+	}
+	return rows
+}
+
+func prepareKeyRows(keys ...entity.APIKey) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "service_account_id", "prefix", "secret_hash", "expires_at", "last_used_at", "created_at"})
+	for _, k := range keys {
+		rows.AddRow(k.ID, k.ServiceAccountID, k.Prefix, k.SecretHash, k.ExpiresAt, k.LastUsedAt, k.CreatedAt)
+	}
+	return rows
+}
@@ -0,0 +1,313 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Create Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createAccountQuery).
+			WithArgs(MockAccount.ID, MockAccount.Name, MockAccount.Scopes, MockAccount.Active, MockAccount.CreatedBy,
+				MockAccount.CreatedAt, MockAccount.UpdatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createKeyQuery).
+			WithArgs(MockKey.ID, MockKey.ServiceAccountID, MockKey.Prefix, MockKey.SecretHash, MockKey.ExpiresAt,
+				MockKey.LastUsedAt, MockKey.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Create(MockAccount, MockKey))
+	})
+
+	t.Run("Create Account Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createAccountQuery).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.Create(MockAccount, MockKey), "failed to create service account: insert failed")
+	})
+
+	t.Run("Create Key Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createAccountQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createKeyQuery).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.Create(MockAccount, MockKey), "failed to create api key: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadOne(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read One Success", func(t *testing.T) {
+		mock.ExpectQuery(readAccountQuery).WithArgs(MockAccount.ID).WillReturnRows(prepareAccountRows(MockAccount))
+
+		account, err := repo.ReadOne(MockAccount.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockAccount, account)
+	})
+
+	t.Run("Read One Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readAccountQuery).WithArgs(MockAccount.ID).WillReturnError(sql.ErrNoRows)
+
+		account, err := repo.ReadOne(MockAccount.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ServiceAccount{}, account)
+	})
+
+	t.Run("Read One Error", func(t *testing.T) {
+		mock.ExpectQuery(readAccountQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadOne(MockAccount.ID)
+
+		assert.EqualError(t, err, "failed to read service account: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadMany(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Many Success", func(t *testing.T) {
+		mock.ExpectQuery(readAccountsQuery).WithArgs(10, 20).WillReturnRows(prepareAccountRows(MockAccount))
+
+		accounts, err := repo.ReadMany(10, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.ServiceAccount{MockAccount}, accounts)
+	})
+
+	t.Run("Read Many Error", func(t *testing.T) {
+		mock.ExpectQuery(readAccountsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadMany(10, 20)
+
+		assert.EqualError(t, err, "failed to read service accounts: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Update(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Update Success", func(t *testing.T) {
+		mock.ExpectExec(updateAccountQuery).
+			WithArgs(MockAccount.Name, MockAccount.Scopes, MockAccount.Active, MockAccount.UpdatedAt, MockAccount.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Update(MockAccount))
+	})
+
+	t.Run("Update Error", func(t *testing.T) {
+		mock.ExpectExec(updateAccountQuery).WillReturnError(errors.New("update failed"))
+
+		assert.EqualError(t, repo.Update(MockAccount), "failed to update service account: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Delete(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Delete Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteKeysQuery).WithArgs(MockAccount.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(deleteAccountQuery).WithArgs(MockAccount.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Delete(MockAccount.ID))
+	})
+
+	t.Run("Delete Keys Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteKeysQuery).WillReturnError(errors.New("delete failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.Delete(MockAccount.ID), "failed to delete api keys: delete failed")
+	})
+
+	t.Run("Delete Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("begin failed"))
+
+		assert.EqualError(t, repo.Delete(MockAccount.ID), "failed to begin transaction: begin failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadKeys(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Keys Success", func(t *testing.T) {
+		revoked := MockKey
+		expiresAt, lastUsedAt := int64(131313), int64(125000)
+		revoked.ExpiresAt = &expiresAt
+		revoked.LastUsedAt = &lastUsedAt
+		mock.ExpectQuery(readKeysQuery).WithArgs(pq.StringArray{MockAccount.ID.String()}).
+			WillReturnRows(prepareKeyRows(MockKey, revoked))
+
+		keys, err := repo.ReadKeys([]uuid.UUID{MockAccount.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.APIKey{MockKey, revoked}, keys)
+	})
+
+	t.Run("Read Keys Error", func(t *testing.T) {
+		mock.ExpectQuery(readKeysQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadKeys([]uuid.UUID{MockAccount.ID})
+
+		assert.EqualError(t, err, "failed to read api keys: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadKeyByPrefix(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Key Success", func(t *testing.T) {
+		mock.ExpectQuery(readKeyByPrefixQuery).WithArgs(MockKey.Prefix).WillReturnRows(prepareKeyRows(MockKey))
+
+		key, err := repo.ReadKeyByPrefix(MockKey.Prefix)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockKey, key)
+	})
+
+	t.Run("Read Key Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readKeyByPrefixQuery).WithArgs(MockKey.Prefix).WillReturnError(sql.ErrNoRows)
+
+		key, err := repo.ReadKeyByPrefix(MockKey.Prefix)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.APIKey{}, key)
+	})
+
+	t.Run("Read Key Error", func(t *testing.T) {
+		mock.ExpectQuery(readKeyByPrefixQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadKeyByPrefix(MockKey.Prefix)
+
+		assert.EqualError(t, err, "failed to read api key: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RotateKey(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Rotate Key Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(expireKeysQuery).WithArgs(int64(131313), MockKey.ServiceAccountID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(createKeyQuery).
+			WithArgs(MockKey.ID, MockKey.ServiceAccountID, MockKey.Prefix, MockKey.SecretHash, MockKey.ExpiresAt,
+				MockKey.LastUsedAt, MockKey.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.RotateKey(MockKey, 131313))
+	})
+
+	t.Run("Rotate Key Expire Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(expireKeysQuery).WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.RotateKey(MockKey, 131313), "failed to expire api keys: update failed")
+	})
+
+	t.Run("Rotate Key Commit Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(expireKeysQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createKeyQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit().WillReturnError(errors.New("commit failed"))
+
+		assert.EqualError(t, repo.RotateKey(MockKey, 131313), "failed to commit transaction: commit failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ExpireKey(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Expire Key Success", func(t *testing.T) {
+		mock.ExpectExec(expireKeyQuery).WithArgs(int64(131313), MockKey.ID, MockAccount.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		expired, err := repo.ExpireKey(MockKey.ID, MockAccount.ID, 131313)
+
+		assert.NoError(t, err)
+		assert.True(t, expired)
+	})
+
+	t.Run("Expire Key Not Found", func(t *testing.T) {
+		mock.ExpectExec(expireKeyQuery).WithArgs(int64(131313), MockKey.ID, MockAccount.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		expired, err := repo.ExpireKey(MockKey.ID, MockAccount.ID, 131313)
+
+		assert.NoError(t, err)
+		assert.False(t, expired)
+	})
+
+	t.Run("Expire Key Error", func(t *testing.T) {
+		mock.ExpectExec(expireKeyQuery).WillReturnError(errors.New("update failed"))
+
+		_, err := repo.ExpireKey(MockKey.ID, MockAccount.ID, 131313)
+
+		assert.EqualError(t, err, "failed to expire api key: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_TouchKey(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Touch Key Success", func(t *testing.T) {
+		mock.ExpectExec(touchKeyQuery).WithArgs(int64(131313), MockKey.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.TouchKey(MockKey.ID, 131313))
+	})
+
+	t.Run("Touch Key Error", func(t *testing.T) {
+		mock.ExpectExec(touchKeyQuery).WillReturnError(errors.New("update failed"))
+
+		assert.EqualError(t, repo.TouchKey(MockKey.ID, 131313), "failed to touch api key: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,481 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/serviceaccount/dto"
+	middleware "CodeWithAzri/internal/pkg/middleware"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServiceAccountService is an autogenerated mock type for the ServiceAccountService type
+type ServiceAccountService struct {
+	mock.Mock
+}
+
+type ServiceAccountService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAccountService) EXPECT() *ServiceAccountService_Expecter {
+	return &ServiceAccountService_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: key
+func (_m *ServiceAccountService) Authenticate(key string) (middleware.ServiceAccount, bool, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 middleware.ServiceAccount
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (middleware.ServiceAccount, bool, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) middleware.ServiceAccount); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(middleware.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ServiceAccountService_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type ServiceAccountService_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - key string
+func (_e *ServiceAccountService_Expecter) Authenticate(key interface{}) *ServiceAccountService_Authenticate_Call {
+	return &ServiceAccountService_Authenticate_Call{Call: _e.mock.On("Authenticate", key)}
+}
+
+func (_c *ServiceAccountService_Authenticate_Call) Run(run func(key string)) *ServiceAccountService_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_Authenticate_Call) Return(_a0 middleware.ServiceAccount, _a1 bool, _a2 error) *ServiceAccountService_Authenticate_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ServiceAccountService_Authenticate_Call) RunAndReturn(run func(string) (middleware.ServiceAccount, bool, error)) *ServiceAccountService_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServiceAccount provides a mock function with given fields: input, createdBy
+func (_m *ServiceAccountService) CreateServiceAccount(input dto.CreateServiceAccountDTO, createdBy string) (dto.ServiceAccountDTO, error) {
+	ret := _m.Called(input, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServiceAccount")
+	}
+
+	var r0 dto.ServiceAccountDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.CreateServiceAccountDTO, string) (dto.ServiceAccountDTO, error)); ok {
+		return rf(input, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(dto.CreateServiceAccountDTO, string) dto.ServiceAccountDTO); ok {
+		r0 = rf(input, createdBy)
+	} else {
+		r0 = ret.Get(0).(dto.ServiceAccountDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.CreateServiceAccountDTO, string) error); ok {
+		r1 = rf(input, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_CreateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServiceAccount'
+type ServiceAccountService_CreateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// CreateServiceAccount is a helper method to define mock.On call
+//   - input dto.CreateServiceAccountDTO
+//   - createdBy string
+func (_e *ServiceAccountService_Expecter) CreateServiceAccount(input interface{}, createdBy interface{}) *ServiceAccountService_CreateServiceAccount_Call {
+	return &ServiceAccountService_CreateServiceAccount_Call{Call: _e.mock.On("CreateServiceAccount", input, createdBy)}
+}
+
+func (_c *ServiceAccountService_CreateServiceAccount_Call) Run(run func(input dto.CreateServiceAccountDTO, createdBy string)) *ServiceAccountService_CreateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(dto.CreateServiceAccountDTO), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_CreateServiceAccount_Call) Return(_a0 dto.ServiceAccountDTO, _a1 error) *ServiceAccountService_CreateServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_CreateServiceAccount_Call) RunAndReturn(run func(dto.CreateServiceAccountDTO, string) (dto.ServiceAccountDTO, error)) *ServiceAccountService_CreateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServiceAccount provides a mock function with given fields: id
+func (_m *ServiceAccountService) DeleteServiceAccount(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountService_DeleteServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccount'
+type ServiceAccountService_DeleteServiceAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceAccount is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *ServiceAccountService_Expecter) DeleteServiceAccount(id interface{}) *ServiceAccountService_DeleteServiceAccount_Call {
+	return &ServiceAccountService_DeleteServiceAccount_Call{Call: _e.mock.On("DeleteServiceAccount", id)}
+}
+
+func (_c *ServiceAccountService_DeleteServiceAccount_Call) Run(run func(id uuid.UUID)) *ServiceAccountService_DeleteServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_DeleteServiceAccount_Call) Return(_a0 error) *ServiceAccountService_DeleteServiceAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountService_DeleteServiceAccount_Call) RunAndReturn(run func(uuid.UUID) error) *ServiceAccountService_DeleteServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceAccount provides a mock function with given fields: id
+func (_m *ServiceAccountService) GetServiceAccount(id uuid.UUID) (dto.ServiceAccountDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccount")
+	}
+
+	var r0 dto.ServiceAccountDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.ServiceAccountDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.ServiceAccountDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.ServiceAccountDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_GetServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceAccount'
+type ServiceAccountService_GetServiceAccount_Call struct {
+	*mock.Call
+}
+
+// GetServiceAccount is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *ServiceAccountService_Expecter) GetServiceAccount(id interface{}) *ServiceAccountService_GetServiceAccount_Call {
+	return &ServiceAccountService_GetServiceAccount_Call{Call: _e.mock.On("GetServiceAccount", id)}
+}
+
+func (_c *ServiceAccountService_GetServiceAccount_Call) Run(run func(id uuid.UUID)) *ServiceAccountService_GetServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_GetServiceAccount_Call) Return(_a0 dto.ServiceAccountDTO, _a1 error) *ServiceAccountService_GetServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_GetServiceAccount_Call) RunAndReturn(run func(uuid.UUID) (dto.ServiceAccountDTO, error)) *ServiceAccountService_GetServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceAccounts provides a mock function with given fields: limit, page
+func (_m *ServiceAccountService) GetServiceAccounts(limit int, page int) ([]dto.ServiceAccountDTO, error) {
+	ret := _m.Called(limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccounts")
+	}
+
+	var r0 []dto.ServiceAccountDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]dto.ServiceAccountDTO, error)); ok {
+		return rf(limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []dto.ServiceAccountDTO); ok {
+		r0 = rf(limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ServiceAccountDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_GetServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceAccounts'
+type ServiceAccountService_GetServiceAccounts_Call struct {
+	*mock.Call
+}
+
+// GetServiceAccounts is a helper method to define mock.On call
+//   - limit int
+//   - page int
+func (_e *ServiceAccountService_Expecter) GetServiceAccounts(limit interface{}, page interface{}) *ServiceAccountService_GetServiceAccounts_Call {
+	return &ServiceAccountService_GetServiceAccounts_Call{Call: _e.mock.On("GetServiceAccounts", limit, page)}
+}
+
+func (_c *ServiceAccountService_GetServiceAccounts_Call) Run(run func(limit int, page int)) *ServiceAccountService_GetServiceAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_GetServiceAccounts_Call) Return(_a0 []dto.ServiceAccountDTO, _a1 error) *ServiceAccountService_GetServiceAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_GetServiceAccounts_Call) RunAndReturn(run func(int, int) ([]dto.ServiceAccountDTO, error)) *ServiceAccountService_GetServiceAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeKey provides a mock function with given fields: id, keyID
+func (_m *ServiceAccountService) RevokeKey(id uuid.UUID, keyID uuid.UUID) error {
+	ret := _m.Called(id, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountService_RevokeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeKey'
+type ServiceAccountService_RevokeKey_Call struct {
+	*mock.Call
+}
+
+// RevokeKey is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - keyID uuid.UUID
+func (_e *ServiceAccountService_Expecter) RevokeKey(id interface{}, keyID interface{}) *ServiceAccountService_RevokeKey_Call {
+	return &ServiceAccountService_RevokeKey_Call{Call: _e.mock.On("RevokeKey", id, keyID)}
+}
+
+func (_c *ServiceAccountService_RevokeKey_Call) Run(run func(id uuid.UUID, keyID uuid.UUID)) *ServiceAccountService_RevokeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_RevokeKey_Call) Return(_a0 error) *ServiceAccountService_RevokeKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountService_RevokeKey_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *ServiceAccountService_RevokeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateKey provides a mock function with given fields: id, input
+func (_m *ServiceAccountService) RotateKey(id uuid.UUID, input dto.RotateAPIKeyDTO) (dto.APIKeyDTO, error) {
+	ret := _m.Called(id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateKey")
+	}
+
+	var r0 dto.APIKeyDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.RotateAPIKeyDTO) (dto.APIKeyDTO, error)); ok {
+		return rf(id, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.RotateAPIKeyDTO) dto.APIKeyDTO); ok {
+		r0 = rf(id, input)
+	} else {
+		r0 = ret.Get(0).(dto.APIKeyDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, dto.RotateAPIKeyDTO) error); ok {
+		r1 = rf(id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_RotateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateKey'
+type ServiceAccountService_RotateKey_Call struct {
+	*mock.Call
+}
+
+// RotateKey is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - input dto.RotateAPIKeyDTO
+func (_e *ServiceAccountService_Expecter) RotateKey(id interface{}, input interface{}) *ServiceAccountService_RotateKey_Call {
+	return &ServiceAccountService_RotateKey_Call{Call: _e.mock.On("RotateKey", id, input)}
+}
+
+func (_c *ServiceAccountService_RotateKey_Call) Run(run func(id uuid.UUID, input dto.RotateAPIKeyDTO)) *ServiceAccountService_RotateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(dto.RotateAPIKeyDTO))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_RotateKey_Call) Return(_a0 dto.APIKeyDTO, _a1 error) *ServiceAccountService_RotateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_RotateKey_Call) RunAndReturn(run func(uuid.UUID, dto.RotateAPIKeyDTO) (dto.APIKeyDTO, error)) *ServiceAccountService_RotateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateServiceAccount provides a mock function with given fields: id, input
+func (_m *ServiceAccountService) UpdateServiceAccount(id uuid.UUID, input dto.UpdateServiceAccountDTO) (dto.ServiceAccountDTO, error) {
+	ret := _m.Called(id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServiceAccount")
+	}
+
+	var r0 dto.ServiceAccountDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.UpdateServiceAccountDTO) (dto.ServiceAccountDTO, error)); ok {
+		return rf(id, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.UpdateServiceAccountDTO) dto.ServiceAccountDTO); ok {
+		r0 = rf(id, input)
+	} else {
+		r0 = ret.Get(0).(dto.ServiceAccountDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, dto.UpdateServiceAccountDTO) error); ok {
+		r1 = rf(id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_UpdateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateServiceAccount'
+type ServiceAccountService_UpdateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// UpdateServiceAccount is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - input dto.UpdateServiceAccountDTO
+func (_e *ServiceAccountService_Expecter) UpdateServiceAccount(id interface{}, input interface{}) *ServiceAccountService_UpdateServiceAccount_Call {
+	return &ServiceAccountService_UpdateServiceAccount_Call{Call: _e.mock.On("UpdateServiceAccount", id, input)}
+}
+
+func (_c *ServiceAccountService_UpdateServiceAccount_Call) Run(run func(id uuid.UUID, input dto.UpdateServiceAccountDTO)) *ServiceAccountService_UpdateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(dto.UpdateServiceAccountDTO))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_UpdateServiceAccount_Call) Return(_a0 dto.ServiceAccountDTO, _a1 error) *ServiceAccountService_UpdateServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_UpdateServiceAccount_Call) RunAndReturn(run func(uuid.UUID, dto.UpdateServiceAccountDTO) (dto.ServiceAccountDTO, error)) *ServiceAccountService_UpdateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAccountService creates a new instance of ServiceAccountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountService {
+	mock := &ServiceAccountService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/dto"
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"CodeWithAzri/internal/app/module/serviceaccount/repository"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	keyPrefix = "cwa"
+	// touchInterval throttles last-used tracking to one write per key per
+	// interval, so busy clients do not cost a write per request.
+	touchInterval = time.Minute
+)
+
+var (
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrAPIKeyNotFound         = errors.New("api key not found")
+	ErrInvalidScope           = errors.New("invalid scope")
+)
+
+type ServiceAccountService interface {
+	middleware.APIKeyAuthenticator
+	CreateServiceAccount(input dto.CreateServiceAccountDTO, createdBy string) (dto.ServiceAccountDTO, error)
+	GetServiceAccounts(limit int, page int) ([]dto.ServiceAccountDTO, error)
+	GetServiceAccount(id uuid.UUID) (dto.ServiceAccountDTO, error)
+	UpdateServiceAccount(id uuid.UUID, input dto.UpdateServiceAccountDTO) (dto.ServiceAccountDTO, error)
+	DeleteServiceAccount(id uuid.UUID) error
+	RotateKey(id uuid.UUID, input dto.RotateAPIKeyDTO) (dto.APIKeyDTO, error)
+	RevokeKey(id uuid.UUID, keyID uuid.UUID) error
+}
+
+type Service struct {
+	repository repository.ServiceAccountRepository
+}
+
+func NewService(r repository.ServiceAccountRepository) ServiceAccountService {
+	s := new(Service)
+	s.repository = r
+	return s
+}
+
+// CreateServiceAccount creates an account with its first key.
+func (s *Service) CreateServiceAccount(input dto.CreateServiceAccountDTO, createdBy string) (dto.ServiceAccountDTO, error) {
+	scopes, err := validScopes(input.Scopes)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	account := entity.ServiceAccount{
+		ID:        uuid.New(),
+		Name:      input.Name,
+		Scopes:    scopes,
+		Active:    true,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	key, plaintext, err := generateKey(account.ID, now)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	err = s.repository.Create(account, key)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	// The key is only shown once, to the admin who created the account.
+	created := toKeyDTO(key)
+	created.Key = plaintext
+	return toDTO(account, []dto.APIKeyDTO{created}), nil
+}
+
+func (s *Service) GetServiceAccounts(limit int, page int) ([]dto.ServiceAccountDTO, error) {
+	offset := (page - 1) * limit
+
+	accounts, err := s.repository.ReadMany(limit, offset)
+	if err != nil {
+		return []dto.ServiceAccountDTO{}, err
+	}
+
+	if len(accounts) == 0 {
+		return []dto.ServiceAccountDTO{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(accounts))
+	for _, account := range accounts {
+		ids = append(ids, account.ID)
+	}
+
+	keys, err := s.repository.ReadKeys(ids)
+	if err != nil {
+		return []dto.ServiceAccountDTO{}, err
+	}
+
+	keysByAccount := make(map[uuid.UUID][]dto.APIKeyDTO, len(accounts))
+	for _, key := range keys {
+		keysByAccount[key.ServiceAccountID] = append(keysByAccount[key.ServiceAccountID], toKeyDTO(key))
+	}
+
+	result := make([]dto.ServiceAccountDTO, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, toDTO(account, keysByAccount[account.ID]))
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetServiceAccount(id uuid.UUID) (dto.ServiceAccountDTO, error) {
+	account, err := s.readAccount(id)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	return s.withKeys(account)
+}
+
+func (s *Service) UpdateServiceAccount(id uuid.UUID, input dto.UpdateServiceAccountDTO) (dto.ServiceAccountDTO, error) {
+	scopes, err := validScopes(input.Scopes)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	account, err := s.readAccount(id)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	account.Name = input.Name
+	account.Scopes = scopes
+	account.Active = input.Active
+	account.UpdatedAt = timepkg.NowUnixMilli()
+
+	err = s.repository.Update(account)
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	return s.withKeys(account)
+}
+
+func (s *Service) DeleteServiceAccount(id uuid.UUID) error {
+	_, err := s.readAccount(id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.Delete(id)
+}
+
+// RotateKey issues a new key for an account. Its other keys keep working
+// for the grace period and stop working after it.
+func (s *Service) RotateKey(id uuid.UUID, input dto.RotateAPIKeyDTO) (dto.APIKeyDTO, error) {
+	_, err := s.readAccount(id)
+	if err != nil {
+		return dto.APIKeyDTO{}, err
+	}
+
+	now := timepkg.NowUnixMilli()
+	key, plaintext, err := generateKey(id, now)
+	if err != nil {
+		return dto.APIKeyDTO{}, err
+	}
+
+	expiresAt := now + (time.Duration(input.GracePeriodMinutes) * time.Minute).Milliseconds()
+	err = s.repository.RotateKey(key, expiresAt)
+	if err != nil {
+		return dto.APIKeyDTO{}, err
+	}
+
+	rotated := toKeyDTO(key)
+	rotated.Key = plaintext
+	return rotated, nil
+}
+
+// RevokeKey makes a key stop working right away.
+func (s *Service) RevokeKey(id uuid.UUID, keyID uuid.UUID) error {
+	_, err := s.readAccount(id)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := s.repository.ExpireKey(keyID, id, timepkg.NowUnixMilli())
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) Authenticate(key string) (middleware.ServiceAccount, bool, error) {
+	prefix, secret, ok := parseKey(key)
+	if !ok {
+		return middleware.ServiceAccount{}, false, nil
+	}
+
+	apiKey, err := s.repository.ReadKeyByPrefix(prefix)
+	if err != nil {
+		return middleware.ServiceAccount{}, false, err
+	}
+
+	if apiKey.ID == uuid.Nil || subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(apiKey.SecretHash)) != 1 {
+		return middleware.ServiceAccount{}, false, nil
+	}
+
+	now := timepkg.NowUnixMilli()
+	if apiKey.ExpiresAt != nil && *apiKey.ExpiresAt <= now {
+		return middleware.ServiceAccount{}, false, nil
+	}
+
+	account, err := s.repository.ReadOne(apiKey.ServiceAccountID)
+	if err != nil {
+		return middleware.ServiceAccount{}, false, err
+	}
+
+	if account.ID == uuid.Nil || !account.Active {
+		return middleware.ServiceAccount{}, false, nil
+	}
+
+	if apiKey.LastUsedAt == nil || now-*apiKey.LastUsedAt >= touchInterval.Milliseconds() {
+		// A lost timestamp is not worth failing the request for.
+		if err := s.repository.TouchKey(apiKey.ID, now); err != nil {
+			log.Printf("failed to record use of api key %s: %v", apiKey.ID, err)
+		}
+	}
+
+	return middleware.ServiceAccount{ID: account.ID.String(), Scopes: account.Scopes}, true, nil
+}
+
+func (s *Service) readAccount(id uuid.UUID) (entity.ServiceAccount, error) {
+	account, err := s.repository.ReadOne(id)
+	if err != nil {
+		return entity.ServiceAccount{}, err
+	}
+
+	if account.ID == uuid.Nil {
+		return entity.ServiceAccount{}, ErrServiceAccountNotFound
+	}
+
+	return account, nil
+}
+
+func (s *Service) withKeys(account entity.ServiceAccount) (dto.ServiceAccountDTO, error) {
+	keys, err := s.repository.ReadKeys([]uuid.UUID{account.ID})
+	if err != nil {
+		return dto.ServiceAccountDTO{}, err
+	}
+
+	keyDTOs := make([]dto.APIKeyDTO, 0, len(keys))
+	for _, key := range keys {
+		keyDTOs = append(keyDTOs, toKeyDTO(key))
+	}
+
+	return toDTO(account, keyDTOs), nil
+}
+
+// validScopes drops duplicates, so the stored list stays tidy.
+func validScopes(scopes []api_scope_enum.APIScope) (pq.StringArray, error) {
+	valid := make(pq.StringArray, 0, len(scopes))
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+		if !slices.Contains(valid, string(scope)) {
+			valid = append(valid, string(scope))
+		}
+	}
+	return valid, nil
+}
+
+// generateKey returns a new key as stored and as handed to the client,
+// "cwa_{prefix}_{secret}".
+func generateKey(accountID uuid.UUID, now int64) (entity.APIKey, string, error) {
+	random := make([]byte, 38)
+	if _, err := rand.Read(random); err != nil {
+		return entity.APIKey{}, "", fmt.Errorf("failed to generate api key: %v", err)
+	}
+	prefix := hex.EncodeToString(random[:6])
+	secret := hex.EncodeToString(random[6:])
+
+	key := entity.APIKey{
+		ID:               uuid.New(),
+		ServiceAccountID: accountID,
+		Prefix:           prefix,
+		SecretHash:       hashSecret(secret),
+		CreatedAt:        now,
+	}
+	return key, keyPrefix + "_" + prefix + "_" + secret, nil
+}
+
+func parseKey(key string) (string, string, bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != keyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// hashSecret needs no salt or stretching: secrets are random and long, so
+// they cannot be guessed from their hash.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func toDTO(account entity.ServiceAccount, keys []dto.APIKeyDTO) dto.ServiceAccountDTO {
+	scopes := make([]api_scope_enum.APIScope, 0, len(account.Scopes))
+	for _, scope := range account.Scopes {
+		scopes = append(scopes, api_scope_enum.APIScope(scope))
+	}
+
+	if keys == nil {
+		keys = []dto.APIKeyDTO{}
+	}
+
+	return dto.ServiceAccountDTO{
+		ID:        account.ID,
+		Name:      account.Name,
+		Scopes:    scopes,
+		Active:    account.Active,
+		CreatedBy: account.CreatedBy,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+		Keys:      keys,
+	}
+}
+
+func toKeyDTO(key entity.APIKey) dto.APIKeyDTO {
+	return dto.APIKeyDTO{
+		ID:         key.ID,
+		Prefix:     key.Prefix,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
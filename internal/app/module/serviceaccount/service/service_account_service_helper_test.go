@@ -0,0 +1,38 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"CodeWithAzri/internal/app/module/serviceaccount/repository/mocks"
+	"CodeWithAzri/internal/app/module/serviceaccount/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// MockPlaintextKey is the key the client sends for MockKey.
+const MockPlaintextKey = "cwa_0a1b2c3d4e5f_secret"
+
+var MockAccount entity.ServiceAccount = entity.ServiceAccount{
+	ID:        uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d51"),
+	Name:      "Catalogue sync",
+	Scopes:    pq.StringArray{"courses", "media"},
+	Active:    true,
+	CreatedBy: "admin-1",
+	CreatedAt: 121212,
+	UpdatedAt: 121212,
+}
+
+var MockKey entity.APIKey = entity.APIKey{
+	ID:               uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d61"),
+	ServiceAccountID: MockAccount.ID,
+	Prefix:           "0a1b2c3d4e5f",
+	// sha256("secret")
+	SecretHash: "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b",
+	CreatedAt:  121212,
+}
+
+func initializeService(t *testing.T) (*mocks.ServiceAccountRepository, service.ServiceAccountService) {
+	repo := mocks.NewServiceAccountRepository(t)
+	return repo, service.NewService(repo)
+}
@@ -0,0 +1,385 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount/dto"
+	"CodeWithAzri/internal/app/module/serviceaccount/entity"
+	"CodeWithAzri/internal/app/module/serviceaccount/service"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"errors"
+	"regexp"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var plaintextKeyPattern = regexp.MustCompile(`^cwa_([0-9a-f]{12})_[0-9a-f]{64}$`)
+
+func TestService_CreateServiceAccount(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 121212 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Create Service Account Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		var stored entity.APIKey
+		repo.On("Create", mock.MatchedBy(func(a entity.ServiceAccount) bool {
+			return a.Name == MockAccount.Name && a.Active && a.CreatedBy == "admin-1" && a.CreatedAt == 121212 &&
+				assert.ObjectsAreEqual(pq.StringArray{"courses"}, a.Scopes)
+		}), mock.AnythingOfType("entity.APIKey")).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(entity.APIKey) }).
+			Return(nil)
+
+		account, err := s.CreateServiceAccount(dto.CreateServiceAccountDTO{
+			Name:   MockAccount.Name,
+			Scopes: []api_scope_enum.APIScope{api_scope_enum.Courses, api_scope_enum.Courses},
+		}, "admin-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []api_scope_enum.APIScope{api_scope_enum.Courses}, account.Scopes)
+		assert.Len(t, account.Keys, 1)
+		match := plaintextKeyPattern.FindStringSubmatch(account.Keys[0].Key)
+		assert.NotNil(t, match)
+		assert.Equal(t, stored.Prefix, match[1])
+		assert.Equal(t, account.ID, stored.ServiceAccountID)
+		assert.NotContains(t, account.Keys[0].Key, stored.SecretHash)
+	})
+
+	t.Run("Create Service Account Invalid Scope", func(t *testing.T) {
+		_, s := initializeService(t)
+
+		_, err := s.CreateServiceAccount(dto.CreateServiceAccountDTO{
+			Name:   MockAccount.Name,
+			Scopes: []api_scope_enum.APIScope{"admin:everything"},
+		}, "admin-1")
+
+		assert.ErrorIs(t, err, service.ErrInvalidScope)
+	})
+
+	t.Run("Create Service Account Error", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("Create", mock.Anything, mock.Anything).Return(errors.New("insert failed"))
+
+		_, err := s.CreateServiceAccount(dto.CreateServiceAccountDTO{
+			Name:   MockAccount.Name,
+			Scopes: []api_scope_enum.APIScope{api_scope_enum.Courses},
+		}, "admin-1")
+
+		assert.EqualError(t, err, "insert failed")
+	})
+}
+
+func TestService_GetServiceAccounts(t *testing.T) {
+	t.Run("Get Service Accounts Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		other := MockAccount
+		other.ID = uuid.MustParse("3b8f1c2d-5e6a-4b7c-8d9e-0f1a2b3c4d52")
+		repo.On("ReadMany", 10, 10).Return([]entity.ServiceAccount{MockAccount, other}, nil)
+		repo.On("ReadKeys", []uuid.UUID{MockAccount.ID, other.ID}).Return([]entity.APIKey{MockKey}, nil)
+
+		accounts, err := s.GetServiceAccounts(10, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, accounts, 2)
+		assert.Equal(t, []dto.APIKeyDTO{{ID: MockKey.ID, Prefix: MockKey.Prefix, CreatedAt: MockKey.CreatedAt}}, accounts[0].Keys)
+		assert.Equal(t, []dto.APIKeyDTO{}, accounts[1].Keys)
+	})
+
+	t.Run("Get Service Accounts Empty", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadMany", 10, 0).Return([]entity.ServiceAccount{}, nil)
+
+		accounts, err := s.GetServiceAccounts(10, 1)
+
+		assert.NoError(t, err)
+		assert.Empty(t, accounts)
+	})
+
+	t.Run("Get Service Accounts Keys Error", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadMany", 10, 0).Return([]entity.ServiceAccount{MockAccount}, nil)
+		repo.On("ReadKeys", []uuid.UUID{MockAccount.ID}).Return(nil, errors.New("query failed"))
+
+		_, err := s.GetServiceAccounts(10, 1)
+
+		assert.EqualError(t, err, "query failed")
+	})
+}
+
+func TestService_GetServiceAccount(t *testing.T) {
+	t.Run("Get Service Account Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("ReadKeys", []uuid.UUID{MockAccount.ID}).Return([]entity.APIKey{MockKey}, nil)
+
+		account, err := s.GetServiceAccount(MockAccount.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockAccount.ID, account.ID)
+		assert.Equal(t, []api_scope_enum.APIScope{api_scope_enum.Courses, api_scope_enum.Media}, account.Scopes)
+		assert.Len(t, account.Keys, 1)
+		assert.Empty(t, account.Keys[0].Key)
+	})
+
+	t.Run("Get Service Account Not Found", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(entity.ServiceAccount{}, nil)
+
+		_, err := s.GetServiceAccount(MockAccount.ID)
+
+		assert.ErrorIs(t, err, service.ErrServiceAccountNotFound)
+	})
+}
+
+func TestService_UpdateServiceAccount(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Update Service Account Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("Update", mock.MatchedBy(func(a entity.ServiceAccount) bool {
+			return a.Name == "Renamed" && !a.Active && a.UpdatedAt == 131313 &&
+				assert.ObjectsAreEqual(pq.StringArray{"admin:jobs"}, a.Scopes)
+		})).Return(nil)
+		repo.On("ReadKeys", []uuid.UUID{MockAccount.ID}).Return([]entity.APIKey{}, nil)
+
+		account, err := s.UpdateServiceAccount(MockAccount.ID, dto.UpdateServiceAccountDTO{
+			Name:   "Renamed",
+			Scopes: []api_scope_enum.APIScope{api_scope_enum.AdminJobs},
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, account.Active)
+	})
+
+	t.Run("Update Service Account Invalid Scope", func(t *testing.T) {
+		_, s := initializeService(t)
+
+		_, err := s.UpdateServiceAccount(MockAccount.ID, dto.UpdateServiceAccountDTO{
+			Name:   "Renamed",
+			Scopes: []api_scope_enum.APIScope{"admin"},
+		})
+
+		assert.ErrorIs(t, err, service.ErrInvalidScope)
+	})
+
+	t.Run("Update Service Account Not Found", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(entity.ServiceAccount{}, nil)
+
+		_, err := s.UpdateServiceAccount(MockAccount.ID, dto.UpdateServiceAccountDTO{
+			Name:   "Renamed",
+			Scopes: []api_scope_enum.APIScope{api_scope_enum.Courses},
+		})
+
+		assert.ErrorIs(t, err, service.ErrServiceAccountNotFound)
+	})
+}
+
+func TestService_DeleteServiceAccount(t *testing.T) {
+	t.Run("Delete Service Account Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("Delete", MockAccount.ID).Return(nil)
+
+		assert.NoError(t, s.DeleteServiceAccount(MockAccount.ID))
+	})
+
+	t.Run("Delete Service Account Not Found", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(entity.ServiceAccount{}, nil)
+
+		assert.ErrorIs(t, s.DeleteServiceAccount(MockAccount.ID), service.ErrServiceAccountNotFound)
+	})
+}
+
+func TestService_RotateKey(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 121212 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Rotate Key Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		var stored entity.APIKey
+		repo.On("RotateKey", mock.AnythingOfType("entity.APIKey"), int64(121212+15*60*1000)).
+			Run(func(args mock.Arguments) { stored = args.Get(0).(entity.APIKey) }).
+			Return(nil)
+
+		key, err := s.RotateKey(MockAccount.ID, dto.RotateAPIKeyDTO{GracePeriodMinutes: 15})
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockAccount.ID, stored.ServiceAccountID)
+		assert.Equal(t, stored.ID, key.ID)
+		assert.Regexp(t, plaintextKeyPattern, key.Key)
+	})
+
+	t.Run("Rotate Key Not Found", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(entity.ServiceAccount{}, nil)
+
+		_, err := s.RotateKey(MockAccount.ID, dto.RotateAPIKeyDTO{})
+
+		assert.ErrorIs(t, err, service.ErrServiceAccountNotFound)
+	})
+
+	t.Run("Rotate Key Error", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("RotateKey", mock.Anything, int64(121212)).Return(errors.New("update failed"))
+
+		_, err := s.RotateKey(MockAccount.ID, dto.RotateAPIKeyDTO{})
+
+		assert.EqualError(t, err, "update failed")
+	})
+}
+
+func TestService_RevokeKey(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 121212 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Revoke Key Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("ExpireKey", MockKey.ID, MockAccount.ID, int64(121212)).Return(true, nil)
+
+		assert.NoError(t, s.RevokeKey(MockAccount.ID, MockKey.ID))
+	})
+
+	t.Run("Revoke Key Not Found", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("ExpireKey", MockKey.ID, MockAccount.ID, int64(121212)).Return(false, nil)
+
+		assert.ErrorIs(t, s.RevokeKey(MockAccount.ID, MockKey.ID), service.ErrAPIKeyNotFound)
+	})
+}
+
+func TestService_Authenticate(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 200000 })
+	defer monkey.UnpatchAll()
+
+	expected := middleware.ServiceAccount{ID: MockAccount.ID.String(), Scopes: []string{"courses", "media"}}
+
+	t.Run("Authenticate Success", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(MockKey, nil)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("TouchKey", MockKey.ID, int64(200000)).Return(nil)
+
+		account, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, expected, account)
+	})
+
+	t.Run("Authenticate Recently Used", func(t *testing.T) {
+		repo, s := initializeService(t)
+		key := MockKey
+		lastUsedAt := int64(190000)
+		key.LastUsedAt = &lastUsedAt
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(key, nil)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+
+		_, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Authenticate Touch Error", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(MockKey, nil)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("TouchKey", MockKey.ID, int64(200000)).Return(errors.New("update failed"))
+
+		_, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Authenticate Malformed Key", func(t *testing.T) {
+		_, s := initializeService(t)
+
+		_, ok, err := s.Authenticate("Bearer token")
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Authenticate Unknown Key", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(entity.APIKey{}, nil)
+
+		_, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Authenticate Wrong Secret", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(MockKey, nil)
+
+		_, ok, err := s.Authenticate("cwa_0a1b2c3d4e5f_guess")
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Authenticate Expired Key", func(t *testing.T) {
+		repo, s := initializeService(t)
+		key := MockKey
+		expiresAt := int64(200000)
+		key.ExpiresAt = &expiresAt
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(key, nil)
+
+		_, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Authenticate Key In Grace Period", func(t *testing.T) {
+		repo, s := initializeService(t)
+		key := MockKey
+		expiresAt := int64(200001)
+		key.ExpiresAt = &expiresAt
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(key, nil)
+		repo.On("ReadOne", MockAccount.ID).Return(MockAccount, nil)
+		repo.On("TouchKey", MockKey.ID, int64(200000)).Return(nil)
+
+		_, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Authenticate Inactive Account", func(t *testing.T) {
+		repo, s := initializeService(t)
+		inactive := MockAccount
+		inactive.Active = false
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(MockKey, nil)
+		repo.On("ReadOne", MockAccount.ID).Return(inactive, nil)
+
+		_, ok, err := s.Authenticate(MockPlaintextKey)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Authenticate Error", func(t *testing.T) {
+		repo, s := initializeService(t)
+		repo.On("ReadKeyByPrefix", MockKey.Prefix).Return(entity.APIKey{}, errors.New("query failed"))
+
+		_, _, err := s.Authenticate(MockPlaintextKey)
+
+		assert.EqualError(t, err, "query failed")
+	})
+}
@@ -1,23 +1,50 @@
 package dto
 
+import (
+	// Not aliased: swag only resolves aliases that match the package name.
+	"CodeWithAzri/internal/app/module/media/dto"
+
+	"github.com/google/uuid"
+)
+
 type UserDTO struct {
-	ID             string `json:"id,omitempty"`
-	Name           string `json:"name,omitempty"`
-	Email          string `json:"email,omitempty"`
-	ProfilePicture string `json:"profilePicture,omitempty"`
-	CreatedAt      int64  `json:"createdAt,omitempty"`
-	UpdatedAt      int64  `json:"updatedAt,omitempty"`
+	ID                    string     `json:"id,omitempty"`
+	Name                  string     `json:"name,omitempty"`
+	Email                 string     `json:"email,omitempty"`
+	EmailVerified         bool       `json:"emailVerified,omitempty"`
+	ProfilePicture        string     `json:"profilePicture,omitempty"`
+	ProfilePictureMediaID *uuid.UUID `json:"profilePictureMediaId,omitempty"`
+	ErasedAt              *int64     `json:"erasedAt,omitempty"`
+	ErasedBy              string     `json:"erasedBy,omitempty"`
+	CreatedAt             int64      `json:"createdAt,omitempty"`
+	UpdatedAt             int64      `json:"updatedAt,omitempty"`
 }
 
 type UserProfileDTO struct {
-	ID             string `json:"id,omitempty"`
-	Name           string `json:"name,omitempty"`
-	ProfilePicture string `json:"profilePicture,omitempty"`
+	ID                     string                `json:"id,omitempty"`
+	Name                   string                `json:"name,omitempty"`
+	ProfilePicture         string                `json:"profilePicture,omitempty"`
+	ProfilePictureMediaID  *uuid.UUID            `json:"profilePictureMediaId,omitempty"`
+	ProfilePictureVariants []dto.ImageVariantDTO `json:"profilePictureVariants,omitempty"`
 }
 
+// CreateUpdateDto registers the authenticated user. The email comes from
+// the verified token rather than the body, and an ID in the body has to
+// match the token.
 type CreateUpdateDto struct {
 	ID             string `json:"id"`
 	Name           string `json:"name" validate:"required"`
-	Email          string `json:"email" validate:"required,email"`
+	Email          string `json:"email" swaggerignore:"true"`
+	EmailVerified  bool   `json:"emailVerified" swaggerignore:"true"`
 	ProfilePicture string `json:"profilePicture" validate:"required"`
 }
+
+type UpdateProfilePictureDTO struct {
+	MediaID uuid.UUID `json:"mediaId" validate:"required"`
+}
+
+// UpdateProfileDTO changes the fields that are set and keeps the others.
+type UpdateProfileDTO struct {
+	Name                  *string    `json:"name" validate:"omitnil,min=1,max=255"`
+	ProfilePictureMediaID *uuid.UUID `json:"profilePictureMediaId" validate:"required_without=Name"`
+}
@@ -1,10 +1,20 @@
 package entity
 
+import "github.com/google/uuid"
+
+// User.ProfilePicture holds the picture from the identity provider until the
+// user uploads their own, which is referenced by ProfilePictureMediaID.
+// Erasing a user clears their personal fields and keeps the row as a
+// tombstone recording when the erasure happened and who asked for it.
 type User struct {
-	ID             string `json:"id" gorm:"primaryKey"`
-	Name           string `json:"name" gorm:"type:varchar(255)"`
-	Email          string `json:"email" gorm:"type:varchar(255);uniqueIndex"`
-	ProfilePicture string `json:"profilePicture" gorm:"type:text"`
-	CreatedAt      int64  `json:"createdAt" gorm:"autoCreateTime"`
-	UpdatedAt      int64  `json:"updatedAt" gorm:"autoUpdateTime"`
+	ID                    string     `json:"id" gorm:"primaryKey"`
+	Name                  string     `json:"name" gorm:"type:varchar(255)"`
+	Email                 string     `json:"email" gorm:"type:varchar(255);uniqueIndex"`
+	EmailVerified         bool       `json:"emailVerified" gorm:"not null;default:false"`
+	ProfilePicture        string     `json:"profilePicture" gorm:"type:text"`
+	ProfilePictureMediaID *uuid.UUID `json:"profilePictureMediaId,omitempty" gorm:"type:uuid"`
+	ErasedAt              *int64     `json:"erasedAt,omitempty"`
+	ErasedBy              string     `json:"erasedBy,omitempty" gorm:"type:varchar(255)"`
+	CreatedAt             int64      `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt             int64      `json:"updatedAt" gorm:"autoUpdateTime"`
 }
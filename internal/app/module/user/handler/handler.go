@@ -6,11 +6,18 @@ import (
 	jsonpkg "CodeWithAzri/pkg/jsonPkg"
 	"CodeWithAzri/pkg/requestPkg"
 	"CodeWithAzri/pkg/response"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"sort"
 
 	"github.com/go-playground/validator/v10"
 )
 
+var errMismatchedID = errors.New("the user ID does not match the signed-in user")
+
 type Handler struct {
 	service  service.UserService
 	validate *validator.Validate
@@ -27,7 +34,7 @@ func NewHandler(s service.UserService, v *validator.Validate) *Handler {
 //
 //	@Summary		Create or fetch a user
 //	@Tags			User
-//	@Description	Create a new user if not exists or fetch the existing user based on the provided data.
+//	@Description	Create a new user if not exists or fetch the existing user based on the provided data. The ID and email address come from the verified token; an ID in the body that does not match the token is rejected. Name and picture fill in what the identity provider did not supply.
 //	@ID				create-or-fetch-user
 //	@Accept			json
 //	@Produce		json
@@ -37,6 +44,7 @@ func NewHandler(s service.UserService, v *validator.Validate) *Handler {
 //	@Success		200	{object}	response.Response{data=dto.UserDTO}
 //	@Failure		400	{object}	response.ResponseError
 //	@Failure		401	{object}	response.ResponseError
+//	@Failure		403	{object}	response.ResponseError
 //	@Failure		500	{object}	response.ResponseError
 //	@Router			/api/v1/users [post]
 func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
@@ -54,7 +62,15 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	d.ID = requestPkg.GetUserID(r)
+	claims := requestPkg.GetTokenClaims(r)
+	if d.ID != "" && d.ID != claims.UID {
+		response.RespondError(http.StatusForbidden, errMismatchedID, w)
+		return
+	}
+
+	d.ID = claims.UID
+	d.Email = claims.Email
+	d.EmailVerified = claims.EmailVerified
 
 	user, err := h.service.Create(&d)
 	if err != nil {
@@ -90,3 +106,277 @@ func (h *Handler) GetProfile(w http.ResponseWriter, r *http.Request) {
 
 	response.BuildResponse(http.StatusOK, "User Profile Fetched Successfully", "Success", user, w)
 }
+
+// UpdateProfile godoc
+//
+//	@Summary		Update the profile
+//	@Tags			User
+//	@Description	Change the name and/or the profile picture of the authenticated user. Fields that are left out keep their value. The profile picture must be an image uploaded through the media endpoints with purpose "profile_picture".
+//	@ID				update-user-profile
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.UpdateProfileDTO	true	"Fields to change"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.UserProfileDTO}
+//	@Failure		400	{object}	response.ResponseError
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		404	{object}	response.ResponseError
+//	@Failure		422	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/users/profile [patch]
+func (h *Handler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	var d dto.UpdateProfileDTO
+
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	user, err := h.service.UpdateProfile(requestPkg.GetUserID(r), d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "User Profile Updated Successfully", "Success", user, w)
+}
+
+// UpdateProfilePicture godoc
+//
+//	@Summary		Set the profile picture
+//	@Tags			User
+//	@Description	Use an image uploaded through the media endpoints with purpose "profile_picture" as the profile picture of the authenticated user. Resized variants are listed once the image has been processed.
+//	@ID				update-user-profile-picture
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.UpdateProfilePictureDTO	true	"Uploaded image to use"
+//	@Param			Authorization	header	string						true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.UserProfileDTO}
+//	@Failure		400	{object}	response.ResponseError
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		422	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/users/profile/picture [put]
+func (h *Handler) UpdateProfilePicture(w http.ResponseWriter, r *http.Request) {
+	var d dto.UpdateProfilePictureDTO
+
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	ID := requestPkg.GetUserID(r)
+
+	user, err := h.service.UpdateProfilePicture(ID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "User Profile Picture Updated Successfully", "Success", user, w)
+}
+
+// DeleteAccount godoc
+//
+//	@Summary		Delete the account
+//	@Tags			User
+//	@Description	Erase the account of the authenticated user. Their enrollments, progress, notifications and votes are deleted, their reviews and comments stay without their name, and they can no longer sign in. The account is kept as a tombstone without personal data.
+//	@ID				delete-user-account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		404	{object}	response.ResponseError
+//	@Failure		409	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/users/profile [delete]
+func (h *Handler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	ID := requestPkg.GetUserID(r)
+
+	err := h.service.EraseAccount(ID, ID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "User Account Deleted Successfully", "Success", nil, w)
+}
+
+// ExportData godoc
+//
+//	@Summary		Export personal data
+//	@Tags			User
+//	@Description	Download everything kept about the authenticated user as a ZIP archive with one JSON file per section: profile, enrollments, progress, reviews, comments, notifications and notification_preference.
+//	@ID				export-user-data
+//	@Produce		application/zip
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{file}		file
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		404	{object}	response.ResponseError
+//	@Failure		409	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/users/me/export [get]
+func (h *Handler) ExportData(w http.ResponseWriter, r *http.Request) {
+	sections, err := h.service.ExportData(requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	archive, err := zipSections(sections)
+	if err != nil {
+		response.RespondError(http.StatusInternalServerError, err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="user-data.zip"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(archive)
+}
+
+// EraseUser godoc
+//
+//	@Summary		Erase a user
+//	@Tags			Admin
+//	@Description	Erase the account of a user on their behalf, as for a data erasure request. The account is kept as a tombstone recording when it was erased and by whom. Requires the admin claim.
+//	@ID				erase-user
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"User ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		403	{object}	response.ResponseError
+//	@Failure		404	{object}	response.ResponseError
+//	@Failure		409	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/admin/users/{id}/erasure [post]
+func (h *Handler) EraseUser(w http.ResponseWriter, r *http.Request) {
+	err := h.service.EraseAccount(requestPkg.GetURLParam(r, "id"), requestPkg.GetUserID(r))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "User Erased Successfully", "Success", nil, w)
+}
+
+// GetPublicProfile godoc
+//
+//	@Summary		Fetch a public profile
+//	@Tags			User
+//	@Description	Fetch the profile of any user as shown to other users, without their email address.
+//	@ID				get-user-public-profile
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"User ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.UserProfileDTO}
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		404	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/users/{id} [get]
+func (h *Handler) GetPublicProfile(w http.ResponseWriter, r *http.Request) {
+	user, err := h.service.GetPublicProfile(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "User Profile Fetched Successfully", "Success", user, w)
+}
+
+// GetUsers godoc
+//
+//	@Summary		List users
+//	@Tags			Admin
+//	@Description	Page through the user accounts, newest first, optionally searching names and email addresses. Requires the admin claim.
+//	@ID				get-users
+//	@Accept			json
+//	@Produce		json
+//	@Param			search			query	string	false	"Text to find in the name or email address"
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of users per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.UserDTO}
+//	@Failure		401	{object}	response.ResponseError
+//	@Failure		403	{object}	response.ResponseError
+//	@Failure		500	{object}	response.ResponseError
+//	@Router			/api/v1/admin/users [get]
+func (h *Handler) GetUsers(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+
+	users, err := h.service.GetUsers(requestPkg.GetQueryParam(r, "search"), limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Users Fetched Successfully", "Success", users, w)
+}
+
+// zipSections writes each section as an indented JSON file, in name order.
+func zipSections(sections map[string]any) ([]byte, error) {
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, name := range names {
+		file, err := archive.Create(name + ".json")
+		if err != nil {
+			return nil, err
+		}
+
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(sections[name])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := archive.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrUserErased):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrInvalidProfilePicture):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -3,10 +3,14 @@ package handler_test
 import (
 	"CodeWithAzri/internal/app/module/user/dto"
 	"CodeWithAzri/internal/app/module/user/handler"
+	"CodeWithAzri/internal/app/module/user/service"
 	"CodeWithAzri/internal/app/module/user/service/mocks"
+	"CodeWithAzri/internal/pkg/middleware"
 	jsonpkg "CodeWithAzri/pkg/jsonPkg"
 	"CodeWithAzri/pkg/requestPkg"
+	"archive/zip"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,7 +20,9 @@ import (
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/go-chi/chi"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -27,19 +33,32 @@ func initializeHandler(t *testing.T) (*handler.Handler, *mocks.UserService) {
 	return handler, mockService
 }
 
+// withURLParam routes r as chi would for a pattern with the {key} parameter.
+func withURLParam(r *http.Request, key string, value string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
 func TestHandler_Create(t *testing.T) {
 	userHandler, mockService := initializeHandler(t)
 
 	t.Run("Create User Successfully", func(t *testing.T) {
 		userInput := []byte(`{"name": "John Doe", "email": "john.doe@example.com", "profilePicture": "https://example.com/image.png"}`)
 
-		mockService.On("Create", mock.AnythingOfType("*dto.CreateUpdateDto")).Return(dto.UserDTO{ID: "123", Name: "John Doe", Email: "john.doe@example.com"}, nil)
+		mockService.On("Create", &dto.CreateUpdateDto{
+			ID:             "user123",
+			Name:           "John Doe",
+			Email:          "john.doe@token.example.com",
+			EmailVerified:  true,
+			ProfilePicture: "https://example.com/image.png",
+		}).Return(dto.UserDTO{ID: "user123", Name: "John Doe", Email: "john.doe@token.example.com"}, nil)
 
 		req, err := http.NewRequest("POST", "/create", bytes.NewBuffer(userInput))
 		assert.NoError(t, err)
 
-		patch := monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
-			return "user123"
+		patch := monkey.Patch(requestPkg.GetTokenClaims, func(r *http.Request) middleware.TokenClaims {
+			return middleware.TokenClaims{UID: "user123", Email: "john.doe@token.example.com", EmailVerified: true}
 		})
 		defer patch.Unpatch()
 
@@ -62,6 +81,27 @@ func TestHandler_Create(t *testing.T) {
 	})
 }
 
+func TestHandler_Create_MismatchedID(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	patch := monkey.Patch(requestPkg.GetTokenClaims, func(r *http.Request) middleware.TokenClaims {
+		return middleware.TokenClaims{UID: "user123", Email: "john.doe@example.com"}
+	})
+	defer patch.Unpatch()
+
+	t.Run("Create User With Another ID", func(t *testing.T) {
+		userInput := []byte(`{"id": "user456", "name": "John Doe", "profilePicture": "https://example.com/image.png"}`)
+
+		req := httptest.NewRequest("POST", "/users", bytes.NewBuffer(userInput))
+		recorder := httptest.NewRecorder()
+
+		userHandler.Create(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		mockService.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
 func TestHandler_Create_DecodeError(t *testing.T) {
 	userHandler, _ := initializeHandler(t)
 
@@ -118,8 +158,8 @@ func TestHandler_Create_ServiceError(t *testing.T) {
 		req, err := http.NewRequest("POST", "/create", bytes.NewBuffer(userInput))
 		assert.NoError(t, err)
 
-		patch := monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
-			return "user123"
+		patch := monkey.Patch(requestPkg.GetTokenClaims, func(r *http.Request) middleware.TokenClaims {
+			return middleware.TokenClaims{UID: "user123", Email: "john.doe@example.com"}
 		})
 		defer patch.Unpatch()
 
@@ -193,3 +233,292 @@ func TestHandler_GetProfile_Service_error(t *testing.T) {
 
 	})
 }
+
+func TestHandler_UpdateProfilePicture(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	patch := monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+	defer patch.Unpatch()
+
+	mediaID := uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+	body := `{"mediaId":"6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11"}`
+
+	t.Run("Update Profile Picture Successfully", func(t *testing.T) {
+		mockService.On("UpdateProfilePicture", "user123", dto.UpdateProfilePictureDTO{MediaID: mediaID}).Return(dto.UserProfileDTO{ID: "user123", ProfilePictureMediaID: &mediaID}, nil).Once()
+
+		req := httptest.NewRequest("PUT", "/users/profile/picture", bytes.NewBufferString(body))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfilePicture(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), mediaID.String())
+	})
+
+	t.Run("Update Profile Picture Missing Media ID", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/users/profile/picture", bytes.NewBufferString(`{}`))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfilePicture(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Update Profile Picture Invalid Media", func(t *testing.T) {
+		mockService.On("UpdateProfilePicture", "user123", dto.UpdateProfilePictureDTO{MediaID: mediaID}).Return(dto.UserProfileDTO{}, service.ErrInvalidProfilePicture).Once()
+
+		req := httptest.NewRequest("PUT", "/users/profile/picture", bytes.NewBufferString(body))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfilePicture(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+
+	t.Run("Update Profile Picture Service Error", func(t *testing.T) {
+		mockService.On("UpdateProfilePicture", "user123", dto.UpdateProfilePictureDTO{MediaID: mediaID}).Return(dto.UserProfileDTO{}, errors.New("Internal Server Error")).Once()
+
+		req := httptest.NewRequest("PUT", "/users/profile/picture", bytes.NewBufferString(body))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfilePicture(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_UpdateProfile(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	patch := monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+	defer patch.Unpatch()
+
+	name := "Johnny"
+
+	t.Run("Update Profile Successfully", func(t *testing.T) {
+		mockService.On("UpdateProfile", "user123", dto.UpdateProfileDTO{Name: &name}).Return(dto.UserProfileDTO{ID: "user123", Name: name}, nil).Once()
+
+		req := httptest.NewRequest("PATCH", "/users/profile", bytes.NewBufferString(`{"name":"Johnny"}`))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfile(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"name":"Johnny"`)
+	})
+
+	t.Run("Update Profile Invalid Input", func(t *testing.T) {
+		for _, body := range []string{`{}`, `{"name":""}`, `{"name":null}`, `{"profilePictureMediaId":"not-a-uuid"}`} {
+			req := httptest.NewRequest("PATCH", "/users/profile", bytes.NewBufferString(body))
+			recorder := httptest.NewRecorder()
+
+			userHandler.UpdateProfile(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, body)
+		}
+	})
+
+	t.Run("Update Profile Invalid Picture", func(t *testing.T) {
+		mediaID := uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+		mockService.On("UpdateProfile", "user123", dto.UpdateProfileDTO{ProfilePictureMediaID: &mediaID}).Return(dto.UserProfileDTO{}, service.ErrInvalidProfilePicture).Once()
+
+		req := httptest.NewRequest("PATCH", "/users/profile", bytes.NewBufferString(`{"profilePictureMediaId":"6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11"}`))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfile(recorder, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+
+	t.Run("Update Profile Not Found", func(t *testing.T) {
+		mockService.On("UpdateProfile", "user123", dto.UpdateProfileDTO{Name: &name}).Return(dto.UserProfileDTO{}, service.ErrUserNotFound).Once()
+
+		req := httptest.NewRequest("PATCH", "/users/profile", bytes.NewBufferString(`{"name":"Johnny"}`))
+		recorder := httptest.NewRecorder()
+
+		userHandler.UpdateProfile(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_DeleteAccount(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	patch := monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+	defer patch.Unpatch()
+
+	t.Run("Delete Account Successfully", func(t *testing.T) {
+		mockService.On("EraseAccount", "user123", "user123").Return(nil).Once()
+
+		req := httptest.NewRequest("DELETE", "/users/profile", nil)
+		recorder := httptest.NewRecorder()
+
+		userHandler.DeleteAccount(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Delete Account Not Found", func(t *testing.T) {
+		mockService.On("EraseAccount", "user123", "user123").Return(service.ErrUserNotFound).Once()
+
+		req := httptest.NewRequest("DELETE", "/users/profile", nil)
+		recorder := httptest.NewRecorder()
+
+		userHandler.DeleteAccount(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_ExportData(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	patch := monkey.Patch(requestPkg.GetUserID, func(r *http.Request) string {
+		return "user123"
+	})
+	defer patch.Unpatch()
+
+	t.Run("Export Data Successfully", func(t *testing.T) {
+		mockService.On("ExportData", "user123").Return(map[string]any{
+			"profile":  dto.UserDTO{ID: "user123", Name: "John Doe"},
+			"comments": []string{},
+		}, nil).Once()
+
+		req := httptest.NewRequest("GET", "/users/me/export", nil)
+		recorder := httptest.NewRecorder()
+
+		userHandler.ExportData(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/zip", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="user-data.zip"`, recorder.Header().Get("Content-Disposition"))
+
+		archive, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+		assert.NoError(t, err)
+		assert.Len(t, archive.File, 2)
+		assert.Equal(t, "comments.json", archive.File[0].Name)
+		assert.Equal(t, "profile.json", archive.File[1].Name)
+
+		file, err := archive.File[1].Open()
+		assert.NoError(t, err)
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":"user123","name":"John Doe"}`, string(content))
+	})
+
+	t.Run("Export Data Erased", func(t *testing.T) {
+		mockService.On("ExportData", "user123").Return(nil, service.ErrUserErased).Once()
+
+		req := httptest.NewRequest("GET", "/users/me/export", nil)
+		recorder := httptest.NewRecorder()
+
+		userHandler.ExportData(recorder, req)
+
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+}
+
+func TestHandler_EraseUser(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest("POST", "/admin/users/user456/erasure", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDContextKey, "admin1"))
+		return withURLParam(req, "id", "user456")
+	}
+
+	t.Run("Erase User Successfully", func(t *testing.T) {
+		mockService.On("EraseAccount", "user456", "admin1").Return(nil).Once()
+
+		req := newRequest()
+		recorder := httptest.NewRecorder()
+
+		userHandler.EraseUser(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Erase User Already Erased", func(t *testing.T) {
+		mockService.On("EraseAccount", "user456", "admin1").Return(service.ErrUserErased).Once()
+
+		req := newRequest()
+		recorder := httptest.NewRecorder()
+
+		userHandler.EraseUser(recorder, req)
+
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+
+	t.Run("Erase User Error", func(t *testing.T) {
+		mockService.On("EraseAccount", "user456", "admin1").Return(errors.New("Revoke Failure")).Once()
+
+		req := newRequest()
+		recorder := httptest.NewRecorder()
+
+		userHandler.EraseUser(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_GetPublicProfile(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Public Profile Successfully", func(t *testing.T) {
+		mockService.On("GetPublicProfile", "user456").Return(dto.UserProfileDTO{ID: "user456", Name: "Jane Doe"}, nil).Once()
+
+		req := withURLParam(httptest.NewRequest("GET", "/users/user456", nil), "id", "user456")
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetPublicProfile(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"name":"Jane Doe"`)
+	})
+
+	t.Run("Get Public Profile Not Found", func(t *testing.T) {
+		mockService.On("GetPublicProfile", "user456").Return(dto.UserProfileDTO{}, service.ErrUserNotFound).Once()
+
+		req := withURLParam(httptest.NewRequest("GET", "/users/user456", nil), "id", "user456")
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetPublicProfile(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_GetUsers(t *testing.T) {
+	userHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Users Successfully", func(t *testing.T) {
+		mockService.On("GetUsers", "john", 20, 2).Return([]dto.UserDTO{{ID: "user123", Email: "john@example.com"}}, nil).Once()
+
+		req := httptest.NewRequest("GET", "/admin/users?search=john&limit=20&page=2", nil)
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetUsers(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"email":"john@example.com"`)
+	})
+
+	t.Run("Get Users Service Error", func(t *testing.T) {
+		mockService.On("GetUsers", "", 10, 1).Return(nil, errors.New("Internal Server Error")).Once()
+
+		req := httptest.NewRequest("GET", "/admin/users", nil)
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetUsers(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
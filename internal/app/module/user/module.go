@@ -5,6 +5,7 @@ import (
 	"CodeWithAzri/internal/app/module/user/migration"
 	"CodeWithAzri/internal/app/module/user/repository"
 	"CodeWithAzri/internal/app/module/user/service"
+	"CodeWithAzri/pkg/cache"
 	"database/sql"
 
 	"github.com/go-playground/validator/v10"
@@ -17,10 +18,10 @@ type Module struct {
 	Migration  *migration.UserMigration
 }
 
-func NewModule(db *sql.DB, validate *validator.Validate) *Module {
+func NewModule(db *sql.DB, validate *validator.Validate, media service.MediaReader, revoker service.AccountRevoker, synced cache.Cache) *Module {
 	m := new(Module)
 	m.Repository = repository.NewRepository(db)
-	m.Service = service.NewService(m.Repository)
+	m.Service = service.NewService(m.Repository, media, revoker, synced)
 	m.Handler = handler.NewHandler(m.Service, validate)
 	m.Migration = &migration.UserMigration{}
 
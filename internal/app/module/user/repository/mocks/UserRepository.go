@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	evententity "CodeWithAzri/internal/app/module/event/entity"
 	entity "CodeWithAzri/internal/app/module/user/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // UserRepository is an autogenerated mock type for the UserRepository type
@@ -21,17 +24,24 @@ func (_m *UserRepository) EXPECT() *UserRepository_Expecter {
 	return &UserRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: e
-func (_m *UserRepository) Create(e entity.User) error {
-	ret := _m.Called(e)
+// Create provides a mock function with given fields: e, events
+func (_m *UserRepository) Create(e entity.User, events ...evententity.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, e)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(entity.User) error); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(entity.User, ...evententity.Event) error); ok {
+		r0 = rf(e, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,13 +56,21 @@ type UserRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - e entity.User
-func (_e *UserRepository_Expecter) Create(e interface{}) *UserRepository_Create_Call {
-	return &UserRepository_Create_Call{Call: _e.mock.On("Create", e)}
+//   - events ...evententity.Event
+func (_e *UserRepository_Expecter) Create(e interface{}, events ...interface{}) *UserRepository_Create_Call {
+	return &UserRepository_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{e}, events...)...)}
 }
 
-func (_c *UserRepository_Create_Call) Run(run func(e entity.User)) *UserRepository_Create_Call {
+func (_c *UserRepository_Create_Call) Run(run func(e entity.User, events ...evententity.Event)) *UserRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(entity.User))
+		variadicArgs := make([]evententity.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(entity.User), variadicArgs...)
 	})
 	return _c
 }
@@ -62,22 +80,29 @@ func (_c *UserRepository_Create_Call) Return(_a0 error) *UserRepository_Create_C
 	return _c
 }
 
-func (_c *UserRepository_Create_Call) RunAndReturn(run func(entity.User) error) *UserRepository_Create_Call {
+func (_c *UserRepository_Create_Call) RunAndReturn(run func(entity.User, ...evententity.Event) error) *UserRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *UserRepository) Delete(id string) error {
-	ret := _m.Called(id)
+// Erase provides a mock function with given fields: id, erasedBy, erasedAt, events
+func (_m *UserRepository) Erase(id string, erasedBy string, erasedAt int64, events ...evententity.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, erasedBy, erasedAt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Erase")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(string, string, int64, ...evententity.Event) error); ok {
+		r0 = rf(id, erasedBy, erasedAt, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -85,37 +110,47 @@ func (_m *UserRepository) Delete(id string) error {
 	return r0
 }
 
-// UserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type UserRepository_Delete_Call struct {
+// UserRepository_Erase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Erase'
+type UserRepository_Erase_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Erase is a helper method to define mock.On call
 //   - id string
-func (_e *UserRepository_Expecter) Delete(id interface{}) *UserRepository_Delete_Call {
-	return &UserRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+//   - erasedBy string
+//   - erasedAt int64
+//   - events ...evententity.Event
+func (_e *UserRepository_Expecter) Erase(id interface{}, erasedBy interface{}, erasedAt interface{}, events ...interface{}) *UserRepository_Erase_Call {
+	return &UserRepository_Erase_Call{Call: _e.mock.On("Erase",
+		append([]interface{}{id, erasedBy, erasedAt}, events...)...)}
 }
 
-func (_c *UserRepository_Delete_Call) Run(run func(id string)) *UserRepository_Delete_Call {
+func (_c *UserRepository_Erase_Call) Run(run func(id string, erasedBy string, erasedAt int64, events ...evententity.Event)) *UserRepository_Erase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]evententity.Event, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(evententity.Event)
+			}
+		}
+		run(args[0].(string), args[1].(string), args[2].(int64), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *UserRepository_Delete_Call) Return(_a0 error) *UserRepository_Delete_Call {
+func (_c *UserRepository_Erase_Call) Return(_a0 error) *UserRepository_Erase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UserRepository_Delete_Call) RunAndReturn(run func(string) error) *UserRepository_Delete_Call {
+func (_c *UserRepository_Erase_Call) RunAndReturn(run func(string, string, int64, ...evententity.Event) error) *UserRepository_Erase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadMany provides a mock function with given fields: limit, offset
-func (_m *UserRepository) ReadMany(limit int, offset int) ([]entity.User, error) {
-	ret := _m.Called(limit, offset)
+// ReadMany provides a mock function with given fields: search, limit, offset
+func (_m *UserRepository) ReadMany(search string, limit int, offset int) ([]entity.User, error) {
+	ret := _m.Called(search, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReadMany")
@@ -123,19 +158,19 @@ func (_m *UserRepository) ReadMany(limit int, offset int) ([]entity.User, error)
 
 	var r0 []entity.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]entity.User, error)); ok {
-		return rf(limit, offset)
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.User, error)); ok {
+		return rf(search, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []entity.User); ok {
-		r0 = rf(limit, offset)
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.User); ok {
+		r0 = rf(search, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(limit, offset)
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(search, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -149,15 +184,16 @@ type UserRepository_ReadMany_Call struct {
 }
 
 // ReadMany is a helper method to define mock.On call
+//   - search string
 //   - limit int
 //   - offset int
-func (_e *UserRepository_Expecter) ReadMany(limit interface{}, offset interface{}) *UserRepository_ReadMany_Call {
-	return &UserRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", limit, offset)}
+func (_e *UserRepository_Expecter) ReadMany(search interface{}, limit interface{}, offset interface{}) *UserRepository_ReadMany_Call {
+	return &UserRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", search, limit, offset)}
 }
 
-func (_c *UserRepository_ReadMany_Call) Run(run func(limit int, offset int)) *UserRepository_ReadMany_Call {
+func (_c *UserRepository_ReadMany_Call) Run(run func(search string, limit int, offset int)) *UserRepository_ReadMany_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(string), args[1].(int), args[2].(int))
 	})
 	return _c
 }
@@ -167,7 +203,7 @@ func (_c *UserRepository_ReadMany_Call) Return(_a0 []entity.User, _a1 error) *Us
 	return _c
 }
 
-func (_c *UserRepository_ReadMany_Call) RunAndReturn(run func(int, int) ([]entity.User, error)) *UserRepository_ReadMany_Call {
+func (_c *UserRepository_ReadMany_Call) RunAndReturn(run func(string, int, int) ([]entity.User, error)) *UserRepository_ReadMany_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -275,6 +311,101 @@ func (_c *UserRepository_Update_Call) RunAndReturn(run func(string, entity.User)
 	return _c
 }
 
+// UpdateIdentity provides a mock function with given fields: id, e
+func (_m *UserRepository) UpdateIdentity(id string, e entity.User) error {
+	ret := _m.Called(id, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, entity.User) error); ok {
+		r0 = rf(id, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdateIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdentity'
+type UserRepository_UpdateIdentity_Call struct {
+	*mock.Call
+}
+
+// UpdateIdentity is a helper method to define mock.On call
+//   - id string
+//   - e entity.User
+func (_e *UserRepository_Expecter) UpdateIdentity(id interface{}, e interface{}) *UserRepository_UpdateIdentity_Call {
+	return &UserRepository_UpdateIdentity_Call{Call: _e.mock.On("UpdateIdentity", id, e)}
+}
+
+func (_c *UserRepository_UpdateIdentity_Call) Run(run func(id string, e entity.User)) *UserRepository_UpdateIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(entity.User))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateIdentity_Call) Return(_a0 error) *UserRepository_UpdateIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_UpdateIdentity_Call) RunAndReturn(run func(string, entity.User) error) *UserRepository_UpdateIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfilePicture provides a mock function with given fields: id, mediaID, updatedAt
+func (_m *UserRepository) UpdateProfilePicture(id string, mediaID uuid.UUID, updatedAt int64) error {
+	ret := _m.Called(id, mediaID, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfilePicture")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uuid.UUID, int64) error); ok {
+		r0 = rf(id, mediaID, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdateProfilePicture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfilePicture'
+type UserRepository_UpdateProfilePicture_Call struct {
+	*mock.Call
+}
+
+// UpdateProfilePicture is a helper method to define mock.On call
+//   - id string
+//   - mediaID uuid.UUID
+//   - updatedAt int64
+func (_e *UserRepository_Expecter) UpdateProfilePicture(id interface{}, mediaID interface{}, updatedAt interface{}) *UserRepository_UpdateProfilePicture_Call {
+	return &UserRepository_UpdateProfilePicture_Call{Call: _e.mock.On("UpdateProfilePicture", id, mediaID, updatedAt)}
+}
+
+func (_c *UserRepository_UpdateProfilePicture_Call) Run(run func(id string, mediaID uuid.UUID, updatedAt int64)) *UserRepository_UpdateProfilePicture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateProfilePicture_Call) Return(_a0 error) *UserRepository_UpdateProfilePicture_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_UpdateProfilePicture_Call) RunAndReturn(run func(string, uuid.UUID, int64) error) *UserRepository_UpdateProfilePicture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
@@ -1,16 +1,27 @@
 package repository
 
 import (
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	eventRepository "CodeWithAzri/internal/app/module/event/repository"
 	"CodeWithAzri/internal/app/module/user/entity"
 	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
 )
 
+// userColumns reads the email of erased users, which is NULL so it never
+// clashes with the unique index, as an empty string.
+const userColumns = "id, name, COALESCE(email, ''), email_verified, profile_picture, profile_picture_media_id, erased_at, erased_by, created_at, updated_at"
+
 type UserRepository interface {
-	Create(e entity.User) error
-	ReadMany(limit, offset int) ([]entity.User, error)
+	Create(e entity.User, events ...eventEntity.Event) error
+	ReadMany(search string, limit, offset int) ([]entity.User, error)
 	ReadOne(id string) (entity.User, error)
 	Update(id string, e entity.User) error
-	Delete(id string) error
+	UpdateIdentity(id string, e entity.User) error
+	UpdateProfilePicture(id string, mediaID uuid.UUID, updatedAt int64) error
+	Erase(id string, erasedBy string, erasedAt int64, events ...eventEntity.Event) error
 }
 
 type Repository struct {
@@ -22,24 +33,57 @@ func NewRepository(db *sql.DB) UserRepository {
 	return r
 }
 
-func (r *Repository) Create(e entity.User) error {
-	query := "INSERT INTO users (id, name, email, profile_picture, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)"
-	_, err := r.db.Exec(query, e.ID, e.Name, e.Email, e.ProfilePicture, e.CreatedAt, e.UpdatedAt)
-	return err
+// Create stores a user and appends events to the outbox in the same
+// transaction.
+func (r *Repository) Create(e entity.User, events ...eventEntity.Event) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		INSERT INTO users (id, name, email, email_verified, profile_picture, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7)
+	`
+	_, err = tx.Exec(query, e.ID, e.Name, e.Email, e.EmailVerified, e.ProfilePicture, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	err = eventRepository.Append(tx, events...)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r *Repository) ReadMany(limit, offset int) ([]entity.User, error) {
-	query := "SELECT * FROM users LIMIT $1 OFFSET $2"
-	rows, err := r.db.Query(query, limit, offset)
+// likeEscaper escapes the wildcards of LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// ReadMany lists users from newest to oldest. A non-empty search matches
+// users whose name or email contains it, ignoring case.
+func (r *Repository) ReadMany(search string, limit, offset int) ([]entity.User, error) {
+	query := `
+		SELECT ` + userColumns + ` FROM users
+		WHERE $1 = '' OR name ILIKE '%' || $1 || '%' OR email ILIKE '%' || $1 || '%'
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.Query(query, likeEscaper.Replace(search), limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var users []entity.User
+	users := make([]entity.User, 0)
 	for rows.Next() {
-		var user entity.User
-		err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.ProfilePicture, &user.CreatedAt, &user.UpdatedAt)
+		user, err := scanUser(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -50,11 +94,8 @@ func (r *Repository) ReadMany(limit, offset int) ([]entity.User, error) {
 }
 
 func (r *Repository) ReadOne(id string) (entity.User, error) {
-	query := "SELECT * FROM users WHERE id = $1"
-	row := r.db.QueryRow(query, id)
-
-	var user entity.User
-	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.ProfilePicture, &user.CreatedAt, &user.UpdatedAt)
+	query := "SELECT " + userColumns + " FROM users WHERE id = $1"
+	user, err := scanUser(r.db.QueryRow(query, id))
 	if err != nil {
 		return entity.User{}, err
 	}
@@ -62,14 +103,70 @@ func (r *Repository) ReadOne(id string) (entity.User, error) {
 	return user, nil
 }
 
+// Update saves the name and uploaded profile picture of a user.
 func (r *Repository) Update(id string, e entity.User) error {
-	query := "UPDATE users SET name = $1 WHERE id = $2"
-	_, err := r.db.Exec(query, e.Name, id)
+	query := "UPDATE users SET name = $1, profile_picture_media_id = $2, updated_at = $3 WHERE id = $4"
+	_, err := r.db.Exec(query, e.Name, e.ProfilePictureMediaID, e.UpdatedAt, id)
+	return err
+}
+
+// UpdateIdentity saves what the identity provider knows about a user: their
+// name, email address and the picture it supplies.
+func (r *Repository) UpdateIdentity(id string, e entity.User) error {
+	query := `
+		UPDATE users SET name = $1, email = NULLIF($2, ''), email_verified = $3, profile_picture = $4, updated_at = $5
+		WHERE id = $6
+	`
+	_, err := r.db.Exec(query, e.Name, e.Email, e.EmailVerified, e.ProfilePicture, e.UpdatedAt, id)
 	return err
 }
 
-func (r *Repository) Delete(id string) error {
-	query := "DELETE FROM users WHERE id = $1"
-	_, err := r.db.Exec(query, id)
+func (r *Repository) UpdateProfilePicture(id string, mediaID uuid.UUID, updatedAt int64) error {
+	query := "UPDATE users SET profile_picture_media_id = $1, updated_at = $2 WHERE id = $3"
+	_, err := r.db.Exec(query, mediaID, updatedAt, id)
 	return err
 }
+
+// Erase clears the personal fields of a user, keeping the row as a tombstone,
+// and appends events to the outbox in the same transaction.
+func (r *Repository) Erase(id string, erasedBy string, erasedAt int64, events ...eventEntity.Event) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `
+		UPDATE users SET name = '', email = NULL, email_verified = false, profile_picture = '', profile_picture_media_id = NULL,
+			erased_at = $1, erased_by = $2, updated_at = $1
+		WHERE id = $3
+	`
+	_, err = tx.Exec(query, erasedAt, erasedBy, id)
+	if err != nil {
+		return err
+	}
+
+	err = eventRepository.Append(tx, events...)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row rowScanner) (entity.User, error) {
+	var user entity.User
+	var erasedBy sql.NullString
+	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.EmailVerified, &user.ProfilePicture, &user.ProfilePictureMediaID,
+		&user.ErasedAt, &erasedBy, &user.CreatedAt, &user.UpdatedAt)
+	user.ErasedBy = erasedBy.String
+	return user, err
+}
@@ -1,15 +1,26 @@
 package repository_test
 
 import (
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
 	"CodeWithAzri/internal/app/module/user/entity"
 	"CodeWithAzri/internal/app/module/user/repository"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
 	"database/sql"
+	"errors"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
+const (
+	createUserQuery  = "INSERT INTO users (id, name, email, email_verified, profile_picture, created_at, updated_at) VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7)"
+	appendEventQuery = "INSERT INTO outbox_events (id, type, aggregate_id, payload, created_at) VALUES ($1, $2, $3, $4, $5)"
+	readManyQuery    = "SELECT id, name, COALESCE(email, ''), email_verified, profile_picture, profile_picture_media_id, erased_at, erased_by, created_at, updated_at FROM users WHERE $1 = '' OR name ILIKE '%' || $1 || '%' OR email ILIKE '%' || $1 || '%' ORDER BY created_at DESC, id LIMIT $2 OFFSET $3"
+	eraseUserQuery   = "UPDATE users SET name = '', email = NULL, email_verified = false, profile_picture = '', profile_picture_media_id = NULL, erased_at = $1, erased_by = $2, updated_at = $1 WHERE id = $3"
+)
+
 func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.UserRepository) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -29,37 +40,81 @@ func TestRepository_Create(t *testing.T) {
 		ID:             "1",
 		Name:           "John Doe",
 		Email:          "john@example.com",
+		EmailVerified:  true,
 		ProfilePicture: "https://example.com/profile.png",
 		CreatedAt:      121212,
 		UpdatedAt:      121212,
 	}
 
-	mock.ExpectExec("INSERT INTO users (id, name, email, profile_picture, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)").
-		WithArgs(user.ID, user.Name, user.Email, user.ProfilePicture, user.CreatedAt, user.UpdatedAt).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	event := eventEntity.Event{
+		ID:          uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+		Type:        event_type_enum.UserRegistered,
+		AggregateID: "1",
+		Payload:     `{"user_id":"1"}`,
+		CreatedAt:   121212,
+	}
 
-	err := repo.Create(user)
-	assert.NoError(t, err)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	t.Run("Create Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createUserQuery).
+			WithArgs(user.ID, user.Name, user.Email, user.EmailVerified, user.ProfilePicture, user.CreatedAt, user.UpdatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(appendEventQuery).
+			WithArgs(event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Create(user, event)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create Append Event Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createUserQuery).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(appendEventQuery).WillReturnError(errors.New("insert failed"))
+		mock.ExpectRollback()
+
+		err := repo.Create(user, event)
+		assert.EqualError(t, err, "failed to append event: insert failed")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 func TestRepository_ReadMany(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "profile_picture", "created_at", "updated_at"}).
-		AddRow("1", "John Doe", "john@domain.com", "https://example.com/profile.png", 121212, 121212).
-		AddRow("2", "Jane Doe", "jane@domain.com", "https://example.com/profile.png", 121212, 121212)
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "email_verified", "profile_picture", "profile_picture_media_id", "erased_at", "erased_by", "created_at", "updated_at"}).
+		AddRow("1", "John Doe", "john@domain.com", false, "https://example.com/profile.png", nil, nil, nil, 121212, 121212).
+		AddRow("2", "Jane Doe", "jane@domain.com", false, "https://example.com/profile.png", nil, nil, nil, 121212, 121212)
 
-	mock.ExpectQuery("SELECT * FROM users LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+	mock.ExpectQuery(readManyQuery).
+		WithArgs("", 10, 0).
 		WillReturnRows(rows)
 
-	users, err := repo.ReadMany(10, 0)
+	users, err := repo.ReadMany("", 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, users, 2)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestRepository_ReadMany_Search(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "email_verified", "profile_picture", "profile_picture_media_id", "erased_at", "erased_by", "created_at", "updated_at"})
+
+	mock.ExpectQuery(readManyQuery).
+		WithArgs(`50\% off\_sale`, 10, 10).
+		WillReturnRows(rows)
+
+	users, err := repo.ReadMany("50% off_sale", 10, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_ReadOne(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
@@ -74,10 +129,10 @@ func TestRepository_ReadOne(t *testing.T) {
 		UpdatedAt:      121212,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "profile_picture", "created_at", "updated_at"}).
-		AddRow(user.ID, user.Name, user.Email, user.ProfilePicture, user.CreatedAt, user.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "email_verified", "profile_picture", "profile_picture_media_id", "erased_at", "erased_by", "created_at", "updated_at"}).
+		AddRow(user.ID, user.Name, user.Email, user.EmailVerified, user.ProfilePicture, nil, nil, nil, user.CreatedAt, user.UpdatedAt)
 
-	mock.ExpectQuery(`SELECT * FROM users WHERE id = $1`).
+	mock.ExpectQuery(`SELECT id, name, COALESCE(email, ''), email_verified, profile_picture, profile_picture_media_id, erased_at, erased_by, created_at, updated_at FROM users WHERE id = $1`).
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnRows(rows)
 
@@ -101,8 +156,8 @@ func TestRepository_Update(t *testing.T) {
 		UpdatedAt:      121212,
 	}
 
-	mock.ExpectExec("UPDATE users SET name = $1 WHERE id = $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+	mock.ExpectExec("UPDATE users SET name = $1, profile_picture_media_id = $2, updated_at = $3 WHERE id = $4").
+		WithArgs(user.Name, user.ProfilePictureMediaID, user.UpdatedAt, userID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	err := repo.Update(userID, user)
@@ -110,28 +165,97 @@ func TestRepository_Update(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestRepository_Delete(t *testing.T) {
+func TestRepository_UpdateIdentity(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	user := entity.User{
+		ID:             "1",
+		Name:           "John Doe",
+		Email:          "john.new@example.com",
+		EmailVerified:  true,
+		ProfilePicture: "https://example.com/profile.png",
+		UpdatedAt:      131313,
+	}
+
+	mock.ExpectExec("UPDATE users SET name = $1, email = NULLIF($2, ''), email_verified = $3, profile_picture = $4, updated_at = $5 WHERE id = $6").
+		WithArgs(user.Name, user.Email, user.EmailVerified, user.ProfilePicture, user.UpdatedAt, user.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdateIdentity(user.ID, user)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateProfilePicture(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
 	userID := "1"
+	mediaID := uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
 
-	mock.ExpectExec("DELETE FROM users WHERE id = $1").
-		WithArgs(userID).
+	mock.ExpectExec("UPDATE users SET profile_picture_media_id = $1, updated_at = $2 WHERE id = $3").
+		WithArgs(mediaID, int64(131313), userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdateProfilePicture(userID, mediaID, 131313)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Erase(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	userID := "1"
+
+	event := eventEntity.Event{
+		ID:          uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c12"),
+		Type:        event_type_enum.UserDeleted,
+		AggregateID: userID,
+		Payload:     `{"user_id":"1"}`,
+		CreatedAt:   121212,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(eraseUserQuery).
+		WithArgs(int64(121212), "admin1", userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(appendEventQuery).
+		WithArgs(event.ID, event.Type, event.AggregateID, event.Payload, event.CreatedAt).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
-	err := repo.Delete(userID)
+	err := repo.Erase(userID, "admin1", 121212, event)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestRepository_ReadOne_Erased(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	erasedAt := int64(131313)
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "email_verified", "profile_picture", "profile_picture_media_id", "erased_at", "erased_by", "created_at", "updated_at"}).
+		AddRow("1", "", "", false, "", nil, erasedAt, "admin1", 121212, erasedAt)
+
+	mock.ExpectQuery(`SELECT id, name, COALESCE(email, ''), email_verified, profile_picture, profile_picture_media_id, erased_at, erased_by, created_at, updated_at FROM users WHERE id = $1`).
+		WithArgs("1").
+		WillReturnRows(rows)
+
+	result, err := repo.ReadOne("1")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.User{ID: "1", ErasedAt: &erasedAt, ErasedBy: "admin1", CreatedAt: 121212, UpdatedAt: erasedAt}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_ReadOne_Error(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
 	userID := "1"
 
-	mock.ExpectQuery(`SELECT * FROM users WHERE id = $1`).
+	mock.ExpectQuery(`SELECT id, name, COALESCE(email, ''), email_verified, profile_picture, profile_picture_media_id, erased_at, erased_by, created_at, updated_at FROM users WHERE id = $1`).
 		WithArgs(userID).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
 			AddRow(userID, "John Doe").
@@ -149,7 +273,7 @@ func TestRepository_ReadOne_NoRecord(t *testing.T) {
 
 	userID := "nonexistent"
 
-	mock.ExpectQuery(`SELECT * FROM users WHERE id = $1`).
+	mock.ExpectQuery(`SELECT id, name, COALESCE(email, ''), email_verified, profile_picture, profile_picture_media_id, erased_at, erased_by, created_at, updated_at FROM users WHERE id = $1`).
 		WithArgs(userID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -159,20 +283,21 @@ func TestRepository_ReadOne_NoRecord(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestRepository_Delete_NoRecord(t *testing.T) {
+func TestRepository_Erase_Error(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
-	userID := "nonexistent"
+	userID := "1"
 
-	mock.ExpectExec("DELETE FROM users WHERE id = $1").
-		WithArgs(userID).
-		WillReturnResult(sqlmock.NewResult(0, 0)).
-		WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectExec(eraseUserQuery).
+		WithArgs(int64(121212), userID, userID).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
 
-	err := repo.Delete(userID)
+	err := repo.Erase(userID, userID, 121212)
 	assert.Error(t, err)
-	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.ErrorIs(t, err, sql.ErrConnDone)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -180,11 +305,11 @@ func TestRepository_ReadMany_Error(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
-	mock.ExpectQuery("SELECT * FROM users LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+	mock.ExpectQuery(readManyQuery).
+		WithArgs("", 10, 0).
 		WillReturnError(sql.ErrTxDone)
 
-	_, err := repo.ReadMany(10, 0)
+	_, err := repo.ReadMany("", 10, 0)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, sql.ErrTxDone)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -194,15 +319,15 @@ func TestRepository_ReadMany_ErrorScan(t *testing.T) {
 	db, mock, repo := initializeMockDB(t)
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "profile_picture", "created_at", "updated_at"}).
-		AddRow("1", "John Doe", "john@domain.com", "https://example.com/profile.png", "invalid_created_at", 121212).
-		AddRow("2", "Jane Doe", "jane@domain.com", "https://example.com/profile.png", 121212, 121212)
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "email_verified", "profile_picture", "profile_picture_media_id", "erased_at", "erased_by", "created_at", "updated_at"}).
+		AddRow("1", "John Doe", "john@domain.com", false, "https://example.com/profile.png", nil, nil, nil, "invalid_created_at", 121212).
+		AddRow("2", "Jane Doe", "jane@domain.com", false, "https://example.com/profile.png", nil, nil, nil, 121212, 121212)
 
-	mock.ExpectQuery("SELECT * FROM users LIMIT $1 OFFSET $2").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+	mock.ExpectQuery(readManyQuery).
+		WithArgs("", 10, 0).
 		WillReturnRows(rows)
 
-	users, err := repo.ReadMany(10, 0)
+	users, err := repo.ReadMany("", 10, 0)
 	assert.Error(t, err)
 	assert.Nil(t, users)
 	assert.Contains(t, err.Error(), "convert")
@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// AccountRevoker is an autogenerated mock type for the AccountRevoker type
+type AccountRevoker struct {
+	mock.Mock
+}
+
+type AccountRevoker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountRevoker) EXPECT() *AccountRevoker_Expecter {
+	return &AccountRevoker_Expecter{mock: &_m.Mock}
+}
+
+// RevokeAccount provides a mock function with given fields: uid
+func (_m *AccountRevoker) RevokeAccount(uid string) error {
+	ret := _m.Called(uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountRevoker_RevokeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccount'
+type AccountRevoker_RevokeAccount_Call struct {
+	*mock.Call
+}
+
+// RevokeAccount is a helper method to define mock.On call
+//   - uid string
+func (_e *AccountRevoker_Expecter) RevokeAccount(uid interface{}) *AccountRevoker_RevokeAccount_Call {
+	return &AccountRevoker_RevokeAccount_Call{Call: _e.mock.On("RevokeAccount", uid)}
+}
+
+func (_c *AccountRevoker_RevokeAccount_Call) Run(run func(uid string)) *AccountRevoker_RevokeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AccountRevoker_RevokeAccount_Call) Return(_a0 error) *AccountRevoker_RevokeAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountRevoker_RevokeAccount_Call) RunAndReturn(run func(string) error) *AccountRevoker_RevokeAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountRevoker creates a new instance of AccountRevoker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountRevoker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountRevoker {
+	mock := &AccountRevoker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
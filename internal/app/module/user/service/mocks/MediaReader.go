@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/media/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MediaReader is an autogenerated mock type for the MediaReader type
+type MediaReader struct {
+	mock.Mock
+}
+
+type MediaReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MediaReader) EXPECT() *MediaReader_Expecter {
+	return &MediaReader_Expecter{mock: &_m.Mock}
+}
+
+// GetImages provides a mock function with given fields: ids
+func (_m *MediaReader) GetImages(ids []uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImages")
+	}
+
+	var r0 map[uuid.UUID]dto.MediaDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) map[uuid.UUID]dto.MediaDTO); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]dto.MediaDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaReader_GetImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImages'
+type MediaReader_GetImages_Call struct {
+	*mock.Call
+}
+
+// GetImages is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MediaReader_Expecter) GetImages(ids interface{}) *MediaReader_GetImages_Call {
+	return &MediaReader_GetImages_Call{Call: _e.mock.On("GetImages", ids)}
+}
+
+func (_c *MediaReader_GetImages_Call) Run(run func(ids []uuid.UUID)) *MediaReader_GetImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MediaReader_GetImages_Call) Return(_a0 map[uuid.UUID]dto.MediaDTO, _a1 error) *MediaReader_GetImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaReader_GetImages_Call) RunAndReturn(run func([]uuid.UUID) (map[uuid.UUID]dto.MediaDTO, error)) *MediaReader_GetImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMediaReader creates a new instance of MediaReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMediaReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MediaReader {
+	mock := &MediaReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
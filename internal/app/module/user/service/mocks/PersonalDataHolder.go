@@ -0,0 +1,136 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// PersonalDataHolder is an autogenerated mock type for the PersonalDataHolder type
+type PersonalDataHolder struct {
+	mock.Mock
+}
+
+type PersonalDataHolder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PersonalDataHolder) EXPECT() *PersonalDataHolder_Expecter {
+	return &PersonalDataHolder_Expecter{mock: &_m.Mock}
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *PersonalDataHolder) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PersonalDataHolder_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type PersonalDataHolder_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *PersonalDataHolder_Expecter) EraseUserData(userID interface{}) *PersonalDataHolder_EraseUserData_Call {
+	return &PersonalDataHolder_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *PersonalDataHolder_EraseUserData_Call) Run(run func(userID string)) *PersonalDataHolder_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PersonalDataHolder_EraseUserData_Call) Return(_a0 error) *PersonalDataHolder_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonalDataHolder_EraseUserData_Call) RunAndReturn(run func(string) error) *PersonalDataHolder_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: userID
+func (_m *PersonalDataHolder) ExportUserData(userID string) (map[string]any, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PersonalDataHolder_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type PersonalDataHolder_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *PersonalDataHolder_Expecter) ExportUserData(userID interface{}) *PersonalDataHolder_ExportUserData_Call {
+	return &PersonalDataHolder_ExportUserData_Call{Call: _e.mock.On("ExportUserData", userID)}
+}
+
+func (_c *PersonalDataHolder_ExportUserData_Call) Run(run func(userID string)) *PersonalDataHolder_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PersonalDataHolder_ExportUserData_Call) Return(_a0 map[string]any, _a1 error) *PersonalDataHolder_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonalDataHolder_ExportUserData_Call) RunAndReturn(run func(string) (map[string]any, error)) *PersonalDataHolder_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPersonalDataHolder creates a new instance of PersonalDataHolder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPersonalDataHolder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PersonalDataHolder {
+	mock := &PersonalDataHolder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,8 +4,11 @@ package mocks
 
 import (
 	dto "CodeWithAzri/internal/app/module/user/dto"
+	middleware "CodeWithAzri/internal/pkg/middleware"
 
 	mock "github.com/stretchr/testify/mock"
+
+	service "CodeWithAzri/internal/app/module/user/service"
 )
 
 // UserService is an autogenerated mock type for the UserService type
@@ -21,6 +24,39 @@ func (_m *UserService) EXPECT() *UserService_Expecter {
 	return &UserService_Expecter{mock: &_m.Mock}
 }
 
+// AddPersonalDataHolder provides a mock function with given fields: holder
+func (_m *UserService) AddPersonalDataHolder(holder service.PersonalDataHolder) {
+	_m.Called(holder)
+}
+
+// UserService_AddPersonalDataHolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPersonalDataHolder'
+type UserService_AddPersonalDataHolder_Call struct {
+	*mock.Call
+}
+
+// AddPersonalDataHolder is a helper method to define mock.On call
+//   - holder service.PersonalDataHolder
+func (_e *UserService_Expecter) AddPersonalDataHolder(holder interface{}) *UserService_AddPersonalDataHolder_Call {
+	return &UserService_AddPersonalDataHolder_Call{Call: _e.mock.On("AddPersonalDataHolder", holder)}
+}
+
+func (_c *UserService_AddPersonalDataHolder_Call) Run(run func(holder service.PersonalDataHolder)) *UserService_AddPersonalDataHolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.PersonalDataHolder))
+	})
+	return _c
+}
+
+func (_c *UserService_AddPersonalDataHolder_Call) Return() *UserService_AddPersonalDataHolder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserService_AddPersonalDataHolder_Call) RunAndReturn(run func(service.PersonalDataHolder)) *UserService_AddPersonalDataHolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: _a0
 func (_m *UserService) Create(_a0 *dto.CreateUpdateDto) (dto.UserDTO, error) {
 	ret := _m.Called(_a0)
@@ -77,6 +113,111 @@ func (_c *UserService_Create_Call) RunAndReturn(run func(*dto.CreateUpdateDto) (
 	return _c
 }
 
+// EraseAccount provides a mock function with given fields: ID, requestedBy
+func (_m *UserService) EraseAccount(ID string, requestedBy string) error {
+	ret := _m.Called(ID, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(ID, requestedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserService_EraseAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseAccount'
+type UserService_EraseAccount_Call struct {
+	*mock.Call
+}
+
+// EraseAccount is a helper method to define mock.On call
+//   - ID string
+//   - requestedBy string
+func (_e *UserService_Expecter) EraseAccount(ID interface{}, requestedBy interface{}) *UserService_EraseAccount_Call {
+	return &UserService_EraseAccount_Call{Call: _e.mock.On("EraseAccount", ID, requestedBy)}
+}
+
+func (_c *UserService_EraseAccount_Call) Run(run func(ID string, requestedBy string)) *UserService_EraseAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_EraseAccount_Call) Return(_a0 error) *UserService_EraseAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserService_EraseAccount_Call) RunAndReturn(run func(string, string) error) *UserService_EraseAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportData provides a mock function with given fields: ID
+func (_m *UserService) ExportData(ID string) (map[string]any, error) {
+	ret := _m.Called(ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(ID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_ExportData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportData'
+type UserService_ExportData_Call struct {
+	*mock.Call
+}
+
+// ExportData is a helper method to define mock.On call
+//   - ID string
+func (_e *UserService_Expecter) ExportData(ID interface{}) *UserService_ExportData_Call {
+	return &UserService_ExportData_Call{Call: _e.mock.On("ExportData", ID)}
+}
+
+func (_c *UserService_ExportData_Call) Run(run func(ID string)) *UserService_ExportData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_ExportData_Call) Return(_a0 map[string]any, _a1 error) *UserService_ExportData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_ExportData_Call) RunAndReturn(run func(string) (map[string]any, error)) *UserService_ExportData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProfile provides a mock function with given fields: ID
 func (_m *UserService) GetProfile(ID string) (dto.UserProfileDTO, error) {
 	ret := _m.Called(ID)
@@ -133,6 +274,348 @@ func (_c *UserService_GetProfile_Call) RunAndReturn(run func(string) (dto.UserPr
 	return _c
 }
 
+// GetPublicProfile provides a mock function with given fields: ID
+func (_m *UserService) GetPublicProfile(ID string) (dto.UserProfileDTO, error) {
+	ret := _m.Called(ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfile")
+	}
+
+	var r0 dto.UserProfileDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (dto.UserProfileDTO, error)); ok {
+		return rf(ID)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.UserProfileDTO); ok {
+		r0 = rf(ID)
+	} else {
+		r0 = ret.Get(0).(dto.UserProfileDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetPublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfile'
+type UserService_GetPublicProfile_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfile is a helper method to define mock.On call
+//   - ID string
+func (_e *UserService_Expecter) GetPublicProfile(ID interface{}) *UserService_GetPublicProfile_Call {
+	return &UserService_GetPublicProfile_Call{Call: _e.mock.On("GetPublicProfile", ID)}
+}
+
+func (_c *UserService_GetPublicProfile_Call) Run(run func(ID string)) *UserService_GetPublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_GetPublicProfile_Call) Return(_a0 dto.UserProfileDTO, _a1 error) *UserService_GetPublicProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetPublicProfile_Call) RunAndReturn(run func(string) (dto.UserProfileDTO, error)) *UserService_GetPublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ID
+func (_m *UserService) GetUser(ID string) (dto.UserDTO, error) {
+	ret := _m.Called(ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 dto.UserDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (dto.UserDTO, error)); ok {
+		return rf(ID)
+	}
+	if rf, ok := ret.Get(0).(func(string) dto.UserDTO); ok {
+		r0 = rf(ID)
+	} else {
+		r0 = ret.Get(0).(dto.UserDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type UserService_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ID string
+func (_e *UserService_Expecter) GetUser(ID interface{}) *UserService_GetUser_Call {
+	return &UserService_GetUser_Call{Call: _e.mock.On("GetUser", ID)}
+}
+
+func (_c *UserService_GetUser_Call) Run(run func(ID string)) *UserService_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUser_Call) Return(_a0 dto.UserDTO, _a1 error) *UserService_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUser_Call) RunAndReturn(run func(string) (dto.UserDTO, error)) *UserService_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: search, limit, page
+func (_m *UserService) GetUsers(search string, limit int, page int) ([]dto.UserDTO, error) {
+	ret := _m.Called(search, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 []dto.UserDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]dto.UserDTO, error)); ok {
+		return rf(search, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []dto.UserDTO); ok {
+		r0 = rf(search, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(search, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type UserService_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - search string
+//   - limit int
+//   - page int
+func (_e *UserService_Expecter) GetUsers(search interface{}, limit interface{}, page interface{}) *UserService_GetUsers_Call {
+	return &UserService_GetUsers_Call{Call: _e.mock.On("GetUsers", search, limit, page)}
+}
+
+func (_c *UserService_GetUsers_Call) Run(run func(search string, limit int, page int)) *UserService_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUsers_Call) Return(_a0 []dto.UserDTO, _a1 error) *UserService_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUsers_Call) RunAndReturn(run func(string, int, int) ([]dto.UserDTO, error)) *UserService_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncUser provides a mock function with given fields: claims
+func (_m *UserService) SyncUser(claims middleware.TokenClaims) (bool, error) {
+	ret := _m.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncUser")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(middleware.TokenClaims) (bool, error)); ok {
+		return rf(claims)
+	}
+	if rf, ok := ret.Get(0).(func(middleware.TokenClaims) bool); ok {
+		r0 = rf(claims)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(middleware.TokenClaims) error); ok {
+		r1 = rf(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_SyncUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncUser'
+type UserService_SyncUser_Call struct {
+	*mock.Call
+}
+
+// SyncUser is a helper method to define mock.On call
+//   - claims middleware.TokenClaims
+func (_e *UserService_Expecter) SyncUser(claims interface{}) *UserService_SyncUser_Call {
+	return &UserService_SyncUser_Call{Call: _e.mock.On("SyncUser", claims)}
+}
+
+func (_c *UserService_SyncUser_Call) Run(run func(claims middleware.TokenClaims)) *UserService_SyncUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(middleware.TokenClaims))
+	})
+	return _c
+}
+
+func (_c *UserService_SyncUser_Call) Return(_a0 bool, _a1 error) *UserService_SyncUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_SyncUser_Call) RunAndReturn(run func(middleware.TokenClaims) (bool, error)) *UserService_SyncUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ID, input
+func (_m *UserService) UpdateProfile(ID string, input dto.UpdateProfileDTO) (dto.UserProfileDTO, error) {
+	ret := _m.Called(ID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 dto.UserProfileDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.UpdateProfileDTO) (dto.UserProfileDTO, error)); ok {
+		return rf(ID, input)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.UpdateProfileDTO) dto.UserProfileDTO); ok {
+		r0 = rf(ID, input)
+	} else {
+		r0 = ret.Get(0).(dto.UserProfileDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.UpdateProfileDTO) error); ok {
+		r1 = rf(ID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type UserService_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ID string
+//   - input dto.UpdateProfileDTO
+func (_e *UserService_Expecter) UpdateProfile(ID interface{}, input interface{}) *UserService_UpdateProfile_Call {
+	return &UserService_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ID, input)}
+}
+
+func (_c *UserService_UpdateProfile_Call) Run(run func(ID string, input dto.UpdateProfileDTO)) *UserService_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.UpdateProfileDTO))
+	})
+	return _c
+}
+
+func (_c *UserService_UpdateProfile_Call) Return(_a0 dto.UserProfileDTO, _a1 error) *UserService_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_UpdateProfile_Call) RunAndReturn(run func(string, dto.UpdateProfileDTO) (dto.UserProfileDTO, error)) *UserService_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfilePicture provides a mock function with given fields: ID, input
+func (_m *UserService) UpdateProfilePicture(ID string, input dto.UpdateProfilePictureDTO) (dto.UserProfileDTO, error) {
+	ret := _m.Called(ID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfilePicture")
+	}
+
+	var r0 dto.UserProfileDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.UpdateProfilePictureDTO) (dto.UserProfileDTO, error)); ok {
+		return rf(ID, input)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.UpdateProfilePictureDTO) dto.UserProfileDTO); ok {
+		r0 = rf(ID, input)
+	} else {
+		r0 = ret.Get(0).(dto.UserProfileDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.UpdateProfilePictureDTO) error); ok {
+		r1 = rf(ID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_UpdateProfilePicture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfilePicture'
+type UserService_UpdateProfilePicture_Call struct {
+	*mock.Call
+}
+
+// UpdateProfilePicture is a helper method to define mock.On call
+//   - ID string
+//   - input dto.UpdateProfilePictureDTO
+func (_e *UserService_Expecter) UpdateProfilePicture(ID interface{}, input interface{}) *UserService_UpdateProfilePicture_Call {
+	return &UserService_UpdateProfilePicture_Call{Call: _e.mock.On("UpdateProfilePicture", ID, input)}
+}
+
+func (_c *UserService_UpdateProfilePicture_Call) Run(run func(ID string, input dto.UpdateProfilePictureDTO)) *UserService_UpdateProfilePicture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(dto.UpdateProfilePictureDTO))
+	})
+	return _c
+}
+
+func (_c *UserService_UpdateProfilePicture_Call) Return(_a0 dto.UserProfileDTO, _a1 error) *UserService_UpdateProfilePicture_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_UpdateProfilePicture_Call) RunAndReturn(run func(string, dto.UpdateProfilePictureDTO) (dto.UserProfileDTO, error)) *UserService_UpdateProfilePicture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserService(t interface {
@@ -1,29 +1,92 @@
 package service
 
 import (
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	mediaDTO "CodeWithAzri/internal/app/module/media/dto"
 	"CodeWithAzri/internal/app/module/user/dto"
 	"CodeWithAzri/internal/app/module/user/entity"
 	"CodeWithAzri/internal/app/module/user/repository"
+	"CodeWithAzri/internal/pkg/middleware"
 	"CodeWithAzri/pkg/adapter"
+	"CodeWithAzri/pkg/cache"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	media_purpose_enum "CodeWithAzri/pkg/enums/mediaPurpose"
 	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
 	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidProfilePicture = errors.New("profile picture must be an image you uploaded as a profile picture")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrUserErased            = errors.New("user has already been erased")
 )
 
 type UserService interface {
+	middleware.UserSyncer
 	Create(dto *dto.CreateUpdateDto) (dto.UserDTO, error)
+	GetUser(ID string) (dto.UserDTO, error)
 	GetProfile(ID string) (dto.UserProfileDTO, error)
+	GetPublicProfile(ID string) (dto.UserProfileDTO, error)
+	GetUsers(search string, limit int, page int) ([]dto.UserDTO, error)
+	UpdateProfile(ID string, input dto.UpdateProfileDTO) (dto.UserProfileDTO, error)
+	UpdateProfilePicture(ID string, input dto.UpdateProfilePictureDTO) (dto.UserProfileDTO, error)
+	ExportData(ID string) (map[string]any, error)
+	EraseAccount(ID string, requestedBy string) error
+	AddPersonalDataHolder(holder PersonalDataHolder)
+}
+
+// MediaReader resolves uploaded profile pictures.
+type MediaReader interface {
+	GetImages(ids []uuid.UUID) (map[uuid.UUID]mediaDTO.MediaDTO, error)
+}
+
+// PersonalDataHolder is a module that keeps data about users. Each section
+// it exports becomes a file of the data export, and erasing a user erases
+// or anonymises what it keeps about them.
+type PersonalDataHolder interface {
+	ExportUserData(userID string) (map[string]any, error)
+	EraseUserData(userID string) error
+}
+
+// AccountRevoker removes a user from the identity provider, so they can no
+// longer sign in.
+type AccountRevoker interface {
+	RevokeAccount(uid string) error
 }
 
 type Service struct {
 	repository repository.UserRepository
+	media      MediaReader
+	revoker    AccountRevoker
+	// synced is the cache UserSyncMiddleware remembers synced users in.
+	synced  cache.Cache
+	holders []PersonalDataHolder
 }
 
-func NewService(r repository.UserRepository) UserService {
+func NewService(r repository.UserRepository, m MediaReader, revoker AccountRevoker, synced cache.Cache) UserService {
 	s := new(Service)
 	s.repository = r
+	s.media = m
+	s.revoker = revoker
+	s.synced = synced
 	return s
 }
 
+// AddPersonalDataHolder includes the data a module keeps about users in
+// data exports and erasures.
+func (s *Service) AddPersonalDataHolder(holder PersonalDataHolder) {
+	s.holders = append(s.holders, holder)
+}
+
+// Create registers the user behind the token. Users are usually provisioned
+// by SyncUser already, in which case only the name and picture the identity
+// provider did not supply are filled in.
 func (s *Service) Create(inputDTO *dto.CreateUpdateDto) (dto.UserDTO, error) {
 	existingUser, err := s.repository.ReadOne(inputDTO.ID)
 
@@ -32,6 +95,21 @@ func (s *Service) Create(inputDTO *dto.CreateUpdateDto) (dto.UserDTO, error) {
 	}
 
 	if existingUser.ID != "" {
+		if existingUser.Name == "" || existingUser.ProfilePicture == "" {
+			if existingUser.Name == "" {
+				existingUser.Name = inputDTO.Name
+			}
+			if existingUser.ProfilePicture == "" {
+				existingUser.ProfilePicture = inputDTO.ProfilePicture
+			}
+			existingUser.UpdatedAt = timepkg.NowUnixMilli()
+
+			err = s.repository.UpdateIdentity(existingUser.ID, existingUser)
+			if err != nil {
+				return dto.UserDTO{}, err
+			}
+		}
+
 		existingUserDTO, _ := adapter.AnyToType[dto.UserDTO](existingUser)
 
 		return existingUserDTO, nil
@@ -43,20 +121,91 @@ func (s *Service) Create(inputDTO *dto.CreateUpdateDto) (dto.UserDTO, error) {
 		return dto.UserDTO{}, err
 	}
 
+	err = s.register(&user)
+
+	if err != nil {
+		return dto.UserDTO{}, err
+	}
+
+	userDTO, _ := adapter.AnyToType[dto.UserDTO](user)
+
+	return userDTO, nil
+}
+
+// SyncUser provisions the user behind a verified token on their first
+// request, and keeps their email address in line with the identity provider
+// afterwards. Erased users are not let back in.
+func (s *Service) SyncUser(claims middleware.TokenClaims) (bool, error) {
+	user, err := s.repository.ReadOne(claims.UID)
+	if err == sql.ErrNoRows {
+		err = s.register(&entity.User{
+			ID:             claims.UID,
+			Name:           claims.Name,
+			Email:          claims.Email,
+			EmailVerified:  claims.EmailVerified,
+			ProfilePicture: claims.Picture,
+		})
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if user.ErasedAt != nil {
+		return false, nil
+	}
+
+	if user.Email == claims.Email && user.EmailVerified == claims.EmailVerified {
+		return true, nil
+	}
+
+	user.Email = claims.Email
+	user.EmailVerified = claims.EmailVerified
+	user.UpdatedAt = timepkg.NowUnixMilli()
+
+	err = s.repository.UpdateIdentity(user.ID, user)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// register stores a new user along with the event announcing them.
+func (s *Service) register(user *entity.User) error {
 	now := timepkg.NowUnixMilli()
 
 	user.CreatedAt = now
 	user.UpdatedAt = now
 
-	err = s.repository.Create(user)
+	registered, err := eventService.NewEvent(event_type_enum.UserRegistered, user.ID, eventDTO.UserRegisteredPayload{
+		UserID: user.ID,
+		Name:   user.Name,
+		Email:  user.Email,
+	})
+	if err != nil {
+		return err
+	}
 
+	return s.repository.Create(*user, registered)
+}
+
+// GetUser returns the account of a user, including their email address,
+// for other modules. Use GetProfile for anything shown to other users.
+func (s *Service) GetUser(ID string) (dto.UserDTO, error) {
+	user, err := s.repository.ReadOne(ID)
+	if err == sql.ErrNoRows {
+		return dto.UserDTO{}, ErrUserNotFound
+	}
 	if err != nil {
 		return dto.UserDTO{}, err
 	}
 
-	userDTO, _ := adapter.AnyToType[dto.UserDTO](user)
+	if user.ID == "" || user.ErasedAt != nil {
+		return dto.UserDTO{}, ErrUserNotFound
+	}
 
-	return userDTO, nil
+	return adapter.AnyToType[dto.UserDTO](user)
 }
 
 func (s *Service) GetProfile(ID string) (dto.UserProfileDTO, error) {
@@ -66,10 +215,201 @@ func (s *Service) GetProfile(ID string) (dto.UserProfileDTO, error) {
 		return dto.UserProfileDTO{}, err
 	}
 
+	return s.toProfileDTO(user)
+}
+
+// GetPublicProfile returns the profile of a user as shown to other users.
+func (s *Service) GetPublicProfile(ID string) (dto.UserProfileDTO, error) {
+	user, err := s.repository.ReadOne(ID)
+	if err == sql.ErrNoRows {
+		return dto.UserProfileDTO{}, ErrUserNotFound
+	}
+	if err != nil {
+		return dto.UserProfileDTO{}, err
+	}
+
+	if user.ErasedAt != nil {
+		return dto.UserProfileDTO{}, ErrUserNotFound
+	}
+
+	return s.toProfileDTO(user)
+}
+
+// GetUsers lists the accounts of users, newest first, for admins. A
+// non-empty search matches names and email addresses.
+func (s *Service) GetUsers(search string, limit int, page int) ([]dto.UserDTO, error) {
+	offset := (page - 1) * limit
+
+	users, err := s.repository.ReadMany(search, limit, offset)
+	if err != nil {
+		return []dto.UserDTO{}, err
+	}
+
+	return adapter.AnyToType[[]dto.UserDTO](users)
+}
+
+func (s *Service) UpdateProfile(ID string, input dto.UpdateProfileDTO) (dto.UserProfileDTO, error) {
+	user, err := s.repository.ReadOne(ID)
+	if err == sql.ErrNoRows {
+		return dto.UserProfileDTO{}, ErrUserNotFound
+	}
+	if err != nil {
+		return dto.UserProfileDTO{}, err
+	}
+
+	if input.Name != nil {
+		user.Name = *input.Name
+	}
+
+	if input.ProfilePictureMediaID != nil {
+		err = s.checkProfilePicture(ID, *input.ProfilePictureMediaID)
+		if err != nil {
+			return dto.UserProfileDTO{}, err
+		}
+		user.ProfilePictureMediaID = input.ProfilePictureMediaID
+	}
+
+	user.UpdatedAt = timepkg.NowUnixMilli()
+
+	err = s.repository.Update(ID, user)
+	if err != nil {
+		return dto.UserProfileDTO{}, err
+	}
+
+	return s.toProfileDTO(user)
+}
+
+func (s *Service) UpdateProfilePicture(ID string, input dto.UpdateProfilePictureDTO) (dto.UserProfileDTO, error) {
+	err := s.checkProfilePicture(ID, input.MediaID)
+	if err != nil {
+		return dto.UserProfileDTO{}, err
+	}
+
+	err = s.repository.UpdateProfilePicture(ID, input.MediaID, timepkg.NowUnixMilli())
+	if err != nil {
+		return dto.UserProfileDTO{}, err
+	}
+
+	return s.GetProfile(ID)
+}
+
+// ExportData collects everything kept about a user: their account as the
+// "profile" section, followed by the sections of every personal data holder.
+func (s *Service) ExportData(ID string) (map[string]any, error) {
+	user, err := s.readActiveUser(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := map[string]any{"profile": user}
+	for _, holder := range s.holders {
+		data, err := holder.ExportUserData(ID)
+		if err != nil {
+			return nil, err
+		}
+		for name, section := range data {
+			sections[name] = section
+		}
+	}
+
+	return sections, nil
+}
+
+// EraseAccount erases a user at their own request or at the request of an
+// admin. The modules holding their data go first, then their sign-in, and
+// the account itself is turned into a tombstone last, so an erasure that
+// fails halfway is finished by running it again.
+func (s *Service) EraseAccount(ID string, requestedBy string) error {
+	_, err := s.readActiveUser(ID)
+	if err != nil {
+		return err
+	}
+
+	for _, holder := range s.holders {
+		err = holder.EraseUserData(ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.revoker.RevokeAccount(ID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := eventService.NewEvent(event_type_enum.UserDeleted, ID, eventDTO.UserDeletedPayload{
+		UserID: ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Erase(ID, requestedBy, timepkg.NowUnixMilli(), deleted)
+	if err != nil {
+		return err
+	}
+
+	// The next request with a token that is still valid syncs again and
+	// finds the tombstone.
+	err = s.synced.Delete(context.Background(), middleware.SyncedUserKey(ID))
+	if err != nil {
+		log.Printf("failed to forget synced user %s: %v\n", ID, err)
+	}
+
+	return nil
+}
+
+// readActiveUser reads a user who exists and was not erased.
+func (s *Service) readActiveUser(ID string) (entity.User, error) {
+	user, err := s.repository.ReadOne(ID)
+	if err == sql.ErrNoRows {
+		return entity.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	if user.ErasedAt != nil {
+		return entity.User{}, ErrUserErased
+	}
+
+	return user, nil
+}
+
+// checkProfilePicture makes sure mediaID is an image userID uploaded as a
+// profile picture.
+func (s *Service) checkProfilePicture(userID string, mediaID uuid.UUID) error {
+	images, err := s.media.GetImages([]uuid.UUID{mediaID})
+	if err != nil {
+		return err
+	}
+
+	image, ok := images[mediaID]
+	if !ok || image.Purpose != media_purpose_enum.ProfilePicture || image.OwnerID != userID {
+		return ErrInvalidProfilePicture
+	}
+
+	return nil
+}
+
+func (s *Service) toProfileDTO(user entity.User) (dto.UserProfileDTO, error) {
 	userDTO, err := adapter.AnyToType[dto.UserProfileDTO](user)
 	if err != nil {
 		return dto.UserProfileDTO{}, err
 	}
 
+	// An uploaded picture replaces the one from the identity provider.
+	if userDTO.ProfilePictureMediaID != nil {
+		images, err := s.media.GetImages([]uuid.UUID{*userDTO.ProfilePictureMediaID})
+		if err != nil {
+			return dto.UserProfileDTO{}, err
+		}
+
+		if image, ok := images[*userDTO.ProfilePictureMediaID]; ok {
+			userDTO.ProfilePicture = image.URL
+			userDTO.ProfilePictureVariants = image.Variants
+		}
+	}
+
 	return userDTO, nil
 }
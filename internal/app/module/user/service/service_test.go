@@ -1,25 +1,61 @@
 package service_test
 
 import (
+	eventEntity "CodeWithAzri/internal/app/module/event/entity"
+	mediaDTO "CodeWithAzri/internal/app/module/media/dto"
 	dto "CodeWithAzri/internal/app/module/user/dto"
 	"CodeWithAzri/internal/app/module/user/entity"
 	"CodeWithAzri/internal/app/module/user/repository/mocks"
 	"CodeWithAzri/internal/app/module/user/service"
+	serviceMocks "CodeWithAzri/internal/app/module/user/service/mocks"
+	"CodeWithAzri/internal/pkg/middleware"
+	"CodeWithAzri/pkg/cache"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
 	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"bou.ke/monkey"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func initializeService(t *testing.T) (service.UserService, *mocks.UserRepository) {
+	userService, mockRepo, _ := initializeServiceWithMedia(t)
+	return userService, mockRepo
+}
+
+func initializeServiceWithMedia(t *testing.T) (service.UserService, *mocks.UserRepository, *serviceMocks.MediaReader) {
 	mockRepo := mocks.NewUserRepository(t)
-	service := service.NewService(mockRepo)
-	return service, mockRepo
+	mockMedia := serviceMocks.NewMediaReader(t)
+	service := service.NewService(mockRepo, mockMedia, serviceMocks.NewAccountRevoker(t), cache.NopCache{})
+	return service, mockRepo, mockMedia
+}
+
+// personalDataTest holds the mocks a data export or erasure goes through.
+type personalDataTest struct {
+	service    service.UserService
+	repository *mocks.UserRepository
+	revoker    *serviceMocks.AccountRevoker
+	holder     *serviceMocks.PersonalDataHolder
+	synced     *cache.MemoryCache
+}
+
+func initializePersonalDataTest(t *testing.T) personalDataTest {
+	test := personalDataTest{
+		repository: mocks.NewUserRepository(t),
+		revoker:    serviceMocks.NewAccountRevoker(t),
+		holder:     serviceMocks.NewPersonalDataHolder(t),
+		synced:     cache.NewMemoryCache(10),
+	}
+	test.service = service.NewService(test.repository, serviceMocks.NewMediaReader(t), test.revoker, test.synced)
+	test.service.AddPersonalDataHolder(test.holder)
+	return test
 }
 
 func TestService_Create(t *testing.T) {
@@ -44,7 +80,10 @@ func TestService_Create(t *testing.T) {
 		}
 
 		mockRepo.On("ReadOne", createUpdateDto.ID).Return(entity.User{}, sql.ErrNoRows)
-		mockRepo.On("Create", expectedUser).Return(nil)
+		mockRepo.On("Create", expectedUser, mock.MatchedBy(func(event eventEntity.Event) bool {
+			return event.Type == event_type_enum.UserRegistered && event.AggregateID == "123" &&
+				event.Payload == `{"user_id":"123","name":"John Doe","email":"john.doe@example.com"}`
+		})).Return(nil)
 
 		createdUser, err := userService.Create(createUpdateDto)
 
@@ -101,6 +140,120 @@ func TestService_CreateUserExisted(t *testing.T) {
 	})
 }
 
+func TestService_CreateFillsMissingIdentity(t *testing.T) {
+	userService, mockRepo := initializeService(t)
+
+	t.Run("Provisioned User Without Name", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+		defer patch.Unpatch()
+
+		provisioned := entity.User{ID: "123", Email: "john.doe@example.com", CreatedAt: 121212, UpdatedAt: 121212}
+		expectedUser := provisioned
+		expectedUser.Name = "John Doe"
+		expectedUser.ProfilePicture = "https://example.com/profile.png"
+		expectedUser.UpdatedAt = 131313
+
+		mockRepo.On("ReadOne", "123").Return(provisioned, nil).Once()
+		mockRepo.On("UpdateIdentity", "123", expectedUser).Return(nil).Once()
+
+		createdUser, err := userService.Create(&dto.CreateUpdateDto{
+			ID:             "123",
+			Name:           "John Doe",
+			Email:          "john.doe@example.com",
+			ProfilePicture: "https://example.com/profile.png",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", createdUser.Name)
+		assert.Equal(t, "https://example.com/profile.png", createdUser.ProfilePicture)
+	})
+}
+
+func TestService_SyncUser(t *testing.T) {
+	claims := middleware.TokenClaims{
+		UID:           "123",
+		Email:         "john.doe@example.com",
+		EmailVerified: true,
+		Name:          "John Doe",
+		Picture:       "https://example.com/profile.png",
+	}
+
+	t.Run("Sync New User", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 121212 })
+		defer patch.Unpatch()
+
+		userService, mockRepo := initializeService(t)
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, sql.ErrNoRows)
+		mockRepo.On("Create", entity.User{
+			ID:             "123",
+			Name:           "John Doe",
+			Email:          "john.doe@example.com",
+			EmailVerified:  true,
+			ProfilePicture: "https://example.com/profile.png",
+			CreatedAt:      121212,
+			UpdatedAt:      121212,
+		}, mock.MatchedBy(func(event eventEntity.Event) bool {
+			return event.Type == event_type_enum.UserRegistered && event.AggregateID == "123"
+		})).Return(nil)
+
+		allowed, err := userService.SyncUser(claims)
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Sync Unchanged User", func(t *testing.T) {
+		userService, mockRepo := initializeService(t)
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", Name: "Johnny", Email: "john.doe@example.com", EmailVerified: true}, nil)
+
+		allowed, err := userService.SyncUser(claims)
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Sync Changed Email Keeps Name", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+		defer patch.Unpatch()
+
+		userService, mockRepo := initializeService(t)
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", Name: "Johnny", Email: "john@old.example.com", UpdatedAt: 121212}, nil)
+		mockRepo.On("UpdateIdentity", "123", entity.User{
+			ID:            "123",
+			Name:          "Johnny",
+			Email:         "john.doe@example.com",
+			EmailVerified: true,
+			UpdatedAt:     131313,
+		}).Return(nil)
+
+		allowed, err := userService.SyncUser(claims)
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Sync Erased User", func(t *testing.T) {
+		userService, mockRepo := initializeService(t)
+		erasedAt := int64(131313)
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", ErasedAt: &erasedAt}, nil)
+
+		allowed, err := userService.SyncUser(claims)
+
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Sync User Repository Error", func(t *testing.T) {
+		userService, mockRepo := initializeService(t)
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, errors.New("Repository Failure"))
+
+		allowed, err := userService.SyncUser(claims)
+
+		assert.EqualError(t, err, "Repository Failure")
+		assert.False(t, allowed)
+	})
+}
+
 func TestService_CreateCheckExistError(t *testing.T) {
 
 	userService, mockRepo := initializeService(t)
@@ -138,7 +291,7 @@ func TestService_CreateError(t *testing.T) {
 		defer patch.Unpatch()
 
 		mockRepo.On("ReadOne", "456").Return(entity.User{}, nil)
-		mockRepo.On("Create", existingUser).Return(sql.ErrTxDone)
+		mockRepo.On("Create", existingUser, mock.AnythingOfType("entity.Event")).Return(sql.ErrTxDone)
 
 		createUpdateDto := &dto.CreateUpdateDto{
 			ID:    "456",
@@ -177,6 +330,41 @@ func TestService_CreateConversionError(t *testing.T) {
 	})
 }
 
+func TestService_GetUser(t *testing.T) {
+	userService, mockRepo := initializeService(t)
+
+	t.Run("Get User Successfully", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", Name: "John Doe", Email: "john.doe@example.com"}, nil).Once()
+
+		userDTO, err := userService.GetUser("123")
+		assert.NoError(t, err)
+		assert.Equal(t, "john.doe@example.com", userDTO.Email)
+		assert.Equal(t, "John Doe", userDTO.Name)
+	})
+
+	t.Run("Get User Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, sql.ErrNoRows).Once()
+
+		_, err := userService.GetUser("123")
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Get User Erased", func(t *testing.T) {
+		erasedAt := int64(131313)
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", ErasedAt: &erasedAt}, nil).Once()
+
+		_, err := userService.GetUser("123")
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Get User Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, errors.New("Repository Failure")).Once()
+
+		_, err := userService.GetUser("123")
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
 func TestService_GetProfile(t *testing.T) {
 	userService, mockRepo := initializeService(t)
 
@@ -232,3 +420,327 @@ func TestService_GetProfileAdapterError(t *testing.T) {
 
 	})
 }
+
+func TestService_GetProfileUploadedPicture(t *testing.T) {
+	userService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	mediaID := uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+	user := entity.User{
+		ID:                    "123",
+		Name:                  "John Doe",
+		ProfilePicture:        "https://example.com/profile.png",
+		ProfilePictureMediaID: &mediaID,
+	}
+	image := mediaDTO.MediaDTO{
+		ID:       mediaID,
+		OwnerID:  "123",
+		Purpose:  "profile_picture",
+		URL:      "http://localhost/api/v1/media/files/profile_picture/me.png?expires=1&signature=abc",
+		Variants: []mediaDTO.ImageVariantDTO{{Name: "thumbnail", Format: "jpeg", Width: 200, Height: 200}},
+	}
+
+	t.Run("Get Profile With Uploaded Picture", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(user, nil).Once()
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: image}, nil).Once()
+
+		userDTO, err := userService.GetProfile("123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, image.URL, userDTO.ProfilePicture)
+		assert.Equal(t, image.Variants, userDTO.ProfilePictureVariants)
+	})
+
+	t.Run("Get Profile Falls Back When Picture Is Gone", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(user, nil).Once()
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{}, nil).Once()
+
+		userDTO, err := userService.GetProfile("123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.ProfilePicture, userDTO.ProfilePicture)
+	})
+}
+
+func TestService_UpdateProfilePicture(t *testing.T) {
+	userService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	mediaID := uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+	image := mediaDTO.MediaDTO{ID: mediaID, OwnerID: "123", Purpose: "profile_picture", URL: "http://localhost/picture"}
+	input := dto.UpdateProfilePictureDTO{MediaID: mediaID}
+
+	t.Run("Update Profile Picture Successfully", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 12121212 })
+		defer patch.Unpatch()
+
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: image}, nil).Twice()
+		mockRepo.On("UpdateProfilePicture", "123", mediaID, int64(12121212)).Return(nil).Once()
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", ProfilePictureMediaID: &mediaID}, nil).Once()
+
+		userDTO, err := userService.UpdateProfilePicture("123", input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &mediaID, userDTO.ProfilePictureMediaID)
+		assert.Equal(t, image.URL, userDTO.ProfilePicture)
+	})
+
+	t.Run("Update Profile Picture Of Someone Else", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: image}, nil).Once()
+
+		_, err := userService.UpdateProfilePicture("456", input)
+
+		assert.ErrorIs(t, err, service.ErrInvalidProfilePicture)
+	})
+
+	t.Run("Update Profile Picture Wrong Purpose", func(t *testing.T) {
+		galleryImage := image
+		galleryImage.Purpose = "course_gallery"
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: galleryImage}, nil).Once()
+
+		_, err := userService.UpdateProfilePicture("123", input)
+
+		assert.ErrorIs(t, err, service.ErrInvalidProfilePicture)
+	})
+
+	t.Run("Update Profile Picture Repository Error", func(t *testing.T) {
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: image}, nil).Once()
+		mockRepo.On("UpdateProfilePicture", "123", mediaID, mock.AnythingOfType("int64")).Return(errors.New("Repository Failure")).Once()
+
+		_, err := userService.UpdateProfilePicture("123", input)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetPublicProfile(t *testing.T) {
+	userService, mockRepo := initializeService(t)
+
+	t.Run("Get Public Profile Successfully", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", Name: "John Doe", Email: "john.doe@example.com"}, nil).Once()
+
+		userDTO, err := userService.GetPublicProfile("123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.UserProfileDTO{ID: "123", Name: "John Doe"}, userDTO)
+	})
+
+	t.Run("Get Public Profile Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, sql.ErrNoRows).Once()
+
+		_, err := userService.GetPublicProfile("123")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Get Public Profile Erased", func(t *testing.T) {
+		erasedAt := int64(131313)
+		mockRepo.On("ReadOne", "123").Return(entity.User{ID: "123", ErasedAt: &erasedAt}, nil).Once()
+
+		_, err := userService.GetPublicProfile("123")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Get Public Profile Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, errors.New("Repository Failure")).Once()
+
+		_, err := userService.GetPublicProfile("123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetUsers(t *testing.T) {
+	userService, mockRepo := initializeService(t)
+
+	t.Run("Get Users Successfully", func(t *testing.T) {
+		mockRepo.On("ReadMany", "john", 10, 10).Return([]entity.User{{ID: "123", Name: "John Doe", Email: "john.doe@example.com"}}, nil).Once()
+
+		users, err := userService.GetUsers("john", 10, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.UserDTO{{ID: "123", Name: "John Doe", Email: "john.doe@example.com"}}, users)
+	})
+
+	t.Run("Get Users Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadMany", "", 10, 0).Return(nil, errors.New("Repository Failure")).Once()
+
+		users, err := userService.GetUsers("", 10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+		assert.Empty(t, users)
+	})
+}
+
+func TestService_UpdateProfile(t *testing.T) {
+	userService, mockRepo, mockMedia := initializeServiceWithMedia(t)
+
+	mediaID := uuid.MustParse("6a0c3e8e-2f7e-4d8a-9a57-0d2f3b6f1c11")
+	image := mediaDTO.MediaDTO{ID: mediaID, OwnerID: "123", Purpose: "profile_picture", URL: "http://localhost/picture"}
+	user := entity.User{ID: "123", Name: "John Doe", Email: "john.doe@example.com", CreatedAt: 121212, UpdatedAt: 121212}
+	name := "Johnny"
+
+	t.Run("Update Name", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 12121212 })
+		defer patch.Unpatch()
+
+		updated := user
+		updated.Name = name
+		updated.UpdatedAt = 12121212
+		mockRepo.On("ReadOne", "123").Return(user, nil).Once()
+		mockRepo.On("Update", "123", updated).Return(nil).Once()
+
+		userDTO, err := userService.UpdateProfile("123", dto.UpdateProfileDTO{Name: &name})
+
+		assert.NoError(t, err)
+		assert.Equal(t, dto.UserProfileDTO{ID: "123", Name: name}, userDTO)
+	})
+
+	t.Run("Update Profile Picture", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 12121212 })
+		defer patch.Unpatch()
+
+		updated := user
+		updated.ProfilePictureMediaID = &mediaID
+		updated.UpdatedAt = 12121212
+		mockRepo.On("ReadOne", "123").Return(user, nil).Once()
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: image}, nil).Twice()
+		mockRepo.On("Update", "123", updated).Return(nil).Once()
+
+		userDTO, err := userService.UpdateProfile("123", dto.UpdateProfileDTO{ProfilePictureMediaID: &mediaID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.Name, userDTO.Name)
+		assert.Equal(t, image.URL, userDTO.ProfilePicture)
+	})
+
+	t.Run("Update Profile Picture Of Someone Else", func(t *testing.T) {
+		mockRepo.On("ReadOne", "456").Return(entity.User{ID: "456"}, nil).Once()
+		mockMedia.On("GetImages", []uuid.UUID{mediaID}).Return(map[uuid.UUID]mediaDTO.MediaDTO{mediaID: image}, nil).Once()
+
+		_, err := userService.UpdateProfile("456", dto.UpdateProfileDTO{ProfilePictureMediaID: &mediaID})
+
+		assert.ErrorIs(t, err, service.ErrInvalidProfilePicture)
+	})
+
+	t.Run("Update Profile Not Found", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(entity.User{}, sql.ErrNoRows).Once()
+
+		_, err := userService.UpdateProfile("123", dto.UpdateProfileDTO{Name: &name})
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Update Profile Repository Error", func(t *testing.T) {
+		mockRepo.On("ReadOne", "123").Return(user, nil).Once()
+		mockRepo.On("Update", "123", mock.AnythingOfType("entity.User")).Return(errors.New("Repository Failure")).Once()
+
+		_, err := userService.UpdateProfile("123", dto.UpdateProfileDTO{Name: &name})
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_ExportData(t *testing.T) {
+	user := entity.User{ID: "123", Name: "John Doe", Email: "john.doe@example.com", CreatedAt: 121212, UpdatedAt: 121212}
+
+	t.Run("Export Data Successfully", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		test.repository.On("ReadOne", "123").Return(user, nil)
+		test.holder.On("ExportUserData", "123").Return(map[string]any{"enrollments": []string{"course1"}}, nil)
+
+		sections, err := test.service.ExportData("123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"profile": user, "enrollments": []string{"course1"}}, sections)
+	})
+
+	t.Run("Export Data Of Erased User", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		erasedAt := int64(131313)
+		test.repository.On("ReadOne", "123").Return(entity.User{ID: "123", ErasedAt: &erasedAt}, nil)
+
+		_, err := test.service.ExportData("123")
+
+		assert.ErrorIs(t, err, service.ErrUserErased)
+	})
+
+	t.Run("Export Data Holder Error", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		test.repository.On("ReadOne", "123").Return(user, nil)
+		test.holder.On("ExportUserData", "123").Return(nil, errors.New("Holder Failure"))
+
+		_, err := test.service.ExportData("123")
+
+		assert.EqualError(t, err, "Holder Failure")
+	})
+}
+
+func TestService_EraseAccount(t *testing.T) {
+	t.Run("Erase Account Successfully", func(t *testing.T) {
+		patch := monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+		defer patch.Unpatch()
+
+		test := initializePersonalDataTest(t)
+		assert.NoError(t, test.synced.Set(context.Background(), middleware.SyncedUserKey("123"), []byte("{}"), time.Minute))
+		var deleted eventEntity.Event
+		test.repository.On("ReadOne", "123").Return(entity.User{ID: "123"}, nil)
+		test.holder.On("EraseUserData", "123").Return(nil)
+		test.revoker.On("RevokeAccount", "123").Return(nil)
+		test.repository.On("Erase", "123", "admin1", int64(131313), mock.AnythingOfType("entity.Event")).
+			Run(func(args mock.Arguments) { deleted = args.Get(3).(eventEntity.Event) }).
+			Return(nil)
+
+		err := test.service.EraseAccount("123", "admin1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, event_type_enum.UserDeleted, deleted.Type)
+		assert.Equal(t, "123", deleted.AggregateID)
+		assert.JSONEq(t, `{"user_id":"123"}`, deleted.Payload)
+
+		_, synced, _ := test.synced.Get(context.Background(), middleware.SyncedUserKey("123"))
+		assert.False(t, synced)
+	})
+
+	t.Run("Erase Account Not Found", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		test.repository.On("ReadOne", "123").Return(entity.User{}, sql.ErrNoRows)
+
+		err := test.service.EraseAccount("123", "123")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Erase Account Already Erased", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		erasedAt := int64(131313)
+		test.repository.On("ReadOne", "123").Return(entity.User{ID: "123", ErasedAt: &erasedAt}, nil)
+
+		err := test.service.EraseAccount("123", "123")
+
+		assert.ErrorIs(t, err, service.ErrUserErased)
+	})
+
+	t.Run("Erase Account Holder Error Keeps Account", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		test.repository.On("ReadOne", "123").Return(entity.User{ID: "123"}, nil)
+		test.holder.On("EraseUserData", "123").Return(errors.New("Holder Failure"))
+
+		err := test.service.EraseAccount("123", "123")
+
+		assert.EqualError(t, err, "Holder Failure")
+		test.revoker.AssertNotCalled(t, "RevokeAccount", mock.Anything)
+		test.repository.AssertNotCalled(t, "Erase", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Erase Account Revoke Error", func(t *testing.T) {
+		test := initializePersonalDataTest(t)
+		test.repository.On("ReadOne", "123").Return(entity.User{ID: "123"}, nil)
+		test.holder.On("EraseUserData", "123").Return(nil)
+		test.revoker.On("RevokeAccount", "123").Return(errors.New("Revoke Failure"))
+
+		err := test.service.EraseAccount("123", "123")
+
+		assert.EqualError(t, err, "Revoke Failure")
+		test.repository.AssertNotCalled(t, "Erase", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
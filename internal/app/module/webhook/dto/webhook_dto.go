@@ -0,0 +1,49 @@
+package dto
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookDTO subscribes a partner endpoint. Without event types it
+// receives every event; without a secret one is generated.
+type CreateWebhookDTO struct {
+	URL        string                      `json:"url" validate:"required,http_url,max=2048"`
+	EventTypes []event_type_enum.EventType `json:"event_types"`
+	Secret     string                      `json:"secret" validate:"omitempty,min=16,max=255"`
+}
+
+type UpdateWebhookDTO struct {
+	URL        string                      `json:"url" validate:"required,http_url,max=2048"`
+	EventTypes []event_type_enum.EventType `json:"event_types"`
+	Active     bool                        `json:"active"`
+}
+
+// WebhookDTO only carries the secret when the webhook is created.
+type WebhookDTO struct {
+	ID         uuid.UUID                   `json:"id"`
+	URL        string                      `json:"url"`
+	EventTypes []event_type_enum.EventType `json:"event_types"`
+	Secret     string                      `json:"secret,omitempty"`
+	Active     bool                        `json:"active"`
+	CreatedAt  int64                       `json:"created_at"`
+	UpdatedAt  int64                       `json:"updated_at"`
+}
+
+type WebhookDeliveryDTO struct {
+	ID             uuid.UUID                                          `json:"id"`
+	WebhookID      uuid.UUID                                          `json:"webhook_id"`
+	EventID        uuid.UUID                                          `json:"event_id"`
+	EventType      event_type_enum.EventType                          `json:"event_type"`
+	Payload        json.RawMessage                                    `json:"payload" swaggertype:"object"`
+	Status         webhook_delivery_status_enum.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                                                `json:"attempts"`
+	ResponseStatus int                                                `json:"response_status,omitempty"`
+	LastError      string                                             `json:"last_error,omitempty"`
+	DeliveredAt    *int64                                             `json:"delivered_at,omitempty"`
+	CreatedAt      int64                                              `json:"created_at"`
+	UpdatedAt      int64                                              `json:"updated_at"`
+}
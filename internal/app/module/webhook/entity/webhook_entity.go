@@ -0,0 +1,38 @@
+package entity
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// WebhookSubscription posts events of EventTypes to URL, signed with Secret.
+// An empty EventTypes subscribes to every event.
+type WebhookSubscription struct {
+	ID         uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey"`
+	URL        string         `json:"url" gorm:"type:varchar(2048);not null"`
+	EventTypes pq.StringArray `json:"event_types" gorm:"type:text[];not null;default:'{}'"`
+	Secret     string         `json:"secret" gorm:"type:varchar(255);not null"`
+	Active     bool           `json:"active" gorm:"not null;default:true"`
+	CreatedAt  int64          `json:"created_at"`
+	UpdatedAt  int64          `json:"updated_at"`
+}
+
+// WebhookDelivery is one event sent to one subscription. Payload is the body
+// that is posted, so redeliveries send the same event again.
+type WebhookDelivery struct {
+	ID             uuid.UUID                                          `json:"id" gorm:"type:uuid;primaryKey"`
+	SubscriptionID uuid.UUID                                          `json:"subscription_id" gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_event,priority:1;index:idx_webhook_deliveries_log,priority:1"`
+	EventID        uuid.UUID                                          `json:"event_id" gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_event,priority:2"`
+	EventType      event_type_enum.EventType                          `json:"event_type" gorm:"type:varchar(100);not null"`
+	Payload        string                                             `json:"payload" gorm:"type:jsonb;not null"`
+	Status         webhook_delivery_status_enum.WebhookDeliveryStatus `json:"status" gorm:"type:varchar(20);not null"`
+	Attempts       int                                                `json:"attempts" gorm:"not null;default:0"`
+	ResponseStatus int                                                `json:"response_status" gorm:"not null;default:0"`
+	LastError      string                                             `json:"last_error" gorm:"type:text;not null;default:''"`
+	DeliveredAt    *int64                                             `json:"delivered_at"`
+	CreatedAt      int64                                              `json:"created_at" gorm:"index:idx_webhook_deliveries_log,priority:2"`
+	UpdatedAt      int64                                              `json:"updated_at"`
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+var errInvalidDeliveryStatus = errors.New("invalid delivery status")
+
+// GetWebhookDeliveries godoc
+//
+//	@Summary		List webhook deliveries
+//	@Tags			Admin
+//	@Description	List the deliveries of a webhook from newest to oldest, with the response status and error of their last attempt. Requires the admin claim.
+//	@ID				get-webhook-deliveries
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Webhook ID"
+//	@Param			status			query	string	false	"Delivery status: pending, succeeded or failed"
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.WebhookDeliveryDTO}	"Successful response with deliveries"
+//	@Failure		400	{object}	response.ResponseError								"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError								"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError								"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError								"Webhook not found"
+//	@Failure		500	{object}	response.ResponseError								"Internal server error"
+//	@Router			/api/v1/admin/webhooks/{id}/deliveries [get]
+func (h *Handler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	webhookID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	status := webhook_delivery_status_enum.WebhookDeliveryStatus(requestPkg.GetQueryParam(r, "status"))
+	if status != "" && !status.IsValid() {
+		response.RespondError(http.StatusBadRequest, errInvalidDeliveryStatus, w)
+		return
+	}
+
+	limit, page := requestPkg.ParsePagination(r)
+
+	deliveries, err := h.service.GetDeliveries(webhookID, status, limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Webhook Deliveries Fetched Successfully", "Success", deliveries, w)
+}
+
+// RedeliverWebhook godoc
+//
+//	@Summary		Redeliver a webhook delivery
+//	@Tags			Admin
+//	@Description	Queue a delivery to be sent again with a fresh signature, whatever the outcome of earlier attempts. Requires the admin claim.
+//	@ID				redeliver-webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Webhook ID"
+//	@Param			deliveryId		path	string	true	"Delivery ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.WebhookDeliveryDTO}	"Successful response with the queued delivery"
+//	@Failure		400	{object}	response.ResponseError							"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError							"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError							"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError							"Webhook or delivery not found"
+//	@Failure		500	{object}	response.ResponseError							"Internal server error"
+//	@Router			/api/v1/admin/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *Handler) RedeliverWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	deliveryID, err := uuid.Parse(requestPkg.GetURLParam(r, "deliveryId"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	delivery, err := h.service.Redeliver(webhookID, deliveryID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Webhook Delivery Queued Successfully", "Success", delivery, w)
+}
@@ -0,0 +1,111 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_GetWebhookDeliveries(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Deliveries Successfully", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("GetDeliveries", MockWebhookDTO.ID, webhook_delivery_status_enum.Failed, 20, 2).
+			Return([]dto.WebhookDeliveryDTO{MockDeliveryDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String()+"/deliveries?status=failed&page=2&limit=20", nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhookDeliveries(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"response_status":503`)
+		assert.Contains(t, recorder.Body.String(), `"payload":{"type":"course.enrolled"}`)
+	})
+
+	t.Run("Get Deliveries Invalid Status", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String()+"/deliveries?status=dead", nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhookDeliveries(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Get Deliveries Webhook Not Found", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("GetDeliveries", MockWebhookDTO.ID, webhook_delivery_status_enum.WebhookDeliveryStatus(""), 10, 1).
+			Return(nil, service.ErrWebhookNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String()+"/deliveries", nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhookDeliveries(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_RedeliverWebhook(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	params := map[string]string{"id": MockWebhookDTO.ID.String(), "deliveryId": MockDeliveryDTO.ID.String()}
+	path := "/api/v1/admin/webhooks/" + MockWebhookDTO.ID.String() + "/deliveries/" + MockDeliveryDTO.ID.String() + "/redeliver"
+
+	t.Run("Redeliver Successfully", func(t *testing.T) {
+		patchWebhookRequest(params)
+		queued := MockDeliveryDTO
+		queued.Status = webhook_delivery_status_enum.Pending
+		mockService.On("Redeliver", MockWebhookDTO.ID, MockDeliveryDTO.ID).Return(queued, nil).Once()
+
+		req, _ := http.NewRequest("POST", path, nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.RedeliverWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"status":"pending"`)
+	})
+
+	t.Run("Redeliver Delivery Not Found", func(t *testing.T) {
+		patchWebhookRequest(params)
+		mockService.On("Redeliver", MockWebhookDTO.ID, MockDeliveryDTO.ID).Return(dto.WebhookDeliveryDTO{}, service.ErrDeliveryNotFound).Once()
+
+		req, _ := http.NewRequest("POST", path, nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.RedeliverWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Redeliver Invalid Delivery ID", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String(), "deliveryId": "invalid"})
+
+		req, _ := http.NewRequest("POST", path, nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.RedeliverWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Redeliver Service Error", func(t *testing.T) {
+		patchWebhookRequest(params)
+		mockService.On("Redeliver", MockWebhookDTO.ID, MockDeliveryDTO.ID).Return(dto.WebhookDeliveryDTO{}, errors.New("Service Failure")).Once()
+
+		req, _ := http.NewRequest("POST", path, nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.RedeliverWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	jsonpkg "CodeWithAzri/pkg/jsonPkg"
+	"CodeWithAzri/pkg/requestPkg"
+	"CodeWithAzri/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	service  service.WebhookService
+	validate *validator.Validate
+}
+
+func NewHandler(s service.WebhookService, v *validator.Validate) *Handler {
+	h := new(Handler)
+	h.service = s
+	h.validate = v
+	return h
+}
+
+// CreateWebhook godoc
+//
+//	@Summary		Create a webhook
+//	@Tags			Admin
+//	@Description	Subscribe a partner URL to events. Without event types the webhook receives every event. Deliveries are signed with HMAC-SHA256 of "{timestamp}.{body}" in the X-Webhook-Signature header; the secret is generated when none is given and is only returned here. Requires the admin claim.
+//	@ID				create-webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			input			body	dto.CreateWebhookDTO	true	"Webhook URL, event types and optional secret"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		201	{object}	response.Response{data=dto.WebhookDTO}	"Successful response with the webhook and its secret"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/admin/webhooks [post]
+func (h *Handler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var d dto.CreateWebhookDTO
+	err := jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	webhook, err := h.service.CreateWebhook(d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusCreated, "Webhook Created Successfully", "Success", webhook, w)
+}
+
+// GetWebhooks godoc
+//
+//	@Summary		List webhooks
+//	@Tags			Admin
+//	@Description	List webhooks from newest to oldest. Requires the admin claim.
+//	@ID				get-webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			page			query	int		false	"Page number (default: 1)"
+//	@Param			limit			query	int		false	"Number of items per page (default: 10)"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=[]dto.WebhookDTO}	"Successful response with webhooks"
+//	@Failure		401	{object}	response.ResponseError						"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError						"Forbidden, not an admin"
+//	@Failure		500	{object}	response.ResponseError						"Internal server error"
+//	@Router			/api/v1/admin/webhooks [get]
+func (h *Handler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	limit, page := requestPkg.ParsePagination(r)
+
+	webhooks, err := h.service.GetWebhooks(limit, page)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Webhooks Fetched Successfully", "Success", webhooks, w)
+}
+
+// GetWebhook godoc
+//
+//	@Summary		Get a webhook
+//	@Tags			Admin
+//	@Description	Fetch a webhook. Requires the admin claim.
+//	@ID				get-webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Webhook ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.WebhookDTO}	"Successful response with the webhook"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError					"Webhook not found"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/admin/webhooks/{id} [get]
+func (h *Handler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	webhook, err := h.service.GetWebhook(webhookID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Webhook Fetched Successfully", "Success", webhook, w)
+}
+
+// UpdateWebhook godoc
+//
+//	@Summary		Update a webhook
+//	@Tags			Admin
+//	@Description	Change the URL and event types of a webhook, or pause it. Deliveries of a paused webhook are kept pending. The secret does not change. Requires the admin claim.
+//	@ID				update-webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string					true	"Webhook ID"
+//	@Param			input			body	dto.UpdateWebhookDTO	true	"Webhook URL, event types and whether it is active"
+//	@Param			Authorization	header	string					true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response{data=dto.WebhookDTO}	"Successful response with the webhook"
+//	@Failure		400	{object}	response.ResponseError					"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError					"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError					"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError					"Webhook not found"
+//	@Failure		500	{object}	response.ResponseError					"Internal server error"
+//	@Router			/api/v1/admin/webhooks/{id} [put]
+func (h *Handler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	var d dto.UpdateWebhookDTO
+	err = jsonpkg.Decode(r.Body, &d)
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	if err := h.validate.Struct(d); err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	webhook, err := h.service.UpdateWebhook(webhookID, d)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Webhook Updated Successfully", "Success", webhook, w)
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete a webhook
+//	@Tags			Admin
+//	@Description	Delete a webhook together with its delivery log. Queued deliveries are dropped. Requires the admin claim.
+//	@ID				delete-webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string	true	"Webhook ID"
+//	@Param			Authorization	header	string	true	"Bearer token for authentication"
+//	@Security		Bearer
+//	@Success		200	{object}	response.Response		"Successful response"
+//	@Failure		400	{object}	response.ResponseError	"Bad request, invalid input"
+//	@Failure		401	{object}	response.ResponseError	"Unauthorized, missing or invalid authentication token"
+//	@Failure		403	{object}	response.ResponseError	"Forbidden, not an admin"
+//	@Failure		404	{object}	response.ResponseError	"Webhook not found"
+//	@Failure		500	{object}	response.ResponseError	"Internal server error"
+//	@Router			/api/v1/admin/webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookID, err := uuid.Parse(requestPkg.GetURLParam(r, "id"))
+	if err != nil {
+		response.RespondError(http.StatusBadRequest, err, w)
+		return
+	}
+
+	err = h.service.DeleteWebhook(webhookID)
+	if err != nil {
+		response.RespondError(statusCodeFromError(err), err, w)
+		return
+	}
+
+	response.BuildResponse(http.StatusOK, "Webhook Deleted Successfully", "Success", nil, w)
+}
+
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, service.ErrWebhookNotFound), errors.Is(err, service.ErrDeliveryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidEventType):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,51 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/handler"
+	"CodeWithAzri/internal/app/module/webhook/service/mocks"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/go-chi/chi"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var MockWebhookDTO dto.WebhookDTO = dto.WebhookDTO{
+	ID:         uuid.MustParse("7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e41"),
+	URL:        "https://partner.example.com/hooks",
+	EventTypes: []event_type_enum.EventType{event_type_enum.CourseEnrolled},
+	Active:     true,
+	CreatedAt:  121212,
+	UpdatedAt:  121212,
+}
+
+var MockDeliveryDTO dto.WebhookDeliveryDTO = dto.WebhookDeliveryDTO{
+	ID:             uuid.MustParse("7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e51"),
+	WebhookID:      MockWebhookDTO.ID,
+	EventID:        uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+	EventType:      event_type_enum.CourseEnrolled,
+	Payload:        json.RawMessage(`{"type":"course.enrolled"}`),
+	Status:         "failed",
+	Attempts:       5,
+	ResponseStatus: 503,
+	LastError:      "webhook responded with status 503",
+	CreatedAt:      121212,
+	UpdatedAt:      121212,
+}
+
+func initializeHandler(t *testing.T) (*handler.Handler, *mocks.WebhookService) {
+	mockService := mocks.NewWebhookService(t)
+	handler := handler.NewHandler(mockService, validator.New())
+	return handler, mockService
+}
+
+func patchWebhookRequest(params map[string]string) {
+	monkey.Patch(chi.URLParam, func(r *http.Request, key string) string {
+		return params[key]
+	})
+}
@@ -0,0 +1,201 @@
+package handler_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_CreateWebhook(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	t.Run("Create Webhook Successfully", func(t *testing.T) {
+		input := dto.CreateWebhookDTO{
+			URL:        "https://partner.example.com/hooks",
+			EventTypes: []event_type_enum.EventType{event_type_enum.CourseEnrolled},
+		}
+		created := MockWebhookDTO
+		created.Secret = "whsec_0123456789abcdef"
+		mockService.On("CreateWebhook", input).Return(created, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/webhooks", bytes.NewBufferString(`{"url": "https://partner.example.com/hooks", "event_types": ["course.enrolled"]}`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.CreateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"secret":"whsec_0123456789abcdef"`)
+	})
+
+	t.Run("Create Webhook Invalid URL", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/admin/webhooks", bytes.NewBufferString(`{"url": "ftp://partner.example.com"}`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.CreateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Webhook Invalid Body", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/v1/admin/webhooks", bytes.NewBufferString(`{`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.CreateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Webhook Invalid Event Type", func(t *testing.T) {
+		mockService.On("CreateWebhook", dto.CreateWebhookDTO{
+			URL:        "https://partner.example.com/hooks",
+			EventTypes: []event_type_enum.EventType{"course.deleted"},
+		}).Return(dto.WebhookDTO{}, fmt.Errorf("%w: course.deleted", service.ErrInvalidEventType)).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/webhooks", bytes.NewBufferString(`{"url": "https://partner.example.com/hooks", "event_types": ["course.deleted"]}`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.CreateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_GetWebhooks(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	t.Run("Get Webhooks Successfully", func(t *testing.T) {
+		mockService.On("GetWebhooks", 20, 2).Return([]dto.WebhookDTO{MockWebhookDTO}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks?page=2&limit=20", nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhooks(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), `"secret"`)
+	})
+
+	t.Run("Get Webhooks Service Error", func(t *testing.T) {
+		mockService.On("GetWebhooks", 10, 1).Return(nil, errors.New("Service Failure")).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks", nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhooks(recorder, req)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestHandler_GetWebhook(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Get Webhook Successfully", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("GetWebhook", MockWebhookDTO.ID).Return(MockWebhookDTO, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Get Webhook Not Found", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("GetWebhook", MockWebhookDTO.ID).Return(dto.WebhookDTO{}, service.ErrWebhookNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("Get Webhook Invalid ID", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": "invalid"})
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/webhooks/invalid", nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.GetWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_UpdateWebhook(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Update Webhook Successfully", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		input := dto.UpdateWebhookDTO{URL: "https://partner.example.com/v2/hooks", Active: false}
+		updated := MockWebhookDTO
+		updated.URL = input.URL
+		updated.Active = false
+		mockService.On("UpdateWebhook", MockWebhookDTO.ID, input).Return(updated, nil).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), bytes.NewBufferString(`{"url": "https://partner.example.com/v2/hooks", "active": false}`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.UpdateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"active":false`)
+	})
+
+	t.Run("Update Webhook Missing URL", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+
+		req, _ := http.NewRequest("PUT", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), bytes.NewBufferString(`{"active": true}`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.UpdateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Update Webhook Not Found", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("UpdateWebhook", MockWebhookDTO.ID, dto.UpdateWebhookDTO{URL: MockWebhookDTO.URL, Active: true}).
+			Return(dto.WebhookDTO{}, service.ErrWebhookNotFound).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), bytes.NewBufferString(`{"url": "https://partner.example.com/hooks", "active": true}`))
+		recorder := httptest.NewRecorder()
+		webhookHandler.UpdateWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestHandler_DeleteWebhook(t *testing.T) {
+	webhookHandler, mockService := initializeHandler(t)
+
+	defer monkey.UnpatchAll()
+
+	t.Run("Delete Webhook Successfully", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("DeleteWebhook", MockWebhookDTO.ID).Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.DeleteWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Delete Webhook Not Found", func(t *testing.T) {
+		patchWebhookRequest(map[string]string{"id": MockWebhookDTO.ID.String()})
+		mockService.On("DeleteWebhook", MockWebhookDTO.ID).Return(service.ErrWebhookNotFound).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/admin/webhooks/"+MockWebhookDTO.ID.String(), nil)
+		recorder := httptest.NewRecorder()
+		webhookHandler.DeleteWebhook(recorder, req)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
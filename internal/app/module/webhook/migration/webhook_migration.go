@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	"CodeWithAzri/pkg/migrator"
+	"database/sql"
+)
+
+type WebhookMigration struct{}
+
+func (m WebhookMigration) CreateWebhookTables(db *sql.DB) error {
+	migrationDB := migrator.CreateMigrateDB(db)
+
+	return migrationDB.AutoMigrate(
+		entity.WebhookSubscription{},
+		entity.WebhookDelivery{},
+	)
+}
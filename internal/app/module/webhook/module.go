@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	"CodeWithAzri/internal/app/module/webhook/handler"
+	"CodeWithAzri/internal/app/module/webhook/migration"
+	"CodeWithAzri/internal/app/module/webhook/repository"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Module struct {
+	Handler    *handler.Handler
+	Service    service.WebhookService
+	Repository repository.WebhookRepository
+	Migration  *migration.WebhookMigration
+}
+
+func NewModule(db *sql.DB, validate *validator.Validate, jobs jobService.JobService, events eventService.EventService) *Module {
+	m := new(Module)
+	m.Repository = repository.NewRepository(db)
+	m.Service = service.NewWebhookService(m.Repository, jobs, events, &http.Client{Timeout: 10 * time.Second})
+	m.Handler = handler.NewHandler(m.Service, validate)
+	m.Migration = &migration.WebhookMigration{}
+	return m
+}
@@ -0,0 +1,671 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "CodeWithAzri/internal/app/module/webhook/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+type WebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepository) EXPECT() *WebhookRepository_Expecter {
+	return &WebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: subscription
+func (_m *WebhookRepository) Create(subscription entity.WebhookSubscription) error {
+	ret := _m.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.WebhookSubscription) error); ok {
+		r0 = rf(subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type WebhookRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - subscription entity.WebhookSubscription
+func (_e *WebhookRepository_Expecter) Create(subscription interface{}) *WebhookRepository_Create_Call {
+	return &WebhookRepository_Create_Call{Call: _e.mock.On("Create", subscription)}
+}
+
+func (_c *WebhookRepository_Create_Call) Run(run func(subscription entity.WebhookSubscription)) *WebhookRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.WebhookSubscription))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_Create_Call) Return(_a0 error) *WebhookRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_Create_Call) RunAndReturn(run func(entity.WebhookSubscription) error) *WebhookRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDelivery provides a mock function with given fields: delivery
+func (_m *WebhookRepository) CreateDelivery(delivery entity.WebhookDelivery) (entity.WebhookDelivery, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelivery")
+	}
+
+	var r0 entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.WebhookDelivery) (entity.WebhookDelivery, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(entity.WebhookDelivery) entity.WebhookDelivery); ok {
+		r0 = rf(delivery)
+	} else {
+		r0 = ret.Get(0).(entity.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.WebhookDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_CreateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelivery'
+type WebhookRepository_CreateDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateDelivery is a helper method to define mock.On call
+//   - delivery entity.WebhookDelivery
+func (_e *WebhookRepository_Expecter) CreateDelivery(delivery interface{}) *WebhookRepository_CreateDelivery_Call {
+	return &WebhookRepository_CreateDelivery_Call{Call: _e.mock.On("CreateDelivery", delivery)}
+}
+
+func (_c *WebhookRepository_CreateDelivery_Call) Run(run func(delivery entity.WebhookDelivery)) *WebhookRepository_CreateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateDelivery_Call) Return(_a0 entity.WebhookDelivery, _a1 error) *WebhookRepository_CreateDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateDelivery_Call) RunAndReturn(run func(entity.WebhookDelivery) (entity.WebhookDelivery, error)) *WebhookRepository_CreateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *WebhookRepository) Delete(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type WebhookRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *WebhookRepository_Expecter) Delete(id interface{}) *WebhookRepository_Delete_Call {
+	return &WebhookRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *WebhookRepository_Delete_Call) Run(run func(id uuid.UUID)) *WebhookRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_Delete_Call) Return(_a0 error) *WebhookRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error) *WebhookRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDeliveries provides a mock function with given fields: subscriptionID, status, limit, offset
+func (_m *WebhookRepository) ReadDeliveries(subscriptionID uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, offset int) ([]entity.WebhookDelivery, error) {
+	ret := _m.Called(subscriptionID, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDeliveries")
+	}
+
+	var r0 []entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) ([]entity.WebhookDelivery, error)); ok {
+		return rf(subscriptionID, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) []entity.WebhookDelivery); ok {
+		r0 = rf(subscriptionID, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) error); ok {
+		r1 = rf(subscriptionID, status, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ReadDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeliveries'
+type WebhookRepository_ReadDeliveries_Call struct {
+	*mock.Call
+}
+
+// ReadDeliveries is a helper method to define mock.On call
+//   - subscriptionID uuid.UUID
+//   - status webhook_delivery_status_enum.WebhookDeliveryStatus
+//   - limit int
+//   - offset int
+func (_e *WebhookRepository_Expecter) ReadDeliveries(subscriptionID interface{}, status interface{}, limit interface{}, offset interface{}) *WebhookRepository_ReadDeliveries_Call {
+	return &WebhookRepository_ReadDeliveries_Call{Call: _e.mock.On("ReadDeliveries", subscriptionID, status, limit, offset)}
+}
+
+func (_c *WebhookRepository_ReadDeliveries_Call) Run(run func(subscriptionID uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, offset int)) *WebhookRepository_ReadDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(webhook_delivery_status_enum.WebhookDeliveryStatus), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ReadDeliveries_Call) Return(_a0 []entity.WebhookDelivery, _a1 error) *WebhookRepository_ReadDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ReadDeliveries_Call) RunAndReturn(run func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) ([]entity.WebhookDelivery, error)) *WebhookRepository_ReadDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDelivery provides a mock function with given fields: id
+func (_m *WebhookRepository) ReadDelivery(id uuid.UUID) (entity.WebhookDelivery, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDelivery")
+	}
+
+	var r0 entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.WebhookDelivery, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.WebhookDelivery); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ReadDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDelivery'
+type WebhookRepository_ReadDelivery_Call struct {
+	*mock.Call
+}
+
+// ReadDelivery is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *WebhookRepository_Expecter) ReadDelivery(id interface{}) *WebhookRepository_ReadDelivery_Call {
+	return &WebhookRepository_ReadDelivery_Call{Call: _e.mock.On("ReadDelivery", id)}
+}
+
+func (_c *WebhookRepository_ReadDelivery_Call) Run(run func(id uuid.UUID)) *WebhookRepository_ReadDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ReadDelivery_Call) Return(_a0 entity.WebhookDelivery, _a1 error) *WebhookRepository_ReadDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ReadDelivery_Call) RunAndReturn(run func(uuid.UUID) (entity.WebhookDelivery, error)) *WebhookRepository_ReadDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMany provides a mock function with given fields: limit, offset
+func (_m *WebhookRepository) ReadMany(limit int, offset int) ([]entity.WebhookSubscription, error) {
+	ret := _m.Called(limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMany")
+	}
+
+	var r0 []entity.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]entity.WebhookSubscription, error)); ok {
+		return rf(limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []entity.WebhookSubscription); ok {
+		r0 = rf(limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ReadMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMany'
+type WebhookRepository_ReadMany_Call struct {
+	*mock.Call
+}
+
+// ReadMany is a helper method to define mock.On call
+//   - limit int
+//   - offset int
+func (_e *WebhookRepository_Expecter) ReadMany(limit interface{}, offset interface{}) *WebhookRepository_ReadMany_Call {
+	return &WebhookRepository_ReadMany_Call{Call: _e.mock.On("ReadMany", limit, offset)}
+}
+
+func (_c *WebhookRepository_ReadMany_Call) Run(run func(limit int, offset int)) *WebhookRepository_ReadMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ReadMany_Call) Return(_a0 []entity.WebhookSubscription, _a1 error) *WebhookRepository_ReadMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ReadMany_Call) RunAndReturn(run func(int, int) ([]entity.WebhookSubscription, error)) *WebhookRepository_ReadMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOne provides a mock function with given fields: id
+func (_m *WebhookRepository) ReadOne(id uuid.UUID) (entity.WebhookSubscription, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOne")
+	}
+
+	var r0 entity.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (entity.WebhookSubscription, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) entity.WebhookSubscription); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(entity.WebhookSubscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ReadOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOne'
+type WebhookRepository_ReadOne_Call struct {
+	*mock.Call
+}
+
+// ReadOne is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *WebhookRepository_Expecter) ReadOne(id interface{}) *WebhookRepository_ReadOne_Call {
+	return &WebhookRepository_ReadOne_Call{Call: _e.mock.On("ReadOne", id)}
+}
+
+func (_c *WebhookRepository_ReadOne_Call) Run(run func(id uuid.UUID)) *WebhookRepository_ReadOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ReadOne_Call) Return(_a0 entity.WebhookSubscription, _a1 error) *WebhookRepository_ReadOne_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ReadOne_Call) RunAndReturn(run func(uuid.UUID) (entity.WebhookSubscription, error)) *WebhookRepository_ReadOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadSubscribed provides a mock function with given fields: eventType
+func (_m *WebhookRepository) ReadSubscribed(eventType event_type_enum.EventType) ([]entity.WebhookSubscription, error) {
+	ret := _m.Called(eventType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSubscribed")
+	}
+
+	var r0 []entity.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(event_type_enum.EventType) ([]entity.WebhookSubscription, error)); ok {
+		return rf(eventType)
+	}
+	if rf, ok := ret.Get(0).(func(event_type_enum.EventType) []entity.WebhookSubscription); ok {
+		r0 = rf(eventType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(event_type_enum.EventType) error); ok {
+		r1 = rf(eventType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ReadSubscribed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSubscribed'
+type WebhookRepository_ReadSubscribed_Call struct {
+	*mock.Call
+}
+
+// ReadSubscribed is a helper method to define mock.On call
+//   - eventType event_type_enum.EventType
+func (_e *WebhookRepository_Expecter) ReadSubscribed(eventType interface{}) *WebhookRepository_ReadSubscribed_Call {
+	return &WebhookRepository_ReadSubscribed_Call{Call: _e.mock.On("ReadSubscribed", eventType)}
+}
+
+func (_c *WebhookRepository_ReadSubscribed_Call) Run(run func(eventType event_type_enum.EventType)) *WebhookRepository_ReadSubscribed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(event_type_enum.EventType))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ReadSubscribed_Call) Return(_a0 []entity.WebhookSubscription, _a1 error) *WebhookRepository_ReadSubscribed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ReadSubscribed_Call) RunAndReturn(run func(event_type_enum.EventType) ([]entity.WebhookSubscription, error)) *WebhookRepository_ReadSubscribed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAttempt provides a mock function with given fields: id, status, responseStatus, message, deliveredAt, updatedAt
+func (_m *WebhookRepository) RecordAttempt(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, responseStatus int, message string, deliveredAt *int64, updatedAt int64) error {
+	ret := _m.Called(id, status, responseStatus, message, deliveredAt, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, string, *int64, int64) error); ok {
+		r0 = rf(id, status, responseStatus, message, deliveredAt, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_RecordAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAttempt'
+type WebhookRepository_RecordAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordAttempt is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - status webhook_delivery_status_enum.WebhookDeliveryStatus
+//   - responseStatus int
+//   - message string
+//   - deliveredAt *int64
+//   - updatedAt int64
+func (_e *WebhookRepository_Expecter) RecordAttempt(id interface{}, status interface{}, responseStatus interface{}, message interface{}, deliveredAt interface{}, updatedAt interface{}) *WebhookRepository_RecordAttempt_Call {
+	return &WebhookRepository_RecordAttempt_Call{Call: _e.mock.On("RecordAttempt", id, status, responseStatus, message, deliveredAt, updatedAt)}
+}
+
+func (_c *WebhookRepository_RecordAttempt_Call) Run(run func(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, responseStatus int, message string, deliveredAt *int64, updatedAt int64)) *WebhookRepository_RecordAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(webhook_delivery_status_enum.WebhookDeliveryStatus), args[2].(int), args[3].(string), args[4].(*int64), args[5].(int64))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_RecordAttempt_Call) Return(_a0 error) *WebhookRepository_RecordAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_RecordAttempt_Call) RunAndReturn(run func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, string, *int64, int64) error) *WebhookRepository_RecordAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeliver provides a mock function with given fields: id, updatedAt
+func (_m *WebhookRepository) Redeliver(id uuid.UUID, updatedAt int64) error {
+	ret := _m.Called(id, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int64) error); ok {
+		r0 = rf(id, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_Redeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeliver'
+type WebhookRepository_Redeliver_Call struct {
+	*mock.Call
+}
+
+// Redeliver is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - updatedAt int64
+func (_e *WebhookRepository_Expecter) Redeliver(id interface{}, updatedAt interface{}) *WebhookRepository_Redeliver_Call {
+	return &WebhookRepository_Redeliver_Call{Call: _e.mock.On("Redeliver", id, updatedAt)}
+}
+
+func (_c *WebhookRepository_Redeliver_Call) Run(run func(id uuid.UUID, updatedAt int64)) *WebhookRepository_Redeliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_Redeliver_Call) Return(_a0 error) *WebhookRepository_Redeliver_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_Redeliver_Call) RunAndReturn(run func(uuid.UUID, int64) error) *WebhookRepository_Redeliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceDeliveryPayloads provides a mock function with given fields: eventType, aggregateID, payload
+func (_m *WebhookRepository) ReplaceDeliveryPayloads(eventType event_type_enum.EventType, aggregateID string, payload string) error {
+	ret := _m.Called(eventType, aggregateID, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceDeliveryPayloads")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(event_type_enum.EventType, string, string) error); ok {
+		r0 = rf(eventType, aggregateID, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_ReplaceDeliveryPayloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceDeliveryPayloads'
+type WebhookRepository_ReplaceDeliveryPayloads_Call struct {
+	*mock.Call
+}
+
+// ReplaceDeliveryPayloads is a helper method to define mock.On call
+//   - eventType event_type_enum.EventType
+//   - aggregateID string
+//   - payload string
+func (_e *WebhookRepository_Expecter) ReplaceDeliveryPayloads(eventType interface{}, aggregateID interface{}, payload interface{}) *WebhookRepository_ReplaceDeliveryPayloads_Call {
+	return &WebhookRepository_ReplaceDeliveryPayloads_Call{Call: _e.mock.On("ReplaceDeliveryPayloads", eventType, aggregateID, payload)}
+}
+
+func (_c *WebhookRepository_ReplaceDeliveryPayloads_Call) Run(run func(eventType event_type_enum.EventType, aggregateID string, payload string)) *WebhookRepository_ReplaceDeliveryPayloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(event_type_enum.EventType), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ReplaceDeliveryPayloads_Call) Return(_a0 error) *WebhookRepository_ReplaceDeliveryPayloads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_ReplaceDeliveryPayloads_Call) RunAndReturn(run func(event_type_enum.EventType, string, string) error) *WebhookRepository_ReplaceDeliveryPayloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: subscription
+func (_m *WebhookRepository) Update(subscription entity.WebhookSubscription) error {
+	ret := _m.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.WebhookSubscription) error); ok {
+		r0 = rf(subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type WebhookRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - subscription entity.WebhookSubscription
+func (_e *WebhookRepository_Expecter) Update(subscription interface{}) *WebhookRepository_Update_Call {
+	return &WebhookRepository_Update_Call{Call: _e.mock.On("Update", subscription)}
+}
+
+func (_c *WebhookRepository_Update_Call) Run(run func(subscription entity.WebhookSubscription)) *WebhookRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.WebhookSubscription))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_Update_Call) Return(_a0 error) *WebhookRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_Update_Call) RunAndReturn(run func(entity.WebhookSubscription) error) *WebhookRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const deliveryColumns = `id, subscription_id, event_id, event_type, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at`
+
+// CreateDelivery saves a delivery unless the subscription already has one
+// for the event, and returns the stored delivery either way. Events reach
+// the webhook module at least once, so the same event can arrive again.
+func (r *Repository) CreateDelivery(delivery entity.WebhookDelivery) (entity.WebhookDelivery, error) {
+	query := `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, status, attempts,
+			response_status, last_error, delivered_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (subscription_id, event_id) DO UPDATE SET updated_at = webhook_deliveries.updated_at
+		RETURNING ` + deliveryColumns
+
+	saved, err := scanDelivery(r.db.QueryRow(query, delivery.ID, delivery.SubscriptionID, delivery.EventID,
+		delivery.EventType, delivery.Payload, delivery.Status, delivery.Attempts, delivery.ResponseStatus,
+		delivery.LastError, delivery.DeliveredAt, delivery.CreatedAt, delivery.UpdatedAt))
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("failed to create webhook delivery: %v", err)
+	}
+
+	return saved, nil
+}
+
+func (r *Repository) ReadDelivery(id uuid.UUID) (entity.WebhookDelivery, error) {
+	query := "SELECT " + deliveryColumns + " FROM webhook_deliveries WHERE id = $1"
+
+	delivery, err := scanDelivery(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.WebhookDelivery{}, nil
+	}
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("failed to read webhook delivery: %v", err)
+	}
+
+	return delivery, nil
+}
+
+// ReadDeliveries lists the deliveries of a subscription from newest to
+// oldest. An empty status matches every delivery.
+func (r *Repository) ReadDeliveries(subscriptionID uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, offset int) ([]entity.WebhookDelivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + ` FROM webhook_deliveries
+		WHERE subscription_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(query, subscriptionID, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]entity.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %v", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// RecordAttempt stores the outcome of sending a delivery. deliveredAt is only
+// set by a successful attempt and is kept by later failed ones.
+func (r *Repository) RecordAttempt(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, responseStatus int, message string, deliveredAt *int64, updatedAt int64) error {
+	query := `
+		UPDATE webhook_deliveries SET status = $1, attempts = attempts + 1, response_status = $2, last_error = $3,
+			delivered_at = COALESCE($4, delivered_at), updated_at = $5
+		WHERE id = $6
+	`
+
+	_, err := r.db.Exec(query, status, responseStatus, message, deliveredAt, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %v", err)
+	}
+
+	return nil
+}
+
+// Redeliver queues a delivery to be sent again. The log of earlier attempts
+// is kept.
+func (r *Repository) Redeliver(id uuid.UUID, updatedAt int64) error {
+	_, err := r.db.Exec("UPDATE webhook_deliveries SET status = $1, updated_at = $2 WHERE id = $3",
+		webhook_delivery_status_enum.Pending, updatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to redeliver webhook delivery: %v", err)
+	}
+
+	return nil
+}
+
+// ReplaceDeliveryPayloads overwrites the event payload kept in the body of
+// every delivery of an event of eventType about aggregateID.
+func (r *Repository) ReplaceDeliveryPayloads(eventType event_type_enum.EventType, aggregateID string, payload string) error {
+	query := `
+		UPDATE webhook_deliveries SET payload = jsonb_set(payload, '{payload}', $1::jsonb)
+		WHERE event_type = $2 AND payload->>'aggregate_id' = $3
+	`
+
+	_, err := r.db.Exec(query, payload, eventType, aggregateID)
+	if err != nil {
+		return fmt.Errorf("failed to replace webhook delivery payloads: %v", err)
+	}
+
+	return nil
+}
+
+func scanDelivery(row rowScanner) (entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+	err := row.Scan(
+		&delivery.ID, &delivery.SubscriptionID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.ResponseStatus, &delivery.LastError, &delivery.DeliveredAt,
+		&delivery.CreatedAt, &delivery.UpdatedAt,
+	)
+	return delivery, err
+}
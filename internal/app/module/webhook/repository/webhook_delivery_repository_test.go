@@ -0,0 +1,174 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_CreateDelivery(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Create Delivery Success", func(t *testing.T) {
+		d := MockDelivery
+		mock.ExpectQuery(createDeliveryQuery).
+			WithArgs(d.ID, d.SubscriptionID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts, d.ResponseStatus,
+				d.LastError, d.DeliveredAt, d.CreatedAt, d.UpdatedAt).
+			WillReturnRows(prepareDeliveryRows(d))
+
+		delivery, err := repo.CreateDelivery(d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, d, delivery)
+	})
+
+	t.Run("Create Delivery Returns Existing", func(t *testing.T) {
+		deliveredAt := int64(131313)
+		existing := MockDelivery
+		existing.Status = webhook_delivery_status_enum.Succeeded
+		existing.Attempts = 1
+		existing.ResponseStatus = 200
+		existing.DeliveredAt = &deliveredAt
+		mock.ExpectQuery(createDeliveryQuery).WillReturnRows(prepareDeliveryRows(existing))
+
+		delivery, err := repo.CreateDelivery(MockDelivery)
+
+		assert.NoError(t, err)
+		assert.Equal(t, existing, delivery)
+	})
+
+	t.Run("Create Delivery Error", func(t *testing.T) {
+		mock.ExpectQuery(createDeliveryQuery).WillReturnError(errors.New("insert failed"))
+
+		_, err := repo.CreateDelivery(MockDelivery)
+
+		assert.EqualError(t, err, "failed to create webhook delivery: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadDelivery(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Delivery Success", func(t *testing.T) {
+		mock.ExpectQuery(readDeliveryQuery).WithArgs(MockDelivery.ID).WillReturnRows(prepareDeliveryRows(MockDelivery))
+
+		delivery, err := repo.ReadDelivery(MockDelivery.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockDelivery, delivery)
+	})
+
+	t.Run("Read Delivery Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readDeliveryQuery).WithArgs(MockDelivery.ID).WillReturnError(sql.ErrNoRows)
+
+		delivery, err := repo.ReadDelivery(MockDelivery.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.WebhookDelivery{}, delivery)
+	})
+
+	t.Run("Read Delivery Error", func(t *testing.T) {
+		mock.ExpectQuery(readDeliveryQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadDelivery(MockDelivery.ID)
+
+		assert.EqualError(t, err, "failed to read webhook delivery: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadDeliveries(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Deliveries Success", func(t *testing.T) {
+		mock.ExpectQuery(readDeliveriesQuery).
+			WithArgs(MockSubscription.ID, webhook_delivery_status_enum.Failed, 10, 0).
+			WillReturnRows(prepareDeliveryRows(MockDelivery))
+
+		deliveries, err := repo.ReadDeliveries(MockSubscription.ID, webhook_delivery_status_enum.Failed, 10, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.WebhookDelivery{MockDelivery}, deliveries)
+	})
+
+	t.Run("Read Deliveries Error", func(t *testing.T) {
+		mock.ExpectQuery(readDeliveriesQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadDeliveries(MockSubscription.ID, "", 10, 0)
+
+		assert.EqualError(t, err, "failed to read webhook deliveries: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RecordAttempt(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Record Successful Attempt", func(t *testing.T) {
+		deliveredAt := int64(131313)
+		mock.ExpectExec(recordAttemptQuery).
+			WithArgs(webhook_delivery_status_enum.Succeeded, 200, "", &deliveredAt, deliveredAt, MockDelivery.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.RecordAttempt(MockDelivery.ID, webhook_delivery_status_enum.Succeeded, 200, "", &deliveredAt, deliveredAt)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Record Attempt Error", func(t *testing.T) {
+		mock.ExpectExec(recordAttemptQuery).WillReturnError(errors.New("update failed"))
+
+		err := repo.RecordAttempt(MockDelivery.ID, webhook_delivery_status_enum.Failed, 500, "webhook responded with status 500", nil, 131313)
+
+		assert.EqualError(t, err, "failed to record webhook delivery attempt: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Redeliver(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(redeliverQuery).WithArgs(webhook_delivery_status_enum.Pending, int64(131313), MockDelivery.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Redeliver(MockDelivery.ID, 131313))
+
+	mock.ExpectExec(redeliverQuery).WillReturnError(errors.New("update failed"))
+
+	assert.EqualError(t, repo.Redeliver(MockDelivery.ID, 131313), "failed to redeliver webhook delivery: update failed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReplaceDeliveryPayloads(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(replacePayloadsQuery).WithArgs(`{"user_id":"user123"}`, "user.registered", "user123").WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.ReplaceDeliveryPayloads(event_type_enum.UserRegistered, "user123", `{"user_id":"user123"}`)
+
+	assert.NoError(t, err)
+
+	mock.ExpectExec(replacePayloadsQuery).WillReturnError(errors.New("update failed"))
+
+	err = repo.ReplaceDeliveryPayloads(event_type_enum.UserRegistered, "user123", `{"user_id":"user123"}`)
+
+	assert.EqualError(t, err, "failed to replace webhook delivery payloads: update failed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const subscriptionColumns = `id, url, event_types, secret, active, created_at, updated_at`
+
+type WebhookRepository interface {
+	Create(subscription entity.WebhookSubscription) error
+	ReadOne(id uuid.UUID) (entity.WebhookSubscription, error)
+	ReadMany(limit int, offset int) ([]entity.WebhookSubscription, error)
+	ReadSubscribed(eventType event_type_enum.EventType) ([]entity.WebhookSubscription, error)
+	Update(subscription entity.WebhookSubscription) error
+	Delete(id uuid.UUID) error
+	CreateDelivery(delivery entity.WebhookDelivery) (entity.WebhookDelivery, error)
+	ReadDelivery(id uuid.UUID) (entity.WebhookDelivery, error)
+	ReadDeliveries(subscriptionID uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, offset int) ([]entity.WebhookDelivery, error)
+	RecordAttempt(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, responseStatus int, message string, deliveredAt *int64, updatedAt int64) error
+	Redeliver(id uuid.UUID, updatedAt int64) error
+	ReplaceDeliveryPayloads(eventType event_type_enum.EventType, aggregateID string, payload string) error
+}
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) WebhookRepository {
+	r := &Repository{db: db}
+	return r
+}
+
+func (r *Repository) Create(subscription entity.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (id, url, event_types, secret, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(query, subscription.ID, subscription.URL, subscription.EventTypes, subscription.Secret,
+		subscription.Active, subscription.CreatedAt, subscription.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadOne(id uuid.UUID) (entity.WebhookSubscription, error) {
+	query := "SELECT " + subscriptionColumns + " FROM webhook_subscriptions WHERE id = $1"
+
+	subscription, err := scanSubscription(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return entity.WebhookSubscription{}, nil
+	}
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf("failed to read webhook: %v", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *Repository) ReadMany(limit int, offset int) ([]entity.WebhookSubscription, error) {
+	query := "SELECT " + subscriptionColumns + " FROM webhook_subscriptions ORDER BY created_at DESC LIMIT $1 OFFSET $2"
+
+	rows, err := r.db.Query(query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	return scanSubscriptionRows(rows)
+}
+
+// ReadSubscribed lists the active subscriptions that receive eventType.
+func (r *Repository) ReadSubscribed(eventType event_type_enum.EventType) ([]entity.WebhookSubscription, error) {
+	query := `
+		SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions
+		WHERE active AND (cardinality(event_types) = 0 OR $1 = ANY(event_types))
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(query, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read subscribed webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	return scanSubscriptionRows(rows)
+}
+
+func (r *Repository) Update(subscription entity.WebhookSubscription) error {
+	query := "UPDATE webhook_subscriptions SET url = $1, event_types = $2, active = $3, updated_at = $4 WHERE id = $5"
+
+	_, err := r.db.Exec(query, subscription.URL, subscription.EventTypes, subscription.Active, subscription.UpdatedAt, subscription.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %v", err)
+	}
+
+	return nil
+}
+
+// Delete removes a subscription together with its delivery log.
+func (r *Repository) Delete(id uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM webhook_deliveries WHERE subscription_id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSubscription(row rowScanner) (entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+	err := row.Scan(
+		&subscription.ID, &subscription.URL, &subscription.EventTypes, &subscription.Secret, &subscription.Active,
+		&subscription.CreatedAt, &subscription.UpdatedAt,
+	)
+	return subscription, err
+}
+
+func scanSubscriptionRows(rows *sql.Rows) ([]entity.WebhookSubscription, error) {
+	subscriptions := make([]entity.WebhookSubscription, 0)
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %v", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
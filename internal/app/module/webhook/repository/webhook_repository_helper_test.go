@@ -0,0 +1,79 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	"CodeWithAzri/internal/app/module/webhook/repository"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	createSubscriptionQuery = "INSERT INTO webhook_subscriptions (id, url, event_types, secret, active, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	readSubscriptionQuery   = "SELECT id, url, event_types, secret, active, created_at, updated_at FROM webhook_subscriptions WHERE id = $1"
+	readSubscriptionsQuery  = "SELECT id, url, event_types, secret, active, created_at, updated_at FROM webhook_subscriptions ORDER BY created_at DESC LIMIT $1 OFFSET $2"
+	readSubscribedQuery     = "SELECT id, url, event_types, secret, active, created_at, updated_at FROM webhook_subscriptions WHERE active AND (cardinality(event_types) = 0 OR $1 = ANY(event_types)) ORDER BY created_at"
+	updateSubscriptionQuery = "UPDATE webhook_subscriptions SET url = $1, event_types = $2, active = $3, updated_at = $4 WHERE id = $5"
+	deleteDeliveriesQuery   = "DELETE FROM webhook_deliveries WHERE subscription_id = $1"
+	deleteSubscriptionQuery = "DELETE FROM webhook_subscriptions WHERE id = $1"
+	createDeliveryQuery     = "INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (subscription_id, event_id) DO UPDATE SET updated_at = webhook_deliveries.updated_at RETURNING id, subscription_id, event_id, event_type, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at"
+	readDeliveryQuery       = "SELECT id, subscription_id, event_id, event_type, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at FROM webhook_deliveries WHERE id = $1"
+	replacePayloadsQuery    = "UPDATE webhook_deliveries SET payload = jsonb_set(payload, '{payload}', $1::jsonb) WHERE event_type = $2 AND payload->>'aggregate_id' = $3"
+	readDeliveriesQuery     = "SELECT id, subscription_id, event_id, event_type, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at FROM webhook_deliveries WHERE subscription_id = $1 AND ($2 = '' OR status = $2) ORDER BY created_at DESC LIMIT $3 OFFSET $4"
+	recordAttemptQuery      = "UPDATE webhook_deliveries SET status = $1, attempts = attempts + 1, response_status = $2, last_error = $3, delivered_at = COALESCE($4, delivered_at), updated_at = $5 WHERE id = $6"
+	redeliverQuery          = "UPDATE webhook_deliveries SET status = $1, updated_at = $2 WHERE id = $3"
+)
+
+var MockSubscription entity.WebhookSubscription = entity.WebhookSubscription{
+	ID:         uuid.MustParse("7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e41"),
+	URL:        "https://partner.example.com/hooks",
+	EventTypes: pq.StringArray{"course.enrolled", "lesson.completed"},
+	Secret:     "whsec_0123456789abcdef",
+	Active:     true,
+	CreatedAt:  121212,
+	UpdatedAt:  121212,
+}
+
+var MockDelivery entity.WebhookDelivery = entity.WebhookDelivery{
+	ID:             uuid.MustParse("7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e51"),
+	SubscriptionID: MockSubscription.ID,
+	EventID:        uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+	EventType:      "course.enrolled",
+	Payload:        `{"id":"5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61","type":"course.enrolled"}`,
+	Status:         "pending",
+	CreatedAt:      121212,
+	UpdatedAt:      121212,
+}
+
+func initializeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.WebhookRepository) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("Error creating mock database: %v", err)
+	}
+
+	mockRepo := repository.NewRepository(db)
+
+	return db, mock, mockRepo
+}
+
+func prepareSubscriptionRows(subscriptions ...entity.WebhookSubscription) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "url", "event_types", "secret", "active", "created_at", "updated_at"})
+	for _, s := range subscriptions {
+		eventTypes, _ := s.EventTypes.Value()
+		rows.AddRow(s.ID, s.URL, eventTypes, s.Secret, s.Active, s.CreatedAt, s.UpdatedAt)
+	}
+	return rows
+}
+
+func prepareDeliveryRows(deliveries ...entity.WebhookDelivery) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "subscription_id", "event_id", "event_type", "payload", "status", "attempts",
+		"response_status", "last_error", "delivered_at", "created_at", "updated_at"})
+	for _, d := range deliveries {
+		rows.AddRow(d.ID, d.SubscriptionID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts, d.ResponseStatus,
+			d.LastError, d.DeliveredAt, d.CreatedAt, d.UpdatedAt)
+	}
+	return rows
+}
@@ -0,0 +1,186 @@
+package repository_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Create Success", func(t *testing.T) {
+		mock.ExpectExec(createSubscriptionQuery).
+			WithArgs(MockSubscription.ID, MockSubscription.URL, MockSubscription.EventTypes, MockSubscription.Secret,
+				MockSubscription.Active, MockSubscription.CreatedAt, MockSubscription.UpdatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Create(MockSubscription))
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		mock.ExpectExec(createSubscriptionQuery).WillReturnError(errors.New("insert failed"))
+
+		assert.EqualError(t, repo.Create(MockSubscription), "failed to create webhook: insert failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadOne(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read One Success", func(t *testing.T) {
+		mock.ExpectQuery(readSubscriptionQuery).WithArgs(MockSubscription.ID).
+			WillReturnRows(prepareSubscriptionRows(MockSubscription))
+
+		subscription, err := repo.ReadOne(MockSubscription.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockSubscription, subscription)
+	})
+
+	t.Run("Read One Not Found", func(t *testing.T) {
+		mock.ExpectQuery(readSubscriptionQuery).WithArgs(MockSubscription.ID).WillReturnError(sql.ErrNoRows)
+
+		subscription, err := repo.ReadOne(MockSubscription.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.WebhookSubscription{}, subscription)
+	})
+
+	t.Run("Read One Error", func(t *testing.T) {
+		mock.ExpectQuery(readSubscriptionQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadOne(MockSubscription.ID)
+
+		assert.EqualError(t, err, "failed to read webhook: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadMany(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Many Success", func(t *testing.T) {
+		mock.ExpectQuery(readSubscriptionsQuery).WithArgs(10, 20).WillReturnRows(prepareSubscriptionRows(MockSubscription))
+
+		subscriptions, err := repo.ReadMany(10, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.WebhookSubscription{MockSubscription}, subscriptions)
+	})
+
+	t.Run("Read Many Error", func(t *testing.T) {
+		mock.ExpectQuery(readSubscriptionsQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadMany(10, 0)
+
+		assert.EqualError(t, err, "failed to read webhooks: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReadSubscribed(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Read Subscribed Success", func(t *testing.T) {
+		mock.ExpectQuery(readSubscribedQuery).WithArgs(event_type_enum.CourseEnrolled).
+			WillReturnRows(prepareSubscriptionRows(MockSubscription))
+
+		subscriptions, err := repo.ReadSubscribed(event_type_enum.CourseEnrolled)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.WebhookSubscription{MockSubscription}, subscriptions)
+	})
+
+	t.Run("Read Subscribed Scan Error", func(t *testing.T) {
+		mock.ExpectQuery(readSubscribedQuery).WithArgs(event_type_enum.CourseEnrolled).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(MockSubscription.ID))
+
+		_, err := repo.ReadSubscribed(event_type_enum.CourseEnrolled)
+
+		assert.ErrorContains(t, err, "failed to scan webhook")
+	})
+
+	t.Run("Read Subscribed Error", func(t *testing.T) {
+		mock.ExpectQuery(readSubscribedQuery).WillReturnError(errors.New("query failed"))
+
+		_, err := repo.ReadSubscribed(event_type_enum.CourseEnrolled)
+
+		assert.EqualError(t, err, "failed to read subscribed webhooks: query failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Update(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Update Success", func(t *testing.T) {
+		mock.ExpectExec(updateSubscriptionQuery).
+			WithArgs(MockSubscription.URL, MockSubscription.EventTypes, MockSubscription.Active, MockSubscription.UpdatedAt, MockSubscription.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Update(MockSubscription))
+	})
+
+	t.Run("Update Error", func(t *testing.T) {
+		mock.ExpectExec(updateSubscriptionQuery).WillReturnError(errors.New("update failed"))
+
+		assert.EqualError(t, repo.Update(MockSubscription), "failed to update webhook: update failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Delete(t *testing.T) {
+	db, mock, repo := initializeMockDB(t)
+	defer db.Close()
+
+	t.Run("Delete Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteDeliveriesQuery).WithArgs(MockSubscription.ID).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(deleteSubscriptionQuery).WithArgs(MockSubscription.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Delete(MockSubscription.ID))
+	})
+
+	t.Run("Delete Deliveries Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteDeliveriesQuery).WillReturnError(errors.New("delete failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.Delete(MockSubscription.ID), "failed to delete webhook deliveries: delete failed")
+	})
+
+	t.Run("Delete Subscription Error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteDeliveriesQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(deleteSubscriptionQuery).WillReturnError(errors.New("delete failed"))
+		mock.ExpectRollback()
+
+		assert.EqualError(t, repo.Delete(MockSubscription.ID), "failed to delete webhook: delete failed")
+	})
+
+	t.Run("Delete Begin Error", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("begin failed"))
+
+		assert.EqualError(t, repo.Delete(MockSubscription.ID), "failed to begin transaction: begin failed")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,536 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "CodeWithAzri/internal/app/module/webhook/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+)
+
+// WebhookService is an autogenerated mock type for the WebhookService type
+type WebhookService struct {
+	mock.Mock
+}
+
+type WebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookService) EXPECT() *WebhookService_Expecter {
+	return &WebhookService_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: input
+func (_m *WebhookService) CreateWebhook(input dto.CreateWebhookDTO) (dto.WebhookDTO, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 dto.WebhookDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.CreateWebhookDTO) (dto.WebhookDTO, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(dto.CreateWebhookDTO) dto.WebhookDTO); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(dto.WebhookDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.CreateWebhookDTO) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookService_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - input dto.CreateWebhookDTO
+func (_e *WebhookService_Expecter) CreateWebhook(input interface{}) *WebhookService_CreateWebhook_Call {
+	return &WebhookService_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", input)}
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Run(run func(input dto.CreateWebhookDTO)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(dto.CreateWebhookDTO))
+	})
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Return(_a0 dto.WebhookDTO, _a1 error) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) RunAndReturn(run func(dto.CreateWebhookDTO) (dto.WebhookDTO, error)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: id
+func (_m *WebhookService) DeleteWebhook(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookService_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *WebhookService_Expecter) DeleteWebhook(id interface{}) *WebhookService_DeleteWebhook_Call {
+	return &WebhookService_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", id)}
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Run(run func(id uuid.UUID)) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Return(_a0 error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) RunAndReturn(run func(uuid.UUID) error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EraseUserData provides a mock function with given fields: userID
+func (_m *WebhookService) EraseUserData(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUserData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_EraseUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseUserData'
+type WebhookService_EraseUserData_Call struct {
+	*mock.Call
+}
+
+// EraseUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *WebhookService_Expecter) EraseUserData(userID interface{}) *WebhookService_EraseUserData_Call {
+	return &WebhookService_EraseUserData_Call{Call: _e.mock.On("EraseUserData", userID)}
+}
+
+func (_c *WebhookService_EraseUserData_Call) Run(run func(userID string)) *WebhookService_EraseUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_EraseUserData_Call) Return(_a0 error) *WebhookService_EraseUserData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_EraseUserData_Call) RunAndReturn(run func(string) error) *WebhookService_EraseUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: userID
+func (_m *WebhookService) ExportUserData(userID string) (map[string]any, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]any, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]any); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type WebhookService_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - userID string
+func (_e *WebhookService_Expecter) ExportUserData(userID interface{}) *WebhookService_ExportUserData_Call {
+	return &WebhookService_ExportUserData_Call{Call: _e.mock.On("ExportUserData", userID)}
+}
+
+func (_c *WebhookService_ExportUserData_Call) Run(run func(userID string)) *WebhookService_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ExportUserData_Call) Return(_a0 map[string]any, _a1 error) *WebhookService_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ExportUserData_Call) RunAndReturn(run func(string) (map[string]any, error)) *WebhookService_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeliveries provides a mock function with given fields: id, status, limit, page
+func (_m *WebhookService) GetDeliveries(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, page int) ([]dto.WebhookDeliveryDTO, error) {
+	ret := _m.Called(id, status, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveries")
+	}
+
+	var r0 []dto.WebhookDeliveryDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) ([]dto.WebhookDeliveryDTO, error)); ok {
+		return rf(id, status, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) []dto.WebhookDeliveryDTO); ok {
+		r0 = rf(id, status, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WebhookDeliveryDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) error); ok {
+		r1 = rf(id, status, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeliveries'
+type WebhookService_GetDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetDeliveries is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - status webhook_delivery_status_enum.WebhookDeliveryStatus
+//   - limit int
+//   - page int
+func (_e *WebhookService_Expecter) GetDeliveries(id interface{}, status interface{}, limit interface{}, page interface{}) *WebhookService_GetDeliveries_Call {
+	return &WebhookService_GetDeliveries_Call{Call: _e.mock.On("GetDeliveries", id, status, limit, page)}
+}
+
+func (_c *WebhookService_GetDeliveries_Call) Run(run func(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, page int)) *WebhookService_GetDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(webhook_delivery_status_enum.WebhookDeliveryStatus), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetDeliveries_Call) Return(_a0 []dto.WebhookDeliveryDTO, _a1 error) *WebhookService_GetDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetDeliveries_Call) RunAndReturn(run func(uuid.UUID, webhook_delivery_status_enum.WebhookDeliveryStatus, int, int) ([]dto.WebhookDeliveryDTO, error)) *WebhookService_GetDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function with given fields: id
+func (_m *WebhookService) GetWebhook(id uuid.UUID) (dto.WebhookDTO, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 dto.WebhookDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (dto.WebhookDTO, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) dto.WebhookDTO); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dto.WebhookDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type WebhookService_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *WebhookService_Expecter) GetWebhook(id interface{}) *WebhookService_GetWebhook_Call {
+	return &WebhookService_GetWebhook_Call{Call: _e.mock.On("GetWebhook", id)}
+}
+
+func (_c *WebhookService_GetWebhook_Call) Run(run func(id uuid.UUID)) *WebhookService_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetWebhook_Call) Return(_a0 dto.WebhookDTO, _a1 error) *WebhookService_GetWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetWebhook_Call) RunAndReturn(run func(uuid.UUID) (dto.WebhookDTO, error)) *WebhookService_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function with given fields: limit, page
+func (_m *WebhookService) GetWebhooks(limit int, page int) ([]dto.WebhookDTO, error) {
+	ret := _m.Called(limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []dto.WebhookDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]dto.WebhookDTO, error)); ok {
+		return rf(limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []dto.WebhookDTO); ok {
+		r0 = rf(limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WebhookDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type WebhookService_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - limit int
+//   - page int
+func (_e *WebhookService_Expecter) GetWebhooks(limit interface{}, page interface{}) *WebhookService_GetWebhooks_Call {
+	return &WebhookService_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", limit, page)}
+}
+
+func (_c *WebhookService_GetWebhooks_Call) Run(run func(limit int, page int)) *WebhookService_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetWebhooks_Call) Return(_a0 []dto.WebhookDTO, _a1 error) *WebhookService_GetWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetWebhooks_Call) RunAndReturn(run func(int, int) ([]dto.WebhookDTO, error)) *WebhookService_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeliver provides a mock function with given fields: id, deliveryID
+func (_m *WebhookService) Redeliver(id uuid.UUID, deliveryID uuid.UUID) (dto.WebhookDeliveryDTO, error) {
+	ret := _m.Called(id, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 dto.WebhookDeliveryDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (dto.WebhookDeliveryDTO, error)); ok {
+		return rf(id, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) dto.WebhookDeliveryDTO); ok {
+		r0 = rf(id, deliveryID)
+	} else {
+		r0 = ret.Get(0).(dto.WebhookDeliveryDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_Redeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeliver'
+type WebhookService_Redeliver_Call struct {
+	*mock.Call
+}
+
+// Redeliver is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - deliveryID uuid.UUID
+func (_e *WebhookService_Expecter) Redeliver(id interface{}, deliveryID interface{}) *WebhookService_Redeliver_Call {
+	return &WebhookService_Redeliver_Call{Call: _e.mock.On("Redeliver", id, deliveryID)}
+}
+
+func (_c *WebhookService_Redeliver_Call) Run(run func(id uuid.UUID, deliveryID uuid.UUID)) *WebhookService_Redeliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_Redeliver_Call) Return(_a0 dto.WebhookDeliveryDTO, _a1 error) *WebhookService_Redeliver_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_Redeliver_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (dto.WebhookDeliveryDTO, error)) *WebhookService_Redeliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: id, input
+func (_m *WebhookService) UpdateWebhook(id uuid.UUID, input dto.UpdateWebhookDTO) (dto.WebhookDTO, error) {
+	ret := _m.Called(id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 dto.WebhookDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.UpdateWebhookDTO) (dto.WebhookDTO, error)); ok {
+		return rf(id, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, dto.UpdateWebhookDTO) dto.WebhookDTO); ok {
+		r0 = rf(id, input)
+	} else {
+		r0 = ret.Get(0).(dto.WebhookDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, dto.UpdateWebhookDTO) error); ok {
+		r1 = rf(id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type WebhookService_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - input dto.UpdateWebhookDTO
+func (_e *WebhookService_Expecter) UpdateWebhook(id interface{}, input interface{}) *WebhookService_UpdateWebhook_Call {
+	return &WebhookService_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", id, input)}
+}
+
+func (_c *WebhookService_UpdateWebhook_Call) Run(run func(id uuid.UUID, input dto.UpdateWebhookDTO)) *WebhookService_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(dto.UpdateWebhookDTO))
+	})
+	return _c
+}
+
+func (_c *WebhookService_UpdateWebhook_Call) Return(_a0 dto.WebhookDTO, _a1 error) *WebhookService_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_UpdateWebhook_Call) RunAndReturn(run func(uuid.UUID, dto.UpdateWebhookDTO) (dto.WebhookDTO, error)) *WebhookService_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookService {
+	mock := &WebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
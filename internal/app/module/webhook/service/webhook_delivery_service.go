@@ -0,0 +1,241 @@
+package service
+
+import (
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DeliverJobType sends one delivery. Failed attempts are retried by the
+	// job queue with exponential backoff.
+	DeliverJobType      = "webhooks.deliver"
+	eventSubscriberName = "webhooks"
+
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+)
+
+// deliveryJob is the payload of a DeliverJobType job.
+type deliveryJob struct {
+	DeliveryID uuid.UUID `json:"delivery_id"`
+}
+
+// Sign returns the signature sent in SignatureHeader. Receivers recompute
+// it from the raw body and the value of TimestampHeader, compare it in
+// constant time and reject old timestamps to stop replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) GetDeliveries(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, page int) ([]dto.WebhookDeliveryDTO, error) {
+	_, err := s.readSubscription(id)
+	if err != nil {
+		return []dto.WebhookDeliveryDTO{}, err
+	}
+
+	offset := (page - 1) * limit
+	deliveries, err := s.repository.ReadDeliveries(id, status, limit, offset)
+	if err != nil {
+		return []dto.WebhookDeliveryDTO{}, err
+	}
+
+	deliveryDTOs := make([]dto.WebhookDeliveryDTO, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		deliveryDTOs = append(deliveryDTOs, toDeliveryDTO(delivery))
+	}
+
+	return deliveryDTOs, nil
+}
+
+// Redeliver sends a delivery again, whatever the outcome of earlier
+// attempts. Partners use it to recover events they lost on their side.
+func (s *Service) Redeliver(id uuid.UUID, deliveryID uuid.UUID) (dto.WebhookDeliveryDTO, error) {
+	delivery, err := s.readDelivery(id, deliveryID)
+	if err != nil {
+		return dto.WebhookDeliveryDTO{}, err
+	}
+
+	err = s.repository.Redeliver(deliveryID, timepkg.NowUnixMilli())
+	if err != nil {
+		return dto.WebhookDeliveryDTO{}, err
+	}
+
+	_, err = s.jobs.Enqueue(DeliverJobType, deliveryJob{DeliveryID: delivery.ID})
+	if err != nil {
+		return dto.WebhookDeliveryDTO{}, err
+	}
+
+	delivery, err = s.readDelivery(id, deliveryID)
+	if err != nil {
+		return dto.WebhookDeliveryDTO{}, err
+	}
+
+	return toDeliveryDTO(delivery), nil
+}
+
+func (s *Service) readDelivery(id uuid.UUID, deliveryID uuid.UUID) (entity.WebhookDelivery, error) {
+	_, err := s.readSubscription(id)
+	if err != nil {
+		return entity.WebhookDelivery{}, err
+	}
+
+	delivery, err := s.repository.ReadDelivery(deliveryID)
+	if err != nil {
+		return entity.WebhookDelivery{}, err
+	}
+
+	if delivery.ID == uuid.Nil || delivery.SubscriptionID != id {
+		return entity.WebhookDelivery{}, ErrDeliveryNotFound
+	}
+
+	return delivery, nil
+}
+
+// fanOut creates a delivery of the event for every webhook that wants it.
+// A delivery that already exists is only queued again while it is pending,
+// so an event that reaches the webhooks twice is not posted twice.
+func (s *Service) fanOut(ctx context.Context, event eventDTO.EventDTO) error {
+	subscriptions, err := s.repository.ReadSubscribed(event.Type)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %v", err)
+	}
+
+	now := timepkg.NowUnixMilli()
+	for _, subscription := range subscriptions {
+		delivery, err := s.repository.CreateDelivery(entity.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        string(body),
+			Status:         webhook_delivery_status_enum.Pending,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+		if err != nil {
+			return err
+		}
+
+		if delivery.Status != webhook_delivery_status_enum.Pending {
+			continue
+		}
+
+		_, err = s.jobs.Enqueue(DeliverJobType, deliveryJob{DeliveryID: delivery.ID})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver posts a pending delivery and records the attempt. Deliveries of a
+// deleted webhook are dropped; those of a deactivated one stay pending until
+// they are redelivered.
+func (s *Service) deliver(ctx context.Context, input deliveryJob) error {
+	delivery, err := s.repository.ReadDelivery(input.DeliveryID)
+	if err != nil {
+		return err
+	}
+
+	// An earlier job for the same delivery may have sent it already.
+	if delivery.ID == uuid.Nil || delivery.Status == webhook_delivery_status_enum.Succeeded {
+		return nil
+	}
+
+	subscription, err := s.repository.ReadOne(delivery.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if !subscription.Active {
+		log.Printf("skipping delivery %s of inactive webhook %s\n", delivery.ID, delivery.SubscriptionID)
+		return nil
+	}
+
+	responseStatus, cause := s.send(ctx, subscription, delivery)
+
+	now := timepkg.NowUnixMilli()
+	if cause == nil {
+		return s.repository.RecordAttempt(delivery.ID, webhook_delivery_status_enum.Succeeded, responseStatus, "", &now, now)
+	}
+
+	err = s.repository.RecordAttempt(delivery.ID, webhook_delivery_status_enum.Failed, responseStatus, cause.Error(), nil, now)
+	if err != nil {
+		return err
+	}
+
+	return cause
+}
+
+// send posts the delivery to the webhook and returns the response status,
+// which is 0 when no response arrived.
+func (s *Service) send(ctx context.Context, subscription entity.WebhookSubscription, delivery entity.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := timepkg.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-ID", delivery.ID.String())
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, timestamp, body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %v", err)
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+func toDeliveryDTO(delivery entity.WebhookDelivery) dto.WebhookDeliveryDTO {
+	return dto.WebhookDeliveryDTO{
+		ID:             delivery.ID,
+		WebhookID:      delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
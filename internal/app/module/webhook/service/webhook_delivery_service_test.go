@@ -0,0 +1,263 @@
+package service_test
+
+import (
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func deliveryPayload(id uuid.UUID) []byte {
+	return []byte(`{"delivery_id":"` + id.String() + `"}`)
+}
+
+func TestSign(t *testing.T) {
+	signature := service.Sign("secret", 1700000000, []byte(`{"id":"1"}`))
+
+	assert.Equal(t, "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54", signature)
+	assert.NotEqual(t, signature, service.Sign("other", 1700000000, []byte(`{"id":"1"}`)))
+	assert.NotEqual(t, signature, service.Sign("secret", 1700000001, []byte(`{"id":"1"}`)))
+}
+
+func TestService_FanOut(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	event := eventDTO.EventDTO{
+		ID:          MockDelivery.EventID,
+		Type:        event_type_enum.CourseEnrolled,
+		AggregateID: "18a95d2f-a941-4a64-bbe5-256be7626db2",
+		Payload:     json.RawMessage(`{"user_id":"user123"}`),
+		OccurredAt:  121212,
+	}
+
+	t.Run("Fan Out To Subscribed Webhooks", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadSubscribed", event_type_enum.CourseEnrolled).Return([]entity.WebhookSubscription{MockSubscription}, nil)
+		test.repository.On("CreateDelivery", mock.MatchedBy(func(d entity.WebhookDelivery) bool {
+			var sent eventDTO.EventDTO
+			json.Unmarshal([]byte(d.Payload), &sent)
+			return d.SubscriptionID == MockSubscription.ID && d.EventID == event.ID &&
+				d.Status == webhook_delivery_status_enum.Pending && d.CreatedAt == 131313 && sent.ID == event.ID
+		})).Return(MockDelivery, nil)
+		test.jobs.On("Enqueue", service.DeliverJobType, mock.Anything).
+			Run(func(args mock.Arguments) {
+				encoded, _ := json.Marshal(args.Get(1))
+				assert.JSONEq(t, string(deliveryPayload(MockDelivery.ID)), string(encoded))
+			}).Return(uuid.New(), nil).Once()
+
+		assert.NoError(t, test.fanOut(context.Background(), event))
+	})
+
+	t.Run("Fan Out Skips Sent Deliveries", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		sent := MockDelivery
+		sent.Status = webhook_delivery_status_enum.Succeeded
+		test.repository.On("ReadSubscribed", event_type_enum.CourseEnrolled).Return([]entity.WebhookSubscription{MockSubscription}, nil)
+		test.repository.On("CreateDelivery", mock.AnythingOfType("entity.WebhookDelivery")).Return(sent, nil)
+
+		assert.NoError(t, test.fanOut(context.Background(), event))
+		test.jobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Fan Out Enqueue Error", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadSubscribed", event_type_enum.CourseEnrolled).Return([]entity.WebhookSubscription{MockSubscription}, nil)
+		test.repository.On("CreateDelivery", mock.AnythingOfType("entity.WebhookDelivery")).Return(MockDelivery, nil)
+		test.jobs.On("Enqueue", service.DeliverJobType, mock.Anything).Return(uuid.Nil, errors.New("queue unavailable"))
+
+		assert.EqualError(t, test.fanOut(context.Background(), event), "queue unavailable")
+	})
+
+	t.Run("Fan Out Read Error", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadSubscribed", event_type_enum.CourseEnrolled).Return(nil, errors.New("Repository Failure"))
+
+		assert.EqualError(t, test.fanOut(context.Background(), event), "Repository Failure")
+	})
+}
+
+func TestService_Deliver(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	monkey.Patch(timepkg.Now, func() time.Time { return now })
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return now.UnixMilli() })
+	defer monkey.UnpatchAll()
+
+	deliveredAt := now.UnixMilli()
+
+	t.Run("Deliver Signed Payload", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			timestamp, _ := strconv.ParseInt(r.Header.Get(service.TimestampHeader), 10, 64)
+
+			assert.Equal(t, MockDelivery.Payload, string(body))
+			assert.Equal(t, now.Unix(), timestamp)
+			assert.Equal(t, service.Sign(MockSubscription.Secret, timestamp, body), r.Header.Get(service.SignatureHeader))
+			assert.Equal(t, MockDelivery.ID.String(), r.Header.Get("X-Webhook-ID"))
+			assert.Equal(t, "course.enrolled", r.Header.Get("X-Webhook-Event"))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		subscription := MockSubscription
+		subscription.URL = server.URL
+		test := initializeService(t, server.Client())
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(MockDelivery, nil)
+		test.repository.On("ReadOne", MockSubscription.ID).Return(subscription, nil)
+		test.repository.On("RecordAttempt", MockDelivery.ID, webhook_delivery_status_enum.Succeeded, http.StatusOK, "", &deliveredAt, deliveredAt).Return(nil)
+
+		assert.NoError(t, test.deliver(context.Background(), deliveryPayload(MockDelivery.ID)))
+	})
+
+	t.Run("Deliver Failure Is Recorded And Retried", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		subscription := MockSubscription
+		subscription.URL = server.URL
+		test := initializeService(t, server.Client())
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(MockDelivery, nil)
+		test.repository.On("ReadOne", MockSubscription.ID).Return(subscription, nil)
+		test.repository.On("RecordAttempt", MockDelivery.ID, webhook_delivery_status_enum.Failed, http.StatusServiceUnavailable,
+			"webhook responded with status 503", (*int64)(nil), deliveredAt).Return(nil)
+
+		err := test.deliver(context.Background(), deliveryPayload(MockDelivery.ID))
+
+		assert.EqualError(t, err, "webhook responded with status 503")
+	})
+
+	t.Run("Deliver Unreachable Webhook", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		subscription := MockSubscription
+		subscription.URL = server.URL
+		server.Close()
+
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(MockDelivery, nil)
+		test.repository.On("ReadOne", MockSubscription.ID).Return(subscription, nil)
+		test.repository.On("RecordAttempt", MockDelivery.ID, webhook_delivery_status_enum.Failed, 0,
+			mock.AnythingOfType("string"), (*int64)(nil), deliveredAt).Return(nil)
+
+		err := test.deliver(context.Background(), deliveryPayload(MockDelivery.ID))
+
+		assert.ErrorContains(t, err, "failed to send webhook")
+	})
+
+	t.Run("Deliver Skips Sent Delivery", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		sent := MockDelivery
+		sent.Status = webhook_delivery_status_enum.Succeeded
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(sent, nil)
+
+		assert.NoError(t, test.deliver(context.Background(), deliveryPayload(MockDelivery.ID)))
+	})
+
+	t.Run("Deliver Skips Deleted Delivery", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(entity.WebhookDelivery{}, nil)
+
+		assert.NoError(t, test.deliver(context.Background(), deliveryPayload(MockDelivery.ID)))
+	})
+
+	t.Run("Deliver Keeps Inactive Webhook Pending", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		inactive := MockSubscription
+		inactive.Active = false
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(MockDelivery, nil)
+		test.repository.On("ReadOne", MockSubscription.ID).Return(inactive, nil)
+
+		assert.NoError(t, test.deliver(context.Background(), deliveryPayload(MockDelivery.ID)))
+		test.repository.AssertNotCalled(t, "RecordAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_GetDeliveries(t *testing.T) {
+	test := initializeService(t, http.DefaultClient)
+
+	t.Run("Get Deliveries Success", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil).Once()
+		test.repository.On("ReadDeliveries", MockSubscription.ID, webhook_delivery_status_enum.Failed, 10, 10).
+			Return([]entity.WebhookDelivery{MockDelivery}, nil).Once()
+
+		deliveries, err := test.service.GetDeliveries(MockSubscription.ID, webhook_delivery_status_enum.Failed, 10, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, deliveries, 1)
+		assert.Equal(t, MockSubscription.ID, deliveries[0].WebhookID)
+		assert.JSONEq(t, MockDelivery.Payload, string(deliveries[0].Payload))
+	})
+
+	t.Run("Get Deliveries Webhook Not Found", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(entity.WebhookSubscription{}, nil).Once()
+
+		_, err := test.service.GetDeliveries(MockSubscription.ID, "", 10, 1)
+
+		assert.ErrorIs(t, err, service.ErrWebhookNotFound)
+	})
+}
+
+func TestService_Redeliver(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Redeliver Success", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		failed := MockDelivery
+		failed.Status = webhook_delivery_status_enum.Failed
+		failed.Attempts = 5
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil)
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(failed, nil).Once()
+		test.repository.On("Redeliver", MockDelivery.ID, int64(131313)).Return(nil)
+		test.jobs.On("Enqueue", service.DeliverJobType, mock.Anything).Return(uuid.New(), nil)
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(MockDelivery, nil).Once()
+
+		delivery, err := test.service.Redeliver(MockSubscription.ID, MockDelivery.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, webhook_delivery_status_enum.Pending, delivery.Status)
+	})
+
+	t.Run("Redeliver Delivery Of Other Webhook", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		other := MockDelivery
+		other.SubscriptionID = uuid.New()
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil)
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(other, nil)
+
+		_, err := test.service.Redeliver(MockSubscription.ID, MockDelivery.ID)
+
+		assert.ErrorIs(t, err, service.ErrDeliveryNotFound)
+	})
+
+	t.Run("Redeliver Enqueue Error", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil)
+		test.repository.On("ReadDelivery", MockDelivery.ID).Return(MockDelivery, nil)
+		test.repository.On("Redeliver", MockDelivery.ID, int64(131313)).Return(nil)
+		test.jobs.On("Enqueue", service.DeliverJobType, mock.Anything).Return(uuid.Nil, errors.New("queue unavailable"))
+
+		_, err := test.service.Redeliver(MockSubscription.ID, MockDelivery.ID)
+
+		assert.EqualError(t, err, "queue unavailable")
+	})
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	eventDTO "CodeWithAzri/internal/app/module/event/dto"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"encoding/json"
+	"fmt"
+)
+
+// ExportUserData exports nothing: deliveries only repeat events that the
+// modules publishing them already export.
+func (s *Service) ExportUserData(userID string) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+// EraseUserData strips the name and email of a user from the logged bodies
+// of their registration event, so redelivering it no longer sends them.
+func (s *Service) EraseUserData(userID string) error {
+	payload, err := json.Marshal(eventDTO.UserRegisteredPayload{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("failed to encode event payload: %v", err)
+	}
+
+	return s.repository.ReplaceDeliveryPayloads(event_type_enum.UserRegistered, userID, string(payload))
+}
@@ -0,0 +1,39 @@
+package service_test
+
+import (
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_ExportUserData(t *testing.T) {
+	test := initializeService(t, http.DefaultClient)
+
+	data, err := test.service.ExportUserData("user123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+}
+
+func TestService_EraseUserData(t *testing.T) {
+	t.Run("Erase User Data Successfully", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReplaceDeliveryPayloads", event_type_enum.UserRegistered, "user123", `{"user_id":"user123","name":"","email":""}`).Return(nil)
+
+		err := test.service.EraseUserData("user123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Erase User Data Repository Error", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReplaceDeliveryPayloads", event_type_enum.UserRegistered, "user123", `{"user_id":"user123","name":"","email":""}`).Return(errors.New("Repository Failure"))
+
+		err := test.service.EraseUserData("user123")
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
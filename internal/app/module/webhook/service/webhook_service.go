@@ -0,0 +1,206 @@
+package service
+
+import (
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	"CodeWithAzri/internal/app/module/webhook/repository"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	webhook_delivery_status_enum "CodeWithAzri/pkg/enums/webhookDeliveryStatus"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrWebhookNotFound  = errors.New("webhook not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidEventType = errors.New("invalid event type")
+)
+
+type WebhookService interface {
+	CreateWebhook(input dto.CreateWebhookDTO) (dto.WebhookDTO, error)
+	GetWebhooks(limit int, page int) ([]dto.WebhookDTO, error)
+	GetWebhook(id uuid.UUID) (dto.WebhookDTO, error)
+	UpdateWebhook(id uuid.UUID, input dto.UpdateWebhookDTO) (dto.WebhookDTO, error)
+	DeleteWebhook(id uuid.UUID) error
+	GetDeliveries(id uuid.UUID, status webhook_delivery_status_enum.WebhookDeliveryStatus, limit int, page int) ([]dto.WebhookDeliveryDTO, error)
+	Redeliver(id uuid.UUID, deliveryID uuid.UUID) (dto.WebhookDeliveryDTO, error)
+	ExportUserData(userID string) (map[string]any, error)
+	EraseUserData(userID string) error
+}
+
+type Service struct {
+	repository repository.WebhookRepository
+	jobs       jobService.JobService
+	client     *http.Client
+}
+
+// NewWebhookService subscribes the webhooks to every event. Events are
+// fanned out to one delivery per matching webhook, and every delivery is
+// sent by its own job, so a failing partner is retried with backoff without
+// holding up the others.
+func NewWebhookService(r repository.WebhookRepository, jobs jobService.JobService, events eventService.EventService, client *http.Client) WebhookService {
+	s := new(Service)
+	s.repository = r
+	s.jobs = jobs
+	s.client = client
+	jobs.Register(DeliverJobType, jobService.Handle(s.deliver))
+	events.Subscribe(eventSubscriberName, s.fanOut)
+	return s
+}
+
+func (s *Service) CreateWebhook(input dto.CreateWebhookDTO) (dto.WebhookDTO, error) {
+	eventTypes, err := validEventTypes(input.EventTypes)
+	if err != nil {
+		return dto.WebhookDTO{}, err
+	}
+
+	secret := input.Secret
+	if secret == "" {
+		secret, err = generateSecret()
+		if err != nil {
+			return dto.WebhookDTO{}, err
+		}
+	}
+
+	now := timepkg.NowUnixMilli()
+	subscription := entity.WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        input.URL,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Active:     true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	err = s.repository.Create(subscription)
+	if err != nil {
+		return dto.WebhookDTO{}, err
+	}
+
+	// The secret is only shown once, to the admin who created the webhook.
+	webhook := toDTO(subscription)
+	webhook.Secret = subscription.Secret
+	return webhook, nil
+}
+
+func (s *Service) GetWebhooks(limit int, page int) ([]dto.WebhookDTO, error) {
+	offset := (page - 1) * limit
+
+	subscriptions, err := s.repository.ReadMany(limit, offset)
+	if err != nil {
+		return []dto.WebhookDTO{}, err
+	}
+
+	webhooks := make([]dto.WebhookDTO, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		webhooks = append(webhooks, toDTO(subscription))
+	}
+
+	return webhooks, nil
+}
+
+func (s *Service) GetWebhook(id uuid.UUID) (dto.WebhookDTO, error) {
+	subscription, err := s.readSubscription(id)
+	if err != nil {
+		return dto.WebhookDTO{}, err
+	}
+
+	return toDTO(subscription), nil
+}
+
+// UpdateWebhook changes where and which events a webhook receives. The
+// secret stays the same.
+func (s *Service) UpdateWebhook(id uuid.UUID, input dto.UpdateWebhookDTO) (dto.WebhookDTO, error) {
+	eventTypes, err := validEventTypes(input.EventTypes)
+	if err != nil {
+		return dto.WebhookDTO{}, err
+	}
+
+	subscription, err := s.readSubscription(id)
+	if err != nil {
+		return dto.WebhookDTO{}, err
+	}
+
+	subscription.URL = input.URL
+	subscription.EventTypes = eventTypes
+	subscription.Active = input.Active
+	subscription.UpdatedAt = timepkg.NowUnixMilli()
+
+	err = s.repository.Update(subscription)
+	if err != nil {
+		return dto.WebhookDTO{}, err
+	}
+
+	return toDTO(subscription), nil
+}
+
+func (s *Service) DeleteWebhook(id uuid.UUID) error {
+	_, err := s.readSubscription(id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.Delete(id)
+}
+
+func (s *Service) readSubscription(id uuid.UUID) (entity.WebhookSubscription, error) {
+	subscription, err := s.repository.ReadOne(id)
+	if err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	if subscription.ID == uuid.Nil {
+		return entity.WebhookSubscription{}, ErrWebhookNotFound
+	}
+
+	return subscription, nil
+}
+
+// validEventTypes drops duplicates, so the stored list stays tidy.
+func validEventTypes(eventTypes []event_type_enum.EventType) (pq.StringArray, error) {
+	valid := make(pq.StringArray, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !eventType.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidEventType, eventType)
+		}
+		if !slices.Contains(valid, string(eventType)) {
+			valid = append(valid, string(eventType))
+		}
+	}
+	return valid, nil
+}
+
+func generateSecret() (string, error) {
+	key := make([]byte, 24)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %v", err)
+	}
+	return "whsec_" + hex.EncodeToString(key), nil
+}
+
+func toDTO(subscription entity.WebhookSubscription) dto.WebhookDTO {
+	eventTypes := make([]event_type_enum.EventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, event_type_enum.EventType(eventType))
+	}
+
+	return dto.WebhookDTO{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	eventService "CodeWithAzri/internal/app/module/event/service"
+	eventMocks "CodeWithAzri/internal/app/module/event/service/mocks"
+	jobService "CodeWithAzri/internal/app/module/job/service"
+	jobMocks "CodeWithAzri/internal/app/module/job/service/mocks"
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	"CodeWithAzri/internal/app/module/webhook/repository/mocks"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+)
+
+var MockSubscription entity.WebhookSubscription = entity.WebhookSubscription{
+	ID:         uuid.MustParse("7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e41"),
+	URL:        "https://partner.example.com/hooks",
+	EventTypes: pq.StringArray{"course.enrolled", "lesson.completed"},
+	Secret:     "whsec_0123456789abcdef",
+	Active:     true,
+	CreatedAt:  121212,
+	UpdatedAt:  121212,
+}
+
+var MockDelivery entity.WebhookDelivery = entity.WebhookDelivery{
+	ID:             uuid.MustParse("7c1e2d3f-4a5b-4c6d-8e7f-9a0b1c2d3e51"),
+	SubscriptionID: MockSubscription.ID,
+	EventID:        uuid.MustParse("5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61"),
+	EventType:      "course.enrolled",
+	Payload:        `{"id":"5d7a3c1e-8b2f-4e6a-9c0d-1f2e3a4b5c61","type":"course.enrolled"}`,
+	Status:         "pending",
+	CreatedAt:      121212,
+	UpdatedAt:      121212,
+}
+
+// webhookTest holds what the service hands to the job queue and the event
+// service, so tests can run deliveries like the workers would.
+type webhookTest struct {
+	repository *mocks.WebhookRepository
+	jobs       *jobMocks.JobService
+	service    service.WebhookService
+	deliver    jobService.Handler
+	fanOut     eventService.Subscriber
+}
+
+func initializeService(t *testing.T, client *http.Client) webhookTest {
+	test := webhookTest{
+		repository: mocks.NewWebhookRepository(t),
+		jobs:       jobMocks.NewJobService(t),
+	}
+	events := eventMocks.NewEventService(t)
+
+	test.jobs.On("Register", service.DeliverJobType, mock.AnythingOfType("service.Handler")).
+		Run(func(args mock.Arguments) {
+			test.deliver = args.Get(1).(jobService.Handler)
+		}).Once()
+	events.On("Subscribe", "webhooks", mock.AnythingOfType("service.Subscriber")).
+		Run(func(args mock.Arguments) {
+			test.fanOut = args.Get(1).(eventService.Subscriber)
+		}).Once()
+
+	test.service = service.NewWebhookService(test.repository, test.jobs, events, client)
+	return test
+}
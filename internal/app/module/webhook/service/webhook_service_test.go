@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"CodeWithAzri/internal/app/module/webhook/dto"
+	"CodeWithAzri/internal/app/module/webhook/entity"
+	"CodeWithAzri/internal/app/module/webhook/service"
+	event_type_enum "CodeWithAzri/pkg/enums/eventType"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_CreateWebhook(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 121212 })
+	defer monkey.UnpatchAll()
+
+	t.Run("Create Webhook With Generated Secret", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("Create", mock.MatchedBy(func(s entity.WebhookSubscription) bool {
+			return s.URL == MockSubscription.URL && strings.HasPrefix(s.Secret, "whsec_") && len(s.Secret) == 54 &&
+				s.Active && s.CreatedAt == 121212 && assert.ObjectsAreEqual(pq.StringArray{"course.enrolled"}, s.EventTypes)
+		})).Return(nil)
+
+		webhook, err := test.service.CreateWebhook(dto.CreateWebhookDTO{
+			URL:        MockSubscription.URL,
+			EventTypes: []event_type_enum.EventType{event_type_enum.CourseEnrolled, event_type_enum.CourseEnrolled},
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(webhook.Secret, "whsec_"))
+		assert.Equal(t, []event_type_enum.EventType{event_type_enum.CourseEnrolled}, webhook.EventTypes)
+		assert.True(t, webhook.Active)
+	})
+
+	t.Run("Create Webhook With Own Secret", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("Create", mock.MatchedBy(func(s entity.WebhookSubscription) bool {
+			return s.Secret == MockSubscription.Secret && len(s.EventTypes) == 0
+		})).Return(nil)
+
+		webhook, err := test.service.CreateWebhook(dto.CreateWebhookDTO{URL: MockSubscription.URL, Secret: MockSubscription.Secret})
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockSubscription.Secret, webhook.Secret)
+		assert.Empty(t, webhook.EventTypes)
+	})
+
+	t.Run("Create Webhook Invalid Event Type", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+
+		_, err := test.service.CreateWebhook(dto.CreateWebhookDTO{
+			URL:        MockSubscription.URL,
+			EventTypes: []event_type_enum.EventType{"course.deleted"},
+		})
+
+		assert.ErrorIs(t, err, service.ErrInvalidEventType)
+	})
+
+	t.Run("Create Webhook Repository Error", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("Create", mock.AnythingOfType("entity.WebhookSubscription")).Return(errors.New("Repository Failure"))
+
+		_, err := test.service.CreateWebhook(dto.CreateWebhookDTO{URL: MockSubscription.URL})
+
+		assert.EqualError(t, err, "Repository Failure")
+	})
+}
+
+func TestService_GetWebhooks(t *testing.T) {
+	test := initializeService(t, http.DefaultClient)
+
+	t.Run("Get Webhooks Success", func(t *testing.T) {
+		test.repository.On("ReadMany", 10, 10).Return([]entity.WebhookSubscription{MockSubscription}, nil).Once()
+
+		webhooks, err := test.service.GetWebhooks(10, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, webhooks, 1)
+		assert.Equal(t, MockSubscription.ID, webhooks[0].ID)
+		assert.Empty(t, webhooks[0].Secret)
+		assert.Equal(t, []event_type_enum.EventType{event_type_enum.CourseEnrolled, event_type_enum.LessonCompleted}, webhooks[0].EventTypes)
+	})
+
+	t.Run("Get Webhooks Error", func(t *testing.T) {
+		test.repository.On("ReadMany", 10, 0).Return(nil, errors.New("Repository Failure")).Once()
+
+		webhooks, err := test.service.GetWebhooks(10, 1)
+
+		assert.EqualError(t, err, "Repository Failure")
+		assert.Empty(t, webhooks)
+	})
+}
+
+func TestService_GetWebhook(t *testing.T) {
+	test := initializeService(t, http.DefaultClient)
+
+	t.Run("Get Webhook Success", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil).Once()
+
+		webhook, err := test.service.GetWebhook(MockSubscription.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, MockSubscription.URL, webhook.URL)
+		assert.Empty(t, webhook.Secret)
+	})
+
+	t.Run("Get Webhook Not Found", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(entity.WebhookSubscription{}, nil).Once()
+
+		_, err := test.service.GetWebhook(MockSubscription.ID)
+
+		assert.ErrorIs(t, err, service.ErrWebhookNotFound)
+	})
+}
+
+func TestService_UpdateWebhook(t *testing.T) {
+	monkey.Patch(timepkg.NowUnixMilli, func() int64 { return 131313 })
+	defer monkey.UnpatchAll()
+
+	input := dto.UpdateWebhookDTO{
+		URL:        "https://partner.example.com/v2/hooks",
+		EventTypes: []event_type_enum.EventType{event_type_enum.LessonCompleted},
+		Active:     false,
+	}
+
+	t.Run("Update Webhook Success", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		updated := MockSubscription
+		updated.URL = input.URL
+		updated.EventTypes = pq.StringArray{"lesson.completed"}
+		updated.Active = false
+		updated.UpdatedAt = 131313
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil)
+		test.repository.On("Update", updated).Return(nil)
+
+		webhook, err := test.service.UpdateWebhook(MockSubscription.ID, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, input.URL, webhook.URL)
+		assert.False(t, webhook.Active)
+		assert.Empty(t, webhook.Secret)
+	})
+
+	t.Run("Update Webhook Not Found", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+		test.repository.On("ReadOne", MockSubscription.ID).Return(entity.WebhookSubscription{}, nil)
+
+		_, err := test.service.UpdateWebhook(MockSubscription.ID, input)
+
+		assert.ErrorIs(t, err, service.ErrWebhookNotFound)
+	})
+
+	t.Run("Update Webhook Invalid Event Type", func(t *testing.T) {
+		test := initializeService(t, http.DefaultClient)
+
+		_, err := test.service.UpdateWebhook(MockSubscription.ID, dto.UpdateWebhookDTO{
+			URL:        input.URL,
+			EventTypes: []event_type_enum.EventType{"course.deleted"},
+		})
+
+		assert.ErrorIs(t, err, service.ErrInvalidEventType)
+	})
+}
+
+func TestService_DeleteWebhook(t *testing.T) {
+	test := initializeService(t, http.DefaultClient)
+
+	t.Run("Delete Webhook Success", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(MockSubscription, nil).Once()
+		test.repository.On("Delete", MockSubscription.ID).Return(nil).Once()
+
+		assert.NoError(t, test.service.DeleteWebhook(MockSubscription.ID))
+	})
+
+	t.Run("Delete Webhook Not Found", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(entity.WebhookSubscription{}, nil).Once()
+
+		assert.ErrorIs(t, test.service.DeleteWebhook(MockSubscription.ID), service.ErrWebhookNotFound)
+	})
+
+	t.Run("Delete Webhook Read Error", func(t *testing.T) {
+		test.repository.On("ReadOne", MockSubscription.ID).Return(entity.WebhookSubscription{}, errors.New("Repository Failure")).Once()
+
+		assert.EqualError(t, test.service.DeleteWebhook(MockSubscription.ID), "Repository Failure")
+	})
+}
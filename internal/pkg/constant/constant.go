@@ -5,3 +5,42 @@ const RootPattern = "/"
 const V1 = "/v1"
 const UsersPattern = "/users"
 const CoursesPattern = "/courses"
+const StatusPattern = "/status"
+const RevisionsPattern = "/revisions"
+const DiffPattern = "/diff"
+const RollbackPattern = "/rollback"
+const InstructorsPattern = "/instructors"
+const MediaPattern = "/media"
+const UploadsPattern = "/uploads"
+const FilesPattern = "/files"
+const StreamsPattern = "/streams"
+const EnrollmentsPattern = "/enrollments"
+const LessonsPattern = "/lessons"
+const StreamPattern = "/stream"
+const CompletePattern = "/complete"
+const AdminPattern = "/admin"
+const JobsPattern = "/jobs"
+const RetryPattern = "/retry"
+const WebhooksPattern = "/webhooks"
+const DeliveriesPattern = "/deliveries"
+const RedeliverPattern = "/redeliver"
+const ProgressPattern = "/progress"
+const NotificationsPattern = "/notifications"
+const PreferencesPattern = "/preferences"
+const UnreadCountPattern = "/unread-count"
+const ReadPattern = "/read"
+const ReadAllPattern = "/read-all"
+const RealtimePattern = "/realtime"
+const CommentsPattern = "/comments"
+const UpvotePattern = "/upvote"
+const ReportsPattern = "/reports"
+const ModerationPattern = "/moderation"
+const CasesPattern = "/cases"
+const ActionsPattern = "/actions"
+const AuditLogsPattern = "/audit-logs"
+const ExportPattern = "/export"
+const ErasurePattern = "/erasure"
+const ServiceAccountsPattern = "/service-accounts"
+const KeysPattern = "/keys"
+const TagsPattern = "/tags"
+const MergePattern = "/merge"
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"CodeWithAzri/pkg/response"
+	"net/http"
+)
+
+// AdminMiddleware only lets users with the "admin" custom claim through. It
+// has to run after AuthMiddleware.
+func AdminMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		admin, _ := r.Context().Value(AdminContextKey).(bool)
+		if !admin {
+			response.RespondErrorMessage(
+				http.StatusForbidden,
+				"Admin access is required",
+				w,
+			)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/response"
+)
+
+// APIKeyHeader carries the key of a service account.
+const APIKeyHeader = "X-API-Key"
+
+// ServiceAccountUserIDPrefix marks the UserID of requests made with an API
+// key, so they can be told apart from users in logs and the audit trail.
+const ServiceAccountUserIDPrefix = "service-account:"
+
+// ServiceAccount is the caller behind a valid API key.
+type ServiceAccount struct {
+	ID     string
+	Scopes []string
+}
+
+// APIKeyAuthenticator resolves API keys to the service accounts they belong
+// to.
+type APIKeyAuthenticator interface {
+	// Authenticate reports false for unknown, expired and revoked keys and
+	// for keys of inactive accounts.
+	Authenticate(key string) (ServiceAccount, bool, error)
+}
+
+// ScopedAuthMiddleware accepts an API key in place of an ID token for
+// service accounts holding scope. Admin scopes also stand in for the admin
+// claim. Requests without a key go through AuthMiddleware.
+func (fa *FirebaseMiddleware) ScopedAuthMiddleware(scope api_scope_enum.APIScope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		auth := fa.AuthMiddleware(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(APIKeyHeader)
+			if key == "" || fa.APIKeys == nil {
+				auth.ServeHTTP(w, r)
+				return
+			}
+
+			account, ok, err := fa.APIKeys.Authenticate(key)
+			if err != nil {
+				log.Printf("failed to authenticate api key: %v", err)
+				response.RespondErrorMessage(http.StatusInternalServerError, "Failed to authenticate API key", w)
+				return
+			}
+
+			if !ok {
+				response.RespondErrorMessage(http.StatusUnauthorized, "API Key Invalid", w)
+				return
+			}
+
+			if !slices.Contains(account.Scopes, string(scope)) {
+				response.RespondErrorMessage(http.StatusForbidden, "API key is missing the "+string(scope)+" scope", w)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), UserIDContextKey, ServiceAccountUserIDPrefix+account.ID)
+			ctx = context.WithValue(ctx, AdminContextKey, scope.IsAdmin())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"CodeWithAzri/internal/pkg/constant"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+)
+
+// maxAuditBody bounds how much of a response body is kept to find the ID of
+// a created entity.
+const maxAuditBody = 64 << 10
+
+// AuditEntry describes a data-changing request that succeeded.
+type AuditEntry struct {
+	ActorID    string
+	Action     string
+	EntityType string
+	EntityID   string
+	// Before is the entity as it was before the request, or nil.
+	Before any
+}
+
+// AuditRecorder writes the audit log. Snapshot returns the current state of
+// an entity, or nil when its type is not tracked or it does not exist.
+type AuditRecorder interface {
+	Snapshot(entityType string, entityID string) any
+	Record(entry AuditEntry)
+}
+
+// AuditMiddleware records every successful POST, PUT, PATCH and DELETE in the
+// audit log. It has to run after AuthMiddleware, which sets the actor.
+//
+// The target entity comes from the route: it is the resource named right
+// before the first path parameter, so PATCH /courses/{id}/status targets the
+// course {id}. Requests that create an entity take its ID from the "id" of
+// the response data.
+func AuditMiddleware(recorder AuditRecorder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isDataChanging(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			pattern, entityType, entityID := auditTarget(r)
+			if pattern == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var before any
+			if entityID != "" {
+				before = recorder.Snapshot(entityType, entityID)
+			}
+
+			aw := &auditResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(aw, r)
+
+			if aw.status >= http.StatusBadRequest {
+				return
+			}
+
+			if entityID == "" {
+				entityID = createdID(aw.body.Bytes())
+			}
+
+			actorID, _ := r.Context().Value(UserIDContextKey).(string)
+			recorder.Record(AuditEntry{
+				ActorID:    actorID,
+				Action:     r.Method + " " + pattern,
+				EntityType: entityType,
+				EntityID:   entityID,
+				Before:     before,
+			})
+		})
+	}
+}
+
+func isDataChanging(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// auditTarget matches r against the routes to find its pattern, and the type
+// and ID of the entity it changes.
+func auditTarget(r *http.Request) (string, string, string) {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return "", "", ""
+	}
+
+	tctx := chi.NewRouteContext()
+	if !rctx.Routes.Match(tctx, r.Method, r.URL.Path) {
+		return "", "", ""
+	}
+	pattern := tctx.RoutePattern()
+
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+	if len(segments) >= 2 && "/"+segments[0] == constant.ApiPattern {
+		segments = segments[2:]
+	}
+	if len(segments) > 0 && "/"+segments[0] == constant.AdminPattern {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 {
+		return pattern, "", ""
+	}
+
+	for i, segment := range segments {
+		if i > 0 && strings.HasPrefix(segment, "{") {
+			return pattern, segments[i-1], tctx.URLParam(strings.Trim(segment, "{}"))
+		}
+	}
+
+	return pattern, segments[0], ""
+}
+
+// createdID is the "id" of the data of a JSON response, if there is one.
+func createdID(body []byte) string {
+	var res struct {
+		Data struct {
+			ID any `json:"id"`
+		} `json:"data"`
+	}
+	if json.Unmarshal(body, &res) != nil {
+		return ""
+	}
+
+	id, _ := res.Data.ID.(string)
+	return id
+}
+
+// auditResponseWriter keeps the status and the start of the body of a
+// response.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if room := maxAuditBody - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(len(b), room)])
+	}
+	return w.ResponseWriter.Write(b)
+}
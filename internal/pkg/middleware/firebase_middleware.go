@@ -15,11 +15,29 @@ type UserIDKey string
 
 const (
 	UserIDContextKey UserIDKey = "UserID"
+	// AdminContextKey is true for users whose token carries the "admin"
+	// custom claim.
+	AdminContextKey UserIDKey = "Admin"
+	// ClaimsContextKey holds the TokenClaims of the verified token.
+	ClaimsContextKey UserIDKey = "Claims"
 )
 
+// TokenClaims is who a verified ID token says the user is. Unlike anything
+// in the request body, it can be trusted.
+type TokenClaims struct {
+	UID           string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
 // FirebaseMiddleware represents Firebase middleware.
 type FirebaseMiddleware struct {
 	FirebaseApp *firebase.App
+	// APIKeys checks the keys of service accounts. Without it only ID
+	// tokens are accepted.
+	APIKeys APIKeyAuthenticator
 }
 
 // NewFirebaseMiddleware creates a new FirebaseMiddleware instance.
@@ -63,10 +81,33 @@ func (fa *FirebaseMiddleware) AuthMiddleware(next http.Handler) http.Handler {
 		}
 
 		ctx := context.WithValue(r.Context(), UserIDContextKey, decoded.UID)
+		ctx = context.WithValue(ctx, AdminContextKey, decoded.Claims["admin"] == true)
+		ctx = context.WithValue(ctx, ClaimsContextKey, tokenClaims(decoded))
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// StreamAuthMiddleware is AuthMiddleware for streaming endpoints. EventSource
+// cannot set headers, so the ID token may also come as the access_token query
+// parameter. It is moved to the Authorization header and dropped from the URL
+// to keep it out of request logs.
+func (fa *FirebaseMiddleware) StreamAuthMiddleware(next http.Handler) http.Handler {
+	auth := fa.AuthMiddleware(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		token := query.Get("access_token")
+		if token != "" {
+			r = r.Clone(r.Context())
+			if r.Header.Get("Authorization") == "" {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+			query.Del("access_token")
+			r.URL.RawQuery = query.Encode()
+		}
+		auth.ServeHTTP(w, r)
+	})
+}
+
 // InitializeFirebaseAuthClient initializes the Firebase Auth client.
 func initializeFirebaseAuthClient(app *firebase.App) (*firebaseAuth.Client, error) {
 	client, err := app.Auth(context.Background())
@@ -94,15 +135,26 @@ func handleAuthorizationHeaderMissingError(w http.ResponseWriter) {
 	)
 }
 
-// VerifyIDToken verifies the ID token using Firebase Auth client.
+// VerifyIDToken verifies the ID token using Firebase Auth client. Tokens of
+// revoked or deleted accounts are rejected even before they expire.
 func verifyIDToken(client *firebaseAuth.Client, idToken string) (*firebaseAuth.Token, error) {
-	decoded, err := client.VerifyIDToken(context.Background(), idToken)
+	decoded, err := client.VerifyIDTokenAndCheckRevoked(context.Background(), idToken)
 	if err != nil {
 		return nil, err
 	}
 	return decoded, nil
 }
 
+// tokenClaims reads the standard claims of a verified token.
+func tokenClaims(decoded *firebaseAuth.Token) TokenClaims {
+	claims := TokenClaims{UID: decoded.UID}
+	claims.Email, _ = decoded.Claims["email"].(string)
+	claims.EmailVerified, _ = decoded.Claims["email_verified"].(bool)
+	claims.Name, _ = decoded.Claims["name"].(string)
+	claims.Picture, _ = decoded.Claims["picture"].(string)
+	return claims
+}
+
 // HandleInvalidTokenError handles invalid token errors.
 func handleInvalidTokenError(w http.ResponseWriter) {
 	response.RespondErrorMessage(
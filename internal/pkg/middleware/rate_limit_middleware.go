@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"CodeWithAzri/pkg/ratelimit"
+	"CodeWithAzri/pkg/response"
+)
+
+// RateLimitMiddleware returns a factory of per route group limiters sharing
+// store. A limiter gives every caller its own budget in the group: users
+// by UID, service accounts by account whichever key they use, and anyone
+// else by the IP proxies tells apart. It has to run after authentication to
+// tell callers apart, so IPRateLimitMiddleware guards the authentication
+// itself.
+//
+// Responses carry the RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset
+// and RateLimit-Policy headers, and refused requests get 429 with
+// Retry-After. When the store fails, requests are let through.
+func RateLimitMiddleware(store ratelimit.Store, proxies TrustedProxies) func(group string, limit ratelimit.Limit) func(http.Handler) http.Handler {
+	return func(group string, limit ratelimit.Limit) func(http.Handler) http.Handler {
+		return rateLimit(store, group, limit, proxies.rateLimitKey)
+	}
+}
+
+// IPRateLimitMiddleware gives every IP address one budget across all the
+// groups it guards. It runs before authentication, so guessing ID tokens or
+// API keys is throttled as well.
+func IPRateLimitMiddleware(store ratelimit.Store, proxies TrustedProxies, limit ratelimit.Limit) func(http.Handler) http.Handler {
+	return rateLimit(store, "auth", limit, proxies.ipKey)
+}
+
+// TrustedProxies lists the reverse proxies in front of the API. Only their
+// X-Forwarded-For and X-Real-IP headers are believed, since anyone else
+// could make them up to get a fresh budget with every request.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies reads a comma separated list of IP addresses and CIDR
+// ranges, as given in TRUSTED_PROXIES. An empty list trusts no one.
+func ParseTrustedProxies(list string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+			}
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+		}
+		proxies = append(proxies, network)
+	}
+
+	return proxies, nil
+}
+
+// ClientIP returns the address of the client that sent r. Requests coming
+// from a trusted proxy are traced back through X-Forwarded-For to the
+// rightmost hop that is not a trusted proxy itself, or else to X-Real-IP.
+// Hops further left were added before any trusted proxy saw the request,
+// so they are never believed.
+func (p TrustedProxies) ClientIP(r *http.Request) string {
+	client, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		client = r.RemoteAddr
+	}
+	if !p.trusts(client) {
+		return client
+	}
+
+	forwarded := r.Header.Values("X-Forwarded-For")
+	if len(forwarded) == 0 {
+		if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+			return realIP
+		}
+		return client
+	}
+
+	hops := strings.Split(strings.Join(forwarded, ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		client = hop
+		if !p.trusts(hop) {
+			break
+		}
+	}
+
+	return client
+}
+
+func (p TrustedProxies) trusts(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func rateLimit(store ratelimit.Store, group string, limit ratelimit.Limit, key func(r *http.Request) string) func(http.Handler) http.Handler {
+	policy := strconv.Itoa(limit.Requests) + ";w=" + strconv.Itoa(seconds(limit.Window))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result, err := store.Take(r.Context(), group+":"+key(r), limit)
+			if err != nil {
+				log.Printf("failed to rate limit %s: %v", group, err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := w.Header()
+			header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+			header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			header.Set("RateLimit-Reset", strconv.Itoa(seconds(result.Reset)))
+			header.Set("RateLimit-Policy", policy)
+
+			if !result.Allowed {
+				header.Set("Retry-After", strconv.Itoa(seconds(result.RetryAfter)))
+				response.RespondErrorMessage(http.StatusTooManyRequests, "Too many requests", w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitKey names the caller of r. Service accounts already carry their
+// account in the UserID.
+func (p TrustedProxies) rateLimitKey(r *http.Request) string {
+	if userID, _ := r.Context().Value(UserIDContextKey).(string); userID != "" {
+		return "user:" + userID
+	}
+
+	return p.ipKey(r)
+}
+
+func (p TrustedProxies) ipKey(r *http.Request) string {
+	return "ip:" + p.ClientIP(r)
+}
+
+// seconds rounds d up, so clients never retry too early.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,110 @@
+package middleware_test
+
+import (
+	"CodeWithAzri/internal/pkg/middleware"
+	"CodeWithAzri/pkg/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	t.Run("Parse Addresses And Ranges", func(t *testing.T) {
+		proxies, err := middleware.ParseTrustedProxies(" 10.0.0.1, 192.168.0.0/16,,2001:db8::1 ")
+
+		assert.NoError(t, err)
+		assert.Len(t, proxies, 3)
+	})
+
+	t.Run("Parse Empty List", func(t *testing.T) {
+		proxies, err := middleware.ParseTrustedProxies("")
+
+		assert.NoError(t, err)
+		assert.Empty(t, proxies)
+	})
+
+	t.Run("Parse Invalid Entry", func(t *testing.T) {
+		_, err := middleware.ParseTrustedProxies("10.0.0.1,proxy.internal")
+
+		assert.EqualError(t, err, `invalid trusted proxy "proxy.internal"`)
+	})
+}
+
+func TestTrustedProxies_ClientIP(t *testing.T) {
+	proxies, err := middleware.ParseTrustedProxies("10.0.0.0/8,2001:db8::1")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		realIP       string
+		expectedIP   string
+	}{
+		{"Direct Client", "203.0.113.7:4321", nil, "", "203.0.113.7"},
+		{"Untrusted Client Spoofing Forwarded For", "203.0.113.7:4321", []string{"198.51.100.1"}, "", "203.0.113.7"},
+		{"Untrusted Client Spoofing Real IP", "203.0.113.7:4321", nil, "198.51.100.1", "203.0.113.7"},
+		{"Trusted Proxy", "10.0.0.2:80", []string{"198.51.100.1"}, "", "198.51.100.1"},
+		{"Trusted Proxy Chain", "10.0.0.2:80", []string{"198.51.100.1, 10.0.0.3"}, "", "198.51.100.1"},
+		{"Trusted Proxy Ignores Spoofed Left Hops", "10.0.0.2:80", []string{"192.0.2.9, 198.51.100.1"}, "", "198.51.100.1"},
+		{"Trusted Proxy Repeated Headers", "10.0.0.2:80", []string{"192.0.2.9", "198.51.100.1"}, "", "198.51.100.1"},
+		{"Trusted Proxy Invalid Hop", "10.0.0.2:80", []string{"unknown"}, "", "10.0.0.2"},
+		{"Trusted Proxy Real IP", "10.0.0.2:80", nil, "198.51.100.1", "198.51.100.1"},
+		{"Trusted Proxy Without Headers", "10.0.0.2:80", nil, "", "10.0.0.2"},
+		{"Trusted IPv6 Proxy", "[2001:db8::1]:443", []string{"2001:db8::42"}, "", "2001:db8::42"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/courses", nil)
+			r.RemoteAddr = test.remoteAddr
+			for _, value := range test.forwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if test.realIP != "" {
+				r.Header.Set("X-Real-IP", test.realIP)
+			}
+
+			assert.Equal(t, test.expectedIP, proxies.ClientIP(r))
+		})
+	}
+}
+
+func TestIPRateLimitMiddleware(t *testing.T) {
+	proxies, err := middleware.ParseTrustedProxies("10.0.0.2")
+	assert.NoError(t, err)
+
+	serve := func(handler http.Handler, remoteAddr string, forwardedFor string) int {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/courses", nil)
+		r.RemoteAddr = remoteAddr
+		r.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	newHandler := func() http.Handler {
+		limit := ratelimit.Limit{Requests: 1, Window: time.Minute}
+		return middleware.IPRateLimitMiddleware(ratelimit.NewMemoryStore(), proxies, limit)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	}
+
+	t.Run("Untrusted Client Cannot Rotate Forwarded For", func(t *testing.T) {
+		handler := newHandler()
+
+		assert.Equal(t, http.StatusOK, serve(handler, "203.0.113.7:4321", "198.51.100.1"))
+		assert.Equal(t, http.StatusTooManyRequests, serve(handler, "203.0.113.7:4321", "198.51.100.2"))
+	})
+
+	t.Run("Clients Behind Trusted Proxy Get Their Own Budget", func(t *testing.T) {
+		handler := newHandler()
+
+		assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.2:80", "198.51.100.1"))
+		assert.Equal(t, http.StatusOK, serve(handler, "10.0.0.2:80", "198.51.100.2"))
+		assert.Equal(t, http.StatusTooManyRequests, serve(handler, "10.0.0.2:80", "198.51.100.1"))
+	})
+}
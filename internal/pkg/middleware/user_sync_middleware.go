@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"CodeWithAzri/pkg/cache"
+	"CodeWithAzri/pkg/response"
+)
+
+// userSyncTTL bounds how long a user whose account was erased on another
+// instance keeps access.
+const userSyncTTL = 5 * time.Minute
+
+// UserSyncer keeps the users table in line with the verified token claims.
+type UserSyncer interface {
+	// SyncUser creates the user behind claims or updates their identity,
+	// and reports false for users who may no longer use the API.
+	SyncUser(claims TokenClaims) (bool, error)
+}
+
+// SyncedUserKey is the cache key remembering that the claims of uid were
+// synced. Deleting it makes the next request of the user sync again.
+func SyncedUserKey(uid string) string {
+	return "users:synced:" + uid
+}
+
+// UserSyncMiddleware provisions the user behind a token when synced has not
+// seen the token's claims recently, so every authenticated request can rely
+// on the users row. It has to run after AuthMiddleware.
+func UserSyncMiddleware(syncer UserSyncer, synced cache.Cache) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := r.Context().Value(ClaimsContextKey).(TokenClaims)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := context.Background()
+			key := SyncedUserKey(claims.UID)
+			data, err := json.Marshal(claims)
+			if err != nil {
+				response.RespondErrorMessage(http.StatusInternalServerError, "Failed to sync user", w)
+				return
+			}
+
+			seen, ok, err := synced.Get(ctx, key)
+			if err != nil {
+				log.Printf("failed to read synced user %s: %v", claims.UID, err)
+			}
+			if ok && string(seen) == string(data) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, err := syncer.SyncUser(claims)
+			if err != nil {
+				log.Printf("failed to sync user %s: %v", claims.UID, err)
+				response.RespondErrorMessage(http.StatusInternalServerError, "Failed to sync user", w)
+				return
+			}
+
+			if !allowed {
+				response.RespondErrorMessage(http.StatusForbidden, "Account is no longer available", w)
+				return
+			}
+
+			err = synced.Set(ctx, key, data, userSyncTTL)
+			if err != nil {
+				log.Printf("failed to remember synced user %s: %v", claims.UID, err)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/audit"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterAuditRoutes(router *Router, version string, module *audit.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.AdminAudit))
+			r.Use(router.RateLimitMiddleware("admin-audit", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.AuditLogsPattern,
+				func(r chi.Router) {
+					r.Get(constant.RootPattern, module.Handler.GetAuditLogs)
+				},
+			)
+		},
+	)
+}
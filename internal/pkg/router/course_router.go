@@ -4,6 +4,9 @@ import (
 	"CodeWithAzri/internal/app/module/course"
 	"CodeWithAzri/internal/pkg/constant"
 	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
 
 	"github.com/go-chi/chi"
 )
@@ -11,13 +14,53 @@ import (
 func RegisterCourseRoutes(router *Router, version string, module *course.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
 	router.Mux.Group(
 		func(r chi.Router) {
-			r.Use(firebaseMiddleware.AuthMiddleware)
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.Courses))
+			r.Use(router.RateLimitMiddleware("courses", ratelimit.Limit{Requests: 300, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
 			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
 			r.Route(
 				constant.ApiPattern+version+constant.CoursesPattern,
 				func(r chi.Router) {
+					r.Post(constant.RootPattern, module.Handler.CreateCourse)
 					r.Get(constant.RootPattern+"{id}", module.Handler.GetCourseDetail)
 					r.Get(constant.RootPattern, module.Handler.GetPaginatedCourses)
+					r.Put(constant.RootPattern+"{id}", module.Handler.UpdateCourse)
+					r.Patch(constant.RootPattern+"{id}"+constant.StatusPattern, module.Handler.ChangeCourseStatus)
+					r.Get(constant.RootPattern+"{id}"+constant.RevisionsPattern, module.Handler.GetCourseRevisions)
+					r.Get(constant.RootPattern+"{id}"+constant.RevisionsPattern+constant.DiffPattern, module.Handler.GetCourseRevisionDiff)
+					r.Get(constant.RootPattern+"{id}"+constant.RevisionsPattern+"/{revision}", module.Handler.GetCourseRevision)
+					r.Post(constant.RootPattern+"{id}"+constant.RevisionsPattern+"/{revision}"+constant.RollbackPattern, module.Handler.RollbackCourse)
+					r.Post(constant.RootPattern+"{id}"+constant.InstructorsPattern, module.Handler.AddCourseInstructor)
+					r.Delete(constant.RootPattern+"{id}"+constant.InstructorsPattern+"/{userId}", module.Handler.RemoveCourseInstructor)
+					r.Post(constant.RootPattern+"{id}"+constant.EnrollmentsPattern, module.Handler.EnrollCourse)
+					r.Get(constant.RootPattern+"{id}"+constant.LessonsPattern+"/{lessonId}"+constant.StreamPattern, module.Handler.GetLessonStream)
+					r.Post(constant.RootPattern+"{id}"+constant.LessonsPattern+"/{lessonId}"+constant.CompletePattern, module.Handler.CompleteLesson)
+					r.Get(constant.RootPattern+"{id}"+constant.ProgressPattern, module.Handler.GetCourseProgress)
+				},
+			)
+			r.Get(constant.ApiPattern+version+constant.UsersPattern+"/{id}"+constant.CoursesPattern, module.Handler.GetInstructorCourses)
+			r.Get(constant.ApiPattern+version+constant.TagsPattern, module.Handler.GetTags)
+			r.Get(constant.ApiPattern+version+constant.TagsPattern+"/{id}", module.Handler.GetTag)
+		},
+	)
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.AdminTags))
+			r.Use(router.RateLimitMiddleware("admin-tags", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.TagsPattern,
+				func(r chi.Router) {
+					r.Post(constant.RootPattern, module.Handler.CreateTag)
+					r.Put(constant.RootPattern+"{id}", module.Handler.UpdateTag)
+					r.Delete(constant.RootPattern+"{id}", module.Handler.DeleteTag)
+					r.Post(constant.RootPattern+"{id}"+constant.MergePattern, module.Handler.MergeTag)
 				},
 			)
 		},
@@ -0,0 +1,40 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/discussion"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterDiscussionRoutes(router *Router, version string, module *discussion.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.AuthMiddleware)
+			r.Use(router.RateLimitMiddleware("discussions", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.LessonsPattern,
+				func(r chi.Router) {
+					r.Get(constant.RootPattern+"{id}"+constant.CommentsPattern, module.Handler.GetLessonComments)
+					r.Post(constant.RootPattern+"{id}"+constant.CommentsPattern, module.Handler.CreateLessonComment)
+				},
+			)
+			r.Route(
+				constant.ApiPattern+version+constant.CommentsPattern,
+				func(r chi.Router) {
+					r.Put(constant.RootPattern+"{id}", module.Handler.UpdateComment)
+					r.Delete(constant.RootPattern+"{id}", module.Handler.DeleteComment)
+					r.Post(constant.RootPattern+"{id}"+constant.UpvotePattern, module.Handler.UpvoteComment)
+					r.Delete(constant.RootPattern+"{id}"+constant.UpvotePattern, module.Handler.RemoveCommentUpvote)
+				},
+			)
+		},
+	)
+}
@@ -0,0 +1,34 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/job"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterJobRoutes(router *Router, version string, module *job.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.AdminJobs))
+			r.Use(router.RateLimitMiddleware("admin-jobs", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.JobsPattern,
+				func(r chi.Router) {
+					r.Get(constant.RootPattern, module.Handler.GetJobs)
+					r.Get(constant.RootPattern+"{id}", module.Handler.GetJob)
+					r.Post(constant.RootPattern+"{id}"+constant.RetryPattern, module.Handler.RetryJob)
+				},
+			)
+		},
+	)
+}
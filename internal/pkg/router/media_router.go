@@ -0,0 +1,47 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/media"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterMediaRoutes(router *Router, version string, module *media.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.Media))
+			r.Use(router.RateLimitMiddleware("media", ratelimit.Limit{Requests: 60, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.MediaPattern,
+				func(r chi.Router) {
+					r.Post(constant.RootPattern, module.Handler.UploadMedia)
+					r.Post(constant.UploadsPattern, module.Handler.CreateUpload)
+					r.Head(constant.UploadsPattern+"/{id}", module.Handler.GetUploadOffset)
+					r.Patch(constant.UploadsPattern+"/{id}", module.Handler.AppendUpload)
+					r.Get(constant.RootPattern+"{id}", module.Handler.GetMedia)
+					r.Delete(constant.RootPattern+"{id}", module.Handler.DeleteMedia)
+				},
+			)
+		},
+	)
+
+	// Signed file and playlist URLs are opened directly by browsers and
+	// players and carry their own authorization in the query string.
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.RateLimitMiddleware("media-files", ratelimit.Limit{Requests: 600, Window: time.Minute}))
+			r.Use(middleware.LoggerMiddleware)
+			r.Get(constant.ApiPattern+version+constant.MediaPattern+constant.FilesPattern+"/*", module.Handler.ServeFile)
+			r.Get(constant.ApiPattern+version+constant.MediaPattern+constant.StreamsPattern+"/{id}/*", module.Handler.ServePlaylist)
+		},
+	)
+}
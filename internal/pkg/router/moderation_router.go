@@ -0,0 +1,51 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/moderation"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterModerationRoutes(router *Router, version string, module *moderation.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.AuthMiddleware)
+			r.Use(router.RateLimitMiddleware("reports", ratelimit.Limit{Requests: 20, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.ReportsPattern,
+				func(r chi.Router) {
+					r.Post(constant.RootPattern, module.Handler.CreateReport)
+				},
+			)
+		},
+	)
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.AdminModeration))
+			r.Use(router.RateLimitMiddleware("admin-moderation", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.ModerationPattern,
+				func(r chi.Router) {
+					r.Get(constant.CasesPattern, module.Handler.GetModerationQueue)
+					r.Get(constant.CasesPattern+"/{id}", module.Handler.GetModerationCase)
+					r.Post(constant.CasesPattern+"/{id}"+constant.ActionsPattern, module.Handler.TakeModerationAction)
+					r.Get(constant.ActionsPattern, module.Handler.GetModerationActions)
+				},
+			)
+		},
+	)
+}
@@ -0,0 +1,35 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/notification"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterNotificationRoutes(router *Router, version string, module *notification.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.AuthMiddleware)
+			r.Use(router.RateLimitMiddleware("notifications", ratelimit.Limit{Requests: 300, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.NotificationsPattern,
+				func(r chi.Router) {
+					r.Get(constant.RootPattern, module.Handler.GetNotifications)
+					r.Get(constant.UnreadCountPattern, module.Handler.CountUnreadNotifications)
+					r.Post(constant.ReadAllPattern, module.Handler.MarkAllNotificationsRead)
+					r.Post(constant.RootPattern+"{id}"+constant.ReadPattern, module.Handler.MarkNotificationRead)
+					r.Get(constant.PreferencesPattern, module.Handler.GetNotificationPreferences)
+					r.Put(constant.PreferencesPattern, module.Handler.UpdateNotificationPreferences)
+				},
+			)
+		},
+	)
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/realtime"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterRealtimeRoutes(router *Router, version string, module *realtime.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.StreamAuthMiddleware)
+			r.Use(router.RateLimitMiddleware("realtime", ratelimit.Limit{Requests: 30, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.RealtimePattern,
+				func(r chi.Router) {
+					r.Get(constant.StreamPattern, module.Handler.Stream)
+				},
+			)
+		},
+	)
+}
@@ -1,15 +1,46 @@
 package router
 
 import (
+	"net/http"
+
+	"CodeWithAzri/pkg/ratelimit"
+
 	"github.com/go-chi/chi"
 )
 
 type Router struct {
 	Mux *chi.Mux
+	// AuditMiddleware records data-changing requests. It runs after
+	// authentication in every group with such routes, and lets requests
+	// through unrecorded until the app sets it.
+	AuditMiddleware func(http.Handler) http.Handler
+	// UserSyncMiddleware provisions the user behind the token. It runs right
+	// after authentication in every authenticated group, and lets requests
+	// through untouched until the app sets it.
+	UserSyncMiddleware func(http.Handler) http.Handler
+	// RateLimitMiddleware limits how often each caller may call a route
+	// group. It runs right after authentication, with the limit of the
+	// group, and lets every request through until the app sets it.
+	RateLimitMiddleware func(group string, limit ratelimit.Limit) func(http.Handler) http.Handler
+	// IPRateLimitMiddleware limits how often each IP address may try to
+	// authenticate. It runs right before authentication in every
+	// authenticated group, and lets every request through until the app
+	// sets it.
+	IPRateLimitMiddleware func(http.Handler) http.Handler
 }
 
 func NewRouter() *Router {
 	r := &Router{}
 	r.Mux = chi.NewRouter()
+	r.AuditMiddleware = passthrough
+	r.UserSyncMiddleware = passthrough
+	r.IPRateLimitMiddleware = passthrough
+	r.RateLimitMiddleware = func(string, ratelimit.Limit) func(http.Handler) http.Handler {
+		return passthrough
+	}
 	return r
 }
+
+func passthrough(next http.Handler) http.Handler {
+	return next
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/serviceaccount"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+// RegisterServiceAccountRoutes only accepts ID tokens, so a service account
+// can never mint keys for itself.
+func RegisterServiceAccountRoutes(router *Router, version string, module *serviceaccount.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.AuthMiddleware)
+			r.Use(router.RateLimitMiddleware("admin-service-accounts", ratelimit.Limit{Requests: 60, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.ServiceAccountsPattern,
+				func(r chi.Router) {
+					r.Post(constant.RootPattern, module.Handler.CreateServiceAccount)
+					r.Get(constant.RootPattern, module.Handler.GetServiceAccounts)
+					r.Get(constant.RootPattern+"{id}", module.Handler.GetServiceAccount)
+					r.Put(constant.RootPattern+"{id}", module.Handler.UpdateServiceAccount)
+					r.Delete(constant.RootPattern+"{id}", module.Handler.DeleteServiceAccount)
+					r.Post(constant.RootPattern+"{id}"+constant.KeysPattern, module.Handler.RotateKey)
+					r.Delete(constant.RootPattern+"{id}"+constant.KeysPattern+"/{keyId}", module.Handler.RevokeKey)
+				},
+			)
+		},
+	)
+}
@@ -4,6 +4,9 @@ import (
 	"CodeWithAzri/internal/app/module/user"
 	"CodeWithAzri/internal/pkg/constant"
 	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
 
 	"github.com/go-chi/chi"
 )
@@ -11,13 +14,40 @@ import (
 func RegisterUserRoutes(router *Router, version string, module *user.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
 	router.Mux.Group(
 		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
 			r.Use(firebaseMiddleware.AuthMiddleware)
+			r.Use(router.RateLimitMiddleware("users", ratelimit.Limit{Requests: 60, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
 			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
 			r.Route(
 				constant.ApiPattern+version+constant.UsersPattern,
 				func(r chi.Router) {
 					r.Post(constant.RootPattern, module.Handler.Create)
 					r.Get(constant.RootPattern+"profile", module.Handler.GetProfile)
+					r.Patch(constant.RootPattern+"profile", module.Handler.UpdateProfile)
+					r.Delete(constant.RootPattern+"profile", module.Handler.DeleteAccount)
+					r.Put(constant.RootPattern+"profile/picture", module.Handler.UpdateProfilePicture)
+					r.Get(constant.RootPattern+"me"+constant.ExportPattern, module.Handler.ExportData)
+					r.Get(constant.RootPattern+"{id}", module.Handler.GetPublicProfile)
+				},
+			)
+		},
+	)
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.AdminUsers))
+			r.Use(router.RateLimitMiddleware("admin-users", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.UsersPattern,
+				func(r chi.Router) {
+					r.Get(constant.RootPattern, module.Handler.GetUsers)
+					r.Post(constant.RootPattern+"{id}"+constant.ErasurePattern, module.Handler.EraseUser)
 				},
 			)
 		},
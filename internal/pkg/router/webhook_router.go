@@ -0,0 +1,38 @@
+package router
+
+import (
+	"CodeWithAzri/internal/app/module/webhook"
+	"CodeWithAzri/internal/pkg/constant"
+	"CodeWithAzri/internal/pkg/middleware"
+	api_scope_enum "CodeWithAzri/pkg/enums/apiScope"
+	"CodeWithAzri/pkg/ratelimit"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+func RegisterWebhookRoutes(router *Router, version string, module *webhook.Module, firebaseMiddleware *middleware.FirebaseMiddleware) {
+	router.Mux.Group(
+		func(r chi.Router) {
+			r.Use(router.IPRateLimitMiddleware)
+			r.Use(firebaseMiddleware.ScopedAuthMiddleware(api_scope_enum.AdminWebhooks))
+			r.Use(router.RateLimitMiddleware("admin-webhooks", ratelimit.Limit{Requests: 120, Window: time.Minute}))
+			r.Use(router.UserSyncMiddleware)
+			r.Use(middleware.AdminMiddleware)
+			r.Use(middleware.LoggerMiddleware)
+			r.Use(router.AuditMiddleware)
+			r.Route(
+				constant.ApiPattern+version+constant.AdminPattern+constant.WebhooksPattern,
+				func(r chi.Router) {
+					r.Post(constant.RootPattern, module.Handler.CreateWebhook)
+					r.Get(constant.RootPattern, module.Handler.GetWebhooks)
+					r.Get(constant.RootPattern+"{id}", module.Handler.GetWebhook)
+					r.Put(constant.RootPattern+"{id}", module.Handler.UpdateWebhook)
+					r.Delete(constant.RootPattern+"{id}", module.Handler.DeleteWebhook)
+					r.Get(constant.RootPattern+"{id}"+constant.DeliveriesPattern, module.Handler.GetWebhookDeliveries)
+					r.Post(constant.RootPattern+"{id}"+constant.DeliveriesPattern+"/{deliveryId}"+constant.RedeliverPattern, module.Handler.RedeliverWebhook)
+				},
+			)
+		},
+	)
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"CodeWithAzri/pkg/config"
+	"CodeWithAzri/pkg/redis"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const defaultSize = 1000
+
+// Cache keeps values for a while. A value may be evicted before its TTL is
+// up, so a miss never means the value does not exist.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// NewFromEnv builds the cache selected by CACHE_DRIVER ("memory", "redis"
+// or "none"). CACHE_SIZE caps the entries of the memory cache. Like the
+// memory rate limit store, the memory cache is per instance: a write on one
+// instance leaves stale entries on the others until their TTL is up.
+func NewFromEnv() (Cache, error) {
+	switch driver := config.GetEnvValue("CACHE_DRIVER"); driver {
+	case "", "memory":
+		size := defaultSize
+		if value := config.GetEnvValue("CACHE_SIZE"); value != "" {
+			var err error
+			size, err = strconv.Atoi(value)
+			if err != nil || size <= 0 {
+				return nil, fmt.Errorf("invalid CACHE_SIZE %q", value)
+			}
+		}
+		return NewMemoryCache(size), nil
+	case "redis":
+		c, err := redis.ConfigFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		client, err := redis.NewClient(c)
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisCache(client), nil
+	case "none":
+		return NopCache{}, nil
+	default:
+		return nil, fmt.Errorf("unknown cache driver %q", driver)
+	}
+}
+
+// NopCache never keeps anything.
+type NopCache struct{}
+
+func (NopCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+func (NopCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return nil
+}
+
+func (NopCache) Delete(ctx context.Context, key string) error {
+	return nil
+}
@@ -0,0 +1,102 @@
+package cache_test
+
+import (
+	"CodeWithAzri/pkg/cache"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCache runs the behaviour every cache must share.
+func testCache(t *testing.T, c cache.Cache) {
+	ctx := context.Background()
+
+	t.Run("Miss", func(t *testing.T) {
+		value, ok, err := c.Get(ctx, "course:1")
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, value)
+	})
+
+	t.Run("Hit", func(t *testing.T) {
+		assert.NoError(t, c.Set(ctx, "course:1", []byte(`{"id":1}`), time.Minute))
+
+		value, ok, err := c.Get(ctx, "course:1")
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []byte(`{"id":1}`), value)
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		assert.NoError(t, c.Set(ctx, "course:1", []byte(`{"id":2}`), time.Minute))
+
+		value, _, _ := c.Get(ctx, "course:1")
+
+		assert.Equal(t, []byte(`{"id":2}`), value)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, c.Delete(ctx, "course:1"))
+		assert.NoError(t, c.Delete(ctx, "course:1"))
+
+		_, ok, err := c.Get(ctx, "course:1")
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+
+	testCache(t, cache.NewMemoryCache(10))
+
+	t.Run("Expire", func(t *testing.T) {
+		now := time.UnixMilli(1_700_000_000_000)
+		monkey.Patch(timepkg.Now, func() time.Time { return now })
+		defer monkey.UnpatchAll()
+		c := cache.NewMemoryCache(10)
+		c.Set(ctx, "course:1", []byte("a"), time.Minute)
+
+		now = now.Add(time.Minute - time.Millisecond)
+		_, ok, _ := c.Get(ctx, "course:1")
+		assert.True(t, ok)
+
+		now = now.Add(time.Millisecond)
+		_, ok, _ = c.Get(ctx, "course:1")
+		assert.False(t, ok)
+	})
+
+	t.Run("Evict Least Recently Used", func(t *testing.T) {
+		c := cache.NewMemoryCache(2)
+		c.Set(ctx, "course:1", []byte("a"), time.Minute)
+		c.Set(ctx, "course:2", []byte("b"), time.Minute)
+		c.Get(ctx, "course:1")
+
+		c.Set(ctx, "course:3", []byte("c"), time.Minute)
+
+		_, ok, _ := c.Get(ctx, "course:1")
+		assert.True(t, ok)
+		_, ok, _ = c.Get(ctx, "course:2")
+		assert.False(t, ok)
+		_, ok, _ = c.Get(ctx, "course:3")
+		assert.True(t, ok)
+	})
+}
+
+func TestNopCache(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NopCache{}
+
+	assert.NoError(t, c.Set(ctx, "course:1", []byte("a"), time.Minute))
+	_, ok, err := c.Get(ctx, "course:1")
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
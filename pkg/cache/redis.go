@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"CodeWithAzri/pkg/redis"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const redisKeyPrefix = "cache:"
+
+// RedisCache shares entries between instances through Redis, which also
+// takes care of expiring and evicting them.
+type RedisCache struct {
+	client *redis.Client
+}
+
+func NewRedisCache(client *redis.Client) *RedisCache {
+	return &RedisCache{client: client}
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := c.client.Do(ctx, "GET", redisKeyPrefix+key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if reply == nil {
+		return nil, false, nil
+	}
+
+	value, ok := reply.(string)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected redis reply %v", reply)
+	}
+
+	return []byte(value), true, nil
+}
+
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := c.client.Do(ctx, "SET", redisKeyPrefix+key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+func (c *RedisCache) Delete(ctx context.Context, key string) error {
+	_, err := c.client.Do(ctx, "DEL", redisKeyPrefix+key)
+	return err
+}
@@ -0,0 +1,43 @@
+package cache_test
+
+import (
+	"CodeWithAzri/pkg/cache"
+	"CodeWithAzri/pkg/redis"
+	"CodeWithAzri/pkg/redis/redistest"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisCache(t *testing.T) {
+	t.Run("Share Entries", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		client, _ := redis.NewClient(redis.Config{Addr: server.Addr})
+
+		testCache(t, cache.NewRedisCache(client))
+	})
+
+	t.Run("Prefix Keys And Expire", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		client, _ := redis.NewClient(redis.Config{Addr: server.Addr})
+		c := cache.NewRedisCache(client)
+
+		err := c.Set(context.Background(), "course:1", []byte("a"), 5*time.Minute)
+
+		assert.NoError(t, err)
+		value, _ := server.Get("cache:course:1")
+		assert.Equal(t, "a", value)
+		assert.Equal(t, "300000", server.TTL("cache:course:1"))
+	})
+
+	t.Run("Unreachable Server", func(t *testing.T) {
+		client, _ := redis.NewClient(redis.Config{Addr: "127.0.0.1:1", Timeout: 100 * time.Millisecond})
+		c := cache.NewRedisCache(client)
+
+		_, _, err := c.Get(context.Background(), "course:1")
+
+		assert.ErrorContains(t, err, "failed to connect to redis")
+	})
+}
@@ -0,0 +1,44 @@
+package cache
+
+import "sync"
+
+type call struct {
+	done  chan struct{}
+	value []byte
+	err   error
+}
+
+// Group collapses concurrent loads of the same key into one, so a popular
+// entry that expires costs one query instead of one per waiting request.
+type Group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// Do runs load unless a load of key is already running, in which case it
+// waits for that one and shares its result.
+func (g *Group) Do(key string, load func() ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		<-c.done
+		return c.value, c.err
+	}
+
+	c := &call{done: make(chan struct{})}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(c.done)
+	}()
+
+	c.value, c.err = load()
+	return c.value, c.err
+}
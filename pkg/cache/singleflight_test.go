@@ -0,0 +1,63 @@
+package cache_test
+
+import (
+	"CodeWithAzri/pkg/cache"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("Collapse Concurrent Loads", func(t *testing.T) {
+		var group cache.Group
+		var loads int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+		load := func() ([]byte, error) {
+			if atomic.AddInt32(&loads, 1) == 1 {
+				close(started)
+			}
+			<-release
+			return []byte("a"), nil
+		}
+
+		var wg sync.WaitGroup
+		values := make([][]byte, 5)
+		call := func(i int) {
+			defer wg.Done()
+			values[i], _ = group.Do("course:1", load)
+		}
+
+		wg.Add(1)
+		go call(0)
+		<-started
+		for i := 1; i < len(values); i++ {
+			wg.Add(1)
+			go call(i)
+		}
+		// Give the late callers time to join the running load.
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+		for _, value := range values {
+			assert.Equal(t, []byte("a"), value)
+		}
+	})
+
+	t.Run("Share Error And Forget", func(t *testing.T) {
+		var group cache.Group
+
+		_, err := group.Do("course:1", func() ([]byte, error) { return nil, errors.New("boom") })
+		assert.EqualError(t, err, "boom")
+
+		value, err := group.Do("course:1", func() ([]byte, error) { return []byte("a"), nil })
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("a"), value)
+	})
+}
@@ -0,0 +1,74 @@
+package cron_test
+
+import (
+	"CodeWithAzri/pkg/cron"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Next(t *testing.T) {
+	// A Wednesday.
+	start := time.Date(2024, time.January, 10, 10, 30, 15, 0, time.UTC)
+
+	cases := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.January, 10, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.January, 10, 10, 45, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2024, time.January, 11, 10, 30, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2024, time.January, 11, 3, 0, 0, 0, time.UTC)},
+		{"0 9-17/4 * * 1-5", time.Date(2024, time.January, 10, 13, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, time.January, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Day of month and day of week match if either does.
+		{"0 0 20 * 5", time.Date(2024, time.January, 12, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.January, 10, 11, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.spec, func(t *testing.T) {
+			schedule, err := cron.Parse(tc.spec)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, schedule.Next(start))
+		})
+	}
+
+	t.Run("Never Matches", func(t *testing.T) {
+		schedule, err := cron.Parse("0 0 30 2 *")
+
+		assert.NoError(t, err)
+		assert.True(t, schedule.Next(start).IsZero())
+	})
+}
+
+func TestParse_Invalid(t *testing.T) {
+	specs := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every 30s",
+		"@every soon",
+	}
+
+	for _, spec := range specs {
+		t.Run(spec, func(t *testing.T) {
+			_, err := cron.Parse(spec)
+
+			assert.Error(t, err)
+		})
+	}
+}
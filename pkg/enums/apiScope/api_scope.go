@@ -0,0 +1,32 @@
+package api_scope_enum
+
+// APIScope names a route group a service account may call. Admin scopes
+// also stand in for the admin claim within their group.
+type APIScope string
+
+const (
+	Courses         APIScope = "courses"
+	Media           APIScope = "media"
+	AdminUsers      APIScope = "admin:users"
+	AdminJobs       APIScope = "admin:jobs"
+	AdminWebhooks   APIScope = "admin:webhooks"
+	AdminModeration APIScope = "admin:moderation"
+	AdminAudit      APIScope = "admin:audit"
+	AdminTags       APIScope = "admin:tags"
+)
+
+func (s APIScope) IsValid() bool {
+	switch s {
+	case Courses, Media, AdminUsers, AdminJobs, AdminWebhooks, AdminModeration, AdminAudit, AdminTags:
+		return true
+	}
+	return false
+}
+
+func (s APIScope) IsAdmin() bool {
+	switch s {
+	case AdminUsers, AdminJobs, AdminWebhooks, AdminModeration, AdminAudit, AdminTags:
+		return true
+	}
+	return false
+}
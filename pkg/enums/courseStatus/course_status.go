@@ -0,0 +1,18 @@
+package course_status_enum
+
+type CourseStatus string
+
+const (
+	Draft     CourseStatus = "draft"
+	Review    CourseStatus = "review"
+	Published CourseStatus = "published"
+	Archived  CourseStatus = "archived"
+)
+
+func (s CourseStatus) IsValid() bool {
+	switch s {
+	case Draft, Review, Published, Archived:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package event_type_enum
+
+// EventType names a domain event. Values are stable because they are stored
+// in the outbox and sent to webhooks.
+type EventType string
+
+const (
+	UserRegistered  EventType = "user.registered"
+	UserDeleted     EventType = "user.deleted"
+	CourseCreated   EventType = "course.created"
+	CourseEnrolled  EventType = "course.enrolled"
+	LessonCompleted EventType = "lesson.completed"
+	LessonPublished EventType = "lesson.published"
+	CommentCreated  EventType = "comment.created"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case UserRegistered, UserDeleted, CourseCreated, CourseEnrolled, LessonCompleted, LessonPublished, CommentCreated:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package instructor_role_enum
+
+type InstructorRole string
+
+const (
+	Owner  InstructorRole = "owner"
+	Editor InstructorRole = "editor"
+)
+
+func (r InstructorRole) IsValid() bool {
+	switch r {
+	case Owner, Editor:
+		return true
+	}
+	return false
+}
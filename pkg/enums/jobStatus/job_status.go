@@ -0,0 +1,20 @@
+package job_status_enum
+
+// JobStatus tracks a background job. Failed jobs go back to pending until
+// they run out of attempts and become dead.
+type JobStatus string
+
+const (
+	Pending   JobStatus = "pending"
+	Running   JobStatus = "running"
+	Succeeded JobStatus = "succeeded"
+	Dead      JobStatus = "dead"
+)
+
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case Pending, Running, Succeeded, Dead:
+		return true
+	}
+	return false
+}
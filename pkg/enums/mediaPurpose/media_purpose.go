@@ -0,0 +1,17 @@
+package media_purpose_enum
+
+type MediaPurpose string
+
+const (
+	CourseGallery  MediaPurpose = "course_gallery"
+	LessonVideo    MediaPurpose = "lesson_video"
+	ProfilePicture MediaPurpose = "profile_picture"
+)
+
+func (p MediaPurpose) IsValid() bool {
+	switch p {
+	case CourseGallery, LessonVideo, ProfilePicture:
+		return true
+	}
+	return false
+}
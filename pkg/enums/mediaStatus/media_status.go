@@ -0,0 +1,16 @@
+package media_status_enum
+
+type MediaStatus string
+
+const (
+	Uploading MediaStatus = "uploading"
+	Ready     MediaStatus = "ready"
+)
+
+func (s MediaStatus) IsValid() bool {
+	switch s {
+	case Uploading, Ready:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package message_type_enum
+
+// MessageType tells a client connected to the real-time stream how to read
+// the data of a message.
+type MessageType string
+
+const (
+	Notification MessageType = "notification"
+	Progress     MessageType = "progress"
+	Comment      MessageType = "comment"
+)
+
+func (t MessageType) IsValid() bool {
+	switch t {
+	case Notification, Progress, Comment:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package moderation_action_enum
+
+// ModerationAction is something done to a reported target. Hide is only taken
+// automatically, when a target collects enough reports; the others are taken
+// by admins and resolve the case.
+type ModerationAction string
+
+const (
+	Hide    ModerationAction = "hide"
+	Approve ModerationAction = "approve"
+	Remove  ModerationAction = "remove"
+	Ban     ModerationAction = "ban"
+)
+
+func (a ModerationAction) IsValid() bool {
+	switch a {
+	case Hide, Approve, Remove, Ban:
+		return true
+	}
+	return false
+}
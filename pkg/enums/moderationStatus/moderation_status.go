@@ -0,0 +1,28 @@
+package moderation_status_enum
+
+// ModerationStatus tracks a moderation case. Pending and hidden cases wait in
+// the queue; hidden ones were reported often enough to be taken down until
+// an admin looks at them. The other statuses record how an admin resolved
+// the case.
+type ModerationStatus string
+
+const (
+	Pending  ModerationStatus = "pending"
+	Hidden   ModerationStatus = "hidden"
+	Approved ModerationStatus = "approved"
+	Removed  ModerationStatus = "removed"
+	Banned   ModerationStatus = "banned"
+)
+
+func (s ModerationStatus) IsValid() bool {
+	switch s {
+	case Pending, Hidden, Approved, Removed, Banned:
+		return true
+	}
+	return false
+}
+
+// IsOpen tells whether the case still waits for an admin.
+func (s ModerationStatus) IsOpen() bool {
+	return s == Pending || s == Hidden
+}
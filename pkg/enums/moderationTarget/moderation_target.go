@@ -0,0 +1,24 @@
+package moderation_target_enum
+
+// ModerationTarget is the kind of thing a user can report.
+type ModerationTarget string
+
+const (
+	Review  ModerationTarget = "review"
+	Comment ModerationTarget = "comment"
+	User    ModerationTarget = "user"
+)
+
+func (t ModerationTarget) IsValid() bool {
+	switch t {
+	case Review, Comment, User:
+		return true
+	}
+	return false
+}
+
+// IsContent tells whether the target is something a user wrote, which can be
+// hidden and removed, rather than the user themselves.
+func (t ModerationTarget) IsContent() bool {
+	return t == Review || t == Comment
+}
@@ -0,0 +1,20 @@
+package notification_kind_enum
+
+// NotificationKind is the reason a notification was sent to a user.
+type NotificationKind string
+
+const (
+	Welcome         NotificationKind = "welcome"
+	Enrollment      NotificationKind = "enrollment"
+	Certificate     NotificationKind = "certificate"
+	LessonPublished NotificationKind = "lesson_published"
+	CommentReply    NotificationKind = "comment_reply"
+)
+
+func (k NotificationKind) IsValid() bool {
+	switch k {
+	case Welcome, Enrollment, Certificate, LessonPublished, CommentReply:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package processing_status_enum
+
+// ProcessingStatus tracks the image or video pipeline of an upload. Media
+// that is not processed, such as attachments, has an empty status.
+type ProcessingStatus string
+
+const (
+	Pending ProcessingStatus = "pending"
+	Done    ProcessingStatus = "done"
+	Failed  ProcessingStatus = "failed"
+)
+
+func (s ProcessingStatus) IsValid() bool {
+	switch s {
+	case Pending, Done, Failed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package webhook_delivery_status_enum
+
+// WebhookDeliveryStatus tracks one event sent to one webhook. A failed
+// delivery is retried until it succeeds or runs out of attempts, and keeps
+// the outcome of its last attempt.
+type WebhookDeliveryStatus string
+
+const (
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+	Failed    WebhookDeliveryStatus = "failed"
+)
+
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case Pending, Succeeded, Failed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package imaging_test
+
+import (
+	"CodeWithAzri/pkg/imaging"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newImage(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 80), G: uint8(y * 80), B: 0, A: 255})
+		}
+	}
+	return img
+}
+
+// exifSegment builds an APP1 segment holding only an orientation tag.
+func exifSegment(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = append(tiff, 0x00, 0x01)
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:], 0x0112)
+	binary.BigEndian.PutUint16(entry[2:], 3)
+	binary.BigEndian.PutUint32(entry[4:], 1)
+	binary.BigEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func jpegWithSegments(t *testing.T, img image.Image, segments ...[]byte) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	data := buf.Bytes()
+	out := append([]byte(nil), data[:2]...)
+	for _, segment := range segments {
+		out = append(out, segment...)
+	}
+	return append(out, data[2:]...)
+}
+
+func pngChunk(kind string, data []byte) []byte {
+	chunk := make([]byte, 8)
+	binary.BigEndian.PutUint32(chunk, uint32(len(data)))
+	copy(chunk[4:], kind)
+	chunk = append(chunk, data...)
+	crc := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc, crc32.ChecksumIEEE(chunk[4:]))
+	return append(chunk, crc...)
+}
+
+func TestOrientation(t *testing.T) {
+	img := newImage(3, 1)
+
+	assert.Equal(t, 6, imaging.Orientation(jpegWithSegments(t, img, exifSegment(6))))
+	assert.Equal(t, 1, imaging.Orientation(jpegWithSegments(t, img)))
+	assert.Equal(t, 1, imaging.Orientation([]byte("not an image")))
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Decode Applies Orientation", func(t *testing.T) {
+		for orientation, size := range map[uint16]image.Point{1: {3, 1}, 3: {3, 1}, 6: {1, 3}, 8: {1, 3}} {
+			decoded, format, err := imaging.Decode(jpegWithSegments(t, newImage(3, 1), exifSegment(orientation)), 100)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "jpeg", format)
+			assert.Equal(t, size, decoded.Bounds().Size(), "orientation %d", orientation)
+		}
+	})
+
+	t.Run("Decode PNG", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, png.Encode(&buf, newImage(4, 2)))
+
+		decoded, format, err := imaging.Decode(buf.Bytes(), 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "png", format)
+		assert.Equal(t, image.Pt(4, 2), decoded.Bounds().Size())
+	})
+
+	t.Run("Decode Too Many Pixels", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, png.Encode(&buf, newImage(4, 2)))
+
+		_, _, err := imaging.Decode(buf.Bytes(), 7)
+
+		assert.ErrorIs(t, err, imaging.ErrTooManyPixels)
+	})
+
+	t.Run("Decode Oversized Header", func(t *testing.T) {
+		// A few hundred bytes declaring 50000x50000 pixels, which would take
+		// 10GB to decode.
+		header := make([]byte, 13)
+		binary.BigEndian.PutUint32(header[0:], 50000)
+		binary.BigEndian.PutUint32(header[4:], 50000)
+		header[8], header[9] = 8, 6
+		data := append([]byte("\x89PNG\r\n\x1a\n"), pngChunk("IHDR", header)...)
+		data = append(data, pngChunk("IDAT", []byte{0x78, 0x9c, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01})...)
+		data = append(data, pngChunk("IEND", nil)...)
+
+		_, _, err := imaging.Decode(data, 50_000_000)
+
+		assert.ErrorIs(t, err, imaging.ErrTooManyPixels)
+	})
+
+	t.Run("Decode Invalid", func(t *testing.T) {
+		_, _, err := imaging.Decode([]byte("not an image"), 100)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFit(t *testing.T) {
+	assert.Equal(t, image.Pt(200, 100), imaging.Fit(newImage(400, 200), 200).Bounds().Size())
+	assert.Equal(t, image.Pt(50, 200), imaging.Fit(newImage(100, 400), 200).Bounds().Size())
+
+	small := newImage(20, 10)
+	assert.Same(t, small, imaging.Fit(small, 200))
+}
+
+func TestEncode(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.NoError(t, imaging.Encode(&buf, newImage(2, 2), imaging.JPEG, 80))
+	assert.NoError(t, imaging.Encode(&buf, newImage(2, 2), imaging.PNG, 0))
+	assert.ErrorIs(t, imaging.Encode(&buf, newImage(2, 2), imaging.WebP, 80), imaging.ErrUnsupportedFormat)
+
+	assert.Equal(t, ".jpg", imaging.JPEG.Extension())
+	assert.Equal(t, "image/webp", imaging.WebP.ContentType())
+	assert.True(t, imaging.IsOpaque(newImage(2, 2)))
+	assert.False(t, imaging.IsOpaque(image.NewNRGBA(image.Rect(0, 0, 2, 2))))
+}
+
+func TestStripMetadata(t *testing.T) {
+	t.Run("Strip JPEG", func(t *testing.T) {
+		comment := []byte{0xFF, 0xFE, 0x00, 0x07, 'h', 'e', 'l', 'l', 'o'}
+		data := jpegWithSegments(t, newImage(3, 1), exifSegment(6), comment)
+
+		stripped, err := imaging.StripMetadata(data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(data)-len(exifSegment(6))-len(comment), len(stripped))
+		assert.Equal(t, 1, imaging.Orientation(stripped))
+		_, err = jpeg.Decode(bytes.NewReader(stripped))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Strip PNG", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, png.Encode(&buf, newImage(2, 2)))
+		data := buf.Bytes()
+		text := pngChunk("tEXt", []byte("Author\x00someone"))
+		// Insert the text chunk right after IHDR.
+		withText := append(append(append([]byte(nil), data[:33]...), text...), data[33:]...)
+
+		stripped, err := imaging.StripMetadata(withText)
+
+		assert.NoError(t, err)
+		assert.Equal(t, data, stripped)
+	})
+
+	t.Run("Strip WebP", func(t *testing.T) {
+		chunk := func(fourCC string, payload []byte) []byte {
+			header := make([]byte, 8)
+			copy(header, fourCC)
+			binary.LittleEndian.PutUint32(header[4:], uint32(len(payload)))
+			if len(payload)%2 == 1 {
+				payload = append(payload, 0)
+			}
+			return append(header, payload...)
+		}
+		body := append([]byte("WEBP"), chunk("VP8X", []byte{0x0C, 0, 0, 0, 0, 0, 0, 0, 0, 0})...)
+		body = append(body, chunk("VP8L", []byte{1, 2, 3})...)
+		body = append(body, chunk("EXIF", []byte("exif data"))...)
+		body = append(body, chunk("XMP ", []byte("xmp"))...)
+		data := append([]byte("RIFF\x00\x00\x00\x00"), body...)
+
+		stripped, err := imaging.StripMetadata(data)
+
+		assert.NoError(t, err)
+		assert.NotContains(t, string(stripped), "EXIF")
+		assert.NotContains(t, string(stripped), "XMP ")
+		assert.Equal(t, byte(0), stripped[20])
+		assert.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+	})
+
+	t.Run("Strip Malformed JPEG", func(t *testing.T) {
+		_, err := imaging.StripMetadata([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Strip Other Formats", func(t *testing.T) {
+		stripped, err := imaging.StripMetadata([]byte("GIF89a"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("GIF89a"), stripped)
+	})
+}
+
+func TestCWebPEncoder(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script in place of cwebp")
+	}
+
+	t.Run("Encode WebP", func(t *testing.T) {
+		// The stand-in copies its input to the -o argument.
+		script := filepath.Join(t.TempDir(), "cwebp")
+		err := os.WriteFile(script, []byte("#!/bin/sh\nwhile [ \"$1\" != \"-o\" ]; do in=$1; shift; done\ncp \"$in\" \"$2\"\n"), 0o755)
+		assert.NoError(t, err)
+
+		encoder, err := imaging.NewCWebPEncoder(script)
+		assert.NoError(t, err)
+
+		webp, err := encoder.EncodeWebP(newImage(2, 2), 80)
+
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(webp, []byte("\x89PNG")))
+	})
+
+	t.Run("Encode WebP Failure", func(t *testing.T) {
+		script := filepath.Join(t.TempDir(), "cwebp")
+		err := os.WriteFile(script, []byte("#!/bin/sh\necho broken >&2\nexit 1\n"), 0o755)
+		assert.NoError(t, err)
+
+		encoder, _ := imaging.NewCWebPEncoder(script)
+		_, err = encoder.EncodeWebP(newImage(2, 2), 80)
+
+		assert.ErrorContains(t, err, "broken")
+	})
+
+	t.Run("Missing Binary", func(t *testing.T) {
+		_, err := imaging.NewCWebPEncoder(filepath.Join(t.TempDir(), "missing"))
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,69 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// WebPEncoder converts images to WebP. The standard library and x/image can
+// only decode WebP, so encoding is delegated.
+type WebPEncoder interface {
+	EncodeWebP(img image.Image, quality int) ([]byte, error)
+}
+
+// CWebPEncoder encodes WebP with the cwebp command line tool from libwebp.
+type CWebPEncoder struct {
+	path string
+}
+
+// NewCWebPEncoder looks up the cwebp binary, either by absolute path or on
+// the PATH.
+func NewCWebPEncoder(binary string) (*CWebPEncoder, error) {
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cwebp: %v", err)
+	}
+
+	e := &CWebPEncoder{path: path}
+	return e, nil
+}
+
+func (e *CWebPEncoder) EncodeWebP(img image.Image, quality int) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "cwebp-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input.png")
+	output := filepath.Join(dir, "output.webp")
+
+	file, err := os.Create(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cwebp input: %v", err)
+	}
+	err = Encode(file, img, PNG, 0)
+	file.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write cwebp input: %v", err)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(e.path, "-quiet", "-metadata", "none", "-q", fmt.Sprint(quality), input, "-o", output)
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run cwebp: %v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	webp, err := os.ReadFile(output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cwebp output: %v", err)
+	}
+
+	return webp, nil
+}
@@ -0,0 +1,71 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileSender writes every message as an .eml file into dir, where it can be
+// opened with any mail client.
+type FileSender struct {
+	dir  string
+	from string
+	now  func() time.Time
+}
+
+func NewFileSender(dir string, from string) (*FileSender, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %v", err)
+	}
+
+	return &FileSender{dir: dir, from: from, now: time.Now}, nil
+}
+
+func (s *FileSender) Send(ctx context.Context, message Message) error {
+	err := validAddress(message.To)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	name := fmt.Sprintf("%d-%s.eml", now.UnixNano(), strings.Map(safeFileRune, message.To))
+
+	err = os.WriteFile(filepath.Join(s.dir, name), compose(s.from, message, now), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write email: %v", err)
+	}
+
+	return nil
+}
+
+func safeFileRune(r rune) rune {
+	if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' {
+		return r
+	}
+	return '_'
+}
+
+// LogSender only logs who a message would have gone to.
+type LogSender struct {
+	from string
+}
+
+func NewLogSender(from string) *LogSender {
+	return &LogSender{from: from}
+}
+
+func (s *LogSender) Send(ctx context.Context, message Message) error {
+	err := validAddress(message.To)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("email from %s to %s: %s\n", s.from, message.To, message.Subject)
+	return nil
+}
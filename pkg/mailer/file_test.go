@@ -0,0 +1,56 @@
+package mailer_test
+
+import (
+	"CodeWithAzri/pkg/mailer"
+	"context"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSender(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Write Email File", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := mailer.NewFileSender(dir, "CodeWithAzri <no-reply@example.com>")
+		assert.NoError(t, err)
+
+		err = s.Send(ctx, mailer.Message{To: "student@example.com", Subject: "Selamat datang, Budi", HTML: "<p>Halo</p>"})
+		assert.NoError(t, err)
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*-student_example.com.eml"))
+		assert.Len(t, files, 1)
+
+		file, _ := os.Open(files[0])
+		defer file.Close()
+		parsed, err := mail.ReadMessage(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "student@example.com", parsed.Header.Get("To"))
+		assert.Equal(t, "text/html; charset=utf-8", parsed.Header.Get("Content-Type"))
+		assert.Equal(t, "base64", parsed.Header.Get("Content-Transfer-Encoding"))
+
+		subject, err := new(mimeDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		assert.NoError(t, err)
+		assert.Equal(t, "Selamat datang, Budi", subject)
+	})
+
+	t.Run("Reject Header Injection", func(t *testing.T) {
+		s, err := mailer.NewFileSender(t.TempDir(), "no-reply@example.com")
+		assert.NoError(t, err)
+
+		err = s.Send(ctx, mailer.Message{To: "student@example.com\r\nBcc: everyone@example.com", Subject: "Hi"})
+
+		assert.ErrorContains(t, err, "invalid email address")
+	})
+}
+
+func TestLogSender(t *testing.T) {
+	s := mailer.NewLogSender("no-reply@example.com")
+
+	assert.NoError(t, s.Send(context.Background(), mailer.Message{To: "student@example.com", Subject: "Hi"}))
+	assert.Error(t, s.Send(context.Background(), mailer.Message{Subject: "Hi"}))
+}
@@ -0,0 +1,90 @@
+package mailer
+
+import (
+	"CodeWithAzri/pkg/config"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Message is an HTML email to a single recipient.
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+}
+
+// Sender delivers messages. Implementations do not retry; callers send
+// through the job queue, which does.
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// NewFromEnv builds the sender selected by MAIL_DRIVER ("log", "file" or
+// "smtp"). The log and file senders stand in for a mail server during
+// development.
+func NewFromEnv() (Sender, error) {
+	from := config.GetEnvValue("MAIL_FROM")
+	if from == "" {
+		from = "CodeWithAzri <no-reply@localhost>"
+	}
+
+	switch driver := config.GetEnvValue("MAIL_DRIVER"); driver {
+	case "", "log":
+		return NewLogSender(from), nil
+	case "file":
+		dir := config.GetEnvValue("MAIL_FILE_DIR")
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "codewithazri-mail")
+		}
+		return NewFileSender(dir, from)
+	case "smtp":
+		return NewSMTPSender(SMTPConfig{
+			Host:     config.GetEnvValue("SMTP_HOST"),
+			Port:     config.GetEnvValue("SMTP_PORT"),
+			Username: config.GetEnvValue("SMTP_USERNAME"),
+			Password: config.GetEnvValue("SMTP_PASSWORD"),
+			From:     from,
+		})
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", driver)
+	}
+}
+
+// compose renders message as a MIME email. The body is base64 encoded, so
+// long lines and non-ASCII text survive any mail server.
+func compose(from string, message Message, date time.Time) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + message.To + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", message.Subject) + "\r\n")
+	b.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(message.HTML))
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded + "\r\n")
+
+	return b.Bytes()
+}
+
+// validAddress rejects line breaks, which would let a recipient inject
+// headers.
+func validAddress(address string) error {
+	if address == "" || strings.ContainsAny(address, "\r\n") {
+		return fmt.Errorf("invalid email address %q", address)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPSender sends through a mail server. Authentication is skipped when no
+// username is set, which suits local catch-all servers such as MailHog.
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+	now  func() time.Time
+}
+
+func NewSMTPSender(cfg SMTPConfig) (*SMTPSender, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("SMTP host is required")
+	}
+
+	port := cfg.Port
+	if port == "" {
+		port = "587"
+	}
+
+	s := &SMTPSender{
+		addr: net.JoinHostPort(cfg.Host, port),
+		from: cfg.From,
+		now:  time.Now,
+	}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s, nil
+}
+
+// Send ignores ctx: net/smtp cannot be cancelled, and the job running it is
+// bounded by its own timeout.
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	err := validAddress(message.To)
+	if err != nil {
+		return err
+	}
+
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %v", err)
+	}
+
+	err = smtp.SendMail(s.addr, s.auth, from.Address, []string{message.To}, compose(s.from, message, s.now()))
+	if err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+
+	return nil
+}
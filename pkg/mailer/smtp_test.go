@@ -0,0 +1,117 @@
+package mailer_test
+
+import (
+	"CodeWithAzri/pkg/mailer"
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mimeDecoder = mime.WordDecoder
+
+// fakeSMTPServer accepts a single message and hands back what it received.
+func fakeSMTPServer(t *testing.T) (string, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error starting SMTP server: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+		reply("220 localhost ESMTP")
+
+		var envelope strings.Builder
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM"), strings.HasPrefix(command, "RCPT TO"):
+				envelope.WriteString(strings.TrimSpace(line) + "\n")
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 Go ahead")
+				var data strings.Builder
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				received <- envelope.String() + "\n" + data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), received
+}
+
+func TestSMTPSender(t *testing.T) {
+	t.Run("Send Email", func(t *testing.T) {
+		addr, received := fakeSMTPServer(t)
+		host, port, _ := net.SplitHostPort(addr)
+
+		s, err := mailer.NewSMTPSender(mailer.SMTPConfig{Host: host, Port: port, From: "CodeWithAzri <no-reply@example.com>"})
+		assert.NoError(t, err)
+
+		err = s.Send(context.Background(), mailer.Message{To: "student@example.com", Subject: "Welcome", HTML: "<p>Hello</p>"})
+		assert.NoError(t, err)
+
+		data := <-received
+		envelope, message, _ := strings.Cut(data, "\n\n")
+		assert.Contains(t, envelope, "MAIL FROM:<no-reply@example.com>")
+		assert.Contains(t, envelope, "RCPT TO:<student@example.com>")
+
+		parsed, err := mail.ReadMessage(strings.NewReader(message))
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(base64.NewDecoder(base64.StdEncoding, parsed.Body))
+		assert.Equal(t, "<p>Hello</p>", string(body))
+	})
+
+	t.Run("Send Connection Error", func(t *testing.T) {
+		listener, _ := net.Listen("tcp", "127.0.0.1:0")
+		host, port, _ := net.SplitHostPort(listener.Addr().String())
+		listener.Close()
+
+		s, err := mailer.NewSMTPSender(mailer.SMTPConfig{Host: host, Port: port, From: "no-reply@example.com"})
+		assert.NoError(t, err)
+
+		err = s.Send(context.Background(), mailer.Message{To: "student@example.com", Subject: "Welcome"})
+
+		assert.ErrorContains(t, err, "failed to send email")
+	})
+
+	t.Run("Host Required", func(t *testing.T) {
+		_, err := mailer.NewSMTPSender(mailer.SMTPConfig{})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the memory store forgets buckets that have
+// refilled, so idle clients do not hold memory forever.
+const sweepInterval = time.Minute
+
+type memoryEntry struct {
+	bucket bucket
+	limit  Limit
+}
+
+// MemoryStore keeps the buckets of a single instance in memory.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]memoryEntry)}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := timepkg.Now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	entry, ok := s.entries[key]
+	b, result := entry.bucket.take(limit, now.UnixMilli(), !ok)
+	s.entries[key] = memoryEntry{bucket: b, limit: limit}
+
+	return result, nil
+}
+
+// sweep drops the buckets that would be full by now. Dropping them changes
+// nothing, as a missing bucket starts full.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, entry := range s.entries {
+		if now.UnixMilli()-entry.bucket.UpdatedAt >= entry.limit.Window.Milliseconds() {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
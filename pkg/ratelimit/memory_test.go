@@ -0,0 +1,80 @@
+package ratelimit_test
+
+import (
+	"CodeWithAzri/pkg/ratelimit"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLimit = ratelimit.Limit{Requests: 3, Window: 3 * time.Second}
+
+// patchClock makes timepkg.Now return *now.
+func patchClock(now *time.Time) {
+	monkey.Patch(timepkg.Now, func() time.Time { return *now })
+}
+
+// testStore runs the behaviour every store must share.
+func testStore(t *testing.T, store ratelimit.Store) {
+	ctx := context.Background()
+	now := time.UnixMilli(1_700_000_000_000)
+	patchClock(&now)
+	defer monkey.UnpatchAll()
+
+	t.Run("Allow Up To Limit", func(t *testing.T) {
+		for remaining := 2; remaining >= 0; remaining-- {
+			result, err := store.Take(ctx, "user:budi", testLimit)
+			assert.NoError(t, err)
+			assert.True(t, result.Allowed)
+			assert.Equal(t, 3, result.Limit)
+			assert.Equal(t, remaining, result.Remaining)
+			assert.Zero(t, result.RetryAfter)
+		}
+	})
+
+	t.Run("Refuse Over Limit", func(t *testing.T) {
+		result, err := store.Take(ctx, "user:budi", testLimit)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+		assert.Equal(t, time.Second, result.RetryAfter)
+		assert.Equal(t, 3*time.Second, result.Reset)
+	})
+
+	t.Run("Keys Have Own Buckets", func(t *testing.T) {
+		result, err := store.Take(ctx, "user:siti", testLimit)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 2, result.Remaining)
+	})
+
+	t.Run("Refill Over Time", func(t *testing.T) {
+		now = now.Add(1500 * time.Millisecond)
+
+		result, err := store.Take(ctx, "user:budi", testLimit)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+		assert.Equal(t, 2500*time.Millisecond, result.Reset)
+	})
+
+	t.Run("Refill No More Than Limit", func(t *testing.T) {
+		now = now.Add(time.Hour)
+
+		result, err := store.Take(ctx, "user:budi", testLimit)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Remaining)
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, ratelimit.NewMemoryStore())
+}
@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"CodeWithAzri/pkg/config"
+	"CodeWithAzri/pkg/redis"
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Limit lets Requests requests through per Window. Tokens refill steadily,
+// so a client that used up its budget gets one request back every
+// Window/Requests.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Result is the state of a bucket after a request took its token, or tried
+// to.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until the next request will be allowed. It is
+	// zero while requests are allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps one token bucket per key.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// NewFromEnv builds the store selected by RATE_LIMIT_STORE ("memory" or
+// "redis"). The memory store only limits a single instance; run several
+// behind a load balancer with the redis store.
+func NewFromEnv() (Store, error) {
+	switch driver := config.GetEnvValue("RATE_LIMIT_STORE"); driver {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "redis":
+		c, err := redis.ConfigFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		client, err := redis.NewClient(c)
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisStore(client), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", driver)
+	}
+}
+
+// bucket is a token bucket as of UpdatedAt, in Unix milliseconds.
+type bucket struct {
+	Tokens    float64
+	UpdatedAt int64
+}
+
+// take refills b up to now and takes a token from it. A new bucket starts
+// full.
+func (b bucket) take(limit Limit, now int64, isNew bool) (bucket, Result) {
+	capacity := float64(limit.Requests)
+	perMilli := capacity / float64(limit.Window.Milliseconds())
+
+	tokens := capacity
+	if !isNew {
+		elapsed := math.Max(float64(now-b.UpdatedAt), 0)
+		tokens = math.Min(capacity, b.Tokens+elapsed*perMilli)
+	}
+
+	result := Result{Limit: limit.Requests}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = millis((1 - tokens) / perMilli)
+	}
+	result.Remaining = int(tokens)
+	result.Reset = millis((capacity - tokens) / perMilli)
+
+	return bucket{Tokens: tokens, UpdatedAt: now}, result
+}
+
+func millis(ms float64) time.Duration {
+	return time.Duration(math.Ceil(ms)) * time.Millisecond
+}
@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"CodeWithAzri/pkg/redis"
+	timepkg "CodeWithAzri/pkg/timePkg"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	redisKeyPrefix = "ratelimit:"
+	// redisAttempts bounds the optimistic retries of a bucket that keeps
+	// changing under us. Losing every race means the key is hammered, so
+	// the request is refused.
+	redisAttempts = 5
+)
+
+var errRedisConflict = errors.New("rate limit bucket changed concurrently")
+
+// RedisStore shares buckets between instances through Redis or any server
+// speaking its protocol. Buckets are updated optimistically with WATCH and
+// MULTI/EXEC, so no scripting support is needed.
+type RedisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	var result Result
+	err := s.client.WithConn(ctx, func(conn *redis.Conn) error {
+		var err error
+		for attempt := 0; attempt < redisAttempts; attempt++ {
+			result, err = s.take(conn, redisKeyPrefix+key, limit)
+			if err != errRedisConflict {
+				return err
+			}
+		}
+
+		result = Result{Limit: limit.Requests, RetryAfter: time.Second}
+		return nil
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, nil
+}
+
+// take runs one optimistic update of the bucket at key. It returns
+// errRedisConflict when another client changed the bucket in between.
+func (s *RedisStore) take(conn *redis.Conn, key string, limit Limit) (Result, error) {
+	if _, err := conn.Do("WATCH", key); err != nil {
+		return Result{}, err
+	}
+
+	reply, err := conn.Do("HMGET", key, "tokens", "updated_at")
+	if err != nil {
+		return Result{}, err
+	}
+
+	current, isNew, err := parseBucket(reply)
+	if err != nil {
+		return Result{}, err
+	}
+
+	updated, result := current.take(limit, timepkg.Now().UnixMilli(), isNew)
+
+	replies, err := conn.Pipeline(
+		[]string{"MULTI"},
+		[]string{"HSET", key, "tokens", strconv.FormatFloat(updated.Tokens, 'f', -1, 64), "updated_at", strconv.FormatInt(updated.UpdatedAt, 10)},
+		[]string{"PEXPIRE", key, strconv.FormatInt(limit.Window.Milliseconds(), 10)},
+		[]string{"EXEC"},
+	)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if replies[3] == nil {
+		return Result{}, errRedisConflict
+	}
+
+	return result, nil
+}
+
+func parseBucket(reply any) (bucket, bool, error) {
+	fields, ok := reply.([]any)
+	if !ok || len(fields) != 2 {
+		return bucket{}, false, fmt.Errorf("unexpected redis reply %v", reply)
+	}
+
+	if fields[0] == nil || fields[1] == nil {
+		return bucket{}, true, nil
+	}
+
+	tokens, err := strconv.ParseFloat(fmt.Sprint(fields[0]), 64)
+	if err != nil {
+		return bucket{}, false, fmt.Errorf("invalid rate limit bucket: %v", err)
+	}
+
+	updatedAt, err := strconv.ParseInt(fmt.Sprint(fields[1]), 10, 64)
+	if err != nil {
+		return bucket{}, false, fmt.Errorf("invalid rate limit bucket: %v", err)
+	}
+
+	return bucket{Tokens: tokens, UpdatedAt: updatedAt}, false, nil
+}
@@ -0,0 +1,96 @@
+package ratelimit_test
+
+import (
+	"CodeWithAzri/pkg/ratelimit"
+	"CodeWithAzri/pkg/redis"
+	"CodeWithAzri/pkg/redis/redistest"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRedisStore(t *testing.T, c redis.Config) *ratelimit.RedisStore {
+	client, err := redis.NewClient(c)
+	if err != nil {
+		t.Fatalf("Error creating Redis client: %v", err)
+	}
+	return ratelimit.NewRedisStore(client)
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Share Buckets", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		store := newRedisStore(t, redis.Config{Addr: server.Addr})
+
+		testStore(t, store)
+
+		assert.Equal(t, "2", server.HGet("ratelimit:user:budi", "tokens"))
+		assert.Equal(t, "3000", server.TTL("ratelimit:user:budi"))
+	})
+
+	t.Run("Authenticate", func(t *testing.T) {
+		server := redistest.NewServer(t, "s3cret")
+		store := newRedisStore(t, redis.Config{Addr: server.Addr, Password: "s3cret", DB: 2})
+
+		result, err := store.Take(ctx, "ip:10.0.0.1", testLimit)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, []string{"AUTH", "SELECT", "WATCH", "HMGET", "MULTI", "HSET", "PEXPIRE", "EXEC"}, server.Commands())
+	})
+
+	t.Run("Wrong Password", func(t *testing.T) {
+		server := redistest.NewServer(t, "s3cret")
+		store := newRedisStore(t, redis.Config{Addr: server.Addr, Password: "guess"})
+
+		_, err := store.Take(ctx, "ip:10.0.0.1", testLimit)
+
+		assert.EqualError(t, err, "redis: WRONGPASS invalid password")
+	})
+
+	t.Run("Retry Concurrent Change", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		store := newRedisStore(t, redis.Config{Addr: server.Addr})
+		changes := 0
+		server.BeforeExec = func() {
+			if changes == 0 {
+				changes++
+				server.HSet("ratelimit:user:budi", map[string]string{"tokens": "0.5", "updated_at": fmt.Sprint(time.Now().UnixMilli())})
+			}
+		}
+
+		result, err := store.Take(ctx, "user:budi", testLimit)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 2, strings.Count(strings.Join(server.Commands(), " "), "EXEC"))
+	})
+
+	t.Run("Refuse When Always Changed", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		store := newRedisStore(t, redis.Config{Addr: server.Addr})
+		server.BeforeExec = func() {
+			server.HSet("ratelimit:user:budi", map[string]string{"tokens": "3", "updated_at": fmt.Sprint(time.Now().UnixMilli())})
+		}
+
+		result, err := store.Take(ctx, "user:budi", testLimit)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, time.Second, result.RetryAfter)
+	})
+
+	t.Run("Unreachable Server", func(t *testing.T) {
+		store := newRedisStore(t, redis.Config{Addr: "127.0.0.1:1", Timeout: 100 * time.Millisecond})
+
+		_, err := store.Take(ctx, "user:budi", testLimit)
+
+		assert.ErrorContains(t, err, "failed to connect to redis")
+	})
+}
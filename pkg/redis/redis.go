@@ -0,0 +1,248 @@
+package redis
+
+import (
+	"CodeWithAzri/pkg/config"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const poolSize = 16
+
+// Error is an error reply of the server. The connection stays usable after
+// one.
+type Error string
+
+func (e Error) Error() string {
+	return "redis: " + string(e)
+}
+
+type Config struct {
+	Addr     string
+	Password string
+	DB       int
+	// Timeout bounds dialing and each round trip. It defaults to one
+	// second.
+	Timeout time.Duration
+}
+
+// ConfigFromEnv reads REDIS_ADDR, REDIS_PASSWORD and REDIS_DB.
+func ConfigFromEnv() (Config, error) {
+	c := Config{
+		Addr:     config.GetEnvValue("REDIS_ADDR"),
+		Password: config.GetEnvValue("REDIS_PASSWORD"),
+	}
+
+	if value := config.GetEnvValue("REDIS_DB"); value != "" {
+		db, err := strconv.Atoi(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid REDIS_DB %q", value)
+		}
+		c.DB = db
+	}
+
+	return c, nil
+}
+
+// Client talks to Redis, or any server speaking its protocol, over a small
+// pool of connections. It only knows what this application needs: sending
+// commands and reading their replies.
+type Client struct {
+	config Config
+	idle   chan *Conn
+}
+
+func NewClient(c Config) (*Client, error) {
+	if c.Addr == "" {
+		return nil, errors.New("redis requires an address")
+	}
+
+	if c.Timeout == 0 {
+		c.Timeout = time.Second
+	}
+
+	return &Client{config: c, idle: make(chan *Conn, poolSize)}, nil
+}
+
+// Do sends a single command.
+func (c *Client) Do(ctx context.Context, args ...string) (any, error) {
+	var reply any
+	err := c.WithConn(ctx, func(conn *Conn) error {
+		var err error
+		reply, err = conn.Do(args...)
+		return err
+	})
+	return reply, err
+}
+
+// WithConn runs fn on one connection, for commands that must share it such
+// as WATCH and MULTI/EXEC. The connection goes back to the pool unless fn
+// failed for any reason other than an error reply.
+func (c *Client) WithConn(ctx context.Context, fn func(conn *Conn) error) error {
+	conn, err := c.conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(conn)
+	var replyErr Error
+	if err != nil && !errors.As(err, &replyErr) {
+		conn.Close()
+		return err
+	}
+
+	c.release(conn)
+	return err
+}
+
+func (c *Client) conn(ctx context.Context) (*Conn, error) {
+	select {
+	case conn := <-c.idle:
+		return conn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: c.config.Timeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", c.config.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %v", err)
+	}
+
+	conn := &Conn{conn: netConn, reader: bufio.NewReader(netConn), timeout: c.config.Timeout}
+
+	if c.config.Password != "" {
+		if _, err := conn.Do("AUTH", c.config.Password); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	if c.config.DB != 0 {
+		if _, err := conn.Do("SELECT", strconv.Itoa(c.config.DB)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+// release returns conn to the pool, or closes it when the pool is full.
+func (c *Client) release(conn *Conn) {
+	select {
+	case c.idle <- conn:
+	default:
+		conn.Close()
+	}
+}
+
+// Conn speaks RESP, the Redis serialization protocol.
+type Conn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	timeout time.Duration
+}
+
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
+
+// Do sends a command and reads its reply. Nil bulk strings and arrays read
+// as nil, integers as int64, and strings as string.
+func (c *Conn) Do(args ...string) (any, error) {
+	replies, err := c.Pipeline(args)
+	if err != nil {
+		return nil, err
+	}
+	return replies[0], nil
+}
+
+// Pipeline sends commands in one write and reads their replies in order.
+// An error reply to any of them is returned once all replies are read.
+func (c *Conn) Pipeline(commands ...[]string) ([]any, error) {
+	c.conn.SetDeadline(time.Now().Add(c.timeout))
+
+	var b strings.Builder
+	for _, args := range commands {
+		b.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+		for _, arg := range args {
+			b.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n" + arg + "\r\n")
+		}
+	}
+
+	if _, err := io.WriteString(c.conn, b.String()); err != nil {
+		return nil, fmt.Errorf("failed to write to redis: %v", err)
+	}
+
+	replies := make([]any, 0, len(commands))
+	var replyErr error
+	for range commands {
+		reply, err := c.readReply()
+		if rErr, ok := err.(Error); ok {
+			if replyErr == nil {
+				replyErr = rErr
+			}
+			replies = append(replies, nil)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read from redis: %v", err)
+		}
+		replies = append(replies, reply)
+	}
+
+	return replies, replyErr
+}
+
+func (c *Conn) readReply() (any, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("empty redis reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, Error(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, err
+		}
+		return string(data[:size]), nil
+	case '*':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		items := make([]any, 0, size)
+		for i := 0; i < size; i++ {
+			item, err := c.readReply()
+			if _, ok := err.(Error); err != nil && !ok {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unexpected redis reply %q", line)
+	}
+}
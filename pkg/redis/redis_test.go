@@ -0,0 +1,105 @@
+package redis_test
+
+import (
+	"CodeWithAzri/pkg/redis"
+	"CodeWithAzri/pkg/redis/redistest"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Send Commands", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		client, err := redis.NewClient(redis.Config{Addr: server.Addr})
+		assert.NoError(t, err)
+
+		reply, err := client.Do(ctx, "SET", "greeting", "halo", "PX", "1000")
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", reply)
+
+		reply, err = client.Do(ctx, "GET", "greeting")
+		assert.NoError(t, err)
+		assert.Equal(t, "halo", reply)
+
+		reply, err = client.Do(ctx, "GET", "missing")
+		assert.NoError(t, err)
+		assert.Nil(t, reply)
+
+		reply, err = client.Do(ctx, "DEL", "greeting", "missing")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), reply)
+	})
+
+	t.Run("Reuse Connections", func(t *testing.T) {
+		server := redistest.NewServer(t, "s3cret")
+		client, _ := redis.NewClient(redis.Config{Addr: server.Addr, Password: "s3cret", DB: 2})
+
+		for i := 0; i < 3; i++ {
+			_, err := client.Do(ctx, "GET", "greeting")
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, []string{"AUTH", "SELECT", "GET", "GET", "GET"}, server.Commands())
+	})
+
+	t.Run("Pipeline Transaction", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		client, _ := redis.NewClient(redis.Config{Addr: server.Addr})
+
+		var replies []any
+		err := client.WithConn(ctx, func(conn *redis.Conn) error {
+			var err error
+			replies, err = conn.Pipeline(
+				[]string{"MULTI"},
+				[]string{"HSET", "bucket", "tokens", "2"},
+				[]string{"PEXPIRE", "bucket", "60000"},
+				[]string{"EXEC"},
+			)
+			return err
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"OK", "QUEUED", "QUEUED", []any{int64(1), int64(1)}}, replies)
+		assert.Equal(t, "2", server.HGet("bucket", "tokens"))
+		assert.Equal(t, "60000", server.TTL("bucket"))
+	})
+
+	t.Run("Error Reply", func(t *testing.T) {
+		server := redistest.NewServer(t, "")
+		client, _ := redis.NewClient(redis.Config{Addr: server.Addr})
+
+		_, err := client.Do(ctx, "FLUSHALL")
+		assert.EqualError(t, err, "redis: ERR unknown command 'FLUSHALL'")
+
+		_, err = client.Do(ctx, "GET", "greeting")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Wrong Password", func(t *testing.T) {
+		server := redistest.NewServer(t, "s3cret")
+		client, _ := redis.NewClient(redis.Config{Addr: server.Addr, Password: "guess"})
+
+		_, err := client.Do(ctx, "GET", "greeting")
+
+		assert.EqualError(t, err, "redis: WRONGPASS invalid password")
+	})
+
+	t.Run("Unreachable Server", func(t *testing.T) {
+		client, _ := redis.NewClient(redis.Config{Addr: "127.0.0.1:1", Timeout: 100 * time.Millisecond})
+
+		_, err := client.Do(ctx, "GET", "greeting")
+
+		assert.ErrorContains(t, err, "failed to connect to redis")
+	})
+
+	t.Run("Require Address", func(t *testing.T) {
+		_, err := redis.NewClient(redis.Config{})
+
+		assert.EqualError(t, err, "redis requires an address")
+	})
+}
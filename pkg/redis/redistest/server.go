@@ -0,0 +1,260 @@
+// Package redistest runs an in-process stand-in for Redis, for tests of
+// code that talks to it.
+package redistest
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Server knows the commands this application sends: AUTH, SELECT, GET,
+// SET, DEL, HMGET, HSET, PEXPIRE, WATCH, MULTI and EXEC. Like Redis, WATCH
+// fails a transaction when the watched key was written since. Keys do not
+// expire; their TTLs are only recorded.
+type Server struct {
+	Addr string
+	// BeforeExec runs right before a transaction is executed.
+	BeforeExec func()
+
+	mu       sync.Mutex
+	password string
+	strings  map[string]string
+	hashes   map[string]map[string]string
+	versions map[string]int
+	ttls     map[string]string
+	commands []string
+}
+
+// NewServer starts a server that requires password, unless it is empty. It
+// stops when the test ends.
+func NewServer(t *testing.T, password string) *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error starting Redis server: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	s := &Server{
+		Addr:     listener.Addr().String(),
+		password: password,
+		strings:  make(map[string]string),
+		hashes:   make(map[string]map[string]string),
+		versions: make(map[string]int),
+		ttls:     make(map[string]string),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+// Commands lists the names of the commands received so far.
+func (s *Server) Commands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.commands...)
+}
+
+// Get returns a string key.
+func (s *Server) Get(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.strings[key]
+	return value, ok
+}
+
+// HGet returns a field of a hash key.
+func (s *Server) HGet(key string, field string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hashes[key][field]
+}
+
+// TTL returns the TTL last set on key, in milliseconds.
+func (s *Server) TTL(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ttls[key]
+}
+
+// HSet changes a hash key the way another client would.
+func (s *Server) HSet(key string, fields map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hashes[key] = fields
+	s.versions[key]++
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	authed := s.password == ""
+	watched := map[string]int{}
+	var queued [][]string
+	inMulti := false
+
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		name := strings.ToUpper(args[0])
+
+		s.mu.Lock()
+		s.commands = append(s.commands, name)
+		s.mu.Unlock()
+
+		switch {
+		case name == "AUTH":
+			if args[1] != s.password {
+				io.WriteString(conn, "-WRONGPASS invalid password\r\n")
+				continue
+			}
+			authed = true
+			io.WriteString(conn, "+OK\r\n")
+		case !authed:
+			io.WriteString(conn, "-NOAUTH Authentication required.\r\n")
+		case name == "WATCH":
+			s.mu.Lock()
+			watched[args[1]] = s.versions[args[1]]
+			s.mu.Unlock()
+			io.WriteString(conn, "+OK\r\n")
+		case name == "MULTI":
+			inMulti = true
+			io.WriteString(conn, "+OK\r\n")
+		case name == "EXEC":
+			if s.BeforeExec != nil {
+				s.BeforeExec()
+			}
+			s.mu.Lock()
+			conflict := false
+			for key, version := range watched {
+				if s.versions[key] != version {
+					conflict = true
+				}
+			}
+			if conflict {
+				io.WriteString(conn, "*-1\r\n")
+			} else {
+				reply := "*" + strconv.Itoa(len(queued)) + "\r\n"
+				for _, command := range queued {
+					reply += s.apply(command)
+				}
+				io.WriteString(conn, reply)
+			}
+			s.mu.Unlock()
+			watched = map[string]int{}
+			queued = nil
+			inMulti = false
+		case inMulti:
+			queued = append(queued, args)
+			io.WriteString(conn, "+QUEUED\r\n")
+		default:
+			s.mu.Lock()
+			reply := s.apply(args)
+			s.mu.Unlock()
+			io.WriteString(conn, reply)
+		}
+	}
+}
+
+// apply runs a command and returns its reply. It needs s.mu held.
+func (s *Server) apply(args []string) string {
+	switch strings.ToUpper(args[0]) {
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		value, ok := s.strings[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(value)
+	case "SET":
+		s.strings[args[1]] = args[2]
+		s.versions[args[1]]++
+		for i := 3; i+1 < len(args); i++ {
+			if strings.ToUpper(args[i]) == "PX" {
+				s.ttls[args[1]] = args[i+1]
+			}
+		}
+		return "+OK\r\n"
+	case "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if _, ok := s.strings[key]; ok {
+				deleted++
+			}
+			delete(s.strings, key)
+			delete(s.hashes, key)
+			s.versions[key]++
+		}
+		return ":" + strconv.Itoa(deleted) + "\r\n"
+	case "HMGET":
+		reply := "*" + strconv.Itoa(len(args)-2) + "\r\n"
+		for _, field := range args[2:] {
+			value, ok := s.hashes[args[1]][field]
+			if !ok {
+				reply += "$-1\r\n"
+				continue
+			}
+			reply += bulk(value)
+		}
+		return reply
+	case "HSET":
+		if s.hashes[args[1]] == nil {
+			s.hashes[args[1]] = map[string]string{}
+		}
+		for i := 2; i+1 < len(args); i += 2 {
+			s.hashes[args[1]][args[i]] = args[i+1]
+		}
+		s.versions[args[1]]++
+		return ":1\r\n"
+	case "PEXPIRE":
+		s.ttls[args[1]] = args[2]
+		return ":1\r\n"
+	default:
+		return "-ERR unknown command '" + args[0] + "'\r\n"
+	}
+}
+
+func bulk(value string) string {
+	return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		args = append(args, string(data[:size]))
+	}
+	return args, nil
+}
@@ -3,6 +3,7 @@ package requestPkg
 import (
 	"CodeWithAzri/internal/pkg/middleware"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi"
 )
@@ -12,6 +13,12 @@ func GetUserID(r *http.Request) string {
 	return userID
 }
 
+// GetTokenClaims returns the claims of the verified ID token of the request.
+func GetTokenClaims(r *http.Request) middleware.TokenClaims {
+	claims, _ := r.Context().Value(middleware.ClaimsContextKey).(middleware.TokenClaims)
+	return claims
+}
+
 func GetURLParam(r *http.Request, key string) string {
 	return chi.URLParam(r, key)
 }
@@ -19,3 +26,22 @@ func GetURLParam(r *http.Request, key string) string {
 func GetQueryParam(r *http.Request, key string) string {
 	return r.URL.Query().Get(key)
 }
+
+// ParsePagination reads the page and limit query parameters, ignoring
+// missing or non-positive values in favour of the first page of ten items.
+func ParsePagination(r *http.Request) (limit, page int) {
+	page = 1
+	limit = 10
+
+	pageInt, err := strconv.Atoi(GetQueryParam(r, "page"))
+	if err == nil && pageInt > 0 {
+		page = pageInt
+	}
+
+	limitInt, err := strconv.Atoi(GetQueryParam(r, "limit"))
+	if err == nil && limitInt > 0 {
+		limit = limitInt
+	}
+
+	return limit, page
+}
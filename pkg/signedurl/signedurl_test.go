@@ -0,0 +1,53 @@
+package signedurl_test
+
+import (
+	"CodeWithAzri/pkg/signedurl"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+	t.Run("URL and Verify", func(t *testing.T) {
+		s, err := signedurl.NewSigner("http://localhost/streams/", "secret")
+		assert.NoError(t, err)
+
+		expires := time.Now().Add(time.Minute).Unix()
+		signedURL := s.URL("abc/720p/index.m3u8", expires)
+		assert.True(t, strings.HasPrefix(signedURL, "http://localhost/streams/abc/720p/index.m3u8?"))
+
+		parsed, _ := url.Parse(signedURL)
+		parsedExpires, _ := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+		signature := parsed.Query().Get("signature")
+		assert.Equal(t, expires, parsedExpires)
+
+		assert.NoError(t, s.Verify("abc/720p/index.m3u8", expires, signature))
+		assert.ErrorIs(t, s.Verify("abc/1080p/index.m3u8", expires, signature), signedurl.ErrInvalidSignature)
+		assert.ErrorIs(t, s.Verify("abc/720p/index.m3u8", expires+1, signature), signedurl.ErrInvalidSignature)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		s, _ := signedurl.NewSigner("http://localhost/streams", "secret")
+
+		expires := time.Now().Add(-time.Second).Unix()
+		signedURL := s.URL("abc/master.m3u8", expires)
+		parsed, _ := url.Parse(signedURL)
+
+		err := s.Verify("abc/master.m3u8", expires, parsed.Query().Get("signature"))
+		assert.ErrorIs(t, err, signedurl.ErrInvalidSignature)
+	})
+
+	t.Run("Random Secret", func(t *testing.T) {
+		first, _ := signedurl.NewSigner("http://localhost/streams", "")
+		second, _ := signedurl.NewSigner("http://localhost/streams", "")
+
+		expires := time.Now().Add(time.Minute).Unix()
+		parsed, _ := url.Parse(first.URL("abc/master.m3u8", expires))
+
+		assert.ErrorIs(t, second.Verify("abc/master.m3u8", expires, parsed.Query().Get("signature")), signedurl.ErrInvalidSignature)
+	})
+}
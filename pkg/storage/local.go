@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"CodeWithAzri/pkg/signedurl"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LocalStorage stores objects on the local filesystem. Its signed URLs point
+// at BaseURL, which is expected to be served by the media module.
+type LocalStorage struct {
+	root   string
+	signer *signedurl.Signer
+	now    func() time.Time
+}
+
+// NewLocalStorage creates root if needed. An empty secret generates a random
+// one, which means signed URLs do not survive a restart.
+func NewLocalStorage(root string, baseURL string, secret string) (*LocalStorage, error) {
+	err := os.MkdirAll(root, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage root: %v", err)
+	}
+
+	signer, err := signedurl.NewSigner(baseURL, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &LocalStorage{
+		root:   root,
+		signer: signer,
+		now:    time.Now,
+	}
+	return s, nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create object directory: %v", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial object.
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create object: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, body)
+	closeErr := tmp.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write object: %v", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("failed to write object: %v", closeErr)
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("failed to write object: wrote %d of %d bytes", written, size)
+	}
+
+	err = os.Rename(tmp.Name(), filePath)
+	if err != nil {
+		return fmt.Errorf("failed to store object: %v", err)
+	}
+
+	return nil
+}
+
+// Get returns an *os.File, so callers can type assert to io.ReadSeeker to
+// serve range requests.
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object: %v", err)
+	}
+
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %v", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) SignedURL(key string, expiry time.Duration) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	expires := s.now().Add(expiry).Unix()
+	return s.signer.URL(key, expires), nil
+}
+
+func (s *LocalStorage) VerifySignature(key string, expires int64, signature string) error {
+	return s.signer.Verify(key, expires, signature)
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,74 @@
+package storage_test
+
+import (
+	"CodeWithAzri/pkg/storage"
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Put Get Delete", func(t *testing.T) {
+		s, err := storage.NewLocalStorage(t.TempDir(), "http://localhost/files", "secret")
+		assert.NoError(t, err)
+
+		err = s.Put(ctx, "course_gallery/a.png", strings.NewReader("hello"), 5, "image/png")
+		assert.NoError(t, err)
+
+		body, err := s.Get(ctx, "course_gallery/a.png")
+		assert.NoError(t, err)
+		content, _ := io.ReadAll(body)
+		body.Close()
+		assert.Equal(t, "hello", string(content))
+
+		assert.NoError(t, s.Delete(ctx, "course_gallery/a.png"))
+		assert.NoError(t, s.Delete(ctx, "course_gallery/a.png"))
+
+		_, err = s.Get(ctx, "course_gallery/a.png")
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+
+	t.Run("Put Size Mismatch", func(t *testing.T) {
+		s, _ := storage.NewLocalStorage(t.TempDir(), "http://localhost/files", "secret")
+
+		err := s.Put(ctx, "a.png", strings.NewReader("hello"), 10, "image/png")
+		assert.Error(t, err)
+
+		_, err = s.Get(ctx, "a.png")
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+
+	t.Run("Invalid Keys", func(t *testing.T) {
+		s, _ := storage.NewLocalStorage(t.TempDir(), "http://localhost/files", "secret")
+
+		for _, key := range []string{"", "/etc/passwd", "../secret", "a/../../b", "a\\b", "a//b"} {
+			err := s.Put(ctx, key, strings.NewReader("x"), 1, "text/plain")
+			assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+		}
+	})
+
+	t.Run("Signed URL", func(t *testing.T) {
+		s, _ := storage.NewLocalStorage(t.TempDir(), "http://localhost/files/", "secret")
+
+		signedURL, err := s.SignedURL("course_gallery/a b.png", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(signedURL, "http://localhost/files/course_gallery/a%20b.png?"))
+
+		parsed, _ := url.Parse(signedURL)
+		expires, _ := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+		signature := parsed.Query().Get("signature")
+
+		assert.NoError(t, s.VerifySignature("course_gallery/a b.png", expires, signature))
+		assert.ErrorIs(t, s.VerifySignature("course_gallery/other.png", expires, signature), storage.ErrInvalidSignature)
+		assert.ErrorIs(t, s.VerifySignature("course_gallery/a b.png", expires+1, signature), storage.ErrInvalidSignature)
+		assert.ErrorIs(t, s.VerifySignature("course_gallery/a b.png", time.Now().Add(-time.Second).Unix(), signature), storage.ErrInvalidSignature)
+	})
+}
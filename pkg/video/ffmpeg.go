@@ -0,0 +1,147 @@
+package video
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// segmentDuration is the target length of an HLS segment in seconds.
+const segmentDuration = 6
+
+// Info describes the first video stream of a file.
+type Info struct {
+	Duration time.Duration
+	Width    int
+	Height   int
+}
+
+// Transcoder packages uploaded videos for HLS streaming.
+type Transcoder interface {
+	Probe(ctx context.Context, input string) (Info, error)
+	// PackageHLS writes the media playlist of rendition and its segments to
+	// outputDir.
+	PackageHLS(ctx context.Context, input string, outputDir string, rendition Rendition) error
+}
+
+// FFmpegTranscoder runs the ffmpeg and ffprobe command line tools installed
+// on the local machine.
+type FFmpegTranscoder struct {
+	ffmpegPath  string
+	ffprobePath string
+}
+
+// NewFFmpegTranscoder looks up both binaries, either by absolute path or on
+// the PATH.
+func NewFFmpegTranscoder(ffmpeg string, ffprobe string) (*FFmpegTranscoder, error) {
+	ffmpegPath, err := exec.LookPath(ffmpeg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ffmpeg: %v", err)
+	}
+
+	ffprobePath, err := exec.LookPath(ffprobe)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ffprobe: %v", err)
+	}
+
+	t := &FFmpegTranscoder{ffmpegPath: ffmpegPath, ffprobePath: ffprobePath}
+	return t, nil
+}
+
+type probeOutput struct {
+	Streams []struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func (t *FFmpegTranscoder) Probe(ctx context.Context, input string) (Info, error) {
+	output, err := run(ctx, t.ffprobePath,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=width,height:format=duration",
+		"-of", "json",
+		input,
+	)
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to run ffprobe: %v", err)
+	}
+
+	var probe probeOutput
+	err = json.Unmarshal(output, &probe)
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to parse ffprobe output: %v", err)
+	}
+
+	if len(probe.Streams) == 0 {
+		return Info{}, errors.New("file has no video stream")
+	}
+
+	seconds, err := strconv.ParseFloat(probe.Format.Duration, 64)
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to parse video duration %q: %v", probe.Format.Duration, err)
+	}
+
+	return Info{
+		Duration: time.Duration(seconds * float64(time.Second)),
+		Width:    probe.Streams[0].Width,
+		Height:   probe.Streams[0].Height,
+	}, nil
+}
+
+func (t *FFmpegTranscoder) PackageHLS(ctx context.Context, input string, outputDir string, rendition Rendition) error {
+	err := os.MkdirAll(outputDir, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create rendition directory: %v", err)
+	}
+
+	// Keyframes every two seconds line segment boundaries up across
+	// renditions, so players can switch quality between segments.
+	_, err = run(ctx, t.ffmpegPath,
+		"-hide_banner", "-loglevel", "error", "-y",
+		"-i", input,
+		"-map", "0:v:0", "-map", "0:a:0?",
+		"-map_metadata", "-1",
+		"-vf", fmt.Sprintf("scale=-2:%d", rendition.Height),
+		"-c:v", "libx264", "-preset", "veryfast", "-profile:v", "main", "-pix_fmt", "yuv420p",
+		"-b:v", fmt.Sprintf("%dk", rendition.VideoBitrate),
+		"-maxrate", fmt.Sprintf("%dk", rendition.VideoBitrate*107/100),
+		"-bufsize", fmt.Sprintf("%dk", rendition.VideoBitrate*3/2),
+		"-force_key_frames", "expr:gte(t,n_forced*2)", "-sc_threshold", "0",
+		"-c:a", "aac", "-ac", "2", "-b:a", fmt.Sprintf("%dk", rendition.AudioBitrate),
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(segmentDuration),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", filepath.Join(outputDir, "segment_%04d.ts"),
+		filepath.Join(outputDir, MediaPlaylist),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to run ffmpeg for %s: %v", rendition.Name, err)
+	}
+
+	return nil
+}
+
+func run(ctx context.Context, binary string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return stdout.Bytes(), nil
+}
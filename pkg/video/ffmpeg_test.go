@@ -0,0 +1,84 @@
+package video_test
+
+import (
+	"CodeWithAzri/pkg/video"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeScript creates an executable shell script standing in for ffmpeg or
+// ffprobe.
+func writeScript(t *testing.T, name string, body string) string {
+	script := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(script, []byte("#!/bin/sh\n"+body), 0o755)
+	assert.NoError(t, err)
+	return script
+}
+
+func TestFFmpegTranscoder(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses shell scripts in place of ffmpeg and ffprobe")
+	}
+
+	ctx := context.Background()
+
+	t.Run("Probe", func(t *testing.T) {
+		ffprobe := writeScript(t, "ffprobe", `echo '{"streams":[{"width":1920,"height":1080}],"format":{"duration":"93.500000"}}'`+"\n")
+		transcoder, err := video.NewFFmpegTranscoder(ffprobe, ffprobe)
+		assert.NoError(t, err)
+
+		info, err := transcoder.Probe(ctx, "input.mp4")
+
+		assert.NoError(t, err)
+		assert.Equal(t, video.Info{Duration: 93500 * time.Millisecond, Width: 1920, Height: 1080}, info)
+	})
+
+	t.Run("Probe Without Video Stream", func(t *testing.T) {
+		ffprobe := writeScript(t, "ffprobe", `echo '{"streams":[],"format":{"duration":"3.0"}}'`+"\n")
+		transcoder, _ := video.NewFFmpegTranscoder(ffprobe, ffprobe)
+
+		_, err := transcoder.Probe(ctx, "input.mp3")
+
+		assert.EqualError(t, err, "file has no video stream")
+	})
+
+	t.Run("Package HLS", func(t *testing.T) {
+		// The stand-in records its arguments in the playlist it is asked
+		// to write, which is always the last argument.
+		ffmpeg := writeScript(t, "ffmpeg", "for last; do :; done\necho \"$@\" > \"$last\"\n")
+		transcoder, err := video.NewFFmpegTranscoder(ffmpeg, ffmpeg)
+		assert.NoError(t, err)
+
+		outputDir := filepath.Join(t.TempDir(), "720p")
+		err = transcoder.PackageHLS(ctx, "input.mp4", outputDir, video.Ladder[2])
+		assert.NoError(t, err)
+
+		args, err := os.ReadFile(filepath.Join(outputDir, video.MediaPlaylist))
+		assert.NoError(t, err)
+		assert.Contains(t, string(args), "-i input.mp4")
+		assert.Contains(t, string(args), "scale=-2:720")
+		assert.Contains(t, string(args), "-b:v 2800k")
+		assert.Contains(t, string(args), "-hls_playlist_type vod")
+	})
+
+	t.Run("Package HLS Failure", func(t *testing.T) {
+		ffmpeg := writeScript(t, "ffmpeg", "echo 'invalid data found' >&2\nexit 1\n")
+		transcoder, _ := video.NewFFmpegTranscoder(ffmpeg, ffmpeg)
+
+		err := transcoder.PackageHLS(ctx, "input.mp4", t.TempDir(), video.Ladder[0])
+
+		assert.ErrorContains(t, err, "invalid data found")
+	})
+
+	t.Run("Missing Binary", func(t *testing.T) {
+		_, err := video.NewFFmpegTranscoder(filepath.Join(t.TempDir(), "missing"), "ffprobe")
+
+		assert.Error(t, err)
+	})
+}
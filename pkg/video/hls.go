@@ -0,0 +1,156 @@
+package video
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PlaylistContentType is served for master and media playlists.
+	PlaylistContentType = "application/vnd.apple.mpegurl"
+	// SegmentContentType is served for MPEG-TS segments.
+	SegmentContentType = "video/mp2t"
+	// MasterPlaylist is the file name of the playlist listing all renditions.
+	MasterPlaylist = "master.m3u8"
+	// MediaPlaylist is the file name of the playlist inside every rendition
+	// directory.
+	MediaPlaylist = "index.m3u8"
+)
+
+// Rendition is one quality level of an HLS stream. Bitrates are in kbit/s.
+type Rendition struct {
+	Name         string
+	Height       int
+	VideoBitrate int
+	AudioBitrate int
+}
+
+// Bandwidth is the peak bit rate announced in the master playlist.
+func (r Rendition) Bandwidth() int {
+	return (r.VideoBitrate + r.AudioBitrate) * 1000
+}
+
+// Ladder lists the renditions a video is packaged into, from lowest to
+// highest quality.
+var Ladder = []Rendition{
+	{Name: "360p", Height: 360, VideoBitrate: 800, AudioBitrate: 96},
+	{Name: "480p", Height: 480, VideoBitrate: 1400, AudioBitrate: 128},
+	{Name: "720p", Height: 720, VideoBitrate: 2800, AudioBitrate: 128},
+	{Name: "1080p", Height: 1080, VideoBitrate: 5000, AudioBitrate: 192},
+}
+
+// RenditionsFor picks the renditions of the Ladder that do not upscale a
+// video of the given height. Videos smaller than the lowest rendition keep
+// their own height.
+func RenditionsFor(height int) []Rendition {
+	renditions := make([]Rendition, 0, len(Ladder))
+	for _, rendition := range Ladder {
+		if rendition.Height <= height {
+			renditions = append(renditions, rendition)
+		}
+	}
+
+	if len(renditions) == 0 {
+		lowest := Ladder[0]
+		if height > 0 {
+			lowest.Height = even(height)
+		}
+		renditions = append(renditions, lowest)
+	}
+
+	return renditions
+}
+
+// ScaledWidth is the width ffmpeg produces when scaling info to height while
+// keeping the aspect ratio.
+func ScaledWidth(info Info, height int) int {
+	if info.Height == 0 {
+		return 0
+	}
+	return even(info.Width * height / info.Height)
+}
+
+// Variant is an entry of a master playlist.
+type Variant struct {
+	Rendition Rendition
+	Width     int
+	URI       string
+}
+
+// WriteMasterPlaylist lists variants from lowest to highest quality.
+func WriteMasterPlaylist(variants []Variant) []byte {
+	var playlist bytes.Buffer
+	playlist.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+
+	for _, variant := range variants {
+		fmt.Fprintf(&playlist, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d,NAME=%q\n",
+			variant.Rendition.Bandwidth(), variant.Width, variant.Rendition.Height, variant.Rendition.Name)
+		playlist.WriteString(variant.URI + "\n")
+	}
+
+	return playlist.Bytes()
+}
+
+// RewritePlaylist passes every URI of a playlist through resolve, both the
+// plain URI lines and the URI attributes of tags such as EXT-X-MAP.
+func RewritePlaylist(playlist []byte, resolve func(uri string) (string, error)) ([]byte, error) {
+	var rewritten bytes.Buffer
+
+	scanner := bufio.NewScanner(bytes.NewReader(playlist))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#"):
+			var err error
+			line, err = rewriteURIAttribute(line, resolve)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			var err error
+			line, err = resolve(line)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rewritten.WriteString(line + "\n")
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read playlist: %v", err)
+	}
+
+	return rewritten.Bytes(), nil
+}
+
+func rewriteURIAttribute(tag string, resolve func(uri string) (string, error)) (string, error) {
+	const attribute = `URI="`
+
+	start := strings.Index(tag, attribute)
+	if start < 0 {
+		return tag, nil
+	}
+	start += len(attribute)
+
+	end := strings.IndexByte(tag[start:], '"')
+	if end < 0 {
+		return tag, nil
+	}
+	end += start
+
+	uri, err := resolve(tag[start:end])
+	if err != nil {
+		return "", err
+	}
+
+	return tag[:start] + uri + tag[end:], nil
+}
+
+func even(n int) int {
+	return n &^ 1
+}
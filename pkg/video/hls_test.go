@@ -0,0 +1,73 @@
+package video_test
+
+import (
+	"CodeWithAzri/pkg/video"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenditionsFor(t *testing.T) {
+	t.Run("Skips Upscaling", func(t *testing.T) {
+		renditions := video.RenditionsFor(720)
+
+		names := make([]string, 0, len(renditions))
+		for _, rendition := range renditions {
+			names = append(names, rendition.Name)
+		}
+		assert.Equal(t, []string{"360p", "480p", "720p"}, names)
+	})
+
+	t.Run("Small Video", func(t *testing.T) {
+		renditions := video.RenditionsFor(241)
+
+		assert.Len(t, renditions, 1)
+		assert.Equal(t, "360p", renditions[0].Name)
+		assert.Equal(t, 240, renditions[0].Height)
+	})
+}
+
+func TestScaledWidth(t *testing.T) {
+	assert.Equal(t, 640, video.ScaledWidth(video.Info{Width: 1920, Height: 1080}, 360))
+	assert.Equal(t, 852, video.ScaledWidth(video.Info{Width: 1920, Height: 1080}, 480))
+	assert.Equal(t, 0, video.ScaledWidth(video.Info{}, 480))
+}
+
+func TestWriteMasterPlaylist(t *testing.T) {
+	playlist := video.WriteMasterPlaylist([]video.Variant{
+		{Rendition: video.Ladder[0], Width: 640, URI: "360p/index.m3u8"},
+		{Rendition: video.Ladder[2], Width: 1280, URI: "720p/index.m3u8"},
+	})
+
+	expected := "#EXTM3U\n#EXT-X-VERSION:3\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=896000,RESOLUTION=640x360,NAME=\"360p\"\n360p/index.m3u8\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=2928000,RESOLUTION=1280x720,NAME=\"720p\"\n720p/index.m3u8\n"
+	assert.Equal(t, expected, string(playlist))
+}
+
+func TestRewritePlaylist(t *testing.T) {
+	t.Run("Rewrite URIs", func(t *testing.T) {
+		playlist := "#EXTM3U\r\n#EXT-X-MAP:URI=\"init.mp4\",BYTERANGE=\"100@0\"\n#EXTINF:6.0,\nsegment_0000.ts\n\n#EXT-X-ENDLIST\n"
+
+		rewritten, err := video.RewritePlaylist([]byte(playlist), func(uri string) (string, error) {
+			return "https://cdn/" + uri + "?sig=1", nil
+		})
+
+		assert.NoError(t, err)
+		expected := "#EXTM3U\n#EXT-X-MAP:URI=\"https://cdn/init.mp4?sig=1\",BYTERANGE=\"100@0\"\n#EXTINF:6.0,\nhttps://cdn/segment_0000.ts?sig=1\n\n#EXT-X-ENDLIST\n"
+		assert.Equal(t, expected, string(rewritten))
+	})
+
+	t.Run("Resolve Error", func(t *testing.T) {
+		_, err := video.RewritePlaylist([]byte("#EXTM3U\n../secret.ts\n"), func(uri string) (string, error) {
+			if strings.HasPrefix(uri, "..") {
+				return "", errors.New("invalid uri")
+			}
+			return uri, nil
+		})
+
+		assert.EqualError(t, err, "invalid uri")
+	})
+}